@@ -24,6 +24,12 @@ const (
 	ConnectorStatusPhaseDeprovisioning ConnectorStatusPhase = "deprovisioning" // set by kas-agent
 	ConnectorStatusPhaseDeleting       ConnectorStatusPhase = "deleting"       // set by the kas-fleet-manager - user request
 	ConnectorStatusPhaseDeleted        ConnectorStatusPhase = "deleted"        // set by the agent
+
+	// ConnectorKafkaAvailableCondition is set by the fleet manager when a connector is stopped because its Kafka was deleted
+	ConnectorKafkaAvailableCondition = "KafkaAvailable"
+	// ConnectorScheduledCondition is set by the fleet manager when namespace scheduling is enabled, to explain why a connector
+	// was placed in its namespace, or why no namespace can host it yet
+	ConnectorScheduledCondition = "Scheduled"
 )
 
 var ValidDesiredStates = []string{
@@ -45,10 +51,14 @@ var AgentConnectorStatusPhase = []string{
 type Connector struct {
 	db.Model
 
-	NamespaceId   *string
-	CloudProvider string
-	Region        string
-	MultiAZ       bool
+	NamespaceId *string
+	// TargetNamespaceId is the namespace the connector is being moved to, once its deployment is removed from NamespaceId
+	TargetNamespaceId *string
+	// TargetDesiredState is the desired state the connector is restored to once it's assigned to TargetNamespaceId
+	TargetDesiredState ConnectorDesiredState
+	CloudProvider      string
+	Region             string
+	MultiAZ            bool
 
 	Name           string
 	Owner          string
@@ -63,6 +73,7 @@ type Connector struct {
 	Kafka           KafkaConnectionSettings          `gorm:"embedded;embeddedPrefix:kafka_"`
 	SchemaRegistry  SchemaRegistryConnectionSettings `gorm:"embedded;embeddedPrefix:schema_registry_"`
 	ServiceAccount  ServiceAccount                   `gorm:"embedded;embeddedPrefix:service_account_"`
+	Schedule        *ConnectorSchedule               `gorm:"foreignKey:ConnectorID;references:ID"`
 
 	Status ConnectorStatus `gorm:"foreignKey:ID"`
 }
@@ -77,6 +88,8 @@ type ConnectorStatus struct {
 	db.Model
 	NamespaceID *string
 	Phase       ConnectorStatusPhase
+	// Conditions are set by the fleet manager, unlike the conditions reported by the agent in the deployment status
+	Conditions ConditionList `gorm:"type:jsonb"`
 }
 
 type ConnectorList []*Connector
@@ -101,8 +114,9 @@ type ConnectorDeployment struct {
 	ClusterID                string
 	NamespaceID              string
 	AllowUpgrade             bool
-	Status                   ConnectorDeploymentStatus `gorm:"foreignKey:ID;references:ID"`
-	Annotations              []ConnectorAnnotation     `gorm:"foreignKey:ConnectorID;references:ConnectorID"`
+	SchedulingDecision       ConnectorSchedulingDecision `gorm:"type:jsonb"`
+	Status                   ConnectorDeploymentStatus   `gorm:"foreignKey:ID;references:ID"`
+	Annotations              []ConnectorAnnotation       `gorm:"foreignKey:ConnectorID;references:ConnectorID"`
 }
 
 type ConnectorDeploymentList []ConnectorDeployment
@@ -114,6 +128,7 @@ type ConnectorDeploymentStatus struct {
 	Conditions       api.JSON `gorm:"type:jsonb"`
 	Operators        api.JSON `gorm:"type:jsonb"`
 	UpgradeAvailable bool
+	Metrics          ConnectorDeploymentMetrics `gorm:"type:jsonb"`
 }
 
 type KafkaConnectionSettings struct {
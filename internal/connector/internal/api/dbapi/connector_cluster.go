@@ -18,6 +18,11 @@ const (
 	ConnectorClusterPhaseDeleting ConnectorClusterPhaseEnum = "deleting"
 
 	ConnectorClusterOrgIdAnnotation string = "cos.bf2.org/organisation-id"
+
+	// ConnectorClusterAgentSupportedCondition is set by the fleet manager when the agent version is checked against the compatibility matrix
+	ConnectorClusterAgentSupportedCondition = "AgentVersionSupported"
+	// ConnectorClusterAgentUpToDateCondition is set by the fleet manager when the agent version is compared to the desired agent version
+	ConnectorClusterAgentUpToDateCondition = "AgentUpToDate"
 )
 
 var AgentRequestConnectorClusterStatus = []string{
@@ -75,6 +80,17 @@ func (c ConditionList) Value() (driver.Value, error) {
 	return json.Marshal(c)
 }
 
+// Without returns the conditions of a type other than conditionType
+func (c ConditionList) Without(conditionType string) ConditionList {
+	var result ConditionList
+	for _, condition := range c {
+		if condition.Type != conditionType {
+			result = append(result, condition)
+		}
+	}
+	return result
+}
+
 type OperatorList []OperatorStatus
 
 func (o *OperatorList) Scan(value interface{}) error {
@@ -0,0 +1,36 @@
+package dbapi
+
+import "time"
+
+type ConnectorDeploymentLogKind string
+
+const (
+	// ConnectorDeploymentLogKindLog is a log line of a connector container
+	ConnectorDeploymentLogKindLog ConnectorDeploymentLogKind = "log"
+	// ConnectorDeploymentLogKindEvent is a Kubernetes event involving the resources of a connector
+	ConnectorDeploymentLogKindEvent ConnectorDeploymentLogKind = "event"
+)
+
+var ValidConnectorDeploymentLogKinds = []string{
+	string(ConnectorDeploymentLogKindLog),
+	string(ConnectorDeploymentLogKindEvent),
+}
+
+// ConnectorDeploymentLog is a log line or event relayed by the agent for a deployment,
+// only the most recent entries of each deployment are kept
+type ConnectorDeploymentLog struct {
+	// ID orders the entries in the sequence they were received
+	ID           int64  `gorm:"primaryKey:autoIncrement"`
+	DeploymentID string `gorm:"index"`
+	ConnectorID  string `gorm:"index"`
+	Kind         ConnectorDeploymentLogKind
+	Timestamp    time.Time
+	// Source is the container of a log line, or the object involved in an event
+	Source string
+	// Level is the level of a log line, or the type of an event
+	Level   string
+	Reason  string
+	Message string
+}
+
+type ConnectorDeploymentLogList []ConnectorDeploymentLog
@@ -0,0 +1,76 @@
+package dbapi
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// ConnectorDeploymentMetrics are the counters reported by the agent in the status of a deployment,
+// with the throughput computed from the previously reported counters
+type ConnectorDeploymentMetrics struct {
+	ReportedAt   time.Time            `json:"reported_at"`
+	RecordsIn    int64                `json:"records_in"`
+	RecordsOut   int64                `json:"records_out"`
+	RecordErrors int64                `json:"record_errors"`
+	Lag          int64                `json:"lag"`
+	Tasks        []ConnectorTaskState `json:"tasks,omitempty"`
+
+	RecordsInPerSecond  float64 `json:"records_in_per_second"`
+	RecordsOutPerSecond float64 `json:"records_out_per_second"`
+}
+
+// ConnectorTaskState is the state of a task of a connector, e.g. running or failed
+type ConnectorTaskState struct {
+	ID    string `json:"id"`
+	State string `json:"state"`
+	Trace string `json:"trace,omitempty"`
+}
+
+// IsReported returns false if the agent hasn't reported metrics for the deployment yet
+func (m *ConnectorDeploymentMetrics) IsReported() bool {
+	return !m.ReportedAt.IsZero()
+}
+
+// UpdateThroughput computes the records throughput since the previous metrics of the deployment.
+// Counters going backwards mean the connector restarted, and its throughput is counted from zero.
+func (m *ConnectorDeploymentMetrics) UpdateThroughput(previous ConnectorDeploymentMetrics) {
+	if !previous.IsReported() {
+		return
+	}
+	elapsed := m.ReportedAt.Sub(previous.ReportedAt).Seconds()
+	if elapsed <= 0 {
+		m.RecordsInPerSecond = previous.RecordsInPerSecond
+		m.RecordsOutPerSecond = previous.RecordsOutPerSecond
+		return
+	}
+	rate := func(current, previous int64) float64 {
+		if current < previous {
+			previous = 0
+		}
+		return float64(current-previous) / elapsed
+	}
+	m.RecordsInPerSecond = rate(m.RecordsIn, previous.RecordsIn)
+	m.RecordsOutPerSecond = rate(m.RecordsOut, previous.RecordsOut)
+}
+
+func (m *ConnectorDeploymentMetrics) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case string:
+		return json.Unmarshal([]byte(v), m)
+	case []byte:
+		return json.Unmarshal(v, m)
+	default:
+		return fmt.Errorf("failed to unmarshal json value: %v", value)
+	}
+}
+
+func (m ConnectorDeploymentMetrics) Value() (driver.Value, error) {
+	if !m.IsReported() {
+		return nil, nil
+	}
+	return json.Marshal(m)
+}
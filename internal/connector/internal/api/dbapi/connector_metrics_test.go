@@ -0,0 +1,61 @@
+package dbapi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+)
+
+func TestConnectorDeploymentMetrics_UpdateThroughput(t *testing.T) {
+	reportedAt := time.Date(2023, 2, 22, 10, 0, 0, 0, time.UTC)
+	previous := ConnectorDeploymentMetrics{
+		ReportedAt:          reportedAt,
+		RecordsIn:           1000,
+		RecordsOut:          500,
+		RecordsInPerSecond:  3,
+		RecordsOutPerSecond: 2,
+	}
+	tests := []struct {
+		name       string
+		previous   ConnectorDeploymentMetrics
+		metrics    ConnectorDeploymentMetrics
+		recordsIn  float64
+		recordsOut float64
+	}{
+		{
+			name:    "first report",
+			metrics: ConnectorDeploymentMetrics{ReportedAt: reportedAt, RecordsIn: 1000, RecordsOut: 500},
+		},
+		{
+			name:       "counters increased",
+			previous:   previous,
+			metrics:    ConnectorDeploymentMetrics{ReportedAt: reportedAt.Add(10 * time.Second), RecordsIn: 1100, RecordsOut: 520},
+			recordsIn:  10,
+			recordsOut: 2,
+		},
+		{
+			name:       "connector restarted",
+			previous:   previous,
+			metrics:    ConnectorDeploymentMetrics{ReportedAt: reportedAt.Add(10 * time.Second), RecordsIn: 50, RecordsOut: 20},
+			recordsIn:  5,
+			recordsOut: 2,
+		},
+		{
+			name:       "same report time keeps the previous throughput",
+			previous:   previous,
+			metrics:    ConnectorDeploymentMetrics{ReportedAt: reportedAt, RecordsIn: 1000, RecordsOut: 500},
+			recordsIn:  3,
+			recordsOut: 2,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.metrics.UpdateThroughput(tt.previous)
+			g.Expect(tt.metrics.RecordsInPerSecond).To(gomega.Equal(tt.recordsIn))
+			g.Expect(tt.metrics.RecordsOutPerSecond).To(gomega.Equal(tt.recordsOut))
+		})
+	}
+}
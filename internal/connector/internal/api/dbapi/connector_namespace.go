@@ -1,8 +1,10 @@
 package dbapi
 
 import (
-	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"sort"
 	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
 )
 
 type ConnectorNamespacePhaseEnum string
@@ -47,6 +49,10 @@ type ConnectorNamespace struct {
 	Owner      string `gorm:"not null;index"`
 	Version    int64  `gorm:"type:bigserial;index"`
 	Expiration *time.Time
+	// ExtendedAt is set when the expiration of the namespace was extended, it can be extended only once
+	ExtendedAt *time.Time
+	// NextExpiryWarningAt is the time the next expiry warning is due, updated when the warning is emitted
+	NextExpiryWarningAt *time.Time `gorm:"index"`
 
 	// metadata
 	Annotations []ConnectorNamespaceAnnotation `gorm:"foreignKey:NamespaceId;references:ID"`
@@ -69,3 +75,26 @@ type ConnectorNamespaceStatus struct {
 }
 
 type ConnectorNamespaceList []*ConnectorNamespace
+
+// UpdateNextExpiryWarning sets the next expiry warning to the earliest warning after the given time,
+// warnings are due at the given offsets before the namespace expiration
+func (n *ConnectorNamespace) UpdateNextExpiryWarning(offsets []time.Duration, after time.Time) {
+	n.NextExpiryWarningAt = nil
+	if n.Expiration == nil {
+		return
+	}
+	warnings := make([]time.Time, 0, len(offsets))
+	for _, offset := range offsets {
+		if warning := n.Expiration.Add(-offset); offset > 0 && warning.After(after) {
+			warnings = append(warnings, warning)
+		}
+	}
+	if len(warnings) == 0 {
+		return
+	}
+	sort.Slice(warnings, func(i, j int) bool {
+		return warnings[i].Before(warnings[j])
+	})
+	next := warnings[0].UTC()
+	n.NextExpiryWarningAt = &next
+}
@@ -0,0 +1,31 @@
+package dbapi
+
+import "time"
+
+type ConnectorNamespaceEventType string
+
+const (
+	// ConnectorNamespaceEventExpiryWarning is emitted at the configured offsets before a namespace expires
+	ConnectorNamespaceEventExpiryWarning ConnectorNamespaceEventType = "expiry_warning"
+	// ConnectorNamespaceEventExtended is emitted when the expiration of a namespace is extended
+	ConnectorNamespaceEventExtended ConnectorNamespaceEventType = "extended"
+	// ConnectorNamespaceEventConverted is emitted when the connectors of an eval namespace are moved to a regular namespace
+	ConnectorNamespaceEventConverted ConnectorNamespaceEventType = "converted"
+)
+
+// ConnectorNamespaceEvent records a lifecycle event of a namespace, events are deleted along with their namespace
+type ConnectorNamespaceEvent struct {
+	ID          int64  `gorm:"primaryKey:autoIncrement"`
+	NamespaceID string `gorm:"index"`
+	Type        ConnectorNamespaceEventType
+	Timestamp   time.Time
+	// Expiration is the expiration of the namespace when the event was emitted
+	Expiration *time.Time
+	Message    string
+	// WebhookPending is set while the event still has to be posted to the namespace events webhook
+	WebhookPending bool `gorm:"index"`
+	// WebhookAttempts is the number of times the event was posted to the webhook
+	WebhookAttempts int
+}
+
+type ConnectorNamespaceEventList []ConnectorNamespaceEvent
@@ -0,0 +1,67 @@
+package dbapi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+)
+
+func TestConnectorNamespace_UpdateNextExpiryWarning(t *testing.T) {
+	now := time.Date(2023, 3, 22, 12, 0, 0, 0, time.UTC)
+	expiration := now.Add(48 * time.Hour)
+	offsets := []time.Duration{time.Hour, 24 * time.Hour}
+	tests := []struct {
+		name       string
+		expiration *time.Time
+		offsets    []time.Duration
+		after      time.Time
+		want       *time.Time
+	}{
+		{
+			name:    "no expiration",
+			offsets: offsets,
+			after:   now,
+		},
+		{
+			name:       "no offsets",
+			expiration: &expiration,
+			after:      now,
+		},
+		{
+			name:       "earliest warning",
+			expiration: &expiration,
+			offsets:    offsets,
+			after:      now,
+			want:       timePtr(expiration.Add(-24 * time.Hour)),
+		},
+		{
+			name:       "warning after the last emitted one",
+			expiration: &expiration,
+			offsets:    offsets,
+			after:      expiration.Add(-24 * time.Hour),
+			want:       timePtr(expiration.Add(-time.Hour)),
+		},
+		{
+			name:       "missed warnings are skipped",
+			expiration: &expiration,
+			offsets:    offsets,
+			after:      expiration.Add(-30 * time.Minute),
+		},
+		{
+			name:       "offsets beyond the creation are skipped",
+			expiration: &expiration,
+			offsets:    []time.Duration{72 * time.Hour, 0},
+			after:      now,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			namespace := ConnectorNamespace{Expiration: tt.expiration}
+			namespace.UpdateNextExpiryWarning(tt.offsets, tt.after)
+			g.Expect(namespace.NextExpiryWarningAt).To(gomega.Equal(tt.want))
+		})
+	}
+}
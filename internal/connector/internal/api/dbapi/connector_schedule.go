@@ -0,0 +1,95 @@
+package dbapi
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/cron"
+)
+
+type ConnectorScheduleOperation string
+type ConnectorScheduleResult string
+
+const (
+	ConnectorScheduleStart ConnectorScheduleOperation = "start"
+	ConnectorScheduleStop  ConnectorScheduleOperation = "stop"
+
+	ConnectorScheduleSucceeded ConnectorScheduleResult = "succeeded"
+	// ConnectorScheduleSkipped is the result of operations that didn't change the connector, e.g. stopping a stopped connector
+	ConnectorScheduleSkipped ConnectorScheduleResult = "skipped"
+	ConnectorScheduleFailed  ConnectorScheduleResult = "failed"
+)
+
+// ConnectorSchedule starts and stops a connector at the times matching cron expressions, evaluated in a time zone.
+// Times skipped when clocks spring forward in the time zone never match, the transition is not executed that day
+// and, since it is never due, no execution is recorded for it
+type ConnectorSchedule struct {
+	ConnectorID string `gorm:"primaryKey"`
+	// Start and Stop are cron expressions, either may be empty
+	Start    string
+	Stop     string
+	Timezone string
+	// NextStartAt and NextStopAt are the next scheduled transitions, updated when the schedule is changed or executed
+	NextStartAt *time.Time `gorm:"index"`
+	NextStopAt  *time.Time `gorm:"index"`
+}
+
+// UpdateTransitions sets the next start and stop transitions after the given time
+func (s *ConnectorSchedule) UpdateTransitions(after time.Time) error {
+	location, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return fmt.Errorf("invalid timezone %q: %w", s.Timezone, err)
+	}
+	if s.NextStartAt, err = nextTransition(s.Start, after.In(location)); err != nil {
+		return err
+	}
+	if s.NextStopAt, err = nextTransition(s.Stop, after.In(location)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func nextTransition(expression string, after time.Time) (*time.Time, error) {
+	if expression == "" {
+		return nil, nil
+	}
+	schedule, err := cron.Parse(expression)
+	if err != nil {
+		return nil, err
+	}
+	next := schedule.Next(after)
+	if next.IsZero() {
+		return nil, nil
+	}
+	next = next.UTC()
+	return &next, nil
+}
+
+// DueOperation returns the most recent operation scheduled at or before the given time, if any.
+// An earlier due operation is superseded by a later one, e.g. a stop followed by a start both missed by the worker.
+func (s *ConnectorSchedule) DueOperation(now time.Time) (ConnectorScheduleOperation, time.Time, bool) {
+	startDue := s.NextStartAt != nil && !s.NextStartAt.After(now)
+	stopDue := s.NextStopAt != nil && !s.NextStopAt.After(now)
+	switch {
+	case startDue && (!stopDue || s.NextStartAt.After(*s.NextStopAt)):
+		return ConnectorScheduleStart, *s.NextStartAt, true
+	case stopDue:
+		return ConnectorScheduleStop, *s.NextStopAt, true
+	default:
+		return "", time.Time{}, false
+	}
+}
+
+// ConnectorScheduleExecution records an operation performed by the schedule of a connector,
+// only the most recent executions of each connector are kept
+type ConnectorScheduleExecution struct {
+	ID          int64  `gorm:"primaryKey:autoIncrement"`
+	ConnectorID string `gorm:"index"`
+	Operation   ConnectorScheduleOperation
+	ScheduledAt time.Time
+	ExecutedAt  time.Time
+	Result      ConnectorScheduleResult
+	Message     string
+}
+
+type ConnectorScheduleExecutionList []ConnectorScheduleExecution
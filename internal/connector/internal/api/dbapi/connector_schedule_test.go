@@ -0,0 +1,112 @@
+package dbapi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+)
+
+func TestConnectorSchedule_UpdateTransitions(t *testing.T) {
+	// 10:00 in Rome
+	after := time.Date(2023, 3, 15, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		schedule  ConnectorSchedule
+		wantErr   bool
+		wantStart *time.Time
+		wantStop  *time.Time
+	}{
+		{
+			name:      "start and stop",
+			schedule:  ConnectorSchedule{Start: "0 22 * * *", Stop: "0 6 * * *", Timezone: "UTC"},
+			wantStart: timePtr(time.Date(2023, 3, 15, 22, 0, 0, 0, time.UTC)),
+			wantStop:  timePtr(time.Date(2023, 3, 16, 6, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:      "start only in a time zone",
+			schedule:  ConnectorSchedule{Start: "0 22 * * *", Timezone: "Europe/Rome"},
+			wantStart: timePtr(time.Date(2023, 3, 15, 21, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:     "invalid time zone",
+			schedule: ConnectorSchedule{Start: "0 22 * * *", Timezone: "Mars/Olympus_Mons"},
+			wantErr:  true,
+		},
+		{
+			name:     "invalid expression",
+			schedule: ConnectorSchedule{Stop: "0 25 * * *", Timezone: "UTC"},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			err := tt.schedule.UpdateTransitions(after)
+			if tt.wantErr {
+				g.Expect(err).To(gomega.HaveOccurred())
+				return
+			}
+			if _, zerr := time.LoadLocation(tt.schedule.Timezone); zerr != nil {
+				t.Skipf("time zone data not available: %v", zerr)
+			}
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			g.Expect(tt.schedule.NextStartAt).To(gomega.Equal(tt.wantStart))
+			g.Expect(tt.schedule.NextStopAt).To(gomega.Equal(tt.wantStop))
+		})
+	}
+}
+
+func TestConnectorSchedule_DueOperation(t *testing.T) {
+	now := time.Date(2023, 3, 15, 12, 0, 0, 0, time.UTC)
+	earlier := now.Add(-time.Hour)
+	later := now.Add(time.Hour)
+	tests := []struct {
+		name          string
+		schedule      ConnectorSchedule
+		wantDue       bool
+		wantOperation ConnectorScheduleOperation
+		wantAt        time.Time
+	}{
+		{
+			name:     "nothing due",
+			schedule: ConnectorSchedule{NextStartAt: &later, NextStopAt: &later},
+		},
+		{
+			name:          "start due",
+			schedule:      ConnectorSchedule{NextStartAt: &now, NextStopAt: &later},
+			wantDue:       true,
+			wantOperation: ConnectorScheduleStart,
+			wantAt:        now,
+		},
+		{
+			name:          "stop due without start",
+			schedule:      ConnectorSchedule{NextStopAt: &earlier},
+			wantDue:       true,
+			wantOperation: ConnectorScheduleStop,
+			wantAt:        earlier,
+		},
+		{
+			name:          "most recent of both due",
+			schedule:      ConnectorSchedule{NextStartAt: &now, NextStopAt: &earlier},
+			wantDue:       true,
+			wantOperation: ConnectorScheduleStart,
+			wantAt:        now,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			operation, at, due := tt.schedule.DueOperation(now)
+			g.Expect(due).To(gomega.Equal(tt.wantDue))
+			g.Expect(operation).To(gomega.Equal(tt.wantOperation))
+			g.Expect(at).To(gomega.Equal(tt.wantAt))
+		})
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
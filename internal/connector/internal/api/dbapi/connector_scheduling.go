@@ -0,0 +1,69 @@
+package dbapi
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// ConnectorNamespaceAffinityAnnotation restricts the namespaces a connector can be scheduled to,
+	// its value is a comma separated list of 'key=value' annotations the namespace must have
+	ConnectorNamespaceAffinityAnnotation = "cos.bf2.org/namespace-affinity"
+)
+
+// ConnectorSchedulingDecision records why a connector deployment was placed in its namespace
+type ConnectorSchedulingDecision struct {
+	NamespaceID string                         `json:"namespace_id"`
+	ClusterID   string                         `json:"cluster_id"`
+	Reason      string                         `json:"reason"`
+	ScheduledAt time.Time                      `json:"scheduled_at"`
+	Candidates  []ConnectorSchedulingCandidate `json:"candidates,omitempty"`
+}
+
+// ConnectorSchedulingCandidate is a namespace considered by the scheduler, either rejected or ranked
+type ConnectorSchedulingCandidate struct {
+	NamespaceID string `json:"namespace_id"`
+	ClusterID   string `json:"cluster_id"`
+	// Rejection is the reason the namespace can't host the connector, empty for feasible namespaces
+	Rejection string `json:"rejection,omitempty"`
+	// RemainingConnectors is the remaining connectors quota of a feasible namespace, -1 if it's not limited
+	RemainingConnectors int32 `json:"remaining_connectors"`
+	Deployments         int64 `json:"deployments"`
+}
+
+// Rejections describes why the candidates of the decision were rejected
+func (d *ConnectorSchedulingDecision) Rejections() string {
+	if len(d.Candidates) == 0 {
+		return "no namespace is available to the connector owner or organisation"
+	}
+	rejections := make([]string, 0, len(d.Candidates))
+	for _, c := range d.Candidates {
+		if c.Rejection != "" {
+			rejections = append(rejections, fmt.Sprintf("namespace %s: %s", c.NamespaceID, c.Rejection))
+		}
+	}
+	return strings.Join(rejections, "; ")
+}
+
+func (d *ConnectorSchedulingDecision) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case string:
+		return json.Unmarshal([]byte(v), d)
+	case []byte:
+		return json.Unmarshal(v, d)
+	default:
+		return fmt.Errorf("failed to unmarshal json value: %v", value)
+	}
+}
+
+func (d ConnectorSchedulingDecision) Value() (driver.Value, error) {
+	if d.NamespaceID == "" {
+		return nil, nil
+	}
+	return json.Marshal(d)
+}
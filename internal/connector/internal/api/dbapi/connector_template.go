@@ -0,0 +1,109 @@
+package dbapi
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+)
+
+// ConnectorTemplate is a parameterised connector definition, connectors are created from it by substituting
+// its variables in the connector name and spec. Templates never hold the service account secret.
+type ConnectorTemplate struct {
+	db.Model
+
+	Name           string
+	Description    string
+	Owner          string
+	OrganisationId string
+
+	ConnectorName          string
+	ConnectorTypeId        string
+	Channel                string
+	ConnectorSpec          api.JSON                         `gorm:"type:jsonb"`
+	Kafka                  KafkaConnectionSettings          `gorm:"embedded;embeddedPrefix:kafka_"`
+	SchemaRegistry         SchemaRegistryConnectionSettings `gorm:"embedded;embeddedPrefix:schema_registry_"`
+	ServiceAccountClientId string
+	Variables              ConnectorTemplateVariables `gorm:"type:jsonb"`
+}
+
+type ConnectorTemplateList []*ConnectorTemplate
+
+// ConnectorTemplateVariableType is the type of the values of a variable, variables without a type are strings
+type ConnectorTemplateVariableType string
+
+const (
+	ConnectorTemplateVariableString  ConnectorTemplateVariableType = "string"
+	ConnectorTemplateVariableInteger ConnectorTemplateVariableType = "integer"
+	ConnectorTemplateVariableNumber  ConnectorTemplateVariableType = "number"
+	ConnectorTemplateVariableBoolean ConnectorTemplateVariableType = "boolean"
+)
+
+var ValidConnectorTemplateVariableTypes = []string{
+	string(ConnectorTemplateVariableString),
+	string(ConnectorTemplateVariableInteger),
+	string(ConnectorTemplateVariableNumber),
+	string(ConnectorTemplateVariableBoolean),
+}
+
+// ConnectorTemplateVariable is a variable referenced as ${name} in a template
+type ConnectorTemplateVariable struct {
+	Name        string                        `json:"name"`
+	Description string                        `json:"description,omitempty"`
+	Type        ConnectorTemplateVariableType `json:"type,omitempty"`
+	Default     string                        `json:"default,omitempty"`
+	Required    bool                          `json:"required,omitempty"`
+}
+
+// IsString returns true if the values of the variable are strings
+func (v ConnectorTemplateVariable) IsString() bool {
+	return v.Type == "" || v.Type == ConnectorTemplateVariableString
+}
+
+// Convert returns the given value of the variable converted to the type of the variable,
+// i.e. a string, an int64, a float64 or a bool
+func (v ConnectorTemplateVariable) Convert(value string) (interface{}, error) {
+	var converted interface{}
+	var err error
+	switch v.Type {
+	case "", ConnectorTemplateVariableString:
+		converted = value
+	case ConnectorTemplateVariableInteger:
+		converted, err = strconv.ParseInt(value, 10, 64)
+	case ConnectorTemplateVariableNumber:
+		converted, err = strconv.ParseFloat(value, 64)
+	case ConnectorTemplateVariableBoolean:
+		converted, err = strconv.ParseBool(value)
+	default:
+		return nil, fmt.Errorf("unknown type %q of variable %s", v.Type, v.Name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("value %q of variable %s is not a valid %s", value, v.Name, v.Type)
+	}
+	return converted, nil
+}
+
+type ConnectorTemplateVariables []ConnectorTemplateVariable
+
+func (v *ConnectorTemplateVariables) Scan(value interface{}) error {
+	switch data := value.(type) {
+	case nil:
+		return nil
+	case string:
+		return json.Unmarshal([]byte(data), v)
+	case []byte:
+		return json.Unmarshal(data, v)
+	default:
+		return fmt.Errorf("failed to unmarshal json value: %v", value)
+	}
+}
+
+func (v ConnectorTemplateVariables) Value() (driver.Value, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return json.Marshal(v)
+}
@@ -0,0 +1,27 @@
+/*
+ * Connector Service Fleet Manager Private APIs
+ *
+ * Connector Service Fleet Manager apis that are used by internal services.
+ *
+ * API version: 0.0.3
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// ConnectorDeploymentLogEntry A log line of a connector container, or an event involving the resources of a connector
+type ConnectorDeploymentLogEntry struct {
+	// the kind of the entry, either log or event
+	Kind      string    `json:"kind"`
+	Timestamp time.Time `json:"timestamp"`
+	// the container of a log line, or the object involved in an event
+	Source string `json:"source,omitempty"`
+	// the level of a log line, or the type of an event
+	Level   string `json:"level,omitempty"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message"`
+}
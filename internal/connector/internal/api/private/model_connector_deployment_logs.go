@@ -0,0 +1,15 @@
+/*
+ * Connector Service Fleet Manager Private APIs
+ *
+ * Connector Service Fleet Manager apis that are used by internal services.
+ *
+ * API version: 0.0.3
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ConnectorDeploymentLogs Recent log lines and events of a connector deployment relayed by the agent
+type ConnectorDeploymentLogs struct {
+	Items []ConnectorDeploymentLogEntry `json:"items"`
+}
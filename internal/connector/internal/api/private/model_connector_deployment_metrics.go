@@ -0,0 +1,29 @@
+/*
+ * Connector Service Fleet Manager Private APIs
+ *
+ * Connector Service Fleet Manager apis that are used by internal services.
+ *
+ * API version: 0.0.3
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// ConnectorDeploymentMetrics The counters of a connector deployment
+type ConnectorDeploymentMetrics struct {
+	// the time the counters were collected, defaults to the time the status is received
+	Timestamp time.Time `json:"timestamp,omitempty"`
+	// the total number of records consumed by the connector
+	RecordsIn int64 `json:"records_in,omitempty"`
+	// the total number of records produced by the connector
+	RecordsOut int64 `json:"records_out,omitempty"`
+	// the total number of records that failed to be processed
+	RecordErrors int64 `json:"record_errors,omitempty"`
+	// the number of records the connector is behind its source
+	Lag   int64                          `json:"lag,omitempty"`
+	Tasks []ConnectorDeploymentTaskState `json:"tasks,omitempty"`
+}
@@ -15,4 +15,5 @@ type ConnectorDeploymentStatus struct {
 	ResourceVersion int64                              `json:"resource_version,omitempty"`
 	Operators       ConnectorDeploymentStatusOperators `json:"operators,omitempty"`
 	Conditions      []MetaV1Condition                  `json:"conditions,omitempty"`
+	Metrics         *ConnectorDeploymentMetrics        `json:"metrics,omitempty"`
 }
@@ -0,0 +1,19 @@
+/*
+ * Connector Service Fleet Manager Private APIs
+ *
+ * Connector Service Fleet Manager apis that are used by internal services.
+ *
+ * API version: 0.0.3
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ConnectorDeploymentTaskState The state of a task of a connector deployment
+type ConnectorDeploymentTaskState struct {
+	Id string `json:"id"`
+	// the state of the task, e.g. running or failed
+	State string `json:"state"`
+	// the error of a failed task
+	Trace string `json:"trace,omitempty"`
+}
@@ -507,6 +507,8 @@ type GetConnectorClusterNamespacesOpts struct {
 	Size    optional.String
 	OrderBy optional.String
 	Search  optional.String
+	Cursor  optional.String
+	Fields  optional.String
 }
 
 /*
@@ -519,6 +521,8 @@ Get a connector cluster&#39;s namespaces
   - @param "Size" (optional.String) -  Number of items in each page
   - @param "OrderBy" (optional.String) -  Specifies the order by criteria. The syntax of this parameter is similar to the syntax of the `order by` clause of an SQL statement. Each query can be ordered by any of the underlying resource fields supported in the search parameter. For example, to return all Connector types ordered by their name, use the following syntax:  ```sql name asc ```  To return all Connector types ordered by their name _and_ version, use the following syntax:  ```sql name asc, version asc ```  To return connector types with labels `category-featured` AND `source`, use the following syntax:  ``` label like %25category-featured%25source% ```  NOTE: The AND operator does not work for multiple labels. Instead use an alphabetically ascending order pattern with the LIKE operator to match an aggregated list of ',' separated label names.  If the parameter isn't provided, or if the value is empty, then the results are ordered by name.
   - @param "Search" (optional.String) -  Search criteria.  The syntax of this parameter is similar to the syntax of the `where` clause of a SQL statement.  Allowed fields in the search depend on the resource type:  * Cluster: id, created_at, updated_at, owner, organisation_id, name, state, client_id * Namespace: id, created_at, updated_at, name, cluster_id, owner, expiration, tenant_user_id, tenant_organisation_id, state * Connector Types: id, created_at, updated_at, version, name, description, label, channel, featured_rank, pricing_tier * Connectors: id, created_at, updated_at, name, owner, organisation_id, connector_type_id, desired_state, state, channel, namespace_id, kafka_id, kafka_bootstrap_server, service_account_client_id, schema_registry_id, schema_registry_url  Allowed operators are `<>`, `=`, `IN`, `NOT IN`, `LIKE`, or `ILIKE`. Allowed conjunctive operators are `AND` and `OR`. However, you can use a maximum of 10 conjunctions in a search query.  Examples:  To return a Connector Type with the name `aws-sqs-source` and the channel `stable`, use the following syntax:  ``` name = aws-sqs-source and channel = stable ```  To return a connector instance with a name that starts with `aws`, use the following syntax:  ``` name like aws%25 ```  To return a connector type with a name containing `aws` matching any character case combination, use the following syntax:  ``` name ilike %25aws%25 ```  To return connector types with labels `category-featured` AND `source`, use the following syntax:  ``` label like %25category-featured%25source% ```  NOTE: The AND operator does not work for multiple labels. Instead use an alphabetically ascending order pattern with the LIKE operator to match an aggregated list of ',' separated label names.  If the parameter isn't provided, or if the value is empty, then all the resources that the user has permission to see are returned.  Note. If the query is invalid, an error is returned.
+  - @param "Cursor" (optional.String) -  Opaque cursor returned as the `next_cursor` of a previous page. When the parameter is provided the items are ordered by their id and only the items following the cursor are returned, in which case the `page` and `orderBy` parameters are not used. Provide the parameter with an empty value to get the first page. Cursor based paging is not affected by items created while paging.
+  - @param "Fields" (optional.String) -  Comma separated list of the item properties to return. The `id`, `kind` and `href` of the items are always returned. All the properties are returned when the parameter isn't provided.
 
 @return ConnectorNamespaceList
 */
@@ -552,6 +556,12 @@ func (a *ConnectorClustersApiService) GetConnectorClusterNamespaces(ctx _context
 	if localVarOptionals != nil && localVarOptionals.Search.IsSet() {
 		localVarQueryParams.Add("search", parameterToString(localVarOptionals.Search.Value(), ""))
 	}
+	if localVarOptionals != nil && localVarOptionals.Cursor.IsSet() {
+		localVarQueryParams.Add("cursor", parameterToString(localVarOptionals.Cursor.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.Fields.IsSet() {
+		localVarQueryParams.Add("fields", parameterToString(localVarOptionals.Fields.Value(), ""))
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -640,6 +650,8 @@ type ListConnectorClustersOpts struct {
 	Size    optional.String
 	OrderBy optional.String
 	Search  optional.String
+	Cursor  optional.String
+	Fields  optional.String
 }
 
 /*
@@ -651,6 +663,8 @@ Returns a list of connector clusters
   - @param "Size" (optional.String) -  Number of items in each page
   - @param "OrderBy" (optional.String) -  Specifies the order by criteria. The syntax of this parameter is similar to the syntax of the `order by` clause of an SQL statement. Each query can be ordered by any of the underlying resource fields supported in the search parameter. For example, to return all Connector types ordered by their name, use the following syntax:  ```sql name asc ```  To return all Connector types ordered by their name _and_ version, use the following syntax:  ```sql name asc, version asc ```  To return connector types with labels `category-featured` AND `source`, use the following syntax:  ``` label like %25category-featured%25source% ```  NOTE: The AND operator does not work for multiple labels. Instead use an alphabetically ascending order pattern with the LIKE operator to match an aggregated list of ',' separated label names.  If the parameter isn't provided, or if the value is empty, then the results are ordered by name.
   - @param "Search" (optional.String) -  Search criteria.  The syntax of this parameter is similar to the syntax of the `where` clause of a SQL statement.  Allowed fields in the search depend on the resource type:  * Cluster: id, created_at, updated_at, owner, organisation_id, name, state, client_id * Namespace: id, created_at, updated_at, name, cluster_id, owner, expiration, tenant_user_id, tenant_organisation_id, state * Connector Types: id, created_at, updated_at, version, name, description, label, channel, featured_rank, pricing_tier * Connectors: id, created_at, updated_at, name, owner, organisation_id, connector_type_id, desired_state, state, channel, namespace_id, kafka_id, kafka_bootstrap_server, service_account_client_id, schema_registry_id, schema_registry_url  Allowed operators are `<>`, `=`, `IN`, `NOT IN`, `LIKE`, or `ILIKE`. Allowed conjunctive operators are `AND` and `OR`. However, you can use a maximum of 10 conjunctions in a search query.  Examples:  To return a Connector Type with the name `aws-sqs-source` and the channel `stable`, use the following syntax:  ``` name = aws-sqs-source and channel = stable ```  To return a connector instance with a name that starts with `aws`, use the following syntax:  ``` name like aws%25 ```  To return a connector type with a name containing `aws` matching any character case combination, use the following syntax:  ``` name ilike %25aws%25 ```  To return connector types with labels `category-featured` AND `source`, use the following syntax:  ``` label like %25category-featured%25source% ```  NOTE: The AND operator does not work for multiple labels. Instead use an alphabetically ascending order pattern with the LIKE operator to match an aggregated list of ',' separated label names.  If the parameter isn't provided, or if the value is empty, then all the resources that the user has permission to see are returned.  Note. If the query is invalid, an error is returned.
+  - @param "Cursor" (optional.String) -  Opaque cursor returned as the `next_cursor` of a previous page. When the parameter is provided the items are ordered by their id and only the items following the cursor are returned, in which case the `page` and `orderBy` parameters are not used. Provide the parameter with an empty value to get the first page. Cursor based paging is not affected by items created while paging.
+  - @param "Fields" (optional.String) -  Comma separated list of the item properties to return. The `id`, `kind` and `href` of the items are always returned. All the properties are returned when the parameter isn't provided.
 
 @return ConnectorClusterList
 */
@@ -682,6 +696,12 @@ func (a *ConnectorClustersApiService) ListConnectorClusters(ctx _context.Context
 	if localVarOptionals != nil && localVarOptionals.Search.IsSet() {
 		localVarQueryParams.Add("search", parameterToString(localVarOptionals.Search.Value(), ""))
 	}
+	if localVarOptionals != nil && localVarOptionals.Cursor.IsSet() {
+		localVarQueryParams.Add("cursor", parameterToString(localVarOptionals.Cursor.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.Fields.IsSet() {
+		localVarQueryParams.Add("fields", parameterToString(localVarOptionals.Fields.Value(), ""))
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -34,5 +34,6 @@ type Connector struct {
 	ServiceAccount  ServiceAccount                   `json:"service_account"`
 	SchemaRegistry  SchemaRegistryConnectionSettings `json:"schema_registry,omitempty"`
 	Connector       map[string]interface{}           `json:"connector"`
+	Schedule        *ConnectorSchedule               `json:"schedule,omitempty"`
 	Status          ConnectorStatusStatus            `json:"status,omitempty"`
 }
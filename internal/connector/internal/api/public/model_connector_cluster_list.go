@@ -12,9 +12,10 @@ package public
 
 // ConnectorClusterList struct for ConnectorClusterList
 type ConnectorClusterList struct {
-	Kind  string             `json:"kind"`
-	Page  int32              `json:"page"`
-	Size  int32              `json:"size"`
-	Total int32              `json:"total"`
-	Items []ConnectorCluster `json:"items"`
+	Kind       string             `json:"kind"`
+	Page       int32              `json:"page"`
+	Size       int32              `json:"size"`
+	Total      int32              `json:"total"`
+	Items      []ConnectorCluster `json:"items"`
+	NextCursor string             `json:"next_cursor,omitempty"`
 }
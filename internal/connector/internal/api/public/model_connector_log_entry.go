@@ -0,0 +1,28 @@
+/*
+ * Connector Management API
+ *
+ * Connector Management API is a REST API to manage connectors.
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+import (
+	"time"
+)
+
+// ConnectorLogEntry A log line or event of a connector deployment
+type ConnectorLogEntry struct {
+	// The kind of the entry, either log or event
+	Kind      string    `json:"kind"`
+	Timestamp time.Time `json:"timestamp"`
+	// The container of a log line, or the object involved in an event
+	Source string `json:"source,omitempty"`
+	// The level of a log line, or the type of an event
+	Level   string `json:"level,omitempty"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message"`
+}
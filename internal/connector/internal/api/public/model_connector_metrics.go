@@ -0,0 +1,33 @@
+/*
+ * Connector Management API
+ *
+ * Connector Management API is a REST API to manage connectors.
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+import (
+	"time"
+)
+
+// ConnectorMetrics The metrics of a connector reported by its cluster
+type ConnectorMetrics struct {
+	Kind        string `json:"kind"`
+	ConnectorId string `json:"connector_id"`
+	// The time the metrics were reported, not set if the connector hasn't reported metrics yet
+	ReportedAt *time.Time                 `json:"reported_at,omitempty"`
+	Throughput ConnectorMetricsThroughput `json:"throughput"`
+	// The total number of records consumed by the connector
+	RecordsIn int64 `json:"records_in"`
+	// The total number of records produced by the connector
+	RecordsOut int64 `json:"records_out"`
+	// The total number of records that failed to be processed
+	RecordErrors int64 `json:"record_errors"`
+	// The number of records the connector is behind its source
+	Lag   int64                `json:"lag"`
+	Tasks []ConnectorTaskState `json:"tasks"`
+}
@@ -0,0 +1,17 @@
+/*
+ * Connector Management API
+ *
+ * Connector Management API is a REST API to manage connectors.
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// ConnectorMoveRequest A request to move a connector to another namespace
+type ConnectorMoveRequest struct {
+	// The id of the namespace to move the connector to
+	NamespaceId string `json:"namespace_id"`
+}
@@ -27,9 +27,13 @@ type ConnectorNamespace struct {
 	Annotations     map[string]string       `json:"annotations,omitempty"`
 	ResourceVersion int64                   `json:"resource_version"`
 	Quota           ConnectorNamespaceQuota `json:"quota,omitempty"`
-	ClusterId       string                  `json:"cluster_id"`
+	// Quota left once the connectors of the namespace are accounted for, only returned when getting a single namespace
+	RemainingQuota *ConnectorNamespaceQuota `json:"remaining_quota,omitempty"`
+	ClusterId      string                   `json:"cluster_id"`
 	// Namespace expiration timestamp in RFC 3339 format
-	Expiration string                   `json:"expiration,omitempty"`
+	Expiration string `json:"expiration,omitempty"`
+	// Timestamp in RFC 3339 format of the one-time extension of the namespace expiration
+	ExtendedAt string                   `json:"extended_at,omitempty"`
 	Tenant     ConnectorNamespaceTenant `json:"tenant"`
 	Status     ConnectorNamespaceStatus `json:"status"`
 }
@@ -0,0 +1,17 @@
+/*
+ * Connector Management API
+ *
+ * Connector Management API is a REST API to manage connectors.
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// ConnectorNamespaceConvertRequest A request to move the connectors of an evaluation namespace to a regular namespace
+type ConnectorNamespaceConvertRequest struct {
+	// The id of the regular namespace to move the connectors to
+	NamespaceId string `json:"namespace_id"`
+}
@@ -0,0 +1,22 @@
+/*
+ * Connector Management API
+ *
+ * Connector Management API is a REST API to manage connectors.
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// ConnectorNamespaceConvertResult The connectors of an evaluation namespace being moved to a regular namespace
+type ConnectorNamespaceConvertResult struct {
+	Kind string `json:"kind"`
+	// The id of the evaluation namespace
+	Id string `json:"id"`
+	// The id of the regular namespace the connectors are moved to
+	NamespaceId string `json:"namespace_id"`
+	// The ids of the connectors being moved
+	ConnectorIds []string `json:"connector_ids"`
+}
@@ -0,0 +1,25 @@
+/*
+ * Connector Management API
+ *
+ * Connector Management API is a REST API to manage connectors.
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+import (
+	"time"
+)
+
+// ConnectorNamespaceEvent A lifecycle event of a connector namespace
+type ConnectorNamespaceEvent struct {
+	// One of expiry_warning, extended or converted
+	Type      string    `json:"type"`
+	Timestamp time.Time `json:"timestamp"`
+	// Namespace expiration timestamp in RFC 3339 format when the event was emitted
+	Expiration string `json:"expiration,omitempty"`
+	Message    string `json:"message"`
+}
@@ -0,0 +1,17 @@
+/*
+ * Connector Management API
+ *
+ * Connector Management API is a REST API to manage connectors.
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// ConnectorNamespaceExtendRequest A request to extend the expiration of an evaluation namespace
+type ConnectorNamespaceExtendRequest struct {
+	// Duration to extend the namespace expiration by in golang duration format, defaults to the maximum extension
+	Duration string `json:"duration,omitempty"`
+}
@@ -12,9 +12,10 @@ package public
 
 // ConnectorNamespaceList struct for ConnectorNamespaceList
 type ConnectorNamespaceList struct {
-	Kind  string               `json:"kind"`
-	Page  int32                `json:"page"`
-	Size  int32                `json:"size"`
-	Total int32                `json:"total"`
-	Items []ConnectorNamespace `json:"items"`
+	Kind       string               `json:"kind"`
+	Page       int32                `json:"page"`
+	Size       int32                `json:"size"`
+	Total      int32                `json:"total"`
+	Items      []ConnectorNamespace `json:"items"`
+	NextCursor string               `json:"next_cursor,omitempty"`
 }
@@ -25,4 +25,6 @@ type ConnectorNamespaceMeta struct {
 	Annotations     map[string]string       `json:"annotations,omitempty"`
 	ResourceVersion int64                   `json:"resource_version,omitempty"`
 	Quota           ConnectorNamespaceQuota `json:"quota,omitempty"`
+	// Quota left once the connectors of the namespace are accounted for, only returned when getting a single namespace
+	RemainingQuota *ConnectorNamespaceQuota `json:"remaining_quota,omitempty"`
 }
@@ -21,4 +21,8 @@ type ConnectorNamespaceQuota struct {
 	CpuRequests string `json:"cpu_requests,omitempty"`
 	// CPU quota for limits or requests
 	CpuLimits string `json:"cpu_limits,omitempty"`
+	// Maximum number of connectors per connector type id
+	ConnectorTypes map[string]int32 `json:"connector_types,omitempty"`
+	// Maximum number of connectors per connector type label
+	ConnectorLabels map[string]int32 `json:"connector_labels,omitempty"`
 }
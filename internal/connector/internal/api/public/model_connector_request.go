@@ -23,4 +23,5 @@ type ConnectorRequest struct {
 	ServiceAccount ServiceAccount                   `json:"service_account"`
 	SchemaRegistry SchemaRegistryConnectionSettings `json:"schema_registry,omitempty"`
 	Connector      map[string]interface{}           `json:"connector"`
+	Schedule       *ConnectorSchedule               `json:"schedule,omitempty"`
 }
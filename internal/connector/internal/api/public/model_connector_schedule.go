@@ -0,0 +1,27 @@
+/*
+ * Connector Management API
+ *
+ * Connector Management API is a REST API to manage connectors.
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+import (
+	"time"
+)
+
+// ConnectorSchedule Starts and stops a connector at the times matching cron expressions
+type ConnectorSchedule struct {
+	// Cron expression of the times the connector is started
+	Start string `json:"start,omitempty"`
+	// Cron expression of the times the connector is stopped
+	Stop string `json:"stop,omitempty"`
+	// Time zone the cron expressions are evaluated in, defaults to UTC
+	Timezone    string     `json:"timezone,omitempty"`
+	NextStartAt *time.Time `json:"next_start_at,omitempty"`
+	NextStopAt  *time.Time `json:"next_stop_at,omitempty"`
+}
@@ -0,0 +1,24 @@
+/*
+ * Connector Management API
+ *
+ * Connector Management API is a REST API to manage connectors.
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+import (
+	"time"
+)
+
+// ConnectorScheduleExecution struct for ConnectorScheduleExecution
+type ConnectorScheduleExecution struct {
+	Operation   string    `json:"operation"`
+	ScheduledAt time.Time `json:"scheduled_at"`
+	ExecutedAt  time.Time `json:"executed_at"`
+	Result      string    `json:"result"`
+	Message     string    `json:"message,omitempty"`
+}
@@ -0,0 +1,17 @@
+/*
+ * Connector Management API
+ *
+ * Connector Management API is a REST API to manage connectors.
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// ConnectorScheduleExecutionList struct for ConnectorScheduleExecutionList
+type ConnectorScheduleExecutionList struct {
+	Kind  string                       `json:"kind"`
+	Items []ConnectorScheduleExecution `json:"items"`
+}
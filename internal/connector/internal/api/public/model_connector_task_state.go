@@ -0,0 +1,20 @@
+/*
+ * Connector Management API
+ *
+ * Connector Management API is a REST API to manage connectors.
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// ConnectorTaskState The state of a task of a connector
+type ConnectorTaskState struct {
+	Id string `json:"id"`
+	// The state of the task, e.g. running or failed
+	State string `json:"state"`
+	// The error of a failed task
+	Trace string `json:"trace,omitempty"`
+}
@@ -0,0 +1,38 @@
+/*
+ * Connector Management API
+ *
+ * Connector Management API is a REST API to manage connectors.
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+import (
+	"time"
+)
+
+// ConnectorTemplate struct for ConnectorTemplate
+type ConnectorTemplate struct {
+	Id          string    `json:"id,omitempty"`
+	Kind        string    `json:"kind,omitempty"`
+	Href        string    `json:"href,omitempty"`
+	Owner       string    `json:"owner,omitempty"`
+	CreatedAt   time.Time `json:"created_at,omitempty"`
+	ModifiedAt  time.Time `json:"modified_at,omitempty"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	// The name of the connectors created from the template, may reference variables
+	ConnectorName   string  `json:"connector_name,omitempty"`
+	ConnectorTypeId string  `json:"connector_type_id"`
+	Channel         Channel `json:"channel,omitempty"`
+	// The connector spec, string values may reference variables
+	Connector      map[string]interface{}           `json:"connector"`
+	Kafka          KafkaConnectionSettings          `json:"kafka"`
+	SchemaRegistry SchemaRegistryConnectionSettings `json:"schema_registry,omitempty"`
+	// The client id of the service account of the connectors, its secret is set when creating connectors
+	ServiceAccountClientId string                      `json:"service_account_client_id,omitempty"`
+	Variables              []ConnectorTemplateVariable `json:"variables,omitempty"`
+}
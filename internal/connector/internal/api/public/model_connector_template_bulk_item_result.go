@@ -0,0 +1,21 @@
+/*
+ * Connector Management API
+ *
+ * Connector Management API is a REST API to manage connectors.
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// ConnectorTemplateBulkItemResult The result of an item of a bulk creation
+type ConnectorTemplateBulkItemResult struct {
+	// The index of the item in the request
+	Index int32 `json:"index"`
+	// The created connector
+	Connector *Connector `json:"connector,omitempty"`
+	// The validation errors of the item
+	Errors []string `json:"errors,omitempty"`
+}
@@ -0,0 +1,16 @@
+/*
+ * Connector Management API
+ *
+ * Connector Management API is a REST API to manage connectors.
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// ConnectorTemplateBulkRequest A request to create many connectors from a template at once
+type ConnectorTemplateBulkRequest struct {
+	Items []ConnectorTemplateInstanceRequest `json:"items"`
+}
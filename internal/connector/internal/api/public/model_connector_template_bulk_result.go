@@ -0,0 +1,18 @@
+/*
+ * Connector Management API
+ *
+ * Connector Management API is a REST API to manage connectors.
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// ConnectorTemplateBulkResult The result of a bulk creation, connectors are only created if all the items are valid
+type ConnectorTemplateBulkResult struct {
+	Kind    string                            `json:"kind"`
+	Created bool                              `json:"created"`
+	Items   []ConnectorTemplateBulkItemResult `json:"items"`
+}
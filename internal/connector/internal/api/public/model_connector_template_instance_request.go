@@ -0,0 +1,25 @@
+/*
+ * Connector Management API
+ *
+ * Connector Management API is a REST API to manage connectors.
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// ConnectorTemplateInstanceRequest A request to create a connector from a template
+type ConnectorTemplateInstanceRequest struct {
+	// The name of the connector, defaults to the connector name of the template
+	Name         string                `json:"name,omitempty"`
+	NamespaceId  string                `json:"namespace_id"`
+	DesiredState ConnectorDesiredState `json:"desired_state,omitempty"`
+	// Name-value string annotations for resource
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// The service account of the connector, its client id defaults to the one of the template
+	ServiceAccount ServiceAccount `json:"service_account"`
+	// The values of the template variables
+	Variables map[string]string `json:"variables,omitempty"`
+}
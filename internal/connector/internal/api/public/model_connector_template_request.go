@@ -0,0 +1,28 @@
+/*
+ * Connector Management API
+ *
+ * Connector Management API is a REST API to manage connectors.
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// ConnectorTemplateRequest struct for ConnectorTemplateRequest
+type ConnectorTemplateRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// The name of the connectors created from the template, may reference variables
+	ConnectorName   string  `json:"connector_name,omitempty"`
+	ConnectorTypeId string  `json:"connector_type_id"`
+	Channel         Channel `json:"channel,omitempty"`
+	// The connector spec, string values may reference variables. Secret fields can only reference a variable without a default, e.g. ${password}
+	Connector      map[string]interface{}           `json:"connector"`
+	Kafka          KafkaConnectionSettings          `json:"kafka"`
+	SchemaRegistry SchemaRegistryConnectionSettings `json:"schema_registry,omitempty"`
+	// The client id of the service account of the connectors, its secret is set when creating connectors
+	ServiceAccountClientId string                      `json:"service_account_client_id,omitempty"`
+	Variables              []ConnectorTemplateVariable `json:"variables,omitempty"`
+}
@@ -0,0 +1,23 @@
+/*
+ * Connector Management API
+ *
+ * Connector Management API is a REST API to manage connectors.
+ *
+ * API version: 0.1.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// ConnectorTemplateVariable A variable referenced as ${name} in the connector name and spec of a template
+type ConnectorTemplateVariable struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// The type of the values of the variable. A variable referenced as the entire value of a field of the connector spec, e.g. \"${tasks}\", is substituted with a value of its type, otherwise the variable is substituted in the string
+	Type string `json:"type,omitempty"`
+	// The value used when the variable is not set
+	Default string `json:"default,omitempty"`
+	// Connectors can't be created from the template without setting a required variable
+	Required bool `json:"required,omitempty"`
+}
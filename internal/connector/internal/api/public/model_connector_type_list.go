@@ -12,9 +12,10 @@ package public
 
 // ConnectorTypeList struct for ConnectorTypeList
 type ConnectorTypeList struct {
-	Kind  string          `json:"kind"`
-	Page  int32           `json:"page"`
-	Size  int32           `json:"size"`
-	Total int32           `json:"total"`
-	Items []ConnectorType `json:"items"`
+	Kind       string          `json:"kind"`
+	Page       int32           `json:"page"`
+	Size       int32           `json:"size"`
+	Total      int32           `json:"total"`
+	Items      []ConnectorType `json:"items"`
+	NextCursor string          `json:"next_cursor,omitempty"`
 }
@@ -0,0 +1,175 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/blang/semver/v4"
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v2"
+)
+
+// ConnectorAgentConfig holds the desired version of the connector cluster agent,
+// and the operators each agent version is able to manage
+type ConnectorAgentConfig struct {
+	ConnectorAgentConfigFile string               `yaml:"-"`
+	DesiredVersion           string               `yaml:"desired-version"`
+	Compatibility            []AgentCompatibility `yaml:"compatibility"`
+
+	desiredVersion *semver.Version
+}
+
+// AgentCompatibility lists the operator versions supported by a range of agent versions
+type AgentCompatibility struct {
+	AgentVersions string                  `yaml:"agent-versions"`
+	Operators     []OperatorCompatibility `yaml:"operators"`
+
+	agentVersions semver.Range
+}
+
+type OperatorCompatibility struct {
+	Type     string `yaml:"type"`
+	Versions string `yaml:"versions"`
+
+	versions semver.Range
+}
+
+func NewConnectorAgentConfig() *ConnectorAgentConfig {
+	return &ConnectorAgentConfig{
+		ConnectorAgentConfigFile: "config/connector-agent-configuration.yaml",
+	}
+}
+
+func (c *ConnectorAgentConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&c.ConnectorAgentConfigFile, "connector-agent-config-file", c.ConnectorAgentConfigFile, "Connector cluster agent desired version and compatibility matrix configuration file")
+}
+
+func (c *ConnectorAgentConfig) ReadFiles() error {
+	fileContents, err := shared.ReadFile(c.ConnectorAgentConfigFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("configuration file for connector-agent-config-file not found: '%s'", c.ConnectorAgentConfigFile)
+		}
+		return fmt.Errorf("error reading configuration file '%s': %s", c.ConnectorAgentConfigFile, err)
+	}
+	if err := yaml.UnmarshalStrict([]byte(fileContents), c); err != nil {
+		return fmt.Errorf("error reading configuration file '%s': %s", c.ConnectorAgentConfigFile, err)
+	}
+	if err := c.parse(); err != nil {
+		return fmt.Errorf("invalid configuration file '%s': %s", c.ConnectorAgentConfigFile, err)
+	}
+	return nil
+}
+
+// parse validates the versions and version ranges of the configuration
+func (c *ConnectorAgentConfig) parse() (err error) {
+	c.desiredVersion = nil
+	if c.DesiredVersion != "" {
+		desiredVersion, err := semver.ParseTolerant(c.DesiredVersion)
+		if err != nil {
+			return fmt.Errorf("invalid desired-version %q: %s", c.DesiredVersion, err)
+		}
+		c.desiredVersion = &desiredVersion
+	}
+
+	for i := range c.Compatibility {
+		entry := &c.Compatibility[i]
+		if entry.agentVersions, err = ParseVersionRange(entry.AgentVersions); err != nil {
+			return fmt.Errorf("invalid agent-versions %q: %s", entry.AgentVersions, err)
+		}
+		for j := range entry.Operators {
+			operator := &entry.Operators[j]
+			if operator.Type == "" {
+				return fmt.Errorf("missing operator type for agent-versions %q", entry.AgentVersions)
+			}
+			if operator.versions, err = ParseVersionRange(operator.Versions); err != nil {
+				return fmt.Errorf("invalid versions %q of operator %s: %s", operator.Versions, operator.Type, err)
+			}
+		}
+	}
+	return nil
+}
+
+// HasCompatibilityMatrix returns false when no compatibility matrix is configured, and any agent version is accepted
+func (c *ConnectorAgentConfig) HasCompatibilityMatrix() bool {
+	return len(c.Compatibility) > 0
+}
+
+// SupportedOperators returns the operators supported by an agent version, and false if the agent version isn't supported.
+// Every agent version is supported without compatibility matrix.
+func (c *ConnectorAgentConfig) SupportedOperators(agentVersion string) ([]OperatorCompatibility, bool) {
+	if !c.HasCompatibilityMatrix() {
+		return nil, true
+	}
+	version, err := semver.ParseTolerant(agentVersion)
+	if err != nil {
+		return nil, false
+	}
+	for _, entry := range c.Compatibility {
+		if entry.agentVersions != nil && entry.agentVersions(version) {
+			return entry.Operators, true
+		}
+	}
+	return nil, false
+}
+
+// Supports returns true if the operator supports the given version, an invalid version is never supported
+func (o OperatorCompatibility) Supports(operatorVersion string) bool {
+	version, err := semver.ParseTolerant(operatorVersion)
+	return err == nil && o.versions != nil && o.versions(version)
+}
+
+// IsOutdated returns true if an agent version is older than the desired agent version
+func (c *ConnectorAgentConfig) IsOutdated(agentVersion string) bool {
+	if c.desiredVersion == nil {
+		return false
+	}
+	version, err := semver.ParseTolerant(agentVersion)
+	if err != nil {
+		// agents that haven't reported a valid version yet can't be considered up to date
+		return true
+	}
+	return version.LT(*c.desiredVersion)
+}
+
+// ParseVersionRange parses a maven style version range such as '[1.0.0,2.0.0)' or '[1.2.0,)'.
+// A single version is the minimum version required, an empty range accepts any version.
+func ParseVersionRange(versionRange string) (semver.Range, error) {
+	versionRange = strings.TrimSpace(versionRange)
+	if versionRange == "" {
+		return func(semver.Version) bool { return true }, nil
+	}
+	if !strings.ContainsAny(versionRange, "[]()") {
+		return semver.ParseRange(">=" + versionRange)
+	}
+
+	if len(versionRange) < 2 {
+		return nil, fmt.Errorf("malformed version range")
+	}
+	lower, upper, found := strings.Cut(versionRange[1:len(versionRange)-1], ",")
+	if !found {
+		return nil, fmt.Errorf("malformed version range")
+	}
+
+	var terms []string
+	if lower = strings.TrimSpace(lower); lower != "" {
+		operator := ">"
+		if versionRange[0] == '[' {
+			operator = ">="
+		}
+		terms = append(terms, operator+lower)
+	}
+	if upper = strings.TrimSpace(upper); upper != "" {
+		operator := "<"
+		if versionRange[len(versionRange)-1] == ']' {
+			operator = "<="
+		}
+		terms = append(terms, operator+upper)
+	}
+	if len(terms) == 0 {
+		return func(semver.Version) bool { return true }, nil
+	}
+	return semver.ParseRange(strings.Join(terms, " "))
+}
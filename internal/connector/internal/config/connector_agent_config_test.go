@@ -0,0 +1,173 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/blang/semver/v4"
+	"github.com/onsi/gomega"
+)
+
+const agentConfigFileOk = `
+desired-version: 0.2.0
+compatibility:
+  - agent-versions: "[0.1.0,0.2.0)"
+    operators:
+      - type: camel-connector-operator
+        versions: "[0.1.0,1.0.0)"
+  - agent-versions: "0.2.0"
+    operators:
+      - type: camel-connector-operator
+        versions: "[0.1.0,2.0.0)"
+      - type: debezium-connector-operator
+        versions: "[1.0.0,)"
+`
+
+const agentConfigFileInvalidRange = `
+compatibility:
+  - agent-versions: "[0.1.0"
+`
+
+const agentConfigFileMissingOperatorType = `
+compatibility:
+  - agent-versions: "[0.1.0,)"
+    operators:
+      - versions: "[0.1.0,)"
+`
+
+const agentConfigFileInvalidDesiredVersion = `
+desired-version: latest
+`
+
+func TestConnectorAgentConfig_ReadFiles(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr bool
+	}{
+		{name: "agentConfigFileOk", content: agentConfigFileOk},
+		{name: "emptyAgentConfigFile", content: "---\n"},
+		{name: "agentConfigFileInvalidRange", content: agentConfigFileInvalidRange, wantErr: true},
+		{name: "agentConfigFileMissingOperatorType", content: agentConfigFileMissingOperatorType, wantErr: true},
+		{name: "agentConfigFileInvalidDesiredVersion", content: agentConfigFileInvalidDesiredVersion, wantErr: true},
+		{name: "agentConfigFileUnknownField", content: "desired-agent-version: 0.1.0\n", wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		file := createFile(t, []byte(tt.content))
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			c := NewConnectorAgentConfig()
+			c.ConnectorAgentConfigFile = file
+			g.Expect(c.ReadFiles() != nil).To(gomega.Equal(tt.wantErr))
+		})
+	}
+}
+
+func TestConnectorAgentConfig_SupportedOperators(t *testing.T) {
+	g := gomega.NewWithT(t)
+	c := NewConnectorAgentConfig()
+	c.ConnectorAgentConfigFile = createFile(t, []byte(agentConfigFileOk))
+	g.Expect(c.ReadFiles()).To(gomega.Succeed())
+
+	operators, ok := c.SupportedOperators("0.1.5")
+	g.Expect(ok).To(gomega.BeTrue())
+	g.Expect(operators).To(gomega.HaveLen(1))
+	g.Expect(operators[0].Supports("0.9.0")).To(gomega.BeTrue())
+	g.Expect(operators[0].Supports("1.0.0")).To(gomega.BeFalse())
+	g.Expect(operators[0].Supports("not-a-version")).To(gomega.BeFalse())
+
+	operators, ok = c.SupportedOperators("v0.3.0")
+	g.Expect(ok).To(gomega.BeTrue())
+	g.Expect(operators).To(gomega.HaveLen(2))
+
+	_, ok = c.SupportedOperators("0.0.1")
+	g.Expect(ok).To(gomega.BeFalse())
+	_, ok = c.SupportedOperators("")
+	g.Expect(ok).To(gomega.BeFalse())
+
+	// any agent is supported without a compatibility matrix
+	_, ok = NewConnectorAgentConfig().SupportedOperators("0.0.1")
+	g.Expect(ok).To(gomega.BeTrue())
+}
+
+func TestConnectorAgentConfig_IsOutdated(t *testing.T) {
+	g := gomega.NewWithT(t)
+	c := NewConnectorAgentConfig()
+	c.ConnectorAgentConfigFile = createFile(t, []byte(agentConfigFileOk))
+	g.Expect(c.ReadFiles()).To(gomega.Succeed())
+
+	g.Expect(c.IsOutdated("0.1.9")).To(gomega.BeTrue())
+	g.Expect(c.IsOutdated("")).To(gomega.BeTrue())
+	g.Expect(c.IsOutdated("0.2.0")).To(gomega.BeFalse())
+	g.Expect(c.IsOutdated("1.0.0")).To(gomega.BeFalse())
+
+	// agents are never outdated without a desired version
+	g.Expect(NewConnectorAgentConfig().IsOutdated("0.0.1")).To(gomega.BeFalse())
+}
+
+func Test_ParseVersionRange(t *testing.T) {
+	tests := []struct {
+		name         string
+		versionRange string
+		accepted     []string
+		rejected     []string
+		wantErr      bool
+	}{
+		{
+			name:         "empty range accepts any version",
+			versionRange: "",
+			accepted:     []string{"0.0.1", "99.0.0"},
+		},
+		{
+			name:         "single version is a minimum version",
+			versionRange: "1.2.0",
+			accepted:     []string{"1.2.0", "2.0.0"},
+			rejected:     []string{"1.1.9"},
+		},
+		{
+			name:         "inclusive lower and exclusive upper bounds",
+			versionRange: "[1.0.0,2.0.0)",
+			accepted:     []string{"1.0.0", "1.9.9"},
+			rejected:     []string{"0.9.0", "2.0.0"},
+		},
+		{
+			name:         "exclusive lower and inclusive upper bounds",
+			versionRange: "(1.0.0,2.0.0]",
+			accepted:     []string{"1.0.1", "2.0.0"},
+			rejected:     []string{"1.0.0", "2.0.1"},
+		},
+		{
+			name:         "open upper bound",
+			versionRange: "[1.2.0,)",
+			accepted:     []string{"1.2.0", "10.0.0"},
+			rejected:     []string{"1.1.0"},
+		},
+		{
+			name:         "missing comma",
+			versionRange: "[1.0.0]",
+			wantErr:      true,
+		},
+		{
+			name:         "invalid version",
+			versionRange: "[one,two)",
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			got, err := ParseVersionRange(tt.versionRange)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				return
+			}
+			for _, v := range tt.accepted {
+				g.Expect(got(semver.MustParse(v))).To(gomega.BeTrue(), "version %s should be accepted", v)
+			}
+			for _, v := range tt.rejected {
+				g.Expect(got(semver.MustParse(v))).To(gomega.BeFalse(), "version %s should be rejected", v)
+			}
+		})
+	}
+}
@@ -0,0 +1,235 @@
+package config
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	// shardMetadataResources is the shard metadata key declaring the resource footprint of a connector, e.g.
+	//   resources:
+	//     requests: { cpu: "0.2", memory: "128M" }
+	//     limits: { cpu: "0.5", memory: "256M" }
+	shardMetadataResources = "resources"
+	// camel connectors declare their footprint with container trait annotations instead
+	shardMetadataAnnotations   = "annotations"
+	camelTraitRequestCPU       = "trait.camel.apache.org/container.request-cpu"
+	camelTraitRequestMemory    = "trait.camel.apache.org/container.request-memory"
+	camelTraitLimitCPU         = "trait.camel.apache.org/container.limit-cpu"
+	camelTraitLimitMemory      = "trait.camel.apache.org/container.limit-memory"
+	connectorResourceCPU       = "cpu"
+	connectorResourceMemory    = "memory"
+	connectorResourcesRequests = "requests"
+	connectorResourcesLimits   = "limits"
+)
+
+// ConnectorResources is the resource footprint of a connector, or of all the connectors of a namespace
+type ConnectorResources struct {
+	MemoryRequests resource.Quantity
+	MemoryLimits   resource.Quantity
+	CPURequests    resource.Quantity
+	CPULimits      resource.Quantity
+}
+
+func (r *ConnectorResources) Add(other ConnectorResources) {
+	r.MemoryRequests.Add(other.MemoryRequests)
+	r.MemoryLimits.Add(other.MemoryLimits)
+	r.CPURequests.Add(other.CPURequests)
+	r.CPULimits.Add(other.CPULimits)
+}
+
+// NamespaceQuotaUsage is what the connectors of a namespace consume of its quota
+type NamespaceQuotaUsage struct {
+	Connectors      int32
+	ConnectorTypes  map[string]int32
+	ConnectorLabels map[string]int32
+	Resources       ConnectorResources
+}
+
+// Add accounts one more connector of the given type, labels and footprint
+func (u *NamespaceQuotaUsage) Add(connectorTypeId string, labels []string, footprint ConnectorResources) {
+	u.Connectors++
+	u.ConnectorTypes[connectorTypeId]++
+	for _, label := range labels {
+		u.ConnectorLabels[label]++
+	}
+	u.Resources.Add(footprint)
+}
+
+// GetConnectorResources reads the resource footprint declared in the shard metadata of a connector type channel.
+// Resources that are not declared are left to zero.
+func GetConnectorResources(shardMetadata map[string]interface{}) (ConnectorResources, error) {
+	var result ConnectorResources
+	var err error
+
+	if resources, ok := shardMetadata[shardMetadataResources].(map[string]interface{}); ok {
+		requests, _ := resources[connectorResourcesRequests].(map[string]interface{})
+		limits, _ := resources[connectorResourcesLimits].(map[string]interface{})
+		for _, q := range []struct {
+			values map[string]interface{}
+			key    string
+			into   *resource.Quantity
+		}{
+			{requests, connectorResourceCPU, &result.CPURequests},
+			{requests, connectorResourceMemory, &result.MemoryRequests},
+			{limits, connectorResourceCPU, &result.CPULimits},
+			{limits, connectorResourceMemory, &result.MemoryLimits},
+		} {
+			if *q.into, err = parseQuantity(q.values[q.key]); err != nil {
+				return result, fmt.Errorf("invalid %s %s in shard metadata: %w", q.key, shardMetadataResources, err)
+			}
+		}
+		return result, nil
+	}
+
+	annotations, _ := shardMetadata[shardMetadataAnnotations].(map[string]interface{})
+	for _, q := range []struct {
+		key  string
+		into *resource.Quantity
+	}{
+		{camelTraitRequestCPU, &result.CPURequests},
+		{camelTraitRequestMemory, &result.MemoryRequests},
+		{camelTraitLimitCPU, &result.CPULimits},
+		{camelTraitLimitMemory, &result.MemoryLimits},
+	} {
+		if *q.into, err = parseQuantity(annotations[q.key]); err != nil {
+			return result, fmt.Errorf("invalid annotation %s in shard metadata: %w", q.key, err)
+		}
+	}
+	return result, nil
+}
+
+// Resources parses the resource quotas of the namespace, a zero quantity meaning the resource is not limited
+func (q NamespaceQuota) Resources() (ConnectorResources, error) {
+	var result ConnectorResources
+	var err error
+	for _, r := range []struct {
+		name  string
+		value string
+		into  *resource.Quantity
+	}{
+		{"memory-requests", q.MemoryRequests, &result.MemoryRequests},
+		{"memory-limits", q.MemoryLimits, &result.MemoryLimits},
+		{"cpu-requests", q.CPURequests, &result.CPURequests},
+		{"cpu-limits", q.CPULimits, &result.CPULimits},
+	} {
+		if *r.into, err = parseQuantity(r.value); err != nil {
+			return result, fmt.Errorf("invalid %s quota: %w", r.name, err)
+		}
+	}
+	return result, nil
+}
+
+// IsUnlimited returns true if the quota neither limits the connectors nor their resources
+func (q NamespaceQuota) IsUnlimited() bool {
+	return q.Connectors <= 0 && len(q.ConnectorTypes) == 0 && len(q.ConnectorLabels) == 0 &&
+		q.MemoryRequests == "" && q.MemoryLimits == "" && q.CPURequests == "" && q.CPULimits == ""
+}
+
+// Admit checks that one more connector of the given type, labels and footprint fits in the quota given its current usage
+func (q NamespaceQuota) Admit(usage NamespaceQuotaUsage, connectorTypeId string, labels []string, footprint ConnectorResources) error {
+	if q.Connectors > 0 && usage.Connectors >= q.Connectors {
+		return fmt.Errorf("the maximum number of allowed connectors has been reached")
+	}
+	if limit, ok := q.ConnectorTypes[connectorTypeId]; ok && usage.ConnectorTypes[connectorTypeId] >= limit {
+		return fmt.Errorf("the maximum number of allowed connectors of type %s has been reached", connectorTypeId)
+	}
+	for _, label := range labels {
+		if limit, ok := q.ConnectorLabels[label]; ok && usage.ConnectorLabels[label] >= limit {
+			return fmt.Errorf("the maximum number of allowed %s connectors has been reached", label)
+		}
+	}
+
+	quotaResources, err := q.Resources()
+	if err != nil {
+		return err
+	}
+	required := usage.Resources
+	required.Add(footprint)
+	for _, r := range []struct {
+		name     string
+		quota    resource.Quantity
+		required resource.Quantity
+	}{
+		{"memory requests", quotaResources.MemoryRequests, required.MemoryRequests},
+		{"memory limits", quotaResources.MemoryLimits, required.MemoryLimits},
+		{"cpu requests", quotaResources.CPURequests, required.CPURequests},
+		{"cpu limits", quotaResources.CPULimits, required.CPULimits},
+	} {
+		if !r.quota.IsZero() && r.required.Cmp(r.quota) > 0 {
+			return fmt.Errorf("the connector %s would exceed the namespace quota of %s", r.name, r.quota.String())
+		}
+	}
+	return nil
+}
+
+// Remaining returns what is left of the quota given its current usage. Limits that are not set are left unset.
+func (q NamespaceQuota) Remaining(usage NamespaceQuotaUsage) (NamespaceQuota, error) {
+	remaining := NamespaceQuota{}
+	if q.Connectors > 0 {
+		remaining.Connectors = remainingCount(q.Connectors, usage.Connectors)
+	}
+	if len(q.ConnectorTypes) > 0 {
+		remaining.ConnectorTypes = make(map[string]int32, len(q.ConnectorTypes))
+		for connectorTypeId, limit := range q.ConnectorTypes {
+			remaining.ConnectorTypes[connectorTypeId] = remainingCount(limit, usage.ConnectorTypes[connectorTypeId])
+		}
+	}
+	if len(q.ConnectorLabels) > 0 {
+		remaining.ConnectorLabels = make(map[string]int32, len(q.ConnectorLabels))
+		for label, limit := range q.ConnectorLabels {
+			remaining.ConnectorLabels[label] = remainingCount(limit, usage.ConnectorLabels[label])
+		}
+	}
+
+	quotaResources, err := q.Resources()
+	if err != nil {
+		return remaining, err
+	}
+	for _, r := range []struct {
+		quota resource.Quantity
+		used  resource.Quantity
+		into  *string
+	}{
+		{quotaResources.MemoryRequests, usage.Resources.MemoryRequests, &remaining.MemoryRequests},
+		{quotaResources.MemoryLimits, usage.Resources.MemoryLimits, &remaining.MemoryLimits},
+		{quotaResources.CPURequests, usage.Resources.CPURequests, &remaining.CPURequests},
+		{quotaResources.CPULimits, usage.Resources.CPULimits, &remaining.CPULimits},
+	} {
+		if r.quota.IsZero() {
+			continue
+		}
+		left := r.quota.DeepCopy()
+		left.Sub(r.used)
+		if left.Sign() < 0 {
+			left = resource.Quantity{Format: left.Format}
+		}
+		*r.into = left.String()
+	}
+	return remaining, nil
+}
+
+func remainingCount(limit int32, used int32) int32 {
+	if used >= limit {
+		return 0
+	}
+	return limit - used
+}
+
+func parseQuantity(value interface{}) (resource.Quantity, error) {
+	switch v := value.(type) {
+	case nil:
+		return resource.Quantity{}, nil
+	case string:
+		if v == "" {
+			return resource.Quantity{}, nil
+		}
+		return resource.ParseQuantity(v)
+	case float64:
+		// yaml and json numbers, e.g. cpu: 1
+		return resource.ParseQuantity(fmt.Sprintf("%v", v))
+	default:
+		return resource.Quantity{}, fmt.Errorf("unexpected quantity %v", value)
+	}
+}
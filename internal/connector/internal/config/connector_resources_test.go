@@ -0,0 +1,229 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestGetConnectorResources(t *testing.T) {
+	tests := []struct {
+		name          string
+		shardMetadata map[string]interface{}
+		want          ConnectorResources
+		wantErr       bool
+	}{
+		{
+			name: "reads the resources section",
+			shardMetadata: map[string]interface{}{
+				"resources": map[string]interface{}{
+					"requests": map[string]interface{}{"cpu": "0.5", "memory": "256Mi"},
+					"limits":   map[string]interface{}{"cpu": float64(1), "memory": "512Mi"},
+				},
+			},
+			want: ConnectorResources{
+				CPURequests:    resource.MustParse("0.5"),
+				MemoryRequests: resource.MustParse("256Mi"),
+				CPULimits:      resource.MustParse("1"),
+				MemoryLimits:   resource.MustParse("512Mi"),
+			},
+		},
+		{
+			name: "falls back to camel container trait annotations",
+			shardMetadata: map[string]interface{}{
+				"annotations": map[string]interface{}{
+					"trait.camel.apache.org/container.request-cpu":    "0.20",
+					"trait.camel.apache.org/container.request-memory": "128M",
+				},
+			},
+			want: ConnectorResources{
+				CPURequests:    resource.MustParse("0.20"),
+				MemoryRequests: resource.MustParse("128M"),
+			},
+		},
+		{
+			name:          "leaves undeclared resources to zero",
+			shardMetadata: map[string]interface{}{"connector_image": "some-image"},
+			want:          ConnectorResources{},
+		},
+		{
+			name: "returns an error on an invalid quantity",
+			shardMetadata: map[string]interface{}{
+				"resources": map[string]interface{}{
+					"requests": map[string]interface{}{"memory": "a lot"},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			got, err := GetConnectorResources(tt.shardMetadata)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if !tt.wantErr {
+				g.Expect(got.CPURequests.Cmp(tt.want.CPURequests)).To(gomega.BeZero())
+				g.Expect(got.MemoryRequests.Cmp(tt.want.MemoryRequests)).To(gomega.BeZero())
+				g.Expect(got.CPULimits.Cmp(tt.want.CPULimits)).To(gomega.BeZero())
+				g.Expect(got.MemoryLimits.Cmp(tt.want.MemoryLimits)).To(gomega.BeZero())
+			}
+		})
+	}
+}
+
+func TestNamespaceQuota_Admit(t *testing.T) {
+	quota := NamespaceQuota{
+		Connectors:      4,
+		MemoryRequests:  "1Gi",
+		CPULimits:       "2",
+		ConnectorTypes:  map[string]int32{"log_sink_0.1": 1},
+		ConnectorLabels: map[string]int32{"debezium": 2},
+	}
+	footprint := ConnectorResources{
+		MemoryRequests: resource.MustParse("256Mi"),
+		CPULimits:      resource.MustParse("0.5"),
+	}
+	usageOf := func(connectors int32, resources ConnectorResources) NamespaceQuotaUsage {
+		return NamespaceQuotaUsage{
+			Connectors:      connectors,
+			ConnectorTypes:  map[string]int32{"log_sink_0.1": 0, "debezium-postgres-1.9.4": 1},
+			ConnectorLabels: map[string]int32{"debezium": 1},
+			Resources:       resources,
+		}
+	}
+
+	tests := []struct {
+		name            string
+		quota           NamespaceQuota
+		usage           NamespaceQuotaUsage
+		connectorTypeId string
+		labels          []string
+		wantErr         string
+	}{
+		{
+			name:            "admits a connector within quota",
+			quota:           quota,
+			usage:           usageOf(1, ConnectorResources{}),
+			connectorTypeId: "log_sink_0.1",
+		},
+		{
+			name:            "admits any connector in an unlimited namespace",
+			quota:           NamespaceQuota{},
+			usage:           usageOf(100, ConnectorResources{MemoryRequests: resource.MustParse("100Gi")}),
+			connectorTypeId: "log_sink_0.1",
+		},
+		{
+			name:            "rejects a connector over the connectors count",
+			quota:           quota,
+			usage:           usageOf(4, ConnectorResources{}),
+			connectorTypeId: "log_sink_0.1",
+			wantErr:         "the maximum number of allowed connectors has been reached",
+		},
+		{
+			name:  "rejects a connector over its connector type count",
+			quota: quota,
+			usage: NamespaceQuotaUsage{
+				Connectors:     1,
+				ConnectorTypes: map[string]int32{"log_sink_0.1": 1},
+			},
+			connectorTypeId: "log_sink_0.1",
+			wantErr:         "of type log_sink_0.1 has been reached",
+		},
+		{
+			name:  "rejects a connector over its label count",
+			quota: quota,
+			usage: NamespaceQuotaUsage{
+				Connectors:      2,
+				ConnectorLabels: map[string]int32{"debezium": 2},
+			},
+			connectorTypeId: "debezium-mysql-1.9.4",
+			labels:          []string{"source", "debezium"},
+			wantErr:         "allowed debezium connectors has been reached",
+		},
+		{
+			name:            "rejects a connector over the memory requests budget",
+			quota:           quota,
+			usage:           usageOf(2, ConnectorResources{MemoryRequests: resource.MustParse("800Mi")}),
+			connectorTypeId: "log_sink_0.1",
+			wantErr:         "memory requests would exceed the namespace quota of 1Gi",
+		},
+		{
+			name:            "admits a connector exactly filling the cpu limits budget",
+			quota:           quota,
+			usage:           usageOf(2, ConnectorResources{CPULimits: resource.MustParse("1.5")}),
+			connectorTypeId: "log_sink_0.1",
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			err := tt.quota.Admit(tt.usage, tt.connectorTypeId, tt.labels, footprint)
+			if tt.wantErr == "" {
+				g.Expect(err).ToNot(gomega.HaveOccurred())
+			} else {
+				g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
+
+func TestNamespaceQuotaUsage_Add(t *testing.T) {
+	g := gomega.NewWithT(t)
+	quota := NamespaceQuota{
+		ConnectorTypes:  map[string]int32{"debezium-mysql-1.9.4": 2},
+		ConnectorLabels: map[string]int32{"debezium": 2},
+	}
+	usage := NamespaceQuotaUsage{
+		ConnectorTypes:  map[string]int32{},
+		ConnectorLabels: map[string]int32{},
+	}
+	footprint := ConnectorResources{MemoryRequests: resource.MustParse("256Mi")}
+
+	// connectors admitted one after the other count towards the admission of the next ones
+	g.Expect(quota.Admit(usage, "debezium-mysql-1.9.4", []string{"debezium"}, footprint)).To(gomega.Succeed())
+	usage.Add("debezium-mysql-1.9.4", []string{"debezium"}, footprint)
+	g.Expect(quota.Admit(usage, "debezium-postgres-1.9.4", []string{"debezium"}, footprint)).To(gomega.Succeed())
+	usage.Add("debezium-postgres-1.9.4", []string{"debezium"}, footprint)
+	g.Expect(quota.Admit(usage, "debezium-mysql-1.9.4", []string{"debezium"}, footprint)).
+		To(gomega.MatchError(gomega.ContainSubstring("allowed debezium connectors has been reached")))
+
+	g.Expect(usage.Connectors).To(gomega.Equal(int32(2)))
+	g.Expect(usage.ConnectorTypes).To(gomega.Equal(map[string]int32{"debezium-mysql-1.9.4": 1, "debezium-postgres-1.9.4": 1}))
+	g.Expect(usage.Resources.MemoryRequests.String()).To(gomega.Equal("512Mi"))
+}
+
+func TestNamespaceQuota_Remaining(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	quota := NamespaceQuota{
+		Connectors:      4,
+		MemoryRequests:  "1Gi",
+		CPULimits:       "2",
+		ConnectorTypes:  map[string]int32{"log_sink_0.1": 1},
+		ConnectorLabels: map[string]int32{"debezium": 2},
+	}
+	usage := NamespaceQuotaUsage{
+		Connectors:      3,
+		ConnectorTypes:  map[string]int32{"log_sink_0.1": 2, "debezium-postgres-1.9.4": 1},
+		ConnectorLabels: map[string]int32{"debezium": 1},
+		Resources: ConnectorResources{
+			MemoryRequests: resource.MustParse("768Mi"),
+			CPULimits:      resource.MustParse("3"),
+		},
+	}
+
+	remaining, err := quota.Remaining(usage)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(remaining).To(gomega.Equal(NamespaceQuota{
+		Connectors:      1,
+		MemoryRequests:  "256Mi",
+		CPULimits:       "0",
+		ConnectorTypes:  map[string]int32{"log_sink_0.1": 0},
+		ConnectorLabels: map[string]int32{"debezium": 1},
+	}))
+}
@@ -23,13 +23,18 @@ import (
 type ConnectorsConfig struct {
 	ConnectorEvalDuration               time.Duration           `json:"connector_eval_duration"`
 	ConnectorEvalOrganizations          []string                `json:"connector_eval_organizations"`
+	ConnectorEvalExpiryWarnings         []time.Duration         `json:"connector_eval_expiry_warnings"`
+	ConnectorEvalExpiryWebhookURL       string                  `json:"connector_eval_expiry_webhook_url"`
+	ConnectorEvalMaxExtension           time.Duration           `json:"connector_eval_max_extension"`
 	ConnectorNamespaceLifecycleAPI      bool                    `json:"connector_namespace_lifecycle_api"`
 	ConnectorEnableUnassignedConnectors bool                    `json:"connector_enable_unassigned_connectors"`
+	ConnectorEnableNamespaceScheduling  bool                    `json:"connector_enable_namespace_scheduling"`
 	ConnectorCatalogDirs                []string                `json:"connector_types"`
 	ConnectorMetadataDirs               []string                `json:"connector_metadata"`
 	CatalogEntries                      []ConnectorCatalogEntry `json:"connector_type_urls"`
 	CatalogChecksums                    map[string]string       `json:"connector_catalog_checksums"`
 	ConnectorsSupportedChannels         []string                `json:"connectors_supported_channels"`
+	ConnectorDeploymentLogsMaxEntries   int                     `json:"connector_deployment_logs_max_entries"`
 }
 
 var _ environments.ConfigModule = &ConnectorsConfig{}
@@ -52,7 +57,9 @@ type ConnectorMetadata struct {
 
 func NewConnectorsConfig() *ConnectorsConfig {
 	return &ConnectorsConfig{
-		CatalogChecksums: make(map[string]string),
+		CatalogChecksums:                  make(map[string]string),
+		ConnectorDeploymentLogsMaxEntries: 500,
+		ConnectorEvalExpiryWarnings:       []time.Duration{24 * time.Hour, time.Hour},
 	}
 }
 
@@ -61,9 +68,14 @@ func (c *ConnectorsConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringArrayVar(&c.ConnectorMetadataDirs, "connector-metadata", c.ConnectorMetadataDirs, "Directory containing connector metadata configuration files")
 	fs.DurationVar(&c.ConnectorEvalDuration, "connector-eval-duration", c.ConnectorEvalDuration, "Connector eval duration in golang duration format")
 	fs.StringSliceVar(&c.ConnectorEvalOrganizations, "connector-eval-organizations", c.ConnectorEvalOrganizations, "Connector eval organization IDs")
+	fs.DurationSliceVar(&c.ConnectorEvalExpiryWarnings, "connector-eval-expiry-warnings", c.ConnectorEvalExpiryWarnings, "Offsets before the expiration of eval namespaces at which expiry warnings are emitted, in golang duration format")
+	fs.StringVar(&c.ConnectorEvalExpiryWebhookURL, "connector-eval-expiry-webhook-url", c.ConnectorEvalExpiryWebhookURL, "URL eval namespace lifecycle events are posted to, events are only recorded when empty")
+	fs.DurationVar(&c.ConnectorEvalMaxExtension, "connector-eval-max-extension", c.ConnectorEvalMaxExtension, "Maximum duration eval namespaces can be extended by once, in golang duration format. Extensions are disabled when 0")
 	fs.BoolVar(&c.ConnectorNamespaceLifecycleAPI, "connector-namespace-lifecycle-api", c.ConnectorNamespaceLifecycleAPI, "Enable APIs to create, update, delete non-eval Namespaces")
 	fs.BoolVar(&c.ConnectorEnableUnassignedConnectors, "connector-enable-unassigned-connectors", c.ConnectorEnableUnassignedConnectors, "Enable support for 'unassigned' state for Connectors")
+	fs.BoolVar(&c.ConnectorEnableNamespaceScheduling, "connector-enable-namespace-scheduling", c.ConnectorEnableNamespaceScheduling, "Schedule connectors created without a namespace to one of the namespaces of their owner or organisation")
 	fs.StringSliceVar(&c.ConnectorsSupportedChannels, "connectors-supported-channels", c.ConnectorsSupportedChannels, "Connector channels that are visible")
+	fs.IntVar(&c.ConnectorDeploymentLogsMaxEntries, "connector-deployment-logs-max-entries", c.ConnectorDeploymentLogsMaxEntries, "Maximum number of log lines and events relayed by agents that are kept for each connector deployment")
 }
 
 func (c *ConnectorsConfig) ReadFiles() error {
@@ -7,6 +7,10 @@ type NamespaceQuota struct {
 	MemoryLimits   string `yaml:"memory-limits,omitempty"`
 	CPURequests    string `yaml:"cpu-requests,omitempty"`
 	CPULimits      string `yaml:"cpu-limits,omitempty"`
+	// ConnectorTypes limits the number of connectors per connector type id
+	ConnectorTypes map[string]int32 `yaml:"connector-types,omitempty"`
+	// ConnectorLabels limits the number of connectors per connector type label, e.g. debezium
+	ConnectorLabels map[string]int32 `yaml:"connector-labels,omitempty"`
 }
 
 // Quotas has limits for various resource types, e.g. namespaces
@@ -45,6 +45,13 @@ func (c *ConnectorsQuotaConfig) ReadFiles() (err error) {
 			err = fmt.Errorf("configuration file '%s' is missing default namespace quota profile '%s'",
 				c.ConnectorsQuotaConfigFile, c.DefaultNamespaceQuotaProfile)
 		}
+
+		for name, quotas := range c.connectorsQuotaMap {
+			if _, resourcesErr := quotas.NamespaceQuota.Resources(); resourcesErr != nil {
+				err = fmt.Errorf("configuration file '%s' has an invalid quota profile '%s': %s",
+					c.ConnectorsQuotaConfigFile, name, resourcesErr)
+			}
+		}
 	} else if os.IsNotExist(err) {
 		err = fmt.Errorf("configuration file for connectors-quota-config-file not found: '%s'", c.ConnectorsQuotaConfigFile)
 	} else {
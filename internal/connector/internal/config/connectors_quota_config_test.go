@@ -19,6 +19,21 @@ const quotaConfigFileOk = `
       memory-limits: "2Gi"
       cpu-requests: "1"
       cpu-limits: "2"
+      connector-types:
+        log_sink_0.1: 1
+      connector-labels:
+        debezium: 2
+
+`
+
+const quotaConfigFileInvalidQuantity = `
+---
+- profile-name: default-profile
+- profile-name: evaluation-profile
+  quotas:
+    namespace-quota:
+      connectors: 4
+      memory-requests: "one gigabyte"
 
 `
 
@@ -56,6 +71,16 @@ func TestConnectorsQuotaConfig_ReadFiles(t *testing.T) {
 			},
 			err: "",
 		},
+		{
+			name: "quotaConfigFileInvalidQuantity",
+			config: ConnectorsQuotaConfig{
+				connectorsQuotaMap:           make(ConnectorsQuotaProfileMap),
+				ConnectorsQuotaConfigFile:    createFile(t, []byte(quotaConfigFileInvalidQuantity)),
+				EvalNamespaceQuotaProfile:    profiles.EvaluationProfileName,
+				DefaultNamespaceQuotaProfile: profiles.DefaultProfileName,
+			},
+			err: "has an invalid quota profile 'evaluation-profile': invalid memory-requests quota",
+		},
 		{
 			name: "quotaConfigFileNoDefault",
 			config: ConnectorsQuotaConfig{
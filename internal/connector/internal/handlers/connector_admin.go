@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/public"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/authz"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/workers"
@@ -18,6 +19,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/presenters"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/scheduler"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
 	"github.com/goava/di"
 
@@ -33,9 +35,12 @@ type ConnectorAdminHandler struct {
 	Service               services.ConnectorClusterService
 	ConnectorsService     services.ConnectorsService
 	NamespaceService      services.ConnectorNamespaceService
+	KafkaReferences       services.KafkaReferencesService
+	SchedulesService      services.ConnectorSchedulesService
 	QuotaConfig           *config.ConnectorsQuotaConfig
 	ConnectorCluster      *ConnectorClusterHandler //TODO: eventually move deployment handling into a deployment service
 	ConnectorTypesService services.ConnectorTypesService
+	ConnectorScheduler    scheduler.ConnectorScheduler
 }
 
 type operator struct {
@@ -101,6 +106,35 @@ func (h *ConnectorAdminHandler) ListConnectorClusters(w http.ResponseWriter, r *
 	handlers.HandleList(w, r, cfg)
 }
 
+// ListOutdatedAgentClusters lists the clusters whose agent hasn't been upgraded to the desired agent version yet
+func (h *ConnectorAdminHandler) ListOutdatedAgentClusters(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+
+			resources, err := h.Service.ListOutdatedAgentClusters(r.Context())
+			if err != nil {
+				return nil, err
+			}
+
+			resourceList := private.ConnectorClusterAdminList{
+				Kind:  "ConnectorClusterList",
+				Page:  1,
+				Size:  int32(len(resources)),
+				Total: int32(len(resources)),
+			}
+
+			resourceList.Items = make([]private.ConnectorClusterAdminView, len(resources))
+			for i, resource := range resources {
+				resourceList.Items[i] = presenters.PresentPrivateConnectorCluster(&resource)
+			}
+
+			return resourceList, nil
+		},
+	}
+
+	handlers.HandleList(w, r, cfg)
+}
+
 func (h *ConnectorAdminHandler) GetClusterNamespaces(writer http.ResponseWriter, request *http.Request) {
 	id := mux.Vars(request)["connector_cluster_id"]
 	listArgs := coreservices.NewListArguments(request.URL.Query())
@@ -380,11 +414,34 @@ func (h *ConnectorAdminHandler) PatchConnector(writer http.ResponseWriter, reque
 		connectorsService:     h.ConnectorsService,
 		connectorTypesService: h.ConnectorTypesService,
 		namespaceService:      h.NamespaceService,
+		kafkaReferences:       h.KafkaReferences,
+		schedulesService:      h.SchedulesService,
 		authZService:          h.AuthZService,
 		connectorsConfig:      h.ConnectorsConfig,
 	}.Patch(writer, request)
 }
 
+func (h *ConnectorAdminHandler) MoveConnector(writer http.ResponseWriter, request *http.Request) {
+	connectorId := mux.Vars(request)["connector_id"]
+	var moveRequest public.ConnectorMoveRequest
+	cfg := handlers.HandlerConfig{
+		MarshalInto: &moveRequest,
+		Validate: []handlers.Validate{
+			handlers.Validation("connector_id", &connectorId, handlers.MinLen(1), handlers.MaxLen(maxConnectorIdLength)),
+			handlers.Validation("namespace_id", &moveRequest.NamespaceId, handlers.MinLen(1), handlers.MaxLen(maxConnectorNamespaceIdLength)),
+		},
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			connector, serviceError := HandleConnectorMove(request.Context(), h.ConnectorsService, h.NamespaceService, connectorId, moveRequest.NamespaceId)
+			if serviceError != nil {
+				return nil, serviceError
+			}
+			return presenters.PresentConnectorAdminView(connector)
+		},
+	}
+
+	handlers.Handle(writer, request, &cfg, http.StatusAccepted)
+}
+
 func (h *ConnectorAdminHandler) DeleteConnector(writer http.ResponseWriter, request *http.Request) {
 	connectorId := mux.Vars(request)["connector_id"]
 	cfg := handlers.HandlerConfig{
@@ -615,6 +672,14 @@ func (h *ConnectorAdminHandler) PatchConnectorDeployment(writer http.ResponseWri
 				if serr != nil {
 					return nil, errors.GeneralError("Error in patching deployment, shardMetadata %+v not found: %v", resource.Spec.ShardMetadata, err.Error())
 				}
+				// the upgrade is rejected if the operators or the agent of the cluster can't run it, like when the connector is placed
+				reason, serr := h.ConnectorScheduler.Incompatibility(request.Context(), existingDeployment.ClusterID, updateShardMetadata)
+				if serr != nil {
+					return nil, serr
+				}
+				if reason != "" {
+					return nil, errors.BadRequest("Error in patching deployment, shardMetadata revision %d can't be deployed: %s", updateRevision, reason)
+				}
 				updatedDeployment.ConnectorShardMetadataID = updateShardMetadata.ID
 				updatedDeployment.ConnectorShardMetadata = *updateShardMetadata
 			} else if resource.Spec.OperatorId != "" {
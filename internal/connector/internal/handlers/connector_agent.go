@@ -411,3 +411,53 @@ func (h *ConnectorClusterHandler) UpdateNamespaceStatus(w http.ResponseWriter, r
 	}
 	handlers.Handle(w, r, cfg, http.StatusNoContent)
 }
+
+// maxConnectorDeploymentLogEntries bounds the number of entries an agent can relay in a single request
+const maxConnectorDeploymentLogEntries = 500
+
+func (h *ConnectorClusterHandler) AppendDeploymentLogs(w http.ResponseWriter, r *http.Request) {
+	connectorClusterId := mux.Vars(r)["connector_cluster_id"]
+	deploymentId := mux.Vars(r)["deployment_id"]
+	var resource private.ConnectorDeploymentLogs
+
+	ctx := r.Context()
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &resource,
+		Validate: []handlers.Validate{
+			handlers.Validation("connector_cluster_id", &connectorClusterId, handlers.MinLen(1), handlers.MaxLen(maxConnectorClusterIdLength), validateConnectorClusterId(ctx, h.Service)),
+			handlers.Validation("deployment_id", &deploymentId, handlers.MinLen(1), handlers.MaxLen(maxConnectorIdLength)),
+			validateConnectorDeploymentLogs(&resource),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			ctx := r.Context()
+			deployment, err := h.Service.GetDeployment(ctx, deploymentId)
+			if err != nil {
+				return nil, err
+			}
+			if deployment.ClusterID != connectorClusterId {
+				return nil, errors.NotFound("Connector deployment not found")
+			}
+			return nil, h.Logs.AppendDeploymentLogs(ctx, &deployment, presenters.ConvertConnectorDeploymentLogs(resource))
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusNoContent)
+}
+
+func validateConnectorDeploymentLogs(resource *private.ConnectorDeploymentLogs) handlers.Validate {
+	return func() *errors.ServiceError {
+		if len(resource.Items) > maxConnectorDeploymentLogEntries {
+			return errors.BadRequest("items is not valid. Maximum number of entries is %d", maxConnectorDeploymentLogEntries)
+		}
+		for i := range resource.Items {
+			item := &resource.Items[i]
+			field := fmt.Sprintf("items[%d].kind", i)
+			if err := handlers.Validation(field, &item.Kind, handlers.IsOneOf(dbapi.ValidConnectorDeploymentLogKinds...))(); err != nil {
+				return err
+			}
+			if item.Timestamp.IsZero() {
+				return errors.BadRequest("items[%d].timestamp is required", i)
+			}
+		}
+		return nil
+	}
+}
@@ -43,6 +43,8 @@ type ConnectorClusterHandler struct {
 	ServerConfig       *server.ServerConfig
 	AuthZ              authz.AuthZService
 	QuotaConfig        *config.ConnectorsQuotaConfig
+	AgentConfig        *config.ConnectorAgentConfig
+	Logs               services.ConnectorLogsService
 }
 
 func NewConnectorClusterHandler(handler ConnectorClusterHandler) *ConnectorClusterHandler {
@@ -184,10 +186,11 @@ func (h *ConnectorClusterHandler) List(w http.ResponseWriter, r *http.Request) {
 			}
 
 			resourceList := public.ConnectorClusterList{
-				Kind:  "ConnectorClusterList",
-				Page:  int32(paging.Page),
-				Size:  int32(paging.Size),
-				Total: int32(paging.Total),
+				Kind:       "ConnectorClusterList",
+				Page:       int32(paging.Page),
+				Size:       int32(paging.Size),
+				Total:      int32(paging.Total),
+				NextCursor: paging.NextCursor,
 			}
 
 			for _, resource := range resources {
@@ -273,6 +276,13 @@ func (o *ConnectorClusterHandler) buildAddonParams(cluster *dbapi.ConnectorClust
 			Value: cluster.ClientSecret,
 		},
 	}
+	// the addon upgrades the agent of every cluster to the desired version
+	if o.AgentConfig.DesiredVersion != "" {
+		p = append(p, ocm.Parameter{
+			Id:    "desired-agent-version",
+			Value: o.AgentConfig.DesiredVersion,
+		})
+	}
 	return p
 }
 
@@ -304,10 +314,11 @@ func (h *ConnectorClusterHandler) GetNamespaces(writer http.ResponseWriter, requ
 			}
 
 			resourceList := public.ConnectorNamespaceList{
-				Kind:  "ConnectorNamespaceList",
-				Page:  int32(paging.Page),
-				Size:  int32(paging.Size),
-				Total: int32(paging.Total),
+				Kind:       "ConnectorNamespaceList",
+				Page:       int32(paging.Page),
+				Size:       int32(paging.Size),
+				Total:      int32(paging.Total),
+				NextCursor: paging.NextCursor,
 			}
 
 			for _, resource := range resources {
@@ -1,7 +1,9 @@
 package handlers
 
 import (
+	"context"
 	"fmt"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/public"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/presenters"
@@ -17,6 +19,7 @@ import (
 	"github.com/gorilla/mux"
 	"net/http"
 	"regexp"
+	"time"
 )
 
 var (
@@ -32,10 +35,12 @@ func init() {
 
 type ConnectorNamespaceHandler struct {
 	di.Inject
-	Bus          signalbus.SignalBus
-	Service      services.ConnectorNamespaceService
-	AuthZService authz.AuthZService
-	QuotaConfig  *config.ConnectorsQuotaConfig
+	Bus               signalbus.SignalBus
+	Service           services.ConnectorNamespaceService
+	EventsService     services.ConnectorNamespaceEventsService
+	ConnectorsService services.ConnectorsService
+	AuthZService      authz.AuthZService
+	QuotaConfig       *config.ConnectorsQuotaConfig
 }
 
 func NewConnectorNamespaceHandler(handler ConnectorNamespaceHandler) *ConnectorNamespaceHandler {
@@ -145,7 +150,14 @@ func (h *ConnectorNamespaceHandler) Get(w http.ResponseWriter, r *http.Request)
 			if err != nil {
 				return nil, err
 			}
-			return presenters.PresentConnectorNamespace(resource, h.QuotaConfig), nil
+			remainingQuota, err := h.Service.GetRemainingQuota(connectorNamespaceId)
+			if err != nil {
+				return nil, err
+			}
+			result := presenters.PresentConnectorNamespace(resource, h.QuotaConfig)
+			presentedRemainingQuota := presenters.PresentConnectorNamespaceQuota(remainingQuota)
+			result.RemainingQuota = &presentedRemainingQuota
+			return result, nil
 		},
 	}
 	handlers.HandleGet(w, r, cfg)
@@ -216,6 +228,161 @@ func (h *ConnectorNamespaceHandler) Delete(w http.ResponseWriter, r *http.Reques
 	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
 }
 
+// Extend is the handler for the one-time extension of the expiration of an eval namespace
+func (h *ConnectorNamespaceHandler) Extend(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+	user := h.AuthZService.GetValidationUser(ctx)
+
+	var resource public.ConnectorNamespaceExtendRequest
+	connectorNamespaceId := mux.Vars(r)["connector_namespace_id"]
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &resource,
+		Validate: []handlers.Validate{
+			handlers.Validation("connector_namespace_id", &connectorNamespaceId,
+				handlers.MinLen(1), handlers.MaxLen(maxConnectorNamespaceIdLength), user.AuthorizedNamespaceAdmin()),
+		},
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			var extension time.Duration
+			if resource.Duration != "" {
+				var err error
+				if extension, err = time.ParseDuration(resource.Duration); err != nil {
+					return nil, errors.BadRequest("invalid duration '%s': %v", resource.Duration, err)
+				}
+			}
+
+			namespace, err := h.Service.ExtendExpiration(ctx, connectorNamespaceId, extension)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentConnectorNamespace(namespace, h.QuotaConfig), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+// Convert is the handler for moving all the connectors of an eval namespace to a regular namespace, keeping their ids
+func (h *ConnectorNamespaceHandler) Convert(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+	user := h.AuthZService.GetValidationUser(ctx)
+
+	var resource public.ConnectorNamespaceConvertRequest
+	connectorNamespaceId := mux.Vars(r)["connector_namespace_id"]
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &resource,
+		Validate: []handlers.Validate{
+			handlers.Validation("connector_namespace_id", &connectorNamespaceId,
+				handlers.MinLen(1), handlers.MaxLen(maxConnectorNamespaceIdLength), user.AuthorizedNamespaceAdmin()),
+			handlers.Validation("namespace_id", &resource.NamespaceId, handlers.MinLen(1), handlers.MaxLen(maxConnectorNamespaceIdLength),
+				user.AuthorizedNamespaceUser(errors.ErrorBadRequest)),
+		},
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			namespace, target, connectorIds, err := convertNamespace(ctx, h.ConnectorsService, h.Service, connectorNamespaceId, resource.NamespaceId)
+			if err != nil {
+				return nil, err
+			}
+
+			message := fmt.Sprintf("%d connectors of namespace %s moved to namespace %s", len(connectorIds), namespace.Name, target.Name)
+			if err := h.EventsService.Record(ctx, namespace, dbapi.ConnectorNamespaceEventConverted, message); err != nil {
+				return nil, err
+			}
+
+			return public.ConnectorNamespaceConvertResult{
+				Kind:         "ConnectorNamespaceConvertResult",
+				Id:           connectorNamespaceId,
+				NamespaceId:  resource.NamespaceId,
+				ConnectorIds: connectorIds,
+			}, nil
+		},
+	}
+
+	// return 202 status accepted
+	handlers.Handle(w, r, cfg, http.StatusAccepted)
+}
+
+// convertNamespace moves all the connectors of an eval namespace to a regular namespace.
+// All the connectors are validated before any of them is moved, and they are moved in one transaction
+func convertNamespace(ctx context.Context, connectorsService services.ConnectorsService, namespaceService services.ConnectorNamespaceService,
+	namespaceId string, targetId string) (*dbapi.ConnectorNamespace, *dbapi.ConnectorNamespace, []string, *errors.ServiceError) {
+
+	namespace, err := namespaceService.Get(ctx, namespaceId)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if namespace.Expiration == nil {
+		return nil, nil, nil, errors.BadRequest("connector namespace with id='%s' is not an evaluation namespace", namespaceId)
+	}
+	target, err := namespaceService.Get(ctx, targetId)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if target.Expiration != nil {
+		return nil, nil, nil, errors.BadRequest("connector namespace with id='%s' expires, connectors can only be moved to a regular namespace", targetId)
+	}
+	if target.Status.Phase != dbapi.ConnectorNamespacePhaseReady {
+		return nil, nil, nil, errors.BadRequest("connector namespace with id='%s' is %s", targetId, target.Status.Phase)
+	}
+
+	// connectors already being moved to the target namespace are skipped, so that a failed conversion can be retried
+	var connectors dbapi.ConnectorList
+	if errs := connectorsService.ForEach(func(connector *dbapi.Connector) *errors.ServiceError {
+		if connector.DesiredState != dbapi.ConnectorUnassigned || connector.TargetNamespaceId == nil ||
+			*connector.TargetNamespaceId != targetId {
+			connectors = append(connectors, connector)
+		}
+		return nil
+	}, "connectors.namespace_id = ? AND connectors.desired_state <> ?", namespaceId, dbapi.ConnectorDeleted); len(errs) > 0 {
+		return nil, nil, nil, errors.GeneralError("failed to list connectors of namespace %s: %v", namespaceId, errs)
+	}
+
+	// the connectors must fit in the target namespace together
+	quotaErrs, err := namespaceService.CheckConnectorsQuota(targetId, connectors)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	connectorIds := make([]string, 0, len(connectors))
+	for i, connector := range connectors {
+		if quotaErrs[i] != nil {
+			return nil, nil, nil, quotaErrs[i]
+		}
+		if err := validateConnectorMove(connector, targetId); err != nil {
+			return nil, nil, nil, err
+		}
+		if err := prepareConnectorMove(ctx, namespaceService, connector, targetId); err != nil {
+			return nil, nil, nil, err
+		}
+		connectorIds = append(connectorIds, connector.ID)
+	}
+	if err := namespaceService.MoveConnectors(ctx, namespace, connectors); err != nil {
+		return nil, nil, nil, err
+	}
+	return namespace, target, connectorIds, nil
+}
+
+// Events is the handler for listing the lifecycle events of a namespace, most recent first
+func (h *ConnectorNamespaceHandler) Events(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+	user := h.AuthZService.GetValidationUser(ctx)
+
+	connectorNamespaceId := mux.Vars(r)["connector_namespace_id"]
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			handlers.Validation("connector_namespace_id", &connectorNamespaceId,
+				handlers.MinLen(1), handlers.MaxLen(maxConnectorNamespaceIdLength), user.AuthorizedNamespaceUser(errors.ErrorNotFound)),
+		},
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			events, err := h.EventsService.List(ctx, connectorNamespaceId)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentConnectorNamespaceEventList(events), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
 func (h *ConnectorNamespaceHandler) List(w http.ResponseWriter, r *http.Request) {
 	cfg := &handlers.HandlerConfig{
 		Action: func() (i interface{}, serviceError *errors.ServiceError) {
@@ -246,11 +413,12 @@ func (h *ConnectorNamespaceHandler) List(w http.ResponseWriter, r *http.Request)
 				items[j] = presenters.PresentConnectorNamespace(resource, h.QuotaConfig)
 			}
 			resourceList := public.ConnectorNamespaceList{
-				Kind:  "ConnectorNamespaceList",
-				Page:  int32(paging.Page),
-				Size:  int32(paging.Size),
-				Total: int32(paging.Total),
-				Items: items,
+				Kind:       "ConnectorNamespaceList",
+				Page:       int32(paging.Page),
+				Size:       int32(paging.Size),
+				Total:      int32(paging.Total),
+				Items:      items,
+				NextCursor: paging.NextCursor,
 			}
 
 			return resourceList, nil
@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+)
+
+func TestConvertNamespace(t *testing.T) {
+	evalNamespaceId := "eval-namespace"
+	targetNamespaceId := "target-namespace"
+	expiration := time.Now().Add(time.Hour)
+
+	buildConnector := func(id string, desiredState dbapi.ConnectorDesiredState, phase dbapi.ConnectorStatusPhase) *dbapi.Connector {
+		namespaceId := evalNamespaceId
+		return &dbapi.Connector{
+			Model:           db.Model{ID: id},
+			NamespaceId:     &namespaceId,
+			ConnectorTypeId: "log_sink_0.1",
+			Channel:         "stable",
+			DesiredState:    desiredState,
+			Status: dbapi.ConnectorStatus{
+				Model: db.Model{ID: id},
+				Phase: phase,
+			},
+		}
+	}
+	beingMoved := buildConnector("moving", dbapi.ConnectorUnassigned, dbapi.ConnectorStatusPhaseDeleting)
+	beingMoved.TargetNamespaceId = &targetNamespaceId
+
+	tests := []struct {
+		name             string
+		connectors       dbapi.ConnectorList
+		targetPhase      dbapi.ConnectorNamespacePhaseEnum
+		targetExpiration *time.Time
+		quotaErrs        map[string]*errors.ServiceError
+		wantErr          bool
+		wantConnectorIds []string
+	}{
+		{
+			name: "all connectors are moved",
+			connectors: dbapi.ConnectorList{
+				buildConnector("assigning", dbapi.ConnectorReady, dbapi.ConnectorStatusPhaseAssigning),
+				buildConnector("ready", dbapi.ConnectorReady, dbapi.ConnectorStatusPhaseReady),
+				buildConnector("stopped", dbapi.ConnectorStopped, dbapi.ConnectorStatusPhaseStopped),
+			},
+			targetPhase:      dbapi.ConnectorNamespacePhaseReady,
+			wantConnectorIds: []string{"assigning", "ready", "stopped"},
+		},
+		{
+			name: "connectors already being moved to the target are skipped",
+			connectors: dbapi.ConnectorList{
+				beingMoved,
+				buildConnector("ready", dbapi.ConnectorReady, dbapi.ConnectorStatusPhaseReady),
+			},
+			targetPhase:      dbapi.ConnectorNamespacePhaseReady,
+			wantConnectorIds: []string{"ready"},
+		},
+		{
+			name:             "no connectors",
+			targetPhase:      dbapi.ConnectorNamespacePhaseReady,
+			wantConnectorIds: []string{},
+		},
+		{
+			name: "no connector is moved if one exceeds the target quota",
+			connectors: dbapi.ConnectorList{
+				buildConnector("ready", dbapi.ConnectorReady, dbapi.ConnectorStatusPhaseReady),
+				buildConnector("stopped", dbapi.ConnectorStopped, dbapi.ConnectorStatusPhaseStopped),
+			},
+			targetPhase: dbapi.ConnectorNamespacePhaseReady,
+			quotaErrs:   map[string]*errors.ServiceError{"stopped": errors.InsufficientQuotaError("namespace quota exceeded")},
+			wantErr:     true,
+		},
+		{
+			name: "target namespace not ready",
+			connectors: dbapi.ConnectorList{
+				buildConnector("ready", dbapi.ConnectorReady, dbapi.ConnectorStatusPhaseReady),
+			},
+			targetPhase: dbapi.ConnectorNamespacePhaseDisconnected,
+			wantErr:     true,
+		},
+		{
+			name: "target namespace expires",
+			connectors: dbapi.ConnectorList{
+				buildConnector("ready", dbapi.ConnectorReady, dbapi.ConnectorStatusPhaseReady),
+			},
+			targetPhase:      dbapi.ConnectorNamespacePhaseReady,
+			targetExpiration: &expiration,
+			wantErr:          true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			connectorsService := &services.ConnectorsServiceMock{
+				ForEachFunc: func(f func(*dbapi.Connector) *errors.ServiceError, query string, args ...interface{}) []error {
+					for _, connector := range tt.connectors {
+						// connectors are copied, as they would be read from the database
+						c := *connector
+						_ = f(&c)
+					}
+					return nil
+				},
+			}
+			namespaceService := &services.ConnectorNamespaceServiceMock{
+				GetFunc: func(ctx context.Context, namespaceID string) (*dbapi.ConnectorNamespace, *errors.ServiceError) {
+					namespace := &dbapi.ConnectorNamespace{Status: dbapi.ConnectorNamespaceStatus{Phase: dbapi.ConnectorNamespacePhaseReady}}
+					namespace.ID = namespaceID
+					if namespaceID == targetNamespaceId {
+						namespace.Status.Phase = tt.targetPhase
+						namespace.Expiration = tt.targetExpiration
+					} else {
+						namespace.Expiration = &expiration
+					}
+					return namespace, nil
+				},
+				CheckConnectorsQuotaFunc: func(namespaceId string, connectors dbapi.ConnectorList) ([]*errors.ServiceError, *errors.ServiceError) {
+					result := make([]*errors.ServiceError, len(connectors))
+					for i, connector := range connectors {
+						result[i] = tt.quotaErrs[connector.ID]
+					}
+					return result, nil
+				},
+				MoveConnectorsFunc: func(ctx context.Context, namespace *dbapi.ConnectorNamespace, connectors dbapi.ConnectorList) *errors.ServiceError {
+					return nil
+				},
+			}
+
+			namespace, target, connectorIds, err := convertNamespace(context.Background(), connectorsService, namespaceService, evalNamespaceId, targetNamespaceId)
+			if tt.wantErr {
+				g.Expect(err).To(gomega.HaveOccurred())
+				g.Expect(namespaceService.MoveConnectorsCalls()).To(gomega.BeEmpty())
+				return
+			}
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			g.Expect(namespace.ID).To(gomega.Equal(evalNamespaceId))
+			g.Expect(target.ID).To(gomega.Equal(targetNamespaceId))
+			g.Expect(connectorIds).To(gomega.Equal(tt.wantConnectorIds))
+
+			// all the connectors are moved together
+			calls := namespaceService.MoveConnectorsCalls()
+			g.Expect(calls).To(gomega.HaveLen(1))
+			g.Expect(calls[0].Namespace.ID).To(gomega.Equal(evalNamespaceId))
+			g.Expect(calls[0].Connectors).To(gomega.HaveLen(len(tt.wantConnectorIds)))
+			for _, connector := range calls[0].Connectors {
+				if connector.Status.Phase == dbapi.ConnectorStatusPhaseAssigning {
+					g.Expect(*connector.NamespaceId).To(gomega.Equal(targetNamespaceId))
+				} else {
+					g.Expect(connector.DesiredState).To(gomega.Equal(dbapi.ConnectorUnassigned))
+					g.Expect(*connector.TargetNamespaceId).To(gomega.Equal(targetNamespaceId))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,521 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/authz"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
+	"github.com/goava/di"
+	"github.com/golang/glog"
+	"github.com/gorilla/mux"
+)
+
+const (
+	maxConnectorTemplateIdLength = 32
+	maxConnectorTemplateBulkSize = 100
+)
+
+var (
+	// templateVariablePattern matches the references to variables in templates, e.g. ${topic}
+	templateVariablePattern     = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+	templateVariableNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+)
+
+type ConnectorTemplatesHandler struct {
+	di.Inject
+	Service    services.ConnectorTemplatesService
+	Connectors *ConnectorsHandler
+	AuthZ      authz.AuthZService
+}
+
+func NewConnectorTemplatesHandler(handler ConnectorTemplatesHandler) *ConnectorTemplatesHandler {
+	return &handler
+}
+
+func (h *ConnectorTemplatesHandler) Create(w http.ResponseWriter, r *http.Request) {
+	user := h.AuthZ.GetValidationUser(r.Context())
+
+	var resource public.ConnectorTemplateRequest
+	validations := []handlers.Validate{
+		handlers.Validation("name", &resource.Name, handlers.MinLen(1), handlers.MaxLen(100)),
+		handlers.Validation("channel", (*string)(&resource.Channel), handlers.WithDefault("stable"), handlers.MaxLen(40)),
+		handlers.Validation("connector_type_id", &resource.ConnectorTypeId, handlers.MinLen(1), handlers.MaxLen(maxConnectorTypeIdLength)),
+		handlers.Validation("kafka.id", &resource.Kafka.Id, handlers.MinLen(1), handlers.MaxLen(maxKafkaNameLength)),
+		validateConnectorTemplateType(h.Connectors.connectorTypesService, &resource),
+		validateConnectorTemplateVariables(&resource),
+	}
+	// the bootstrap server is set from the Kafka instance when creating connectors if it can be checked
+	if !h.Connectors.kafkaReferences.Enabled() {
+		validations = append(validations, handlers.Validation("kafka.url", &resource.Kafka.Url, handlers.MinLen(1)))
+	}
+
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &resource,
+		Validate:    validations,
+		Action: func() (interface{}, *errors.ServiceError) {
+			ct, err := h.Connectors.connectorTypesService.Get(resource.ConnectorTypeId)
+			if err != nil {
+				return nil, err
+			}
+			template, err := presenters.ConvertConnectorTemplateRequest(api.NewID(), resource, ct)
+			if err != nil {
+				return nil, err
+			}
+			template.Owner = user.UserId()
+			template.OrganisationId = user.OrgId()
+
+			if err := h.Service.Create(r.Context(), template); err != nil {
+				return nil, err
+			}
+			return presenters.PresentConnectorTemplate(template)
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusCreated)
+}
+
+func (h *ConnectorTemplatesHandler) Get(w http.ResponseWriter, r *http.Request) {
+	templateId := mux.Vars(r)["template_id"]
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			handlers.Validation("template_id", &templateId, handlers.MinLen(1), handlers.MaxLen(maxConnectorTemplateIdLength)),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			template, err := h.Service.Get(r.Context(), templateId)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentConnectorTemplate(template)
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+func (h *ConnectorTemplatesHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			listArgs := coreServices.NewListArguments(r.URL.Query())
+			templates, paging, err := h.Service.List(r.Context(), listArgs)
+			if err != nil {
+				return nil, err
+			}
+
+			resourceList := public.ConnectorTemplateList{
+				Kind:  "ConnectorTemplateList",
+				Page:  int32(paging.Page),
+				Size:  int32(paging.Size),
+				Total: int32(paging.Total),
+				Items: make([]public.ConnectorTemplate, 0, len(templates)),
+			}
+			for _, template := range templates {
+				converted, err := presenters.PresentConnectorTemplate(template)
+				if err != nil {
+					glog.Errorf("connector template id='%s' presentation failed: %v", template.ID, err)
+					return nil, errors.GeneralError("internal error")
+				}
+				resourceList.Items = append(resourceList.Items, converted)
+			}
+			return resourceList, nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
+
+func (h *ConnectorTemplatesHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	templateId := mux.Vars(r)["template_id"]
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			handlers.Validation("template_id", &templateId, handlers.MinLen(1), handlers.MaxLen(maxConnectorTemplateIdLength)),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			return nil, h.Service.Delete(r.Context(), templateId)
+		},
+	}
+	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
+}
+
+// CreateConnector creates a connector from a template
+func (h *ConnectorTemplatesHandler) CreateConnector(w http.ResponseWriter, r *http.Request) {
+	templateId := mux.Vars(r)["template_id"]
+	user := h.AuthZ.GetValidationUser(r.Context())
+
+	var request public.ConnectorTemplateInstanceRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &request,
+		Validate: []handlers.Validate{
+			handlers.ValidateAsyncEnabled(r, "creating connector"),
+			handlers.Validation("template_id", &templateId, handlers.MinLen(1), handlers.MaxLen(maxConnectorTemplateIdLength)),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			ctx := r.Context()
+			template, err := h.Service.Get(ctx, templateId)
+			if err != nil {
+				return nil, err
+			}
+
+			connector, ct, errs := h.prepareConnector(ctx, user, template, request)
+			if len(errs) > 0 {
+				return nil, errs[0]
+			}
+			if err := moveSecretsToVault(connector, ct, h.Connectors.vaultService, true); err != nil {
+				return nil, err
+			}
+			if err := h.Connectors.connectorsService.Create(ctx, connector); err != nil {
+				return nil, err
+			}
+			if err := stripSecretReferences(connector, ct); err != nil {
+				return nil, err
+			}
+			return presenters.PresentConnector(connector)
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusAccepted)
+}
+
+// BulkCreateConnectors creates many connectors from a template in a single transaction.
+// All the items are validated first, and no connector is created if any of them is invalid.
+func (h *ConnectorTemplatesHandler) BulkCreateConnectors(w http.ResponseWriter, r *http.Request) {
+	templateId := mux.Vars(r)["template_id"]
+	user := h.AuthZ.GetValidationUser(r.Context())
+
+	var request public.ConnectorTemplateBulkRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &request,
+		Validate: []handlers.Validate{
+			handlers.ValidateAsyncEnabled(r, "creating connectors"),
+			handlers.Validation("template_id", &templateId, handlers.MinLen(1), handlers.MaxLen(maxConnectorTemplateIdLength)),
+			func() *errors.ServiceError {
+				if len(request.Items) == 0 || len(request.Items) > maxConnectorTemplateBulkSize {
+					return errors.BadRequest("items is not valid. Must contain between 1 and %d items", maxConnectorTemplateBulkSize)
+				}
+				return nil
+			},
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			ctx := r.Context()
+			template, err := h.Service.Get(ctx, templateId)
+			if err != nil {
+				return nil, err
+			}
+
+			result := public.ConnectorTemplateBulkResult{
+				Kind:  "ConnectorTemplateBulkResult",
+				Items: make([]public.ConnectorTemplateBulkItemResult, len(request.Items)),
+			}
+			connectors := make(dbapi.ConnectorList, len(request.Items))
+			connectorTypes := make([]*dbapi.ConnectorType, len(request.Items))
+			valid := true
+			for i, item := range request.Items {
+				result.Items[i].Index = int32(i)
+				connector, ct, errs := h.prepareConnector(ctx, user, template, item)
+				for _, err := range errs {
+					result.Items[i].Errors = append(result.Items[i].Errors, err.Reason)
+				}
+				connectors[i], connectorTypes[i] = connector, ct
+				valid = valid && len(errs) == 0
+			}
+			if !valid {
+				return result, nil
+			}
+
+			// each item was checked against the quota on its own, check the items sharing a namespace fit in it together
+			quotaErrs, err := h.checkBulkQuota(connectors)
+			if err != nil {
+				return nil, err
+			}
+			if len(quotaErrs) > 0 {
+				for i, reason := range quotaErrs {
+					result.Items[i].Errors = append(result.Items[i].Errors, reason)
+				}
+				return result, nil
+			}
+
+			for i, connector := range connectors {
+				if err := moveSecretsToVault(connector, connectorTypes[i], h.Connectors.vaultService, true); err != nil {
+					h.deleteSecrets(connectors[:i+1], connectorTypes)
+					return nil, err
+				}
+			}
+			if err := h.Connectors.connectorsService.CreateAll(ctx, connectors); err != nil {
+				h.deleteSecrets(connectors, connectorTypes)
+				return nil, err
+			}
+
+			result.Created = true
+			for i, connector := range connectors {
+				if err := stripSecretReferences(connector, connectorTypes[i]); err != nil {
+					return nil, err
+				}
+				presented, err := presenters.PresentConnector(connector)
+				if err != nil {
+					return nil, err
+				}
+				result.Items[i].Connector = &presented
+			}
+			return result, nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+// prepareConnector instantiates the template and validates the resulting connector request as a connector creation would,
+// returning all the validation errors of the request
+func (h *ConnectorTemplatesHandler) prepareConnector(ctx context.Context, user *authz.ValidationUser, template *dbapi.ConnectorTemplate,
+	request public.ConnectorTemplateInstanceRequest) (*dbapi.Connector, *dbapi.ConnectorType, []*errors.ServiceError) {
+
+	resource, err := instantiateConnectorTemplate(template, request)
+	if err != nil {
+		return nil, nil, []*errors.ServiceError{err}
+	}
+
+	var errs []*errors.ServiceError
+	for _, validate := range h.Connectors.connectorRequestValidations(user, &resource) {
+		if err := validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return nil, nil, errs
+	}
+
+	connector, ct, err := h.Connectors.convertConnectorRequest(ctx, user, resource)
+	if err != nil {
+		return nil, nil, []*errors.ServiceError{err}
+	}
+	return connector, ct, nil
+}
+
+// checkBulkQuota returns the reason each connector doesn't fit in the quota of its namespace,
+// together with the connectors created before it in the same namespace
+func (h *ConnectorTemplatesHandler) checkBulkQuota(connectors dbapi.ConnectorList) (map[int]string, *errors.ServiceError) {
+	byNamespace := make(map[string][]int)
+	var namespaceIds []string
+	for i, connector := range connectors {
+		if connector.NamespaceId != nil {
+			if _, ok := byNamespace[*connector.NamespaceId]; !ok {
+				namespaceIds = append(namespaceIds, *connector.NamespaceId)
+			}
+			byNamespace[*connector.NamespaceId] = append(byNamespace[*connector.NamespaceId], i)
+		}
+	}
+
+	reasons := make(map[int]string)
+	for _, namespaceId := range namespaceIds {
+		indexes := byNamespace[namespaceId]
+		namespaceConnectors := make(dbapi.ConnectorList, len(indexes))
+		for j, i := range indexes {
+			namespaceConnectors[j] = connectors[i]
+		}
+		quotaErrs, err := h.Connectors.namespaceService.CheckConnectorsQuota(namespaceId, namespaceConnectors)
+		if err != nil {
+			return nil, err
+		}
+		for j, quotaErr := range quotaErrs {
+			if quotaErr != nil {
+				reasons[indexes[j]] = fmt.Sprintf("namespace %s quota exceeded: %s", namespaceId, quotaErr.Reason)
+			}
+		}
+	}
+	return reasons, nil
+}
+
+// deleteSecrets removes the secrets of connectors that failed to be created from the vault
+func (h *ConnectorTemplatesHandler) deleteSecrets(connectors dbapi.ConnectorList, connectorTypes []*dbapi.ConnectorType) {
+	for i, connector := range connectors {
+		refs, err := getSecretRefs(connector, connectorTypes[i])
+		if err != nil {
+			glog.Errorf("failed to get secrets of connector %s: %v", connector.ID, err)
+			continue
+		}
+		for _, ref := range refs {
+			if err := h.Connectors.vaultService.DeleteSecretString(ref); err != nil {
+				glog.Errorf("failed to delete vault secret key '%s': %v", ref, err)
+			}
+		}
+	}
+}
+
+func validateConnectorTemplateType(connectorTypesService services.ConnectorTypesService, resource *public.ConnectorTemplateRequest) handlers.Validate {
+	return func() *errors.ServiceError {
+		ct, err := connectorTypesService.Get(resource.ConnectorTypeId)
+		if err != nil {
+			return errors.BadRequest("invalid connector type id: %s", resource.ConnectorTypeId)
+		}
+		if !arrays.Contains(ct.ChannelNames(), string(resource.Channel)) {
+			return errors.BadRequest("channel is not valid. Must be one of: %s", strings.Join(ct.ChannelNames(), ", "))
+		}
+		return nil
+	}
+}
+
+// validateConnectorTemplateVariables checks the variables are uniquely named, that their defaults are valid values of their types,
+// and that templates only reference declared variables
+func validateConnectorTemplateVariables(resource *public.ConnectorTemplateRequest) handlers.Validate {
+	return func() *errors.ServiceError {
+		declared := make(map[string]bool, len(resource.Variables))
+		for _, v := range resource.Variables {
+			if !templateVariableNamePattern.MatchString(v.Name) {
+				return errors.BadRequest("variable name %q is not valid. Must match %s", v.Name, templateVariableNamePattern)
+			}
+			if declared[v.Name] {
+				return errors.BadRequest("variable %s is declared more than once", v.Name)
+			}
+			declared[v.Name] = true
+			if v.Type != "" && !arrays.Contains(dbapi.ValidConnectorTemplateVariableTypes, v.Type) {
+				return errors.BadRequest("type %q of variable %s is not valid. Must be one of: %s", v.Type, v.Name,
+					strings.Join(dbapi.ValidConnectorTemplateVariableTypes, ", "))
+			}
+			if v.Default != "" {
+				if _, err := templateVariable(v).Convert(v.Default); err != nil {
+					return errors.BadRequest("invalid default: %v", err)
+				}
+			}
+		}
+
+		var undeclared []string
+		check := func(s string) string {
+			for _, match := range templateVariablePattern.FindAllStringSubmatch(s, -1) {
+				if !declared[match[1]] {
+					undeclared = append(undeclared, match[1])
+				}
+			}
+			return s
+		}
+		check(resource.ConnectorName)
+		substituteTemplateStrings(resource.Connector, func(s string) interface{} {
+			return check(s)
+		})
+		if len(undeclared) > 0 {
+			sort.Strings(undeclared)
+			return errors.BadRequest("variables %v are referenced but not declared", undeclared)
+		}
+		return nil
+	}
+}
+
+// instantiateConnectorTemplate returns the request to create a connector from a template, with its variables substituted
+func instantiateConnectorTemplate(template *dbapi.ConnectorTemplate, request public.ConnectorTemplateInstanceRequest) (public.ConnectorRequest, *errors.ServiceError) {
+	values, err := resolveTemplateVariables(template.Variables, request.Variables)
+	if err != nil {
+		return public.ConnectorRequest{}, err
+	}
+	substitute := func(s string) string {
+		return templateVariablePattern.ReplaceAllStringFunc(s, func(reference string) string {
+			return values[reference[2:len(reference)-1]]
+		})
+	}
+	// a typed variable referenced as an entire value is substituted with a value of its type, e.g. "${tasks}" with 3,
+	// or with null when the variable is not set and has no default
+	substituteValue := func(s string) interface{} {
+		if match := templateVariablePattern.FindStringSubmatch(s); match != nil && match[0] == s {
+			for _, v := range template.Variables {
+				if v.Name == match[1] && !v.IsString() {
+					if values[v.Name] == "" {
+						return nil
+					}
+					// values are checked by resolveTemplateVariables
+					value, _ := v.Convert(values[v.Name])
+					return value
+				}
+			}
+		}
+		return substitute(s)
+	}
+
+	spec := map[string]interface{}{}
+	if err := template.ConnectorSpec.Unmarshal(&spec); err != nil {
+		return public.ConnectorRequest{}, errors.GeneralError("invalid connector spec of connector template %s: %v", template.ID, err)
+	}
+
+	name := request.Name
+	if name == "" {
+		name = template.ConnectorName
+		if name == "" {
+			name = template.Name
+		}
+		name = substitute(name)
+	}
+	serviceAccount := request.ServiceAccount
+	if serviceAccount.ClientId == "" {
+		serviceAccount.ClientId = template.ServiceAccountClientId
+	}
+
+	return public.ConnectorRequest{
+		Name:            name,
+		ConnectorTypeId: template.ConnectorTypeId,
+		NamespaceId:     request.NamespaceId,
+		Channel:         public.Channel(template.Channel),
+		DesiredState:    request.DesiredState,
+		Annotations:     request.Annotations,
+		Kafka: public.KafkaConnectionSettings{
+			Id:  template.Kafka.KafkaID,
+			Url: template.Kafka.BootstrapServer,
+		},
+		ServiceAccount: serviceAccount,
+		SchemaRegistry: public.SchemaRegistryConnectionSettings{
+			Id:  template.SchemaRegistry.SchemaRegistryID,
+			Url: template.SchemaRegistry.Url,
+		},
+		Connector: substituteTemplateStrings(spec, substituteValue).(map[string]interface{}),
+	}, nil
+}
+
+// resolveTemplateVariables returns the value of every declared variable, either set in the request or its default
+func resolveTemplateVariables(declared dbapi.ConnectorTemplateVariables, values map[string]string) (map[string]string, *errors.ServiceError) {
+	resolved := make(map[string]string, len(declared))
+	for _, v := range declared {
+		value, found := values[v.Name]
+		if !found {
+			if v.Required {
+				return nil, errors.BadRequest("variable %s is required", v.Name)
+			}
+			value = v.Default
+		}
+		// optional variables without a default may be left unset
+		if value != "" || found {
+			if _, err := v.Convert(value); err != nil {
+				return nil, errors.BadRequest("%v", err)
+			}
+		}
+		resolved[v.Name] = value
+	}
+	for name := range values {
+		if _, found := resolved[name]; !found {
+			return nil, errors.BadRequest("variable %s is not declared by the template", name)
+		}
+	}
+	return resolved, nil
+}
+
+// substituteTemplateStrings replaces all the string values of a json document with their substitution, keys are left unchanged
+func substituteTemplateStrings(node interface{}, substitute func(string) interface{}) interface{} {
+	switch value := node.(type) {
+	case string:
+		return substitute(value)
+	case map[string]interface{}:
+		for k, v := range value {
+			value[k] = substituteTemplateStrings(v, substitute)
+		}
+	case []interface{}:
+		for i, v := range value {
+			value[i] = substituteTemplateStrings(v, substitute)
+		}
+	}
+	return node
+}
+
+func templateVariable(from public.ConnectorTemplateVariable) dbapi.ConnectorTemplateVariable {
+	return dbapi.ConnectorTemplateVariable{Name: from.Name, Type: dbapi.ConnectorTemplateVariableType(from.Type)}
+}
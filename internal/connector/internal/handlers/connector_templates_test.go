@@ -0,0 +1,220 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/onsi/gomega"
+)
+
+func Test_instantiateConnectorTemplate(t *testing.T) {
+	template := &dbapi.ConnectorTemplate{
+		Name:                   "topic-sink",
+		ConnectorName:          "sink-${topic}",
+		ConnectorTypeId:        "log_sink_0.1",
+		Channel:                "stable",
+		ConnectorSpec:          api.JSON(`{"kafka_topic": "${topic}", "log_level": "${level}", "options": ["${topic}-dlq", 5]}`),
+		Kafka:                  dbapi.KafkaConnectionSettings{KafkaID: "kafka", BootstrapServer: "kafka:9092"},
+		ServiceAccountClientId: "template-client",
+		Variables: dbapi.ConnectorTemplateVariables{
+			{Name: "topic", Required: true},
+			{Name: "level", Default: "INFO"},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		request      public.ConnectorTemplateInstanceRequest
+		wantErr      bool
+		wantName     string
+		wantSpec     map[string]interface{}
+		wantClientId string
+	}{
+		{
+			name: "defaults",
+			request: public.ConnectorTemplateInstanceRequest{
+				NamespaceId:    "namespace",
+				ServiceAccount: public.ServiceAccount{ClientSecret: "secret"},
+				Variables:      map[string]string{"topic": "orders"},
+			},
+			wantName:     "sink-orders",
+			wantSpec:     map[string]interface{}{"kafka_topic": "orders", "log_level": "INFO", "options": []interface{}{"orders-dlq", float64(5)}},
+			wantClientId: "template-client",
+		},
+		{
+			name: "overrides",
+			request: public.ConnectorTemplateInstanceRequest{
+				Name:           "my-sink",
+				ServiceAccount: public.ServiceAccount{ClientId: "client", ClientSecret: "secret"},
+				Variables:      map[string]string{"topic": "payments", "level": "DEBUG"},
+			},
+			wantName:     "my-sink",
+			wantSpec:     map[string]interface{}{"kafka_topic": "payments", "log_level": "DEBUG", "options": []interface{}{"payments-dlq", float64(5)}},
+			wantClientId: "client",
+		},
+		{
+			name:    "missing required variable",
+			request: public.ConnectorTemplateInstanceRequest{Variables: map[string]string{"level": "DEBUG"}},
+			wantErr: true,
+		},
+		{
+			name:    "undeclared variable",
+			request: public.ConnectorTemplateInstanceRequest{Variables: map[string]string{"topic": "orders", "partition": "1"}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			resource, err := instantiateConnectorTemplate(template, tt.request)
+			if tt.wantErr {
+				g.Expect(err).To(gomega.HaveOccurred())
+				return
+			}
+			g.Expect(err).To(gomega.BeNil())
+			g.Expect(resource.Name).To(gomega.Equal(tt.wantName))
+			g.Expect(resource.Connector).To(gomega.Equal(tt.wantSpec))
+			g.Expect(resource.ServiceAccount.ClientId).To(gomega.Equal(tt.wantClientId))
+			g.Expect(resource.ConnectorTypeId).To(gomega.Equal(template.ConnectorTypeId))
+			g.Expect(resource.Kafka.Url).To(gomega.Equal(template.Kafka.BootstrapServer))
+		})
+	}
+}
+
+func Test_instantiateConnectorTemplate_typedVariables(t *testing.T) {
+	template := &dbapi.ConnectorTemplate{
+		Name:            "topic-sink",
+		ConnectorName:   "sink-${tasks}",
+		ConnectorTypeId: "log_sink_0.1",
+		Channel:         "stable",
+		ConnectorSpec:   api.JSON(`{"tasks_max": "${tasks}", "ratio": "${ratio}", "enabled": "${enabled}", "description": "${tasks} tasks", "timeout": "${timeout}"}`),
+		Variables: dbapi.ConnectorTemplateVariables{
+			{Name: "tasks", Type: dbapi.ConnectorTemplateVariableInteger, Default: "1"},
+			{Name: "ratio", Type: dbapi.ConnectorTemplateVariableNumber, Default: "0.5"},
+			{Name: "enabled", Type: dbapi.ConnectorTemplateVariableBoolean, Required: true},
+			{Name: "timeout", Type: dbapi.ConnectorTemplateVariableInteger},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		request  public.ConnectorTemplateInstanceRequest
+		wantErr  bool
+		wantName string
+		wantSpec map[string]interface{}
+	}{
+		{
+			name:     "defaults",
+			request:  public.ConnectorTemplateInstanceRequest{Variables: map[string]string{"enabled": "true"}},
+			wantName: "sink-1",
+			wantSpec: map[string]interface{}{"tasks_max": int64(1), "ratio": 0.5, "enabled": true, "description": "1 tasks", "timeout": nil},
+		},
+		{
+			name:     "overrides",
+			request:  public.ConnectorTemplateInstanceRequest{Variables: map[string]string{"tasks": "3", "ratio": "2", "enabled": "false", "timeout": "30"}},
+			wantName: "sink-3",
+			wantSpec: map[string]interface{}{"tasks_max": int64(3), "ratio": float64(2), "enabled": false, "description": "3 tasks", "timeout": int64(30)},
+		},
+		{
+			name:    "invalid integer",
+			request: public.ConnectorTemplateInstanceRequest{Variables: map[string]string{"tasks": "three", "enabled": "true"}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid boolean",
+			request: public.ConnectorTemplateInstanceRequest{Variables: map[string]string{"enabled": "yes"}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			resource, err := instantiateConnectorTemplate(template, tt.request)
+			if tt.wantErr {
+				g.Expect(err).To(gomega.HaveOccurred())
+				return
+			}
+			g.Expect(err).To(gomega.BeNil())
+			g.Expect(resource.Name).To(gomega.Equal(tt.wantName))
+			g.Expect(resource.Connector).To(gomega.Equal(tt.wantSpec))
+		})
+	}
+}
+
+func Test_validateConnectorTemplateVariables(t *testing.T) {
+	tests := []struct {
+		name     string
+		resource public.ConnectorTemplateRequest
+		wantErr  bool
+	}{
+		{
+			name: "declared variables",
+			resource: public.ConnectorTemplateRequest{
+				ConnectorName: "sink-${topic}",
+				Connector:     map[string]interface{}{"kafka_topic": "${topic}"},
+				Variables:     []public.ConnectorTemplateVariable{{Name: "topic"}},
+			},
+		},
+		{
+			name: "undeclared variable in spec",
+			resource: public.ConnectorTemplateRequest{
+				Connector: map[string]interface{}{"nested": map[string]interface{}{"kafka_topic": "${topic}"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "undeclared variable in connector name",
+			resource: public.ConnectorTemplateRequest{
+				ConnectorName: "sink-${topic}",
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicate variable",
+			resource: public.ConnectorTemplateRequest{
+				Variables: []public.ConnectorTemplateVariable{{Name: "topic"}, {Name: "topic"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "typed variables",
+			resource: public.ConnectorTemplateRequest{
+				Connector: map[string]interface{}{"tasks_max": "${tasks}", "enabled": "${enabled}"},
+				Variables: []public.ConnectorTemplateVariable{{Name: "tasks", Type: "integer", Default: "2"}, {Name: "enabled", Type: "boolean"}},
+			},
+		},
+		{
+			name: "invalid variable type",
+			resource: public.ConnectorTemplateRequest{
+				Variables: []public.ConnectorTemplateVariable{{Name: "tasks", Type: "int"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "default not matching the variable type",
+			resource: public.ConnectorTemplateRequest{
+				Variables: []public.ConnectorTemplateVariable{{Name: "tasks", Type: "integer", Default: "two"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid variable name",
+			resource: public.ConnectorTemplateRequest{
+				Variables: []public.ConnectorTemplateVariable{{Name: "kafka-topic"}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			err := validateConnectorTemplateVariables(&tt.resource)()
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr), "validateConnectorTemplateVariables() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
@@ -59,10 +59,11 @@ func (h ConnectorTypesHandler) List(w http.ResponseWriter, r *http.Request) {
 			}
 
 			resourceList := public.ConnectorTypeList{
-				Kind:  "ConnectorTypeList",
-				Page:  int32(paging.Page),
-				Size:  int32(paging.Size),
-				Total: int32(paging.Total),
+				Kind:       "ConnectorTypeList",
+				Page:       int32(paging.Page),
+				Size:       int32(paging.Size),
+				Total:      int32(paging.Total),
+				NextCursor: paging.NextCursor,
 			}
 
 			for _, resource := range resources {
@@ -19,14 +19,18 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
 	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/secrets"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/cron"
 	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/golang/glog"
 	"github.com/gorilla/mux"
 	"github.com/spyzhov/ajson"
 	"io"
 	"net/http"
+	"net/url"
 	"reflect"
+	"strconv"
 	"strings"
+	"time"
 )
 
 const (
@@ -35,12 +39,18 @@ const (
 	APPLICATION_JSON     = "application/json"
 	JSON_PATCH           = "application/json-patch+json"
 	MERGE_PATCH          = "application/merge-patch+json"
+
+	defaultConnectorLogsTail = 100
+	maxConnectorLogsTail     = 1000
 )
 
 type ConnectorsHandler struct {
 	connectorsService     services.ConnectorsService
 	connectorTypesService services.ConnectorTypesService
 	namespaceService      services.ConnectorNamespaceService
+	logsService           services.ConnectorLogsService
+	kafkaReferences       services.KafkaReferencesService
+	schedulesService      services.ConnectorSchedulesService
 	vaultService          vault.VaultService
 	authZService          authz.AuthZService
 	connectorsConfig      *config.ConnectorsConfig
@@ -54,12 +64,16 @@ var stateToOperationsMap = map[public.ConnectorDesiredState]phase.ConnectorOpera
 }
 
 func NewConnectorsHandler(connectorsService services.ConnectorsService, connectorTypesService services.ConnectorTypesService,
-	namespaceService services.ConnectorNamespaceService, vaultService vault.VaultService, authZService authz.AuthZService,
-	connectorsConfig *config.ConnectorsConfig) *ConnectorsHandler {
+	namespaceService services.ConnectorNamespaceService, logsService services.ConnectorLogsService,
+	kafkaReferences services.KafkaReferencesService, schedulesService services.ConnectorSchedulesService,
+	vaultService vault.VaultService, authZService authz.AuthZService, connectorsConfig *config.ConnectorsConfig) *ConnectorsHandler {
 	return &ConnectorsHandler{
 		connectorsService:     connectorsService,
 		connectorTypesService: connectorTypesService,
 		namespaceService:      namespaceService,
+		logsService:           logsService,
+		kafkaReferences:       kafkaReferences,
+		schedulesService:      schedulesService,
 		vaultService:          vaultService,
 		authZService:          authZService,
 		connectorsConfig:      connectorsConfig,
@@ -74,50 +88,14 @@ func (h ConnectorsHandler) Create(w http.ResponseWriter, r *http.Request) {
 	cfg := &handlers.HandlerConfig{
 
 		MarshalInto: &resource,
-		Validate: []handlers.Validate{
+		Validate: append([]handlers.Validate{
 			handlers.ValidateAsyncEnabled(r, "creating connector"),
-			handlers.Validation("channel", (*string)(&resource.Channel), handlers.WithDefault("stable"), handlers.MaxLen(40)),
-			handlers.Validation("name", &resource.Name, handlers.WithDefault("New Connector"), handlers.MinLen(1), handlers.MaxLen(100)),
-			handlers.Validation("kafka.id", &resource.Kafka.Id, handlers.MinLen(1), handlers.MaxLen(maxKafkaNameLength)),
-			handlers.Validation("kafka.url", &resource.Kafka.Url, handlers.MinLen(1)),
-			handlers.Validation("service_account.client_id", &resource.ServiceAccount.ClientId, handlers.MinLen(1)),
-			handlers.Validation("service_account.client_secret", &resource.ServiceAccount.ClientSecret, handlers.MinLen(1)),
-			handlers.Validation("connector_type_id", &resource.ConnectorTypeId, handlers.MinLen(1), handlers.MaxLen(maxConnectorTypeIdLength)),
-			handlers.Validation("desired_state", (*string)(&resource.DesiredState), handlers.WithDefault("ready"), handlers.IsOneOf(dbapi.ValidDesiredStates...)),
-			validateConnectorRequest(h.connectorTypesService, &resource),
-			handlers.Validation("namespace_id", &resource.NamespaceId,
-				handlers.MaxLen(maxConnectorNamespaceIdLength), user.AuthorizedNamespaceUser(errors.ErrorBadRequest), user.ValidateNamespaceConnectorQuota()),
-			validateCreateAnnotations(resource.Annotations),
-		},
+		}, h.connectorRequestValidations(user, &resource)...),
 
 		Action: func() (interface{}, *errors.ServiceError) {
 
-			// validate type id first
-			ct, err := h.connectorTypesService.Get(resource.ConnectorTypeId)
+			convResource, ct, err := h.convertConnectorRequest(r.Context(), user, resource)
 			if err != nil {
-				return nil, errors.BadRequest("invalid connector type id: %s", resource.ConnectorTypeId)
-			}
-
-			newID := api.NewID()
-			addSystemAnnotations(&resource.Annotations, user)
-			// copy type annotations to connector, e.g. for pricing
-			for _, a := range ct.Annotations {
-				resource.Annotations[a.Key] = a.Value
-			}
-
-			convResource, err := presenters.ConvertConnectorRequest(newID, resource)
-			if err != nil {
-				return nil, err
-			}
-
-			convResource.Owner = user.UserId()
-			convResource.OrganisationId = user.OrgId()
-
-			// namespace id is a required field if unassigned connectors are not supported
-			if !h.connectorsConfig.ConnectorEnableUnassignedConnectors && (convResource.NamespaceId == nil || *convResource.NamespaceId == "") {
-				return nil, errors.MinimumFieldLengthNotReached("namespace_id is not valid. Minimum length 1 is required.")
-			}
-			if err := ValidateConnectorOperation(r.Context(), h.namespaceService, convResource, phase.CreateConnector); err != nil {
 				return nil, err
 			}
 
@@ -138,12 +116,75 @@ func (h ConnectorsHandler) Create(w http.ResponseWriter, r *http.Request) {
 		},
 	}
 
+	// return 202 status accepted
+	handlers.Handle(w, r, cfg, http.StatusAccepted)
+}
+
+// connectorRequestValidations validates a request to create a connector, and sets the defaults of its optional fields
+func (h ConnectorsHandler) connectorRequestValidations(user *authz.ValidationUser, resource *public.ConnectorRequest) []handlers.Validate {
+	validations := []handlers.Validate{
+		handlers.Validation("channel", (*string)(&resource.Channel), handlers.WithDefault("stable"), handlers.MaxLen(40)),
+		handlers.Validation("name", &resource.Name, handlers.WithDefault("New Connector"), handlers.MinLen(1), handlers.MaxLen(100)),
+		handlers.Validation("kafka.id", &resource.Kafka.Id, handlers.MinLen(1), handlers.MaxLen(maxKafkaNameLength)),
+		handlers.Validation("service_account.client_id", &resource.ServiceAccount.ClientId, handlers.MinLen(1)),
+		handlers.Validation("service_account.client_secret", &resource.ServiceAccount.ClientSecret, handlers.MinLen(1)),
+		handlers.Validation("connector_type_id", &resource.ConnectorTypeId, handlers.MinLen(1), handlers.MaxLen(maxConnectorTypeIdLength)),
+		handlers.Validation("desired_state", (*string)(&resource.DesiredState), handlers.WithDefault("ready"), handlers.IsOneOf(dbapi.ValidDesiredStates...)),
+		validateConnectorRequest(h.connectorTypesService, resource),
+		handlers.Validation("namespace_id", &resource.NamespaceId,
+			handlers.MaxLen(maxConnectorNamespaceIdLength), user.AuthorizedNamespaceUser(errors.ErrorBadRequest), user.ValidateNamespaceConnectorQuota(&resource.ConnectorTypeId, (*string)(&resource.Channel))),
+		validateCreateAnnotations(resource.Annotations),
+		validateConnectorSchedule(&resource.Schedule),
+	}
+
+	// the bootstrap server is set from the Kafka instance when it can be checked
+	if !h.kafkaReferences.Enabled() {
+		validations = append(validations, handlers.Validation("kafka.url", &resource.Kafka.Url, handlers.MinLen(1)))
+	}
 	if len(h.connectorsConfig.ConnectorsSupportedChannels) > 0 {
-		cfg.Validate = append(cfg.Validate, handlers.Validation("channel", (*string)(&resource.Channel), handlers.WithDefault("stable"), handlers.IsOneOf(h.connectorsConfig.ConnectorsSupportedChannels...)))
+		validations = append(validations, handlers.Validation("channel", (*string)(&resource.Channel), handlers.WithDefault("stable"), handlers.IsOneOf(h.connectorsConfig.ConnectorsSupportedChannels...)))
 	}
+	return validations
+}
 
-	// return 202 status accepted
-	handlers.Handle(w, r, cfg, http.StatusAccepted)
+// convertConnectorRequest converts a validated request to a new connector owned by the user, and checks it can be created.
+// Its secrets aren't moved to the vault yet.
+func (h ConnectorsHandler) convertConnectorRequest(ctx context.Context, user *authz.ValidationUser, resource public.ConnectorRequest) (*dbapi.Connector, *dbapi.ConnectorType, *errors.ServiceError) {
+	// validate type id first
+	ct, err := h.connectorTypesService.Get(resource.ConnectorTypeId)
+	if err != nil {
+		return nil, nil, errors.BadRequest("invalid connector type id: %s", resource.ConnectorTypeId)
+	}
+
+	newID := api.NewID()
+	addSystemAnnotations(&resource.Annotations, user)
+	// copy type annotations to connector, e.g. for pricing
+	for _, a := range ct.Annotations {
+		resource.Annotations[a.Key] = a.Value
+	}
+
+	convResource, err := presenters.ConvertConnectorRequest(newID, resource)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	convResource.Owner = user.UserId()
+	convResource.OrganisationId = user.OrgId()
+
+	// namespace id is a required field if unassigned connectors are not supported,
+	// unless connectors created without a namespace are scheduled to one by the connector manager
+	if !h.connectorsConfig.ConnectorEnableUnassignedConnectors && !h.connectorsConfig.ConnectorEnableNamespaceScheduling &&
+		(convResource.NamespaceId == nil || *convResource.NamespaceId == "") {
+		return nil, nil, errors.MinimumFieldLengthNotReached("namespace_id is not valid. Minimum length 1 is required.")
+	}
+	// the schema registry of the connector is not checked, schema registries aren't managed by the fleet manager
+	if err := h.kafkaReferences.ValidateConnectorKafka(ctx, convResource); err != nil {
+		return nil, nil, err
+	}
+	if err := ValidateConnectorOperation(ctx, h.namespaceService, convResource, phase.CreateConnector); err != nil {
+		return nil, nil, err
+	}
+	return convResource, ct, nil
 }
 
 func (h ConnectorsHandler) Patch(w http.ResponseWriter, r *http.Request) {
@@ -236,6 +277,7 @@ func (h ConnectorsHandler) Patch(w http.ResponseWriter, r *http.Request) {
 			resource.Kafka = patch.Kafka
 			resource.ServiceAccount = patch.ServiceAccount
 			resource.SchemaRegistry = patch.SchemaRegistry
+			resource.Schedule = patch.Schedule
 
 			if h.connectorsConfig.ConnectorEnableUnassignedConnectors {
 				// check namespace id change, from unassigned to assigned and vice versa
@@ -257,6 +299,7 @@ func (h ConnectorsHandler) Patch(w http.ResponseWriter, r *http.Request) {
 				validateConnectorImmutableProperties(patch, originalResource),
 				validatePatchAnnotations(resource.Annotations, originalResource.Annotations),
 				validateConnector(h.connectorTypesService, &resource),
+				validateConnectorSchedule(&resource.Schedule),
 			}
 
 			// Don't validate user's tenancy in admin api calls
@@ -271,6 +314,23 @@ func (h ConnectorsHandler) Patch(w http.ResponseWriter, r *http.Request) {
 				}
 			}
 
+			// check the Kafka of connectors being restarted or changed to another Kafka
+			if resource.Kafka.Id != originalResource.Kafka.Id || (resource.DesiredState == public.CONNECTORDESIREDSTATE_READY &&
+				originalResource.DesiredState != public.CONNECTORDESIREDSTATE_READY) {
+				kafkaConnector := dbresource.Connector
+				kafkaConnector.Kafka.KafkaID = resource.Kafka.Id
+				if serr = h.kafkaReferences.ValidateConnectorKafka(r.Context(), &kafkaConnector); serr != nil {
+					return nil, serr
+				}
+				if h.kafkaReferences.Enabled() {
+					resource.Kafka.Url = kafkaConnector.Kafka.BootstrapServer
+					dbresource.Status.Conditions = dbresource.Status.Conditions.Without(dbapi.ConnectorKafkaAvailableCondition)
+				}
+			} else if h.kafkaReferences.Enabled() {
+				// the bootstrap server is always the one of the Kafka, it can't be changed on its own
+				resource.Kafka.Url = originalResource.Kafka.Url
+			}
+
 			// If we didn't change anything, then just skip the update...
 			if reflect.DeepEqual(originalResource, resource) {
 				return originalResource, nil
@@ -280,6 +340,13 @@ func (h ConnectorsHandler) Patch(w http.ResponseWriter, r *http.Request) {
 			if svcErr != nil {
 				return nil, svcErr
 			}
+			// keep the conditions set by the fleet manager when the status is saved with the connector
+			p.Status.Conditions = dbresource.Status.Conditions
+			// keep the next transitions of an unchanged schedule, so that due transitions aren't skipped
+			if p.Schedule != nil && dbresource.Schedule != nil && p.Schedule.Start == dbresource.Schedule.Start &&
+				p.Schedule.Stop == dbresource.Schedule.Stop && p.Schedule.Timezone == dbresource.Schedule.Timezone {
+				p.Schedule = dbresource.Schedule
+			}
 
 			svcErr = moveSecretsToVault(p, ct, h.vaultService, false)
 			if svcErr != nil {
@@ -508,6 +575,75 @@ func (h ConnectorsHandler) Get(w http.ResponseWriter, r *http.Request) {
 	handlers.HandleGet(w, r, cfg)
 }
 
+// Metrics is the handler for getting the throughput, errors, lag and task states of a connector
+func (h ConnectorsHandler) Metrics(w http.ResponseWriter, r *http.Request) {
+	connectorId := mux.Vars(r)["connector_id"]
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			handlers.Validation("connector_id", &connectorId, handlers.MinLen(1), handlers.MaxLen(maxConnectorIdLength)),
+		},
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			if _, err := h.connectorsService.Get(r.Context(), connectorId); err != nil {
+				return nil, err
+			}
+			metrics, err := h.connectorsService.GetDeploymentMetrics(r.Context(), connectorId)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentConnectorMetrics(connectorId, metrics), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+// Logs is the handler for getting the recent log lines and events of a connector deployment
+func (h ConnectorsHandler) Logs(w http.ResponseWriter, r *http.Request) {
+	connectorId := mux.Vars(r)["connector_id"]
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			handlers.Validation("connector_id", &connectorId, handlers.MinLen(1), handlers.MaxLen(maxConnectorIdLength)),
+		},
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			tail, since, err := parseConnectorLogsQuery(r.URL.Query(), time.Now())
+			if err != nil {
+				return nil, err
+			}
+
+			// only users with access to the connector can read its logs
+			if _, err := h.connectorsService.Get(r.Context(), connectorId); err != nil {
+				return nil, err
+			}
+
+			entries, err := h.logsService.ListConnectorLogs(r.Context(), connectorId, tail, since)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentConnectorLogList(entries), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+// parseConnectorLogsQuery parses the tail parameter, the number of most recent entries to return,
+// and the since parameter, either an RFC 3339 timestamp or a duration relative to now such as 10m
+func parseConnectorLogsQuery(query url.Values, now time.Time) (tail int, since time.Time, serr *errors.ServiceError) {
+	tail = defaultConnectorLogsTail
+	if value := query.Get("tail"); value != "" {
+		var err error
+		if tail, err = strconv.Atoi(value); err != nil || tail < 1 || tail > maxConnectorLogsTail {
+			return 0, since, errors.BadRequest("tail is not valid. Must be a number between 1 and %d", maxConnectorLogsTail)
+		}
+	}
+	if value := query.Get("since"); value != "" {
+		if duration, err := time.ParseDuration(value); err == nil && duration > 0 {
+			since = now.Add(-duration)
+		} else if since, err = time.Parse(time.RFC3339, value); err != nil {
+			return 0, since, errors.BadRequest("since is not valid. Must be an RFC 3339 timestamp or a positive duration")
+		}
+	}
+	return tail, since, nil
+}
+
 // Delete is the handler for deleting a connector
 func (h ConnectorsHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	connectorId := mux.Vars(r)["connector_id"]
@@ -524,6 +660,57 @@ func (h ConnectorsHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
 }
 
+// ScheduleExecutions is the handler for the history of the operations performed by the schedule of a connector
+func (h ConnectorsHandler) ScheduleExecutions(w http.ResponseWriter, r *http.Request) {
+	connectorId := mux.Vars(r)["connector_id"]
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			handlers.Validation("connector_id", &connectorId, handlers.MinLen(1), handlers.MaxLen(maxConnectorIdLength)),
+		},
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			if _, err := h.connectorsService.Get(r.Context(), connectorId); err != nil {
+				return nil, err
+			}
+
+			executions, err := h.schedulesService.ListExecutions(r.Context(), connectorId)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentConnectorScheduleExecutionList(executions), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+// validateConnectorSchedule checks the cron expressions and time zone of an optional connector schedule
+func validateConnectorSchedule(schedule **public.ConnectorSchedule) handlers.Validate {
+	return func() *errors.ServiceError {
+		s := *schedule
+		if s == nil {
+			return nil
+		}
+		if s.Start == "" && s.Stop == "" {
+			return errors.BadRequest("connector schedule requires a start or stop cron expression")
+		}
+		if s.Start != "" {
+			if _, err := cron.Parse(s.Start); err != nil {
+				return errors.BadRequest("schedule.start is not valid: %v", err)
+			}
+		}
+		if s.Stop != "" {
+			if _, err := cron.Parse(s.Stop); err != nil {
+				return errors.BadRequest("schedule.stop is not valid: %v", err)
+			}
+		}
+		if s.Timezone != "" {
+			if _, err := time.LoadLocation(s.Timezone); err != nil {
+				return errors.BadRequest("schedule.timezone %q is not a valid time zone", s.Timezone)
+			}
+		}
+		return nil
+	}
+}
+
 func HandleConnectorDelete(ctx context.Context, connectorsService services.ConnectorsService,
 	namespaceService services.ConnectorNamespaceService, connectorId string) *errors.ServiceError {
 
@@ -554,6 +741,110 @@ func HandleConnectorDelete(ctx context.Context, connectorsService services.Conne
 	return err
 }
 
+// Move is the handler for moving a connector to another namespace of the user
+func (h ConnectorsHandler) Move(w http.ResponseWriter, r *http.Request) {
+	connectorId := mux.Vars(r)["connector_id"]
+	user := h.authZService.GetValidationUser(r.Context())
+
+	var request public.ConnectorMoveRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &request,
+		Validate: []handlers.Validate{
+			handlers.Validation("connector_id", &connectorId, handlers.MinLen(1), handlers.MaxLen(maxConnectorIdLength)),
+			handlers.Validation("namespace_id", &request.NamespaceId, handlers.MinLen(1), handlers.MaxLen(maxConnectorNamespaceIdLength),
+				user.AuthorizedNamespaceUser(errors.ErrorBadRequest)),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			resource, err := HandleConnectorMove(r.Context(), h.connectorsService, h.namespaceService, connectorId, request.NamespaceId)
+			if err != nil {
+				return nil, err
+			}
+
+			ct, err := h.connectorTypesService.Get(resource.ConnectorTypeId)
+			if err != nil {
+				return nil, err
+			}
+			if err := stripSecretReferences(&resource.Connector, ct); err != nil {
+				return nil, err
+			}
+			return presenters.PresentConnectorWithError(resource)
+		},
+	}
+
+	// return 202 status accepted
+	handlers.Handle(w, r, cfg, http.StatusAccepted)
+}
+
+// HandleConnectorMove moves a connector to the namespace with the given id, keeping its id, spec, secrets and desired state.
+// A deployed connector is unassigned from its namespace first, and then assigned to the target namespace by the connector manager.
+func HandleConnectorMove(ctx context.Context, connectorsService services.ConnectorsService,
+	namespaceService services.ConnectorNamespaceService, connectorId string, namespaceId string) (*dbapi.ConnectorWithConditions, *errors.ServiceError) {
+
+	c, err := connectorsService.Get(ctx, connectorId)
+	if err != nil {
+		return nil, err
+	}
+	if err = validateConnectorMove(&c.Connector, namespaceId); err != nil {
+		return nil, err
+	}
+
+	target, err := namespaceService.Get(ctx, namespaceId)
+	if err != nil {
+		return nil, err
+	}
+	if target.Status.Phase != dbapi.ConnectorNamespacePhaseReady {
+		return nil, errors.BadRequest("connector namespace with id='%s' is %s", namespaceId, target.Status.Phase)
+	}
+	if err = namespaceService.CheckConnectorQuota(namespaceId, c.ConnectorTypeId, c.Channel); err != nil {
+		return nil, err
+	}
+
+	if err = prepareConnectorMove(ctx, namespaceService, &c.Connector, namespaceId); err != nil {
+		return nil, err
+	}
+	if c.DesiredState == dbapi.ConnectorUnassigned {
+		// the connector deployment is being removed
+		if err = connectorsService.SaveStatus(ctx, c.Status); err != nil {
+			return nil, err
+		}
+	}
+	if err = connectorsService.Update(ctx, &c.Connector); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// validateConnectorMove checks that a connector can be moved to the namespace with the given id
+func validateConnectorMove(c *dbapi.Connector, namespaceId string) *errors.ServiceError {
+	if c.NamespaceId == nil || *c.NamespaceId == "" {
+		return errors.BadRequest("connector with id='%s' is not assigned to a namespace, assign it by setting its namespace_id", c.ID)
+	}
+	if *c.NamespaceId == namespaceId {
+		return errors.BadRequest("connector with id='%s' is already in namespace with id='%s'", c.ID, namespaceId)
+	}
+	if c.TargetNamespaceId != nil && c.DesiredState == dbapi.ConnectorUnassigned {
+		return errors.Conflict("connector with id='%s' is already being moved to namespace with id='%s'", c.ID, *c.TargetNamespaceId)
+	}
+	return nil
+}
+
+// prepareConnectorMove updates a connector, without saving it, to be moved to the namespace with the given id
+func prepareConnectorMove(ctx context.Context, namespaceService services.ConnectorNamespaceService, c *dbapi.Connector, namespaceId string) *errors.ServiceError {
+	if c.DesiredState == dbapi.ConnectorReady && c.Status.Phase == dbapi.ConnectorStatusPhaseAssigning {
+		// there is no deployment to remove yet, the connector is simply assigned to the target namespace
+		c.NamespaceId = &namespaceId
+		return nil
+	}
+	// the desired state is restored once the connector is assigned to the target namespace
+	desiredState := c.DesiredState
+	return ValidateConnectorOperation(ctx, namespaceService, c, phase.MoveConnector,
+		func(connector *dbapi.Connector) *errors.ServiceError {
+			connector.TargetNamespaceId = &namespaceId
+			connector.TargetDesiredState = desiredState
+			return nil
+		})
+}
+
 func (h ConnectorsHandler) List(w http.ResponseWriter, r *http.Request) {
 	cfg := &handlers.HandlerConfig{
 		Validate: []handlers.Validate{},
@@ -566,10 +857,11 @@ func (h ConnectorsHandler) List(w http.ResponseWriter, r *http.Request) {
 			}
 
 			resourceList := public.ConnectorList{
-				Kind:  "ConnectorList",
-				Page:  int32(paging.Page),
-				Size:  int32(paging.Size),
-				Total: int32(paging.Total),
+				Kind:       "ConnectorList",
+				Page:       int32(paging.Page),
+				Size:       int32(paging.Size),
+				Total:      int32(paging.Total),
+				NextCursor: paging.NextCursor,
 			}
 
 			for _, resource := range resources {
@@ -1,9 +1,21 @@
 package handlers
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/gorilla/mux"
 	"github.com/onsi/gomega"
-	"testing"
 )
 
 func TestValidateConnectorImmutableProperties(t *testing.T) {
@@ -275,3 +287,264 @@ func TestValidateConnectorImmutableProperties(t *testing.T) {
 	}
 
 }
+
+func Test_parseConnectorLogsQuery(t *testing.T) {
+	now := time.Date(2023, 2, 15, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		query   url.Values
+		tail    int
+		since   time.Time
+		wantErr bool
+	}{
+		{
+			name: "defaults",
+			tail: defaultConnectorLogsTail,
+		},
+		{
+			name:  "tail and duration",
+			query: url.Values{"tail": {"10"}, "since": {"15m"}},
+			tail:  10,
+			since: now.Add(-15 * time.Minute),
+		},
+		{
+			name:  "timestamp",
+			query: url.Values{"since": {"2023-02-15T10:30:00Z"}},
+			tail:  defaultConnectorLogsTail,
+			since: time.Date(2023, 2, 15, 10, 30, 0, 0, time.UTC),
+		},
+		{
+			name:    "tail too large",
+			query:   url.Values{"tail": {"5000"}},
+			wantErr: true,
+		},
+		{
+			name:    "tail not a number",
+			query:   url.Values{"tail": {"all"}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid since",
+			query:   url.Values{"since": {"yesterday"}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tail, since, err := parseConnectorLogsQuery(tt.query, now)
+			if tt.wantErr {
+				g.Expect(err).To(gomega.HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			g.Expect(tail).To(gomega.Equal(tt.tail))
+			g.Expect(since.Equal(tt.since)).To(gomega.BeTrue(), "since=%v, expected %v", since, tt.since)
+		})
+	}
+}
+
+func TestHandleConnectorMove(t *testing.T) {
+	sourceNamespaceId := "source-namespace"
+	targetNamespaceId := "target-namespace"
+
+	buildConnector := func(desiredState dbapi.ConnectorDesiredState, phase dbapi.ConnectorStatusPhase) *dbapi.ConnectorWithConditions {
+		namespaceId := sourceNamespaceId
+		return &dbapi.ConnectorWithConditions{
+			Connector: dbapi.Connector{
+				Model:           db.Model{ID: "connector-id"},
+				NamespaceId:     &namespaceId,
+				ConnectorTypeId: "log_sink_0.1",
+				Channel:         "stable",
+				DesiredState:    desiredState,
+				Status: dbapi.ConnectorStatus{
+					Model: db.Model{ID: "connector-id"},
+					Phase: phase,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name                   string
+		connector              *dbapi.ConnectorWithConditions
+		targetPhase            dbapi.ConnectorNamespacePhaseEnum
+		quotaErr               *errors.ServiceError
+		wantErr                bool
+		wantNamespaceId        string
+		wantTargetNamespaceId  *string
+		wantDesiredState       dbapi.ConnectorDesiredState
+		wantTargetDesiredState dbapi.ConnectorDesiredState
+		wantSaveStatusCalls    int
+	}{
+		{
+			name:             "assigning connector is assigned to the target namespace",
+			connector:        buildConnector(dbapi.ConnectorReady, dbapi.ConnectorStatusPhaseAssigning),
+			targetPhase:      dbapi.ConnectorNamespacePhaseReady,
+			wantNamespaceId:  targetNamespaceId,
+			wantDesiredState: dbapi.ConnectorReady,
+		},
+		{
+			name:                   "deployed connector is unassigned from its namespace",
+			connector:              buildConnector(dbapi.ConnectorReady, dbapi.ConnectorStatusPhaseReady),
+			targetPhase:            dbapi.ConnectorNamespacePhaseReady,
+			wantNamespaceId:        sourceNamespaceId,
+			wantTargetNamespaceId:  &targetNamespaceId,
+			wantDesiredState:       dbapi.ConnectorUnassigned,
+			wantTargetDesiredState: dbapi.ConnectorReady,
+			wantSaveStatusCalls:    1,
+		},
+		{
+			name:                   "stopped connector keeps its desired state",
+			connector:              buildConnector(dbapi.ConnectorStopped, dbapi.ConnectorStatusPhaseStopped),
+			targetPhase:            dbapi.ConnectorNamespacePhaseReady,
+			wantNamespaceId:        sourceNamespaceId,
+			wantTargetNamespaceId:  &targetNamespaceId,
+			wantDesiredState:       dbapi.ConnectorUnassigned,
+			wantTargetDesiredState: dbapi.ConnectorStopped,
+			wantSaveStatusCalls:    1,
+		},
+		{
+			name:        "target namespace not ready",
+			connector:   buildConnector(dbapi.ConnectorReady, dbapi.ConnectorStatusPhaseReady),
+			targetPhase: dbapi.ConnectorNamespacePhaseDisconnected,
+			wantErr:     true,
+		},
+		{
+			name:        "target namespace quota exceeded",
+			connector:   buildConnector(dbapi.ConnectorReady, dbapi.ConnectorStatusPhaseReady),
+			targetPhase: dbapi.ConnectorNamespacePhaseReady,
+			quotaErr:    errors.InsufficientQuotaError("namespace quota exceeded"),
+			wantErr:     true,
+		},
+		{
+			name: "connector already being moved",
+			connector: func() *dbapi.ConnectorWithConditions {
+				c := buildConnector(dbapi.ConnectorUnassigned, dbapi.ConnectorStatusPhaseDeleting)
+				c.TargetNamespaceId = &targetNamespaceId
+				return c
+			}(),
+			targetPhase: dbapi.ConnectorNamespacePhaseReady,
+			wantErr:     true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			connectorsService := &services.ConnectorsServiceMock{
+				GetFunc: func(ctx context.Context, id string) (*dbapi.ConnectorWithConditions, *errors.ServiceError) {
+					return tt.connector, nil
+				},
+				UpdateFunc: func(ctx context.Context, resource *dbapi.Connector) *errors.ServiceError {
+					return nil
+				},
+				SaveStatusFunc: func(ctx context.Context, resource dbapi.ConnectorStatus) *errors.ServiceError {
+					return nil
+				},
+			}
+			namespaceService := &services.ConnectorNamespaceServiceMock{
+				GetFunc: func(ctx context.Context, namespaceID string) (*dbapi.ConnectorNamespace, *errors.ServiceError) {
+					namespace := &dbapi.ConnectorNamespace{Status: dbapi.ConnectorNamespaceStatus{Phase: dbapi.ConnectorNamespacePhaseReady}}
+					namespace.ID = namespaceID
+					if namespaceID == targetNamespaceId {
+						namespace.Status.Phase = tt.targetPhase
+					}
+					return namespace, nil
+				},
+				CheckConnectorQuotaFunc: func(namespaceId string, connectorTypeId string, channel string) *errors.ServiceError {
+					return tt.quotaErr
+				},
+			}
+
+			connector, err := HandleConnectorMove(context.Background(), connectorsService, namespaceService, tt.connector.ID, targetNamespaceId)
+			if tt.wantErr {
+				g.Expect(err).To(gomega.HaveOccurred())
+				g.Expect(connectorsService.UpdateCalls()).To(gomega.BeEmpty())
+				return
+			}
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			g.Expect(connectorsService.UpdateCalls()).To(gomega.HaveLen(1))
+			g.Expect(connectorsService.SaveStatusCalls()).To(gomega.HaveLen(tt.wantSaveStatusCalls))
+			g.Expect(*connector.NamespaceId).To(gomega.Equal(tt.wantNamespaceId))
+			g.Expect(connector.TargetNamespaceId).To(gomega.Equal(tt.wantTargetNamespaceId))
+			g.Expect(connector.DesiredState).To(gomega.Equal(tt.wantDesiredState))
+			g.Expect(connector.TargetDesiredState).To(gomega.Equal(tt.wantTargetDesiredState))
+		})
+	}
+}
+
+func TestConnectorsHandler_Metrics(t *testing.T) {
+	reportedAt := time.Date(2023, time.March, 29, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		metrics dbapi.ConnectorDeploymentMetrics
+		want    public.ConnectorMetrics
+	}{
+		{
+			name: "metrics not reported yet",
+			want: public.ConnectorMetrics{
+				Kind:        "ConnectorMetrics",
+				ConnectorId: "connector-id",
+				Tasks:       []public.ConnectorTaskState{},
+			},
+		},
+		{
+			name: "reported metrics",
+			metrics: dbapi.ConnectorDeploymentMetrics{
+				ReportedAt:          reportedAt,
+				RecordsIn:           100,
+				RecordsOut:          90,
+				RecordErrors:        1,
+				Lag:                 10,
+				Tasks:               []dbapi.ConnectorTaskState{{ID: "0", State: "RUNNING"}},
+				RecordsInPerSecond:  2,
+				RecordsOutPerSecond: 1.5,
+			},
+			want: public.ConnectorMetrics{
+				Kind:        "ConnectorMetrics",
+				ConnectorId: "connector-id",
+				ReportedAt:  &reportedAt,
+				Throughput: public.ConnectorMetricsThroughput{
+					RecordsInPerSecond:  2,
+					RecordsOutPerSecond: 1.5,
+				},
+				RecordsIn:    100,
+				RecordsOut:   90,
+				RecordErrors: 1,
+				Lag:          10,
+				Tasks:        []public.ConnectorTaskState{{Id: "0", State: "RUNNING"}},
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			h := ConnectorsHandler{
+				connectorsService: &services.ConnectorsServiceMock{
+					GetFunc: func(ctx context.Context, id string) (*dbapi.ConnectorWithConditions, *errors.ServiceError) {
+						return &dbapi.ConnectorWithConditions{Connector: dbapi.Connector{Model: db.Model{ID: id}}}, nil
+					},
+					GetDeploymentMetricsFunc: func(ctx context.Context, id string) (dbapi.ConnectorDeploymentMetrics, *errors.ServiceError) {
+						return tt.metrics, nil
+					},
+				},
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/connector_mgmt/v1/kafka_connectors/connector-id/metrics", nil)
+			req = mux.SetURLVars(req, map[string]string{"connector_id": "connector-id"})
+			rw := httptest.NewRecorder()
+			h.Metrics(rw, req)
+
+			g.Expect(rw.Code).To(gomega.Equal(http.StatusOK))
+			var got public.ConnectorMetrics
+			g.Expect(json.NewDecoder(rw.Body).Decode(&got)).To(gomega.Succeed())
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+)
+
+func addConnectorDeploymentLogs(migrationId string) *gormigrate.Migration {
+	type ConnectorDeploymentLog struct {
+		ID           int64  `gorm:"primaryKey:autoIncrement"`
+		DeploymentID string `gorm:"index"`
+		ConnectorID  string `gorm:"index"`
+		Kind         string
+		Timestamp    time.Time
+		Source       string
+		Level        string
+		Reason       string
+		Message      string
+	}
+
+	return db.CreateMigrationFromActions(migrationId,
+		db.CreateTableAction(&ConnectorDeploymentLog{}),
+	)
+}
@@ -0,0 +1,36 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+)
+
+func addConnectorTemplates(migrationId string) *gormigrate.Migration {
+	type ConnectorTemplate struct {
+		db.Model
+		Name                   string
+		Description            string
+		Owner                  string `gorm:"index"`
+		OrganisationId         string `gorm:"index"`
+		ConnectorName          string
+		ConnectorTypeId        string
+		Channel                string
+		ConnectorSpec          api.JSON `gorm:"type:jsonb"`
+		KafkaID                string
+		KafkaBootstrapServer   string
+		SchemaRegistryID       string
+		SchemaRegistryUrl      string
+		ServiceAccountClientId string
+		Variables              api.JSON `gorm:"type:jsonb"`
+	}
+
+	return db.CreateMigrationFromActions(migrationId,
+		db.CreateTableAction(&ConnectorTemplate{}),
+	)
+}
@@ -0,0 +1,39 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+)
+
+func addConnectorSchedules(migrationId string) *gormigrate.Migration {
+	type ConnectorSchedule struct {
+		ConnectorID string `gorm:"primaryKey"`
+		Start       string
+		Stop        string
+		Timezone    string
+		NextStartAt *time.Time `gorm:"index"`
+		NextStopAt  *time.Time `gorm:"index"`
+	}
+
+	type ConnectorScheduleExecution struct {
+		ID          int64  `gorm:"primaryKey:autoIncrement"`
+		ConnectorID string `gorm:"index"`
+		Operation   string
+		ScheduledAt time.Time
+		ExecutedAt  time.Time
+		Result      string
+		Message     string
+	}
+
+	return db.CreateMigrationFromActions(migrationId,
+		db.CreateTableAction(&ConnectorSchedule{}),
+		db.CreateTableAction(&ConnectorScheduleExecution{}),
+	)
+}
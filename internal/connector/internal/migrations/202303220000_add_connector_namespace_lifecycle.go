@@ -0,0 +1,36 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+)
+
+func addConnectorNamespaceLifecycle(migrationId string) *gormigrate.Migration {
+	type ConnectorNamespace struct {
+		ExtendedAt          *time.Time
+		NextExpiryWarningAt *time.Time
+	}
+
+	type ConnectorNamespaceEvent struct {
+		ID          int64  `gorm:"primaryKey:autoIncrement"`
+		NamespaceID string `gorm:"index"`
+		Type        string
+		Timestamp   time.Time
+		Expiration  *time.Time
+		Message     string
+	}
+
+	return db.CreateMigrationFromActions(migrationId,
+		db.AddTableColumnsAction(&ConnectorNamespace{}),
+		db.ExecAction(`CREATE INDEX idx_connector_namespaces_next_expiry_warning_at ON connector_namespaces(next_expiry_warning_at)`,
+			`DROP INDEX IF EXISTS idx_connector_namespaces_next_expiry_warning_at`),
+		db.CreateTableAction(&ConnectorNamespaceEvent{}),
+	)
+}
@@ -0,0 +1,39 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+// addIdempotencyKeys adds the table of the shared Idempotency-Key middleware
+func addIdempotencyKeys(migrationId string) *gormigrate.Migration {
+	type IdempotencyKey struct {
+		ID                  string `gorm:"primaryKey"`
+		Owner               string `gorm:"uniqueIndex:idx_idempotency_keys_owner_key"`
+		Key                 string `gorm:"uniqueIndex:idx_idempotency_keys_owner_key"`
+		RequestHash         string
+		ResponseStatus      int
+		ResponseContentType string
+		ResponseBody        []byte
+		CreatedAt           time.Time
+		ExpiresAt           time.Time `gorm:"index"`
+	}
+
+	return db.CreateMigrationFromActions(migrationId,
+		db.FuncAction(func(tx *gorm.DB) error {
+			// We don't want to delete the idempotency keys table on rollback because it's shared with the kas-fleet-manager
+			// so we just create it here if it does not exist yet.. but we don't drop it on rollback.
+			return tx.Migrator().AutoMigrate(&IdempotencyKey{})
+		}, func(tx *gorm.DB) error {
+			return nil
+		}),
+	)
+}
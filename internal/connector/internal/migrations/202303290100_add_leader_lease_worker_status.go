@@ -0,0 +1,34 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+// addLeaderLeaseWorkerStatus adds the worker status columns recorded by the shared workers after each reconcile
+func addLeaderLeaseWorkerStatus(migrationId string) *gormigrate.Migration {
+	type LeaderLease struct {
+		Paused              bool `gorm:"not null;default:false"`
+		LastReconcileAt     *time.Time
+		LastReconcileErrors string `gorm:"type:jsonb"`
+		NextReconcileAt     *time.Time
+	}
+
+	return db.CreateMigrationFromActions(migrationId,
+		db.FuncAction(func(tx *gorm.DB) error {
+			// We don't want to drop the columns on rollback because the leader lease table is shared with the kas-fleet-manager
+			// so we just add them here if they do not exist yet.. but we don't drop them on rollback.
+			return tx.Migrator().AutoMigrate(&LeaderLease{})
+		}, func(tx *gorm.DB) error {
+			return nil
+		}),
+	)
+}
@@ -0,0 +1,19 @@
+package migrations
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+)
+
+// addLeaderLeaseTypeUniqueIndex ensures a single lease exists per lease type, so that the shard leases created
+// on demand by the replicas running in sharded mode cannot be duplicated.
+// Duplicated leases created before the index existed are deleted first, keeping the oldest lease of each type.
+// The index is not dropped on rollback since the leader lease table is shared with the kas-fleet-manager
+func addLeaderLeaseTypeUniqueIndex(migrationId string) *gormigrate.Migration {
+	return db.CreateMigrationFromActions(migrationId,
+		db.ExecAction(`DELETE FROM leader_leases duplicate USING leader_leases original
+			WHERE duplicate.lease_type = original.lease_type AND duplicate.deleted_at IS NULL AND original.deleted_at IS NULL
+			AND (duplicate.created_at, duplicate.id) > (original.created_at, original.id)`, ``),
+		db.ExecAction(`CREATE UNIQUE INDEX IF NOT EXISTS uix_leader_leases_lease_type ON leader_leases (lease_type) WHERE deleted_at IS NULL`, ``),
+	)
+}
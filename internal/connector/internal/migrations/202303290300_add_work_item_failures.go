@@ -0,0 +1,39 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+// addWorkItemFailures adds the table of the failures tracked by the work queues of the shared workers
+func addWorkItemFailures(migrationId string) *gormigrate.Migration {
+	type WorkItemFailure struct {
+		ID            string `gorm:"primaryKey"`
+		WorkerType    string `gorm:"uniqueIndex:idx_work_item_failures_worker_type_resource_id"`
+		ResourceID    string `gorm:"uniqueIndex:idx_work_item_failures_worker_type_resource_id"`
+		Attempts      int
+		LastError     string
+		NextAttemptAt time.Time
+		QuarantinedAt *time.Time
+		CreatedAt     time.Time
+		UpdatedAt     time.Time
+	}
+
+	return db.CreateMigrationFromActions(migrationId,
+		db.FuncAction(func(tx *gorm.DB) error {
+			// We don't want to delete the work item failures table on rollback because it's shared with the kas-fleet-manager
+			// so we just create it here if it does not exist yet.. but we don't drop it on rollback.
+			return tx.Migrator().AutoMigrate(&WorkItemFailure{})
+		}, func(tx *gorm.DB) error {
+			return nil
+		}),
+	)
+}
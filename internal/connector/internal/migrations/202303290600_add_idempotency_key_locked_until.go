@@ -0,0 +1,35 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+// addIdempotencyKeyLockedUntil adds the lease of the pending keys of the shared Idempotency-Key middleware
+func addIdempotencyKeyLockedUntil(migrationId string) *gormigrate.Migration {
+	type IdempotencyKey struct {
+		LockedUntil time.Time
+	}
+
+	return db.CreateMigrationFromActions(migrationId,
+		db.FuncAction(func(tx *gorm.DB) error {
+			// We don't want to drop the column on rollback because the idempotency keys table is shared with the kas-fleet-manager
+			// so we just add it here if it does not exist yet.. but we don't drop it on rollback.
+			if err := tx.Migrator().AutoMigrate(&IdempotencyKey{}); err != nil {
+				return err
+			}
+			// the pending keys stored before the lease existed can be used again
+			return tx.Exec("UPDATE idempotency_keys SET locked_until = created_at WHERE locked_until IS NULL").Error
+		}, func(tx *gorm.DB) error {
+			return nil
+		}),
+	)
+}
@@ -0,0 +1,28 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// addIdempotencyKeyLease adds the lease of the worker purging the expired keys of the shared Idempotency-Key middleware
+func addIdempotencyKeyLease(migrationId string) *gormigrate.Migration {
+	return db.CreateMigrationFromActions(migrationId,
+		db.FuncAction(func(tx *gorm.DB) error {
+			// the lease may have already been created by the kas-fleet-manager migrations
+			now := time.Now().Add(-time.Minute) //set to a expired time
+			return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&api.LeaderLease{
+				Expires:   &now,
+				LeaseType: "idempotency_key",
+			}).Error
+		}, func(tx *gorm.DB) error {
+			// The leader lease may be used by the kas-fleet-manager, it's not deleted on rollback
+			return nil
+		}),
+	)
+}
@@ -0,0 +1,26 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+)
+
+// addConnectorNamespaceEventWebhookDelivery records the webhook delivery of namespace events, for failed deliveries to be retried.
+// Events recorded before are considered delivered
+func addConnectorNamespaceEventWebhookDelivery(migrationId string) *gormigrate.Migration {
+	type ConnectorNamespaceEvent struct {
+		WebhookPending  bool `gorm:"not null;default:false"`
+		WebhookAttempts int  `gorm:"not null;default:0"`
+	}
+
+	return db.CreateMigrationFromActions(migrationId,
+		db.AddTableColumnsAction(&ConnectorNamespaceEvent{}),
+		db.ExecAction(`CREATE INDEX idx_connector_namespace_events_webhook_pending ON connector_namespace_events(webhook_pending)`,
+			`DROP INDEX IF EXISTS idx_connector_namespace_events_webhook_pending`),
+	)
+}
@@ -50,6 +50,22 @@ var migrations = []*gormigrate.Migration{
 	renameNamespaceProfileAnnotations("202211280000"),
 	addOrgIDAnnotations("202212050000"),
 	addConnectorTypeDeprecated("202301180000"),
+	addConnectorDeploymentSchedulingDecision("202302010000"),
+	addConnectorTargetNamespaceId("202302080000"),
+	addConnectorDeploymentLogs("202302150000"),
+	addConnectorDeploymentStatusMetrics("202302220000"),
+	addConnectorTemplates("202303010000"),
+	addConnectorStatusConditions("202303080000"),
+	addConnectorSchedules("202303150000"),
+	addConnectorNamespaceLifecycle("202303220000"),
+	addIdempotencyKeys("202303290000"),
+	addLeaderLeaseWorkerStatus("202303290100"),
+	addLeaderLeaseTypeUniqueIndex("202303290200"),
+	addWorkItemFailures("202303290300"),
+	addConnectorTargetDesiredState("202303290400"),
+	addIdempotencyKeyLockedUntil("202303290600"),
+	addIdempotencyKeyLease("202303290700"),
+	addConnectorNamespaceEventWebhookDelivery("202303290800"),
 }
 
 func New(dbConfig *db.DatabaseConfig) (*db.Migration, func(), error) {
@@ -23,6 +23,10 @@ func ConvertConnector(from public.Connector) (*dbapi.Connector, *errors.ServiceE
 	if from.NamespaceId != "" {
 		namespaceId = &from.NamespaceId
 	}
+	schedule, serr := ConvertConnectorSchedule(from.Id, from.Schedule)
+	if serr != nil {
+		return nil, serr
+	}
 	return &dbapi.Connector{
 		Model: db.Model{
 			ID: from.Id,
@@ -48,6 +52,7 @@ func ConvertConnector(from public.Connector) (*dbapi.Connector, *errors.ServiceE
 			ClientSecret: from.ServiceAccount.ClientSecret,
 		},
 		Annotations: ConvertConnectorAnnotations(from.Id, from.Annotations),
+		Schedule:    schedule,
 		Status: dbapi.ConnectorStatus{
 			Phase: dbapi.ConnectorStatusPhase(from.Status.State),
 		},
@@ -80,7 +85,9 @@ func PresentConnectorWithError(from *dbapi.ConnectorWithConditions) (public.Conn
 		if err != nil {
 			return public.Connector{}, errors.GeneralError("invalid conditions: %v", err)
 		}
-		connector.Status.Error = getStatusError(conditions)
+		if statusError := getStatusError(conditions); statusError != "" {
+			connector.Status.Error = statusError
+		}
 	}
 
 	return connector, nil
@@ -104,6 +111,7 @@ func PresentConnectorAdminView(from *dbapi.ConnectorWithConditions) (admin.Conne
 		ConnectorTypeId: from.ConnectorTypeId,
 		Status: admin.ConnectorStatusStatus{
 			State: admin.ConnectorState(from.Status.Phase),
+			Error: getConnectorStatusError(from.Status.Conditions),
 		},
 		DesiredState: admin.ConnectorDesiredState(from.DesiredState),
 		Channel:      admin.Channel(from.Channel),
@@ -121,7 +129,9 @@ func PresentConnectorAdminView(from *dbapi.ConnectorWithConditions) (admin.Conne
 			if err != nil {
 				return admin.ConnectorAdminView{}, errors.GeneralError("invalid conditions: %v", err)
 			}
-			connector.Status.Error = getStatusError(conditions)
+			if statusError := getStatusError(conditions); statusError != "" {
+				connector.Status.Error = statusError
+			}
 		}
 	}
 
@@ -155,6 +165,16 @@ func getStatusError(conditions []private.MetaV1Condition) string {
 	return result
 }
 
+// getConnectorStatusError returns the error of the first failed condition set by the fleet manager
+func getConnectorStatusError(conditions dbapi.ConditionList) string {
+	for _, c := range conditions {
+		if c.Status == "False" {
+			return c.Reason + ": " + c.Message
+		}
+	}
+	return ""
+}
+
 func PresentConnector(from *dbapi.Connector) (public.Connector, *errors.ServiceError) {
 	spec := map[string]interface{}{}
 	err := from.ConnectorSpec.Unmarshal(&spec)
@@ -184,6 +204,7 @@ func PresentConnector(from *dbapi.Connector) (public.Connector, *errors.ServiceE
 		Annotations:     PresentConnectorAnnotations(from.Annotations),
 		Status: public.ConnectorStatusStatus{
 			State: public.ConnectorState(from.Status.Phase),
+			Error: getConnectorStatusError(from.Status.Conditions),
 		},
 		DesiredState: public.ConnectorDesiredState(from.DesiredState),
 		Channel:      public.Channel(from.Channel),
@@ -199,5 +220,6 @@ func PresentConnector(from *dbapi.Connector) (public.Connector, *errors.ServiceE
 			ClientId:     from.ServiceAccount.ClientId,
 			ClientSecret: from.ServiceAccount.ClientSecret,
 		},
+		Schedule: PresentConnectorSchedule(from.Schedule),
 	}, nil
 }
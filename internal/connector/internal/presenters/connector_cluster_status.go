@@ -23,6 +23,7 @@ func PresentConnectorClusterAdminStatus(from dbapi.ConnectorClusterStatus) admin
 	return admin.ConnectorClusterAdminStatus{
 		Conditions: PresentAdminConditions(from.Conditions),
 		State:      admin.ConnectorClusterState(from.Phase),
+		Version:    from.Version,
 		Operators:  PresentAdminOperators(from.Operators),
 		Platform: admin.ConnectorClusterPlatform{
 			Id:      from.Platform.PlatformID,
@@ -2,6 +2,7 @@ package presenters
 
 import (
 	"encoding/json"
+	"time"
 
 	admin "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/admin/private"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
@@ -187,5 +188,30 @@ func ConvertConnectorDeploymentStatus(from private.ConnectorDeploymentStatus) (d
 		Conditions:       conditions,
 		Operators:        operators,
 		UpgradeAvailable: from.Operators.Available.Id != "" && from.Operators.Available.Id != from.Operators.Assigned.Id,
+		Metrics:          convertConnectorDeploymentMetrics(from.Metrics),
 	}, nil
 }
+
+func convertConnectorDeploymentMetrics(from *private.ConnectorDeploymentMetrics) dbapi.ConnectorDeploymentMetrics {
+	if from == nil {
+		return dbapi.ConnectorDeploymentMetrics{}
+	}
+	metrics := dbapi.ConnectorDeploymentMetrics{
+		ReportedAt:   from.Timestamp,
+		RecordsIn:    from.RecordsIn,
+		RecordsOut:   from.RecordsOut,
+		RecordErrors: from.RecordErrors,
+		Lag:          from.Lag,
+	}
+	if metrics.ReportedAt.IsZero() {
+		metrics.ReportedAt = time.Now()
+	}
+	for _, task := range from.Tasks {
+		metrics.Tasks = append(metrics.Tasks, dbapi.ConnectorTaskState{
+			ID:    task.Id,
+			State: task.State,
+			Trace: task.Trace,
+		})
+	}
+	return metrics
+}
@@ -0,0 +1,57 @@
+package presenters
+
+import (
+	"unicode/utf8"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/public"
+)
+
+// maxConnectorLogMessageLength bounds the size of the messages relayed by agents
+const maxConnectorLogMessageLength = 4096
+
+func ConvertConnectorDeploymentLogs(from private.ConnectorDeploymentLogs) dbapi.ConnectorDeploymentLogList {
+	entries := make(dbapi.ConnectorDeploymentLogList, 0, len(from.Items))
+	for _, item := range from.Items {
+		entries = append(entries, dbapi.ConnectorDeploymentLog{
+			Kind:      dbapi.ConnectorDeploymentLogKind(item.Kind),
+			Timestamp: item.Timestamp,
+			Source:    item.Source,
+			Level:     item.Level,
+			Reason:    item.Reason,
+			Message:   truncateConnectorLogMessage(item.Message),
+		})
+	}
+	return entries
+}
+
+// truncateConnectorLogMessage cuts long messages on a rune boundary, so that they remain valid UTF-8
+func truncateConnectorLogMessage(message string) string {
+	if len(message) <= maxConnectorLogMessageLength {
+		return message
+	}
+	end := maxConnectorLogMessageLength
+	for end > 0 && !utf8.RuneStart(message[end]) {
+		end--
+	}
+	return message[:end]
+}
+
+func PresentConnectorLogList(from dbapi.ConnectorDeploymentLogList) public.ConnectorLogList {
+	list := public.ConnectorLogList{
+		Kind:  "ConnectorLogList",
+		Items: make([]public.ConnectorLogEntry, 0, len(from)),
+	}
+	for _, entry := range from {
+		list.Items = append(list.Items, public.ConnectorLogEntry{
+			Kind:      string(entry.Kind),
+			Timestamp: entry.Timestamp,
+			Source:    entry.Source,
+			Level:     entry.Level,
+			Reason:    entry.Reason,
+			Message:   entry.Message,
+		})
+	}
+	return list
+}
@@ -0,0 +1,43 @@
+package presenters
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/onsi/gomega"
+)
+
+func Test_truncateConnectorLogMessage(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    string
+	}{
+		{
+			name:    "should keep a short message",
+			message: "connector started",
+			want:    "connector started",
+		},
+		{
+			name:    "should cut a long ascii message at the maximum length",
+			message: strings.Repeat("a", maxConnectorLogMessageLength+10),
+			want:    strings.Repeat("a", maxConnectorLogMessageLength),
+		},
+		{
+			name:    "should not split a multi-byte rune at the maximum length",
+			message: strings.Repeat("a", maxConnectorLogMessageLength-1) + "€" + "a",
+			want:    strings.Repeat("a", maxConnectorLogMessageLength-1),
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			got := truncateConnectorLogMessage(tt.message)
+			g.Expect(got).To(gomega.Equal(tt.want))
+			g.Expect(utf8.ValidString(got)).To(gomega.BeTrue())
+		})
+	}
+}
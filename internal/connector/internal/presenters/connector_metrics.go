@@ -0,0 +1,34 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/public"
+)
+
+func PresentConnectorMetrics(connectorId string, from dbapi.ConnectorDeploymentMetrics) public.ConnectorMetrics {
+	metrics := public.ConnectorMetrics{
+		Kind:        "ConnectorMetrics",
+		ConnectorId: connectorId,
+		Throughput: public.ConnectorMetricsThroughput{
+			RecordsInPerSecond:  from.RecordsInPerSecond,
+			RecordsOutPerSecond: from.RecordsOutPerSecond,
+		},
+		RecordsIn:    from.RecordsIn,
+		RecordsOut:   from.RecordsOut,
+		RecordErrors: from.RecordErrors,
+		Lag:          from.Lag,
+		Tasks:        make([]public.ConnectorTaskState, 0, len(from.Tasks)),
+	}
+	if from.IsReported() {
+		reportedAt := from.ReportedAt
+		metrics.ReportedAt = &reportedAt
+	}
+	for _, task := range from.Tasks {
+		metrics.Tasks = append(metrics.Tasks, public.ConnectorTaskState{
+			Id:    task.ID,
+			State: task.State,
+			Trace: task.Trace,
+		})
+	}
+	return metrics
+}
@@ -160,13 +160,7 @@ func PresentConnectorNamespace(namespace *dbapi.ConnectorNamespace, quotaConfig
 		ModifiedAt:      namespace.UpdatedAt,
 		Owner:           namespace.Owner,
 		ResourceVersion: namespace.Version,
-		Quota: public.ConnectorNamespaceQuota{
-			Connectors:     quota.Connectors,
-			MemoryRequests: quota.MemoryRequests,
-			MemoryLimits:   quota.MemoryLimits,
-			CpuRequests:    quota.CPURequests,
-			CpuLimits:      quota.CPULimits,
-		},
+		Quota:           PresentConnectorNamespaceQuota(quota),
 
 		Name:        namespace.Name,
 		ClusterId:   namespace.ClusterId,
@@ -191,10 +185,44 @@ func PresentConnectorNamespace(namespace *dbapi.ConnectorNamespace, quotaConfig
 	if namespace.Expiration != nil {
 		result.Expiration = getTimestamp(*namespace.Expiration)
 	}
+	if namespace.ExtendedAt != nil {
+		result.ExtendedAt = getTimestamp(*namespace.ExtendedAt)
+	}
 
 	return result
 }
 
+func PresentConnectorNamespaceEventList(from dbapi.ConnectorNamespaceEventList) public.ConnectorNamespaceEventList {
+	list := public.ConnectorNamespaceEventList{
+		Kind:  "ConnectorNamespaceEventList",
+		Items: make([]public.ConnectorNamespaceEvent, 0, len(from)),
+	}
+	for _, event := range from {
+		item := public.ConnectorNamespaceEvent{
+			Type:      string(event.Type),
+			Timestamp: event.Timestamp,
+			Message:   event.Message,
+		}
+		if event.Expiration != nil {
+			item.Expiration = getTimestamp(*event.Expiration)
+		}
+		list.Items = append(list.Items, item)
+	}
+	return list
+}
+
+func PresentConnectorNamespaceQuota(quota config.NamespaceQuota) public.ConnectorNamespaceQuota {
+	return public.ConnectorNamespaceQuota{
+		Connectors:      quota.Connectors,
+		MemoryRequests:  quota.MemoryRequests,
+		MemoryLimits:    quota.MemoryLimits,
+		CpuRequests:     quota.CPURequests,
+		CpuLimits:       quota.CPULimits,
+		ConnectorTypes:  quota.ConnectorTypes,
+		ConnectorLabels: quota.ConnectorLabels,
+	}
+}
+
 func PresentConnectorNamespaceDeployment(namespace *dbapi.ConnectorNamespace, quotaConfig *config.ConnectorsQuotaConfig) private.ConnectorNamespaceDeployment {
 	var quota config.NamespaceQuota
 	annotations := make(map[string]string, len(namespace.Annotations))
@@ -20,6 +20,10 @@ func ConvertConnectorRequest(id string, from public.ConnectorRequest) (*dbapi.Co
 	if *namespaceId == "" {
 		namespaceId = nil
 	}
+	schedule, serr := ConvertConnectorSchedule(id, from.Schedule)
+	if serr != nil {
+		return nil, serr
+	}
 	return &dbapi.Connector{
 		Model: db.Model{
 			ID: id,
@@ -43,5 +47,6 @@ func ConvertConnectorRequest(id string, from public.ConnectorRequest) (*dbapi.Co
 			ClientId:     from.ServiceAccount.ClientId,
 			ClientSecret: from.ServiceAccount.ClientSecret,
 		},
+		Schedule: schedule,
 	}, nil
 }
@@ -0,0 +1,62 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+)
+
+const defaultConnectorScheduleTimezone = "UTC"
+
+// ConvertConnectorSchedule converts the schedule of a connector, and computes its next transitions from now
+func ConvertConnectorSchedule(connectorId string, from *public.ConnectorSchedule) (*dbapi.ConnectorSchedule, *errors.ServiceError) {
+	if from == nil {
+		return nil, nil
+	}
+
+	schedule := &dbapi.ConnectorSchedule{
+		ConnectorID: connectorId,
+		Start:       from.Start,
+		Stop:        from.Stop,
+		Timezone:    from.Timezone,
+	}
+	if schedule.Timezone == "" {
+		schedule.Timezone = defaultConnectorScheduleTimezone
+	}
+	if err := schedule.UpdateTransitions(time.Now()); err != nil {
+		return nil, errors.BadRequest("invalid connector schedule: %v", err)
+	}
+	return schedule, nil
+}
+
+func PresentConnectorSchedule(from *dbapi.ConnectorSchedule) *public.ConnectorSchedule {
+	if from == nil {
+		return nil
+	}
+	return &public.ConnectorSchedule{
+		Start:       from.Start,
+		Stop:        from.Stop,
+		Timezone:    from.Timezone,
+		NextStartAt: from.NextStartAt,
+		NextStopAt:  from.NextStopAt,
+	}
+}
+
+func PresentConnectorScheduleExecutionList(from dbapi.ConnectorScheduleExecutionList) public.ConnectorScheduleExecutionList {
+	list := public.ConnectorScheduleExecutionList{
+		Kind:  "ConnectorScheduleExecutionList",
+		Items: make([]public.ConnectorScheduleExecution, 0, len(from)),
+	}
+	for _, execution := range from {
+		list.Items = append(list.Items, public.ConnectorScheduleExecution{
+			Operation:   string(execution.Operation),
+			ScheduledAt: execution.ScheduledAt,
+			ExecutedAt:  execution.ExecutedAt,
+			Result:      string(execution.Result),
+			Message:     execution.Message,
+		})
+	}
+	return list
+}
@@ -0,0 +1,124 @@
+package presenters
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/secrets"
+	"github.com/spyzhov/ajson"
+)
+
+// secretVariableReferencePattern matches a secret field value made of a single variable reference, e.g. ${password}
+var secretVariableReferencePattern = regexp.MustCompile(`^\$\{([A-Za-z_][A-Za-z0-9_]*)\}$`)
+
+// ConvertConnectorTemplateRequest converts a connector template request of the given connector type.
+// Templates are stored as is, so the secret fields of the connector spec can only reference variables without a default,
+// whose values are provided when creating a connector and moved to the vault like any connector secret
+func ConvertConnectorTemplateRequest(id string, from public.ConnectorTemplateRequest, ct *dbapi.ConnectorType) (*dbapi.ConnectorTemplate, *errors.ServiceError) {
+	spec, err := json.Marshal(from.Connector)
+	if err != nil {
+		return nil, errors.BadRequest("invalid connector spec: %v", err)
+	}
+
+	secretVariables := map[string]bool{}
+	if _, err := secrets.ModifySecrets(ct.JsonSchema, spec, func(node *ajson.Node) error {
+		switch node.Type() {
+		case ajson.Null:
+			return nil
+		case ajson.String:
+			value, _ := node.GetString()
+			if match := secretVariableReferencePattern.FindStringSubmatch(value); match != nil {
+				secretVariables[match[1]] = true
+				return nil
+			}
+		}
+		return fmt.Errorf("secret field %s must reference a variable, e.g. ${password}", node.Path())
+	}); err != nil {
+		return nil, errors.BadRequest("invalid connector spec: %v", err)
+	}
+
+	variables := make(dbapi.ConnectorTemplateVariables, 0, len(from.Variables))
+	for _, v := range from.Variables {
+		if secretVariables[v.Name] && v.Default != "" {
+			return nil, errors.BadRequest("variable %s is used by a secret field and cannot have a default", v.Name)
+		}
+		variables = append(variables, dbapi.ConnectorTemplateVariable{
+			Name:        v.Name,
+			Description: v.Description,
+			Type:        dbapi.ConnectorTemplateVariableType(v.Type),
+			Default:     v.Default,
+			Required:    v.Required,
+		})
+	}
+
+	return &dbapi.ConnectorTemplate{
+		Model: db.Model{
+			ID: id,
+		},
+		Name:            from.Name,
+		Description:     from.Description,
+		ConnectorName:   from.ConnectorName,
+		ConnectorTypeId: from.ConnectorTypeId,
+		Channel:         string(from.Channel),
+		ConnectorSpec:   spec,
+		Kafka: dbapi.KafkaConnectionSettings{
+			KafkaID:         from.Kafka.Id,
+			BootstrapServer: from.Kafka.Url,
+		},
+		SchemaRegistry: dbapi.SchemaRegistryConnectionSettings{
+			SchemaRegistryID: from.SchemaRegistry.Id,
+			Url:              from.SchemaRegistry.Url,
+		},
+		ServiceAccountClientId: from.ServiceAccountClientId,
+		Variables:              variables,
+	}, nil
+}
+
+func PresentConnectorTemplate(from *dbapi.ConnectorTemplate) (public.ConnectorTemplate, *errors.ServiceError) {
+	spec := map[string]interface{}{}
+	if err := from.ConnectorSpec.Unmarshal(&spec); err != nil {
+		return public.ConnectorTemplate{}, errors.GeneralError("invalid connector spec of connector template %s: %v", from.ID, err)
+	}
+
+	variables := make([]public.ConnectorTemplateVariable, 0, len(from.Variables))
+	for _, v := range from.Variables {
+		variables = append(variables, public.ConnectorTemplateVariable{
+			Name:        v.Name,
+			Description: v.Description,
+			Type:        string(v.Type),
+			Default:     v.Default,
+			Required:    v.Required,
+		})
+	}
+
+	reference := PresentReference(from.ID, from)
+	return public.ConnectorTemplate{
+		Id:              reference.Id,
+		Kind:            reference.Kind,
+		Href:            reference.Href,
+		Owner:           from.Owner,
+		CreatedAt:       from.CreatedAt,
+		ModifiedAt:      from.UpdatedAt,
+		Name:            from.Name,
+		Description:     from.Description,
+		ConnectorName:   from.ConnectorName,
+		ConnectorTypeId: from.ConnectorTypeId,
+		Channel:         public.Channel(from.Channel),
+		Connector:       spec,
+		Kafka: public.KafkaConnectionSettings{
+			Id:  from.Kafka.KafkaID,
+			Url: from.Kafka.BootstrapServer,
+		},
+		SchemaRegistry: public.SchemaRegistryConnectionSettings{
+			Id:  from.SchemaRegistry.SchemaRegistryID,
+			Url: from.SchemaRegistry.Url,
+		},
+		ServiceAccountClientId: from.ServiceAccountClientId,
+		Variables:              variables,
+	}, nil
+}
@@ -0,0 +1,95 @@
+package presenters
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/public"
+	"github.com/onsi/gomega"
+)
+
+const connectorTemplateTestSchema = `
+{
+  "properties": {
+    "queue": {
+      "type": "string"
+    },
+    "access_key": {
+      "oneOf": [
+        {
+          "type": "string",
+          "format": "password"
+        },
+        {
+          "type": "object",
+          "properties": {}
+        }
+      ]
+    }
+  }
+}
+`
+
+func TestConvertConnectorTemplateRequest(t *testing.T) {
+	ct := &dbapi.ConnectorType{JsonSchema: []byte(connectorTemplateTestSchema)}
+
+	tests := []struct {
+		name      string
+		connector map[string]interface{}
+		variables []public.ConnectorTemplateVariable
+		wantErr   string
+	}{
+		{
+			name:      "should accept a secret field referencing a variable",
+			connector: map[string]interface{}{"queue": "orders", "access_key": "${access_key}"},
+			variables: []public.ConnectorTemplateVariable{{Name: "access_key", Required: true}},
+		},
+		{
+			name:      "should accept a template without secret values",
+			connector: map[string]interface{}{"queue": "${queue}"},
+			variables: []public.ConnectorTemplateVariable{{Name: "queue", Default: "orders"}},
+		},
+		{
+			name:      "should reject a secret field with a literal value",
+			connector: map[string]interface{}{"access_key": "s3cr3t"},
+			wantErr:   "must reference a variable",
+		},
+		{
+			name:      "should reject a secret field with an opaque value",
+			connector: map[string]interface{}{"access_key": map[string]interface{}{"kind": "base64", "value": "czNjcjN0"}},
+			wantErr:   "must reference a variable",
+		},
+		{
+			name:      "should reject a secret field mixing a variable with a literal value",
+			connector: map[string]interface{}{"access_key": "prefix-${access_key}"},
+			variables: []public.ConnectorTemplateVariable{{Name: "access_key"}},
+			wantErr:   "must reference a variable",
+		},
+		{
+			name:      "should reject a default for a variable used by a secret field",
+			connector: map[string]interface{}{"access_key": "${access_key}"},
+			variables: []public.ConnectorTemplateVariable{{Name: "access_key", Default: "s3cr3t"}},
+			wantErr:   "variable access_key is used by a secret field and cannot have a default",
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := gomega.NewWithT(t)
+			template, err := ConvertConnectorTemplateRequest("template-id", public.ConnectorTemplateRequest{
+				Name:      "template",
+				Connector: tt.connector,
+				Variables: tt.variables,
+			}, ct)
+			if tt.wantErr != "" {
+				g.Expect(err).ToNot(gomega.BeNil())
+				g.Expect(err.Reason).To(gomega.ContainSubstring(tt.wantErr))
+				return
+			}
+			g.Expect(err).To(gomega.BeNil())
+			g.Expect(template.ID).To(gomega.Equal("template-id"))
+		})
+	}
+}
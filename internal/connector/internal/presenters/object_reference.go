@@ -23,6 +23,8 @@ const (
 	KindConnectorDeploymentAdminView = "ConnectorDeploymentAdminView"
 	// KindConnectorNamespace is a string identifier for the type dbapi.ConnectorNamespace
 	KindConnectorNamespace = "ConnectorNamespace"
+	// KindConnectorTemplate is a string identifier for the type dbapi.ConnectorTemplate
+	KindConnectorTemplate = "ConnectorTemplate"
 	// KindConnectorType is a string identifier for the type dbapi.ConnectorType
 	KindConnectorType = "ConnectorType"
 	// ConnectorTypeAdminView is a string identifier for the type admin.ConnectorTypeAdminView
@@ -49,6 +51,8 @@ func objectKind(i interface{}) string {
 		return KindConnectorDeploymentAdminView
 	case dbapi.ConnectorNamespace, *dbapi.ConnectorNamespace:
 		return KindConnectorNamespace
+	case dbapi.ConnectorTemplate, *dbapi.ConnectorTemplate:
+		return KindConnectorTemplate
 	case dbapi.ConnectorType, *dbapi.ConnectorType:
 		return KindConnectorType
 	case admin.ConnectorTypeAdminView:
@@ -82,6 +86,8 @@ func objectPath(id string, obj interface{}) string {
 		return fmt.Sprintf("/api/connector_mgmt/v1/admin/kafka_connector_clusters/%s/deployments/%s", obj.Spec.ClusterId, id)
 	case dbapi.ConnectorNamespace, *dbapi.ConnectorNamespace:
 		return fmt.Sprintf("/api/connector_mgmt/v1/kafka_connector_namespaces/%s", id)
+	case dbapi.ConnectorTemplate, *dbapi.ConnectorTemplate:
+		return fmt.Sprintf("/api/connector_mgmt/v1/kafka_connector_templates/%s", id)
 	default:
 		return ""
 	}
@@ -35,10 +35,12 @@ type options struct {
 	ConnectorAdminHandler     *handlers.ConnectorAdminHandler
 	ConnectorTypesHandler     *handlers.ConnectorTypesHandler
 	ConnectorsHandler         *handlers.ConnectorsHandler
+	ConnectorTemplatesHandler *handlers.ConnectorTemplatesHandler
 	ConnectorClusterHandler   *handlers.ConnectorClusterHandler
 	ConnectorNamespaceHandler *handlers.ConnectorNamespaceHandler
 	DB                        *db.ConnectionFactory
 	AdminRoleAuthZConfig      *auth.AdminRoleAuthZConfig
+	IdempotencyMiddleware     *coreHandlers.IdempotencyMiddleware
 }
 
 func NewRouteLoader(s options) environments.RouteLoader {
@@ -90,14 +92,34 @@ func (s *options) AddRoutes(mainRouter *mux.Router) error {
 	})
 
 	apiV1ConnectorsRouter := apiV1Router.PathPrefix("/kafka_connectors").Subrouter()
-	apiV1ConnectorsRouter.HandleFunc("", s.ConnectorsHandler.Create).Methods(http.MethodPost)
+	apiV1ConnectorsRouter.Handle("", s.IdempotencyMiddleware.Idempotent(http.HandlerFunc(s.ConnectorsHandler.Create))).Methods(http.MethodPost)
 	apiV1ConnectorsRouter.HandleFunc("", s.ConnectorsHandler.List).Methods(http.MethodGet)
 	apiV1ConnectorsRouter.HandleFunc("/{connector_id}", s.ConnectorsHandler.Get).Methods(http.MethodGet)
 	apiV1ConnectorsRouter.HandleFunc("/{connector_id}", s.ConnectorsHandler.Patch).Methods(http.MethodPatch)
 	apiV1ConnectorsRouter.HandleFunc("/{connector_id}", s.ConnectorsHandler.Delete).Methods(http.MethodDelete)
+	apiV1ConnectorsRouter.HandleFunc("/{connector_id}/move", s.ConnectorsHandler.Move).Methods(http.MethodPost)
+	apiV1ConnectorsRouter.HandleFunc("/{connector_id}/logs", s.ConnectorsHandler.Logs).Methods(http.MethodGet)
+	apiV1ConnectorsRouter.HandleFunc("/{connector_id}/metrics", s.ConnectorsHandler.Metrics).Methods(http.MethodGet)
+	apiV1ConnectorsRouter.HandleFunc("/{connector_id}/schedule/executions", s.ConnectorsHandler.ScheduleExecutions).Methods(http.MethodGet)
 	apiV1ConnectorsRouter.Use(authorizeMiddleware)
 	apiV1ConnectorsRouter.Use(requireOrgID)
 
+	//  /api/connector_mgmt/v1/kafka_connector_templates
+	v1Collections = append(v1Collections, api.CollectionMetadata{
+		ID:   "kafka_connector_templates",
+		Kind: "ConnectorTemplateList",
+	})
+
+	apiV1ConnectorTemplatesRouter := apiV1Router.PathPrefix("/kafka_connector_templates").Subrouter()
+	apiV1ConnectorTemplatesRouter.HandleFunc("", s.ConnectorTemplatesHandler.Create).Methods(http.MethodPost)
+	apiV1ConnectorTemplatesRouter.HandleFunc("", s.ConnectorTemplatesHandler.List).Methods(http.MethodGet)
+	apiV1ConnectorTemplatesRouter.HandleFunc("/{template_id}", s.ConnectorTemplatesHandler.Get).Methods(http.MethodGet)
+	apiV1ConnectorTemplatesRouter.HandleFunc("/{template_id}", s.ConnectorTemplatesHandler.Delete).Methods(http.MethodDelete)
+	apiV1ConnectorTemplatesRouter.Handle("/{template_id}/connectors", s.IdempotencyMiddleware.Idempotent(http.HandlerFunc(s.ConnectorTemplatesHandler.CreateConnector))).Methods(http.MethodPost)
+	apiV1ConnectorTemplatesRouter.HandleFunc("/{template_id}/connectors/bulk", s.ConnectorTemplatesHandler.BulkCreateConnectors).Methods(http.MethodPost)
+	apiV1ConnectorTemplatesRouter.Use(authorizeMiddleware)
+	apiV1ConnectorTemplatesRouter.Use(requireOrgID)
+
 	//  /api/connector_mgmt/v1/kafka_connector_clusters
 	v1Collections = append(v1Collections, api.CollectionMetadata{
 		ID:   "kafka_connector_clusters",
@@ -125,6 +147,9 @@ func (s *options) AddRoutes(mainRouter *mux.Router) error {
 	apiV1ConnectorNamespacesRouter.HandleFunc("", s.ConnectorNamespaceHandler.List).Methods(http.MethodGet)
 	apiV1ConnectorNamespacesRouter.HandleFunc("/eval", s.ConnectorNamespaceHandler.CreateEvaluation).Methods(http.MethodPost)
 	apiV1ConnectorNamespacesRouter.HandleFunc("/{connector_namespace_id}", s.ConnectorNamespaceHandler.Get).Methods(http.MethodGet)
+	apiV1ConnectorNamespacesRouter.HandleFunc("/{connector_namespace_id}/extend", s.ConnectorNamespaceHandler.Extend).Methods(http.MethodPost)
+	apiV1ConnectorNamespacesRouter.HandleFunc("/{connector_namespace_id}/convert", s.ConnectorNamespaceHandler.Convert).Methods(http.MethodPost)
+	apiV1ConnectorNamespacesRouter.HandleFunc("/{connector_namespace_id}/events", s.ConnectorNamespaceHandler.Events).Methods(http.MethodGet)
 	if s.ConnectorsConfig.ConnectorNamespaceLifecycleAPI {
 		apiV1ConnectorNamespacesRouter.HandleFunc("", s.ConnectorNamespaceHandler.Create).Methods(http.MethodPost)
 		apiV1ConnectorNamespacesRouter.HandleFunc("/{connector_namespace_id}", s.ConnectorNamespaceHandler.Update).Methods(http.MethodPatch)
@@ -148,6 +173,7 @@ func (s *options) AddRoutes(mainRouter *mux.Router) error {
 		agentRouter.HandleFunc("/namespaces/{namespace_id}", s.ConnectorClusterHandler.GetAgentNamespace).Methods(http.MethodGet)
 		agentRouter.HandleFunc("/namespaces/{namespace_id}/status", s.ConnectorClusterHandler.UpdateNamespaceStatus).Methods(http.MethodPut)
 		agentRouter.HandleFunc("/deployments/{deployment_id}/status", s.ConnectorClusterHandler.UpdateDeploymentStatus).Methods(http.MethodPut)
+		agentRouter.HandleFunc("/deployments/{deployment_id}/logs", s.ConnectorClusterHandler.AppendDeploymentLogs).Methods(http.MethodPost)
 		auth.UseOperatorAuthorisationMiddleware(agentRouter, s.KeycloakService.GetRealmConfig().ValidIssuerURI, "connector_cluster_id", s.AuthAgentService)
 	}
 
@@ -157,6 +183,7 @@ func (s *options) AddRoutes(mainRouter *mux.Router) error {
 	adminRouter.Use(auth.NewRolesAuthzMiddleware(s.AdminRoleAuthZConfig).RequireRolesForMethods(kerrors.ErrorNotFound))
 	adminRouter.Use(auth.NewAuditLogMiddleware().AuditLog(kerrors.ErrorNotFound))
 	adminRouter.HandleFunc("/kafka_connector_clusters", s.ConnectorAdminHandler.ListConnectorClusters).Methods(http.MethodGet)
+	adminRouter.HandleFunc("/kafka_connector_clusters/outdated_agents", s.ConnectorAdminHandler.ListOutdatedAgentClusters).Methods(http.MethodGet)
 	adminRouter.HandleFunc("/kafka_connector_clusters/{connector_cluster_id}", s.ConnectorAdminHandler.GetConnectorCluster).Methods(http.MethodGet)
 	adminRouter.HandleFunc("/kafka_connector_clusters/{connector_cluster_id}/namespaces", s.ConnectorAdminHandler.GetClusterNamespaces).Methods(http.MethodGet)
 	adminRouter.HandleFunc("/kafka_connector_clusters/{connector_cluster_id}/connectors", s.ConnectorAdminHandler.GetClusterConnectors).Methods(http.MethodGet)
@@ -175,6 +202,7 @@ func (s *options) AddRoutes(mainRouter *mux.Router) error {
 	adminRouter.HandleFunc("/kafka_connectors/{connector_id}", s.ConnectorAdminHandler.GetConnector).Methods(http.MethodGet)
 	adminRouter.HandleFunc("/kafka_connectors/{connector_id}", s.ConnectorAdminHandler.DeleteConnector).Methods(http.MethodDelete)
 	adminRouter.HandleFunc("/kafka_connectors/{connector_id}", s.ConnectorAdminHandler.PatchConnector).Methods(http.MethodPatch)
+	adminRouter.HandleFunc("/kafka_connectors/{connector_id}/move", s.ConnectorAdminHandler.MoveConnector).Methods(http.MethodPost)
 	adminRouter.HandleFunc("/kafka_connector_types", s.ConnectorAdminHandler.ListConnectorTypes).Methods(http.MethodGet)
 	adminRouter.HandleFunc("/kafka_connector_types/{connector_type_id}", s.ConnectorAdminHandler.GetConnectorType).Methods(http.MethodGet)
 
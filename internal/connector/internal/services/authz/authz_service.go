@@ -175,13 +175,21 @@ func (u *ValidationUser) AuthorizedCreateEvalNamespace() handlers.Validate {
 	}
 }
 
-func (u *ValidationUser) ValidateNamespaceConnectorQuota() handlers.ValidateOption {
+// ValidateNamespaceConnectorQuota checks that a connector of the given type and channel fits in the quota of the namespace
+func (u *ValidationUser) ValidateNamespaceConnectorQuota(connectorTypeId *string, channel *string) handlers.ValidateOption {
 	return func(field string, value *string) (err *errors.ServiceError) {
 		if u.err != nil {
 			err = u.err
 		} else {
 			if value != nil && len(*value) > 0 {
-				err = u.service.namespaceService.CheckConnectorQuota(*value)
+				var typeId, channelName string
+				if connectorTypeId != nil {
+					typeId = *connectorTypeId
+				}
+				if channel != nil {
+					channelName = *channel
+				}
+				err = u.service.namespaceService.CheckConnectorQuota(*value, typeId, channelName)
 			}
 		}
 		return err
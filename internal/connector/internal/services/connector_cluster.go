@@ -9,6 +9,7 @@ import (
 	"gorm.io/gorm/clause"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/phase"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/vault"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
@@ -31,6 +32,7 @@ type ConnectorClusterService interface {
 	Update(ctx context.Context, resource *dbapi.ConnectorCluster) *errors.ServiceError
 	UpdateConnectorClusterStatus(ctx context.Context, id string, status dbapi.ConnectorClusterStatus) *errors.ServiceError
 	GetConnectorClusterStatus(ctx context.Context, id string) (dbapi.ConnectorClusterStatus, *errors.ServiceError)
+	ListOutdatedAgentClusters(ctx context.Context) (dbapi.ConnectorClusterList, *errors.ServiceError)
 
 	SaveDeployment(ctx context.Context, resource *dbapi.ConnectorDeployment) *errors.ServiceError
 	UpdateDeployment(resource *dbapi.ConnectorDeployment) *errors.ServiceError
@@ -58,11 +60,13 @@ type connectorClusterService struct {
 	keycloakService           sso.KafkaKeycloakService
 	connectorsService         ConnectorsService
 	connectorNamespaceService ConnectorNamespaceService
+	agentConfig               *config.ConnectorAgentConfig
 }
 
 func NewConnectorClusterService(connectionFactory *db.ConnectionFactory, bus signalbus.SignalBus, vaultService vault.VaultService,
 	connectorTypesService ConnectorTypesService, connectorsService ConnectorsService,
-	keycloakService sso.KafkaKeycloakService, connectorNamespaceService ConnectorNamespaceService) *connectorClusterService {
+	keycloakService sso.KafkaKeycloakService, connectorNamespaceService ConnectorNamespaceService,
+	agentConfig *config.ConnectorAgentConfig) *connectorClusterService {
 	return &connectorClusterService{
 		connectionFactory:         connectionFactory,
 		bus:                       bus,
@@ -71,6 +75,7 @@ func NewConnectorClusterService(connectionFactory *db.ConnectionFactory, bus sig
 		connectorsService:         connectorsService,
 		keycloakService:           keycloakService,
 		connectorNamespaceService: connectorNamespaceService,
+		agentConfig:               agentConfig,
 	}
 }
 
@@ -230,6 +235,14 @@ func GetValidClusterColumns() []string {
 	return []string{"id", "created_at", "updated_at", "owner", "organisation_id", "name", "state", "client_id"}
 }
 
+// GetSearchClusterColumns returns the typed columns connector clusters can be searched by
+func GetSearchClusterColumns() []coreServices.Column {
+	columns := coreServices.SetColumnType(coreServices.TypedColumns(coreServices.StringColumn, GetValidClusterColumns()...), coreServices.TimestampColumn, "created_at", "updated_at")
+	return append(columns,
+		coreServices.Column{Name: "annotations", Type: coreServices.KeyValueColumn, Expression: "(SELECT jsonb_object_agg(key, value) FROM connector_cluster_annotations WHERE connector_cluster_annotations.connector_cluster_id = connector_clusters.id)"},
+	)
+}
+
 // List returns all connector clusters visible to the user within the requested paging window.
 func (k *connectorClusterService) List(ctx context.Context, listArgs *services.ListArguments) (dbapi.ConnectorClusterList, *api.PagingMeta, *errors.ServiceError) {
 	if err := listArgs.Validate(GetValidClusterColumns()); err != nil {
@@ -237,10 +250,6 @@ func (k *connectorClusterService) List(ctx context.Context, listArgs *services.L
 	}
 	var resourceList dbapi.ConnectorClusterList
 	dbConn := k.connectionFactory.New()
-	pagingMeta := &api.PagingMeta{
-		Page: listArgs.Page,
-		Size: listArgs.Size,
-	}
 
 	var err *errors.ServiceError
 	// allow admins to list clusters
@@ -257,23 +266,18 @@ func (k *connectorClusterService) List(ctx context.Context, listArgs *services.L
 
 	// Apply search query
 	if len(listArgs.Search) > 0 {
-		queryParser := coreServices.NewQueryParser(GetValidClusterColumns()...)
+		queryParser := coreServices.NewTypedQueryParser(GetSearchClusterColumns()...)
 		searchDbQuery, err := queryParser.Parse(listArgs.Search)
 		if err != nil {
-			return resourceList, pagingMeta, errors.NewWithCause(errors.ErrorFailedToParseSearch, err, "unable to list connector cluster requests: %s", err.Error())
+			return resourceList, nil, errors.NewWithCause(errors.ErrorFailedToParseSearch, err, "unable to list connector cluster requests: %s", err.Error())
 		}
 		dbConn = dbConn.Where(strings.ReplaceAll(searchDbQuery.Query, "state", "status_phase"), searchDbQuery.Values...)
 	}
 
-	// set total, limit and paging (based on https://gitlab.cee.redhat.com/service/api-guidelines#user-content-paging)
-	total := int64(pagingMeta.Total)
-	dbConn.Model(&resourceList).Count(&total)
-	pagingMeta.Total = int(total)
-
-	if pagingMeta.Size > pagingMeta.Total {
-		pagingMeta.Size = pagingMeta.Total
+	dbConn, pagingMeta, err := listArgs.Paginate(dbConn, &resourceList, "connector_clusters.id")
+	if err != nil {
+		return nil, nil, err
 	}
-	dbConn = dbConn.Offset((pagingMeta.Page - 1) * pagingMeta.Size).Limit(pagingMeta.Size)
 
 	// Set the order by arguments if any
 	if len(listArgs.OrderBy) == 0 {
@@ -340,6 +344,7 @@ func (k *connectorClusterService) UpdateConnectorClusterStatus(ctx context.Conte
 
 	// agent doesn't directly modify cluster phase, that's done in PerformClusterOperation()
 	status.Phase = resource.Status.Phase
+	setAgentConditions(k.agentConfig, resource.Status.Conditions, &status)
 
 	if updated || !reflect.DeepEqual(resource.Status, status) {
 
@@ -368,6 +373,28 @@ func (k *connectorClusterService) UpdateConnectorClusterStatus(ctx context.Conte
 	return nil
 }
 
+// ListOutdatedAgentClusters lists the clusters whose agent is older than the desired agent version
+func (k *connectorClusterService) ListOutdatedAgentClusters(ctx context.Context) (dbapi.ConnectorClusterList, *errors.ServiceError) {
+	var resourceList dbapi.ConnectorClusterList
+	if k.agentConfig.DesiredVersion == "" {
+		return resourceList, nil
+	}
+
+	var clusters dbapi.ConnectorClusterList
+	if err := k.connectionFactory.New().Preload(clause.Associations).
+		Where("status_phase <> ?", dbapi.ConnectorClusterPhaseDeleting).
+		Order("status_version, id").
+		Find(&clusters).Error; err != nil {
+		return nil, services.HandleGetError("Connector cluster", "status_version", k.agentConfig.DesiredVersion, err)
+	}
+	for _, cluster := range clusters {
+		if k.agentConfig.IsOutdated(cluster.Status.Version) {
+			resourceList = append(resourceList, cluster)
+		}
+	}
+	return resourceList, nil
+}
+
 // Get gets a connector by id from the database
 func (k *connectorClusterService) GetConnectorClusterStatus(ctx context.Context, id string) (dbapi.ConnectorClusterStatus, *errors.ServiceError) {
 
@@ -435,11 +462,6 @@ func (k *connectorClusterService) ListConnectorDeployments(ctx context.Context,
 	// specify preload for annotations only, to avoid skipping deleted connectors
 	dbConn = dbConn.Preload("Annotations").Joins("Status").Joins("ConnectorShardMetadata").Joins("Connector")
 
-	pagingMeta := &api.PagingMeta{
-		Page: listArgs.Page,
-		Size: listArgs.Size,
-	}
-
 	if clusterId != "" {
 		dbConn = dbConn.Where("connector_deployments.cluster_id = ?", clusterId)
 	}
@@ -463,24 +485,19 @@ func (k *connectorClusterService) ListConnectorDeployments(ctx context.Context,
 		queryParser := coreServices.NewQueryParserWithColumnPrefix("connector_deployments", GetValidDeploymentColumns()...)
 		searchDbQuery, err := queryParser.Parse(listArgs.Search)
 		if err != nil {
-			return resourceList, pagingMeta, errors.NewWithCause(errors.ErrorFailedToParseSearch, err, "unable to list connector deployments requests: %s", err.Error())
+			return resourceList, nil, errors.NewWithCause(errors.ErrorFailedToParseSearch, err, "unable to list connector deployments requests: %s", err.Error())
 		}
 		dbConn = dbConn.Where(searchDbQuery.Query, searchDbQuery.Values...)
 	}
 
-	// set total, limit and paging (based on https://gitlab.cee.redhat.com/service/api-guidelines#user-content-paging)
-	total := int64(pagingMeta.Total)
-	dbConn.Session(&gorm.Session{}).Model(&resourceList).Count(&total)
-	pagingMeta.Total = int(total)
-
-	if pagingMeta.Size > pagingMeta.Total {
-		pagingMeta.Size = pagingMeta.Total
-	}
-	dbConn = dbConn.Offset((pagingMeta.Page - 1) * pagingMeta.Size).Limit(pagingMeta.Size)
-
 	// default the order by version
 	dbConn = dbConn.Order("connector_deployments.version")
 
+	dbConn, pagingMeta, svcErr := listArgs.Paginate(dbConn, &resourceList, "connector_deployments.id")
+	if svcErr != nil {
+		return nil, nil, svcErr
+	}
+
 	// execute query
 	if err := dbConn.Find(&resourceList).Error; err != nil {
 		return resourceList, pagingMeta, services.HandleGetError("Connector deployment",
@@ -505,6 +522,18 @@ func (k *connectorClusterService) UpdateConnectorDeploymentStatus(ctx context.Co
 		return services.HandleGoneError("Connector deployment", "id", deploymentStatus.ID)
 	}
 
+	// keep the previous metrics of agents not reporting them in every status update
+	previous := dbapi.ConnectorDeploymentStatus{}
+	if err := dbConn.Select("metrics").Where("id = ?", deploymentStatus.ID).
+		Find(&previous).Error; err != nil {
+		return services.HandleGetError("Connector deployment status", "id", deploymentStatus.ID, err)
+	}
+	if deploymentStatus.Metrics.IsReported() {
+		deploymentStatus.Metrics.UpdateThroughput(previous.Metrics)
+	} else {
+		deploymentStatus.Metrics = previous.Metrics
+	}
+
 	if err := dbConn.Model(&deploymentStatus).Where("id = ? and version <= ?", deploymentStatus.ID, deploymentStatus.Version).Save(&deploymentStatus).Error; err != nil {
 		return errors.Conflict("failed to update deployment status: %s, probably a stale deployment status version was used: %d", err.Error(), deploymentStatus.Version)
 	}
@@ -0,0 +1,64 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/config"
+)
+
+// setAgentConditions adds the agent version conditions to the status reported by an agent,
+// keeping the transition time of the previous conditions that didn't change
+func setAgentConditions(agentConfig *config.ConnectorAgentConfig, previous dbapi.ConditionList, status *dbapi.ConnectorClusterStatus) {
+	var conditions dbapi.ConditionList
+	for _, condition := range status.Conditions {
+		// agents can't report the conditions owned by the fleet manager
+		if condition.Type != dbapi.ConnectorClusterAgentSupportedCondition && condition.Type != dbapi.ConnectorClusterAgentUpToDateCondition {
+			conditions = append(conditions, condition)
+		}
+	}
+
+	if agentConfig.HasCompatibilityMatrix() {
+		condition := dbapi.Condition{
+			Type:    dbapi.ConnectorClusterAgentSupportedCondition,
+			Status:  "True",
+			Reason:  "SupportedVersion",
+			Message: fmt.Sprintf("agent version %s is supported", status.Version),
+		}
+		if _, ok := agentConfig.SupportedOperators(status.Version); !ok {
+			condition.Status = "False"
+			condition.Reason = "UnsupportedVersion"
+			condition.Message = fmt.Sprintf("agent version %q is not supported, connectors can't be deployed until the agent is upgraded", status.Version)
+		}
+		conditions = append(conditions, withTransitionTime(condition, previous))
+	}
+
+	if agentConfig.DesiredVersion != "" {
+		condition := dbapi.Condition{
+			Type:    dbapi.ConnectorClusterAgentUpToDateCondition,
+			Status:  "True",
+			Reason:  "DesiredVersion",
+			Message: fmt.Sprintf("agent version %s is up to date", status.Version),
+		}
+		if agentConfig.IsOutdated(status.Version) {
+			condition.Status = "False"
+			condition.Reason = "UpgradePending"
+			condition.Message = fmt.Sprintf("agent version %q is older than desired version %s", status.Version, agentConfig.DesiredVersion)
+		}
+		conditions = append(conditions, withTransitionTime(condition, previous))
+	}
+
+	status.Conditions = conditions
+}
+
+func withTransitionTime(condition dbapi.Condition, previous dbapi.ConditionList) dbapi.Condition {
+	condition.LastTransitionTime = time.Now().UTC().Format(time.RFC3339)
+	for _, p := range previous {
+		if p.Type == condition.Type && p.Status == condition.Status {
+			condition.LastTransitionTime = p.LastTransitionTime
+			break
+		}
+	}
+	return condition
+}
@@ -0,0 +1,95 @@
+package services
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_connectorClusterService_UpdateConnectorDeploymentStatus_metrics(t *testing.T) {
+	previousReportedAt := time.Date(2023, time.March, 29, 10, 0, 0, 0, time.UTC)
+	previous := dbapi.ConnectorDeploymentMetrics{
+		ReportedAt:          previousReportedAt,
+		RecordsIn:           100,
+		RecordsOut:          50,
+		RecordsInPerSecond:  3,
+		RecordsOutPerSecond: 2,
+	}
+
+	tests := []struct {
+		name    string
+		metrics dbapi.ConnectorDeploymentMetrics
+		want    dbapi.ConnectorDeploymentMetrics
+	}{
+		{
+			name: "should keep the previous metrics when none are reported",
+			want: previous,
+		},
+		{
+			name: "should compute the throughput from the previous metrics",
+			metrics: dbapi.ConnectorDeploymentMetrics{
+				ReportedAt: previousReportedAt.Add(10 * time.Second),
+				RecordsIn:  200,
+				RecordsOut: 100,
+			},
+			want: dbapi.ConnectorDeploymentMetrics{
+				ReportedAt:          previousReportedAt.Add(10 * time.Second),
+				RecordsIn:           200,
+				RecordsOut:          100,
+				RecordsInPerSecond:  10,
+				RecordsOutPerSecond: 5,
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+
+			previousMetrics, err := json.Marshal(previous)
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+
+			var saved *dbapi.ConnectorDeploymentMetrics
+			mocket.Catcher.Reset()
+			mocket.Catcher.NewMock().WithQuery(`SELECT "connector_id","deleted_at" FROM "connector_deployments"`).
+				WithReply([]map[string]interface{}{{"connector_id": "connector-id"}})
+			mocket.Catcher.NewMock().WithQuery(`SELECT "metrics" FROM "connector_deployment_statuses"`).
+				WithReply([]map[string]interface{}{{"metrics": previousMetrics}})
+			mocket.Catcher.NewMock().WithQuery(`UPDATE "connector_deployment_statuses" SET`).WithRowsNum(1).
+				WithCallback(func(query string, args []driver.NamedValue) {
+					for _, arg := range args {
+						if value, ok := arg.Value.([]byte); ok {
+							var metrics dbapi.ConnectorDeploymentMetrics
+							if json.Unmarshal(value, &metrics) == nil && metrics.IsReported() {
+								saved = &metrics
+							}
+						}
+					}
+				})
+			mocket.Catcher.NewMock().WithQuery(`SELECT "desired_state" FROM "connectors"`).
+				WithReply([]map[string]interface{}{{"desired_state": "ready"}})
+			mocket.Catcher.NewMock().WithQuery(`SELECT "phase" FROM "connector_statuses"`).
+				WithReply([]map[string]interface{}{{"phase": "ready"}})
+			mocket.Catcher.NewMock().WithQuery(`UPDATE "connector_statuses" SET`).WithRowsNum(1)
+
+			k := NewConnectorClusterService(db.NewMockConnectionFactory(nil), nil, nil, nil, nil, nil, nil, nil)
+			status := dbapi.ConnectorDeploymentStatus{
+				Phase:   dbapi.ConnectorStatusPhaseReady,
+				Metrics: tt.metrics,
+			}
+			status.ID = "deployment-id"
+
+			g.Expect(k.UpdateConnectorDeploymentStatus(context.Background(), status)).To(gomega.BeNil())
+			g.Expect(saved).ToNot(gomega.BeNil())
+			g.Expect(*saved).To(gomega.Equal(tt.want))
+		})
+	}
+}
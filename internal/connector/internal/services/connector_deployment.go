@@ -22,5 +22,8 @@ func deleteConnectorDeployment(dbConn *gorm.DB, id string) *errors.ServiceError
 			return err
 		}
 	}
+	if err := dbConn.Where("deployment_id = ?", id).Delete(&dbapi.ConnectorDeploymentLog{}).Error; err != nil {
+		return services.HandleDeleteError("ConnectorDeploymentLog", "deployment_id", id, err)
+	}
 	return nil
 }
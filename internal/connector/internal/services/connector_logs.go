@@ -0,0 +1,97 @@
+package services
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"gorm.io/gorm"
+)
+
+// ConnectorLogsService stores the log lines and events relayed by agents for connector deployments
+type ConnectorLogsService interface {
+	// AppendDeploymentLogs adds entries to the logs of a deployment, dropping its oldest entries beyond the configured maximum
+	AppendDeploymentLogs(ctx context.Context, deployment *dbapi.ConnectorDeployment, entries dbapi.ConnectorDeploymentLogList) *errors.ServiceError
+	// ListConnectorLogs returns the last tail entries of a connector since the given time, oldest first.
+	// A tail of 0 returns all entries, and a zero time doesn't filter on time.
+	ListConnectorLogs(ctx context.Context, connectorId string, tail int, since time.Time) (dbapi.ConnectorDeploymentLogList, *errors.ServiceError)
+}
+
+var _ ConnectorLogsService = &connectorLogsService{}
+
+type connectorLogsService struct {
+	connectionFactory *db.ConnectionFactory
+	connectorsConfig  *config.ConnectorsConfig
+}
+
+func NewConnectorLogsService(connectionFactory *db.ConnectionFactory, connectorsConfig *config.ConnectorsConfig) *connectorLogsService {
+	return &connectorLogsService{
+		connectionFactory: connectionFactory,
+		connectorsConfig:  connectorsConfig,
+	}
+}
+
+func (k *connectorLogsService) AppendDeploymentLogs(ctx context.Context, deployment *dbapi.ConnectorDeployment, entries dbapi.ConnectorDeploymentLogList) *errors.ServiceError {
+	maxEntries := k.connectorsConfig.ConnectorDeploymentLogsMaxEntries
+	if len(entries) == 0 || maxEntries <= 0 {
+		return nil
+	}
+
+	// entries are kept in the order they are received, so sort each batch to keep them chronological
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Timestamp.Before(entries[j].Timestamp)
+	})
+	if len(entries) > maxEntries {
+		entries = entries[len(entries)-maxEntries:]
+	}
+	for i := range entries {
+		entries[i].ID = 0
+		entries[i].DeploymentID = deployment.ID
+		entries[i].ConnectorID = deployment.ConnectorID
+	}
+
+	if err := k.connectionFactory.New().Transaction(func(dbConn *gorm.DB) error {
+		if err := dbConn.Create(&entries).Error; err != nil {
+			return errors.GeneralError("failed to save logs of connector deployment %s: %v", deployment.ID, err)
+		}
+
+		// drop the oldest entries of the ring buffer
+		latest := dbConn.Model(&dbapi.ConnectorDeploymentLog{}).Select("id").
+			Where("deployment_id = ?", deployment.ID).
+			Order("id DESC").Limit(maxEntries)
+		if err := dbConn.Where("deployment_id = ? AND id NOT IN (?)", deployment.ID, latest).
+			Delete(&dbapi.ConnectorDeploymentLog{}).Error; err != nil {
+			return services.HandleDeleteError("ConnectorDeploymentLog", "deployment_id", deployment.ID, err)
+		}
+		return nil
+	}); err != nil {
+		return errors.ToServiceError(err)
+	}
+	return nil
+}
+
+func (k *connectorLogsService) ListConnectorLogs(ctx context.Context, connectorId string, tail int, since time.Time) (dbapi.ConnectorDeploymentLogList, *errors.ServiceError) {
+	dbConn := k.connectionFactory.New().Where("connector_id = ?", connectorId)
+	if !since.IsZero() {
+		dbConn = dbConn.Where("timestamp >= ?", since)
+	}
+	if tail > 0 {
+		dbConn = dbConn.Limit(tail)
+	}
+
+	var entries dbapi.ConnectorDeploymentLogList
+	if err := dbConn.Order("id DESC").Find(&entries).Error; err != nil {
+		return nil, services.HandleGetError("Connector logs", "connector_id", connectorId, err)
+	}
+
+	// return the tail oldest first
+	for i, j := 0, len(entries)-1; i < j; i, j = i+1, j-1 {
+		entries[i], entries[j] = entries[j], entries[i]
+	}
+	return entries, nil
+}
@@ -0,0 +1,196 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/golang/glog"
+)
+
+const (
+	namespaceEventWebhookTimeout = 10 * time.Second
+	// namespaceEventWebhookMaxAttempts is the number of times an event is posted to the webhook before giving up
+	namespaceEventWebhookMaxAttempts = 10
+)
+
+// ConnectorNamespaceEventsService records namespace lifecycle events,
+// and posts them to the configured webhook once the current transaction is committed
+type ConnectorNamespaceEventsService interface {
+	Record(ctx context.Context, namespace *dbapi.ConnectorNamespace, eventType dbapi.ConnectorNamespaceEventType, message string) *errors.ServiceError
+	// List returns the events of a namespace, most recent first
+	List(ctx context.Context, namespaceId string) (dbapi.ConnectorNamespaceEventList, *errors.ServiceError)
+	// ReconcileUndeliveredEvents posts the events that failed to be posted to the webhook again
+	ReconcileUndeliveredEvents(ctx context.Context) (int64, *errors.ServiceError)
+}
+
+var _ ConnectorNamespaceEventsService = &connectorNamespaceEventsService{}
+
+type connectorNamespaceEventsService struct {
+	connectionFactory *db.ConnectionFactory
+	connectorsConfig  *config.ConnectorsConfig
+	httpClient        *http.Client
+}
+
+func NewConnectorNamespaceEventsService(connectionFactory *db.ConnectionFactory, connectorsConfig *config.ConnectorsConfig) *connectorNamespaceEventsService {
+	return &connectorNamespaceEventsService{
+		connectionFactory: connectionFactory,
+		connectorsConfig:  connectorsConfig,
+		httpClient:        &http.Client{Timeout: namespaceEventWebhookTimeout},
+	}
+}
+
+// namespaceEventWebhookRequest is the body posted to the namespace events webhook
+type namespaceEventWebhookRequest struct {
+	// EventID is the same for every attempt to post an event, for receivers to ignore duplicates
+	EventID     int64      `json:"event_id"`
+	NamespaceID string     `json:"namespace_id"`
+	Name        string     `json:"name"`
+	Owner       string     `json:"owner"`
+	Type        string     `json:"type"`
+	Timestamp   time.Time  `json:"timestamp"`
+	Expiration  *time.Time `json:"expiration,omitempty"`
+	Message     string     `json:"message"`
+}
+
+func (k *connectorNamespaceEventsService) Record(ctx context.Context, namespace *dbapi.ConnectorNamespace, eventType dbapi.ConnectorNamespaceEventType, message string) *errors.ServiceError {
+	url := k.connectorsConfig.ConnectorEvalExpiryWebhookURL
+	event := dbapi.ConnectorNamespaceEvent{
+		NamespaceID:    namespace.ID,
+		Type:           eventType,
+		Timestamp:      time.Now().UTC(),
+		Expiration:     namespace.Expiration,
+		Message:        message,
+		WebhookPending: url != "",
+	}
+	if err := k.connectionFactory.New().Create(&event).Error; err != nil {
+		return errors.GeneralError("failed to save event of connector namespace %s: %v", namespace.ID, err)
+	}
+	glog.Infof("connector namespace %s event %s: %s", namespace.ID, eventType, message)
+
+	if url == "" {
+		return nil
+	}
+	deliver := func() {
+		go k.deliver(url, namespace, &event)
+	}
+	// only notify the webhook of committed events, or right away outside a transaction,
+	// events that fail to be posted are posted again by ReconcileUndeliveredEvents
+	if err := db.AddPostCommitAction(ctx, deliver); err != nil {
+		deliver()
+	}
+	return nil
+}
+
+// deliver posts an event to the webhook and records the outcome in the event
+func (k *connectorNamespaceEventsService) deliver(url string, namespace *dbapi.ConnectorNamespace, event *dbapi.ConnectorNamespaceEvent) {
+	err := k.post(url, namespaceEventWebhookRequest{
+		EventID:     event.ID,
+		NamespaceID: namespace.ID,
+		Name:        namespace.Name,
+		Owner:       namespace.Owner,
+		Type:        string(event.Type),
+		Timestamp:   event.Timestamp,
+		Expiration:  event.Expiration,
+		Message:     event.Message,
+	})
+
+	event.WebhookAttempts++
+	event.WebhookPending = err != nil && event.WebhookAttempts < namespaceEventWebhookMaxAttempts
+	if err != nil {
+		if event.WebhookPending {
+			glog.Errorf("failed to post event %s of connector namespace %s to webhook, attempt %d of %d: %v",
+				event.Type, namespace.ID, event.WebhookAttempts, namespaceEventWebhookMaxAttempts, err)
+		} else {
+			glog.Errorf("giving up posting event %s of connector namespace %s to webhook after %d attempts: %v",
+				event.Type, namespace.ID, event.WebhookAttempts, err)
+		}
+	}
+
+	if err := k.connectionFactory.New().Model(event).UpdateColumns(map[string]interface{}{
+		"webhook_pending":  event.WebhookPending,
+		"webhook_attempts": event.WebhookAttempts,
+	}).Error; err != nil {
+		glog.Errorf("failed to update webhook delivery of event %d of connector namespace %s: %v", event.ID, namespace.ID, err)
+	}
+}
+
+func (k *connectorNamespaceEventsService) ReconcileUndeliveredEvents(ctx context.Context) (int64, *errors.ServiceError) {
+	url := k.connectorsConfig.ConnectorEvalExpiryWebhookURL
+	if url == "" {
+		return 0, nil
+	}
+
+	// recent events may still be posted by the service that recorded them
+	before := time.Now().Add(-namespaceEventWebhookTimeout)
+	var events dbapi.ConnectorNamespaceEventList
+	if err := k.connectionFactory.New().Where("webhook_pending AND timestamp < ?", before).
+		Order("id").Find(&events).Error; err != nil {
+		return 0, services.HandleGetError("Connector namespace events", "webhook_pending", true, err)
+	}
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	namespaceIds := make([]string, 0, len(events))
+	for _, event := range events {
+		namespaceIds = append(namespaceIds, event.NamespaceID)
+	}
+	// events of deleted namespaces are still delivered
+	var namespaces dbapi.ConnectorNamespaceList
+	if err := k.connectionFactory.New().Unscoped().Where("id IN ?", namespaceIds).Find(&namespaces).Error; err != nil {
+		return 0, services.HandleGetError("Connector namespace", "id", namespaceIds, err)
+	}
+	namespacesById := make(map[string]*dbapi.ConnectorNamespace, len(namespaces))
+	for _, namespace := range namespaces {
+		namespacesById[namespace.ID] = namespace
+	}
+
+	for i := range events {
+		event := &events[i]
+		namespace, ok := namespacesById[event.NamespaceID]
+		if !ok {
+			// the namespace is gone, the event cannot be described anymore
+			namespace = &dbapi.ConnectorNamespace{}
+			namespace.ID = event.NamespaceID
+		}
+		k.deliver(url, namespace, event)
+	}
+
+	return int64(len(events)), nil
+}
+
+func (k *connectorNamespaceEventsService) post(url string, request namespaceEventWebhookRequest) error {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+	response, err := k.httpClient.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = response.Body.Close() }()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		message, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+		return fmt.Errorf("unexpected status %d: %s", response.StatusCode, message)
+	}
+	return nil
+}
+
+func (k *connectorNamespaceEventsService) List(ctx context.Context, namespaceId string) (dbapi.ConnectorNamespaceEventList, *errors.ServiceError) {
+	var events dbapi.ConnectorNamespaceEventList
+	if err := k.connectionFactory.New().Where("namespace_id = ?", namespaceId).
+		Order("id DESC").Find(&events).Error; err != nil {
+		return nil, services.HandleGetError("Connector namespace events", "namespace_id", namespaceId, err)
+	}
+	return events, nil
+}
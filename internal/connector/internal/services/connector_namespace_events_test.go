@@ -0,0 +1,132 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_connectorNamespaceEventsService_deliver(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       int
+		attempts     int
+		wantPending  bool
+		wantAttempts int
+	}{
+		{
+			name:         "should record a delivered event",
+			status:       http.StatusOK,
+			wantPending:  false,
+			wantAttempts: 1,
+		},
+		{
+			name:         "should keep a failed event pending",
+			status:       http.StatusServiceUnavailable,
+			wantPending:  true,
+			wantAttempts: 1,
+		},
+		{
+			name:         "should give up after the last attempt",
+			status:       http.StatusServiceUnavailable,
+			attempts:     namespaceEventWebhookMaxAttempts - 1,
+			wantPending:  false,
+			wantAttempts: namespaceEventWebhookMaxAttempts,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+
+			var received namespaceEventWebhookRequest
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				g.Expect(json.NewDecoder(r.Body).Decode(&received)).To(gomega.Succeed())
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			mocket.Catcher.Reset()
+			update := mocket.Catcher.NewMock().WithQuery(`UPDATE "connector_namespace_events" SET`).WithRowsNum(1)
+
+			k := NewConnectorNamespaceEventsService(db.NewMockConnectionFactory(nil), config.NewConnectorsConfig())
+			namespace := &dbapi.ConnectorNamespace{Name: "eval", Owner: "owner"}
+			namespace.ID = "namespace-id"
+			event := &dbapi.ConnectorNamespaceEvent{
+				ID:              42,
+				NamespaceID:     namespace.ID,
+				Type:            dbapi.ConnectorNamespaceEventExpiryWarning,
+				Timestamp:       time.Now(),
+				Message:         "namespace eval expires",
+				WebhookPending:  true,
+				WebhookAttempts: tt.attempts,
+			}
+			k.deliver(server.URL, namespace, event)
+
+			g.Expect(received.EventID).To(gomega.Equal(int64(42)))
+			g.Expect(received.NamespaceID).To(gomega.Equal(namespace.ID))
+			g.Expect(event.WebhookPending).To(gomega.Equal(tt.wantPending))
+			g.Expect(event.WebhookAttempts).To(gomega.Equal(tt.wantAttempts))
+			g.Expect(update.Triggered).To(gomega.BeTrue())
+		})
+	}
+}
+
+func Test_connectorNamespaceEventsService_ReconcileUndeliveredEvents(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	var received []namespaceEventWebhookRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request namespaceEventWebhookRequest
+		g.Expect(json.NewDecoder(r.Body).Decode(&request)).To(gomega.Succeed())
+		received = append(received, request)
+	}))
+	defer server.Close()
+
+	mocket.Catcher.Reset()
+	mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "connector_namespace_events" WHERE webhook_pending AND timestamp < $1`).
+		WithReply([]map[string]interface{}{
+			{"id": 1, "namespace_id": "namespace-id", "type": "expiry_warning", "webhook_pending": true, "webhook_attempts": 1},
+			{"id": 2, "namespace_id": "deleted-namespace-id", "type": "extended", "webhook_pending": true, "webhook_attempts": 2},
+		})
+	mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "connector_namespaces" WHERE id IN ($1,$2)`).
+		WithReply([]map[string]interface{}{{"id": "namespace-id", "name": "eval", "owner": "owner"}})
+	update := mocket.Catcher.NewMock().WithQuery(`UPDATE "connector_namespace_events" SET`).WithRowsNum(1)
+
+	connectorsConfig := config.NewConnectorsConfig()
+	connectorsConfig.ConnectorEvalExpiryWebhookURL = server.URL
+	k := NewConnectorNamespaceEventsService(db.NewMockConnectionFactory(nil), connectorsConfig)
+
+	count, err := k.ReconcileUndeliveredEvents(context.Background())
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(count).To(gomega.Equal(int64(2)))
+	g.Expect(update.Triggered).To(gomega.BeTrue())
+	g.Expect(received).To(gomega.HaveLen(2))
+	g.Expect(received[0].EventID).To(gomega.Equal(int64(1)))
+	g.Expect(received[0].Name).To(gomega.Equal("eval"))
+	g.Expect(received[1].EventID).To(gomega.Equal(int64(2)))
+	g.Expect(received[1].NamespaceID).To(gomega.Equal("deleted-namespace-id"))
+}
+
+func Test_connectorNamespaceEventsService_ReconcileUndeliveredEvents_withoutWebhook(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	mocket.Catcher.Reset()
+	query := mocket.Catcher.NewMock().WithQuery(`FROM "connector_namespace_events"`)
+
+	k := NewConnectorNamespaceEventsService(db.NewMockConnectionFactory(nil), config.NewConnectorsConfig())
+	count, err := k.ReconcileUndeliveredEvents(context.Background())
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(count).To(gomega.BeZero())
+	g.Expect(query.Triggered).To(gomega.BeFalse())
+}
@@ -26,6 +26,7 @@ import (
 	"gorm.io/gorm"
 )
 
+//go:generate moq -out connector_namespaces_moq.go . ConnectorNamespaceService
 type ConnectorNamespaceService interface {
 	Create(ctx context.Context, request *dbapi.ConnectorNamespace) *errors.ServiceError
 	Update(ctx context.Context, request *dbapi.ConnectorNamespace) *errors.ServiceError
@@ -37,22 +38,31 @@ type ConnectorNamespaceService interface {
 	UpdateConnectorNamespaceStatus(ctx context.Context, namespaceID string, status *dbapi.ConnectorNamespaceStatus) *errors.ServiceError
 	DeleteNamespaces(ctx context.Context, dbConn *gorm.DB, query interface{}, values ...interface{}) (int64, *errors.ServiceError)
 	ReconcileExpiredNamespaces(ctx context.Context) (int64, *errors.ServiceError)
+	ReconcileExpiringNamespaces(ctx context.Context) (int64, *errors.ServiceError)
+	ExtendExpiration(ctx context.Context, namespaceId string, extension time.Duration) (*dbapi.ConnectorNamespace, *errors.ServiceError)
+	MoveConnectors(ctx context.Context, namespace *dbapi.ConnectorNamespace, connectors dbapi.ConnectorList) *errors.ServiceError
 	ReconcileUnusedDeletingNamespaces(ctx context.Context) (int64, *errors.ServiceError)
 	ReconcileUsedDeletingNamespaces(ctx context.Context) (int64, *errors.ServiceError)
 	ReconcileDeletedNamespaces(ctx context.Context) (int64, *errors.ServiceError)
 	GetNamespaceTenant(namespaceId string) (*dbapi.ConnectorNamespace, *errors.ServiceError)
-	CheckConnectorQuota(namespaceId string) *errors.ServiceError
+	CheckConnectorQuota(namespaceId string, connectorTypeId string, channel string) *errors.ServiceError
+	CheckConnectorsQuota(namespaceId string, connectors dbapi.ConnectorList) ([]*errors.ServiceError, *errors.ServiceError)
+	GetRemainingQuota(namespaceId string) (config.NamespaceQuota, *errors.ServiceError)
 	CanCreateEvalNamespace(userId string) *errors.ServiceError
 	GetEmptyDeletingNamespaces(clusterId string) (dbapi.ConnectorNamespaceList, *errors.ServiceError)
 }
 
 var _ ConnectorNamespaceService = &connectorNamespaceService{}
 
+// connectorMoveGracePeriod is the minimum time an expiring namespace is kept for, once its connectors are moved out of it
+const connectorMoveGracePeriod = time.Hour
+
 type connectorNamespaceService struct {
 	connectionFactory *db.ConnectionFactory
 	connectorsConfig  *config.ConnectorsConfig
 	quotaConfig       *config.ConnectorsQuotaConfig
 	bus               signalbus.SignalBus
+	eventsService     ConnectorNamespaceEventsService
 }
 
 func init() {
@@ -61,12 +71,13 @@ func init() {
 }
 
 func NewConnectorNamespaceService(factory *db.ConnectionFactory, config *config.ConnectorsConfig,
-	quotaConfig *config.ConnectorsQuotaConfig, bus signalbus.SignalBus) *connectorNamespaceService {
+	quotaConfig *config.ConnectorsQuotaConfig, bus signalbus.SignalBus, eventsService ConnectorNamespaceEventsService) *connectorNamespaceService {
 	return &connectorNamespaceService{
 		connectionFactory: factory,
 		connectorsConfig:  config,
 		quotaConfig:       quotaConfig,
 		bus:               bus,
+		eventsService:     eventsService,
 	}
 }
 
@@ -125,6 +136,7 @@ func (k *connectorNamespaceService) Create(ctx context.Context, request *dbapi.C
 	if err := k.validateAnnotations(request); err != nil {
 		return err
 	}
+	request.UpdateNextExpiryWarning(k.connectorsConfig.ConnectorEvalExpiryWarnings, time.Now())
 
 	dbConn := k.connectionFactory.New()
 	if err := dbConn.Create(request).Error; err != nil {
@@ -240,17 +252,20 @@ func GetValidNamespaceColumns() []string {
 	return []string{"id", "created_at", "updated_at", "name", "cluster_id", "owner", "expiration", "tenant_user_id", "tenant_organisation_id", "state"}
 }
 
+// GetSearchNamespaceColumns returns the typed columns connector namespaces can be searched by
+func GetSearchNamespaceColumns() []queryparser.Column {
+	columns := queryparser.SetColumnType(queryparser.TypedColumns(queryparser.StringColumn, GetValidNamespaceColumns()...), queryparser.TimestampColumn, "created_at", "updated_at", "expiration")
+	return append(columns,
+		queryparser.Column{Name: "annotations", Type: queryparser.KeyValueColumn, Expression: "(SELECT jsonb_object_agg(key, value) FROM connector_namespace_annotations WHERE connector_namespace_annotations.namespace_id = connector_namespaces.id)"},
+	)
+}
+
 func (k *connectorNamespaceService) List(ctx context.Context, clusterIDs []string, listArguments *services.ListArguments, gtVersion int64) (dbapi.ConnectorNamespaceList, *api.PagingMeta, *errors.ServiceError) {
 	if err := listArguments.Validate(GetValidNamespaceColumns()); err != nil {
 		return nil, nil, errors.NewWithCause(errors.ErrorMalformedRequest, err, "Unable to list connector namespace requests: %s", err.Error())
 	}
 
 	var resourceList dbapi.ConnectorNamespaceList
-	pagingMeta := api.PagingMeta{
-		Page:  listArguments.Page,
-		Size:  listArguments.Size,
-		Total: 0,
-	}
 	dbConn := k.connectionFactory.New().Model(&resourceList)
 	if len(clusterIDs) != 0 {
 		dbConn = dbConn.Where("cluster_id IN ?", clusterIDs)
@@ -258,10 +273,10 @@ func (k *connectorNamespaceService) List(ctx context.Context, clusterIDs []strin
 
 	// Apply search query
 	if len(listArguments.Search) > 0 {
-		queryParser := queryparser.NewQueryParserWithColumnPrefix("connector_namespaces", GetValidNamespaceColumns()...)
+		queryParser := queryparser.NewTypedQueryParserWithColumnPrefix("connector_namespaces", GetSearchNamespaceColumns()...)
 		searchDbQuery, err := queryParser.Parse(listArguments.Search)
 		if err != nil {
-			return resourceList, &pagingMeta, errors.NewWithCause(errors.ErrorFailedToParseSearch, err, "Unable to list connector namespace requests: %s", err.Error())
+			return resourceList, nil, errors.NewWithCause(errors.ErrorFailedToParseSearch, err, "Unable to list connector namespace requests: %s", err.Error())
 		}
 		dbConn = dbConn.Where(strings.ReplaceAll(searchDbQuery.Query, "connector_namespaces.state", "connector_namespaces.status_phase"), searchDbQuery.Values...)
 	}
@@ -271,14 +286,10 @@ func (k *connectorNamespaceService) List(ctx context.Context, clusterIDs []strin
 		dbConn = dbConn.Where("connector_namespaces.version > ?", gtVersion)
 	}
 
-	// set total, limit and paging (based on https://gitlab.cee.redhat.com/service/api-guidelines#user-content-paging)
-	total := int64(pagingMeta.Total)
-	dbConn.Count(&total)
-	pagingMeta.Total = int(total)
-	if pagingMeta.Size > pagingMeta.Total {
-		pagingMeta.Size = pagingMeta.Total
+	dbConn, pagingMeta, svcErr := listArguments.Paginate(dbConn, &resourceList, "connector_namespaces.id")
+	if svcErr != nil {
+		return nil, nil, svcErr
 	}
-	dbConn = dbConn.Offset((pagingMeta.Page - 1) * pagingMeta.Size).Limit(pagingMeta.Size)
 
 	// Set the order by arguments if any
 	if len(listArguments.OrderBy) == 0 {
@@ -297,9 +308,9 @@ func (k *connectorNamespaceService) List(ctx context.Context, clusterIDs []strin
 	}
 
 	if err := k.setConnectorsDeployed(resourceList); err != nil {
-		return resourceList, &pagingMeta, err
+		return resourceList, pagingMeta, err
 	}
-	return resourceList, &pagingMeta, nil
+	return resourceList, pagingMeta, nil
 }
 
 func (k *connectorNamespaceService) Delete(ctx context.Context, namespaceId string) *errors.ServiceError {
@@ -556,6 +567,179 @@ func (k *connectorNamespaceService) ReconcileExpiredNamespaces(ctx context.Conte
 	return count, nil
 }
 
+// ReconcileExpiringNamespaces emits the due expiry warnings of namespaces, and schedules their next warnings
+func (k *connectorNamespaceService) ReconcileExpiringNamespaces(ctx context.Context) (int64, *errors.ServiceError) {
+	now := time.Now()
+	if err := k.scheduleMissingExpiryWarnings(now); err != nil {
+		return 0, err
+	}
+
+	var namespaces dbapi.ConnectorNamespaceList
+	if err := k.connectionFactory.New().
+		Where("next_expiry_warning_at <= ? AND expiration > ? AND status_phase NOT IN ?", now, now,
+			[]string{string(dbapi.ConnectorNamespacePhaseDeleting), string(dbapi.ConnectorNamespacePhaseDeleted)}).
+		Find(&namespaces).Error; err != nil {
+		return 0, services.HandleGetError("Connector namespace", "next_expiry_warning_at", now, err)
+	}
+
+	for _, namespace := range namespaces {
+		message := fmt.Sprintf("namespace %s expires in %s, at %s, its connectors will be deleted", namespace.Name,
+			namespace.Expiration.Sub(now).Round(time.Minute), namespace.Expiration.UTC().Format(time.RFC3339))
+		if err := k.eventsService.Record(ctx, namespace, dbapi.ConnectorNamespaceEventExpiryWarning, message); err != nil {
+			return 0, err
+		}
+
+		namespace.UpdateNextExpiryWarning(k.connectorsConfig.ConnectorEvalExpiryWarnings, now)
+		if err := k.connectionFactory.New().Model(namespace).
+			UpdateColumn("next_expiry_warning_at", namespace.NextExpiryWarningAt).Error; err != nil {
+			return 0, services.HandleUpdateError("Connector namespace", err)
+		}
+	}
+
+	return int64(len(namespaces)), nil
+}
+
+// scheduleMissingExpiryWarnings schedules the next expiry warning of namespaces that can still be warned but have no warning scheduled,
+// i.e. namespaces created before expiry warnings existed
+func (k *connectorNamespaceService) scheduleMissingExpiryWarnings(now time.Time) *errors.ServiceError {
+	offsets := k.connectorsConfig.ConnectorEvalExpiryWarnings
+	var minOffset time.Duration
+	for _, offset := range offsets {
+		if offset > 0 && (minOffset == 0 || offset < minOffset) {
+			minOffset = offset
+		}
+	}
+	if minOffset == 0 {
+		return nil
+	}
+
+	var namespaces dbapi.ConnectorNamespaceList
+	if err := k.connectionFactory.New().
+		Where("next_expiry_warning_at IS NULL AND expiration > ? AND status_phase NOT IN ?", now.Add(minOffset),
+			[]string{string(dbapi.ConnectorNamespacePhaseDeleting), string(dbapi.ConnectorNamespacePhaseDeleted)}).
+		Find(&namespaces).Error; err != nil {
+		return services.HandleGetError("Connector namespace", "next_expiry_warning_at", nil, err)
+	}
+
+	for _, namespace := range namespaces {
+		namespace.UpdateNextExpiryWarning(offsets, now)
+		if err := k.connectionFactory.New().Model(namespace).
+			UpdateColumn("next_expiry_warning_at", namespace.NextExpiryWarningAt).Error; err != nil {
+			return services.HandleUpdateError("Connector namespace", err)
+		}
+	}
+
+	return nil
+}
+
+// ExtendExpiration extends the expiration of a namespace once, by up to the configured maximum extension
+func (k *connectorNamespaceService) ExtendExpiration(ctx context.Context, namespaceId string, extension time.Duration) (*dbapi.ConnectorNamespace, *errors.ServiceError) {
+	maxExtension := k.connectorsConfig.ConnectorEvalMaxExtension
+	if maxExtension <= 0 {
+		return nil, errors.BadRequest("connector namespace extensions are not enabled")
+	}
+	if extension == 0 {
+		extension = maxExtension
+	}
+	if extension < 0 || extension > maxExtension {
+		return nil, errors.BadRequest("connector namespace extension %s must be between 0s and %s", extension, maxExtension)
+	}
+
+	namespace, err := k.Get(ctx, namespaceId)
+	if err != nil {
+		return nil, err
+	}
+	if namespace.Expiration == nil {
+		return nil, errors.BadRequest("connector namespace with id='%s' does not expire", namespaceId)
+	}
+	if namespace.ExtendedAt != nil {
+		return nil, errors.Conflict("connector namespace with id='%s' was already extended at %s",
+			namespaceId, namespace.ExtendedAt.UTC().Format(time.RFC3339))
+	}
+	if namespace.Status.Phase == dbapi.ConnectorNamespacePhaseDeleting || namespace.Status.Phase == dbapi.ConnectorNamespacePhaseDeleted {
+		return nil, errors.BadRequest("connector namespace with id='%s' is %s", namespaceId, namespace.Status.Phase)
+	}
+
+	now := time.Now()
+	expiration := namespace.Expiration.Add(extension)
+	namespace.Expiration = &expiration
+	namespace.ExtendedAt = &now
+	namespace.UpdateNextExpiryWarning(k.connectorsConfig.ConnectorEvalExpiryWarnings, now)
+
+	// the extension is only applied once, even with concurrent requests
+	updates := k.connectionFactory.New().Model(namespace).Where("extended_at IS NULL").
+		Updates(map[string]interface{}{
+			"expiration":             namespace.Expiration,
+			"extended_at":            namespace.ExtendedAt,
+			"next_expiry_warning_at": namespace.NextExpiryWarningAt,
+		})
+	if err := updates.Error; err != nil {
+		return nil, services.HandleUpdateError("Connector namespace", err)
+	}
+	if updates.RowsAffected == 0 {
+		return nil, errors.Conflict("connector namespace with id='%s' was already extended", namespaceId)
+	}
+
+	message := fmt.Sprintf("namespace %s expiration extended by %s, to %s", namespace.Name,
+		extension, expiration.UTC().Format(time.RFC3339))
+	if err := k.eventsService.Record(ctx, namespace, dbapi.ConnectorNamespaceEventExtended, message); err != nil {
+		return nil, err
+	}
+
+	// reload namespace to get version update
+	return k.Get(ctx, namespaceId)
+}
+
+// MoveConnectors saves connectors being moved out of a namespace in one transaction.
+// An expiring namespace is kept for at least connectorMoveGracePeriod, so that it doesn't expire while its connectors are removed
+func (k *connectorNamespaceService) MoveConnectors(ctx context.Context, namespace *dbapi.ConnectorNamespace, connectors dbapi.ConnectorList) *errors.ServiceError {
+	if len(connectors) == 0 {
+		return nil
+	}
+
+	if err := k.connectionFactory.New().Transaction(func(dbConn *gorm.DB) error {
+		for _, connector := range connectors {
+			update := dbConn.Model(connector).Where("version = ?", connector.Version).
+				Select("namespace_id", "target_namespace_id", "desired_state", "target_desired_state").
+				Updates(connector)
+			if err := update.Error; err != nil {
+				return services.HandleUpdateError("Connector", err)
+			}
+			if update.RowsAffected == 0 {
+				return errors.Conflict("connector with id='%s' version changed", connector.ID)
+			}
+			if err := dbConn.Save(&connector.Status).Error; err != nil {
+				return errors.GeneralError("failed to save status of connector %s: %v", connector.ID, err)
+			}
+			// read it back to get the updated version
+			if err := dbConn.Where("id = ?", connector.ID).First(connector).Error; err != nil {
+				return services.HandleGetError("Connector", "id", connector.ID, err)
+			}
+		}
+
+		now := time.Now()
+		if namespace.Expiration != nil && namespace.Expiration.Before(now.Add(connectorMoveGracePeriod)) {
+			expiration := now.Add(connectorMoveGracePeriod)
+			namespace.Expiration = &expiration
+			namespace.UpdateNextExpiryWarning(k.connectorsConfig.ConnectorEvalExpiryWarnings, now)
+			if err := dbConn.Model(namespace).Updates(map[string]interface{}{
+				"expiration":             namespace.Expiration,
+				"next_expiry_warning_at": namespace.NextExpiryWarningAt,
+			}).Error; err != nil {
+				return services.HandleUpdateError("Connector namespace", err)
+			}
+		}
+		return nil
+	}); err != nil {
+		return errors.ToServiceError(err)
+	}
+
+	_ = db.AddPostCommitAction(ctx, func() {
+		k.bus.Notify("reconcile:connector")
+	})
+	return nil
+}
+
 func (k *connectorNamespaceService) ReconcileUnusedDeletingNamespaces(_ context.Context) (int64, *errors.ServiceError) {
 	var count int64
 	if err := k.connectionFactory.New().Transaction(func(dbConn *gorm.DB) error {
@@ -661,6 +845,11 @@ func (k *connectorNamespaceService) ReconcileDeletedNamespaces(_ context.Context
 			count = 0
 			return services.HandleDeleteError("Connector namespace", "id", namespaceIds, err)
 		}
+		if err := dbConn.Where("namespace_id IN ?", namespaceIds).
+			Delete(&dbapi.ConnectorNamespaceEvent{}).Error; err != nil {
+			count = 0
+			return services.HandleDeleteError("Connector namespace events", "namespace_id", namespaceIds, err)
+		}
 
 		return nil
 
@@ -685,28 +874,198 @@ func (k *connectorNamespaceService) GetNamespaceTenant(namespaceId string) (*dba
 	return &namespace, nil
 }
 
-func (k *connectorNamespaceService) CheckConnectorQuota(namespaceId string) *errors.ServiceError {
+// CheckConnectorQuota admits a new connector of the given type and channel in the namespace,
+// checking its count and declared resources against the quota profile of the namespace
+func (k *connectorNamespaceService) CheckConnectorQuota(namespaceId string, connectorTypeId string, channel string) *errors.ServiceError {
+	quota, err := k.getNamespaceQuota(namespaceId)
+	if err != nil {
+		return err
+	}
+	if quota.IsUnlimited() {
+		return nil
+	}
+
+	usage, err := k.getNamespaceQuotaUsage(namespaceId)
+	if err != nil {
+		return err
+	}
+	labels, err := k.getConnectorTypeLabels([]string{connectorTypeId})
+	if err != nil {
+		return err
+	}
+	footprint, err := k.getConnectorResources(connectorTypeId, channel)
+	if err != nil {
+		return err
+	}
+
+	if admitErr := quota.Admit(usage, connectorTypeId, labels[connectorTypeId], footprint); admitErr != nil {
+		return errors.InsufficientQuotaError("%s", admitErr)
+	}
+	return nil
+}
+
+// CheckConnectorsQuota admits new connectors in the namespace together, in order, as CheckConnectorQuota does for one connector.
+// It returns, for each connector, the quota error if it doesn't fit in the namespace along with the connectors admitted before it
+func (k *connectorNamespaceService) CheckConnectorsQuota(namespaceId string, connectors dbapi.ConnectorList) ([]*errors.ServiceError, *errors.ServiceError) {
+	result := make([]*errors.ServiceError, len(connectors))
+	quota, err := k.getNamespaceQuota(namespaceId)
+	if err != nil {
+		return nil, err
+	}
+	if quota.IsUnlimited() {
+		return result, nil
+	}
+
+	usage, err := k.getNamespaceQuotaUsage(namespaceId)
+	if err != nil {
+		return nil, err
+	}
+	typeIds := make([]string, 0, len(connectors))
+	for _, connector := range connectors {
+		typeIds = append(typeIds, connector.ConnectorTypeId)
+	}
+	labels, err := k.getConnectorTypeLabels(typeIds)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, connector := range connectors {
+		footprint, err := k.getConnectorResources(connector.ConnectorTypeId, connector.Channel)
+		if err != nil {
+			return nil, err
+		}
+		if admitErr := quota.Admit(usage, connector.ConnectorTypeId, labels[connector.ConnectorTypeId], footprint); admitErr != nil {
+			result[i] = errors.InsufficientQuotaError("%s", admitErr)
+			continue
+		}
+		usage.Add(connector.ConnectorTypeId, labels[connector.ConnectorTypeId], footprint)
+	}
+	return result, nil
+}
+
+func (k *connectorNamespaceService) GetRemainingQuota(namespaceId string) (config.NamespaceQuota, *errors.ServiceError) {
+	quota, err := k.getNamespaceQuota(namespaceId)
+	if err != nil {
+		return config.NamespaceQuota{}, err
+	}
+	if quota.IsUnlimited() {
+		return config.NamespaceQuota{}, nil
+	}
+
+	usage, err := k.getNamespaceQuotaUsage(namespaceId)
+	if err != nil {
+		return config.NamespaceQuota{}, err
+	}
+	remaining, remainingErr := quota.Remaining(usage)
+	if remainingErr != nil {
+		return config.NamespaceQuota{}, errors.GeneralError("invalid quota of Connector namespace %s: %s", namespaceId, remainingErr)
+	}
+	return remaining, nil
+}
+
+func (k *connectorNamespaceService) getNamespaceQuota(namespaceId string) (config.NamespaceQuota, *errors.ServiceError) {
 	dbConn := k.connectionFactory.New()
 	var profileName string
-	var quota config.NamespaceQuota
 	if err := dbConn.Model(&dbapi.ConnectorNamespaceAnnotation{}).
 		Where("namespace_id = ? AND key = ?", namespaceId, profiles.AnnotationProfileKey).
 		Select("value").First(&profileName).Error; err != nil {
-		return errors.FailedToCheckQuota("error reading Connector namespace annotation with namespace id %s: %s", namespaceId, err)
+		return config.NamespaceQuota{}, errors.FailedToCheckQuota("error reading Connector namespace annotation with namespace id %s: %s", namespaceId, err)
+	}
+	quota, _ := k.quotaConfig.GetNamespaceQuota(profileName)
+	return quota, nil
+}
+
+// getNamespaceQuotaUsage accounts the connectors of the namespace, per type and label, and their declared resources.
+// The connectors being moved to the namespace are accounted too, so that their deployment is guaranteed to fit
+func (k *connectorNamespaceService) getNamespaceQuotaUsage(namespaceId string) (config.NamespaceQuotaUsage, *errors.ServiceError) {
+	usage := config.NamespaceQuotaUsage{
+		ConnectorTypes:  make(map[string]int32),
+		ConnectorLabels: make(map[string]int32),
+	}
+
+	var groups []struct {
+		ConnectorTypeId string
+		Channel         string
+		Count           int32
 	}
-	quota, _ = k.quotaConfig.GetNamespaceQuota(profileName)
-	if quota.Connectors > 0 {
-		// get number of connectors using this namespace
-		var count int64
-		if err := dbConn.Model(&dbapi.Connector{}).Where("namespace_id = ?", namespaceId).
-			Count(&count).Error; err != nil {
-			return services.HandleGetError("Connector", "namespace_id", namespaceId, err)
+	dbConn := k.connectionFactory.New()
+	if err := dbConn.Model(&dbapi.Connector{}).
+		Select("connector_type_id, channel, count(*) as count").
+		Where("namespace_id = ? OR target_namespace_id = ?", namespaceId, namespaceId).
+		Group("connector_type_id, channel").
+		Scan(&groups).Error; err != nil {
+		return usage, services.HandleGetError("Connector", "namespace_id", namespaceId, err)
+	}
+
+	typeIds := make([]string, 0, len(groups))
+	for _, group := range groups {
+		usage.Connectors += group.Count
+		if _, ok := usage.ConnectorTypes[group.ConnectorTypeId]; !ok {
+			typeIds = append(typeIds, group.ConnectorTypeId)
+		}
+		usage.ConnectorTypes[group.ConnectorTypeId] += group.Count
+
+		footprint, err := k.getConnectorResources(group.ConnectorTypeId, group.Channel)
+		if err != nil {
+			return usage, err
 		}
-		if count >= int64(quota.Connectors) {
-			return errors.InsufficientQuotaError("the maximum number of allowed connectors has been reached")
+		for i := int32(0); i < group.Count; i++ {
+			usage.Resources.Add(footprint)
 		}
 	}
-	return nil
+
+	labels, err := k.getConnectorTypeLabels(typeIds)
+	if err != nil {
+		return usage, err
+	}
+	for typeId, typeLabels := range labels {
+		for _, label := range typeLabels {
+			usage.ConnectorLabels[label] += usage.ConnectorTypes[typeId]
+		}
+	}
+
+	return usage, nil
+}
+
+func (k *connectorNamespaceService) getConnectorTypeLabels(typeIds []string) (map[string][]string, *errors.ServiceError) {
+	result := make(map[string][]string, len(typeIds))
+	if len(typeIds) == 0 {
+		return result, nil
+	}
+	var labels []dbapi.ConnectorTypeLabel
+	dbConn := k.connectionFactory.New()
+	if err := dbConn.Where("connector_type_id IN ?", typeIds).Find(&labels).Error; err != nil {
+		return nil, errors.FailedToCheckQuota("error reading labels of connector types %v: %s", typeIds, err)
+	}
+	for _, label := range labels {
+		result[label.ConnectorTypeID] = append(result[label.ConnectorTypeID], label.Label)
+	}
+	return result, nil
+}
+
+// getConnectorResources returns the resources declared by the latest shard metadata of the connector type channel.
+// Connectors of unknown types or channels, which can't be deployed anyway, have no footprint.
+func (k *connectorNamespaceService) getConnectorResources(connectorTypeId string, channel string) (config.ConnectorResources, *errors.ServiceError) {
+	var shardMetadata dbapi.ConnectorShardMetadata
+	dbConn := k.connectionFactory.New()
+	if err := dbConn.Where(dbapi.ConnectorShardMetadata{ConnectorTypeId: connectorTypeId, Channel: channel}).
+		Order("revision desc").
+		First(&shardMetadata).Error; err != nil {
+		if services.IsRecordNotFoundError(err) {
+			return config.ConnectorResources{}, nil
+		}
+		return config.ConnectorResources{}, errors.FailedToCheckQuota("error reading shard metadata of connector type %s and channel %s: %s", connectorTypeId, channel, err)
+	}
+
+	metadata, err := shardMetadata.ShardMetadata.Object()
+	if err != nil {
+		return config.ConnectorResources{}, errors.FailedToCheckQuota("error reading shard metadata of connector type %s and channel %s: %s", connectorTypeId, channel, err)
+	}
+	footprint, err := config.GetConnectorResources(metadata)
+	if err != nil {
+		return config.ConnectorResources{}, errors.FailedToCheckQuota("error reading resources of connector type %s and channel %s: %s", connectorTypeId, channel, err)
+	}
+	return footprint, nil
 }
 
 func (k *connectorNamespaceService) CanCreateEvalNamespace(userId string) *errors.ServiceError {
@@ -0,0 +1,181 @@
+package services
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+const namespaceQuotaConfigFile = `
+---
+- profile-name: default-profile
+- profile-name: evaluation-profile
+  quotas:
+    namespace-quota:
+      connectors: 3
+      memory-requests: "1Gi"
+      connector-types:
+        log_sink_0.1: 1
+      connector-labels:
+        debezium: 1
+`
+
+func newQuotaTestNamespaceService(t *testing.T, profileName string, usage []map[string]interface{}, memoryRequest string) *connectorNamespaceService {
+	g := gomega.NewWithT(t)
+	quotaConfig := config.NewConnectorsQuotaConfig()
+	quotaConfig.ConnectorsQuotaConfigFile = filepath.Join(t.TempDir(), "connectors-quota-configuration.yaml")
+	g.Expect(os.WriteFile(quotaConfig.ConnectorsQuotaConfigFile, []byte(namespaceQuotaConfigFile), 0600)).To(gomega.Succeed())
+	g.Expect(quotaConfig.ReadFiles()).To(gomega.Succeed())
+
+	mocket.Catcher.Reset()
+	mocket.Catcher.NewMock().WithQuery(`FROM "connector_namespace_annotations"`).
+		WithReply([]map[string]interface{}{{"value": profileName}})
+	mocket.Catcher.NewMock().WithQuery(`SELECT connector_type_id, channel, count(*) as count FROM "connectors"`).
+		WithReply(usage)
+	mocket.Catcher.NewMock().WithQuery(`FROM "connector_type_labels"`).
+		WithReply([]map[string]interface{}{{"connector_type_id": "debezium-postgres-1.9", "label": "debezium"}})
+	mocket.Catcher.NewMock().WithQuery(`FROM "connector_shard_metadata"`).
+		WithReply([]map[string]interface{}{{
+			"id":             1,
+			"shard_metadata": []byte(`{"resources": {"requests": {"memory": "` + memoryRequest + `"}}}`),
+		}})
+
+	return NewConnectorNamespaceService(db.NewMockConnectionFactory(nil), config.NewConnectorsConfig(), quotaConfig, nil, nil)
+}
+
+func Test_connectorNamespaceService_CheckConnectorQuota(t *testing.T) {
+	twoConnectors := []map[string]interface{}{
+		{"connector_type_id": "log_sink_0.1", "channel": "stable", "count": 1},
+		{"connector_type_id": "debezium-postgres-1.9", "channel": "stable", "count": 1},
+	}
+
+	tests := []struct {
+		name            string
+		profileName     string
+		usage           []map[string]interface{}
+		memoryRequest   string
+		connectorTypeId string
+		wantErr         bool
+	}{
+		{
+			name:            "should admit a connector within the quota",
+			profileName:     "evaluation-profile",
+			usage:           twoConnectors,
+			memoryRequest:   "256Mi",
+			connectorTypeId: "http_sink_0.1",
+		},
+		{
+			name:            "should admit any connector in a namespace without quota",
+			profileName:     "default-profile",
+			usage:           twoConnectors,
+			memoryRequest:   "1Gi",
+			connectorTypeId: "log_sink_0.1",
+		},
+		{
+			name:        "should reject a connector over the connectors quota",
+			profileName: "evaluation-profile",
+			usage: []map[string]interface{}{
+				{"connector_type_id": "http_sink_0.1", "channel": "stable", "count": 3},
+			},
+			memoryRequest:   "256Mi",
+			connectorTypeId: "http_sink_0.1",
+			wantErr:         true,
+		},
+		{
+			name:            "should reject a connector over its connector type quota",
+			profileName:     "evaluation-profile",
+			usage:           twoConnectors,
+			memoryRequest:   "256Mi",
+			connectorTypeId: "log_sink_0.1",
+			wantErr:         true,
+		},
+		{
+			name:            "should reject a connector over its label quota",
+			profileName:     "evaluation-profile",
+			usage:           twoConnectors,
+			memoryRequest:   "256Mi",
+			connectorTypeId: "debezium-postgres-1.9",
+			wantErr:         true,
+		},
+		{
+			name:            "should reject a connector over the memory quota",
+			profileName:     "evaluation-profile",
+			usage:           twoConnectors,
+			memoryRequest:   "512Mi",
+			connectorTypeId: "http_sink_0.1",
+			wantErr:         true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			k := newQuotaTestNamespaceService(t, tt.profileName, tt.usage, tt.memoryRequest)
+			err := k.CheckConnectorQuota("namespace-id", tt.connectorTypeId, "stable")
+			if tt.wantErr {
+				g.Expect(err).To(gomega.HaveOccurred())
+				g.Expect(err.InSufficientQuota()).To(gomega.BeTrue())
+				return
+			}
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+		})
+	}
+}
+
+func Test_connectorNamespaceService_GetRemainingQuota(t *testing.T) {
+	g := gomega.NewWithT(t)
+	k := newQuotaTestNamespaceService(t, "evaluation-profile", []map[string]interface{}{
+		{"connector_type_id": "log_sink_0.1", "channel": "stable", "count": 1},
+		{"connector_type_id": "debezium-postgres-1.9", "channel": "stable", "count": 1},
+	}, "256Mi")
+
+	remaining, err := k.GetRemainingQuota("namespace-id")
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(remaining).To(gomega.Equal(config.NamespaceQuota{
+		Connectors:      1,
+		MemoryRequests:  "512Mi",
+		ConnectorTypes:  map[string]int32{"log_sink_0.1": 0},
+		ConnectorLabels: map[string]int32{"debezium": 0},
+	}))
+
+	// namespaces without quota have no remaining quota
+	k = newQuotaTestNamespaceService(t, "default-profile", nil, "256Mi")
+	remaining, err = k.GetRemainingQuota("namespace-id")
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(remaining).To(gomega.Equal(config.NamespaceQuota{}))
+}
+
+func Test_connectorNamespaceService_scheduleMissingExpiryWarnings(t *testing.T) {
+	g := gomega.NewWithT(t)
+	now := time.Now()
+	expiration := now.Add(12 * time.Hour)
+
+	mocket.Catcher.Reset()
+	selectNamespaces := mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "connector_namespaces" WHERE (next_expiry_warning_at IS NULL AND expiration > $1 AND status_phase NOT IN ($2,$3))`).
+		WithReply([]map[string]interface{}{{"id": "namespace-id", "expiration": expiration}})
+	update := mocket.Catcher.NewMock().WithQuery(`UPDATE "connector_namespaces" SET "next_expiry_warning_at"=$1 WHERE`).
+		WithArgs(expiration.Add(-time.Hour).UTC(), "namespace-id").WithRowsNum(1)
+
+	connectorsConfig := config.NewConnectorsConfig()
+	connectorsConfig.ConnectorEvalExpiryWarnings = []time.Duration{24 * time.Hour, time.Hour}
+	k := NewConnectorNamespaceService(db.NewMockConnectionFactory(nil), connectorsConfig, nil, nil, nil)
+
+	g.Expect(k.scheduleMissingExpiryWarnings(now)).To(gomega.BeNil())
+	g.Expect(selectNamespaces.Triggered).To(gomega.BeTrue())
+	g.Expect(update.Triggered).To(gomega.BeTrue())
+
+	// no namespace can be warned without warnings
+	mocket.Catcher.Reset()
+	selectNamespaces = mocket.Catcher.NewMock().WithQuery(`FROM "connector_namespaces"`)
+	connectorsConfig.ConnectorEvalExpiryWarnings = nil
+
+	g.Expect(k.scheduleMissingExpiryWarnings(now)).To(gomega.BeNil())
+	g.Expect(selectNamespaces.Triggered).To(gomega.BeFalse())
+}
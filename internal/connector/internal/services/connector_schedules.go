@@ -0,0 +1,84 @@
+package services
+
+import (
+	"context"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"gorm.io/gorm"
+)
+
+// maxConnectorScheduleExecutions is the number of executions kept in the history of a connector schedule
+const maxConnectorScheduleExecutions = 50
+
+// ConnectorSchedulesService tracks the executions of connector start and stop schedules.
+// Schedules are saved along with their connectors.
+type ConnectorSchedulesService interface {
+	Get(ctx context.Context, connectorId string) (*dbapi.ConnectorSchedule, *errors.ServiceError)
+	// SaveExecution saves the next transitions of an executed schedule, and adds the execution to its history
+	SaveExecution(ctx context.Context, schedule *dbapi.ConnectorSchedule, execution dbapi.ConnectorScheduleExecution) *errors.ServiceError
+	// ListExecutions returns the history of a connector schedule, most recent first
+	ListExecutions(ctx context.Context, connectorId string) (dbapi.ConnectorScheduleExecutionList, *errors.ServiceError)
+}
+
+var _ ConnectorSchedulesService = &connectorSchedulesService{}
+
+type connectorSchedulesService struct {
+	connectionFactory *db.ConnectionFactory
+}
+
+func NewConnectorSchedulesService(connectionFactory *db.ConnectionFactory) *connectorSchedulesService {
+	return &connectorSchedulesService{
+		connectionFactory: connectionFactory,
+	}
+}
+
+func (k *connectorSchedulesService) Get(ctx context.Context, connectorId string) (*dbapi.ConnectorSchedule, *errors.ServiceError) {
+	var schedule dbapi.ConnectorSchedule
+	if err := k.connectionFactory.New().Where("connector_id = ?", connectorId).First(&schedule).Error; err != nil {
+		return nil, services.HandleGetError("Connector schedule", "connector_id", connectorId, err)
+	}
+	return &schedule, nil
+}
+
+func (k *connectorSchedulesService) SaveExecution(ctx context.Context, schedule *dbapi.ConnectorSchedule, execution dbapi.ConnectorScheduleExecution) *errors.ServiceError {
+	execution.ID = 0
+	execution.ConnectorID = schedule.ConnectorID
+
+	if err := k.connectionFactory.New().Transaction(func(dbConn *gorm.DB) error {
+		if err := dbConn.Model(schedule).Where("connector_id = ?", schedule.ConnectorID).
+			Updates(map[string]interface{}{
+				"next_start_at": schedule.NextStartAt,
+				"next_stop_at":  schedule.NextStopAt,
+			}).Error; err != nil {
+			return services.HandleUpdateError("Connector schedule", err)
+		}
+		if err := dbConn.Create(&execution).Error; err != nil {
+			return errors.GeneralError("failed to save execution of connector schedule %s: %v", schedule.ConnectorID, err)
+		}
+
+		// drop the oldest executions
+		latest := dbConn.Model(&dbapi.ConnectorScheduleExecution{}).Select("id").
+			Where("connector_id = ?", schedule.ConnectorID).
+			Order("id DESC").Limit(maxConnectorScheduleExecutions)
+		if err := dbConn.Where("connector_id = ? AND id NOT IN (?)", schedule.ConnectorID, latest).
+			Delete(&dbapi.ConnectorScheduleExecution{}).Error; err != nil {
+			return services.HandleDeleteError("ConnectorScheduleExecution", "connector_id", schedule.ConnectorID, err)
+		}
+		return nil
+	}); err != nil {
+		return errors.ToServiceError(err)
+	}
+	return nil
+}
+
+func (k *connectorSchedulesService) ListExecutions(ctx context.Context, connectorId string) (dbapi.ConnectorScheduleExecutionList, *errors.ServiceError) {
+	var executions dbapi.ConnectorScheduleExecutionList
+	if err := k.connectionFactory.New().Where("connector_id = ?", connectorId).
+		Order("id DESC").Find(&executions).Error; err != nil {
+		return nil, services.HandleGetError("Connector schedule executions", "connector_id", connectorId, err)
+	}
+	return executions, nil
+}
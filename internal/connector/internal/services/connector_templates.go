@@ -0,0 +1,135 @@
+package services
+
+import (
+	"context"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/queryparser"
+	"gorm.io/gorm"
+)
+
+// ConnectorTemplatesService manages the connector templates of users and their organisations
+type ConnectorTemplatesService interface {
+	Create(ctx context.Context, resource *dbapi.ConnectorTemplate) *errors.ServiceError
+	Get(ctx context.Context, id string) (*dbapi.ConnectorTemplate, *errors.ServiceError)
+	List(ctx context.Context, listArgs *services.ListArguments) (dbapi.ConnectorTemplateList, *api.PagingMeta, *errors.ServiceError)
+	Delete(ctx context.Context, id string) *errors.ServiceError
+}
+
+var _ ConnectorTemplatesService = &connectorTemplatesService{}
+
+type connectorTemplatesService struct {
+	connectionFactory *db.ConnectionFactory
+}
+
+func NewConnectorTemplatesService(connectionFactory *db.ConnectionFactory) *connectorTemplatesService {
+	return &connectorTemplatesService{
+		connectionFactory: connectionFactory,
+	}
+}
+
+func GetValidConnectorTemplateColumns() []string {
+	return []string{"id", "created_at", "updated_at", "name", "owner", "organisation_id", "connector_type_id", "channel"}
+}
+
+// GetSearchConnectorTemplateColumns returns the typed columns connector templates can be searched by
+func GetSearchConnectorTemplateColumns() []queryparser.Column {
+	return queryparser.SetColumnType(queryparser.TypedColumns(queryparser.StringColumn, GetValidConnectorTemplateColumns()...), queryparser.TimestampColumn, "created_at", "updated_at")
+}
+
+func (k *connectorTemplatesService) Create(ctx context.Context, resource *dbapi.ConnectorTemplate) *errors.ServiceError {
+	if err := k.connectionFactory.New().Create(resource).Error; err != nil {
+		return errors.GeneralError("failed to create connector template: %v", err)
+	}
+	return nil
+}
+
+func (k *connectorTemplatesService) Get(ctx context.Context, id string) (*dbapi.ConnectorTemplate, *errors.ServiceError) {
+	dbConn, err := k.filterToOwnerOrOrg(ctx, k.connectionFactory.New())
+	if err != nil {
+		return nil, err
+	}
+
+	var resource dbapi.ConnectorTemplate
+	if err := dbConn.Where("id = ?", id).First(&resource).Error; err != nil {
+		return nil, services.HandleGetError("Connector template", "id", id, err)
+	}
+	return &resource, nil
+}
+
+func (k *connectorTemplatesService) List(ctx context.Context, listArgs *services.ListArguments) (dbapi.ConnectorTemplateList, *api.PagingMeta, *errors.ServiceError) {
+	if err := listArgs.Validate(GetValidConnectorTemplateColumns()); err != nil {
+		return nil, nil, errors.NewWithCause(errors.ErrorMalformedRequest, err, "Unable to list connector template requests: %s", err.Error())
+	}
+
+	var resourceList dbapi.ConnectorTemplateList
+	dbConn, err := k.filterToOwnerOrOrg(ctx, k.connectionFactory.New().Model(&resourceList))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(listArgs.Search) > 0 {
+		queryParser := queryparser.NewTypedQueryParserWithColumnPrefix("connector_templates", GetSearchConnectorTemplateColumns()...)
+		searchDbQuery, err := queryParser.Parse(listArgs.Search)
+		if err != nil {
+			return nil, nil, errors.NewWithCause(errors.ErrorFailedToParseSearch, err, "Unable to list connector template requests: %s", err.Error())
+		}
+		dbConn = dbConn.Where(searchDbQuery.Query, searchDbQuery.Values...)
+	}
+
+	dbConn, pagingMeta, err := listArgs.Paginate(dbConn, &resourceList, "connector_templates.id")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(listArgs.OrderBy) == 0 {
+		dbConn = dbConn.Order("name ASC")
+	} else {
+		for _, orderByArg := range listArgs.OrderBy {
+			dbConn = dbConn.Order(orderByArg)
+		}
+	}
+
+	if err := dbConn.Find(&resourceList).Error; err != nil {
+		return nil, nil, errors.GeneralError("failed to get connector templates: %v", err)
+	}
+	return resourceList, pagingMeta, nil
+}
+
+func (k *connectorTemplatesService) Delete(ctx context.Context, id string) *errors.ServiceError {
+	resource, err := k.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := k.connectionFactory.New().Delete(resource).Error; err != nil {
+		return services.HandleDeleteError("Connector template", "id", id, err)
+	}
+	return nil
+}
+
+// filterToOwnerOrOrg restricts templates to the ones owned by the user or, for organisation members, shared with their organisation
+func (k *connectorTemplatesService) filterToOwnerOrOrg(ctx context.Context, dbConn *gorm.DB) (*gorm.DB, *errors.ServiceError) {
+	admin, err := isAdmin(ctx)
+	if err != nil || admin {
+		return dbConn, err
+	}
+
+	claims, claimsErr := auth.GetClaimsFromContext(ctx)
+	if claimsErr != nil {
+		return dbConn, errors.Unauthenticated("user not authenticated")
+	}
+	owner, _ := claims.GetUsername()
+	if owner == "" {
+		return dbConn, errors.Unauthenticated("user not authenticated")
+	}
+
+	if orgId, _ := claims.GetOrgId(); orgId != "" && auth.GetFilterByOrganisationFromContext(ctx) {
+		return dbConn.Where("owner = ? OR organisation_id = ?", owner, orgId), nil
+	}
+	return dbConn.Where("owner = ?", owner), nil
+}
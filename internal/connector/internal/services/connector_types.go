@@ -171,6 +171,14 @@ func GetValidConnectorTypeColumns() []string {
 	return []string{"id", "created_at", "updated_at", "version", "name", "description", "label", "channel", "featured_rank", "pricing_tier", "deprecated"}
 }
 
+// GetSearchConnectorTypeColumns returns the typed columns connector types can be searched by
+func GetSearchConnectorTypeColumns() []queryparser.Column {
+	columns := queryparser.TypedColumns(queryparser.StringColumn, GetValidConnectorTypeColumns()...)
+	columns = queryparser.SetColumnType(columns, queryparser.TimestampColumn, "created_at", "updated_at")
+	columns = queryparser.SetColumnType(columns, queryparser.IntegerColumn, "featured_rank")
+	return queryparser.SetColumnType(columns, queryparser.BooleanColumn, "deprecated")
+}
+
 var skipOrderByColumnsRegExp = regexp.MustCompile("^(channel)|(label)|(pricing_tier)")
 
 var labelSetSearchClause = regexp.MustCompile("label [Ii]?[Ll][Ii][Kk][Ee] ")
@@ -184,10 +192,6 @@ func (cts *connectorTypesService) List(listArgs *services.ListArguments) (dbapi.
 	//var resourceList dbapi.ConnectorTypeList
 	var resourceList dbapi.ConnectorTypeList
 	dbConn := cts.connectionFactory.New()
-	pagingMeta := &api.PagingMeta{
-		Page: listArgs.Page,
-		Size: listArgs.Size,
-	}
 
 	if len(cts.connectorsConfig.ConnectorsSupportedChannels) > 0 {
 		dbConn = dbConn.Joins("LEFT JOIN connector_type_channels channels on channels.connector_type_id = connector_types.id")
@@ -197,10 +201,10 @@ func (cts *connectorTypesService) List(listArgs *services.ListArguments) (dbapi.
 
 	// Apply search query
 	if len(listArgs.Search) > 0 {
-		queryParser := queryparser.NewQueryParser(GetValidConnectorTypeColumns()...)
+		queryParser := queryparser.NewTypedQueryParser(GetSearchConnectorTypeColumns()...)
 		searchDbQuery, err := queryParser.Parse(listArgs.Search)
 		if err != nil {
-			return resourceList, pagingMeta, errors.NewWithCause(errors.ErrorFailedToParseSearch, err, "Unable to list connector type requests: %s", err.Error())
+			return resourceList, nil, errors.NewWithCause(errors.ErrorFailedToParseSearch, err, "Unable to list connector type requests: %s", err.Error())
 		}
 		if strings.Contains(searchDbQuery.Query, "channel") {
 			if len(cts.connectorsConfig.ConnectorsSupportedChannels) == 0 {
@@ -237,14 +241,10 @@ func (cts *connectorTypesService) List(listArgs *services.ListArguments) (dbapi.
 		dbConn = dbConn.Order(orderByArg)
 	}
 
-	// set total, limit and paging (based on https://gitlab.cee.redhat.com/service/api-guidelines#user-content-paging)
-	total := int64(pagingMeta.Total)
-	cts.connectionFactory.New().Table("(?) as types", dbConn.Model(&resourceList)).Count(&total)
-	pagingMeta.Total = int(total)
-	if pagingMeta.Size > pagingMeta.Total {
-		pagingMeta.Size = pagingMeta.Total
+	dbConn, pagingMeta, svcErr := listArgs.Paginate(dbConn, &resourceList, "connector_types.id")
+	if svcErr != nil {
+		return nil, nil, svcErr
 	}
-	dbConn = dbConn.Offset((pagingMeta.Page - 1) * pagingMeta.Size).Limit(pagingMeta.Size)
 
 	// execute query
 	result := dbConn.
@@ -275,7 +275,7 @@ func (cts *connectorTypesService) ListLabels(listArgs *services.ListArguments) (
 
 	// Apply search query
 	if len(listArgs.Search) > 0 {
-		queryParser := queryparser.NewQueryParser(GetValidConnectorTypeColumns()...)
+		queryParser := queryparser.NewTypedQueryParser(GetSearchConnectorTypeColumns()...)
 		searchDbQuery, err := queryParser.Parse(listArgs.Search)
 		if err != nil {
 			return resourceList, errors.NewWithCause(errors.ErrorFailedToParseSearch, err, "unable to list connector type labels requests: %s", err.Error())
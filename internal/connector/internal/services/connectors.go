@@ -28,8 +28,11 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 )
 
+//go:generate moq -out connectors_moq.go . ConnectorsService
 type ConnectorsService interface {
 	Create(ctx context.Context, resource *dbapi.Connector) *errors.ServiceError
+	// CreateAll creates all the connectors in a single transaction, none are created if any of them fails
+	CreateAll(ctx context.Context, resources dbapi.ConnectorList) *errors.ServiceError
 	Get(ctx context.Context, id string) (*dbapi.ConnectorWithConditions, *errors.ServiceError)
 	List(ctx context.Context, listArgs *services.ListArguments, clusterId string) (dbapi.ConnectorWithConditionsList, *api.PagingMeta, *errors.ServiceError)
 	Update(ctx context.Context, resource *dbapi.Connector) *errors.ServiceError
@@ -39,6 +42,8 @@ type ConnectorsService interface {
 	ForceDelete(ctx context.Context, id string) *errors.ServiceError
 
 	ResolveConnectorRefsWithBase64Secrets(resource *dbapi.Connector) (bool, *errors.ServiceError)
+	// GetDeploymentMetrics returns the metrics last reported for the deployment of a connector, empty if none were reported
+	GetDeploymentMetrics(ctx context.Context, id string) (dbapi.ConnectorDeploymentMetrics, *errors.ServiceError)
 }
 
 var _ ConnectorsService = &connectorsService{}
@@ -91,6 +96,34 @@ func (k *connectorsService) Create(ctx context.Context, resource *dbapi.Connecto
 	return nil
 }
 
+func (k *connectorsService) CreateAll(ctx context.Context, resources dbapi.ConnectorList) *errors.ServiceError {
+	if err := k.connectionFactory.New().Transaction(func(dbConn *gorm.DB) error {
+		for _, resource := range resources {
+			if err := dbConn.Create(resource).Error; err != nil {
+				return errors.GeneralError("failed to create connector %s: %v", resource.Name, err)
+			}
+			// read it back to get the updated version
+			if err := dbConn.Where("id = ?", resource.ID).First(resource).Error; err != nil {
+				return services.HandleGetError("Connector", "id", resource.ID, err)
+			}
+
+			resource.Status.ID = resource.ID
+			resource.Status.Phase = dbapi.ConnectorStatusPhaseAssigning
+			if err := dbConn.Save(&resource.Status).Error; err != nil {
+				return errors.GeneralError("failed to save status: %v", err)
+			}
+		}
+		return nil
+	}); err != nil {
+		return errors.ToServiceError(err)
+	}
+
+	_ = db.AddPostCommitAction(ctx, func() {
+		k.bus.Notify("reconcile:connector")
+	})
+	return nil
+}
+
 // Get gets a connector by id from the database
 func (k *connectorsService) Get(ctx context.Context, id string) (*dbapi.ConnectorWithConditions, *errors.ServiceError) {
 	dbConn := k.connectionFactory.New()
@@ -168,6 +201,12 @@ func (k *connectorsService) Delete(ctx context.Context, id string) *errors.Servi
 	if err := dbConn.Where("id = ?", id).Delete(&dbapi.ConnectorStatus{}).Error; err != nil {
 		return services.HandleGetError("ConnectorStatus", "id", id, err)
 	}
+	if err := dbConn.Where("connector_id = ?", id).Delete(&dbapi.ConnectorSchedule{}).Error; err != nil {
+		return services.HandleDeleteError("ConnectorSchedule", "connector_id", id, err)
+	}
+	if err := dbConn.Where("connector_id = ?", id).Delete(&dbapi.ConnectorScheduleExecution{}).Error; err != nil {
+		return services.HandleDeleteError("ConnectorScheduleExecution", "connector_id", id, err)
+	}
 
 	_ = db.AddPostCommitAction(ctx, func() {
 		// delete related distributed resources...
@@ -211,6 +250,15 @@ func GetValidConnectorColumns() []string {
 	return []string{"id", "created_at", "updated_at", "name", "owner", "organisation_id", "kafka_id", "connector_type_id", "desired_state", "state", "channel", "kafka_bootstrap_server", "service_account_client_id", "schema_registry_id", "schema_registry_url", "namespace_id"}
 }
 
+// GetSearchConnectorColumns returns the typed columns connectors can be searched by
+func GetSearchConnectorColumns() []coreServices.Column {
+	columns := coreServices.SetColumnType(coreServices.TypedColumns(coreServices.StringColumn, GetValidConnectorColumns()...), coreServices.TimestampColumn, "created_at", "updated_at")
+	return append(columns,
+		coreServices.Column{Name: "connector_spec", Type: coreServices.JSONColumn},
+		coreServices.Column{Name: "annotations", Type: coreServices.KeyValueColumn, Expression: "(SELECT jsonb_object_agg(key, value) FROM connector_annotations WHERE connector_annotations.connector_id = connectors.id)"},
+	)
+}
+
 var columnRegex = regexp.MustCompile("^(" + strings.Join(GetValidConnectorColumns(), "|") + ")")
 
 // List returns all connectors visible to the user within the requested paging window.
@@ -220,10 +268,6 @@ func (k *connectorsService) List(ctx context.Context, listArgs *services.ListArg
 	}
 
 	dbConn := k.connectionFactory.New()
-	pagingMeta := &api.PagingMeta{
-		Page: listArgs.Page,
-		Size: listArgs.Size,
-	}
 
 	var err *errors.ServiceError
 	admin, err := isAdmin(ctx)
@@ -244,10 +288,10 @@ func (k *connectorsService) List(ctx context.Context, listArgs *services.ListArg
 	joinedStatus := false
 	// Apply search query
 	if len(listArgs.Search) > 0 {
-		queryParser := coreServices.NewQueryParserWithColumnPrefix("connectors", GetValidConnectorColumns()...)
+		queryParser := coreServices.NewTypedQueryParserWithColumnPrefix("connectors", GetSearchConnectorColumns()...)
 		searchDbQuery, err := queryParser.Parse(listArgs.Search)
 		if err != nil {
-			return nil, pagingMeta, errors.NewWithCause(errors.ErrorFailedToParseSearch, err, "Unable to list connector requests: %s", err.Error())
+			return nil, nil, errors.NewWithCause(errors.ErrorFailedToParseSearch, err, "Unable to list connector requests: %s", err.Error())
 		}
 		if strings.Contains(searchDbQuery.Query, "connectors.state") {
 			joinedStatus = true
@@ -258,14 +302,10 @@ func (k *connectorsService) List(ctx context.Context, listArgs *services.ListArg
 		dbConn = dbConn.Where(searchDbQuery.Query, searchDbQuery.Values...)
 	}
 
-	// set total, limit and paging (based on https://gitlab.cee.redhat.com/service/api-guidelines#user-content-paging)
-	total := int64(pagingMeta.Total)
-	dbConn.Model(&dbapi.ConnectorList{}).Count(&total)
-	pagingMeta.Total = int(total)
-	if pagingMeta.Size > pagingMeta.Total {
-		pagingMeta.Size = pagingMeta.Total
+	dbConn, pagingMeta, err := listArgs.Paginate(dbConn, &dbapi.ConnectorList{}, "connectors.id")
+	if err != nil {
+		return nil, nil, err
 	}
-	dbConn = dbConn.Offset((pagingMeta.Page - 1) * pagingMeta.Size).Limit(pagingMeta.Size)
 
 	// Set the order by arguments if any
 	if len(listArgs.OrderBy) == 0 {
@@ -321,6 +361,10 @@ func (k *connectorsService) Update(ctx context.Context, resource *dbapi.Connecto
 		if err := dbConn.Where("connector_id = ?", resource.ID).Delete(&dbapi.ConnectorAnnotation{}).Error; err != nil {
 			return services.HandleUpdateError("Connector", err)
 		}
+		// and old schedule, which is saved again if the connector still has one
+		if err := dbConn.Where("connector_id = ?", resource.ID).Delete(&dbapi.ConnectorSchedule{}).Error; err != nil {
+			return services.HandleUpdateError("Connector", err)
+		}
 
 		update := dbConn.Model(resource).Session(&gorm.Session{FullSaveAssociations: true}).
 			Where("id = ? AND version = ?", resource.ID, resource.Version).Updates(resource)
@@ -489,3 +533,17 @@ func getSecretsFromVaultAsBase64(connector *dbapi.Connector, cts ConnectorTypesS
 	}
 	return nil
 }
+
+func (k *connectorsService) GetDeploymentMetrics(ctx context.Context, id string) (dbapi.ConnectorDeploymentMetrics, *errors.ServiceError) {
+	var statuses []dbapi.ConnectorDeploymentStatus
+	if err := k.connectionFactory.New().Select("connector_deployment_statuses.metrics").
+		Joins("JOIN connector_deployments ON connector_deployments.id = connector_deployment_statuses.id AND connector_deployments.deleted_at IS NULL").
+		Where("connector_deployments.connector_id = ?", id).
+		Find(&statuses).Error; err != nil {
+		return dbapi.ConnectorDeploymentMetrics{}, services.HandleGetError("Connector deployment status", "connector_id", id, err)
+	}
+	if len(statuses) == 0 {
+		return dbapi.ConnectorDeploymentMetrics{}, nil
+	}
+	return statuses[0].Metrics, nil
+}
@@ -0,0 +1,114 @@
+package services
+
+import (
+	"context"
+	goerrors "errors"
+	"fmt"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// KafkaReferencesService checks the Kafka instances referenced by connectors.
+// Kafka instances can only be checked when the connector service runs in the same fleet manager as the Kafka service,
+// otherwise connector Kafka references are left unchecked.
+// Schema registries referenced by connectors aren't managed by a fleet manager service, so they are not checked.
+type KafkaReferencesService interface {
+	// Enabled returns true if the Kafka instances referenced by connectors are checked
+	Enabled() bool
+	// ValidateConnectorKafka checks that the Kafka referenced by a connector exists, belongs to the owner or organisation
+	// of the connector and is ready, and sets the bootstrap server of the connector to the one of the Kafka
+	ValidateConnectorKafka(ctx context.Context, connector *dbapi.Connector) *errors.ServiceError
+	// DeletedConnectorKafka returns a sub-query selecting the Kafka of a connector if it has been deleted,
+	// to be used in an EXISTS condition of a query on the connectors table
+	DeletedConnectorKafka() *gorm.DB
+}
+
+var _ KafkaReferencesService = &kafkaReferencesService{}
+var _ KafkaReferencesService = &disabledKafkaReferencesService{}
+
+// kafkaRequest has the columns of the Kafka service kafka_requests table needed to check connectors
+type kafkaRequest struct {
+	ID                  string
+	Status              string
+	Owner               string
+	OrganisationId      string
+	BootstrapServerHost string
+}
+
+type kafkaReferencesService struct {
+	connectionFactory *db.ConnectionFactory
+}
+
+func NewKafkaReferencesService(connectionFactory *db.ConnectionFactory) *kafkaReferencesService {
+	return &kafkaReferencesService{
+		connectionFactory: connectionFactory,
+	}
+}
+
+func (k *kafkaReferencesService) Enabled() bool {
+	return true
+}
+
+func (k *kafkaReferencesService) ValidateConnectorKafka(ctx context.Context, connector *dbapi.Connector) *errors.ServiceError {
+	kafkaId := connector.Kafka.KafkaID
+
+	var kafka kafkaRequest
+	if err := k.connectionFactory.New().Table("kafka_requests").
+		Select("id, status, owner, organisation_id, bootstrap_server_host").
+		Where("id = ? AND deleted_at IS NULL", kafkaId).
+		Take(&kafka).Error; err != nil {
+		if goerrors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.BadRequest("kafka %s not found", kafkaId)
+		}
+		return errors.GeneralError("failed to get kafka %s: %v", kafkaId, err)
+	}
+
+	// kafkas of other users in the same organisation can be used, like connector namespaces
+	if connector.OrganisationId != "" {
+		if kafka.OrganisationId != connector.OrganisationId {
+			return errors.BadRequest("kafka %s not found", kafkaId)
+		}
+	} else if kafka.Owner != connector.Owner {
+		return errors.BadRequest("kafka %s not found", kafkaId)
+	}
+
+	if kafka.Status != constants.KafkaRequestStatusReady.String() || kafka.BootstrapServerHost == "" {
+		return errors.BadRequest("kafka %s is not ready, its status is %s", kafkaId, kafka.Status)
+	}
+
+	connector.Kafka.BootstrapServer = fmt.Sprintf("%s:443", kafka.BootstrapServerHost)
+	return nil
+}
+
+func (k *kafkaReferencesService) DeletedConnectorKafka() *gorm.DB {
+	// the sub-query is correlated to the connectors, so that only the kafkas of the queried connectors are looked up
+	return k.connectionFactory.New().Table("kafka_requests").Select("1").
+		Where("kafka_requests.id = connectors.kafka_id").
+		Where("kafka_requests.deleted_at IS NOT NULL OR kafka_requests.status IN ?", []string{
+			constants.KafkaRequestStatusDeprovision.String(),
+			constants.KafkaRequestStatusDeleting.String(),
+		})
+}
+
+// disabledKafkaReferencesService is used when the connector service runs without the Kafka service
+type disabledKafkaReferencesService struct{}
+
+func NewDisabledKafkaReferencesService() *disabledKafkaReferencesService {
+	return &disabledKafkaReferencesService{}
+}
+
+func (d *disabledKafkaReferencesService) Enabled() bool {
+	return false
+}
+
+func (d *disabledKafkaReferencesService) ValidateConnectorKafka(ctx context.Context, connector *dbapi.Connector) *errors.ServiceError {
+	return nil
+}
+
+func (d *disabledKafkaReferencesService) DeletedConnectorKafka() *gorm.DB {
+	return nil
+}
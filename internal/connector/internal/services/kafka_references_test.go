@@ -0,0 +1,117 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_kafkaReferencesService_ValidateConnectorKafka(t *testing.T) {
+	kafkaReply := func(status, owner, organisationId string) []map[string]interface{} {
+		return []map[string]interface{}{{
+			"id":                    "kafka-id",
+			"status":                status,
+			"owner":                 owner,
+			"organisation_id":       organisationId,
+			"bootstrap_server_host": "kafka-id.kafka.example.com",
+		}}
+	}
+
+	tests := []struct {
+		name                string
+		owner               string
+		organisationId      string
+		reply               []map[string]interface{}
+		wantErr             bool
+		wantBootstrapServer string
+	}{
+		{
+			name:                "should set the bootstrap server of a ready kafka in the same organisation",
+			owner:               "user",
+			organisationId:      "org",
+			reply:               kafkaReply("ready", "other-user", "org"),
+			wantBootstrapServer: "kafka-id.kafka.example.com:443",
+		},
+		{
+			name:                "should set the bootstrap server of a ready kafka of the same owner",
+			owner:               "user",
+			reply:               kafkaReply("ready", "user", ""),
+			wantBootstrapServer: "kafka-id.kafka.example.com:443",
+		},
+		{
+			name:           "should reject a kafka of another organisation",
+			owner:          "user",
+			organisationId: "org",
+			reply:          kafkaReply("ready", "user", "other-org"),
+			wantErr:        true,
+		},
+		{
+			name:    "should reject a kafka of another owner",
+			owner:   "user",
+			reply:   kafkaReply("ready", "other-user", ""),
+			wantErr: true,
+		},
+		{
+			name:           "should reject a kafka that is not ready",
+			owner:          "user",
+			organisationId: "org",
+			reply:          kafkaReply("provisioning", "user", "org"),
+			wantErr:        true,
+		},
+		{
+			name:           "should reject a kafka that doesn't exist",
+			owner:          "user",
+			organisationId: "org",
+			wantErr:        true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mocket.Catcher.Reset()
+			if tt.reply != nil {
+				mocket.Catcher.NewMock().WithQuery(`FROM "kafka_requests"`).WithReply(tt.reply)
+			}
+
+			connector := &dbapi.Connector{
+				Owner:          tt.owner,
+				OrganisationId: tt.organisationId,
+				Kafka: dbapi.KafkaConnectionSettings{
+					KafkaID:         "kafka-id",
+					BootstrapServer: "user-provided:443",
+				},
+			}
+			err := NewKafkaReferencesService(db.NewMockConnectionFactory(nil)).ValidateConnectorKafka(context.Background(), connector)
+			if tt.wantErr {
+				g.Expect(err).To(gomega.HaveOccurred())
+				g.Expect(connector.Kafka.BootstrapServer).To(gomega.Equal("user-provided:443"))
+				return
+			}
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			g.Expect(connector.Kafka.BootstrapServer).To(gomega.Equal(tt.wantBootstrapServer))
+		})
+	}
+}
+
+func Test_kafkaReferencesService_DeletedConnectorKafka(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mocket.Catcher.Reset()
+	query := mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "connectors" WHERE (desired_state = $1 AND EXISTS ` +
+		`(SELECT 1 FROM "kafka_requests" WHERE (kafka_requests.id = connectors.kafka_id) AND ` +
+		`(kafka_requests.deleted_at IS NOT NULL OR kafka_requests.status IN ($2,$3))))`).
+		WithReply([]map[string]interface{}{{"id": "connector-id"}})
+
+	connectionFactory := db.NewMockConnectionFactory(nil)
+	var connectors dbapi.ConnectorList
+	err := connectionFactory.New().Table("connectors").Where("desired_state = ? AND EXISTS (?)", dbapi.ConnectorReady,
+		NewKafkaReferencesService(connectionFactory).DeletedConnectorKafka()).Find(&connectors).Error
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(query.Triggered).To(gomega.BeTrue())
+	g.Expect(connectors).To(gomega.HaveLen(1))
+}
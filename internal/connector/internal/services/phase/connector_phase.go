@@ -18,6 +18,7 @@ const (
 	StopConnector     ConnectorOperation = "stop"
 	RestartConnector  ConnectorOperation = "restart"
 	DeleteConnector   ConnectorOperation = "delete"
+	MoveConnector     ConnectorOperation = "move"
 )
 
 // ConnectorFSM handles connector phase changes within it's cluster's current phase
@@ -48,6 +49,8 @@ var connectorEvents = map[dbapi.ConnectorNamespacePhaseEnum][]fsm.EventDesc{
 		{Name: string(RestartConnector), Src: []string{string(dbapi.ConnectorStopped), string(dbapi.ConnectorReady)}, Dst: string(dbapi.ConnectorReady)},
 		{Name: string(StopConnector), Src: []string{string(dbapi.ConnectorStopped), string(dbapi.ConnectorReady)}, Dst: string(dbapi.ConnectorStopped)},
 		{Name: string(DeleteConnector), Src: []string{string(dbapi.ConnectorUnassigned), string(dbapi.ConnectorReady), string(dbapi.ConnectorStopped), string(dbapi.ConnectorDeleted)}, Dst: string(dbapi.ConnectorDeleted)},
+		// a moved connector is unassigned from its namespace, then assigned to its target namespace by the connector manager
+		{Name: string(MoveConnector), Src: []string{string(dbapi.ConnectorReady), string(dbapi.ConnectorStopped)}, Dst: string(dbapi.ConnectorUnassigned)},
 	},
 	dbapi.ConnectorNamespacePhaseDeleting: {
 		{Name: string(UnassignConnector), Src: []string{string(dbapi.ConnectorUnassigned), string(dbapi.ConnectorReady), string(dbapi.ConnectorStopped)}, Dst: string(dbapi.ConnectorUnassigned)},
@@ -63,6 +66,7 @@ var ConnectorStartingPhase = map[ConnectorOperation]dbapi.ConnectorStatusPhase{
 	StopConnector:     dbapi.ConnectorStatusPhaseAssigned,
 	UpdateConnector:   dbapi.ConnectorStatusPhaseUpdating,
 	DeleteConnector:   dbapi.ConnectorStatusPhaseDeleting,
+	MoveConnector:     dbapi.ConnectorStatusPhaseDeleting,
 }
 
 func NewConnectorFSM(namespace *dbapi.ConnectorNamespace, connector *dbapi.Connector) *ConnectorFSM {
@@ -0,0 +1,102 @@
+package phase
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+)
+
+func Test_PerformConnectorOperation(t *testing.T) {
+
+	tests := []struct {
+		scenario       string
+		namespacePhase dbapi.ConnectorNamespacePhaseEnum
+		operation      ConnectorOperation
+		desiredState   dbapi.ConnectorDesiredState
+		expectError    bool
+		updated        bool
+		result         dbapi.ConnectorDesiredState
+		resultPhase    dbapi.ConnectorStatusPhase
+	}{
+		{
+			scenario:       "move ready connector in ready namespace",
+			namespacePhase: dbapi.ConnectorNamespacePhaseReady,
+			operation:      MoveConnector,
+			desiredState:   dbapi.ConnectorReady,
+			updated:        true,
+			result:         dbapi.ConnectorUnassigned,
+			resultPhase:    dbapi.ConnectorStatusPhaseDeleting,
+		},
+		{
+			scenario:       "move stopped connector in ready namespace",
+			namespacePhase: dbapi.ConnectorNamespacePhaseReady,
+			operation:      MoveConnector,
+			desiredState:   dbapi.ConnectorStopped,
+			updated:        true,
+			result:         dbapi.ConnectorUnassigned,
+			resultPhase:    dbapi.ConnectorStatusPhaseDeleting,
+		},
+		{
+			scenario:       "move deleted connector",
+			namespacePhase: dbapi.ConnectorNamespacePhaseReady,
+			operation:      MoveConnector,
+			desiredState:   dbapi.ConnectorDeleted,
+			expectError:    true,
+			result:         dbapi.ConnectorDeleted,
+		},
+		{
+			scenario:       "move connector in disconnected namespace",
+			namespacePhase: dbapi.ConnectorNamespacePhaseDisconnected,
+			operation:      MoveConnector,
+			desiredState:   dbapi.ConnectorReady,
+			expectError:    true,
+			result:         dbapi.ConnectorReady,
+		},
+		{
+			scenario:       "stop ready connector",
+			namespacePhase: dbapi.ConnectorNamespacePhaseReady,
+			operation:      StopConnector,
+			desiredState:   dbapi.ConnectorReady,
+			updated:        true,
+			result:         dbapi.ConnectorStopped,
+			resultPhase:    dbapi.ConnectorStatusPhaseAssigned,
+		},
+		{
+			scenario:       "delete connector in deleting namespace",
+			namespacePhase: dbapi.ConnectorNamespacePhaseDeleting,
+			operation:      DeleteConnector,
+			desiredState:   dbapi.ConnectorReady,
+			updated:        true,
+			result:         dbapi.ConnectorDeleted,
+			resultPhase:    dbapi.ConnectorStatusPhaseDeleting,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.scenario, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			namespace := &dbapi.ConnectorNamespace{
+				Status: dbapi.ConnectorNamespaceStatus{
+					Phase: tt.namespacePhase,
+				},
+			}
+			connector := &dbapi.Connector{
+				DesiredState: tt.desiredState,
+			}
+			phaseSaved := false
+			updated, err := PerformConnectorOperation(namespace, connector, tt.operation, func(c *dbapi.Connector) *errors.ServiceError {
+				phaseSaved = true
+				return nil
+			})
+
+			g.Expect(updated).Should(gomega.Equal(tt.updated), "PerformConnectorOperation updated=%v, expect updated=%v", updated, tt.updated)
+			g.Expect(err != nil).Should(gomega.Equal(tt.expectError), "PerformConnectorOperation error=%v, expect error=%v", err, tt.expectError)
+			g.Expect(phaseSaved).Should(gomega.Equal(tt.updated), "PerformConnectorOperation phase updated=%v, expect phase updated=%v", phaseSaved, tt.updated)
+			g.Expect(connector.DesiredState).Should(gomega.Equal(tt.result))
+			g.Expect(connector.Status.Phase).Should(gomega.Equal(tt.resultPhase))
+		})
+	}
+}
@@ -0,0 +1,321 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+)
+
+const (
+	// clusterReadyCondition is the condition reported by the agent on the readiness of its cluster
+	clusterReadyCondition = "Ready"
+
+	reasonExplicitNamespace = "namespace requested by the connector"
+	reasonRanked            = "namespace with the most remaining quota and the fewest deployments"
+)
+
+// ConnectorScheduler places connectors in namespaces, filtering them on cluster readiness, operator and agent compatibility,
+// affinity and quota, then ranking them on their remaining quota
+//
+//go:generate moq -out connector_scheduler_moq.go . ConnectorScheduler
+type ConnectorScheduler interface {
+	// Schedule chooses the namespace to deploy the connector to, among its requested namespace or, when it has none,
+	// the namespaces of its owner and organisation. A nil namespace is returned if no namespace can host the connector yet.
+	Schedule(ctx context.Context, connector *dbapi.Connector, shardMetadata *dbapi.ConnectorShardMetadata) (*dbapi.ConnectorNamespace, *dbapi.ConnectorSchedulingDecision, *errors.ServiceError)
+	// Incompatibility checks that the operators and the agent of a cluster can run a deployment with the given shard metadata,
+	// e.g. before an existing deployment is updated. It returns why they can't, or an empty string if they can.
+	Incompatibility(ctx context.Context, clusterId string, shardMetadata *dbapi.ConnectorShardMetadata) (string, *errors.ServiceError)
+}
+
+var _ ConnectorScheduler = &connectorScheduler{}
+
+type connectorScheduler struct {
+	connectionFactory *db.ConnectionFactory
+	namespaceService  services.ConnectorNamespaceService
+	agentConfig       *config.ConnectorAgentConfig
+}
+
+func NewConnectorScheduler(connectionFactory *db.ConnectionFactory, namespaceService services.ConnectorNamespaceService,
+	agentConfig *config.ConnectorAgentConfig) *connectorScheduler {
+	return &connectorScheduler{
+		connectionFactory: connectionFactory,
+		namespaceService:  namespaceService,
+		agentConfig:       agentConfig,
+	}
+}
+
+// candidate is a namespace considered to host a connector
+type candidate struct {
+	namespace *dbapi.ConnectorNamespace
+	cluster   *dbapi.ConnectorCluster
+	dbapi.ConnectorSchedulingCandidate
+}
+
+func (s *connectorScheduler) Schedule(ctx context.Context, connector *dbapi.Connector, shardMetadata *dbapi.ConnectorShardMetadata) (*dbapi.ConnectorNamespace, *dbapi.ConnectorSchedulingDecision, *errors.ServiceError) {
+	explicitNamespace := connector.NamespaceId != nil && *connector.NamespaceId != ""
+
+	candidates, err := s.findCandidates(connector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	requirements, reqErr := getOperatorRequirements(shardMetadata)
+	if reqErr != nil {
+		return nil, nil, errors.GeneralError("invalid operators in shard metadata of connector type %s and channel %s: %v",
+			shardMetadata.ConnectorTypeId, shardMetadata.Channel, reqErr)
+	}
+	affinity, err := s.getNamespaceAffinity(connector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, c := range candidates {
+		c.Rejection = filter(c, requirements, affinity, s.agentConfig)
+		// a requested namespace has already been checked for quota when the connector was created
+		if c.Rejection == "" && !explicitNamespace {
+			if quotaErr := s.namespaceService.CheckConnectorQuota(c.namespace.ID, connector.ConnectorTypeId, connector.Channel); quotaErr != nil {
+				if !quotaErr.InSufficientQuota() {
+					return nil, nil, quotaErr
+				}
+				c.Rejection = quotaErr.Reason
+			}
+		}
+	}
+
+	if err := s.rank(candidates); err != nil {
+		return nil, nil, err
+	}
+
+	decision := &dbapi.ConnectorSchedulingDecision{
+		ScheduledAt: time.Now(),
+		Candidates:  make([]dbapi.ConnectorSchedulingCandidate, 0, len(candidates)),
+	}
+	for _, c := range candidates {
+		decision.Candidates = append(decision.Candidates, c.ConnectorSchedulingCandidate)
+	}
+
+	if len(candidates) == 0 || candidates[0].Rejection != "" {
+		return nil, decision, nil
+	}
+
+	chosen := candidates[0]
+	decision.NamespaceID = chosen.namespace.ID
+	decision.ClusterID = chosen.cluster.ID
+	decision.Reason = reasonRanked
+	if explicitNamespace {
+		decision.Reason = reasonExplicitNamespace
+	}
+	return chosen.namespace, decision, nil
+}
+
+func (s *connectorScheduler) Incompatibility(ctx context.Context, clusterId string, shardMetadata *dbapi.ConnectorShardMetadata) (string, *errors.ServiceError) {
+	var cluster dbapi.ConnectorCluster
+	if err := s.connectionFactory.New().Where("id = ?", clusterId).First(&cluster).Error; err != nil {
+		return "", coreServices.HandleGetError("Connector cluster", "id", clusterId, err)
+	}
+
+	requirements, err := getOperatorRequirements(shardMetadata)
+	if err != nil {
+		return "", errors.GeneralError("invalid operators in shard metadata of connector type %s and channel %s: %v",
+			shardMetadata.ConnectorTypeId, shardMetadata.Channel, err)
+	}
+	if reason := operatorIncompatibilityReason(&cluster, requirements); reason != "" {
+		return reason, nil
+	}
+	return agentIncompatibilityReason(&cluster, requirements, s.agentConfig), nil
+}
+
+// findCandidates loads the ready namespaces that may host the connector, with their cluster
+func (s *connectorScheduler) findCandidates(connector *dbapi.Connector) ([]*candidate, *errors.ServiceError) {
+	dbConn := s.connectionFactory.New().Preload("Annotations")
+	if connector.NamespaceId != nil && *connector.NamespaceId != "" {
+		dbConn = dbConn.Where("id = ?", *connector.NamespaceId)
+	}
+	if connector.OrganisationId != "" {
+		dbConn = dbConn.Where("(tenant_organisation_id = ? OR tenant_user_id = ?)", connector.OrganisationId, connector.Owner)
+	} else {
+		dbConn = dbConn.Where("tenant_user_id = ?", connector.Owner)
+	}
+
+	var namespaces dbapi.ConnectorNamespaceList
+	if err := dbConn.Where("status_phase = ?", dbapi.ConnectorNamespacePhaseReady).
+		Order("id").Find(&namespaces).Error; err != nil {
+		return nil, coreServices.HandleGetError("Connector namespace", "owner", connector.Owner, err)
+	}
+	if len(namespaces) == 0 {
+		return nil, nil
+	}
+
+	clusterIds := make([]string, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		clusterIds = append(clusterIds, namespace.ClusterId)
+	}
+	var clusters dbapi.ConnectorClusterList
+	if err := s.connectionFactory.New().Where("id IN ?", clusterIds).Find(&clusters).Error; err != nil {
+		return nil, coreServices.HandleGetError("Connector cluster", "id", clusterIds, err)
+	}
+	clustersById := make(map[string]*dbapi.ConnectorCluster, len(clusters))
+	for i := range clusters {
+		clustersById[clusters[i].ID] = &clusters[i]
+	}
+
+	candidates := make([]*candidate, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		cluster, ok := clustersById[namespace.ClusterId]
+		if !ok {
+			continue // the cluster is being deleted
+		}
+		candidates = append(candidates, &candidate{
+			namespace: namespace,
+			cluster:   cluster,
+			ConnectorSchedulingCandidate: dbapi.ConnectorSchedulingCandidate{
+				NamespaceID: namespace.ID,
+				ClusterID:   cluster.ID,
+			},
+		})
+	}
+	return candidates, nil
+}
+
+// rank sorts the candidates, feasible namespaces first, by decreasing remaining connectors quota and increasing number of deployments
+func (s *connectorScheduler) rank(candidates []*candidate) *errors.ServiceError {
+	feasible := make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		if c.Rejection != "" {
+			continue
+		}
+		feasible = append(feasible, c.namespace.ID)
+		remaining, err := s.namespaceService.GetRemainingQuota(c.namespace.ID)
+		if err != nil {
+			return err
+		}
+		c.RemainingConnectors = -1
+		if remaining.Connectors > 0 {
+			c.RemainingConnectors = remaining.Connectors
+		}
+	}
+
+	if len(feasible) > 0 {
+		var deployments []struct {
+			NamespaceId string
+			Count       int64
+		}
+		if err := s.connectionFactory.New().Model(&dbapi.ConnectorDeployment{}).
+			Select("namespace_id, count(*) as count").
+			Where("namespace_id IN ?", feasible).
+			Group("namespace_id").
+			Scan(&deployments).Error; err != nil {
+			return coreServices.HandleGetError("Connector deployment", "namespace_id", feasible, err)
+		}
+		counts := make(map[string]int64, len(deployments))
+		for _, d := range deployments {
+			counts[d.NamespaceId] = d.Count
+		}
+		for _, c := range candidates {
+			c.Deployments = counts[c.namespace.ID]
+		}
+	}
+
+	sortCandidates(candidates)
+	return nil
+}
+
+func sortCandidates(candidates []*candidate) {
+	remaining := func(c *candidate) int64 {
+		if c.RemainingConnectors < 0 {
+			return math.MaxInt64
+		}
+		return int64(c.RemainingConnectors)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if (a.Rejection == "") != (b.Rejection == "") {
+			return a.Rejection == ""
+		}
+		if remaining(a) != remaining(b) {
+			return remaining(a) > remaining(b)
+		}
+		return a.Deployments < b.Deployments
+	})
+}
+
+// filter returns why the candidate can't host the connector, or an empty string if it can
+func filter(c *candidate, requirements []operatorRequirement, affinity map[string]string, agentConfig *config.ConnectorAgentConfig) string {
+	if reason := clusterNotReadyReason(c.cluster); reason != "" {
+		return reason
+	}
+	if reason := operatorIncompatibilityReason(c.cluster, requirements); reason != "" {
+		return reason
+	}
+	if reason := agentIncompatibilityReason(c.cluster, requirements, agentConfig); reason != "" {
+		return reason
+	}
+	return affinityMismatchReason(c.namespace, affinity)
+}
+
+func clusterNotReadyReason(cluster *dbapi.ConnectorCluster) string {
+	if cluster.Status.Phase != dbapi.ConnectorClusterPhaseReady {
+		return fmt.Sprintf("cluster %s is %s", cluster.ID, cluster.Status.Phase)
+	}
+	for _, condition := range cluster.Status.Conditions {
+		if condition.Type == clusterReadyCondition && !strings.EqualFold(condition.Status, "True") {
+			return fmt.Sprintf("cluster %s is not ready: %s", cluster.ID, condition.Message)
+		}
+	}
+	return ""
+}
+
+// getNamespaceAffinity loads the affinity annotation of the connector, which isn't preloaded by the reconcilers
+func (s *connectorScheduler) getNamespaceAffinity(connector *dbapi.Connector) (map[string]string, *errors.ServiceError) {
+	var annotations []dbapi.ConnectorAnnotation
+	if err := s.connectionFactory.New().
+		Where("connector_id = ? AND key = ?", connector.ID, dbapi.ConnectorNamespaceAffinityAnnotation).
+		Find(&annotations).Error; err != nil {
+		return nil, coreServices.HandleGetError("Connector annotation", "connector_id", connector.ID, err)
+	}
+	affinity := make(map[string]string)
+	for _, annotation := range annotations {
+		for key, value := range parseNamespaceAffinity(annotation.Value) {
+			affinity[key] = value
+		}
+	}
+	return affinity, nil
+}
+
+// parseNamespaceAffinity parses a comma separated list of namespace annotations, e.g. 'region=eu,tier=premium'
+func parseNamespaceAffinity(value string) map[string]string {
+	affinity := make(map[string]string)
+	for _, term := range strings.Split(value, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(term), "=")
+		if key = strings.TrimSpace(key); key != "" {
+			affinity[key] = strings.TrimSpace(value)
+		}
+	}
+	return affinity
+}
+
+func affinityMismatchReason(namespace *dbapi.ConnectorNamespace, affinity map[string]string) string {
+	for key, value := range affinity {
+		found := false
+		for _, annotation := range namespace.Annotations {
+			if annotation.Key == key && annotation.Value == value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Sprintf("namespace does not match affinity %s=%s", key, value)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,145 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package scheduler
+
+import (
+	"context"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"sync"
+)
+
+// Ensure, that ConnectorSchedulerMock does implement ConnectorScheduler.
+// If this is not the case, regenerate this file with moq.
+var _ ConnectorScheduler = &ConnectorSchedulerMock{}
+
+// ConnectorSchedulerMock is a mock implementation of ConnectorScheduler.
+//
+//	func TestSomethingThatUsesConnectorScheduler(t *testing.T) {
+//
+//		// make and configure a mocked ConnectorScheduler
+//		mockedConnectorScheduler := &ConnectorSchedulerMock{
+//			IncompatibilityFunc: func(ctx context.Context, clusterId string, shardMetadata *dbapi.ConnectorShardMetadata) (string, *errors.ServiceError) {
+//				panic("mock out the Incompatibility method")
+//			},
+//			ScheduleFunc: func(ctx context.Context, connector *dbapi.Connector, shardMetadata *dbapi.ConnectorShardMetadata) (*dbapi.ConnectorNamespace, *dbapi.ConnectorSchedulingDecision, *errors.ServiceError) {
+//				panic("mock out the Schedule method")
+//			},
+//		}
+//
+//		// use mockedConnectorScheduler in code that requires ConnectorScheduler
+//		// and then make assertions.
+//
+//	}
+type ConnectorSchedulerMock struct {
+	// IncompatibilityFunc mocks the Incompatibility method.
+	IncompatibilityFunc func(ctx context.Context, clusterId string, shardMetadata *dbapi.ConnectorShardMetadata) (string, *errors.ServiceError)
+
+	// ScheduleFunc mocks the Schedule method.
+	ScheduleFunc func(ctx context.Context, connector *dbapi.Connector, shardMetadata *dbapi.ConnectorShardMetadata) (*dbapi.ConnectorNamespace, *dbapi.ConnectorSchedulingDecision, *errors.ServiceError)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Incompatibility holds details about calls to the Incompatibility method.
+		Incompatibility []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ClusterId is the clusterId argument value.
+			ClusterId string
+			// ShardMetadata is the shardMetadata argument value.
+			ShardMetadata *dbapi.ConnectorShardMetadata
+		}
+		// Schedule holds details about calls to the Schedule method.
+		Schedule []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Connector is the connector argument value.
+			Connector *dbapi.Connector
+			// ShardMetadata is the shardMetadata argument value.
+			ShardMetadata *dbapi.ConnectorShardMetadata
+		}
+	}
+	lockIncompatibility sync.RWMutex
+	lockSchedule        sync.RWMutex
+}
+
+// Incompatibility calls IncompatibilityFunc.
+func (mock *ConnectorSchedulerMock) Incompatibility(ctx context.Context, clusterId string, shardMetadata *dbapi.ConnectorShardMetadata) (string, *errors.ServiceError) {
+	if mock.IncompatibilityFunc == nil {
+		panic("ConnectorSchedulerMock.IncompatibilityFunc: method is nil but ConnectorScheduler.Incompatibility was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		ClusterId     string
+		ShardMetadata *dbapi.ConnectorShardMetadata
+	}{
+		Ctx:           ctx,
+		ClusterId:     clusterId,
+		ShardMetadata: shardMetadata,
+	}
+	mock.lockIncompatibility.Lock()
+	mock.calls.Incompatibility = append(mock.calls.Incompatibility, callInfo)
+	mock.lockIncompatibility.Unlock()
+	return mock.IncompatibilityFunc(ctx, clusterId, shardMetadata)
+}
+
+// IncompatibilityCalls gets all the calls that were made to Incompatibility.
+// Check the length with:
+//
+//	len(mockedConnectorScheduler.IncompatibilityCalls())
+func (mock *ConnectorSchedulerMock) IncompatibilityCalls() []struct {
+	Ctx           context.Context
+	ClusterId     string
+	ShardMetadata *dbapi.ConnectorShardMetadata
+} {
+	var calls []struct {
+		Ctx           context.Context
+		ClusterId     string
+		ShardMetadata *dbapi.ConnectorShardMetadata
+	}
+	mock.lockIncompatibility.RLock()
+	calls = mock.calls.Incompatibility
+	mock.lockIncompatibility.RUnlock()
+	return calls
+}
+
+// Schedule calls ScheduleFunc.
+func (mock *ConnectorSchedulerMock) Schedule(ctx context.Context, connector *dbapi.Connector, shardMetadata *dbapi.ConnectorShardMetadata) (*dbapi.ConnectorNamespace, *dbapi.ConnectorSchedulingDecision, *errors.ServiceError) {
+	if mock.ScheduleFunc == nil {
+		panic("ConnectorSchedulerMock.ScheduleFunc: method is nil but ConnectorScheduler.Schedule was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		Connector     *dbapi.Connector
+		ShardMetadata *dbapi.ConnectorShardMetadata
+	}{
+		Ctx:           ctx,
+		Connector:     connector,
+		ShardMetadata: shardMetadata,
+	}
+	mock.lockSchedule.Lock()
+	mock.calls.Schedule = append(mock.calls.Schedule, callInfo)
+	mock.lockSchedule.Unlock()
+	return mock.ScheduleFunc(ctx, connector, shardMetadata)
+}
+
+// ScheduleCalls gets all the calls that were made to Schedule.
+// Check the length with:
+//
+//	len(mockedConnectorScheduler.ScheduleCalls())
+func (mock *ConnectorSchedulerMock) ScheduleCalls() []struct {
+	Ctx           context.Context
+	Connector     *dbapi.Connector
+	ShardMetadata *dbapi.ConnectorShardMetadata
+} {
+	var calls []struct {
+		Ctx           context.Context
+		Connector     *dbapi.Connector
+		ShardMetadata *dbapi.ConnectorShardMetadata
+	}
+	mock.lockSchedule.RLock()
+	calls = mock.calls.Schedule
+	mock.lockSchedule.RUnlock()
+	return calls
+}
@@ -0,0 +1,282 @@
+package scheduler
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_operatorIncompatibilityReason(t *testing.T) {
+	shardMetadata := &dbapi.ConnectorShardMetadata{
+		ShardMetadata: api.JSON(`{"operators": [{"type": "camel-connector-operator", "version": "[1.0.0,2.0.0)"}]}`),
+	}
+	requirements, err := getOperatorRequirements(shardMetadata)
+	gomega.NewWithT(t).Expect(err).ToNot(gomega.HaveOccurred())
+
+	tests := []struct {
+		name       string
+		operators  dbapi.OperatorList
+		compatible bool
+	}{
+		{
+			name:       "cluster not reporting operators",
+			compatible: true,
+		},
+		{
+			name: "operator within the range",
+			operators: dbapi.OperatorList{
+				{Type: "debezium-connector-operator", Version: "2.0.0"},
+				{Type: "camel-connector-operator", Version: "1.1.0"},
+			},
+			compatible: true,
+		},
+		{
+			name: "operator outside of the range",
+			operators: dbapi.OperatorList{
+				{Type: "camel-connector-operator", Version: "2.1.0"},
+			},
+		},
+		{
+			name: "missing operator type",
+			operators: dbapi.OperatorList{
+				{Type: "debezium-connector-operator", Version: "1.1.0"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			cluster := &dbapi.ConnectorCluster{Status: dbapi.ConnectorClusterStatus{Operators: tt.operators}}
+			g.Expect(operatorIncompatibilityReason(cluster, requirements) == "").To(gomega.Equal(tt.compatible))
+		})
+	}
+}
+
+const agentConfigFile = `
+compatibility:
+  - agent-versions: "[0.0.1,0.1.0)"
+    operators:
+      - type: debezium-connector-operator
+        versions: "[1.0.0,)"
+  - agent-versions: "[0.1.0,0.2.0)"
+    operators:
+      - type: camel-connector-operator
+        versions: "[0.1.0,1.0.0)"
+  - agent-versions: "[0.2.0,1.0.0)"
+    operators:
+      - type: camel-connector-operator
+        versions: "[0.1.0,2.0.0)"
+`
+
+func Test_agentIncompatibilityReason(t *testing.T) {
+	g := gomega.NewWithT(t)
+	requirements, err := getOperatorRequirements(&dbapi.ConnectorShardMetadata{
+		ShardMetadata: api.JSON(`{"operators": [{"type": "camel-connector-operator", "version": "[1.0.0,2.0.0)"}]}`),
+	})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+
+	agentConfig := config.NewConnectorAgentConfig()
+	agentConfig.ConnectorAgentConfigFile = filepath.Join(t.TempDir(), "connector-agent-configuration.yaml")
+	g.Expect(os.WriteFile(agentConfig.ConnectorAgentConfigFile, []byte(agentConfigFile), 0600)).To(gomega.Succeed())
+	g.Expect(agentConfig.ReadFiles()).To(gomega.Succeed())
+
+	tests := []struct {
+		name         string
+		agentVersion string
+		operators    dbapi.OperatorList
+		compatible   bool
+	}{
+		{
+			name:         "supported agent without operators",
+			agentVersion: "0.2.0",
+			compatible:   true,
+		},
+		{
+			name:         "supported agent and operator",
+			agentVersion: "0.2.0",
+			operators:    dbapi.OperatorList{{Type: "camel-connector-operator", Version: "1.1.0"}},
+			compatible:   true,
+		},
+		{
+			name:         "operator version not supported by the agent",
+			agentVersion: "0.1.0",
+			operators:    dbapi.OperatorList{{Type: "camel-connector-operator", Version: "1.1.0"}},
+		},
+		{
+			name:         "operator type not supported by the agent",
+			agentVersion: "0.0.1",
+		},
+		{
+			name:         "unsupported agent version",
+			agentVersion: "5.0.0",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			cluster := &dbapi.ConnectorCluster{Status: dbapi.ConnectorClusterStatus{Version: tt.agentVersion, Operators: tt.operators}}
+			g.Expect(agentIncompatibilityReason(cluster, requirements, agentConfig) == "").To(gomega.Equal(tt.compatible))
+		})
+	}
+
+	// without compatibility matrix any agent is accepted
+	g.Expect(agentIncompatibilityReason(&dbapi.ConnectorCluster{}, requirements, config.NewConnectorAgentConfig())).To(gomega.BeEmpty())
+}
+
+func Test_getOperatorRequirements_InvalidRange(t *testing.T) {
+	g := gomega.NewWithT(t)
+	_, err := getOperatorRequirements(&dbapi.ConnectorShardMetadata{
+		ShardMetadata: api.JSON(`{"operators": [{"type": "camel-connector-operator", "version": "[1.0.0"}]}`),
+	})
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+func Test_clusterNotReadyReason(t *testing.T) {
+	tests := []struct {
+		name   string
+		status dbapi.ConnectorClusterStatus
+		ready  bool
+	}{
+		{
+			name:   "ready cluster",
+			status: dbapi.ConnectorClusterStatus{Phase: dbapi.ConnectorClusterPhaseReady},
+			ready:  true,
+		},
+		{
+			name:   "disconnected cluster",
+			status: dbapi.ConnectorClusterStatus{Phase: dbapi.ConnectorClusterPhaseDisconnected},
+		},
+		{
+			name: "ready cluster reporting a not ready condition",
+			status: dbapi.ConnectorClusterStatus{
+				Phase:      dbapi.ConnectorClusterPhaseReady,
+				Conditions: dbapi.ConditionList{{Type: "Ready", Status: "False", Message: "out of memory"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			cluster := &dbapi.ConnectorCluster{Status: tt.status}
+			g.Expect(clusterNotReadyReason(cluster) == "").To(gomega.Equal(tt.ready))
+		})
+	}
+}
+
+func Test_affinityMismatchReason(t *testing.T) {
+	namespace := &dbapi.ConnectorNamespace{
+		Annotations: []dbapi.ConnectorNamespaceAnnotation{
+			{Key: "region", Value: "eu"},
+			{Key: "tier", Value: "premium"},
+		},
+	}
+	tests := []struct {
+		name     string
+		affinity string
+		matches  bool
+	}{
+		{name: "no affinity", affinity: "", matches: true},
+		{name: "matching annotations", affinity: "region=eu, tier=premium", matches: true},
+		{name: "different value", affinity: "region=us", matches: false},
+		{name: "missing annotation", affinity: "zone=a", matches: false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			g.Expect(affinityMismatchReason(namespace, parseNamespaceAffinity(tt.affinity)) == "").To(gomega.Equal(tt.matches))
+		})
+	}
+}
+
+func Test_sortCandidates(t *testing.T) {
+	g := gomega.NewWithT(t)
+	newCandidate := func(id string, rejection string, remaining int32, deployments int64) *candidate {
+		return &candidate{ConnectorSchedulingCandidate: dbapi.ConnectorSchedulingCandidate{
+			NamespaceID:         id,
+			Rejection:           rejection,
+			RemainingConnectors: remaining,
+			Deployments:         deployments,
+		}}
+	}
+	candidates := []*candidate{
+		newCandidate("rejected", "cluster is disconnected", 0, 0),
+		newCandidate("few-remaining", "", 1, 0),
+		newCandidate("busy-unlimited", "", -1, 10),
+		newCandidate("many-remaining", "", 5, 3),
+		newCandidate("idle-unlimited", "", -1, 2),
+	}
+
+	sortCandidates(candidates)
+
+	ids := make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		ids = append(ids, c.NamespaceID)
+	}
+	g.Expect(ids).To(gomega.Equal([]string{"idle-unlimited", "busy-unlimited", "many-remaining", "few-remaining", "rejected"}))
+}
+
+func Test_connectorScheduler_Incompatibility(t *testing.T) {
+	g := gomega.NewWithT(t)
+	agentConfig := config.NewConnectorAgentConfig()
+	agentConfig.ConnectorAgentConfigFile = filepath.Join(t.TempDir(), "connector-agent-configuration.yaml")
+	g.Expect(os.WriteFile(agentConfig.ConnectorAgentConfigFile, []byte(agentConfigFile), 0600)).To(gomega.Succeed())
+	g.Expect(agentConfig.ReadFiles()).To(gomega.Succeed())
+
+	shardMetadata := &dbapi.ConnectorShardMetadata{
+		ShardMetadata: api.JSON(`{"operators": [{"type": "camel-connector-operator", "version": "[1.0.0,2.0.0)"}]}`),
+	}
+
+	tests := []struct {
+		name         string
+		agentVersion string
+		operators    string
+		compatible   bool
+	}{
+		{
+			name:         "cluster able to run the deployment",
+			agentVersion: "0.2.0",
+			operators:    `[{"type": "camel-connector-operator", "version": "1.1.0"}]`,
+			compatible:   true,
+		},
+		{
+			name:         "operator version not supported by the agent",
+			agentVersion: "0.1.0",
+			operators:    `[{"type": "camel-connector-operator", "version": "1.1.0"}]`,
+		},
+		{
+			name:         "operator outside of the range",
+			agentVersion: "0.2.0",
+			operators:    `[{"type": "camel-connector-operator", "version": "0.5.0"}]`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mocket.Catcher.Reset()
+			mocket.Catcher.NewMock().WithQuery(`FROM "connector_clusters"`).WithReply([]map[string]interface{}{{
+				"id":               "cluster-id",
+				"status_phase":     "ready",
+				"status_version":   tt.agentVersion,
+				"status_operators": tt.operators,
+			}})
+
+			s := NewConnectorScheduler(db.NewMockConnectionFactory(nil), nil, agentConfig)
+			reason, err := s.Incompatibility(context.Background(), "cluster-id", shardMetadata)
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			g.Expect(reason == "").To(gomega.Equal(tt.compatible))
+		})
+	}
+}
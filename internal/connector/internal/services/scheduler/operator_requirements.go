@@ -0,0 +1,110 @@
+package scheduler
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/config"
+	"github.com/blang/semver/v4"
+)
+
+// operatorRequirement is an operator needed to run a connector, as declared in the 'operators' of its shard metadata e.g.
+//
+//	{"type": "camel-connector-operator", "version": "[1.0.0,2.0.0)"}
+type operatorRequirement struct {
+	Type    string `json:"type"`
+	Version string `json:"version"`
+
+	versionRange semver.Range
+}
+
+func getOperatorRequirements(shardMetadata *dbapi.ConnectorShardMetadata) ([]operatorRequirement, error) {
+	if shardMetadata == nil || len(shardMetadata.ShardMetadata) == 0 {
+		return nil, nil
+	}
+
+	var metadata struct {
+		Operators []operatorRequirement `json:"operators"`
+	}
+	if err := json.Unmarshal(shardMetadata.ShardMetadata, &metadata); err != nil {
+		return nil, err
+	}
+
+	for i := range metadata.Operators {
+		versionRange, err := config.ParseVersionRange(metadata.Operators[i].Version)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version range %q of operator %s: %w", metadata.Operators[i].Version, metadata.Operators[i].Type, err)
+		}
+		metadata.Operators[i].versionRange = versionRange
+	}
+	return metadata.Operators, nil
+}
+
+// operatorIncompatibilityReason checks that the operators installed on the cluster can run the connector.
+// Clusters whose agent doesn't report its operators are assumed to be compatible.
+func operatorIncompatibilityReason(cluster *dbapi.ConnectorCluster, requirements []operatorRequirement) string {
+	if len(cluster.Status.Operators) == 0 {
+		return ""
+	}
+	for _, requirement := range requirements {
+		compatible := false
+		for _, operator := range cluster.Status.Operators {
+			if operator.Type != requirement.Type {
+				continue
+			}
+			version, err := semver.ParseTolerant(operator.Version)
+			if err == nil && requirement.versionRange(version) {
+				compatible = true
+				break
+			}
+		}
+		if !compatible {
+			return fmt.Sprintf("cluster %s has no operator %s with version %s", cluster.ID, requirement.Type, requirement.Version)
+		}
+	}
+	return ""
+}
+
+// agentIncompatibilityReason checks that the agent of the cluster is able to manage the operators of the connector,
+// according to the agent compatibility matrix
+func agentIncompatibilityReason(cluster *dbapi.ConnectorCluster, requirements []operatorRequirement, agentConfig *config.ConnectorAgentConfig) string {
+	if !agentConfig.HasCompatibilityMatrix() {
+		return ""
+	}
+	supported, ok := agentConfig.SupportedOperators(cluster.Status.Version)
+	if !ok {
+		return fmt.Sprintf("cluster %s agent version %q is not supported", cluster.ID, cluster.Status.Version)
+	}
+	for _, requirement := range requirements {
+		compatible := false
+		for _, operator := range supported {
+			if operator.Type != requirement.Type {
+				continue
+			}
+			if len(cluster.Status.Operators) == 0 {
+				// the operator versions aren't known, the agent only needs to support the operator type
+				compatible = true
+				break
+			}
+			for _, installed := range cluster.Status.Operators {
+				if installed.Type != requirement.Type || !operator.Supports(installed.Version) {
+					continue
+				}
+				version, err := semver.ParseTolerant(installed.Version)
+				if err == nil && requirement.versionRange(version) {
+					compatible = true
+					break
+				}
+			}
+			if compatible {
+				break
+			}
+		}
+		if !compatible {
+			return fmt.Sprintf("cluster %s agent version %s doesn't support operator %s with version %s",
+				cluster.ID, cluster.Status.Version, requirement.Type, requirement.Version)
+		}
+	}
+	return ""
+}
@@ -3,11 +3,16 @@ package workers
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"reflect"
+	"strings"
+	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/phase"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/scheduler"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/vault"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
@@ -17,6 +22,8 @@ import (
 	"github.com/golang/glog"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // ConnectorManager represents a connector manager that periodically reconciles connector requests
@@ -25,8 +32,14 @@ type ConnectorManager struct {
 	connectorService        services.ConnectorsService
 	connectorClusterService services.ConnectorClusterService
 	connectorTypesService   services.ConnectorTypesService
+	connectorScheduler      scheduler.ConnectorScheduler
+	kafkaReferences         services.KafkaReferencesService
+	schedulesService        services.ConnectorSchedulesService
+	namespaceService        services.ConnectorNamespaceService
+	connectorsConfig        *config.ConnectorsConfig
 	vaultService            vault.VaultService
 	lastVersion             int64
+	lastShards              []int
 	db                      *db.ConnectionFactory
 	ctx                     context.Context
 }
@@ -36,6 +49,11 @@ func NewConnectorManager(
 	connectorTypesService services.ConnectorTypesService,
 	connectorService services.ConnectorsService,
 	connectorClusterService services.ConnectorClusterService,
+	connectorScheduler scheduler.ConnectorScheduler,
+	kafkaReferences services.KafkaReferencesService,
+	schedulesService services.ConnectorSchedulesService,
+	namespaceService services.ConnectorNamespaceService,
+	connectorsConfig *config.ConnectorsConfig,
 	vaultService vault.VaultService,
 	db *db.ConnectionFactory,
 	reconciler workers.Reconciler,
@@ -49,6 +67,11 @@ func NewConnectorManager(
 		connectorService:        connectorService,
 		connectorClusterService: connectorClusterService,
 		connectorTypesService:   connectorTypesService,
+		connectorScheduler:      connectorScheduler,
+		kafkaReferences:         kafkaReferences,
+		schedulesService:        schedulesService,
+		namespaceService:        namespaceService,
+		connectorsConfig:        connectorsConfig,
 		vaultService:            vaultService,
 		db:                      db,
 	}
@@ -66,10 +89,25 @@ func (k *ConnectorManager) Stop() {
 	k.StopWorker(k)
 }
 
+// SupportsSharding returns true, connectors are reconciled independently of each other
+func (k *ConnectorManager) SupportsSharding() bool {
+	return true
+}
+
 func (k *ConnectorManager) Reconcile() []error {
 	glog.V(5).Infoln("Reconciling connectors...")
 	var errs []error
 
+	// connector updates of shards newly owned by this worker may not have been reconciled by their previous owner
+	if shards := k.GetShards(); !reflect.DeepEqual(shards, k.lastShards) {
+		k.lastShards = shards
+		k.lastVersion = 0
+	}
+
+	if err := k.WorkQueue().Refresh(); err != nil {
+		errs = append(errs, errors.Wrap(err, "failed to list failing connectors"))
+	}
+
 	if k.ctx == nil {
 		ctx, err := k.db.NewContext(context.Background())
 		if err != nil {
@@ -78,9 +116,17 @@ func (k *ConnectorManager) Reconcile() []error {
 		k.ctx = ctx
 	}
 
-	// reconcile assigning connectors in "ready" desired state with "assigning" phase and a valid namespace id
-	k.doReconcile(&errs, "assigning", k.reconcileAssigning,
-		"desired_state = ? AND phase = ? AND connectors.namespace_id IS NOT NULL", dbapi.ConnectorReady, dbapi.ConnectorStatusPhaseAssigning)
+	// reconcile assigning connectors in "ready" desired state with "assigning" phase and a valid namespace id,
+	// or without namespace when they are scheduled to one of the namespaces of their owner.
+	// Stopped connectors are only assigned when they have been moved to another namespace while stopped
+	assigningStates := "(desired_state = ? OR (desired_state = ? AND target_desired_state = ?)) AND phase = ?"
+	if k.connectorsConfig.ConnectorEnableNamespaceScheduling {
+		k.doReconcile(&errs, "assigning", k.reconcileAssigning,
+			assigningStates, dbapi.ConnectorReady, dbapi.ConnectorStopped, dbapi.ConnectorStopped, dbapi.ConnectorStatusPhaseAssigning)
+	} else {
+		k.doReconcile(&errs, "assigning", k.reconcileAssigning,
+			assigningStates+" AND connectors.namespace_id IS NOT NULL", dbapi.ConnectorReady, dbapi.ConnectorStopped, dbapi.ConnectorStopped, dbapi.ConnectorStatusPhaseAssigning)
+	}
 
 	// reconcile unassigned connectors in "unassigned" desired state and "deleted" phase
 	k.doReconcile(&errs, "unassigned", k.reconcileUnassigned,
@@ -95,6 +141,19 @@ func (k *ConnectorManager) Reconcile() []error {
 		"desired_state = ? AND phase IN ?", dbapi.ConnectorDeleted,
 		[]string{string(dbapi.ConnectorStatusPhaseAssigning), string(dbapi.ConnectorStatusPhaseDeleted)})
 
+	// stop connectors in "ready" desired state whose Kafka has been deleted
+	if k.kafkaReferences.Enabled() {
+		k.doReconcile(&errs, "kafka deleted", k.reconcileKafkaDeleted,
+			"desired_state = ? AND EXISTS (?)", dbapi.ConnectorReady, k.kafkaReferences.DeletedConnectorKafka())
+	}
+
+	// start and stop connectors whose schedule is due
+	now := time.Now()
+	k.doReconcile(&errs, "scheduled", k.reconcileScheduled,
+		"desired_state <> ? AND connectors.id IN (?)", dbapi.ConnectorDeleted,
+		k.db.New().Model(&dbapi.ConnectorSchedule{}).Select("connector_id").
+			Where("next_start_at <= ? OR next_stop_at <= ?", now, now))
+
 	// reconcile connector updates for assigned connectors that aren't being deleted...
 	k.doReconcile(&errs, "updated", k.reconcileConnectorUpdate,
 		"version > ? AND phase NOT IN ?", k.lastVersion,
@@ -145,25 +204,71 @@ func GetShardMetadataRevision(connectorShardMetadata map[string]interface{}) (in
 }
 
 func (k *ConnectorManager) reconcileAssigning(ctx context.Context, connector *dbapi.Connector) error {
-	var namespace *dbapi.ConnectorNamespace
-	namespace, err := k.connectorClusterService.FindAvailableNamespace(connector.Owner, connector.OrganisationId, connector.NamespaceId)
+	shardMetadata, err := k.connectorTypesService.GetLatestConnectorShardMetadata(connector.ConnectorTypeId, connector.Channel)
 	if err != nil {
-		return errors.Wrapf(err, "failed to find namespace for connector request %s", connector.ID)
+		return errors.Wrapf(err, "failed to get latest channel version for connector request %s", connector.ID)
+	}
+
+	var namespace *dbapi.ConnectorNamespace
+	var decision dbapi.ConnectorSchedulingDecision
+	if k.connectorsConfig.ConnectorEnableNamespaceScheduling {
+		var scheduled *dbapi.ConnectorSchedulingDecision
+		var schedulingErr error
+		if namespace, scheduled, schedulingErr = k.scheduleNamespace(ctx, connector, shardMetadata); schedulingErr != nil {
+			return schedulingErr
+		}
+		if namespace != nil {
+			decision = *scheduled
+		}
+	} else {
+		var serr *serviceError.ServiceError
+		if namespace, serr = k.connectorClusterService.FindAvailableNamespace(connector.Owner, connector.OrganisationId, connector.NamespaceId); serr != nil {
+			return errors.Wrapf(serr, "failed to find namespace for connector request %s", connector.ID)
+		}
 	}
 	if namespace == nil {
 		// we will try to find a ready namespace again in the next reconcile
 		return nil
 	}
 
-	shardMetadata, err := k.connectorTypesService.GetLatestConnectorShardMetadata(connector.ConnectorTypeId, connector.Channel)
-	if err != nil {
-		return errors.Wrapf(err, "failed to get latest channel version for connector request %s", connector.ID)
+	// the version of the connector is bumped when it's updated
+	versionChanged := false
+	if connector.NamespaceId == nil || *connector.NamespaceId == "" {
+		admitted, err := k.admitScheduledConnector(connector, namespace)
+		if err != nil {
+			return err
+		}
+		if !admitted {
+			// we will try to schedule the connector again in the next reconcile
+			return nil
+		}
+		connector.NamespaceId = &namespace.ID
+		versionChanged = true
+	}
+	if connector.TargetDesiredState != "" {
+		// a moved connector has reached its target namespace
+		if err := k.db.New().Model(&dbapi.Connector{}).Where("id = ?", connector.ID).
+			Update("target_desired_state", "").Error; err != nil {
+			return errors.Wrapf(err, "failed to update target_desired_state for connector %s", connector.ID)
+		}
+		connector.TargetDesiredState = ""
+		versionChanged = true
+	}
+	if versionChanged {
+		if err := k.db.New().Model(&dbapi.Connector{}).Where("id = ?", connector.ID).
+			Select("version").Scan(&connector.Version).Error; err != nil {
+			return errors.Wrapf(err, "failed to get version of connector %s", connector.ID)
+		}
 	}
 
 	var status = dbapi.ConnectorStatus{}
 	status.ID = connector.ID
 	status.NamespaceID = &namespace.ID
 	status.Phase = dbapi.ConnectorStatusPhaseAssigned
+	if k.connectorsConfig.ConnectorEnableNamespaceScheduling {
+		condition, _ := k.schedulingCondition(connector, &decision)
+		status.Conditions = dbapi.ConditionList{condition}
+	}
 	if err = k.connectorService.SaveStatus(ctx, status); err != nil {
 		return errors.Wrapf(err, "failed to update connector status %s with namespace details", status.ID)
 	}
@@ -177,6 +282,7 @@ func (k *ConnectorManager) reconcileAssigning(ctx context.Context, connector *db
 		NamespaceID:              namespace.ID,
 		ConnectorVersion:         connector.Version,
 		ConnectorShardMetadataID: shardMetadata.ID,
+		SchedulingDecision:       decision,
 		Status:                   dbapi.ConnectorDeploymentStatus{},
 	}
 
@@ -187,7 +293,95 @@ func (k *ConnectorManager) reconcileAssigning(ctx context.Context, connector *db
 	return nil
 }
 
+// admitScheduledConnector sets the namespace of a connector to the namespace chosen by the scheduler. The namespace is locked
+// while the connector is admitted, so that the connectors scheduled concurrently by the workers of other shards can't
+// exceed its quota. It returns false if the namespace can't host the connector anymore, or if the connector has been
+// assigned a namespace concurrently, e.g. by a user
+func (k *ConnectorManager) admitScheduledConnector(connector *dbapi.Connector, namespace *dbapi.ConnectorNamespace) (bool, error) {
+	admitted := false
+	err := k.db.New().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").
+			Where("id = ?", namespace.ID).Take(&dbapi.ConnectorNamespace{}).Error; err != nil {
+			return errors.Wrapf(err, "failed to lock namespace %s", namespace.ID)
+		}
+		// the quota is checked again, now that the connectors admitted concurrently have been assigned to the namespace
+		if serr := k.namespaceService.CheckConnectorQuota(namespace.ID, connector.ConnectorTypeId, connector.Channel); serr != nil {
+			if serr.InSufficientQuota() {
+				glog.V(5).Infof("Namespace %s can't host connector %s anymore: %s", namespace.ID, connector.ID, serr.Reason)
+				return nil
+			}
+			return errors.Wrapf(serr, "failed to check quota of namespace %s", namespace.ID)
+		}
+		result := tx.Model(&dbapi.Connector{}).Where("id = ? AND namespace_id IS NULL", connector.ID).
+			Update("namespace_id", namespace.ID)
+		if result.Error != nil {
+			return errors.Wrapf(result.Error, "failed to update namespace_id for connector %s", connector.ID)
+		}
+		if result.RowsAffected == 0 {
+			glog.V(5).Infof("Connector %s was assigned a namespace concurrently", connector.ID)
+			return nil
+		}
+		admitted = true
+		return nil
+	})
+	return admitted, err
+}
+
+// scheduleNamespace chooses the namespace of a connector with the connector scheduler. When no namespace can host
+// the connector yet, the rejections of the candidate namespaces are recorded in the Scheduled condition of the connector
+func (k *ConnectorManager) scheduleNamespace(ctx context.Context, connector *dbapi.Connector,
+	shardMetadata *dbapi.ConnectorShardMetadata) (*dbapi.ConnectorNamespace, *dbapi.ConnectorSchedulingDecision, error) {
+
+	namespace, decision, serr := k.connectorScheduler.Schedule(ctx, connector, shardMetadata)
+	if serr != nil {
+		return nil, nil, errors.Wrapf(serr, "failed to find namespace for connector request %s", connector.ID)
+	}
+	if namespace != nil {
+		return namespace, decision, nil
+	}
+
+	glog.V(5).Infof("No namespace available for connector %s, candidates: %+v", connector.ID, decision.Candidates)
+	condition, changed := k.schedulingCondition(connector, decision)
+	if !changed {
+		// the connector is still waiting for the same reasons
+		return nil, decision, nil
+	}
+	connector.Status.Conditions = append(connector.Status.Conditions.Without(dbapi.ConnectorScheduledCondition), condition)
+	if err := k.connectorService.SaveStatus(ctx, connector.Status); err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to update scheduled condition of connector %s", connector.ID)
+	}
+	return nil, decision, nil
+}
+
+// schedulingCondition returns the Scheduled condition recording a scheduling decision.
+// The transition time of the current condition of the connector is kept when the condition hasn't changed
+func (k *ConnectorManager) schedulingCondition(connector *dbapi.Connector, decision *dbapi.ConnectorSchedulingDecision) (dbapi.Condition, bool) {
+	condition := dbapi.Condition{
+		Type:    dbapi.ConnectorScheduledCondition,
+		Status:  "True",
+		Reason:  "Scheduled",
+		Message: fmt.Sprintf("scheduled to namespace %s, %s", decision.NamespaceID, decision.Reason),
+	}
+	if decision.NamespaceID == "" {
+		condition.Status = "False"
+		condition.Reason = "NoNamespaceAvailable"
+		condition.Message = decision.Rejections()
+	}
+	for _, c := range connector.Status.Conditions {
+		if c.Type == condition.Type && c.Status == condition.Status && c.Reason == condition.Reason && c.Message == condition.Message {
+			condition.LastTransitionTime = c.LastTransitionTime
+			return condition, false
+		}
+	}
+	condition.LastTransitionTime = time.Now().UTC().Format(time.RFC3339)
+	return condition, true
+}
+
 func (k *ConnectorManager) reconcileUnassigned(ctx context.Context, connector *dbapi.Connector) error {
+	if connector.TargetNamespaceId != nil {
+		return k.reconcileMoved(ctx, connector)
+	}
+
 	// set phase to "assigning" and namespace_id to nil
 	connector.Status.Phase = dbapi.ConnectorStatusPhaseAssigning
 	connector.Status.NamespaceID = nil
@@ -204,6 +398,38 @@ func (k *ConnectorManager) reconcileUnassigned(ctx context.Context, connector *d
 	return nil
 }
 
+// reconcileMoved assigns a connector removed from its previous namespace to the namespace it's being moved to
+func (k *ConnectorManager) reconcileMoved(ctx context.Context, connector *dbapi.Connector) error {
+	// set phase to "assigning", namespace_id to the target namespace and restore the desired state the connector had
+	// before being moved. Connectors moved before their desired state was recorded are started.
+	// The target desired state of stopped connectors is kept until they are assigned, to tell them from connectors stopped while assigning
+	connector.Status.Phase = dbapi.ConnectorStatusPhaseAssigning
+	connector.Status.NamespaceID = nil
+	connector.NamespaceId = connector.TargetNamespaceId
+	connector.TargetNamespaceId = nil
+	connector.DesiredState = dbapi.ConnectorReady
+	if connector.TargetDesiredState == dbapi.ConnectorStopped {
+		connector.DesiredState = dbapi.ConnectorStopped
+	} else {
+		connector.TargetDesiredState = ""
+	}
+
+	if err := k.db.New().Model(&dbapi.Connector{}).Where("id = ?", connector.ID).
+		Updates(map[string]interface{}{
+			"namespace_id":         connector.NamespaceId,
+			"target_namespace_id":  nil,
+			"target_desired_state": connector.TargetDesiredState,
+			"desired_state":        connector.DesiredState,
+		}).Error; err != nil {
+		return errors.Wrapf(err, "failed to move connector %s to namespace %s", connector.ID, *connector.NamespaceId)
+	}
+	if err := k.connectorService.SaveStatus(ctx, connector.Status); err != nil {
+		return errors.Wrapf(err, "failed to update phase to assigning for connector %s", connector.ID)
+	}
+
+	return nil
+}
+
 func (k *ConnectorManager) reconcileDeleting(ctx context.Context, connector *dbapi.Connector) error {
 	_, err := k.connectorClusterService.GetDeploymentByConnectorId(ctx, connector.ID)
 	if err != nil {
@@ -232,6 +458,140 @@ func (k *ConnectorManager) reconcileDeleted(ctx context.Context, connector *dbap
 	return nil
 }
 
+// reconcileKafkaDeleted stops a connector whose Kafka has been deleted, with a condition explaining why it was stopped.
+// Connectors in a namespace are stopped like users do, through the state machine of their namespace.
+func (k *ConnectorManager) reconcileKafkaDeleted(ctx context.Context, connector *dbapi.Connector) error {
+	previousPhase := connector.Status.Phase
+	if connector.NamespaceId != nil {
+		namespace, serr := k.namespaceService.Get(ctx, *connector.NamespaceId)
+		if serr != nil {
+			return errors.Wrapf(serr, "failed to get namespace of connector %s", connector.ID)
+		}
+		updated, serr := phase.PerformConnectorOperation(namespace, connector, phase.StopConnector)
+		if serr != nil {
+			// e.g. connectors in a deleting namespace are deleted with it
+			glog.V(5).Infof("Skipped stopping connector %s whose kafka %s has been deleted: %s",
+				connector.ID, connector.Kafka.KafkaID, serr.Reason)
+			return nil
+		}
+		if !updated {
+			return nil
+		}
+	} else {
+		// connectors without a namespace have no deployment to stop
+		connector.DesiredState = dbapi.ConnectorStopped
+	}
+
+	if err := k.db.New().Model(&dbapi.Connector{}).Where("id = ?", connector.ID).
+		Update("desired_state", connector.DesiredState).Error; err != nil {
+		return errors.Wrapf(err, "failed to stop connector %s", connector.ID)
+	}
+
+	// connectors that haven't been assigned to a namespace yet have no deployment to stop
+	if previousPhase == dbapi.ConnectorStatusPhaseAssigning {
+		connector.Status.Phase = dbapi.ConnectorStatusPhaseStopped
+	}
+	connector.Status.Conditions = append(connector.Status.Conditions.Without(dbapi.ConnectorKafkaAvailableCondition), dbapi.Condition{
+		Type:               dbapi.ConnectorKafkaAvailableCondition,
+		Status:             "False",
+		Reason:             "KafkaDeleted",
+		Message:            fmt.Sprintf("kafka %s has been deleted, the connector was stopped", connector.Kafka.KafkaID),
+		LastTransitionTime: time.Now().UTC().Format(time.RFC3339),
+	})
+	if err := k.connectorService.SaveStatus(ctx, connector.Status); err != nil {
+		return errors.Wrapf(err, "failed to update status of stopped connector %s", connector.ID)
+	}
+
+	glog.Infof("Stopped connector %s, its kafka %s has been deleted", connector.ID, connector.Kafka.KafkaID)
+	return nil
+}
+
+// reconcileScheduled performs the most recent due operation of a connector schedule, and records its execution
+func (k *ConnectorManager) reconcileScheduled(ctx context.Context, connector *dbapi.Connector) error {
+	schedule, serr := k.schedulesService.Get(ctx, connector.ID)
+	if serr != nil {
+		return errors.Wrapf(serr, "failed to get schedule of connector %s", connector.ID)
+	}
+
+	now := time.Now()
+	operation, scheduledAt, due := schedule.DueOperation(now)
+	if !due {
+		return nil
+	}
+
+	execution := dbapi.ConnectorScheduleExecution{
+		Operation:   operation,
+		ScheduledAt: scheduledAt,
+		ExecutedAt:  now,
+	}
+	var err error
+	if execution.Result, execution.Message, err = k.performScheduledOperation(ctx, connector, operation); err != nil {
+		return err
+	}
+
+	if err := schedule.UpdateTransitions(now); err != nil {
+		return errors.Wrapf(err, "failed to update schedule of connector %s", connector.ID)
+	}
+	if serr := k.schedulesService.SaveExecution(ctx, schedule, execution); serr != nil {
+		return errors.Wrapf(serr, "failed to save schedule execution of connector %s", connector.ID)
+	}
+
+	glog.V(5).Infof("Scheduled %s of connector %s %s %s", operation, connector.ID, execution.Result, execution.Message)
+	return nil
+}
+
+// performScheduledOperation starts or stops a connector like users do, through the state machine of its namespace.
+// Operations that can't be performed are reported in the result, and only failures to save the connector are returned as errors.
+func (k *ConnectorManager) performScheduledOperation(ctx context.Context, connector *dbapi.Connector,
+	operation dbapi.ConnectorScheduleOperation) (dbapi.ConnectorScheduleResult, string, error) {
+
+	if connector.NamespaceId == nil {
+		return dbapi.ConnectorScheduleSkipped, "connector is not assigned to a namespace", nil
+	}
+	namespace, serr := k.namespaceService.Get(ctx, *connector.NamespaceId)
+	if serr != nil {
+		return dbapi.ConnectorScheduleFailed, serr.Reason, nil
+	}
+
+	connectorOperation := phase.StopConnector
+	if operation == dbapi.ConnectorScheduleStart {
+		connectorOperation = phase.RestartConnector
+		if serr := k.kafkaReferences.ValidateConnectorKafka(ctx, connector); serr != nil {
+			return dbapi.ConnectorScheduleFailed, serr.Reason, nil
+		}
+	}
+
+	previousPhase := connector.Status.Phase
+	updated, serr := phase.PerformConnectorOperation(namespace, connector, connectorOperation)
+	if serr != nil {
+		return dbapi.ConnectorScheduleFailed, serr.Reason, nil
+	}
+	if !updated {
+		return dbapi.ConnectorScheduleSkipped, fmt.Sprintf("connector desired state is already %s", connector.DesiredState), nil
+	}
+
+	if err := k.db.New().Model(&dbapi.Connector{}).Where("id = ?", connector.ID).
+		Updates(map[string]interface{}{
+			"desired_state":          connector.DesiredState,
+			"kafka_bootstrap_server": connector.Kafka.BootstrapServer,
+		}).Error; err != nil {
+		return "", "", errors.Wrapf(err, "failed to update desired state of connector %s", connector.ID)
+	}
+
+	// connectors that haven't been deployed yet are still being assigned
+	if previousPhase == dbapi.ConnectorStatusPhaseAssigning {
+		connector.Status.Phase = previousPhase
+	}
+	if operation == dbapi.ConnectorScheduleStart {
+		connector.Status.Conditions = connector.Status.Conditions.Without(dbapi.ConnectorKafkaAvailableCondition)
+	}
+	if err := k.connectorService.SaveStatus(ctx, connector.Status); err != nil {
+		return "", "", errors.Wrapf(err, "failed to update status of connector %s", connector.ID)
+	}
+
+	return dbapi.ConnectorScheduleSucceeded, "", nil
+}
+
 func (k *ConnectorManager) reconcileConnectorUpdate(ctx context.Context, connector *dbapi.Connector) (err error) {
 
 	// Get the deployment for the connector...
@@ -266,16 +626,31 @@ func (k *ConnectorManager) doReconcile(errs *[]error, reconcilePhase string, rec
 	var count int64
 	var serviceErrs []error
 	glog.V(5).Infof("Reconciling %s connectors...", reconcilePhase)
+	// only the connectors of the shards owned by the worker are listed
+	if shardCondition, shardArgs := k.ShardCondition("connectors.id"); shardCondition != "" {
+		query = fmt.Sprintf("(%s) AND %s", query, shardCondition)
+		args = append(args, shardArgs...)
+	}
 	if serviceErrs = k.connectorService.ForEach(func(connector *dbapi.Connector) *serviceError.ServiceError {
-		return InDBTransaction(k.ctx, func(ctx context.Context) error {
-			if err := reconcileFunc(ctx, connector); err != nil {
-				glog.Errorf("Failed to reconcile %s connector %s in phase %s: %v", reconcilePhase,
-					connector.ID, connector.Status.Phase, err)
-				return err
+		// connectors failing to reconcile are backed off, and quarantined once they failed too many times.
+		// Failures are tracked per phase, so that a connector failing to be assigned can still be deleted
+		if err := k.WorkQueue().Process(connectorWorkItemID(connector.ID, reconcilePhase), func() error {
+			if svcErr := InDBTransaction(k.ctx, func(ctx context.Context) error {
+				if err := reconcileFunc(ctx, connector); err != nil {
+					glog.Errorf("Failed to reconcile %s connector %s in phase %s: %v", reconcilePhase,
+						connector.ID, connector.Status.Phase, err)
+					return err
+				}
+				count++
+				return nil
+			}); svcErr != nil {
+				return svcErr
 			}
-			count++
 			return nil
-		})
+		}); err != nil {
+			return serviceError.ToServiceError(err)
+		}
+		return nil
 	}, query, args...); len(serviceErrs) > 0 {
 		*errs = append(*errs, serviceErrs...)
 	}
@@ -286,6 +661,11 @@ func (k *ConnectorManager) doReconcile(errs *[]error, reconcilePhase string, rec
 	}
 }
 
+// connectorWorkItemID returns the id of the work item reconciling a connector in a phase, e.g. "<connector id>:kafka-deleted"
+func connectorWorkItemID(connectorID string, reconcilePhase string) string {
+	return connectorID + ":" + strings.ReplaceAll(reconcilePhase, " ", "-")
+}
+
 func InDBTransaction(ctx context.Context, f func(ctx context.Context) error) (rerr *serviceError.ServiceError) {
 	err := db.Begin(ctx)
 	if err != nil {
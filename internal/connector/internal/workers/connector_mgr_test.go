@@ -0,0 +1,333 @@
+package workers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/scheduler"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func TestConnectorManager_reconcileKafkaDeleted(t *testing.T) {
+	namespaceId := "namespace-id"
+
+	tests := []struct {
+		name              string
+		namespaceId       *string
+		namespacePhase    dbapi.ConnectorNamespacePhaseEnum
+		phase             dbapi.ConnectorStatusPhase
+		wantStopped       bool
+		wantPhase         dbapi.ConnectorStatusPhase
+		wantNamespaceGets int
+	}{
+		{
+			name:              "should stop a deployed connector through its namespace",
+			namespaceId:       &namespaceId,
+			namespacePhase:    dbapi.ConnectorNamespacePhaseReady,
+			phase:             dbapi.ConnectorStatusPhaseReady,
+			wantStopped:       true,
+			wantPhase:         dbapi.ConnectorStatusPhaseAssigned,
+			wantNamespaceGets: 1,
+		},
+		{
+			name:              "should stop a connector being assigned to its namespace",
+			namespaceId:       &namespaceId,
+			namespacePhase:    dbapi.ConnectorNamespacePhaseDisconnected,
+			phase:             dbapi.ConnectorStatusPhaseAssigning,
+			wantStopped:       true,
+			wantPhase:         dbapi.ConnectorStatusPhaseStopped,
+			wantNamespaceGets: 1,
+		},
+		{
+			name:        "should stop a connector without a namespace",
+			phase:       dbapi.ConnectorStatusPhaseAssigning,
+			wantStopped: true,
+			wantPhase:   dbapi.ConnectorStatusPhaseStopped,
+		},
+		{
+			name:              "should leave a connector in a deleting namespace",
+			namespaceId:       &namespaceId,
+			namespacePhase:    dbapi.ConnectorNamespacePhaseDeleting,
+			phase:             dbapi.ConnectorStatusPhaseReady,
+			wantPhase:         dbapi.ConnectorStatusPhaseReady,
+			wantNamespaceGets: 1,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mocket.Catcher.Reset()
+			update := mocket.Catcher.NewMock().WithQuery(`UPDATE "connectors" SET "desired_state"`).WithRowsNum(1)
+
+			connectorService := &services.ConnectorsServiceMock{
+				SaveStatusFunc: func(ctx context.Context, resource dbapi.ConnectorStatus) *errors.ServiceError {
+					return nil
+				},
+			}
+			namespaceService := &services.ConnectorNamespaceServiceMock{
+				GetFunc: func(ctx context.Context, namespaceID string) (*dbapi.ConnectorNamespace, *errors.ServiceError) {
+					namespace := &dbapi.ConnectorNamespace{Status: dbapi.ConnectorNamespaceStatus{Phase: tt.namespacePhase}}
+					namespace.ID = namespaceID
+					return namespace, nil
+				},
+			}
+			k := &ConnectorManager{
+				connectorService: connectorService,
+				namespaceService: namespaceService,
+				db:               db.NewMockConnectionFactory(nil),
+			}
+
+			connector := &dbapi.Connector{
+				Model:        db.Model{ID: "connector-id"},
+				NamespaceId:  tt.namespaceId,
+				DesiredState: dbapi.ConnectorReady,
+				Kafka:        dbapi.KafkaConnectionSettings{KafkaID: "kafka-id"},
+				Status: dbapi.ConnectorStatus{
+					Model: db.Model{ID: "connector-id"},
+					Phase: tt.phase,
+				},
+			}
+			g.Expect(k.reconcileKafkaDeleted(context.Background(), connector)).To(gomega.Succeed())
+			g.Expect(namespaceService.GetCalls()).To(gomega.HaveLen(tt.wantNamespaceGets))
+			g.Expect(connector.Status.Phase).To(gomega.Equal(tt.wantPhase))
+			g.Expect(update.Triggered).To(gomega.Equal(tt.wantStopped))
+			if !tt.wantStopped {
+				g.Expect(connectorService.SaveStatusCalls()).To(gomega.BeEmpty())
+				return
+			}
+
+			g.Expect(connector.DesiredState).To(gomega.Equal(dbapi.ConnectorStopped))
+			g.Expect(connectorService.SaveStatusCalls()).To(gomega.HaveLen(1))
+			status := connectorService.SaveStatusCalls()[0].Resource
+			g.Expect(status.Phase).To(gomega.Equal(tt.wantPhase))
+			g.Expect(status.Conditions).To(gomega.ContainElement(gomega.HaveField("Reason", "KafkaDeleted")))
+		})
+	}
+}
+
+func TestConnectorManager_scheduleNamespace(t *testing.T) {
+	rejected := &dbapi.ConnectorSchedulingDecision{
+		Candidates: []dbapi.ConnectorSchedulingCandidate{
+			{NamespaceID: "namespace-1", Rejection: "cluster is not ready"},
+			{NamespaceID: "namespace-2", Rejection: "insufficient quota"},
+		},
+	}
+	rejectedCondition := dbapi.Condition{
+		Type:               dbapi.ConnectorScheduledCondition,
+		Status:             "False",
+		Reason:             "NoNamespaceAvailable",
+		Message:            "namespace namespace-1: cluster is not ready; namespace namespace-2: insufficient quota",
+		LastTransitionTime: "2023-01-01T00:00:00Z",
+	}
+
+	tests := []struct {
+		name          string
+		namespace     *dbapi.ConnectorNamespace
+		decision      *dbapi.ConnectorSchedulingDecision
+		conditions    dbapi.ConditionList
+		wantNamespace bool
+		wantSaved     bool
+		wantMessage   string
+	}{
+		{
+			name:          "should return the namespace chosen by the scheduler",
+			namespace:     &dbapi.ConnectorNamespace{Model: db.Model{ID: "namespace-1"}},
+			decision:      &dbapi.ConnectorSchedulingDecision{NamespaceID: "namespace-1"},
+			wantNamespace: true,
+		},
+		{
+			name:        "should record why no namespace can host the connector",
+			decision:    rejected,
+			wantSaved:   true,
+			wantMessage: rejectedCondition.Message,
+		},
+		{
+			name:        "should record that the connector owner has no namespace",
+			decision:    &dbapi.ConnectorSchedulingDecision{},
+			wantSaved:   true,
+			wantMessage: "no namespace is available to the connector owner or organisation",
+		},
+		{
+			name:       "should not record the same rejections again",
+			decision:   rejected,
+			conditions: dbapi.ConditionList{rejectedCondition},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+
+			connectorService := &services.ConnectorsServiceMock{
+				SaveStatusFunc: func(ctx context.Context, resource dbapi.ConnectorStatus) *errors.ServiceError {
+					return nil
+				},
+			}
+			k := &ConnectorManager{
+				connectorService: connectorService,
+				connectorScheduler: &scheduler.ConnectorSchedulerMock{
+					ScheduleFunc: func(ctx context.Context, connector *dbapi.Connector, shardMetadata *dbapi.ConnectorShardMetadata) (*dbapi.ConnectorNamespace, *dbapi.ConnectorSchedulingDecision, *errors.ServiceError) {
+						return tt.namespace, tt.decision, nil
+					},
+				},
+			}
+
+			connector := &dbapi.Connector{
+				Model: db.Model{ID: "connector-id"},
+				Status: dbapi.ConnectorStatus{
+					Model:      db.Model{ID: "connector-id"},
+					Phase:      dbapi.ConnectorStatusPhaseAssigning,
+					Conditions: tt.conditions,
+				},
+			}
+			namespace, _, err := k.scheduleNamespace(context.Background(), connector, &dbapi.ConnectorShardMetadata{})
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			g.Expect(namespace != nil).To(gomega.Equal(tt.wantNamespace))
+			if !tt.wantSaved {
+				g.Expect(connectorService.SaveStatusCalls()).To(gomega.BeEmpty())
+				return
+			}
+
+			g.Expect(connectorService.SaveStatusCalls()).To(gomega.HaveLen(1))
+			status := connectorService.SaveStatusCalls()[0].Resource
+			g.Expect(status.Phase).To(gomega.Equal(dbapi.ConnectorStatusPhaseAssigning))
+			g.Expect(status.Conditions).To(gomega.ConsistOf(gomega.And(
+				gomega.HaveField("Status", "False"),
+				gomega.HaveField("Reason", "NoNamespaceAvailable"),
+				gomega.HaveField("Message", tt.wantMessage),
+			)))
+		})
+	}
+}
+
+func TestConnectorManager_reconcileMoved(t *testing.T) {
+	targetNamespaceId := "target-namespace-id"
+
+	tests := []struct {
+		name                   string
+		targetDesiredState     dbapi.ConnectorDesiredState
+		wantDesiredState       dbapi.ConnectorDesiredState
+		wantTargetDesiredState dbapi.ConnectorDesiredState
+	}{
+		{
+			name:             "should start a connector moved while ready",
+			wantDesiredState: dbapi.ConnectorReady,
+		},
+		{
+			name:               "should restore the ready desired state of a moved connector",
+			targetDesiredState: dbapi.ConnectorReady,
+			wantDesiredState:   dbapi.ConnectorReady,
+		},
+		{
+			name:                   "should keep the target desired state of a connector moved while stopped until it's assigned",
+			targetDesiredState:     dbapi.ConnectorStopped,
+			wantDesiredState:       dbapi.ConnectorStopped,
+			wantTargetDesiredState: dbapi.ConnectorStopped,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mocket.Catcher.Reset()
+			update := mocket.Catcher.NewMock().WithQuery(`UPDATE "connectors" SET`).WithRowsNum(1)
+
+			connectorService := &services.ConnectorsServiceMock{
+				SaveStatusFunc: func(ctx context.Context, resource dbapi.ConnectorStatus) *errors.ServiceError {
+					return nil
+				},
+			}
+			k := &ConnectorManager{
+				connectorService: connectorService,
+				db:               db.NewMockConnectionFactory(nil),
+			}
+
+			connector := &dbapi.Connector{
+				Model:              db.Model{ID: "connector-id"},
+				TargetNamespaceId:  &targetNamespaceId,
+				TargetDesiredState: tt.targetDesiredState,
+				DesiredState:       dbapi.ConnectorUnassigned,
+				Status: dbapi.ConnectorStatus{
+					Model: db.Model{ID: "connector-id"},
+					Phase: dbapi.ConnectorStatusPhaseDeleted,
+				},
+			}
+			g.Expect(k.reconcileUnassigned(context.Background(), connector)).To(gomega.Succeed())
+			g.Expect(update.Triggered).To(gomega.BeTrue())
+			g.Expect(connector.NamespaceId).To(gomega.Equal(&targetNamespaceId))
+			g.Expect(connector.TargetNamespaceId).To(gomega.BeNil())
+			g.Expect(connector.DesiredState).To(gomega.Equal(tt.wantDesiredState))
+			g.Expect(connector.TargetDesiredState).To(gomega.Equal(tt.wantTargetDesiredState))
+			g.Expect(connectorService.SaveStatusCalls()).To(gomega.HaveLen(1))
+			g.Expect(connectorService.SaveStatusCalls()[0].Resource.Phase).To(gomega.Equal(dbapi.ConnectorStatusPhaseAssigning))
+		})
+	}
+}
+
+func TestConnectorManager_admitScheduledConnector(t *testing.T) {
+	tests := []struct {
+		name         string
+		quotaErr     *errors.ServiceError
+		updatedRows  int
+		wantAdmitted bool
+		wantUpdated  bool
+		wantErr      bool
+	}{
+		{
+			name:         "should assign the connector to a namespace with enough quota",
+			updatedRows:  1,
+			wantAdmitted: true,
+			wantUpdated:  true,
+		},
+		{
+			name:     "should not assign the connector when the quota of the namespace has been used concurrently",
+			quotaErr: errors.InsufficientQuotaError("namespace quota exceeded"),
+		},
+		{
+			name:     "should fail when the quota of the namespace can't be checked",
+			quotaErr: errors.GeneralError("failed to get namespace quota"),
+			wantErr:  true,
+		},
+		{
+			name:        "should not assign the connector when it has been assigned a namespace concurrently",
+			wantUpdated: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mocket.Catcher.Reset()
+			lock := mocket.Catcher.NewMock().WithQuery(`SELECT "id" FROM "connector_namespaces" WHERE id = $1`).
+				WithReply([]map[string]interface{}{{"id": "namespace-id"}})
+			update := mocket.Catcher.NewMock().WithQuery(`UPDATE "connectors" SET "namespace_id"=$1`).WithRowsNum(int64(tt.updatedRows))
+
+			k := &ConnectorManager{
+				namespaceService: &services.ConnectorNamespaceServiceMock{
+					CheckConnectorQuotaFunc: func(namespaceId string, connectorTypeId string, channel string) *errors.ServiceError {
+						return tt.quotaErr
+					},
+				},
+				db: db.NewMockConnectionFactory(nil),
+			}
+
+			connector := &dbapi.Connector{Model: db.Model{ID: "connector-id"}}
+			namespace := &dbapi.ConnectorNamespace{Model: db.Model{ID: "namespace-id"}}
+			admitted, err := k.admitScheduledConnector(connector, namespace)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(admitted).To(gomega.Equal(tt.wantAdmitted))
+			g.Expect(lock.Triggered).To(gomega.BeTrue())
+			g.Expect(update.Triggered).To(gomega.Equal(tt.wantUpdated))
+		})
+	}
+}
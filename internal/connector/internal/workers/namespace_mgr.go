@@ -15,6 +15,7 @@ var _ workers.Worker = &NamespaceManager{}
 type NamespaceManager struct {
 	workers.BaseWorker
 	namespaceService services.ConnectorNamespaceService
+	eventsService    services.ConnectorNamespaceEventsService
 	db               *db.ConnectionFactory
 	ctx              context.Context
 }
@@ -27,8 +28,8 @@ func (m *NamespaceManager) Stop() {
 	m.StopWorker(m)
 }
 
-func NewNamespaceManager(namespaceService services.ConnectorNamespaceService, db *db.ConnectionFactory,
-	reconciler workers.Reconciler) *NamespaceManager {
+func NewNamespaceManager(namespaceService services.ConnectorNamespaceService, eventsService services.ConnectorNamespaceEventsService,
+	db *db.ConnectionFactory, reconciler workers.Reconciler) *NamespaceManager {
 	return &NamespaceManager{
 		BaseWorker: workers.BaseWorker{
 			Id:         uuid.New().String(),
@@ -36,6 +37,7 @@ func NewNamespaceManager(namespaceService services.ConnectorNamespaceService, db
 			Reconciler: reconciler,
 		},
 		namespaceService: namespaceService,
+		eventsService:    eventsService,
 		db:               db,
 	}
 }
@@ -51,6 +53,12 @@ func (m *NamespaceManager) Reconcile() []error {
 		m.ctx = ctx
 	}
 
+	// emit expiry warnings of expiring namespaces
+	m.doReconcile(&errs, "expiring", m.namespaceService.ReconcileExpiringNamespaces)
+
+	// post the events of namespaces that failed to be posted to the webhook
+	m.doReconcile(&errs, "undelivered events of", m.eventsService.ReconcileUndeliveredEvents)
+
 	// reconcile expired namespaces
 	m.doReconcile(&errs, "expired", m.namespaceService.ReconcileExpiredNamespaces)
 
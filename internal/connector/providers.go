@@ -9,6 +9,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/routes"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/authz"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/scheduler"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/vault"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/workers"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
@@ -24,6 +25,7 @@ func ConfigProviders(kafkaEnabled bool) di.Option {
 	result := di.Options(
 		di.Provide(config.NewConnectorsConfig, di.As(new(environments2.ConfigModule))),
 		di.Provide(config.NewConnectorsQuotaConfig, di.As(new(environments2.ConfigModule))),
+		di.Provide(config.NewConnectorAgentConfig, di.As(new(environments2.ConfigModule))),
 		di.Provide(environments2.Func(serviceProviders)),
 		di.Provide(migrations.New),
 		di.Provide(cmdvault.NewVaultCommand),
@@ -42,6 +44,8 @@ func ConfigProviders(kafkaEnabled bool) di.Option {
 			result,
 			di.Provide(environments2.Func(serviceProvidersNoKafka)),
 		)
+	} else {
+		result = di.Options(result, di.Provide(environments2.Func(serviceProvidersKafka)))
 	}
 
 	return result
@@ -53,11 +57,17 @@ func serviceProviders() di.Option {
 		di.Provide(services.NewConnectorTypesService, di.As(new(services.ConnectorTypesService))),
 		di.Provide(services.NewConnectorClusterService, di.As(new(services.ConnectorClusterService)), di.As(new(auth.AuthAgentService))),
 		di.Provide(services.NewConnectorNamespaceService, di.As(new(services.ConnectorNamespaceService))),
+		di.Provide(services.NewConnectorNamespaceEventsService, di.As(new(services.ConnectorNamespaceEventsService))),
+		di.Provide(services.NewConnectorLogsService, di.As(new(services.ConnectorLogsService))),
+		di.Provide(services.NewConnectorTemplatesService, di.As(new(services.ConnectorTemplatesService))),
+		di.Provide(services.NewConnectorSchedulesService, di.As(new(services.ConnectorSchedulesService))),
+		di.Provide(scheduler.NewConnectorScheduler, di.As(new(scheduler.ConnectorScheduler))),
 		di.Provide(authz.NewAuthZService, di.As(new(authz.AuthZService))),
 		di.Provide(handlers.NewConnectorNamespaceHandler),
 		di.Provide(handlers.NewConnectorAdminHandler),
 		di.Provide(handlers.NewConnectorTypesHandler),
 		di.Provide(handlers.NewConnectorsHandler),
+		di.Provide(handlers.NewConnectorTemplatesHandler),
 		di.Provide(handlers.NewConnectorClusterHandler),
 		di.Provide(routes.NewRouteLoader),
 		di.Provide(workers.NewConnectorTypeManager, di.As(new(coreWorkers.Worker))),
@@ -71,5 +81,13 @@ func serviceProviders() di.Option {
 func serviceProvidersNoKafka() di.Option {
 	return di.Options(
 		di.Provide(handlers.NewAuthenticationBuilder),
+		di.Provide(services.NewDisabledKafkaReferencesService, di.As(new(services.KafkaReferencesService))),
+	)
+}
+
+// serviceProvidersKafka provides the services that depend on the Kafka service running in the same fleet manager
+func serviceProvidersKafka() di.Option {
+	return di.Options(
+		di.Provide(services.NewKafkaReferencesService, di.As(new(services.KafkaReferencesService))),
 	)
 }
@@ -2368,6 +2368,257 @@ Feature: connector agent API
     }
     """
 
+  Scenario: Bobby moves a stopped connector to another namespace
+    Given I am logged in as "Bobby"
+
+    #---------------------------------------------------------------------------------------------
+    # Create a target cluster with a second namespace, and connect it using the Shard user
+    # --------------------------------------------------------------------------------------------
+    When I POST path "/v1/kafka_connector_clusters" with json body:
+      """
+      {}
+      """
+    Then the response code should be 202
+    Given I store the ".id" selection from the response as ${connector_cluster_id}
+
+    When I GET path "/v1/kafka_connector_namespaces/?search=cluster_id=${connector_cluster_id}"
+    Then the response code should be 200
+    Given I store the ".items[0].id" selection from the response as ${connector_namespace_id}
+
+    When I POST path "/v1/kafka_connector_namespaces/" with json body:
+      """
+      {
+        "name": "target_namespace",
+        "cluster_id": "${connector_cluster_id}",
+        "kind": "organisation",
+        "annotations": { "cos.bf2.org/profile": "default-profile" }
+      }
+      """
+    Then the response code should be 201
+    Given I store the ".id" selection from the response as ${target_namespace_id}
+
+    When I GET path "/v1/kafka_connector_clusters/${connector_cluster_id}/addon_parameters"
+    Then the response code should be 200
+    And get and store access token using the addon parameter response as ${shard_token} and clientID as ${clientID}
+    And I remember keycloak client for cleanup with clientID: ${clientID}
+
+    Given I am logged in as "Shard"
+    Given I set the "Authorization" header to "Bearer ${shard_token}"
+    When I PUT path "/v1/agent/kafka_connector_clusters/${connector_cluster_id}/status" with json body:
+      """
+      {
+        "phase":"ready",
+        "version": "0.0.1",
+        "conditions": [{
+          "type": "Ready",
+          "status": "True",
+          "lastTransitionTime": "2018-01-01T00:00:00Z"
+        }],
+        "namespaces": [{
+          "id": "${connector_namespace_id}",
+          "phase": "ready",
+          "version": "0.0.1",
+          "connectors_deployed": 0,
+          "conditions": [{
+            "type": "Ready",
+            "status": "True",
+            "lastTransitionTime": "2018-01-01T00:00:00Z"
+          }]
+        }, {
+          "id": "${target_namespace_id}",
+          "phase": "ready",
+          "version": "0.0.1",
+          "connectors_deployed": 0,
+          "conditions": [{
+            "type": "Ready",
+            "status": "True",
+            "lastTransitionTime": "2018-01-01T00:00:00Z"
+          }]
+        }],
+        "operators": [{
+          "id":"camelk",
+          "version": "1.0",
+          "namespace": "openshift-mcs-camelk-1.0",
+          "status": "ready"
+        }]
+      }
+      """
+    Then the response code should be 204
+
+    #---------------------------------------------------------------------------------------------
+    # Create a connector, and stop it once deployed
+    # --------------------------------------------------------------------------------------------
+    Given I am logged in as "Bobby"
+    When I POST path "/v1/kafka_connectors?async=true" with json body:
+      """
+      {
+        "kind": "Connector",
+        "name": "example 1",
+        "namespace_id": "${connector_namespace_id}",
+        "channel":"stable",
+        "connector_type_id": "log_sink_0.1",
+        "kafka": {
+          "id": "mykafka",
+          "url": "kafka.hostname"
+        },
+        "service_account": {
+          "client_id": "myclient",
+          "client_secret": "test"
+        },
+        "connector": {
+          "log_multi_line": true,
+          "kafka_topic":"test",
+          "processors":[]
+        }
+      }
+      """
+    Then the response code should be 202
+    Given I store the ".id" selection from the response as ${connector_id}
+
+    Given I am logged in as "Shard"
+    Given I set the "Authorization" header to "Bearer ${shard_token}"
+    Given I wait up to "10" seconds for a GET on path "/v1/agent/kafka_connector_clusters/${connector_cluster_id}/deployments" response ".total" selection to match "1"
+    When I GET path "/v1/agent/kafka_connector_clusters/${connector_cluster_id}/deployments"
+    Then the ".total" selection from the response should match "1"
+    Given I store the ".items[0].id" selection from the response as ${connector_deployment_id}
+    When I PUT path "/v1/agent/kafka_connector_clusters/${connector_cluster_id}/deployments/${connector_deployment_id}/status" with json body:
+      """
+      {
+        "phase":"ready",
+        "resource_version": 45
+      }
+      """
+    Then the response code should be 204
+
+    Given I am logged in as "Bobby"
+    Given I set the "Content-Type" header to "application/merge-patch+json"
+    When I PATCH path "/v1/kafka_connectors/${connector_id}" with json body:
+      """
+      {
+        "desired_state": "stopped"
+      }
+      """
+    Then the response code should be 202
+
+    Given I am logged in as "Shard"
+    Given I set the "Authorization" header to "Bearer ${shard_token}"
+    Given I wait up to "10" seconds for a GET on path "/v1/agent/kafka_connector_clusters/${connector_cluster_id}/deployments/${connector_deployment_id}" response ".spec.desired_state" selection to match "stopped"
+    When I PUT path "/v1/agent/kafka_connector_clusters/${connector_cluster_id}/deployments/${connector_deployment_id}/status" with json body:
+      """
+      {
+        "phase":"stopped",
+        "resource_version": 46
+      }
+      """
+    Then the response code should be 204
+
+    #---------------------------------------------------------------------------------------------
+    # Move the stopped connector, the agent removes its deployment from the source namespace
+    # --------------------------------------------------------------------------------------------
+    Given I am logged in as "Bobby"
+    Given I set the "Content-Type" header to "application/json"
+    When I POST path "/v1/kafka_connectors/${connector_id}/move" with json body:
+      """
+      {
+        "namespace_id": "${target_namespace_id}"
+      }
+      """
+    Then the response code should be 202
+    And the ".desired_state" selection from the response should match "unassigned"
+
+    # moving the connector again is rejected while its deployment is being removed
+    When I POST path "/v1/kafka_connectors/${connector_id}/move" with json body:
+      """
+      {
+        "namespace_id": "${target_namespace_id}"
+      }
+      """
+    Then the response code should be 409
+
+    Given I am logged in as "Shard"
+    Given I set the "Authorization" header to "Bearer ${shard_token}"
+    Given I wait up to "10" seconds for a GET on path "/v1/agent/kafka_connector_clusters/${connector_cluster_id}/deployments/${connector_deployment_id}" response ".spec.desired_state" selection to match "unassigned"
+    When I PUT path "/v1/agent/kafka_connector_clusters/${connector_cluster_id}/deployments/${connector_deployment_id}/status" with json body:
+      """
+      {
+        "phase":"deleted",
+        "resource_version": 47
+      }
+      """
+    Then the response code should be 204
+
+    #---------------------------------------------------------------------------------------------
+    # The connector is deployed to the target namespace, still stopped
+    # --------------------------------------------------------------------------------------------
+    Given I am logged in as "Bobby"
+    When I wait up to "10" seconds for a GET on path "/v1/kafka_connectors/${connector_id}" response ".namespace_id" selection to match "${target_namespace_id}"
+    And I GET path "/v1/kafka_connectors/${connector_id}"
+    Then the response code should be 200
+    And the ".namespace_id" selection from the response should match "${target_namespace_id}"
+    And the ".desired_state" selection from the response should match "stopped"
+
+    Given I am logged in as "Shard"
+    Given I set the "Authorization" header to "Bearer ${shard_token}"
+    Given I wait up to "10" seconds for a GET on path "/v1/agent/kafka_connector_clusters/${connector_cluster_id}/deployments" response ".items[0].spec.namespace_id" selection to match "${target_namespace_id}"
+    When I GET path "/v1/agent/kafka_connector_clusters/${connector_cluster_id}/deployments"
+    Then the ".total" selection from the response should match "1"
+    And the ".items[0].spec.namespace_id" selection from the response should match "${target_namespace_id}"
+    And the ".items[0].spec.desired_state" selection from the response should match "stopped"
+    Given I store the ".items[0].id" selection from the response as ${moved_deployment_id}
+
+    #---------------------------------------------------------------------------------------------
+    # Cleanup the connector and the cluster
+    # --------------------------------------------------------------------------------------------
+    Given I am logged in as "Bobby"
+    When I DELETE path "/v1/kafka_connectors/${connector_id}"
+    Then the response code should be 204
+
+    Given I am logged in as "Shard"
+    Given I set the "Authorization" header to "Bearer ${shard_token}"
+    Given I wait up to "10" seconds for a GET on path "/v1/agent/kafka_connector_clusters/${connector_cluster_id}/deployments/${moved_deployment_id}" response ".spec.desired_state" selection to match "deleted"
+    When I PUT path "/v1/agent/kafka_connector_clusters/${connector_cluster_id}/deployments/${moved_deployment_id}/status" with json body:
+      """
+      {
+        "phase":"deleted",
+        "resource_version": 48
+      }
+      """
+    Then the response code should be 204
+
+    Given I am logged in as "Bobby"
+    And I wait up to "10" seconds for a GET on path "/v1/kafka_connectors/${connector_id}" response code to match "410"
+    When I DELETE path "/v1/kafka_connector_clusters/${connector_cluster_id}"
+    Then the response code should be 204
+    And I wait up to "10" seconds for a GET on path "/v1/kafka_connector_namespaces/${connector_namespace_id}" response ".status.state" selection to match "deleting"
+    And I wait up to "10" seconds for a GET on path "/v1/kafka_connector_namespaces/${target_namespace_id}" response ".status.state" selection to match "deleting"
+
+    Given I am logged in as "Shard"
+    Given I set the "Authorization" header to "Bearer ${shard_token}"
+    When I PUT path "/v1/agent/kafka_connector_clusters/${connector_cluster_id}/namespaces/${connector_namespace_id}/status" with json body:
+      """
+      {
+        "id": "${connector_namespace_id}",
+        "phase": "deleted",
+        "version": "0.0.2"
+      }
+      """
+    Then the response code should be 204
+    When I PUT path "/v1/agent/kafka_connector_clusters/${connector_cluster_id}/namespaces/${target_namespace_id}/status" with json body:
+      """
+      {
+        "id": "${target_namespace_id}",
+        "phase": "deleted",
+        "version": "0.0.2"
+      }
+      """
+    Then the response code should be 204
+
+    Given I am logged in as "Bobby"
+    Given I wait up to "10" seconds for a GET on path "/v1/kafka_connector_clusters/${connector_cluster_id}" response code to match "410"
+    When I GET path "/v1/kafka_connector_clusters/${connector_cluster_id}"
+    Then the response code should be 410
+    And I can forget keycloak clientID: ${clientID}
+
     Scenario: Admin API can delete dangling connector deployments
       Given I am logged in as "Bobby"
       When I POST path "/v1/kafka_connector_clusters" with json body:
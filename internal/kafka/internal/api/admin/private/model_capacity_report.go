@@ -0,0 +1,21 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.2.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// CapacityReport struct for CapacityReport
+type CapacityReport struct {
+	Kind string `json:"kind"`
+	// How far ahead, in minutes, the streaming units are forecasted
+	ForecastHorizonMinutes int32 `json:"forecast_horizon_minutes"`
+	// How much Kafka creation history, in hours, the forecast is based on
+	LookbackWindowHours int32                `json:"lookback_window_hours"`
+	Items               []CapacityReportItem `json:"items"`
+}
@@ -0,0 +1,35 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.2.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// CapacityReportItem The current capacity and the forecasted demand of an instance type in a cloud provider's region
+type CapacityReportItem struct {
+	CloudProvider string `json:"cloud_provider"`
+	Region        string `json:"region"`
+	InstanceType  string `json:"instance_type"`
+	// The streaming units capacity of the ready data plane clusters
+	MaxStreamingUnits      int32 `json:"max_streaming_units"`
+	ConsumedStreamingUnits int32 `json:"consumed_streaming_units"`
+	FreeStreamingUnits     int32 `json:"free_streaming_units"`
+	// The number of Kafka instances requested during the lookback window
+	ObservedKafkaCount int32 `json:"observed_kafka_count"`
+	// The streaming units of the Kafka instances requested during the lookback window
+	ObservedStreamingUnits int32 `json:"observed_streaming_units"`
+	// The streaming units expected to be requested within the forecast horizon
+	ForecastedStreamingUnits int32 `json:"forecasted_streaming_units"`
+	// The free streaming units left once the forecasted streaming units are consumed
+	ProjectedFreeStreamingUnits             int32 `json:"projected_free_streaming_units"`
+	MinAvailableCapacitySlackStreamingUnits int32 `json:"min_available_capacity_slack_streaming_units"`
+	// The streaming units limit of the instance type in the region, if any
+	Limit *int32 `json:"limit,omitempty"`
+	// Whether a data plane cluster supporting the instance type is being provisioned in the region
+	ScaleUpOngoing bool `json:"scale_up_ongoing"`
+}
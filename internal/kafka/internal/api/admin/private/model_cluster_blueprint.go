@@ -0,0 +1,39 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.2.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// ClusterBlueprint struct for ClusterBlueprint
+type ClusterBlueprint struct {
+	Id   string `json:"id"`
+	Kind string `json:"kind"`
+	Href string `json:"href"`
+	// The cloud provider of the clusters of the blueprint
+	CloudProvider string `json:"cloud_provider"`
+	// The region of the clusters of the blueprint
+	Region string `json:"region"`
+	// The number of clusters desired in the region
+	ClusterCount int32 `json:"cluster_count"`
+	// The comma separated list of instance types supported by the clusters
+	SupportedInstanceType string `json:"supported_instance_type"`
+	MultiAz               bool   `json:"multi_az"`
+	// The OpenShift version new clusters are installed with and existing clusters are upgraded to. The data plane cluster configuration is used when empty
+	OpenshiftVersion string `json:"openshift_version,omitempty"`
+	// The machine type of the cluster wide workload of new clusters. The data plane cluster configuration is used when empty
+	ClusterWideMachineType string                           `json:"cluster_wide_machine_type,omitempty"`
+	MachinePools           []ClusterBlueprintMachinePool    `json:"machine_pools"`
+	AddonParameters        []ClusterBlueprintAddonParameter `json:"addon_parameters"`
+	CreatedAt              time.Time                        `json:"created_at"`
+	UpdatedAt              time.Time                        `json:"updated_at"`
+}
@@ -0,0 +1,18 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.2.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ClusterBlueprintAddonParameter struct for ClusterBlueprintAddonParameter
+type ClusterBlueprintAddonParameter struct {
+	// The id of the kas-fleetshard operator addon parameter
+	Id    string `json:"id"`
+	Value string `json:"value"`
+}
@@ -0,0 +1,21 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.2.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ClusterBlueprintMachinePool struct for ClusterBlueprintMachinePool
+type ClusterBlueprintMachinePool struct {
+	// The instance type whose Kafka workload runs in the machine pool
+	InstanceType string `json:"instance_type"`
+	// The cloud provider specific machine type of the nodes of the machine pool
+	MachineType     string `json:"machine_type"`
+	MinComputeNodes int32  `json:"min_compute_nodes"`
+	MaxComputeNodes int32  `json:"max_compute_nodes"`
+}
@@ -0,0 +1,24 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.2.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ClusterBlueprintPlan struct for ClusterBlueprintPlan
+type ClusterBlueprintPlan struct {
+	Kind string `json:"kind"`
+	// The id of the cluster blueprint
+	BlueprintId string `json:"blueprint_id"`
+	// The number of clusters desired by the blueprint
+	DesiredClusterCount int32 `json:"desired_cluster_count"`
+	// The number of clusters of the blueprint that are not failed or being deleted
+	CurrentClusterCount int32 `json:"current_cluster_count"`
+	// The steps taken to reconcile the clusters toward the blueprint
+	Steps []ClusterBlueprintPlanStep `json:"steps"`
+}
@@ -0,0 +1,25 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.2.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ClusterBlueprintPlanStep struct for ClusterBlueprintPlanStep
+type ClusterBlueprintPlanStep struct {
+	// Values: [create, update, retire]
+	Action string `json:"action"`
+	// The id of the cluster the step applies to. Empty for create steps and for clusters not yet created in the provider
+	ClusterId string `json:"cluster_id,omitempty"`
+	// The status of the cluster the step applies to
+	ClusterStatus string `json:"cluster_status,omitempty"`
+	// Why the step is needed
+	Reason string `json:"reason"`
+	// Whether the step is blocked, e.g. a cluster to retire still has Kafka instances
+	Blocked bool `json:"blocked"`
+}
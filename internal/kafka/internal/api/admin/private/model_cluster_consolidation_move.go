@@ -0,0 +1,23 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.2.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ClusterConsolidationMove struct for ClusterConsolidationMove
+type ClusterConsolidationMove struct {
+	// The id of the Kafka instance to move
+	KafkaId string `json:"kafka_id"`
+	// The instance type of the Kafka instance
+	InstanceType string `json:"instance_type"`
+	// The number of streaming units consumed by the Kafka instance
+	StreamingUnits int32 `json:"streaming_units"`
+	// The id of the cluster the Kafka instance is moved onto
+	TargetClusterId string `json:"target_cluster_id"`
+}
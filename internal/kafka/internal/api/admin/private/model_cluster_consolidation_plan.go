@@ -0,0 +1,40 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.2.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// ClusterConsolidationPlan struct for ClusterConsolidationPlan
+type ClusterConsolidationPlan struct {
+	Id   string `json:"id"`
+	Kind string `json:"kind"`
+	Href string `json:"href"`
+	// The id of the underutilized data plane cluster to empty
+	ClusterId string `json:"cluster_id"`
+	// The cloud provider of the cluster
+	CloudProvider string `json:"cloud_provider,omitempty"`
+	// The region of the cluster
+	Region string `json:"region,omitempty"`
+	// Values: [proposed, approved, rejected, completed, expired]
+	Status string `json:"status"`
+	// The streaming units utilization of the cluster, in percent, when the plan was proposed
+	Utilization int32 `json:"utilization"`
+	// The planned moves of the Kafka instances of the cluster not yet provisioned in the data plane
+	Moves []ClusterConsolidationMove `json:"moves"`
+	// The Kafka instances already provisioned in the data plane, which cannot be moved and stay on the cluster until they are deleted
+	RetainedKafkas []ClusterConsolidationRetainedKafka `json:"retained_kafkas,omitempty"`
+	// The time the plan has been approved, from which the drain timeout is counted
+	ApprovedAt *time.Time `json:"approved_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
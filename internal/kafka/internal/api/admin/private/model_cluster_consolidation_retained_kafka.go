@@ -0,0 +1,21 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.2.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ClusterConsolidationRetainedKafka struct for ClusterConsolidationRetainedKafka
+type ClusterConsolidationRetainedKafka struct {
+	// The id of the Kafka instance staying on the cluster
+	KafkaId string `json:"kafka_id"`
+	// The instance type of the Kafka instance
+	InstanceType string `json:"instance_type"`
+	// The number of streaming units consumed by the Kafka instance
+	StreamingUnits int32 `json:"streaming_units"`
+}
@@ -0,0 +1,36 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.2.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// ClusterUpgrade struct for ClusterUpgrade
+type ClusterUpgrade struct {
+	Kind string `json:"kind"`
+	// The id of the cluster
+	ClusterId string `json:"cluster_id"`
+	// The status of the latest upgrade requested for the cluster. Empty if no upgrade was ever requested
+	Status string `json:"status"`
+	// The OpenShift version the cluster is requested to be upgraded to
+	Version string `json:"version,omitempty"`
+	// The time before which the upgrade does not start
+	ScheduledAt *time.Time `json:"scheduled_at,omitempty"`
+	// The id of the upgrade wave the upgrade is part of
+	WaveId string `json:"wave_id,omitempty"`
+	// Why a pending upgrade is held back or why an upgrade failed
+	StatusDetails string `json:"status_details,omitempty"`
+	// The OpenShift version the cluster runs
+	CurrentVersion string `json:"current_version,omitempty"`
+	// The OpenShift versions the cluster can be upgraded to
+	AvailableUpgrades []string `json:"available_upgrades,omitempty"`
+}
@@ -0,0 +1,23 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.2.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// ClusterUpgradeRequest struct for ClusterUpgradeRequest
+type ClusterUpgradeRequest struct {
+	// The OpenShift version to upgrade the cluster to. It must be one of the available upgrades of the cluster
+	Version string `json:"version"`
+	// The time before which the upgrade must not start. The upgrade starts as soon as possible when not set
+	ScheduledAt *time.Time `json:"scheduled_at,omitempty"`
+}
@@ -0,0 +1,29 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.2.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// ClusterUpgradeWaveRequest struct for ClusterUpgradeWaveRequest
+type ClusterUpgradeWaveRequest struct {
+	// The OpenShift version to upgrade the clusters to. It must be one of the available upgrades of every cluster
+	Version string `json:"version"`
+	// The ids of the clusters to upgrade, in the order they are upgraded
+	ClusterIds []string `json:"cluster_ids"`
+	// The time before which the first batch of clusters must not be upgraded. The first batch is upgraded as soon as possible when not set
+	StartAt *time.Time `json:"start_at,omitempty"`
+	// The number of clusters upgraded together. Defaults to 1
+	BatchSize int32 `json:"batch_size,omitempty"`
+	// The minimum number of minutes between the upgrades of two consecutive batches
+	BatchIntervalMinutes int32 `json:"batch_interval_minutes,omitempty"`
+}
@@ -0,0 +1,29 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.2.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// QuarantinedWorkItem struct for QuarantinedWorkItem
+type QuarantinedWorkItem struct {
+	// The type of the worker reconciling the work item
+	WorkerType string `json:"worker_type"`
+	// The id of the work item, for example the id of a kafka or of a connector
+	ResourceId string `json:"resource_id"`
+	// The number of failed attempts to reconcile the work item
+	Attempts int32 `json:"attempts"`
+	// The error returned by the last attempt to reconcile the work item
+	LastError string `json:"last_error,omitempty"`
+	// The time at which the work item has been quarantined
+	QuarantinedAt time.Time `json:"quarantined_at"`
+}
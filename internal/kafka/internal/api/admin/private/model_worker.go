@@ -0,0 +1,36 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.2.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// Worker struct for Worker
+type Worker struct {
+	Id   string `json:"id"`
+	Kind string `json:"kind"`
+	Href string `json:"href"`
+	// The type of the worker, for example kafka or cluster
+	WorkerType string `json:"worker_type"`
+	// The id of the worker instance holding the leader lease of the worker type
+	Leader string `json:"leader,omitempty"`
+	// The time at which the leader lease expires, unless it is renewed by its leader
+	LeaseExpiresAt *time.Time `json:"lease_expires_at,omitempty"`
+	// Whether the reconciles of the worker type are paused
+	Paused bool `json:"paused"`
+	// The time at which the last reconcile started
+	LastReconcileAt *time.Time `json:"last_reconcile_at,omitempty"`
+	// The errors returned by the last reconcile
+	LastReconcileErrors []string `json:"last_reconcile_errors,omitempty"`
+	// The time at which the next reconcile is scheduled
+	NextReconcileAt *time.Time `json:"next_reconcile_at,omitempty"`
+}
@@ -0,0 +1,111 @@
+package dbapi
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"gorm.io/gorm"
+)
+
+// ClusterBlueprint declares the data plane clusters desired in a cloud provider's region.
+// The cluster manager creates, updates and retires the clusters of the blueprint until they match it
+type ClusterBlueprint struct {
+	api.Meta
+	CloudProvider string `json:"cloud_provider" gorm:"uniqueIndex:idx_cluster_blueprints_location"`
+	Region        string `json:"region" gorm:"uniqueIndex:idx_cluster_blueprints_location"`
+	// ClusterCount is the number of clusters desired in the region
+	ClusterCount int `json:"cluster_count"`
+	// SupportedInstanceType is the comma separated list of the instance types supported by the clusters of the blueprint
+	SupportedInstanceType string `json:"supported_instance_type"`
+	MultiAZ               bool   `json:"multi_az"`
+	// OpenShiftVersion is the version new clusters are installed with and existing clusters are upgraded to.
+	// ClusterWideMachineType only applies to the clusters created after it is set.
+	// The data plane cluster configuration is used when empty
+	OpenShiftVersion       string `json:"openshift_version"`
+	ClusterWideMachineType string `json:"cluster_wide_machine_type"`
+	// MachinePools overrides the Kafka workload machine pools of the data plane cluster configuration
+	MachinePools api.JSON `json:"machine_pools"`
+	// AddonParameters are added to the parameters of the kas-fleetshard operator addon installed on the clusters
+	AddonParameters api.JSON `json:"addon_parameters"`
+}
+
+type ClusterBlueprintList []*ClusterBlueprint
+
+func (b *ClusterBlueprint) BeforeCreate(scope *gorm.DB) error {
+	if b.ID == "" {
+		b.ID = api.NewID()
+	}
+	return nil
+}
+
+// GetSupportedInstanceTypes returns the list of instance types supported by the clusters of the blueprint
+func (b *ClusterBlueprint) GetSupportedInstanceTypes() []string {
+	var instanceTypes []string
+	for _, instanceType := range strings.Split(b.SupportedInstanceType, ",") {
+		instanceType = strings.TrimSpace(instanceType)
+		if instanceType != "" {
+			instanceTypes = append(instanceTypes, instanceType)
+		}
+	}
+	return instanceTypes
+}
+
+func (b *ClusterBlueprint) GetMachinePools() ([]types.MachinePoolSpec, error) {
+	var machinePools []types.MachinePoolSpec
+	if b.MachinePools == nil {
+		return machinePools, nil
+	}
+	if err := json.Unmarshal(b.MachinePools, &machinePools); err != nil {
+		return nil, err
+	}
+	return machinePools, nil
+}
+
+func (b *ClusterBlueprint) SetMachinePools(machinePools []types.MachinePoolSpec) error {
+	m, err := json.Marshal(machinePools)
+	if err != nil {
+		return err
+	}
+	b.MachinePools = m
+	return nil
+}
+
+func (b *ClusterBlueprint) GetAddonParameters() ([]types.AddonParameterSpec, error) {
+	var parameters []types.AddonParameterSpec
+	if b.AddonParameters == nil {
+		return parameters, nil
+	}
+	if err := json.Unmarshal(b.AddonParameters, &parameters); err != nil {
+		return nil, err
+	}
+	return parameters, nil
+}
+
+func (b *ClusterBlueprint) SetAddonParameters(parameters []types.AddonParameterSpec) error {
+	p, err := json.Marshal(parameters)
+	if err != nil {
+		return err
+	}
+	b.AddonParameters = p
+	return nil
+}
+
+// BuildClusterProviderSpec returns the provider spec stored in the clusters of the blueprint
+func (b *ClusterBlueprint) BuildClusterProviderSpec() (*types.ClusterProviderSpec, error) {
+	machinePools, err := b.GetMachinePools()
+	if err != nil {
+		return nil, err
+	}
+	addonParameters, err := b.GetAddonParameters()
+	if err != nil {
+		return nil, err
+	}
+	return &types.ClusterProviderSpec{
+		OpenShiftVersion:   b.OpenShiftVersion,
+		ComputeMachineType: b.ClusterWideMachineType,
+		MachinePools:       machinePools,
+		AddonParameters:    addonParameters,
+	}, nil
+}
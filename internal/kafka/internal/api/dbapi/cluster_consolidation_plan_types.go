@@ -0,0 +1,111 @@
+package dbapi
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"gorm.io/gorm"
+)
+
+type ClusterConsolidationPlanStatus string
+
+func (s ClusterConsolidationPlanStatus) String() string {
+	return string(s)
+}
+
+const (
+	// ClusterConsolidationPlanProposed - the plan is waiting to be approved or rejected by an admin
+	ClusterConsolidationPlanProposed ClusterConsolidationPlanStatus = "proposed"
+	// ClusterConsolidationPlanApproved - the plan has been approved and the cluster is being drained
+	ClusterConsolidationPlanApproved ClusterConsolidationPlanStatus = "approved"
+	// ClusterConsolidationPlanRejected - the plan has been rejected by an admin
+	ClusterConsolidationPlanRejected ClusterConsolidationPlanStatus = "rejected"
+	// ClusterConsolidationPlanCompleted - the cluster has been emptied or is no longer ready
+	ClusterConsolidationPlanCompleted ClusterConsolidationPlanStatus = "completed"
+	// ClusterConsolidationPlanExpired - the cluster has not been emptied within the drain timeout and receives new Kafka instances again
+	ClusterConsolidationPlanExpired ClusterConsolidationPlanStatus = "expired"
+)
+
+// ClusterConsolidationPlan is the plan to empty an underutilized data plane cluster by moving its Kafka instances
+// onto the other clusters of the same region
+type ClusterConsolidationPlan struct {
+	api.Meta
+	ClusterID     string                         `json:"cluster_id" gorm:"index"`
+	CloudProvider string                         `json:"cloud_provider"`
+	Region        string                         `json:"region"`
+	Status        ClusterConsolidationPlanStatus `json:"status" gorm:"index"`
+	// Utilization is the streaming units utilization, in percent, of the cluster at the time the plan was proposed
+	Utilization int `json:"utilization"`
+	// Moves is the list of the planned moves of the Kafka instances of the cluster not yet provisioned in the data plane
+	Moves api.JSON `json:"moves"`
+	// RetainedKafkas is the list of the Kafka instances already provisioned in the data plane, which cannot be moved
+	// and stay on the cluster until they are deleted
+	RetainedKafkas api.JSON `json:"retained_kafkas"`
+	// ApprovedAt is the time the plan has been approved, from which the drain timeout is counted
+	ApprovedAt *time.Time `json:"approved_at"`
+}
+
+// ClusterConsolidationMove is the planned move of a Kafka instance onto a target cluster
+type ClusterConsolidationMove struct {
+	KafkaID         string `json:"kafka_id"`
+	InstanceType    string `json:"instance_type"`
+	StreamingUnits  int    `json:"streaming_units"`
+	TargetClusterID string `json:"target_cluster_id"`
+}
+
+// ClusterConsolidationRetainedKafka is a Kafka instance staying on the cluster until it is deleted
+type ClusterConsolidationRetainedKafka struct {
+	KafkaID        string `json:"kafka_id"`
+	InstanceType   string `json:"instance_type"`
+	StreamingUnits int    `json:"streaming_units"`
+}
+
+type ClusterConsolidationPlanList []*ClusterConsolidationPlan
+
+func (p *ClusterConsolidationPlan) BeforeCreate(scope *gorm.DB) error {
+	if p.ID == "" {
+		p.ID = api.NewID()
+	}
+	return nil
+}
+
+func (p *ClusterConsolidationPlan) GetMoves() ([]ClusterConsolidationMove, error) {
+	var moves []ClusterConsolidationMove
+	if p.Moves == nil {
+		return moves, nil
+	}
+	if err := json.Unmarshal(p.Moves, &moves); err != nil {
+		return nil, err
+	}
+	return moves, nil
+}
+
+func (p *ClusterConsolidationPlan) SetMoves(moves []ClusterConsolidationMove) error {
+	m, err := json.Marshal(moves)
+	if err != nil {
+		return err
+	}
+	p.Moves = m
+	return nil
+}
+
+func (p *ClusterConsolidationPlan) GetRetainedKafkas() ([]ClusterConsolidationRetainedKafka, error) {
+	var retained []ClusterConsolidationRetainedKafka
+	if p.RetainedKafkas == nil {
+		return retained, nil
+	}
+	if err := json.Unmarshal(p.RetainedKafkas, &retained); err != nil {
+		return nil, err
+	}
+	return retained, nil
+}
+
+func (p *ClusterConsolidationPlan) SetRetainedKafkas(retained []ClusterConsolidationRetainedKafka) error {
+	r, err := json.Marshal(retained)
+	if err != nil {
+		return err
+	}
+	p.RetainedKafkas = r
+	return nil
+}
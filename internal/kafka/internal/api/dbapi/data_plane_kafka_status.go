@@ -13,6 +13,9 @@ type DataPlaneKafkaStatus struct {
 	StrimziVersion  string
 	KafkaIBPVersion string
 	AdminServerURI  string
+	// KafkaConfig contains the Kafka broker configuration overrides applied on the data plane.
+	// It is nil when the data plane did not report them
+	KafkaConfig map[string]string
 }
 
 type DataPlaneKafkaStatusCondition struct {
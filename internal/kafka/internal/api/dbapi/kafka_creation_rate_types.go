@@ -0,0 +1,32 @@
+package dbapi
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"gorm.io/gorm"
+)
+
+// KafkaCreationRate counts the Kafka instances requested for an instance type in a cloud provider's region
+// during the hour starting at WindowStart. It is the history the data plane capacity forecast is based on
+type KafkaCreationRate struct {
+	ID            string    `gorm:"primaryKey"`
+	CloudProvider string    `gorm:"uniqueIndex:idx_kafka_creation_rates_window"`
+	Region        string    `gorm:"uniqueIndex:idx_kafka_creation_rates_window"`
+	InstanceType  string    `gorm:"uniqueIndex:idx_kafka_creation_rates_window"`
+	WindowStart   time.Time `gorm:"uniqueIndex:idx_kafka_creation_rates_window"`
+	KafkaCount    int
+	// StreamingUnits is the sum of the streaming units of the Kafka instances requested during the window
+	StreamingUnits int
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+type KafkaCreationRateList []*KafkaCreationRate
+
+func (r *KafkaCreationRate) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == "" {
+		r.ID = api.NewID()
+	}
+	return nil
+}
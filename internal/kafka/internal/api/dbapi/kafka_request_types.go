@@ -75,6 +75,46 @@ type KafkaRequest struct {
 	KafkasRoutesBaseDomainTLSKeyRef string
 	// KafkasRoutesBaseDomainTLSCrtRef is the key referencing the TLS certificate crt (public part of the certificate) for the base kafka domain
 	KafkasRoutesBaseDomainTLSCrtRef string
+	// DesiredKafkaConfig contains the Kafka broker configuration overrides requested by the user. It is a map of configuration keys to values
+	DesiredKafkaConfig api.JSON `json:"desired_kafka_config"`
+	// ActualKafkaConfig contains the Kafka broker configuration overrides that have been applied on the data plane, as reported by the fleetshard
+	ActualKafkaConfig api.JSON `json:"actual_kafka_config"`
+	// PrivateConnectivity is set when the Kafka instance is reached through a private endpoint service instead of the public routes
+	PrivateConnectivity          bool                  `json:"private_connectivity"`
+	PrivateEndpointStatus        PrivateEndpointStatus `json:"private_endpoint_status" gorm:"index"`
+	PrivateEndpointServiceID     string                `json:"private_endpoint_service_id"`
+	PrivateEndpointServiceName   string                `json:"private_endpoint_service_name"`
+	PrivateEndpointStatusDetails string                `json:"private_endpoint_status_details"`
+	// PrivateEndpointAllowedAccounts contains the ids of the cloud provider accounts allowed to connect to the private endpoint service
+	PrivateEndpointAllowedAccounts api.JSON `json:"private_endpoint_allowed_accounts"`
+}
+
+type PrivateEndpointStatus string
+
+const (
+	// PrivateEndpointStatusPending is the status of a private endpoint waiting for the data plane to provision the load balancer of its Kafka instance
+	PrivateEndpointStatusPending PrivateEndpointStatus = "pending"
+	// PrivateEndpointStatusProvisioning is the status of a private endpoint service being created by the cloud provider
+	PrivateEndpointStatusProvisioning PrivateEndpointStatus = "provisioning"
+	// PrivateEndpointStatusUpdating is the status of a private endpoint whose allowed accounts are being applied
+	PrivateEndpointStatusUpdating PrivateEndpointStatus = "updating"
+	PrivateEndpointStatusReady    PrivateEndpointStatus = "ready"
+	PrivateEndpointStatusFailed   PrivateEndpointStatus = "failed"
+	// PrivateEndpointStatusDeleted is the status of a private endpoint whose service has been deleted once its Kafka instance was deprovisioned
+	PrivateEndpointStatusDeleted PrivateEndpointStatus = "deleted"
+	// PrivateEndpointStatusNone is the status of the Kafka instances without private connectivity
+	PrivateEndpointStatusNone PrivateEndpointStatus = ""
+)
+
+func (s PrivateEndpointStatus) String() string {
+	return string(s)
+}
+
+// PrivateEndpointStatusesInProgress are the statuses of the private endpoints still reconciled by the fleet manager
+var PrivateEndpointStatusesInProgress = []PrivateEndpointStatus{
+	PrivateEndpointStatusPending,
+	PrivateEndpointStatusProvisioning,
+	PrivateEndpointStatusUpdating,
 }
 
 type KafkaPromotionStatus string
@@ -146,6 +186,83 @@ func (k *KafkaRequest) SetRoutes(routes []DataPlaneKafkaRoute) error {
 	}
 }
 
+// GetDesiredKafkaConfig returns the Kafka broker configuration overrides requested by the user
+func (k *KafkaRequest) GetDesiredKafkaConfig() (map[string]string, error) {
+	return unmarshalKafkaConfig(k.DesiredKafkaConfig)
+}
+
+// SetDesiredKafkaConfig sets the Kafka broker configuration overrides requested by the user
+func (k *KafkaRequest) SetDesiredKafkaConfig(kafkaConfig map[string]string) error {
+	c, err := marshalKafkaConfig(kafkaConfig)
+	if err != nil {
+		return err
+	}
+	k.DesiredKafkaConfig = c
+	return nil
+}
+
+// GetActualKafkaConfig returns the Kafka broker configuration overrides applied on the data plane
+func (k *KafkaRequest) GetActualKafkaConfig() (map[string]string, error) {
+	return unmarshalKafkaConfig(k.ActualKafkaConfig)
+}
+
+// SetActualKafkaConfig sets the Kafka broker configuration overrides applied on the data plane
+func (k *KafkaRequest) SetActualKafkaConfig(kafkaConfig map[string]string) error {
+	c, err := marshalKafkaConfig(kafkaConfig)
+	if err != nil {
+		return err
+	}
+	k.ActualKafkaConfig = c
+	return nil
+}
+
+// GetPrivateEndpointAllowedAccounts returns the ids of the accounts allowed to connect to the private endpoint service
+func (k *KafkaRequest) GetPrivateEndpointAllowedAccounts() ([]string, error) {
+	accountIDs := []string{}
+	if len(k.PrivateEndpointAllowedAccounts) == 0 {
+		return accountIDs, nil
+	}
+	if err := json.Unmarshal(k.PrivateEndpointAllowedAccounts, &accountIDs); err != nil {
+		return nil, err
+	}
+	return accountIDs, nil
+}
+
+// SetPrivateEndpointAllowedAccounts sets the ids of the accounts allowed to connect to the private endpoint service
+func (k *KafkaRequest) SetPrivateEndpointAllowedAccounts(accountIDs []string) error {
+	if accountIDs == nil {
+		accountIDs = []string{}
+	}
+	a, err := json.Marshal(accountIDs)
+	if err != nil {
+		return err
+	}
+	k.PrivateEndpointAllowedAccounts = a
+	return nil
+}
+
+func unmarshalKafkaConfig(value api.JSON) (map[string]string, error) {
+	var kafkaConfig map[string]string
+	if len(value) == 0 {
+		return kafkaConfig, nil
+	}
+	if err := json.Unmarshal(value, &kafkaConfig); err != nil {
+		return nil, err
+	}
+	return kafkaConfig, nil
+}
+
+func marshalKafkaConfig(kafkaConfig map[string]string) (api.JSON, error) {
+	if len(kafkaConfig) == 0 {
+		return nil, nil
+	}
+	c, err := json.Marshal(kafkaConfig)
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
 // GetExpirationTime returns when the Kafka request will expire based on the
 // provided lifespanSeconds value. lifespanSeconds is assumed to be greater
 // than 0
@@ -184,3 +184,20 @@ func TestKafkaRequest_IsADeveloperInstance(t *testing.T) {
 		})
 	}
 }
+
+func TestKafkaRequest_PrivateEndpointAllowedAccounts(t *testing.T) {
+	g := gomega.NewWithT(t)
+	kafka := &KafkaRequest{}
+
+	accountIDs, err := kafka.GetPrivateEndpointAllowedAccounts()
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(accountIDs).To(gomega.BeEmpty())
+
+	g.Expect(kafka.SetPrivateEndpointAllowedAccounts([]string{"111111111111", "222222222222"})).To(gomega.Succeed())
+	accountIDs, err = kafka.GetPrivateEndpointAllowedAccounts()
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(accountIDs).To(gomega.Equal([]string{"111111111111", "222222222222"}))
+
+	g.Expect(kafka.SetPrivateEndpointAllowedAccounts(nil)).To(gomega.Succeed())
+	g.Expect(string(kafka.PrivateEndpointAllowedAccounts)).To(gomega.Equal("[]"))
+}
@@ -18,4 +18,6 @@ type DataPlaneKafkaStatus struct {
 	// Routes created for a Kafka cluster
 	Routes         *[]DataPlaneKafkaStatusRoutes `json:"routes,omitempty"`
 	AdminServerURI string                        `json:"adminServerURI,omitempty"`
+	// Kafka broker configuration overrides applied on the Kafka cluster
+	KafkaConfig map[string]string `json:"kafkaConfig,omitempty"`
 }
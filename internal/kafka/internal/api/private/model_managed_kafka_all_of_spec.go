@@ -17,5 +17,7 @@ type ManagedKafkaAllOfSpec struct {
 	Owners          []string                               `json:"owners,omitempty"`
 	Endpoint        ManagedKafkaAllOfSpecEndpoint          `json:"endpoint,omitempty"`
 	Versions        ManagedKafkaVersions                   `json:"versions,omitempty"`
-	Deleted         bool                                   `json:"deleted"`
+	// Kafka broker configuration overrides requested for the Kafka cluster
+	KafkaConfig map[string]string `json:"kafkaConfig,omitempty"`
+	Deleted     bool              `json:"deleted"`
 }
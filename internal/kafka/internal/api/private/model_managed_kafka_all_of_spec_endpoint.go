@@ -13,4 +13,5 @@ package private
 type ManagedKafkaAllOfSpecEndpoint struct {
 	BootstrapServerHost string                            `json:"bootstrapServerHost,omitempty"`
 	Tls                 *ManagedKafkaAllOfSpecEndpointTls `json:"tls,omitempty"`
+	PrivateConnectivity bool                              `json:"privateConnectivity,omitempty"`
 }
@@ -16,6 +16,8 @@ import (
 	_nethttp "net/http"
 	_neturl "net/url"
 	"strings"
+
+	"github.com/antihax/optional"
 )
 
 // Linger please
@@ -391,14 +393,27 @@ func (a *EnterpriseDataplaneClustersApiService) GetEnterpriseClusterById(ctx _co
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// GetEnterpriseOsdClustersOpts Optional parameters for the method 'GetEnterpriseOsdClusters'
+type GetEnterpriseOsdClustersOpts struct {
+	Page   optional.String
+	Size   optional.String
+	Cursor optional.String
+	Fields optional.String
+}
+
 /*
 GetEnterpriseOsdClusters Method for GetEnterpriseOsdClusters
 List all Enterprise data plane clusters
   - @param ctx _context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+  - @param optional nil or *GetEnterpriseOsdClustersOpts - Optional Parameters:
+  - @param "Page" (optional.String) -  Page index
+  - @param "Size" (optional.String) -  Number of items in each page
+  - @param "Cursor" (optional.String) -  Opaque cursor returned as the `next_cursor` of a previous page. When the parameter is provided the items are ordered by their id and only the items following the cursor are returned, in which case the `page` and `orderBy` parameters are not used. Provide the parameter with an empty value to get the first page. Cursor based paging is not affected by items created while paging.
+  - @param "Fields" (optional.String) -  Comma separated list of the item properties to return. The `id`, `kind` and `href` of the items are always returned. All the properties are returned when the parameter isn't provided.
 
 @return EnterpriseClusterList
 */
-func (a *EnterpriseDataplaneClustersApiService) GetEnterpriseOsdClusters(ctx _context.Context) (EnterpriseClusterList, *_nethttp.Response, error) {
+func (a *EnterpriseDataplaneClustersApiService) GetEnterpriseOsdClusters(ctx _context.Context, localVarOptionals *GetEnterpriseOsdClustersOpts) (EnterpriseClusterList, *_nethttp.Response, error) {
 	var (
 		localVarHTTPMethod   = _nethttp.MethodGet
 		localVarPostBody     interface{}
@@ -414,6 +429,18 @@ func (a *EnterpriseDataplaneClustersApiService) GetEnterpriseOsdClusters(ctx _co
 	localVarQueryParams := _neturl.Values{}
 	localVarFormParams := _neturl.Values{}
 
+	if localVarOptionals != nil && localVarOptionals.Page.IsSet() {
+		localVarQueryParams.Add("page", parameterToString(localVarOptionals.Page.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.Size.IsSet() {
+		localVarQueryParams.Add("size", parameterToString(localVarOptionals.Size.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.Cursor.IsSet() {
+		localVarQueryParams.Add("cursor", parameterToString(localVarOptionals.Cursor.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.Fields.IsSet() {
+		localVarQueryParams.Add("fields", parameterToString(localVarOptionals.Fields.Value(), ""))
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
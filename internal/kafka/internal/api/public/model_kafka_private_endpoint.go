@@ -0,0 +1,24 @@
+/*
+ * Kafka Management API
+ *
+ * Kafka Management API is a REST API to manage Kafka instances
+ *
+ * API version: 1.16.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// KafkaPrivateEndpoint Private endpoint of a Kafka instance
+type KafkaPrivateEndpoint struct {
+	Kind string `json:"kind"`
+	// Status of the private endpoint. Possible values: ['pending', 'provisioning', 'ready', 'updating', 'failed']
+	Status string `json:"status"`
+	// The name of the private endpoint service to create the private endpoints from. It is set once the private endpoint service has been created
+	ServiceName string `json:"service_name,omitempty"`
+	// The IDs of the cloud provider accounts allowed to connect to the private endpoint service of the Kafka instance
+	AllowedAccountIds []string `json:"allowed_account_ids"`
+	// Details of the private endpoint status. It is set when the private endpoint has failed
+	StatusDetails string `json:"status_details,omitempty"`
+}
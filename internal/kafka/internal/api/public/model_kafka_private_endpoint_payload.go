@@ -0,0 +1,17 @@
+/*
+ * Kafka Management API
+ *
+ * Kafka Management API is a REST API to manage Kafka instances
+ *
+ * API version: 1.16.0
+ * Contact: rhosak-support@redhat.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// KafkaPrivateEndpointPayload Schema for the private endpoint of a Kafka instance in the request body sent to /kafkas POST and /kafkas/{id}/private_endpoint PUT
+type KafkaPrivateEndpointPayload struct {
+	// The IDs of the cloud provider accounts allowed to connect to the private endpoint service of the Kafka instance
+	AllowedAccountIds []string `json:"allowed_account_ids"`
+}
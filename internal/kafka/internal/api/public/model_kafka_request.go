@@ -71,4 +71,9 @@ type KafkaRequest struct {
 	ClusterId *string `json:"cluster_id,omitempty"`
 	// Details of the Kafka request promotion. It can be set when a Kafka request promotion is in progress or has failed
 	PromotionDetails string `json:"promotion_details,omitempty"`
+	// Kafka broker configuration overrides requested for the Kafka instance
+	KafkaConfig map[string]string `json:"kafka_config,omitempty"`
+	// Kafka broker configuration overrides that have been applied on the Kafka instance
+	AppliedKafkaConfig map[string]string     `json:"applied_kafka_config,omitempty"`
+	PrivateEndpoint    *KafkaPrivateEndpoint `json:"private_endpoint,omitempty"`
 }
@@ -12,9 +12,10 @@ package public
 
 // KafkaRequestList struct for KafkaRequestList
 type KafkaRequestList struct {
-	Kind  string         `json:"kind"`
-	Page  int32          `json:"page"`
-	Size  int32          `json:"size"`
-	Total int32          `json:"total"`
-	Items []KafkaRequest `json:"items"`
+	Kind       string         `json:"kind"`
+	Page       int32          `json:"page"`
+	Size       int32          `json:"size"`
+	Total      int32          `json:"total"`
+	Items      []KafkaRequest `json:"items"`
+	NextCursor string         `json:"next_cursor,omitempty"`
 }
@@ -30,4 +30,7 @@ type KafkaRequestPayload struct {
 	BillingModel *string `json:"billing_model,omitempty"`
 	// enterprise OSD cluster ID to be used for kafka creation
 	ClusterId *string `json:"cluster_id,omitempty"`
+	// Kafka broker configuration overrides. Only the allowed configuration keys can be set and their values must be within the allowed bounds
+	KafkaConfig     map[string]string            `json:"kafka_config,omitempty"`
+	PrivateEndpoint *KafkaPrivateEndpointPayload `json:"private_endpoint,omitempty"`
 }
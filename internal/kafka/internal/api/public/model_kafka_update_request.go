@@ -15,4 +15,6 @@ type KafkaUpdateRequest struct {
 	Owner *string `json:"owner,omitempty"`
 	// Whether connection reauthentication is enabled or not. If set to true, connection reauthentication on the Kafka instance will be required every 5 minutes.
 	ReauthenticationEnabled *bool `json:"reauthentication_enabled,omitempty"`
+	// Kafka broker configuration overrides. It replaces the existing overrides. Only the allowed configuration keys can be set and their values must be within the allowed bounds
+	KafkaConfig *map[string]string `json:"kafka_config,omitempty"`
 }
@@ -67,8 +67,18 @@ func (r clusterBuilder) NewOCMClusterFromCluster(clusterRequest *types.ClusterRe
 	clusterBuilder.CloudProvider(clustersmgmtv1.NewCloudProvider().ID(clusterRequest.CloudProvider))
 	clusterBuilder.Region(clustersmgmtv1.NewCloudRegion().ID(clusterRequest.Region))
 	clusterBuilder.MultiAZ(clusterRequest.MultiAZ)
-	if r.dataplaneClusterConfig.DynamicScalingConfig.NewDataPlaneOpenShiftVersion != "" {
-		clusterBuilder.Version(clustersmgmtv1.NewVersion().ID(r.dataplaneClusterConfig.DynamicScalingConfig.NewDataPlaneOpenShiftVersion))
+
+	providerSpec, err := types.ParseClusterProviderSpec(clusterRequest.AdditionalSpec)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse the provider spec of the cluster request")
+	}
+
+	openShiftVersion := r.dataplaneClusterConfig.DynamicScalingConfig.NewDataPlaneOpenShiftVersion
+	if providerSpec.OpenShiftVersion != "" {
+		openShiftVersion = providerSpec.OpenShiftVersion
+	}
+	if openShiftVersion != "" {
+		clusterBuilder.Version(clustersmgmtv1.NewVersion().ID(openShiftVersion))
 	}
 	// setting CCS to always be true for now as this is the only available cluster type within our quota.
 	clusterBuilder.CCS(clustersmgmtv1.NewCCS().Enabled(true))
@@ -84,8 +94,12 @@ func (r clusterBuilder) NewOCMClusterFromCluster(clusterRequest *types.ClusterRe
 	}
 
 	clusterWideWorkloadConfig := computeMachineConfig.ClusterWideWorkload
+	computeMachineType := clusterWideWorkloadConfig.ComputeMachineType
+	if providerSpec.ComputeMachineType != "" {
+		computeMachineType = providerSpec.ComputeMachineType
+	}
 	clusterBuilder.Nodes(clustersmgmtv1.NewClusterNodes().
-		ComputeMachineType(clustersmgmtv1.NewMachineType().ID(clusterWideWorkloadConfig.ComputeMachineType)).
+		ComputeMachineType(clustersmgmtv1.NewMachineType().ID(computeMachineType)).
 		AutoscaleCompute(clustersmgmtv1.NewMachinePoolAutoscaling().
 			MinReplicas(clusterWideWorkloadConfig.ComputeNodesAutoscaling.MinComputeNodes).
 			MaxReplicas(clusterWideWorkloadConfig.ComputeNodesAutoscaling.MaxComputeNodes)))
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters/types"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/ocm"
@@ -25,6 +26,11 @@ const (
 	// In OCM, number of compute nodes in a Multi-AZ cluster must be a multiple of
 	// this number
 	ocmMultiAZClusterNodeScalingMultiple = 3
+
+	// OCM rejects manual upgrade policies whose next run is less than a few minutes in the future
+	ocmUpgradePolicyMinimumLeadTime = 10 * time.Minute
+	ocmUpgradePolicyScheduleType    = "manual"
+	ocmUpgradePolicyUpgradeType     = "OSD"
 )
 
 var (
@@ -441,22 +447,11 @@ func (o *OCMProvider) CreateMachinePool(request *types.MachinePoolRequest) (*typ
 	machinePoolBuilder.InstanceType(request.InstanceSize)
 
 	if request.AutoScalingEnabled {
-		autoScalingMaxNodes := request.AutoScaling.MaxNodes
-		autoScalingMinNodes := request.AutoScaling.MinNodes
-
-		if request.MultiAZ {
-			autoScalingMinNodes = shared.RoundUp(request.AutoScaling.MinNodes, ocmMultiAZClusterNodeScalingMultiple)
-			autoScalingMaxNodes = shared.RoundUp(request.AutoScaling.MaxNodes, ocmMultiAZClusterNodeScalingMultiple)
-		}
-		if autoScalingMinNodes > autoScalingMaxNodes {
-			return nil, fmt.Errorf("error creating MachinePool '%s' for cluster id '%s': minimum number of nodes cannot be more than maximum number of nodes", request.ID, request.ClusterID)
+		autoScalingBuilder, err := buildMachinePoolAutoscaling(request)
+		if err != nil {
+			return nil, fmt.Errorf("error creating MachinePool '%s' for cluster id '%s': %v", request.ID, request.ClusterID, err)
 		}
-		autoScalingBuilder := clustersmgmtv1.NewMachinePoolAutoscaling()
-		autoScalingBuilder.MinReplicas(autoScalingMinNodes)
-		autoScalingBuilder.MaxReplicas(autoScalingMaxNodes)
-		autoScalingBuilder.ID(request.ID)
 		machinePoolBuilder.Autoscaling(autoScalingBuilder)
-
 	}
 	machinePoolBuilder.Labels(request.NodeLabels)
 	var taintsBuilders []*clustersmgmtv1.TaintBuilder
@@ -481,6 +476,151 @@ func (o *OCMProvider) CreateMachinePool(request *types.MachinePoolRequest) (*typ
 	return request, err
 }
 
+func (o *OCMProvider) UpdateMachinePool(request *types.MachinePoolRequest) (*types.MachinePoolRequest, error) {
+	if !request.AutoScalingEnabled {
+		return nil, fmt.Errorf("error updating MachinePool '%s' for cluster id '%s': only the autoscaling of machine pools can be updated", request.ID, request.ClusterID)
+	}
+	autoScalingBuilder, err := buildMachinePoolAutoscaling(request)
+	if err != nil {
+		return nil, fmt.Errorf("error updating MachinePool '%s' for cluster id '%s': %v", request.ID, request.ClusterID, err)
+	}
+	machinePool, err := clustersmgmtv1.NewMachinePool().
+		ID(request.ID).
+		Autoscaling(autoScalingBuilder).
+		Build()
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = o.ocmClient.UpdateMachinePool(request.ClusterID, machinePool)
+	if err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+// buildMachinePoolAutoscaling builds the autoscaling of the requested machine pool. The number of nodes of
+// multi AZ machine pools are rounded up to a multiple of the number of availability zones
+func buildMachinePoolAutoscaling(request *types.MachinePoolRequest) (*clustersmgmtv1.MachinePoolAutoscalingBuilder, error) {
+	autoScalingMaxNodes := request.AutoScaling.MaxNodes
+	autoScalingMinNodes := request.AutoScaling.MinNodes
+
+	if request.MultiAZ {
+		autoScalingMinNodes = shared.RoundUp(request.AutoScaling.MinNodes, ocmMultiAZClusterNodeScalingMultiple)
+		autoScalingMaxNodes = shared.RoundUp(request.AutoScaling.MaxNodes, ocmMultiAZClusterNodeScalingMultiple)
+	}
+	if autoScalingMinNodes > autoScalingMaxNodes {
+		return nil, fmt.Errorf("minimum number of nodes cannot be more than maximum number of nodes")
+	}
+	autoScalingBuilder := clustersmgmtv1.NewMachinePoolAutoscaling()
+	autoScalingBuilder.MinReplicas(autoScalingMinNodes)
+	autoScalingBuilder.MaxReplicas(autoScalingMaxNodes)
+	autoScalingBuilder.ID(request.ID)
+	return autoScalingBuilder, nil
+}
+
+func (o *OCMProvider) GetClusterVersion(clusterID string) (*types.ClusterVersionInfo, error) {
+	cluster, err := o.ocmClient.GetCluster(clusterID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get cluster %q", clusterID)
+	}
+
+	version := cluster.Version()
+	rawVersion := version.RawID()
+	if rawVersion == "" {
+		rawVersion = strings.TrimPrefix(version.ID(), "openshift-v")
+	}
+
+	return &types.ClusterVersionInfo{
+		Version:           rawVersion,
+		AvailableUpgrades: version.AvailableUpgrades(),
+	}, nil
+}
+
+// ScheduleUpgrade creates a manual upgrade policy for the cluster. The next run is postponed to the
+// earliest time accepted by OCM when the requested one is too close
+func (o *OCMProvider) ScheduleUpgrade(request *types.ClusterUpgradeRequest) (*types.ClusterUpgradeInfo, error) {
+	nextRun := request.NextRun
+	if earliestNextRun := time.Now().Add(ocmUpgradePolicyMinimumLeadTime); nextRun.Before(earliestNextRun) {
+		nextRun = earliestNextRun
+	}
+
+	upgradePolicy, err := clustersmgmtv1.NewUpgradePolicy().
+		ScheduleType(ocmUpgradePolicyScheduleType).
+		UpgradeType(ocmUpgradePolicyUpgradeType).
+		Version(request.Version).
+		NextRun(nextRun).
+		Build()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build upgrade policy for cluster %q", request.ClusterID)
+	}
+
+	created, err := o.ocmClient.CreateUpgradePolicy(request.ClusterID, upgradePolicy)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create upgrade policy for cluster %q", request.ClusterID)
+	}
+
+	return &types.ClusterUpgradeInfo{
+		ID:      created.ID(),
+		Version: created.Version(),
+		NextRun: created.NextRun(),
+		State:   api.ClusterUpgradeScheduled,
+	}, nil
+}
+
+// GetUpgrade returns the state of an upgrade policy of the cluster. As OCM removes upgrade policies once executed,
+// an upgrade whose policy no longer exists is completed if the cluster runs the requested version and failed otherwise
+func (o *OCMProvider) GetUpgrade(clusterID string, upgradeID string, version string) (*types.ClusterUpgradeInfo, error) {
+	upgradePolicyState, err := o.ocmClient.GetUpgradePolicyState(clusterID, upgradeID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get the state of upgrade policy %q of cluster %q", upgradeID, clusterID)
+	}
+
+	info := &types.ClusterUpgradeInfo{
+		ID:      upgradeID,
+		Version: version,
+	}
+
+	if upgradePolicyState == nil {
+		versionInfo, err := o.GetClusterVersion(clusterID)
+		if err != nil {
+			return nil, err
+		}
+		if versionInfo.Version == version {
+			info.State = api.ClusterUpgradeCompleted
+		} else {
+			info.State = api.ClusterUpgradeFailed
+			info.Description = fmt.Sprintf("upgrade policy %q no longer exists and the cluster runs version %q", upgradeID, versionInfo.Version)
+		}
+		return info, nil
+	}
+
+	info.Description = upgradePolicyState.Description()
+	switch upgradePolicyState.Value() {
+	case clustersmgmtv1.UpgradePolicyStateValueStarted:
+		info.State = api.ClusterUpgradeInProgress
+	case clustersmgmtv1.UpgradePolicyStateValueCompleted:
+		info.State = api.ClusterUpgradeCompleted
+	case clustersmgmtv1.UpgradePolicyStateValueFailed:
+		info.State = api.ClusterUpgradeFailed
+	case clustersmgmtv1.UpgradePolicyStateValueCancelled:
+		info.State = api.ClusterUpgradeCancelled
+	default:
+		// pending, scheduled and delayed upgrade policies have not started yet
+		info.State = api.ClusterUpgradeScheduled
+	}
+
+	return info, nil
+}
+
+func (o *OCMProvider) CancelUpgrade(clusterID string, upgradeID string) error {
+	if _, err := o.ocmClient.DeleteUpgradePolicy(clusterID, upgradeID); err != nil {
+		return errors.Wrapf(err, "failed to delete upgrade policy %q of cluster %q", upgradeID, clusterID)
+	}
+	return nil
+}
+
 // GetClusterResourceQuotaCosts returns a list of quota cost information related to ocm resources used for the provisioning and
 // terraforming of data plane clusters for the authenticated user.
 //
@@ -1490,6 +1490,81 @@ func sampleOperatorGroup() *v1alpha2.OperatorGroup {
 	}
 }
 
+func TestOCMProvider_UpdateMachinePool(t *testing.T) {
+	type fields struct {
+		ocmClient ocm.Client
+	}
+
+	machinePoolRequest := types.MachinePoolRequest{
+		ID:                 "test-machinepool-id",
+		ClusterID:          "test-cluster-id",
+		InstanceSize:       "m5.2xlarge",
+		MultiAZ:            true,
+		AutoScalingEnabled: true,
+		AutoScaling: types.MachinePoolAutoScaling{
+			MinNodes: 2,
+			MaxNodes: 4,
+		},
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		request types.MachinePoolRequest
+		wantErr bool
+	}{
+		{
+			name: "only the autoscaling of the machinepool is updated, rounded up for multi AZ clusters",
+			fields: fields{
+				ocmClient: &ocm.ClientMock{
+					UpdateMachinePoolFunc: func(clusterID string, machinePool *clustersmgmtv1.MachinePool) (*clustersmgmtv1.MachinePool, error) {
+						if clusterID != "test-cluster-id" || machinePool.ID() != "test-machinepool-id" || machinePool.InstanceType() != "" {
+							return nil, fmt.Errorf("test fail: unexpected machinepool update %v", machinePool)
+						}
+						if machinePool.Autoscaling().MinReplicas() != 3 || machinePool.Autoscaling().MaxReplicas() != 6 {
+							return nil, fmt.Errorf("test fail: unexpected autoscaling")
+						}
+						return machinePool, nil
+					},
+				},
+			},
+			request: machinePoolRequest,
+		},
+		{
+			name:   "an error is returned when the machinepool has no autoscaling",
+			fields: fields{ocmClient: &ocm.ClientMock{}},
+			request: func() types.MachinePoolRequest {
+				request := machinePoolRequest
+				request.AutoScalingEnabled = false
+				return request
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "an error is returned when OCM fails to update the machinepool",
+			fields: fields{
+				ocmClient: &ocm.ClientMock{
+					UpdateMachinePoolFunc: func(clusterID string, machinePool *clustersmgmtv1.MachinePool) (*clustersmgmtv1.MachinePool, error) {
+						return nil, fmt.Errorf("test error")
+					},
+				},
+			},
+			request: machinePoolRequest,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			ocmProvider := newOCMProvider(test.fields.ocmClient, nil, &ocm.OCMConfig{})
+			_, err := ocmProvider.UpdateMachinePool(&test.request)
+			g.Expect(err != nil).To(gomega.Equal(test.wantErr))
+		})
+	}
+}
+
 func TestOCMProvider_GetClusterResourceQuotaCosts(t *testing.T) {
 	orgBuilder := accountsmgmtv1.NewOrganization().ID("test-organisation").ExternalID("test-organisation")
 	testAccount, err := accountsmgmtv1.NewAccount().Username("test-user").Organization(orgBuilder).Build()
@@ -1749,3 +1824,146 @@ func TestOCMProvider_CheckIfOrganizationIsTheClusterOwner(t *testing.T) {
 		})
 	}
 }
+
+func TestOCMProvider_ScheduleUpgrade(t *testing.T) {
+	upgradePolicy := func(policy *clustersmgmtv1.UpgradePolicy) *clustersmgmtv1.UpgradePolicy {
+		created, _ := clustersmgmtv1.NewUpgradePolicy().
+			ID("policy-id").
+			Version(policy.Version()).
+			NextRun(policy.NextRun()).
+			Build()
+		return created
+	}
+
+	tests := []struct {
+		name        string
+		nextRun     time.Time
+		createErr   error
+		wantNextRun func(nextRun time.Time) bool
+		wantErr     bool
+	}{
+		{
+			name:    "should create a manual upgrade policy running at the requested time",
+			nextRun: time.Now().Add(time.Hour),
+			wantNextRun: func(nextRun time.Time) bool {
+				return nextRun.After(time.Now().Add(59 * time.Minute))
+			},
+		},
+		{
+			name:    "should postpone the next run to the minimum lead time accepted by OCM",
+			nextRun: time.Now(),
+			wantNextRun: func(nextRun time.Time) bool {
+				return !nextRun.Before(time.Now().Add(ocmUpgradePolicyMinimumLeadTime - time.Minute))
+			},
+		},
+		{
+			name:      "should return an error if the upgrade policy cannot be created",
+			nextRun:   time.Now(),
+			createErr: errors.New("failed to create upgrade policy"),
+			wantErr:   true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			ocmClient := &ocm.ClientMock{
+				CreateUpgradePolicyFunc: func(clusterID string, policy *clustersmgmtv1.UpgradePolicy) (*clustersmgmtv1.UpgradePolicy, error) {
+					if tt.createErr != nil {
+						return nil, tt.createErr
+					}
+					return upgradePolicy(policy), nil
+				},
+			}
+			p := newOCMProvider(ocmClient, nil, &ocm.OCMConfig{})
+			info, err := p.ScheduleUpgrade(&types.ClusterUpgradeRequest{ClusterID: "cluster-id", Version: "4.11.25", NextRun: tt.nextRun})
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				return
+			}
+
+			policy := ocmClient.CreateUpgradePolicyCalls()[0].UpgradePolicy
+			g.Expect(policy.ScheduleType()).To(gomega.Equal(ocmUpgradePolicyScheduleType))
+			g.Expect(policy.UpgradeType()).To(gomega.Equal(ocmUpgradePolicyUpgradeType))
+			g.Expect(tt.wantNextRun(policy.NextRun())).To(gomega.BeTrue())
+			g.Expect(info.ID).To(gomega.Equal("policy-id"))
+			g.Expect(info.Version).To(gomega.Equal("4.11.25"))
+			g.Expect(info.State).To(gomega.Equal(api.ClusterUpgradeScheduled))
+		})
+	}
+}
+
+func TestOCMProvider_GetUpgrade(t *testing.T) {
+	upgradePolicyState := func(value clustersmgmtv1.UpgradePolicyStateValue) *clustersmgmtv1.UpgradePolicyState {
+		state, _ := clustersmgmtv1.NewUpgradePolicyState().Value(value).Description("upgrade description").Build()
+		return state
+	}
+	clusterWithVersion := func(version string) *clustersmgmtv1.Cluster {
+		cluster, _ := clustersmgmtv1.NewCluster().Version(clustersmgmtv1.NewVersion().ID("openshift-v" + version)).Build()
+		return cluster
+	}
+
+	tests := []struct {
+		name      string
+		state     *clustersmgmtv1.UpgradePolicyState
+		stateErr  error
+		cluster   *clustersmgmtv1.Cluster
+		wantState api.ClusterUpgradeStatus
+		wantErr   bool
+	}{
+		{
+			name:      "should return a scheduled upgrade for a pending upgrade policy",
+			state:     upgradePolicyState(clustersmgmtv1.UpgradePolicyStateValuePending),
+			wantState: api.ClusterUpgradeScheduled,
+		},
+		{
+			name:      "should return an upgrade in progress for a started upgrade policy",
+			state:     upgradePolicyState(clustersmgmtv1.UpgradePolicyStateValueStarted),
+			wantState: api.ClusterUpgradeInProgress,
+		},
+		{
+			name:      "should return a failed upgrade for a failed upgrade policy",
+			state:     upgradePolicyState(clustersmgmtv1.UpgradePolicyStateValueFailed),
+			wantState: api.ClusterUpgradeFailed,
+		},
+		{
+			name:      "should return a completed upgrade if the upgrade policy no longer exists and the cluster runs the requested version",
+			cluster:   clusterWithVersion("4.11.25"),
+			wantState: api.ClusterUpgradeCompleted,
+		},
+		{
+			name:      "should return a failed upgrade if the upgrade policy no longer exists and the cluster runs another version",
+			cluster:   clusterWithVersion("4.11.22"),
+			wantState: api.ClusterUpgradeFailed,
+		},
+		{
+			name:     "should return an error if the state of the upgrade policy cannot be retrieved",
+			stateErr: errors.New("failed to get upgrade policy state"),
+			wantErr:  true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			ocmClient := &ocm.ClientMock{
+				GetUpgradePolicyStateFunc: func(clusterID string, upgradePolicyID string) (*clustersmgmtv1.UpgradePolicyState, error) {
+					return tt.state, tt.stateErr
+				},
+				GetClusterFunc: func(clusterID string) (*clustersmgmtv1.Cluster, error) {
+					return tt.cluster, nil
+				},
+			}
+			p := newOCMProvider(ocmClient, nil, &ocm.OCMConfig{})
+			info, err := p.GetUpgrade("cluster-id", "policy-id", "4.11.25")
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				return
+			}
+			g.Expect(info.ID).To(gomega.Equal("policy-id"))
+			g.Expect(info.State).To(gomega.Equal(tt.wantState))
+		})
+	}
+}
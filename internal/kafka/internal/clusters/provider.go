@@ -43,6 +43,16 @@ type Provider interface {
 	InstallKasFleetshard(clusterSpec *types.ClusterSpec, params []types.Parameter) (bool, error)
 	GetMachinePool(clusterID string, id string) (*types.MachinePoolInfo, error)
 	CreateMachinePool(request *types.MachinePoolRequest) (*types.MachinePoolRequest, error)
+	// UpdateMachinePool updates the autoscaling of an existing machine pool. The machine type of a machine pool cannot be changed
+	UpdateMachinePool(request *types.MachinePoolRequest) (*types.MachinePoolRequest, error)
+	// GetClusterVersion returns the OpenShift version of the cluster along with the versions it can be upgraded to
+	GetClusterVersion(clusterID string) (*types.ClusterVersionInfo, error)
+	// ScheduleUpgrade schedules the upgrade of the cluster to the requested OpenShift version
+	ScheduleUpgrade(request *types.ClusterUpgradeRequest) (*types.ClusterUpgradeInfo, error)
+	// GetUpgrade returns the state of a scheduled upgrade of the cluster
+	GetUpgrade(clusterID string, upgradeID string, version string) (*types.ClusterUpgradeInfo, error)
+	// CancelUpgrade cancels a scheduled upgrade of the cluster which has not started yet
+	CancelUpgrade(clusterID string, upgradeID string) error
 	// GetClusterResourceQuotaCosts returns a list of quota cost information related to resources used for the provisioning and
 	// terraforming of data plane clusters for the authenticated user.
 	GetClusterResourceQuotaCosts() ([]types.QuotaCost, error)
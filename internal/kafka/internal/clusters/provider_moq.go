@@ -25,6 +25,9 @@ var _ Provider = &ProviderMock{}
 //			ApplyResourcesFunc: func(clusterSpec *types.ClusterSpec, resources types.ResourceSet) (*types.ResourceSet, error) {
 //				panic("mock out the ApplyResources method")
 //			},
+//			CancelUpgradeFunc: func(clusterID string, upgradeID string) error {
+//				panic("mock out the CancelUpgrade method")
+//			},
 //			CheckClusterStatusFunc: func(spec *types.ClusterSpec) (*types.ClusterSpec, error) {
 //				panic("mock out the CheckClusterStatus method")
 //			},
@@ -55,9 +58,15 @@ var _ Provider = &ProviderMock{}
 //			GetClusterSpecFunc: func(clusterID string) (types.ClusterSpec, error) {
 //				panic("mock out the GetClusterSpec method")
 //			},
+//			GetClusterVersionFunc: func(clusterID string) (*types.ClusterVersionInfo, error) {
+//				panic("mock out the GetClusterVersion method")
+//			},
 //			GetMachinePoolFunc: func(clusterID string, id string) (*types.MachinePoolInfo, error) {
 //				panic("mock out the GetMachinePool method")
 //			},
+//			GetUpgradeFunc: func(clusterID string, upgradeID string, version string) (*types.ClusterUpgradeInfo, error) {
+//				panic("mock out the GetUpgrade method")
+//			},
 //			InstallClusterLoggingFunc: func(clusterSpec *types.ClusterSpec, params []ocm.Parameter) (bool, error) {
 //				panic("mock out the InstallClusterLogging method")
 //			},
@@ -70,6 +79,12 @@ var _ Provider = &ProviderMock{}
 //			RemoveResourcesFunc: func(clusterSpec *types.ClusterSpec, syncSetName string) error {
 //				panic("mock out the RemoveResources method")
 //			},
+//			ScheduleUpgradeFunc: func(request *types.ClusterUpgradeRequest) (*types.ClusterUpgradeInfo, error) {
+//				panic("mock out the ScheduleUpgrade method")
+//			},
+//			UpdateMachinePoolFunc: func(request *types.MachinePoolRequest) (*types.MachinePoolRequest, error) {
+//				panic("mock out the UpdateMachinePool method")
+//			},
 //		}
 //
 //		// use mockedProvider in code that requires Provider
@@ -83,6 +98,9 @@ type ProviderMock struct {
 	// ApplyResourcesFunc mocks the ApplyResources method.
 	ApplyResourcesFunc func(clusterSpec *types.ClusterSpec, resources types.ResourceSet) (*types.ResourceSet, error)
 
+	// CancelUpgradeFunc mocks the CancelUpgrade method.
+	CancelUpgradeFunc func(clusterID string, upgradeID string) error
+
 	// CheckClusterStatusFunc mocks the CheckClusterStatus method.
 	CheckClusterStatusFunc func(spec *types.ClusterSpec) (*types.ClusterSpec, error)
 
@@ -113,9 +131,15 @@ type ProviderMock struct {
 	// GetClusterSpecFunc mocks the GetClusterSpec method.
 	GetClusterSpecFunc func(clusterID string) (types.ClusterSpec, error)
 
+	// GetClusterVersionFunc mocks the GetClusterVersion method.
+	GetClusterVersionFunc func(clusterID string) (*types.ClusterVersionInfo, error)
+
 	// GetMachinePoolFunc mocks the GetMachinePool method.
 	GetMachinePoolFunc func(clusterID string, id string) (*types.MachinePoolInfo, error)
 
+	// GetUpgradeFunc mocks the GetUpgrade method.
+	GetUpgradeFunc func(clusterID string, upgradeID string, version string) (*types.ClusterUpgradeInfo, error)
+
 	// InstallClusterLoggingFunc mocks the InstallClusterLogging method.
 	InstallClusterLoggingFunc func(clusterSpec *types.ClusterSpec, params []ocm.Parameter) (bool, error)
 
@@ -128,6 +152,12 @@ type ProviderMock struct {
 	// RemoveResourcesFunc mocks the RemoveResources method.
 	RemoveResourcesFunc func(clusterSpec *types.ClusterSpec, syncSetName string) error
 
+	// ScheduleUpgradeFunc mocks the ScheduleUpgrade method.
+	ScheduleUpgradeFunc func(request *types.ClusterUpgradeRequest) (*types.ClusterUpgradeInfo, error)
+
+	// UpdateMachinePoolFunc mocks the UpdateMachinePool method.
+	UpdateMachinePoolFunc func(request *types.MachinePoolRequest) (*types.MachinePoolRequest, error)
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// AddIdentityProvider holds details about calls to the AddIdentityProvider method.
@@ -144,6 +174,13 @@ type ProviderMock struct {
 			// Resources is the resources argument value.
 			Resources types.ResourceSet
 		}
+		// CancelUpgrade holds details about calls to the CancelUpgrade method.
+		CancelUpgrade []struct {
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// UpgradeID is the upgradeID argument value.
+			UpgradeID string
+		}
 		// CheckClusterStatus holds details about calls to the CheckClusterStatus method.
 		CheckClusterStatus []struct {
 			// Spec is the spec argument value.
@@ -194,6 +231,11 @@ type ProviderMock struct {
 			// ClusterID is the clusterID argument value.
 			ClusterID string
 		}
+		// GetClusterVersion holds details about calls to the GetClusterVersion method.
+		GetClusterVersion []struct {
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+		}
 		// GetMachinePool holds details about calls to the GetMachinePool method.
 		GetMachinePool []struct {
 			// ClusterID is the clusterID argument value.
@@ -201,6 +243,15 @@ type ProviderMock struct {
 			// ID is the id argument value.
 			ID string
 		}
+		// GetUpgrade holds details about calls to the GetUpgrade method.
+		GetUpgrade []struct {
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// UpgradeID is the upgradeID argument value.
+			UpgradeID string
+			// Version is the version argument value.
+			Version string
+		}
 		// InstallClusterLogging holds details about calls to the InstallClusterLogging method.
 		InstallClusterLogging []struct {
 			// ClusterSpec is the clusterSpec argument value.
@@ -227,9 +278,20 @@ type ProviderMock struct {
 			// SyncSetName is the syncSetName argument value.
 			SyncSetName string
 		}
+		// ScheduleUpgrade holds details about calls to the ScheduleUpgrade method.
+		ScheduleUpgrade []struct {
+			// Request is the request argument value.
+			Request *types.ClusterUpgradeRequest
+		}
+		// UpdateMachinePool holds details about calls to the UpdateMachinePool method.
+		UpdateMachinePool []struct {
+			// Request is the request argument value.
+			Request *types.MachinePoolRequest
+		}
 	}
 	lockAddIdentityProvider                  sync.RWMutex
 	lockApplyResources                       sync.RWMutex
+	lockCancelUpgrade                        sync.RWMutex
 	lockCheckClusterStatus                   sync.RWMutex
 	lockCheckIfOrganizationIsTheClusterOwner sync.RWMutex
 	lockCreate                               sync.RWMutex
@@ -240,11 +302,15 @@ type ProviderMock struct {
 	lockGetClusterDNS                        sync.RWMutex
 	lockGetClusterResourceQuotaCosts         sync.RWMutex
 	lockGetClusterSpec                       sync.RWMutex
+	lockGetClusterVersion                    sync.RWMutex
 	lockGetMachinePool                       sync.RWMutex
+	lockGetUpgrade                           sync.RWMutex
 	lockInstallClusterLogging                sync.RWMutex
 	lockInstallKasFleetshard                 sync.RWMutex
 	lockInstallStrimzi                       sync.RWMutex
 	lockRemoveResources                      sync.RWMutex
+	lockScheduleUpgrade                      sync.RWMutex
+	lockUpdateMachinePool                    sync.RWMutex
 }
 
 // AddIdentityProvider calls AddIdentityProviderFunc.
@@ -319,6 +385,42 @@ func (mock *ProviderMock) ApplyResourcesCalls() []struct {
 	return calls
 }
 
+// CancelUpgrade calls CancelUpgradeFunc.
+func (mock *ProviderMock) CancelUpgrade(clusterID string, upgradeID string) error {
+	if mock.CancelUpgradeFunc == nil {
+		panic("ProviderMock.CancelUpgradeFunc: method is nil but Provider.CancelUpgrade was just called")
+	}
+	callInfo := struct {
+		ClusterID string
+		UpgradeID string
+	}{
+		ClusterID: clusterID,
+		UpgradeID: upgradeID,
+	}
+	mock.lockCancelUpgrade.Lock()
+	mock.calls.CancelUpgrade = append(mock.calls.CancelUpgrade, callInfo)
+	mock.lockCancelUpgrade.Unlock()
+	return mock.CancelUpgradeFunc(clusterID, upgradeID)
+}
+
+// CancelUpgradeCalls gets all the calls that were made to CancelUpgrade.
+// Check the length with:
+//
+//	len(mockedProvider.CancelUpgradeCalls())
+func (mock *ProviderMock) CancelUpgradeCalls() []struct {
+	ClusterID string
+	UpgradeID string
+} {
+	var calls []struct {
+		ClusterID string
+		UpgradeID string
+	}
+	mock.lockCancelUpgrade.RLock()
+	calls = mock.calls.CancelUpgrade
+	mock.lockCancelUpgrade.RUnlock()
+	return calls
+}
+
 // CheckClusterStatus calls CheckClusterStatusFunc.
 func (mock *ProviderMock) CheckClusterStatus(spec *types.ClusterSpec) (*types.ClusterSpec, error) {
 	if mock.CheckClusterStatusFunc == nil {
@@ -637,6 +739,38 @@ func (mock *ProviderMock) GetClusterSpecCalls() []struct {
 	return calls
 }
 
+// GetClusterVersion calls GetClusterVersionFunc.
+func (mock *ProviderMock) GetClusterVersion(clusterID string) (*types.ClusterVersionInfo, error) {
+	if mock.GetClusterVersionFunc == nil {
+		panic("ProviderMock.GetClusterVersionFunc: method is nil but Provider.GetClusterVersion was just called")
+	}
+	callInfo := struct {
+		ClusterID string
+	}{
+		ClusterID: clusterID,
+	}
+	mock.lockGetClusterVersion.Lock()
+	mock.calls.GetClusterVersion = append(mock.calls.GetClusterVersion, callInfo)
+	mock.lockGetClusterVersion.Unlock()
+	return mock.GetClusterVersionFunc(clusterID)
+}
+
+// GetClusterVersionCalls gets all the calls that were made to GetClusterVersion.
+// Check the length with:
+//
+//	len(mockedProvider.GetClusterVersionCalls())
+func (mock *ProviderMock) GetClusterVersionCalls() []struct {
+	ClusterID string
+} {
+	var calls []struct {
+		ClusterID string
+	}
+	mock.lockGetClusterVersion.RLock()
+	calls = mock.calls.GetClusterVersion
+	mock.lockGetClusterVersion.RUnlock()
+	return calls
+}
+
 // GetMachinePool calls GetMachinePoolFunc.
 func (mock *ProviderMock) GetMachinePool(clusterID string, id string) (*types.MachinePoolInfo, error) {
 	if mock.GetMachinePoolFunc == nil {
@@ -673,6 +807,46 @@ func (mock *ProviderMock) GetMachinePoolCalls() []struct {
 	return calls
 }
 
+// GetUpgrade calls GetUpgradeFunc.
+func (mock *ProviderMock) GetUpgrade(clusterID string, upgradeID string, version string) (*types.ClusterUpgradeInfo, error) {
+	if mock.GetUpgradeFunc == nil {
+		panic("ProviderMock.GetUpgradeFunc: method is nil but Provider.GetUpgrade was just called")
+	}
+	callInfo := struct {
+		ClusterID string
+		UpgradeID string
+		Version   string
+	}{
+		ClusterID: clusterID,
+		UpgradeID: upgradeID,
+		Version:   version,
+	}
+	mock.lockGetUpgrade.Lock()
+	mock.calls.GetUpgrade = append(mock.calls.GetUpgrade, callInfo)
+	mock.lockGetUpgrade.Unlock()
+	return mock.GetUpgradeFunc(clusterID, upgradeID, version)
+}
+
+// GetUpgradeCalls gets all the calls that were made to GetUpgrade.
+// Check the length with:
+//
+//	len(mockedProvider.GetUpgradeCalls())
+func (mock *ProviderMock) GetUpgradeCalls() []struct {
+	ClusterID string
+	UpgradeID string
+	Version   string
+} {
+	var calls []struct {
+		ClusterID string
+		UpgradeID string
+		Version   string
+	}
+	mock.lockGetUpgrade.RLock()
+	calls = mock.calls.GetUpgrade
+	mock.lockGetUpgrade.RUnlock()
+	return calls
+}
+
 // InstallClusterLogging calls InstallClusterLoggingFunc.
 func (mock *ProviderMock) InstallClusterLogging(clusterSpec *types.ClusterSpec, params []ocm.Parameter) (bool, error) {
 	if mock.InstallClusterLoggingFunc == nil {
@@ -812,3 +986,67 @@ func (mock *ProviderMock) RemoveResourcesCalls() []struct {
 	mock.lockRemoveResources.RUnlock()
 	return calls
 }
+
+// ScheduleUpgrade calls ScheduleUpgradeFunc.
+func (mock *ProviderMock) ScheduleUpgrade(request *types.ClusterUpgradeRequest) (*types.ClusterUpgradeInfo, error) {
+	if mock.ScheduleUpgradeFunc == nil {
+		panic("ProviderMock.ScheduleUpgradeFunc: method is nil but Provider.ScheduleUpgrade was just called")
+	}
+	callInfo := struct {
+		Request *types.ClusterUpgradeRequest
+	}{
+		Request: request,
+	}
+	mock.lockScheduleUpgrade.Lock()
+	mock.calls.ScheduleUpgrade = append(mock.calls.ScheduleUpgrade, callInfo)
+	mock.lockScheduleUpgrade.Unlock()
+	return mock.ScheduleUpgradeFunc(request)
+}
+
+// ScheduleUpgradeCalls gets all the calls that were made to ScheduleUpgrade.
+// Check the length with:
+//
+//	len(mockedProvider.ScheduleUpgradeCalls())
+func (mock *ProviderMock) ScheduleUpgradeCalls() []struct {
+	Request *types.ClusterUpgradeRequest
+} {
+	var calls []struct {
+		Request *types.ClusterUpgradeRequest
+	}
+	mock.lockScheduleUpgrade.RLock()
+	calls = mock.calls.ScheduleUpgrade
+	mock.lockScheduleUpgrade.RUnlock()
+	return calls
+}
+
+// UpdateMachinePool calls UpdateMachinePoolFunc.
+func (mock *ProviderMock) UpdateMachinePool(request *types.MachinePoolRequest) (*types.MachinePoolRequest, error) {
+	if mock.UpdateMachinePoolFunc == nil {
+		panic("ProviderMock.UpdateMachinePoolFunc: method is nil but Provider.UpdateMachinePool was just called")
+	}
+	callInfo := struct {
+		Request *types.MachinePoolRequest
+	}{
+		Request: request,
+	}
+	mock.lockUpdateMachinePool.Lock()
+	mock.calls.UpdateMachinePool = append(mock.calls.UpdateMachinePool, callInfo)
+	mock.lockUpdateMachinePool.Unlock()
+	return mock.UpdateMachinePoolFunc(request)
+}
+
+// UpdateMachinePoolCalls gets all the calls that were made to UpdateMachinePool.
+// Check the length with:
+//
+//	len(mockedProvider.UpdateMachinePoolCalls())
+func (mock *ProviderMock) UpdateMachinePoolCalls() []struct {
+	Request *types.MachinePoolRequest
+} {
+	var calls []struct {
+		Request *types.MachinePoolRequest
+	}
+	mock.lockUpdateMachinePool.RLock()
+	calls = mock.calls.UpdateMachinePool
+	mock.lockUpdateMachinePool.RUnlock()
+	return calls
+}
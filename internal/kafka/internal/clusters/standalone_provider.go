@@ -13,6 +13,7 @@ import (
 	"github.com/operator-framework/api/pkg/operators/v1alpha1"
 	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	operatorsv1alpha2 "github.com/operator-framework/api/pkg/operators/v1alpha2"
+	"github.com/pkg/errors"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -578,6 +579,30 @@ func (s *StandaloneProvider) CreateMachinePool(request *types.MachinePoolRequest
 	return nil, nil
 }
 
+func (s *StandaloneProvider) UpdateMachinePool(request *types.MachinePoolRequest) (*types.MachinePoolRequest, error) {
+	// TODO implement
+	return nil, nil
+}
+
+// OpenShift upgrades of standalone clusters are performed outside of the fleet manager
+var errStandaloneUpgradeNotSupported = errors.New("OpenShift upgrades are not supported for standalone clusters")
+
+func (s *StandaloneProvider) GetClusterVersion(clusterID string) (*types.ClusterVersionInfo, error) {
+	return nil, errStandaloneUpgradeNotSupported
+}
+
+func (s *StandaloneProvider) ScheduleUpgrade(request *types.ClusterUpgradeRequest) (*types.ClusterUpgradeInfo, error) {
+	return nil, errStandaloneUpgradeNotSupported
+}
+
+func (s *StandaloneProvider) GetUpgrade(clusterID string, upgradeID string, version string) (*types.ClusterUpgradeInfo, error) {
+	return nil, errStandaloneUpgradeNotSupported
+}
+
+func (s *StandaloneProvider) CancelUpgrade(clusterID string, upgradeID string) error {
+	return errStandaloneUpgradeNotSupported
+}
+
 // noop method, it will always return a nil slice as a standalone provider does not have any resource quotas
 func (s *StandaloneProvider) GetClusterResourceQuotaCosts() ([]types.QuotaCost, error) {
 	var quotaCostList []types.QuotaCost
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"encoding/json"
+	"time"
+
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/ocm"
 )
@@ -41,6 +44,54 @@ type ClusterRequest struct {
 	AdditionalSpec api.JSON
 }
 
+// ClusterProviderSpec holds the settings of a data plane cluster that override the data plane cluster configuration.
+// It is stored in the provider spec of clusters managed through a cluster blueprint
+type ClusterProviderSpec struct {
+	// OpenShiftVersion is the version of OpenShift the cluster is installed with
+	OpenShiftVersion string `json:"openshift_version,omitempty"`
+	// ComputeMachineType is the machine type of the nodes running the cluster wide workload
+	ComputeMachineType string `json:"compute_machine_type,omitempty"`
+	// MachinePools overrides the Kafka workload machine pool of the listed instance types
+	MachinePools []MachinePoolSpec `json:"machine_pools,omitempty"`
+	// AddonParameters are added to, or replace, the parameters of the kas-fleetshard operator addon
+	AddonParameters []AddonParameterSpec `json:"addon_parameters,omitempty"`
+}
+
+type AddonParameterSpec struct {
+	ID    string `json:"id"`
+	Value string `json:"value"`
+}
+
+type MachinePoolSpec struct {
+	InstanceType    string `json:"instance_type"`
+	MachineType     string `json:"machine_type"`
+	MinComputeNodes int    `json:"min_compute_nodes"`
+	MaxComputeNodes int    `json:"max_compute_nodes"`
+}
+
+// ParseClusterProviderSpec returns the cluster provider spec stored in the given JSON.
+// An empty spec is returned when no provider spec is stored
+func ParseClusterProviderSpec(providerSpec api.JSON) (*ClusterProviderSpec, error) {
+	spec := &ClusterProviderSpec{}
+	if len(providerSpec) == 0 || string(providerSpec) == "null" {
+		return spec, nil
+	}
+	if err := json.Unmarshal(providerSpec, spec); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+// GetMachinePool returns the machine pool override of the given instance type, if any
+func (s *ClusterProviderSpec) GetMachinePool(instanceType string) (MachinePoolSpec, bool) {
+	for _, machinePool := range s.MachinePools {
+		if machinePool.InstanceType == instanceType {
+			return machinePool, true
+		}
+	}
+	return MachinePoolSpec{}, false
+}
+
 type MachinePoolRequest struct {
 	ID                 string
 	InstanceSize       string
@@ -76,6 +127,28 @@ type ClusterNodeTaint struct {
 	Value  string
 }
 
+// ClusterVersionInfo holds the OpenShift version a cluster runs and the versions it can be upgraded to
+type ClusterVersionInfo struct {
+	Version           string
+	AvailableUpgrades []string
+}
+
+// ClusterUpgradeRequest requests the upgrade of a cluster to an OpenShift version, not before NextRun
+type ClusterUpgradeRequest struct {
+	ClusterID string
+	Version   string
+	NextRun   time.Time
+}
+
+// ClusterUpgradeInfo holds the state of an upgrade of a cluster in the provider
+type ClusterUpgradeInfo struct {
+	ID          string
+	Version     string
+	NextRun     time.Time
+	State       api.ClusterUpgradeStatus
+	Description string
+}
+
 // ClusterSpec Information about the openshift/k8s cluster
 type ClusterSpec struct {
 	// internal id of the cluster. Used when making requests to the provider
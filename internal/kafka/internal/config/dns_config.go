@@ -0,0 +1,123 @@
+package config
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/cloudproviders"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+)
+
+const (
+	Route53DNSProvider     = "route53"
+	GoogleCloudDNSProvider = "google_cloud_dns"
+	AzureDNSProvider       = "azure_dns"
+	InMemoryDNSProvider    = "in_memory"
+
+	defaultAzureDNSCredentialsFilePath = "secrets/azure.dns-credentials"
+)
+
+var validDNSProviders = []string{Route53DNSProvider, GoogleCloudDNSProvider, AzureDNSProvider, InMemoryDNSProvider}
+
+// DNSConfig configures the DNS providers managing the records of the Kafka routes and of the ACME DNS-01 challenges
+// of the Kafka TLS certificates
+type DNSConfig struct {
+	// DefaultProvider is the DNS provider of the cloud providers without a provider of their own. It is also the
+	// provider solving the ACME DNS-01 challenges
+	DefaultProvider string
+	// CloudProviderDNSProviders maps a cloud provider to the DNS provider managing the records of its Kafka instances
+	CloudProviderDNSProviders   map[string]string
+	AzureDNSCredentials         AzureDNSCredentials
+	azureDNSCredentialsFilePath string
+}
+
+type AzureDNSCredentials struct {
+	TenantID       string `json:"tenant_id" validate:"required"`
+	ClientID       string `json:"client_id" validate:"required"`
+	ClientSecret   string `json:"client_secret" validate:"required"`
+	SubscriptionID string `json:"subscription_id" validate:"required"`
+	ResourceGroup  string `json:"resource_group" validate:"required"`
+}
+
+func NewDNSConfig() *DNSConfig {
+	return &DNSConfig{
+		DefaultProvider:             Route53DNSProvider,
+		CloudProviderDNSProviders:   map[string]string{},
+		azureDNSCredentialsFilePath: defaultAzureDNSCredentialsFilePath,
+	}
+}
+
+func (c *DNSConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&c.DefaultProvider, "dns-provider", c.DefaultProvider, fmt.Sprintf("The DNS provider of the Kafka routes and of the ACME DNS-01 challenges. Supported values are %v", validDNSProviders))
+	fs.StringToStringVar(&c.CloudProviderDNSProviders, "cloud-provider-dns-providers", c.CloudProviderDNSProviders, "The DNS provider of the Kafka routes per cloud provider, overriding the default DNS provider. For example: 'gcp=google_cloud_dns,azure=azure_dns'")
+	fs.StringVar(&c.azureDNSCredentialsFilePath, "azure-dns-credentials-file", c.azureDNSCredentialsFilePath, "Path to a file containing the Azure service principal credentials used to manage Azure DNS zones in JSON format")
+}
+
+func (c *DNSConfig) ReadFiles() error {
+	err := shared.ReadJSONFile(c.azureDNSCredentialsFilePath, &c.AzureDNSCredentials)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error reading file %q: %v", c.azureDNSCredentialsFilePath, err)
+	}
+	return nil
+}
+
+func (c *DNSConfig) Validate(env *environments.Env) error {
+	var gcpConfig *GCPConfig
+	env.MustResolve(&gcpConfig)
+
+	return c.validate(gcpConfig.GCPCredentials)
+}
+
+func (c *DNSConfig) validate(gcpCredentials GCPCredentials) error {
+	knownCloudProviders := cloudproviders.KnownCloudProviders()
+	for cloudProvider := range c.CloudProviderDNSProviders {
+		if !knownCloudProviders.Contains(cloudproviders.ParseCloudProviderID(cloudProvider)) {
+			return fmt.Errorf("invalid cloud provider %q supplied in the DNS providers per cloud provider", cloudProvider)
+		}
+	}
+
+	usedProviders := c.UsedProviders()
+	for _, provider := range usedProviders {
+		if !arrays.Contains(validDNSProviders, provider) {
+			return fmt.Errorf("invalid DNS provider %q supplied. Valid DNS providers are %v", provider, validDNSProviders)
+		}
+	}
+
+	validate := validator.New()
+	if arrays.Contains(usedProviders, GoogleCloudDNSProvider) {
+		if err := validate.Struct(gcpCredentials); err != nil {
+			return errors.Wrap(err, "error validating the GCP API credentials used by the Google Cloud DNS provider")
+		}
+	}
+	if arrays.Contains(usedProviders, AzureDNSProvider) {
+		if err := validate.Struct(c.AzureDNSCredentials); err != nil {
+			return errors.Wrap(err, "error validating the Azure DNS credentials")
+		}
+	}
+
+	return nil
+}
+
+// GetProvider returns the DNS provider managing the records of the Kafka instances of the given cloud provider
+func (c *DNSConfig) GetProvider(cloudProvider string) string {
+	if provider, ok := c.CloudProviderDNSProviders[cloudProvider]; ok {
+		return provider
+	}
+	return c.DefaultProvider
+}
+
+// UsedProviders returns the distinct DNS providers in use, the default one first
+func (c *DNSConfig) UsedProviders() []string {
+	usedProviders := []string{c.DefaultProvider}
+	for _, provider := range c.CloudProviderDNSProviders {
+		if !arrays.Contains(usedProviders, provider) {
+			usedProviders = append(usedProviders, provider)
+		}
+	}
+	return usedProviders
+}
@@ -0,0 +1,165 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/onsi/gomega"
+)
+
+func Test_DNSConfig_ReadFiles(t *testing.T) {
+	testTempFilePrefix := "test_dnsconfig_readfiles"
+
+	tests := []struct {
+		name                string
+		fileContent         string
+		wantAzureCredential AzureDNSCredentials
+		wantErr             bool
+	}{
+		{
+			name:        "should read the Azure DNS credentials",
+			fileContent: `{"tenant_id": "tenant", "client_id": "client", "client_secret": "secret", "subscription_id": "subscription", "resource_group": "group"}`,
+			wantAzureCredential: AzureDNSCredentials{
+				TenantID:       "tenant",
+				ClientID:       "client",
+				ClientSecret:   "secret",
+				SubscriptionID: "subscription",
+				ResourceGroup:  "group",
+			},
+		},
+		{
+			name: "should not return an error when the file does not exist",
+		},
+		{
+			name:        "should return an error when the file is not a valid JSON",
+			fileContent: "anincorrect: j son",
+			wantErr:     true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			dnsConfig := NewDNSConfig()
+			dnsConfig.azureDNSCredentialsFilePath = "unexistingfilename"
+			if tt.fileContent != "" {
+				file, err := shared.CreateTempFileFromStringData(testTempFilePrefix, tt.fileContent)
+				if err != nil {
+					panic(fmt.Errorf("test error: %v", err))
+				}
+				// cleanup of test temporary files
+				defer os.Remove(file)
+				dnsConfig.azureDNSCredentialsFilePath = file
+			}
+
+			err := dnsConfig.ReadFiles()
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(dnsConfig.AzureDNSCredentials).To(gomega.Equal(tt.wantAzureCredential))
+		})
+	}
+}
+
+func Test_DNSConfig_validate(t *testing.T) {
+	validGCPCredentials := GCPCredentials{
+		AuthProviderX509CertURL: "https://www.googleapis.com/oauth2/v1/certs",
+		AuthURI:                 "https://accounts.google.com/o/oauth2/auth",
+		ClientEmail:             "fleet-manager@project.iam.gserviceaccount.com",
+		ClientID:                "client-id",
+		ClientX509CertURL:       "https://www.googleapis.com/robot/v1/metadata/x509/fleet-manager",
+		PrivateKey:              "private-key",
+		PrivateKeyID:            "private-key-id",
+		ProjectID:               "project",
+		TokenURI:                "https://oauth2.googleapis.com/token",
+		Type:                    "service_account",
+	}
+
+	tests := []struct {
+		name           string
+		dnsConfig      *DNSConfig
+		gcpCredentials GCPCredentials
+		wantErr        bool
+	}{
+		{
+			name:      "should succeed with the default configuration",
+			dnsConfig: NewDNSConfig(),
+		},
+		{
+			name: "should succeed when the credentials of the providers in use are valid",
+			dnsConfig: &DNSConfig{
+				DefaultProvider: Route53DNSProvider,
+				CloudProviderDNSProviders: map[string]string{
+					"gcp":   GoogleCloudDNSProvider,
+					"azure": AzureDNSProvider,
+				},
+				AzureDNSCredentials: AzureDNSCredentials{
+					TenantID:       "tenant",
+					ClientID:       "client",
+					ClientSecret:   "secret",
+					SubscriptionID: "subscription",
+					ResourceGroup:  "group",
+				},
+			},
+			gcpCredentials: validGCPCredentials,
+		},
+		{
+			name:      "should fail on an invalid default provider",
+			dnsConfig: &DNSConfig{DefaultProvider: "cloudflare"},
+			wantErr:   true,
+		},
+		{
+			name: "should fail on an invalid provider of a cloud provider",
+			dnsConfig: &DNSConfig{
+				DefaultProvider:           Route53DNSProvider,
+				CloudProviderDNSProviders: map[string]string{"gcp": "cloudflare"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "should fail on an unknown cloud provider",
+			dnsConfig: &DNSConfig{
+				DefaultProvider:           Route53DNSProvider,
+				CloudProviderDNSProviders: map[string]string{"ibm": InMemoryDNSProvider},
+			},
+			wantErr: true,
+		},
+		{
+			name: "should fail when Google Cloud DNS is used without GCP credentials",
+			dnsConfig: &DNSConfig{
+				DefaultProvider:           Route53DNSProvider,
+				CloudProviderDNSProviders: map[string]string{"gcp": GoogleCloudDNSProvider},
+			},
+			wantErr: true,
+		},
+		{
+			name:      "should fail when Azure DNS is used without Azure DNS credentials",
+			dnsConfig: &DNSConfig{DefaultProvider: AzureDNSProvider},
+			wantErr:   true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			err := tt.dnsConfig.validate(tt.gcpCredentials)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+		})
+	}
+}
+
+func Test_DNSConfig_GetProvider(t *testing.T) {
+	g := gomega.NewWithT(t)
+	dnsConfig := &DNSConfig{
+		DefaultProvider:           Route53DNSProvider,
+		CloudProviderDNSProviders: map[string]string{"gcp": GoogleCloudDNSProvider},
+	}
+
+	g.Expect(dnsConfig.GetProvider("gcp")).To(gomega.Equal(GoogleCloudDNSProvider))
+	g.Expect(dnsConfig.GetProvider("aws")).To(gomega.Equal(Route53DNSProvider))
+	g.Expect(dnsConfig.UsedProviders()).To(gomega.Equal([]string{Route53DNSProvider, GoogleCloudDNSProvider}))
+}
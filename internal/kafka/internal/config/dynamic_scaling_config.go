@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/cloudproviders"
 	"github.com/pkg/errors"
@@ -15,6 +16,12 @@ type DynamicScalingConfig struct {
 	EnableDynamicScaleUpManagerScaleUpTrigger     bool                                                     `yaml:"enable_dynamic_data_plane_scale_up"`
 	EnableDynamicScaleDownManagerScaleDownTrigger bool                                                     `yaml:"enable_dynamic_data_plane_scale_down"`
 	NewDataPlaneOpenShiftVersion                  string                                                   `yaml:"new_data_plane_openshift_version"`
+	EnableDynamicDataPlaneConsolidation           bool                                                     `yaml:"enable_dynamic_data_plane_consolidation"`
+	ConsolidationMaxUtilizationPercentage         int                                                      `yaml:"consolidation_max_utilization_percentage" validate:"gte=0,lte=100"`
+	ConsolidationDrainTimeoutHours                int                                                      `yaml:"consolidation_drain_timeout_hours" validate:"gt=0"`
+	EnablePredictiveScaleUp                       bool                                                     `yaml:"enable_predictive_data_plane_scale_up"`
+	PredictiveScaleUpHorizonMinutes               int                                                      `yaml:"predictive_scale_up_horizon_minutes" validate:"gt=0"`
+	PredictiveScaleUpLookbackHours                int                                                      `yaml:"predictive_scale_up_lookback_hours" validate:"gt=0"`
 }
 
 func NewDynamicScalingConfig() DynamicScalingConfig {
@@ -25,7 +32,18 @@ func NewDynamicScalingConfig() DynamicScalingConfig {
 		EnableDynamicScaleDownManagerScaleDownTrigger: true,
 		// Provision new data plane cluster with the latest version of openshift 4.11 available on ocm
 		// This should only be bumped to the next minor version once testing on that version has been completed.
-		NewDataPlaneOpenShiftVersion: "openshift-v4.11.36",
+		NewDataPlaneOpenShiftVersion:          "openshift-v4.11.36",
+		EnableDynamicDataPlaneConsolidation:   false,
+		ConsolidationMaxUtilizationPercentage: 25,
+		// Kafka instances provisioned in the data plane are not moved, so a cluster is only emptied once they are deleted.
+		// A week leaves time for the short lived instances to go away without keeping the capacity of the cluster unused for too long.
+		ConsolidationDrainTimeoutHours: 168,
+		EnablePredictiveScaleUp:        false,
+		// Provisioning a new data plane cluster takes a bit more than 40 minutes, so the forecast
+		// needs to look at least that far ahead to have the cluster ready before it is needed.
+		PredictiveScaleUpHorizonMinutes: 60,
+		// A week of history covers the daily and weekly variations of the creation rate
+		PredictiveScaleUpLookbackHours: 168,
 	}
 }
 
@@ -37,6 +55,30 @@ func (c *DynamicScalingConfig) IsDataplaneScaleDownTriggerEnabled() bool {
 	return c.EnableDynamicScaleDownManagerScaleDownTrigger
 }
 
+func (c *DynamicScalingConfig) IsDataplaneConsolidationEnabled() bool {
+	return c.EnableDynamicDataPlaneConsolidation
+}
+
+// ConsolidationDrainTimeout returns how long the cluster of an approved consolidation plan is excluded from the placement
+// of new Kafka instances before the plan expires
+func (c *DynamicScalingConfig) ConsolidationDrainTimeout() time.Duration {
+	return time.Duration(c.ConsolidationDrainTimeoutHours) * time.Hour
+}
+
+func (c *DynamicScalingConfig) IsPredictiveScaleUpEnabled() bool {
+	return c.EnablePredictiveScaleUp
+}
+
+// PredictiveScaleUpHorizon returns how far ahead the Kafka creations are forecasted
+func (c *DynamicScalingConfig) PredictiveScaleUpHorizon() time.Duration {
+	return time.Duration(c.PredictiveScaleUpHorizonMinutes) * time.Minute
+}
+
+// PredictiveScaleUpLookback returns how much Kafka creation history the forecast is based on
+func (c *DynamicScalingConfig) PredictiveScaleUpLookback() time.Duration {
+	return time.Duration(c.PredictiveScaleUpLookbackHours) * time.Hour
+}
+
 func (c *DynamicScalingConfig) validate() error {
 	err := validate.Struct(c)
 	if err != nil {
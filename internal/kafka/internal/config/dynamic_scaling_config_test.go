@@ -423,7 +423,11 @@ func TestComputeMachinesConfig_GetKafkaWorkloadConfigForInstanceType(t *testing.
 func TestDynamicScalingConfig_validate(t *testing.T) {
 	t.Parallel()
 	type fields struct {
-		ComputeMachinePerCloudProvider map[cloudproviders.CloudProviderID]ComputeMachinesConfig
+		ComputeMachinePerCloudProvider        map[cloudproviders.CloudProviderID]ComputeMachinesConfig
+		ConsolidationMaxUtilizationPercentage int
+		ConsolidationDrainTimeoutHours        int
+		PredictiveScaleUpHorizonMinutes       int
+		PredictiveScaleUpLookbackHours        int
 	}
 	tests := []struct {
 		name    string
@@ -463,6 +467,37 @@ func TestDynamicScalingConfig_validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "return an error when the consolidation max utilization percentage is greater than 100",
+			fields: fields{
+				ComputeMachinePerCloudProvider:        map[cloudproviders.CloudProviderID]ComputeMachinesConfig{},
+				ConsolidationMaxUtilizationPercentage: 150,
+				ConsolidationDrainTimeoutHours:        168,
+				PredictiveScaleUpHorizonMinutes:       60,
+				PredictiveScaleUpLookbackHours:        168,
+			},
+			wantErr: true,
+		},
+		{
+			name: "return an error when the consolidation drain timeout is not set",
+			fields: fields{
+				ComputeMachinePerCloudProvider:  map[cloudproviders.CloudProviderID]ComputeMachinesConfig{},
+				ConsolidationDrainTimeoutHours:  0,
+				PredictiveScaleUpHorizonMinutes: 60,
+				PredictiveScaleUpLookbackHours:  168,
+			},
+			wantErr: true,
+		},
+		{
+			name: "return an error when the predictive scale up horizon is not set",
+			fields: fields{
+				ComputeMachinePerCloudProvider:  map[cloudproviders.CloudProviderID]ComputeMachinesConfig{},
+				ConsolidationDrainTimeoutHours:  168,
+				PredictiveScaleUpHorizonMinutes: 0,
+				PredictiveScaleUpLookbackHours:  168,
+			},
+			wantErr: true,
+		},
 		{
 			name: "should not return an error when the configuration is valid",
 			fields: fields{
@@ -486,6 +521,9 @@ func TestDynamicScalingConfig_validate(t *testing.T) {
 						},
 					},
 				},
+				ConsolidationDrainTimeoutHours:  168,
+				PredictiveScaleUpHorizonMinutes: 60,
+				PredictiveScaleUpLookbackHours:  168,
 			},
 			wantErr: false,
 		},
@@ -496,7 +534,11 @@ func TestDynamicScalingConfig_validate(t *testing.T) {
 			t.Parallel()
 			g := gomega.NewWithT(t)
 			c := &DynamicScalingConfig{
-				ComputeMachinePerCloudProvider: testcase.fields.ComputeMachinePerCloudProvider,
+				ComputeMachinePerCloudProvider:        testcase.fields.ComputeMachinePerCloudProvider,
+				ConsolidationMaxUtilizationPercentage: testcase.fields.ConsolidationMaxUtilizationPercentage,
+				ConsolidationDrainTimeoutHours:        testcase.fields.ConsolidationDrainTimeoutHours,
+				PredictiveScaleUpHorizonMinutes:       testcase.fields.PredictiveScaleUpHorizonMinutes,
+				PredictiveScaleUpLookbackHours:        testcase.fields.PredictiveScaleUpLookbackHours,
 			}
 			err := c.validate()
 			g.Expect(err != nil).To(gomega.Equal(testcase.wantErr))
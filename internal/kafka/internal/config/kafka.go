@@ -16,9 +16,12 @@ type KafkaConfig struct {
 
 	Quota                  *KafkaQuotaConfig
 	SupportedInstanceTypes *KafkaSupportedInstanceTypesConfig
+	ConfigOverrides        *KafkaConfigOverridesConfig
 	EnableKafkaOwnerConfig bool
 	KafkaOwnerList         []string
 	KafkaOwnerListFile     string
+	// EnablePrivateConnectivity allows the Kafka instances to be requested with a private endpoint
+	EnablePrivateConnectivity bool
 }
 
 func NewKafkaConfig() *KafkaConfig {
@@ -28,6 +31,7 @@ func NewKafkaConfig() *KafkaConfig {
 		KafkaDomainName:              "kafka.bf2.dev",
 		Quota:                        NewKafkaQuotaConfig(),
 		SupportedInstanceTypes:       NewKafkaSupportedInstanceTypesConfig(),
+		ConfigOverrides:              NewKafkaConfigOverridesConfig(),
 		KafkaOwnerListFile:           "config/kafka-owner-list.yaml",
 		BrowserUrl:                   "http://localhost:8080/",
 	}
@@ -39,9 +43,11 @@ func (c *KafkaConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&c.Quota.Type, "quota-type", c.Quota.Type, "The type of the quota service to be used. The available options are: 'ams' for AMS backed implementation and 'quota-management-list' for quota list backed implementation (default).")
 	fs.BoolVar(&c.Quota.AllowDeveloperInstance, "allow-developer-instance", c.Quota.AllowDeveloperInstance, "Allow the creation of kafka developer instances")
 	fs.StringVar(&c.SupportedInstanceTypes.ConfigurationFile, "supported-kafka-instance-types-config-file", c.SupportedInstanceTypes.ConfigurationFile, "File containing the supported instance types configuration")
+	fs.StringVar(&c.ConfigOverrides.ConfigurationFile, "kafka-config-overrides-config-file", c.ConfigOverrides.ConfigurationFile, "File containing the Kafka broker configuration keys that users are allowed to override and their bounds")
 	fs.StringVar(&c.BrowserUrl, "browser-url", c.BrowserUrl, "Browser url to kafka admin UI")
 	fs.BoolVar(&c.EnableKafkaOwnerConfig, "enable-kafka-owner-config", c.EnableKafkaOwnerConfig, "Enable configuration for setting kafka owners")
 	fs.StringVar(&c.KafkaOwnerListFile, "kafka-owner-list-file", c.KafkaOwnerListFile, "File containing list of kafka owners")
+	fs.BoolVar(&c.EnablePrivateConnectivity, "enable-kafka-private-connectivity", c.EnablePrivateConnectivity, "Allow Kafka instances to be reached through a private endpoint service instead of public routes")
 	fs.IntVar(&c.Quota.MaxAllowedDeveloperInstances, "max-allowed-developer-instances", c.Quota.MaxAllowedDeveloperInstances, "As a user, one can create up to N defined max developer instances if they do not have quota to create standard instances")
 }
 
@@ -51,6 +57,11 @@ func (c *KafkaConfig) ReadFiles() error {
 		return err
 	}
 
+	err = shared.ReadYamlFile(c.ConfigOverrides.ConfigurationFile, &c.ConfigOverrides.Configuration)
+	if err != nil {
+		return err
+	}
+
 	if c.EnableKafkaOwnerConfig {
 		err = shared.ReadYamlFile(c.KafkaOwnerListFile, &c.KafkaOwnerList)
 		if err != nil {
@@ -62,7 +73,10 @@ func (c *KafkaConfig) ReadFiles() error {
 }
 
 func (c *KafkaConfig) Validate(env *environments.Env) error {
-	return c.SupportedInstanceTypes.Configuration.validate()
+	if err := c.SupportedInstanceTypes.Configuration.validate(); err != nil {
+		return err
+	}
+	return c.ConfigOverrides.validate()
 }
 
 func (c *KafkaConfig) GetFirstAvailableSize(instanceType string) (*KafkaInstanceSize, error) {
@@ -84,6 +98,15 @@ func (c *KafkaConfig) GetKafkaInstanceSize(instanceType, sizeId string) (*KafkaI
 	return kafkaInstanceType.GetKafkaInstanceSizeByID(sizeId)
 }
 
+// GetKafkaInstanceSizeForOrganisation returns the Kafka instance size only if it is available to the given organisation
+func (c *KafkaConfig) GetKafkaInstanceSizeForOrganisation(instanceType, sizeId, organisationID string) (*KafkaInstanceSize, error) {
+	kafkaInstanceType, err := c.SupportedInstanceTypes.Configuration.GetKafkaInstanceTypeByID(instanceType)
+	if err != nil {
+		return nil, err
+	}
+	return kafkaInstanceType.GetKafkaInstanceSizeByIDForOrganisation(sizeId, organisationID)
+}
+
 func (c *KafkaConfig) GetBillingModels(instanceType string) ([]KafkaBillingModel, error) {
 	kafkaInstanceType, err := c.SupportedInstanceTypes.Configuration.GetKafkaInstanceTypeByID(instanceType)
 	if err != nil {
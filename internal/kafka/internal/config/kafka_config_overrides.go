@@ -0,0 +1,142 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
+)
+
+type KafkaConfigOverrideType string
+
+const (
+	KafkaConfigOverrideTypeBoolean KafkaConfigOverrideType = "boolean"
+	KafkaConfigOverrideTypeInteger KafkaConfigOverrideType = "integer"
+	KafkaConfigOverrideTypeString  KafkaConfigOverrideType = "string"
+)
+
+func getValidKafkaConfigOverrideTypes() []KafkaConfigOverrideType {
+	return []KafkaConfigOverrideType{KafkaConfigOverrideTypeBoolean, KafkaConfigOverrideTypeInteger, KafkaConfigOverrideTypeString}
+}
+
+// KafkaConfigOverride defines a Kafka broker configuration key that users are allowed to set on their Kafka instances
+// together with the bounds its value has to respect
+type KafkaConfigOverride struct {
+	Key  string                  `yaml:"key"`
+	Type KafkaConfigOverrideType `yaml:"type"`
+	// Min and Max are the inclusive bounds of the value. They are only used for 'integer' keys
+	Min *int64 `yaml:"min"`
+	Max *int64 `yaml:"max"`
+	// AllowedValues is the list of accepted values. It is only used for 'string' keys. Any value is accepted when empty
+	AllowedValues []string `yaml:"allowed_values"`
+}
+
+// validates the kafka config override to ensure the following:
+// - key must be defined
+// - type must be one of 'boolean', 'integer' or 'string'
+// - min must not be greater than max
+func (o *KafkaConfigOverride) validate() error {
+	if shared.StringEmpty(o.Key) {
+		return fmt.Errorf("kafka config override is missing the 'key' parameter")
+	}
+
+	if !arrays.Contains(getValidKafkaConfigOverrideTypes(), o.Type) {
+		return fmt.Errorf("type '%s' of kafka config override '%s' is invalid. Valid types are: '%v'", o.Type, o.Key, getValidKafkaConfigOverrideTypes())
+	}
+
+	if o.Min != nil && o.Max != nil && *o.Min > *o.Max {
+		return fmt.Errorf("kafka config override '%s' specifies a min value greater than its max value", o.Key)
+	}
+
+	return nil
+}
+
+// ValidateValue checks that the given value can be used for the kafka config override key
+func (o *KafkaConfigOverride) ValidateValue(value string) error {
+	switch o.Type {
+	case KafkaConfigOverrideTypeBoolean:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("value %q of kafka config %q must be a boolean", value, o.Key)
+		}
+	case KafkaConfigOverrideTypeInteger:
+		v, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("value %q of kafka config %q must be an integer", value, o.Key)
+		}
+		if o.Min != nil && v < *o.Min {
+			return fmt.Errorf("value %q of kafka config %q must be greater than or equal to %d", value, o.Key, *o.Min)
+		}
+		if o.Max != nil && v > *o.Max {
+			return fmt.Errorf("value %q of kafka config %q must be less than or equal to %d", value, o.Key, *o.Max)
+		}
+	case KafkaConfigOverrideTypeString:
+		if len(o.AllowedValues) > 0 && !arrays.Contains(o.AllowedValues, value) {
+			return fmt.Errorf("value %q of kafka config %q is not allowed. Allowed values are: '%v'", value, o.Key, o.AllowedValues)
+		}
+	}
+
+	return nil
+}
+
+type KafkaConfigOverrides struct {
+	AllowedKafkaConfig []KafkaConfigOverride `yaml:"allowed_kafka_config"`
+}
+
+type KafkaConfigOverridesConfig struct {
+	Configuration     KafkaConfigOverrides
+	ConfigurationFile string
+}
+
+func NewKafkaConfigOverridesConfig() *KafkaConfigOverridesConfig {
+	return &KafkaConfigOverridesConfig{
+		ConfigurationFile: "config/kafka-config-overrides-configuration.yaml",
+	}
+}
+
+// GetAllowedKafkaConfigOverride returns the kafka config override definition of the given key, if it is allow-listed
+func (c *KafkaConfigOverridesConfig) GetAllowedKafkaConfigOverride(key string) (*KafkaConfigOverride, bool) {
+	if idx, override := arrays.FindFirst(c.Configuration.AllowedKafkaConfig, func(o KafkaConfigOverride) bool { return o.Key == key }); idx != -1 {
+		return &override, true
+	}
+	return nil, false
+}
+
+// ValidateKafkaConfig checks that each of the given kafka config keys is allow-listed and that its value respects the configured bounds
+func (c *KafkaConfigOverridesConfig) ValidateKafkaConfig(kafkaConfig map[string]string) error {
+	keys := make([]string, 0, len(kafkaConfig))
+	for key := range kafkaConfig {
+		keys = append(keys, key)
+	}
+	// sort the keys so that the reported error is deterministic
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		override, ok := c.GetAllowedKafkaConfigOverride(key)
+		if !ok {
+			return fmt.Errorf("kafka config %q is not supported", key)
+		}
+		if err := override.ValidateValue(kafkaConfig[key]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *KafkaConfigOverridesConfig) validate() error {
+	existingKeys := make(map[string]struct{}, len(c.Configuration.AllowedKafkaConfig))
+	for _, override := range c.Configuration.AllowedKafkaConfig {
+		if _, ok := existingKeys[override.Key]; ok {
+			return fmt.Errorf("kafka config override '%s' was defined more than once", override.Key)
+		}
+		existingKeys[override.Key] = struct{}{}
+
+		if err := override.validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+func buildTestKafkaConfigOverridesConfig() *KafkaConfigOverridesConfig {
+	return &KafkaConfigOverridesConfig{
+		Configuration: KafkaConfigOverrides{
+			AllowedKafkaConfig: []KafkaConfigOverride{
+				{Key: "auto.create.topics.enable", Type: KafkaConfigOverrideTypeBoolean},
+				{Key: "message.max.bytes", Type: KafkaConfigOverrideTypeInteger, Min: &[]int64{1}[0], Max: &[]int64{1048588}[0]},
+				{Key: "compression.type", Type: KafkaConfigOverrideTypeString, AllowedValues: []string{"producer", "gzip"}},
+				{Key: "some.string", Type: KafkaConfigOverrideTypeString},
+			},
+		},
+	}
+}
+
+func TestKafkaConfigOverridesConfig_ValidateKafkaConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		kafkaConfig map[string]string
+		wantErr     bool
+	}{
+		{
+			name:        "should not return an error when kafka config is empty",
+			kafkaConfig: map[string]string{},
+			wantErr:     false,
+		},
+		{
+			name: "should not return an error when all keys are allowed and within bounds",
+			kafkaConfig: map[string]string{
+				"auto.create.topics.enable": "true",
+				"message.max.bytes":         "1048588",
+				"compression.type":          "gzip",
+				"some.string":               "any-value",
+			},
+			wantErr: false,
+		},
+		{
+			name:        "should return an error when a key is not allowed",
+			kafkaConfig: map[string]string{"unclean.leader.election.enable": "true"},
+			wantErr:     true,
+		},
+		{
+			name:        "should return an error when a boolean value is not a boolean",
+			kafkaConfig: map[string]string{"auto.create.topics.enable": "yes please"},
+			wantErr:     true,
+		},
+		{
+			name:        "should return an error when an integer value is not an integer",
+			kafkaConfig: map[string]string{"message.max.bytes": "1Mi"},
+			wantErr:     true,
+		},
+		{
+			name:        "should return an error when an integer value is lower than min",
+			kafkaConfig: map[string]string{"message.max.bytes": "0"},
+			wantErr:     true,
+		},
+		{
+			name:        "should return an error when an integer value is greater than max",
+			kafkaConfig: map[string]string{"message.max.bytes": "1048589"},
+			wantErr:     true,
+		},
+		{
+			name:        "should return an error when a string value is not one of the allowed values",
+			kafkaConfig: map[string]string{"compression.type": "brotli"},
+			wantErr:     true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			err := buildTestKafkaConfigOverridesConfig().ValidateKafkaConfig(tt.kafkaConfig)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+		})
+	}
+}
+
+func TestKafkaConfigOverridesConfig_validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		modifyFn func(c *KafkaConfigOverridesConfig)
+		wantErr  bool
+	}{
+		{
+			name:    "should not return an error with valid configuration",
+			wantErr: false,
+		},
+		{
+			name: "should not return an error with empty configuration",
+			modifyFn: func(c *KafkaConfigOverridesConfig) {
+				c.Configuration.AllowedKafkaConfig = nil
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return an error when a key is defined more than once",
+			modifyFn: func(c *KafkaConfigOverridesConfig) {
+				c.Configuration.AllowedKafkaConfig = append(c.Configuration.AllowedKafkaConfig, KafkaConfigOverride{Key: "auto.create.topics.enable", Type: KafkaConfigOverrideTypeBoolean})
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when a key is empty",
+			modifyFn: func(c *KafkaConfigOverridesConfig) {
+				c.Configuration.AllowedKafkaConfig[0].Key = ""
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when a type is invalid",
+			modifyFn: func(c *KafkaConfigOverridesConfig) {
+				c.Configuration.AllowedKafkaConfig[0].Type = "float"
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when min is greater than max",
+			modifyFn: func(c *KafkaConfigOverridesConfig) {
+				c.Configuration.AllowedKafkaConfig[1].Min = &[]int64{10}[0]
+				c.Configuration.AllowedKafkaConfig[1].Max = &[]int64{1}[0]
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			c := buildTestKafkaConfigOverridesConfig()
+			if tt.modifyFn != nil {
+				tt.modifyFn(c)
+			}
+			g.Expect(c.validate() != nil).To(gomega.Equal(tt.wantErr))
+		})
+	}
+}
@@ -30,8 +30,20 @@ type KafkaInstanceType struct {
 	DisplayName            string              `yaml:"display_name"`
 	Sizes                  []KafkaInstanceSize `yaml:"sizes"`
 	SupportedBillingModels []KafkaBillingModel `yaml:"supported_billing_models" validate:"min=1,unique=ID,dive"`
+	// OrganisationSizes contains Kafka instance sizes that are only available to the given organisations.
+	// They are appended to Sizes when listing the sizes available to one of those organisations.
+	OrganisationSizes []KafkaOrganisationInstanceSizes `yaml:"organisation_sizes"`
 }
 
+// KafkaOrganisationInstanceSizes defines a list of Kafka instance sizes scoped to a single organisation
+type KafkaOrganisationInstanceSizes struct {
+	OrganisationID string              `yaml:"organisation_id"`
+	Sizes          []KafkaInstanceSize `yaml:"sizes"`
+}
+
+// GetKafkaInstanceSizeByID returns the Kafka instance size with the given id.
+// Both the global sizes and the organisation scoped sizes are searched as existing Kafka instances
+// need to be able to resolve their size independently of who is performing the lookup.
 func (kp *KafkaInstanceType) GetKafkaInstanceSizeByID(sizeId string) (*KafkaInstanceSize, error) {
 	for _, size := range kp.Sizes {
 		if size.Id == sizeId {
@@ -39,9 +51,47 @@ func (kp *KafkaInstanceType) GetKafkaInstanceSizeByID(sizeId string) (*KafkaInst
 			return &ret, nil
 		}
 	}
+	for _, orgSizes := range kp.OrganisationSizes {
+		for _, size := range orgSizes.Sizes {
+			if size.Id == sizeId {
+				ret := size
+				return &ret, nil
+			}
+		}
+	}
 	return nil, fmt.Errorf("kafka instance size id: '%s' not found for '%s' instance type", sizeId, kp.Id)
 }
 
+// GetKafkaInstanceSizeByIDForOrganisation returns the Kafka instance size with the given id only if it is
+// available to the given organisation, i.e. it is either a global size or a size scoped to that organisation
+func (kp *KafkaInstanceType) GetKafkaInstanceSizeByIDForOrganisation(sizeId string, organisationID string) (*KafkaInstanceSize, error) {
+	for _, size := range kp.GetKafkaInstanceSizesForOrganisation(organisationID) {
+		if size.Id == sizeId {
+			ret := size
+			return &ret, nil
+		}
+	}
+	return nil, fmt.Errorf("kafka instance size id: '%s' not found for '%s' instance type and organisation '%s'", sizeId, kp.Id, organisationID)
+}
+
+// GetKafkaInstanceSizesForOrganisation returns the global Kafka instance sizes followed by the
+// sizes scoped to the given organisation, if any
+func (kp *KafkaInstanceType) GetKafkaInstanceSizesForOrganisation(organisationID string) []KafkaInstanceSize {
+	sizes := make([]KafkaInstanceSize, 0, len(kp.Sizes))
+	sizes = append(sizes, kp.Sizes...)
+	if shared.StringEmpty(organisationID) {
+		return sizes
+	}
+
+	for _, orgSizes := range kp.OrganisationSizes {
+		if orgSizes.OrganisationID == organisationID {
+			sizes = append(sizes, orgSizes.Sizes...)
+		}
+	}
+
+	return sizes
+}
+
 func (kp *KafkaInstanceType) GetKafkaSupportedBillingModelByID(kafkaBillingModelID string) (*KafkaBillingModel, error) {
 	if idx, billingModel := arrays.FindFirst(kp.SupportedBillingModels, func(x KafkaBillingModel) bool { return shared.StringEqualsIgnoreCase(x.ID, kafkaBillingModelID) }); idx != -1 {
 		return &billingModel, nil
@@ -82,6 +132,7 @@ func (kp *KafkaInstanceType) HasAnInstanceSizeWithLifespan() bool {
 // - id must be defined and included in the valid instance type id list
 // - display_name must be defined and included in the valid instance type list
 // - sizes cannot be an empty list and each size id must be unique
+// - organisation sizes must specify an organisation id and their size ids must be unique among all the sizes of the instance type
 func (kp *KafkaInstanceType) validate() error {
 	if kp.Id == "" || kp.DisplayName == "" || len(kp.Sizes) == 0 {
 		return fmt.Errorf("kafka instance type '%s' is missing required parameters", kp.Id)
@@ -104,6 +155,28 @@ func (kp *KafkaInstanceType) validate() error {
 		}
 	}
 
+	existingOrganisations := make(map[string]struct{}, len(kp.OrganisationSizes))
+	for _, orgSizes := range kp.OrganisationSizes {
+		if shared.StringEmpty(orgSizes.OrganisationID) || len(orgSizes.Sizes) == 0 {
+			return fmt.Errorf("organisation sizes for kafka instance type '%s' are missing required parameters", kp.Id)
+		}
+		if _, ok := existingOrganisations[orgSizes.OrganisationID]; ok {
+			return fmt.Errorf("organisation sizes for organisation '%s' and instance type '%s' were defined more than once", orgSizes.OrganisationID, kp.Id)
+		}
+		existingOrganisations[orgSizes.OrganisationID] = struct{}{}
+
+		for _, kafkaInstanceSize := range orgSizes.Sizes {
+			if _, ok := existingSizes[kafkaInstanceSize.Id]; ok {
+				return fmt.Errorf("kafka instance size '%s' for instance type '%s' was defined more than once", kafkaInstanceSize.Id, kp.Id)
+			}
+			existingSizes[kafkaInstanceSize.Id]++
+
+			if err := kafkaInstanceSize.validate(kp.Id); err != nil {
+				return err
+			}
+		}
+	}
+
 	err := validate.Struct(kp)
 	if err != nil {
 		return err
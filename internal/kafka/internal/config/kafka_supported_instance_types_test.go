@@ -59,6 +59,117 @@ func TestKafkaSupportedSizesConfig_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Should not return an error with valid organisation sizes",
+			configFactoryFunc: func() SupportedKafkaInstanceTypesConfig {
+				testKafkaInstanceSizex1 := buildTestStandardKafkaInstanceSize()
+				testOrgKafkaInstanceSize := buildTestStandardKafkaInstanceSize()
+				testOrgKafkaInstanceSize.Id = "x1-custom"
+				testOrgKafkaInstanceSize.CapacityConsumed = 3
+				res := SupportedKafkaInstanceTypesConfig{
+					SupportedKafkaInstanceTypes: []KafkaInstanceType{
+						{
+							Id:          "standard",
+							DisplayName: "Standard",
+							Sizes: []KafkaInstanceSize{
+								testKafkaInstanceSizex1,
+							},
+							SupportedBillingModels: buildTestSupportedBillingModels(),
+							OrganisationSizes: []KafkaOrganisationInstanceSizes{
+								{
+									OrganisationID: "13640203",
+									Sizes:          []KafkaInstanceSize{testOrgKafkaInstanceSize},
+								},
+							},
+						},
+					},
+				}
+				return res
+			},
+			wantErr: false,
+		},
+		{
+			name: "Should fail because an organisation size id is already used by a global size",
+			configFactoryFunc: func() SupportedKafkaInstanceTypesConfig {
+				testKafkaInstanceSizex1 := buildTestStandardKafkaInstanceSize()
+				res := SupportedKafkaInstanceTypesConfig{
+					SupportedKafkaInstanceTypes: []KafkaInstanceType{
+						{
+							Id:          "standard",
+							DisplayName: "Standard",
+							Sizes: []KafkaInstanceSize{
+								testKafkaInstanceSizex1,
+							},
+							SupportedBillingModels: buildTestSupportedBillingModels(),
+							OrganisationSizes: []KafkaOrganisationInstanceSizes{
+								{
+									OrganisationID: "13640203",
+									Sizes:          []KafkaInstanceSize{testKafkaInstanceSizex1},
+								},
+							},
+						},
+					},
+				}
+				return res
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should fail because organisation sizes do not specify an organisation id",
+			configFactoryFunc: func() SupportedKafkaInstanceTypesConfig {
+				testKafkaInstanceSizex1 := buildTestStandardKafkaInstanceSize()
+				testOrgKafkaInstanceSize := buildTestStandardKafkaInstanceSize()
+				testOrgKafkaInstanceSize.Id = "x1-custom"
+				res := SupportedKafkaInstanceTypesConfig{
+					SupportedKafkaInstanceTypes: []KafkaInstanceType{
+						{
+							Id:          "standard",
+							DisplayName: "Standard",
+							Sizes: []KafkaInstanceSize{
+								testKafkaInstanceSizex1,
+							},
+							SupportedBillingModels: buildTestSupportedBillingModels(),
+							OrganisationSizes: []KafkaOrganisationInstanceSizes{
+								{
+									Sizes: []KafkaInstanceSize{testOrgKafkaInstanceSize},
+								},
+							},
+						},
+					},
+				}
+				return res
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should fail because an organisation size is invalid",
+			configFactoryFunc: func() SupportedKafkaInstanceTypesConfig {
+				testKafkaInstanceSizex1 := buildTestStandardKafkaInstanceSize()
+				testOrgKafkaInstanceSize := buildTestStandardKafkaInstanceSize()
+				testOrgKafkaInstanceSize.Id = "x1-custom"
+				testOrgKafkaInstanceSize.CapacityConsumed = 0
+				res := SupportedKafkaInstanceTypesConfig{
+					SupportedKafkaInstanceTypes: []KafkaInstanceType{
+						{
+							Id:          "standard",
+							DisplayName: "Standard",
+							Sizes: []KafkaInstanceSize{
+								testKafkaInstanceSizex1,
+							},
+							SupportedBillingModels: buildTestSupportedBillingModels(),
+							OrganisationSizes: []KafkaOrganisationInstanceSizes{
+								{
+									OrganisationID: "13640203",
+									Sizes:          []KafkaInstanceSize{testOrgKafkaInstanceSize},
+								},
+							},
+						},
+					},
+				}
+				return res
+			},
+			wantErr: true,
+		},
 		{
 			name: "Should fail because property TotalMaxConnections was not specified",
 			configFactoryFunc: func() SupportedKafkaInstanceTypesConfig {
@@ -1279,6 +1390,71 @@ func TestKafkaInstanceType_GetBiggestCapacityConsumedSize(t *testing.T) {
 
 }
 
+func TestKafkaInstanceType_GetKafkaInstanceSizeByIDForOrganisation(t *testing.T) {
+	kafkaInstanceType := KafkaInstanceType{
+		Id: "t1",
+		Sizes: []KafkaInstanceSize{
+			{Id: "s1", CapacityConsumed: 1},
+		},
+		OrganisationSizes: []KafkaOrganisationInstanceSizes{
+			{
+				OrganisationID: "org1",
+				Sizes:          []KafkaInstanceSize{{Id: "s1-org1", CapacityConsumed: 3}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		sizeId         string
+		organisationID string
+		want           *KafkaInstanceSize
+		wantErr        bool
+	}{
+		{
+			name:           "global sizes are available to every organisation",
+			sizeId:         "s1",
+			organisationID: "org2",
+			want:           &KafkaInstanceSize{Id: "s1", CapacityConsumed: 1},
+		},
+		{
+			name:           "organisation sizes are available to their organisation",
+			sizeId:         "s1-org1",
+			organisationID: "org1",
+			want:           &KafkaInstanceSize{Id: "s1-org1", CapacityConsumed: 3},
+		},
+		{
+			name:           "organisation sizes are not available to other organisations",
+			sizeId:         "s1-org1",
+			organisationID: "org2",
+			wantErr:        true,
+		},
+		{
+			name:    "organisation sizes are not available when no organisation is given",
+			sizeId:  "s1-org1",
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			res, err := kafkaInstanceType.GetKafkaInstanceSizeByIDForOrganisation(tt.sizeId, tt.organisationID)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(res).To(gomega.Equal(tt.want))
+		})
+	}
+
+	t.Run("organisation sizes can be looked up without an organisation", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		res, err := kafkaInstanceType.GetKafkaInstanceSizeByID("s1-org1")
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+		g.Expect(res).To(gomega.Equal(&KafkaInstanceSize{Id: "s1-org1", CapacityConsumed: 3}))
+	})
+}
+
 func buildTestSupportedBillingModels() []KafkaBillingModel {
 	return []KafkaBillingModel{
 		KafkaBillingModel{
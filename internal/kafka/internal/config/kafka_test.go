@@ -18,6 +18,7 @@ func Test_NewKafkaConfig(t *testing.T) {
 				Quota:                  NewKafkaQuotaConfig(),
 				BrowserUrl:             "http://localhost:8080/",
 				SupportedInstanceTypes: NewKafkaSupportedInstanceTypesConfig(),
+				ConfigOverrides:        NewKafkaConfigOverridesConfig(),
 				EnableKafkaOwnerConfig: false,
 				KafkaOwnerListFile:     "config/kafka-owner-list.yaml",
 			},
@@ -62,6 +63,16 @@ func Test_ReadFilesKafkaConfig(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "should return an error with misconfigured ConfigOverrides",
+			fields: fields{
+				config: NewKafkaConfig(),
+			},
+			modifyFn: func(config *KafkaConfig) {
+				config.ConfigOverrides.ConfigurationFile = "invalid"
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, testcase := range tests {
@@ -2,6 +2,8 @@ package config
 
 import (
 	"fmt"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
@@ -19,10 +21,15 @@ const (
 	FileTLSCertStorageType         = "file"
 	ManualCertificateManagement    = "manual"
 	AutomaticCertificateManagement = "automatic"
+
+	ACMECertificateIssuer    = "acme"
+	LocalCACertificateIssuer = "local_ca"
+	WebhookCertificateIssuer = "webhook"
 )
 
 var validStorageTypes = []string{InMemoryTLSCertStorageType, FileTLSCertStorageType, SecureTLSCertStorageType}
 var validCertificateManagementStrategies = []string{ManualCertificateManagement, AutomaticCertificateManagement}
+var validCertificateIssuers = []string{ACMECertificateIssuer, LocalCACertificateIssuer, WebhookCertificateIssuer}
 
 type KafkaTLSCertificateManagementConfig struct {
 	CertificateAuthorityEndpoint         string
@@ -31,6 +38,12 @@ type KafkaTLSCertificateManagementConfig struct {
 	EnableKafkaExternalCertificate       bool
 	ManualCertificateManagementConfig    ManualCertificateManagementConfig
 	AutomaticCertificateManagementConfig AutomaticCertificateManagementConfig
+	// DefaultCertificateIssuer issues the certificates of the base domains without an issuer of their own
+	DefaultCertificateIssuer string
+	// BaseDomainCertificateIssuers maps a base domain to the issuer of the certificates of the Kafka routes under it
+	BaseDomainCertificateIssuers map[string]string
+	LocalCAIssuerConfig          LocalCAIssuerConfig
+	WebhookIssuerConfig          WebhookIssuerConfig
 }
 
 type ManualCertificateManagementConfig struct {
@@ -49,6 +62,22 @@ type AutomaticCertificateManagementConfig struct {
 	MustStaple                   bool
 }
 
+// LocalCAIssuerConfig configures the self-signed certificate authority generated by Fleet Manager. The CA
+// certificate and its private key are kept in the certificate storage.
+type LocalCAIssuerConfig struct {
+	CommonName          string        `validate:"required"`
+	CAValidity          time.Duration `validate:"gt=0"`
+	CertificateValidity time.Duration `validate:"gt=0"`
+}
+
+// WebhookIssuerConfig configures an external issuer called over HTTP to sign and revoke the certificates
+type WebhookIssuerConfig struct {
+	URL           string        `validate:"required,url"`
+	Timeout       time.Duration `validate:"gt=0"`
+	Token         string
+	TokenFilePath string
+}
+
 func NewCertificateManagementConfig() *KafkaTLSCertificateManagementConfig {
 	return &KafkaTLSCertificateManagementConfig{
 		CertificateAuthorityEndpoint:   certmagic.LetsEncryptProductionCA,
@@ -66,6 +95,17 @@ func NewCertificateManagementConfig() *KafkaTLSCertificateManagementConfig {
 			AcmeIssuerAccountKeyFilePath: "secrets/kafka-tls-certificate-management-acme-issuer-account-key.pem",
 			MustStaple:                   false,
 		},
+		DefaultCertificateIssuer:     ACMECertificateIssuer,
+		BaseDomainCertificateIssuers: map[string]string{},
+		LocalCAIssuerConfig: LocalCAIssuerConfig{
+			CommonName:          "Kafka Service Fleet Manager CA",
+			CAValidity:          10 * 365 * 24 * time.Hour,
+			CertificateValidity: 90 * 24 * time.Hour,
+		},
+		WebhookIssuerConfig: WebhookIssuerConfig{
+			Timeout:       30 * time.Second,
+			TokenFilePath: "secrets/kafka-tls-certificate-management-webhook-issuer-token",
+		},
 	}
 }
 
@@ -80,13 +120,31 @@ func (c *KafkaTLSCertificateManagementConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&c.AutomaticCertificateManagementConfig.AcmeIssuerAccountKeyFilePath, "kafka-tls-certificate-management-acme-issuer-account-key-file-path", c.AutomaticCertificateManagementConfig.AcmeIssuerAccountKeyFilePath, "The file containing the ACME Issuer account key. This is required")
 	fs.Float64Var(&c.AutomaticCertificateManagementConfig.RenewalWindowRatio, "kafka-tls-certificate-management-renewal-window-ratio", c.AutomaticCertificateManagementConfig.RenewalWindowRatio, "How much of a certificate's lifetime becomes the renewal window")
 	fs.DurationVar(&c.AutomaticCertificateManagementConfig.CertificateCacheTTL, "kafka-tls-certificate-management-secure-storage-cache-ttl", c.AutomaticCertificateManagementConfig.CertificateCacheTTL, "The cache duration of the certificate when secure-storage is used. Past this duration, the cached certificate will be refreshed from the secure storage on its retrieval")
+	fs.StringVar(&c.DefaultCertificateIssuer, "kafka-tls-certificate-management-issuer", c.DefaultCertificateIssuer, fmt.Sprintf("The issuer of the automatically managed certificates. Supported values are %v", validCertificateIssuers))
+	fs.StringToStringVar(&c.BaseDomainCertificateIssuers, "kafka-tls-certificate-management-base-domain-issuers", c.BaseDomainCertificateIssuers, "The issuer of the certificates per base domain, overriding the default issuer. For example: 'kafka.internal.example.com=local_ca'")
+	fs.StringVar(&c.LocalCAIssuerConfig.CommonName, "kafka-tls-certificate-management-local-ca-common-name", c.LocalCAIssuerConfig.CommonName, "The common name of the certificate authority generated by the local_ca issuer")
+	fs.DurationVar(&c.LocalCAIssuerConfig.CAValidity, "kafka-tls-certificate-management-local-ca-validity", c.LocalCAIssuerConfig.CAValidity, "The validity of the certificate authority generated by the local_ca issuer")
+	fs.DurationVar(&c.LocalCAIssuerConfig.CertificateValidity, "kafka-tls-certificate-management-local-ca-certificate-validity", c.LocalCAIssuerConfig.CertificateValidity, "The validity of the certificates issued by the local_ca issuer")
+	fs.StringVar(&c.WebhookIssuerConfig.URL, "kafka-tls-certificate-management-webhook-issuer-url", c.WebhookIssuerConfig.URL, "The base URL of the external issuer used by the webhook issuer. Certificates are requested from '<url>/issue' and revoked through '<url>/revoke'")
+	fs.DurationVar(&c.WebhookIssuerConfig.Timeout, "kafka-tls-certificate-management-webhook-issuer-timeout", c.WebhookIssuerConfig.Timeout, "The timeout of the requests sent to the external issuer")
+	fs.StringVar(&c.WebhookIssuerConfig.TokenFilePath, "kafka-tls-certificate-management-webhook-issuer-token-file", c.WebhookIssuerConfig.TokenFilePath, "File containing the bearer token sent to the external issuer. The token is optional")
 }
 
 func (c *KafkaTLSCertificateManagementConfig) ReadFiles() error {
 	if c.CertificateManagementStrategy == AutomaticCertificateManagement {
-		err := shared.ReadFileValueString(c.AutomaticCertificateManagementConfig.AcmeIssuerAccountKeyFilePath, &c.AutomaticCertificateManagementConfig.AcmeIssuerAccountKey)
-		if err != nil {
-			return err
+		usedIssuers := c.UsedCertificateIssuers()
+		if arrays.Contains(usedIssuers, ACMECertificateIssuer) {
+			err := shared.ReadFileValueString(c.AutomaticCertificateManagementConfig.AcmeIssuerAccountKeyFilePath, &c.AutomaticCertificateManagementConfig.AcmeIssuerAccountKey)
+			if err != nil {
+				return err
+			}
+		}
+
+		if arrays.Contains(usedIssuers, WebhookCertificateIssuer) {
+			err := shared.ReadFileValueString(c.WebhookIssuerConfig.TokenFilePath, &c.WebhookIssuerConfig.Token)
+			if err != nil && !os.IsNotExist(err) {
+				return err
+			}
 		}
 	}
 
@@ -118,7 +176,7 @@ func (c *KafkaTLSCertificateManagementConfig) Validate(env *environments.Env) er
 	}
 
 	if c.CertificateManagementStrategy == AutomaticCertificateManagement && c.EnableKafkaExternalCertificate {
-		err := validator.New().Struct(c.AutomaticCertificateManagementConfig)
+		err := c.validateCertificateIssuers()
 		if err != nil {
 			return errors.Wrap(err, "error validating the automatic kafka tls certificate management configuration")
 		}
@@ -133,3 +191,70 @@ func (c *KafkaTLSCertificateManagementConfig) Validate(env *environments.Env) er
 
 	return nil
 }
+
+func (c *KafkaTLSCertificateManagementConfig) validateCertificateIssuers() error {
+	usedIssuers := c.UsedCertificateIssuers()
+	for _, issuer := range usedIssuers {
+		if !arrays.Contains(validCertificateIssuers, issuer) {
+			return fmt.Errorf("invalid certificate issuer %q supplied. Valid certificate issuers are %v", issuer, validCertificateIssuers)
+		}
+	}
+
+	validate := validator.New()
+	// the notification email and the account key are only meaningful to ACME certificate authorities
+	if arrays.Contains(usedIssuers, ACMECertificateIssuer) {
+		if err := validate.Struct(c.AutomaticCertificateManagementConfig); err != nil {
+			return err
+		}
+	} else if err := validate.Var(c.AutomaticCertificateManagementConfig.RenewalWindowRatio, "gte=0,lte=1"); err != nil {
+		return errors.Wrap(err, "invalid renewal window ratio")
+	}
+
+	if arrays.Contains(usedIssuers, LocalCACertificateIssuer) {
+		// the generated CA is shared by all the replicas, and must outlive their restarts
+		if c.StorageType != SecureTLSCertStorageType {
+			return fmt.Errorf("the %q certificate issuer requires the %q storage type, %q supplied", LocalCACertificateIssuer, SecureTLSCertStorageType, c.StorageType)
+		}
+		if err := validate.Struct(c.LocalCAIssuerConfig); err != nil {
+			return errors.Wrap(err, "error validating the local CA issuer configuration")
+		}
+	}
+
+	if arrays.Contains(usedIssuers, WebhookCertificateIssuer) {
+		if err := validate.Struct(c.WebhookIssuerConfig); err != nil {
+			return errors.Wrap(err, "error validating the webhook issuer configuration")
+		}
+	}
+
+	return nil
+}
+
+// GetCertificateIssuer returns the issuer of the certificate of the given domain. The issuer of the longest
+// configured base domain the domain belongs to is returned, falling back to the default issuer
+func (c *KafkaTLSCertificateManagementConfig) GetCertificateIssuer(domain string) string {
+	domain = strings.TrimPrefix(strings.ToLower(domain), "*.")
+	issuer := c.DefaultCertificateIssuer
+	longestMatch := -1
+	for baseDomain, baseDomainIssuer := range c.BaseDomainCertificateIssuers {
+		baseDomain = strings.ToLower(strings.TrimSuffix(baseDomain, "."))
+		if domain != baseDomain && !strings.HasSuffix(domain, "."+baseDomain) {
+			continue
+		}
+		if len(baseDomain) > longestMatch {
+			longestMatch = len(baseDomain)
+			issuer = baseDomainIssuer
+		}
+	}
+	return issuer
+}
+
+// UsedCertificateIssuers returns the distinct certificate issuers in use, the default one first
+func (c *KafkaTLSCertificateManagementConfig) UsedCertificateIssuers() []string {
+	usedIssuers := []string{c.DefaultCertificateIssuer}
+	for _, issuer := range c.BaseDomainCertificateIssuers {
+		if !arrays.Contains(usedIssuers, issuer) {
+			usedIssuers = append(usedIssuers, issuer)
+		}
+	}
+	return usedIssuers
+}
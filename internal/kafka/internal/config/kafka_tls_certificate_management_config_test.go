@@ -2,8 +2,10 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
 	"github.com/onsi/gomega"
 )
 
@@ -17,6 +19,16 @@ func TestKafkaTLSCertificateManagementConfig_Validate(t *testing.T) {
 		EnableKafkaExternalCertificate bool
 		KafkaTLSCertFile               string
 		KafkaTLSKeyFile                string
+		CertificateIssuer              string
+		BaseDomainCertificateIssuers   map[string]string
+		LocalCAIssuerConfig            LocalCAIssuerConfig
+		WebhookIssuerConfig            WebhookIssuerConfig
+	}
+
+	validLocalCAIssuerConfig := LocalCAIssuerConfig{
+		CommonName:          "some-ca",
+		CAValidity:          time.Hour,
+		CertificateValidity: time.Minute,
 	}
 
 	type args struct {
@@ -180,6 +192,118 @@ func TestKafkaTLSCertificateManagementConfig_Validate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "should not require the ACME configuration when the local CA issuer is the only issuer in use",
+			fields: fields{
+				StorageType:                    "secure-storage",
+				CertificateManagementStrategy:  AutomaticCertificateManagement,
+				RenewalWindowRatio:             0.2,
+				EnableKafkaExternalCertificate: true,
+				CertificateIssuer:              LocalCACertificateIssuer,
+				LocalCAIssuerConfig:            validLocalCAIssuerConfig,
+			},
+			args: args{
+				&environments.Env{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "should return an error when the local CA issuer is used with the in-memory storage",
+			fields: fields{
+				StorageType:                    "in-memory",
+				CertificateManagementStrategy:  AutomaticCertificateManagement,
+				RenewalWindowRatio:             0.2,
+				EnableKafkaExternalCertificate: true,
+				CertificateIssuer:              LocalCACertificateIssuer,
+				LocalCAIssuerConfig:            validLocalCAIssuerConfig,
+			},
+			args: args{
+				&environments.Env{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when a base domain uses the local CA issuer with the file storage",
+			fields: fields{
+				StorageType:                    "file",
+				CertificateManagementStrategy:  AutomaticCertificateManagement,
+				RenewalWindowRatio:             0.2,
+				EnableKafkaExternalCertificate: true,
+				CertificateIssuer:              WebhookCertificateIssuer,
+				WebhookIssuerConfig:            WebhookIssuerConfig{URL: "https://issuer.example.com", Timeout: time.Second},
+				BaseDomainCertificateIssuers:   map[string]string{"kafka.internal.example.com": LocalCACertificateIssuer},
+				LocalCAIssuerConfig:            validLocalCAIssuerConfig,
+			},
+			args: args{
+				&environments.Env{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when the local CA issuer configuration is invalid",
+			fields: fields{
+				StorageType:                    "secure-storage",
+				CertificateManagementStrategy:  AutomaticCertificateManagement,
+				RenewalWindowRatio:             0.2,
+				EnableKafkaExternalCertificate: true,
+				CertificateIssuer:              LocalCACertificateIssuer,
+				LocalCAIssuerConfig: LocalCAIssuerConfig{
+					CommonName: "some-ca",
+					CAValidity: time.Hour,
+				},
+			},
+			args: args{
+				&environments.Env{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when the webhook issuer has no url",
+			fields: fields{
+				StorageType:                    "secure-storage",
+				CertificateManagementStrategy:  AutomaticCertificateManagement,
+				RenewalWindowRatio:             0.2,
+				EnableKafkaExternalCertificate: true,
+				CertificateIssuer:              WebhookCertificateIssuer,
+				WebhookIssuerConfig:            WebhookIssuerConfig{Timeout: time.Second},
+			},
+			args: args{
+				&environments.Env{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when a base domain uses an unknown issuer",
+			fields: fields{
+				StorageType:                    "secure-storage",
+				CertificateManagementStrategy:  AutomaticCertificateManagement,
+				RenewalWindowRatio:             0.2,
+				EnableKafkaExternalCertificate: true,
+				CertificateIssuer:              LocalCACertificateIssuer,
+				LocalCAIssuerConfig:            validLocalCAIssuerConfig,
+				BaseDomainCertificateIssuers:   map[string]string{"kafka.example.com": "unknown"},
+			},
+			args: args{
+				&environments.Env{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "should validate the ACME configuration when a base domain uses the ACME issuer",
+			fields: fields{
+				StorageType:                    "secure-storage",
+				CertificateManagementStrategy:  AutomaticCertificateManagement,
+				RenewalWindowRatio:             0.2,
+				EnableKafkaExternalCertificate: true,
+				CertificateIssuer:              LocalCACertificateIssuer,
+				LocalCAIssuerConfig:            validLocalCAIssuerConfig,
+				BaseDomainCertificateIssuers:   map[string]string{"kafka.example.com": ACMECertificateIssuer},
+			},
+			args: args{
+				&environments.Env{},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		testcase := tt
@@ -195,6 +319,10 @@ func TestKafkaTLSCertificateManagementConfig_Validate(t *testing.T) {
 					AcmeIssuerAccountKeyFilePath: testcase.fields.AcmeIssuerAccountKeyPEMFile,
 					EmailToSendNotificationTo:    testcase.fields.EmailToSendNotificationTo,
 				},
+				DefaultCertificateIssuer:     arrays.FirstNonEmptyOrDefault(ACMECertificateIssuer, testcase.fields.CertificateIssuer),
+				BaseDomainCertificateIssuers: testcase.fields.BaseDomainCertificateIssuers,
+				LocalCAIssuerConfig:          testcase.fields.LocalCAIssuerConfig,
+				WebhookIssuerConfig:          testcase.fields.WebhookIssuerConfig,
 			}
 
 			err := c.Validate(testcase.args.env)
@@ -202,3 +330,48 @@ func TestKafkaTLSCertificateManagementConfig_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestKafkaTLSCertificateManagementConfig_GetCertificateIssuer(t *testing.T) {
+	c := &KafkaTLSCertificateManagementConfig{
+		DefaultCertificateIssuer: ACMECertificateIssuer,
+		BaseDomainCertificateIssuers: map[string]string{
+			"internal.example.com":       LocalCACertificateIssuer,
+			"kafka.internal.example.com": WebhookCertificateIssuer,
+		},
+	}
+
+	tests := []struct {
+		name   string
+		domain string
+		want   string
+	}{
+		{
+			name:   "should return the default issuer when the domain is not under any configured base domain",
+			domain: "abc.kafka.bf2.dev",
+			want:   ACMECertificateIssuer,
+		},
+		{
+			name:   "should return the issuer of the base domain the domain belongs to",
+			domain: "abc.internal.example.com",
+			want:   LocalCACertificateIssuer,
+		},
+		{
+			name:   "should return the issuer of the longest matching base domain",
+			domain: "*.abc.kafka.internal.example.com",
+			want:   WebhookCertificateIssuer,
+		},
+		{
+			name:   "should not match a domain merely ending with the base domain",
+			domain: "abcinternal.example.com",
+			want:   ACMECertificateIssuer,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			g.Expect(c.GetCertificateIssuer(tt.domain)).To(gomega.Equal(tt.want))
+		})
+	}
+}
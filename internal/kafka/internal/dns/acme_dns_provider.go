@@ -0,0 +1,107 @@
+package dns
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/libdns/libdns"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultACMEChallengeRecordTTL = time.Minute
+	acmeChangePollInterval        = 5 * time.Second
+)
+
+// ACMEDNSProvider adapts a Provider to the libdns interfaces used by certmagic to solve ACME DNS-01 challenges.
+// The challenge records are only reported as appended once their change is in sync, or when maxWait elapses
+type ACMEDNSProvider struct {
+	provider     Provider
+	maxWait      time.Duration
+	pollInterval time.Duration
+}
+
+var _ libdns.RecordAppender = &ACMEDNSProvider{}
+var _ libdns.RecordDeleter = &ACMEDNSProvider{}
+
+func NewACMEDNSProvider(provider Provider, maxWait time.Duration) *ACMEDNSProvider {
+	return &ACMEDNSProvider{
+		provider:     provider,
+		maxWait:      maxWait,
+		pollInterval: acmeChangePollInterval,
+	}
+}
+
+func (p *ACMEDNSProvider) AppendRecords(ctx context.Context, zone string, records []libdns.Record) ([]libdns.Record, error) {
+	records = withDefaultTTL(records)
+	change, err := p.provider.ChangeRecords(ctx, zone, toRecordChanges(RecordActionCreate, zone, records))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.waitForChange(ctx, zone, change); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+func (p *ACMEDNSProvider) DeleteRecords(ctx context.Context, zone string, records []libdns.Record) ([]libdns.Record, error) {
+	records = withDefaultTTL(records)
+	if _, err := p.provider.ChangeRecords(ctx, zone, toRecordChanges(RecordActionDelete, zone, records)); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+func (p *ACMEDNSProvider) waitForChange(ctx context.Context, zone string, change *Change) error {
+	ctx, cancel := context.WithTimeout(ctx, p.maxWait)
+	defer cancel()
+
+	ticker := time.NewTicker(p.pollInterval)
+	defer ticker.Stop()
+
+	for !change.IsInSync() {
+		select {
+		case <-ctx.Done():
+			return errors.Errorf("change %q of zone %q is not in sync after %s", change.ID, zone, p.maxWait)
+		case <-ticker.C:
+			var err error
+			change, err = p.provider.GetChange(ctx, zone, change.ID)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// withDefaultTTL sets a TTL on the records without one. The same TTL must be used when deleting the records as some
+// providers only delete records matching exactly
+func withDefaultTTL(records []libdns.Record) []libdns.Record {
+	result := make([]libdns.Record, 0, len(records))
+	for _, record := range records {
+		if record.TTL == 0 {
+			record.TTL = defaultACMEChallengeRecordTTL
+		}
+		result = append(result, record)
+	}
+	return result
+}
+
+func toRecordChanges(action RecordAction, zone string, records []libdns.Record) []RecordChange {
+	changes := make([]RecordChange, 0, len(records))
+	for _, record := range records {
+		changes = append(changes, RecordChange{
+			Action: action,
+			Record: Record{
+				Name:  strings.TrimSuffix(libdns.AbsoluteName(record.Name, zone), "."),
+				Type:  record.Type,
+				TTL:   record.TTL,
+				Value: record.Value,
+			},
+		})
+	}
+	return changes
+}
@@ -0,0 +1,109 @@
+package dns
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libdns/libdns"
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+)
+
+func Test_ACMEDNSProvider_AppendRecords(t *testing.T) {
+	challenge := libdns.Record{Type: RecordTypeTXT, Name: "_acme-challenge.kafka", Value: "challenge"}
+
+	tests := []struct {
+		name     string
+		provider func() *ProviderMock
+		want     []libdns.Record
+		wantErr  bool
+	}{
+		{
+			name: "should create the challenge records with the default TTL and wait for them to be in sync",
+			provider: func() *ProviderMock {
+				getChangeCalls := 0
+				return &ProviderMock{
+					ChangeRecordsFunc: func(ctx context.Context, zone string, changes []RecordChange) (*Change, error) {
+						expected := []RecordChange{{
+							Action: RecordActionCreate,
+							Record: Record{Name: "_acme-challenge.kafka.example.com", Type: RecordTypeTXT, TTL: defaultACMEChallengeRecordTTL, Value: "challenge"},
+						}}
+						if zone != "example.com." || len(changes) != 1 || changes[0] != expected[0] {
+							return nil, errors.Errorf("unexpected changes %v of zone %q", changes, zone)
+						}
+						return &Change{ID: "change-id", Status: ChangeStatusPending}, nil
+					},
+					GetChangeFunc: func(ctx context.Context, zone string, changeID string) (*Change, error) {
+						getChangeCalls++
+						if getChangeCalls < 2 {
+							return &Change{ID: changeID, Status: ChangeStatusPending}, nil
+						}
+						return &Change{ID: changeID, Status: ChangeStatusInSync}, nil
+					},
+				}
+			},
+			want: []libdns.Record{{Type: RecordTypeTXT, Name: "_acme-challenge.kafka", Value: "challenge", TTL: defaultACMEChallengeRecordTTL}},
+		},
+		{
+			name: "should return an error when the change is not in sync in time",
+			provider: func() *ProviderMock {
+				return &ProviderMock{
+					ChangeRecordsFunc: func(ctx context.Context, zone string, changes []RecordChange) (*Change, error) {
+						return &Change{ID: "change-id", Status: ChangeStatusPending}, nil
+					},
+					GetChangeFunc: func(ctx context.Context, zone string, changeID string) (*Change, error) {
+						return &Change{ID: changeID, Status: ChangeStatusPending}, nil
+					},
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when the records cannot be created",
+			provider: func() *ProviderMock {
+				return &ProviderMock{
+					ChangeRecordsFunc: func(ctx context.Context, zone string, changes []RecordChange) (*Change, error) {
+						return nil, errors.New("record already exists")
+					},
+				}
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			provider := &ACMEDNSProvider{
+				provider:     tt.provider(),
+				maxWait:      100 * time.Millisecond,
+				pollInterval: time.Millisecond,
+			}
+
+			got, err := provider.AppendRecords(context.Background(), "example.com.", []libdns.Record{challenge})
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func Test_ACMEDNSProvider_DeleteRecords(t *testing.T) {
+	g := gomega.NewWithT(t)
+	inMemoryProvider := NewInMemoryProvider()
+	provider := NewACMEDNSProvider(inMemoryProvider, time.Second)
+	records := []libdns.Record{{Type: RecordTypeTXT, Name: "_acme-challenge.kafka", Value: "challenge"}}
+
+	_, err := provider.AppendRecords(context.Background(), "example.com.", records)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(inMemoryProvider.Records("example.com")).To(gomega.HaveLen(1))
+
+	_, err = provider.DeleteRecords(context.Background(), "example.com.", records)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(inMemoryProvider.Records("example.com")).To(gomega.BeEmpty())
+
+	// records already deleted are ignored
+	_, err = provider.DeleteRecords(context.Background(), "example.com.", records)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+}
@@ -0,0 +1,195 @@
+package dns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+const (
+	azureManagementBaseURL = "https://management.azure.com"
+	azureManagementScope   = "https://management.azure.com/.default"
+	azureTokenURLFormat    = "https://login.microsoftonline.com/%s/oauth2/v2.0/token"
+	azureDNSAPIVersion     = "2018-05-01"
+)
+
+var _ Provider = &azureDNSProvider{}
+
+// azureDNSProvider manages the records of Azure DNS zones through the Azure Resource Manager REST API,
+// authenticating with a service principal. Azure DNS applies the changes of records synchronously: they are
+// in sync once applied
+type azureDNSProvider struct {
+	httpClient     *http.Client
+	baseURL        string
+	subscriptionID string
+	resourceGroup  string
+}
+
+func newAzureDNSProvider(credentials config.AzureDNSCredentials) *azureDNSProvider {
+	clientCredentialsConfig := &clientcredentials.Config{
+		ClientID:     credentials.ClientID,
+		ClientSecret: credentials.ClientSecret,
+		TokenURL:     fmt.Sprintf(azureTokenURLFormat, url.PathEscape(credentials.TenantID)),
+		Scopes:       []string{azureManagementScope},
+	}
+
+	return &azureDNSProvider{
+		httpClient:     clientCredentialsConfig.Client(context.Background()),
+		baseURL:        azureManagementBaseURL,
+		subscriptionID: credentials.SubscriptionID,
+		resourceGroup:  credentials.ResourceGroup,
+	}
+}
+
+type azureDNSRecordSet struct {
+	Properties azureDNSRecordSetProperties `json:"properties"`
+}
+
+type azureDNSRecordSetProperties struct {
+	TTL         int64              `json:"TTL"`
+	CNAMERecord *azureDNSCNAME     `json:"CNAMERecord,omitempty"`
+	TXTRecords  []azureDNSTXTValue `json:"TXTRecords,omitempty"`
+}
+
+type azureDNSCNAME struct {
+	CNAME string `json:"cname"`
+}
+
+type azureDNSTXTValue struct {
+	Value []string `json:"value"`
+}
+
+// ChangeRecords applies the changes one record set at a time as Azure DNS has no batch API. Creations fail on
+// existing record sets with a different value so that a record is never silently overwritten, while the changes
+// applied by a previous attempt that failed part way through are ignored
+func (p *azureDNSProvider) ChangeRecords(ctx context.Context, zone string, changes []RecordChange) (*Change, error) {
+	for _, change := range changes {
+		var err error
+		switch change.Action {
+		case RecordActionCreate:
+			err = p.createRecordSet(ctx, zone, change.Record)
+		case RecordActionDelete:
+			err = p.deleteRecordSet(ctx, zone, change.Record)
+		default:
+			err = errors.Errorf("unsupported record action %q", change.Action)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to change %s record %q of zone %q", change.Record.Type, change.Record.Name, zone)
+		}
+	}
+
+	return &Change{ID: uuid.New().String(), Status: ChangeStatusInSync}, nil
+}
+
+func (p *azureDNSProvider) GetChange(ctx context.Context, zone string, changeID string) (*Change, error) {
+	return &Change{ID: changeID, Status: ChangeStatusInSync}, nil
+}
+
+func (p *azureDNSProvider) createRecordSet(ctx context.Context, zone string, record Record) error {
+	properties := azureDNSRecordSetProperties{TTL: int64(record.TTL.Seconds())}
+	switch record.Type {
+	case RecordTypeCNAME:
+		properties.CNAMERecord = &azureDNSCNAME{CNAME: record.Value}
+	case RecordTypeTXT:
+		properties.TXTRecords = []azureDNSTXTValue{{Value: []string{record.Value}}}
+	default:
+		return errors.Errorf("unsupported record type %q", record.Type)
+	}
+
+	payload, err := json.Marshal(azureDNSRecordSet{Properties: properties})
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPut, p.recordSetURL(zone, record), bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("If-None-Match", "*")
+
+	err = p.do(request, nil)
+	if !hasStatusCode(err, http.StatusPreconditionFailed) {
+		return err
+	}
+
+	// the record set already exists, which is expected when it's created again after a partial failure
+	existing, getErr := p.getRecordSet(ctx, zone, record)
+	if getErr != nil {
+		return errors.Wrapf(getErr, "unable to get existing record set")
+	}
+	if existing == nil || !reflect.DeepEqual(existing.Properties, properties) {
+		return err
+	}
+	return nil
+}
+
+func (p *azureDNSProvider) getRecordSet(ctx context.Context, zone string, record Record) (*azureDNSRecordSet, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, p.recordSetURL(zone, record), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var recordSet azureDNSRecordSet
+	if err := p.do(request, &recordSet); err != nil {
+		if hasStatusCode(err, http.StatusNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &recordSet, nil
+}
+
+func (p *azureDNSProvider) deleteRecordSet(ctx context.Context, zone string, record Record) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodDelete, p.recordSetURL(zone, record), nil)
+	if err != nil {
+		return err
+	}
+
+	// the record set has already been deleted
+	if err := p.do(request, nil); err != nil && !hasStatusCode(err, http.StatusNotFound) {
+		return err
+	}
+	return nil
+}
+
+func (p *azureDNSProvider) recordSetURL(zone string, record Record) string {
+	return fmt.Sprintf("%s/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnsZones/%s/%s/%s?api-version=%s",
+		p.baseURL,
+		url.PathEscape(p.subscriptionID),
+		url.PathEscape(p.resourceGroup),
+		url.PathEscape(normalizeZone(zone)),
+		record.Type,
+		url.PathEscape(relativeRecordName(record.Name, zone)),
+		azureDNSAPIVersion)
+}
+
+func (p *azureDNSProvider) do(request *http.Request, result interface{}) error {
+	response, err := p.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return &statusCodeError{statusCode: response.StatusCode, body: string(body)}
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(body, result)
+}
@@ -0,0 +1,212 @@
+package dns
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+)
+
+const testAzureDNSZonePath = "/subscriptions/test-subscription/resourceGroups/test-group/providers/Microsoft.Network/dnsZones/example.com"
+
+type azureDNSRequest struct {
+	method      string
+	path        string
+	ifNoneMatch string
+	body        azureDNSRecordSet
+}
+
+func Test_azureDNSProvider_ChangeRecords(t *testing.T) {
+	tests := []struct {
+		name         string
+		changes      []RecordChange
+		status       int
+		existing     *azureDNSRecordSet
+		wantRequests []azureDNSRequest
+		wantErr      bool
+	}{
+		{
+			name: "should create a CNAME record set without overwriting an existing one",
+			changes: []RecordChange{
+				{
+					Action: RecordActionCreate,
+					Record: Record{Name: "kafka.example.com", Type: RecordTypeCNAME, TTL: 5 * time.Minute, Value: "router.example.com"},
+				},
+			},
+			status: http.StatusCreated,
+			wantRequests: []azureDNSRequest{
+				{
+					method:      http.MethodPut,
+					path:        testAzureDNSZonePath + "/CNAME/kafka",
+					ifNoneMatch: "*",
+					body: azureDNSRecordSet{Properties: azureDNSRecordSetProperties{
+						TTL:         300,
+						CNAMERecord: &azureDNSCNAME{CNAME: "router.example.com"},
+					}},
+				},
+			},
+		},
+		{
+			name: "should create a TXT record set",
+			changes: []RecordChange{
+				{
+					Action: RecordActionCreate,
+					Record: Record{Name: "_acme-challenge.kafka.example.com", Type: RecordTypeTXT, TTL: time.Minute, Value: "challenge"},
+				},
+			},
+			status: http.StatusCreated,
+			wantRequests: []azureDNSRequest{
+				{
+					method:      http.MethodPut,
+					path:        testAzureDNSZonePath + "/TXT/_acme-challenge.kafka",
+					ifNoneMatch: "*",
+					body: azureDNSRecordSet{Properties: azureDNSRecordSetProperties{
+						TTL:        60,
+						TXTRecords: []azureDNSTXTValue{{Value: []string{"challenge"}}},
+					}},
+				},
+			},
+		},
+		{
+			name: "should delete the record set",
+			changes: []RecordChange{
+				{
+					Action: RecordActionDelete,
+					Record: Record{Name: "kafka.example.com", Type: RecordTypeCNAME, TTL: 5 * time.Minute, Value: "router.example.com"},
+				},
+			},
+			status: http.StatusOK,
+			wantRequests: []azureDNSRequest{
+				{method: http.MethodDelete, path: testAzureDNSZonePath + "/CNAME/kafka"},
+			},
+		},
+		{
+			name: "should ignore the deletion of a missing record set",
+			changes: []RecordChange{
+				{
+					Action: RecordActionDelete,
+					Record: Record{Name: "kafka.example.com", Type: RecordTypeCNAME, TTL: 5 * time.Minute, Value: "router.example.com"},
+				},
+			},
+			status: http.StatusNotFound,
+			wantRequests: []azureDNSRequest{
+				{method: http.MethodDelete, path: testAzureDNSZonePath + "/CNAME/kafka"},
+			},
+		},
+		{
+			name: "should ignore the creation of a record set that already exists with the same value",
+			changes: []RecordChange{
+				{
+					Action: RecordActionCreate,
+					Record: Record{Name: "kafka.example.com", Type: RecordTypeCNAME, TTL: 5 * time.Minute, Value: "router.example.com"},
+				},
+			},
+			status: http.StatusPreconditionFailed,
+			existing: &azureDNSRecordSet{Properties: azureDNSRecordSetProperties{
+				TTL:         300,
+				CNAMERecord: &azureDNSCNAME{CNAME: "router.example.com"},
+			}},
+			wantRequests: []azureDNSRequest{
+				{
+					method:      http.MethodPut,
+					path:        testAzureDNSZonePath + "/CNAME/kafka",
+					ifNoneMatch: "*",
+					body: azureDNSRecordSet{Properties: azureDNSRecordSetProperties{
+						TTL:         300,
+						CNAMERecord: &azureDNSCNAME{CNAME: "router.example.com"},
+					}},
+				},
+				{method: http.MethodGet, path: testAzureDNSZonePath + "/CNAME/kafka"},
+			},
+		},
+		{
+			name: "should return an error when the record set already exists with another value",
+			changes: []RecordChange{
+				{
+					Action: RecordActionCreate,
+					Record: Record{Name: "kafka.example.com", Type: RecordTypeCNAME, TTL: 5 * time.Minute, Value: "router.example.com"},
+				},
+			},
+			status: http.StatusPreconditionFailed,
+			existing: &azureDNSRecordSet{Properties: azureDNSRecordSetProperties{
+				TTL:         300,
+				CNAMERecord: &azureDNSCNAME{CNAME: "other-router.example.com"},
+			}},
+			wantRequests: []azureDNSRequest{
+				{
+					method:      http.MethodPut,
+					path:        testAzureDNSZonePath + "/CNAME/kafka",
+					ifNoneMatch: "*",
+					body: azureDNSRecordSet{Properties: azureDNSRecordSetProperties{
+						TTL:         300,
+						CNAMERecord: &azureDNSCNAME{CNAME: "router.example.com"},
+					}},
+				},
+				{method: http.MethodGet, path: testAzureDNSZonePath + "/CNAME/kafka"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error on an unsupported record type",
+			changes: []RecordChange{
+				{
+					Action: RecordActionCreate,
+					Record: Record{Name: "kafka.example.com", Type: "A", TTL: 5 * time.Minute, Value: "10.0.0.1"},
+				},
+			},
+			wantRequests: []azureDNSRequest{},
+			wantErr:      true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+
+			var mutex sync.Mutex
+			requests := []azureDNSRequest{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mutex.Lock()
+				defer mutex.Unlock()
+				request := azureDNSRequest{method: r.Method, path: r.URL.Path, ifNoneMatch: r.Header.Get("If-None-Match")}
+				if r.Method == http.MethodPut {
+					_ = json.NewDecoder(r.Body).Decode(&request.body)
+				}
+				if r.URL.Query().Get("api-version") != azureDNSAPIVersion {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				requests = append(requests, request)
+				if r.Method == http.MethodGet {
+					if tt.existing == nil {
+						w.WriteHeader(http.StatusNotFound)
+						return
+					}
+					_ = json.NewEncoder(w).Encode(tt.existing)
+					return
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			provider := &azureDNSProvider{
+				httpClient:     server.Client(),
+				baseURL:        server.URL,
+				subscriptionID: "test-subscription",
+				resourceGroup:  "test-group",
+			}
+			change, err := provider.ChangeRecords(context.Background(), "example.com", tt.changes)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(requests).To(gomega.Equal(tt.wantRequests))
+			if !tt.wantErr {
+				g.Expect(change.IsInSync()).To(gomega.BeTrue())
+			}
+		})
+	}
+}
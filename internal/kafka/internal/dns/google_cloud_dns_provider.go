@@ -0,0 +1,216 @@
+package dns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2/jwt"
+)
+
+const (
+	googleCloudDNSBaseURL          = "https://dns.googleapis.com/dns/v1"
+	googleCloudDNSScope            = "https://www.googleapis.com/auth/ndev.clouddns.readwrite"
+	googleCloudDNSChangeStatusDone = "done"
+)
+
+var _ Provider = &googleCloudDNSProvider{}
+
+// googleCloudDNSProvider manages the records of Google Cloud DNS managed zones through the Cloud DNS REST API,
+// authenticating with the GCP service account of the fleet manager
+type googleCloudDNSProvider struct {
+	httpClient *http.Client
+	baseURL    string
+	projectID  string
+}
+
+func newGoogleCloudDNSProvider(credentials config.GCPCredentials) *googleCloudDNSProvider {
+	jwtConfig := &jwt.Config{
+		Email:        credentials.ClientEmail,
+		PrivateKey:   []byte(credentials.PrivateKey),
+		PrivateKeyID: credentials.PrivateKeyID,
+		Scopes:       []string{googleCloudDNSScope},
+		TokenURL:     credentials.TokenURI,
+	}
+
+	return &googleCloudDNSProvider{
+		httpClient: jwtConfig.Client(context.Background()),
+		baseURL:    googleCloudDNSBaseURL,
+		projectID:  credentials.ProjectID,
+	}
+}
+
+type googleCloudDNSResourceRecordSet struct {
+	Name    string   `json:"name"`
+	Type    string   `json:"type"`
+	TTL     int64    `json:"ttl"`
+	RRDatas []string `json:"rrdatas"`
+}
+
+type googleCloudDNSChange struct {
+	ID        string                            `json:"id,omitempty"`
+	Status    string                            `json:"status,omitempty"`
+	Additions []googleCloudDNSResourceRecordSet `json:"additions,omitempty"`
+	Deletions []googleCloudDNSResourceRecordSet `json:"deletions,omitempty"`
+}
+
+type googleCloudDNSResourceRecordSetList struct {
+	RRSets []googleCloudDNSResourceRecordSet `json:"rrsets"`
+}
+
+type googleCloudDNSManagedZoneList struct {
+	ManagedZones []struct {
+		Name string `json:"name"`
+	} `json:"managedZones"`
+}
+
+func (p *googleCloudDNSProvider) ChangeRecords(ctx context.Context, zone string, changes []RecordChange) (*Change, error) {
+	managedZone, err := p.findManagedZone(ctx, zone)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cloud DNS rejects the whole change when an addition already exists or when a deletion doesn't match the current
+	// record set exactly, so the changes are applied to the current record sets and the ones already applied are skipped
+	var request googleCloudDNSChange
+	for _, change := range changes {
+		recordSet := toGoogleCloudDNSResourceRecordSet(change.Record)
+		current, err := p.getResourceRecordSet(ctx, managedZone, recordSet.Name, recordSet.Type)
+		if err != nil {
+			return nil, err
+		}
+		switch change.Action {
+		case RecordActionCreate:
+			if current != nil && reflect.DeepEqual(*current, recordSet) {
+				continue
+			}
+			request.Additions = append(request.Additions, recordSet)
+		case RecordActionDelete:
+			if current == nil {
+				continue
+			}
+			request.Deletions = append(request.Deletions, *current)
+		default:
+			return nil, errors.Errorf("unsupported record action %q", change.Action)
+		}
+	}
+	if len(request.Additions) == 0 && len(request.Deletions) == 0 {
+		return &Change{Status: ChangeStatusInSync}, nil
+	}
+
+	var response googleCloudDNSChange
+	if err := p.do(ctx, http.MethodPost, fmt.Sprintf("managedZones/%s/changes", managedZone), request, &response); err != nil {
+		// the record sets to delete have been deleted or changed concurrently
+		if len(request.Additions) == 0 && hasStatusCode(err, http.StatusNotFound, http.StatusPreconditionFailed) {
+			return &Change{Status: ChangeStatusInSync}, nil
+		}
+		return nil, errors.Wrapf(err, "unable to change the records of managed zone %q", managedZone)
+	}
+
+	return toGoogleCloudDNSChange(response), nil
+}
+
+func (p *googleCloudDNSProvider) GetChange(ctx context.Context, zone string, changeID string) (*Change, error) {
+	managedZone, err := p.findManagedZone(ctx, zone)
+	if err != nil {
+		return nil, err
+	}
+
+	var response googleCloudDNSChange
+	if err := p.do(ctx, http.MethodGet, fmt.Sprintf("managedZones/%s/changes/%s", managedZone, url.PathEscape(changeID)), nil, &response); err != nil {
+		return nil, errors.Wrapf(err, "unable to get change %q of managed zone %q", changeID, managedZone)
+	}
+
+	return toGoogleCloudDNSChange(response), nil
+}
+
+// getResourceRecordSet returns the record set of the managed zone with the given name and type, or nil if there is none
+func (p *googleCloudDNSProvider) getResourceRecordSet(ctx context.Context, managedZone string, name string, recordType string) (*googleCloudDNSResourceRecordSet, error) {
+	var response googleCloudDNSResourceRecordSetList
+	query := url.Values{"name": {name}, "type": {recordType}}
+	if err := p.do(ctx, http.MethodGet, fmt.Sprintf("managedZones/%s/rrsets?%s", managedZone, query.Encode()), nil, &response); err != nil {
+		return nil, errors.Wrapf(err, "unable to get the %s record set %q of managed zone %q", recordType, name, managedZone)
+	}
+	if len(response.RRSets) == 0 {
+		return nil, nil
+	}
+	return &response.RRSets[0], nil
+}
+
+// findManagedZone returns the name of the managed zone of the project serving the domain name of the zone
+func (p *googleCloudDNSProvider) findManagedZone(ctx context.Context, zone string) (string, error) {
+	var response googleCloudDNSManagedZoneList
+	if err := p.do(ctx, http.MethodGet, "managedZones?dnsName="+url.QueryEscape(fullyQualified(zone)), nil, &response); err != nil {
+		return "", errors.Wrapf(err, "unable to list the managed zones of %q", zone)
+	}
+	if len(response.ManagedZones) == 0 {
+		return "", errors.Errorf("no managed zone found for %q in project %q", zone, p.projectID)
+	}
+	return response.ManagedZones[0].Name, nil
+}
+
+func (p *googleCloudDNSProvider) do(ctx context.Context, method string, path string, body interface{}, result interface{}) error {
+	var requestBody io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		requestBody = bytes.NewReader(payload)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s/projects/%s/%s", p.baseURL, url.PathEscape(p.projectID), path), requestBody)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := p.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return &statusCodeError{statusCode: response.StatusCode, body: string(responseBody)}
+	}
+
+	return json.Unmarshal(responseBody, result)
+}
+
+func toGoogleCloudDNSResourceRecordSet(record Record) googleCloudDNSResourceRecordSet {
+	value := record.Value
+	switch record.Type {
+	case RecordTypeCNAME:
+		value = fullyQualified(value)
+	case RecordTypeTXT:
+		value = strconv.Quote(value)
+	}
+
+	return googleCloudDNSResourceRecordSet{
+		Name:    fullyQualified(record.Name),
+		Type:    record.Type,
+		TTL:     int64(record.TTL.Seconds()),
+		RRDatas: []string{value},
+	}
+}
+
+func toGoogleCloudDNSChange(change googleCloudDNSChange) *Change {
+	status := ChangeStatusPending
+	if change.Status == googleCloudDNSChangeStatusDone {
+		status = ChangeStatusInSync
+	}
+	return &Change{ID: change.ID, Status: status}
+}
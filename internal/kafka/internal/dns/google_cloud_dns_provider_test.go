@@ -0,0 +1,161 @@
+package dns
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+)
+
+func newTestGoogleCloudDNSProvider(handler http.HandlerFunc) (*googleCloudDNSProvider, func()) {
+	server := httptest.NewServer(handler)
+	return &googleCloudDNSProvider{
+		httpClient: server.Client(),
+		baseURL:    server.URL,
+		projectID:  "test-project",
+	}, server.Close
+}
+
+func Test_googleCloudDNSProvider_ChangeRecords(t *testing.T) {
+	cname := googleCloudDNSResourceRecordSet{Name: "kafka.example.com.", Type: "CNAME", TTL: 300, RRDatas: []string{"router.example.com."}}
+	txt := googleCloudDNSResourceRecordSet{Name: "_acme-challenge.kafka.example.com.", Type: "TXT", TTL: 60, RRDatas: []string{`"challenge"`}}
+	changes := []RecordChange{
+		{
+			Action: RecordActionCreate,
+			Record: Record{Name: "kafka.example.com", Type: RecordTypeCNAME, TTL: 5 * time.Minute, Value: "router.example.com"},
+		},
+		{
+			Action: RecordActionDelete,
+			Record: Record{Name: "_acme-challenge.kafka.example.com", Type: RecordTypeTXT, TTL: time.Minute, Value: "challenge"},
+		},
+	}
+
+	// newHandler serves the managed zone of example.com with the given record sets, and answers the changes with
+	// the given status once they match the expected change
+	newHandler := func(recordSets []googleCloudDNSResourceRecordSet, expected googleCloudDNSChange, changeStatus int) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.Method == http.MethodGet && r.URL.Path == "/projects/test-project/managedZones":
+				if r.URL.Query().Get("dnsName") != "example.com." {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				_, _ = w.Write([]byte(`{"managedZones": [{"name": "example-zone"}]}`))
+			case r.Method == http.MethodGet && r.URL.Path == "/projects/test-project/managedZones/example-zone/rrsets":
+				response := googleCloudDNSResourceRecordSetList{}
+				for _, recordSet := range recordSets {
+					if recordSet.Name == r.URL.Query().Get("name") && recordSet.Type == r.URL.Query().Get("type") {
+						response.RRSets = append(response.RRSets, recordSet)
+					}
+				}
+				_ = json.NewEncoder(w).Encode(response)
+			case r.Method == http.MethodPost && r.URL.Path == "/projects/test-project/managedZones/example-zone/changes":
+				var request googleCloudDNSChange
+				if err := json.NewDecoder(r.Body).Decode(&request); err != nil || !reflect.DeepEqual(request, expected) {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				if changeStatus != http.StatusOK {
+					w.WriteHeader(changeStatus)
+					return
+				}
+				_, _ = w.Write([]byte(`{"id": "1", "status": "pending"}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}
+	}
+
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+		want    *Change
+		wantErr bool
+	}{
+		{
+			name: "should submit the changes to the managed zone serving the zone",
+			handler: newHandler([]googleCloudDNSResourceRecordSet{txt}, googleCloudDNSChange{
+				Additions: []googleCloudDNSResourceRecordSet{cname},
+				Deletions: []googleCloudDNSResourceRecordSet{txt},
+			}, http.StatusOK),
+			want: &Change{ID: "1", Status: ChangeStatusPending},
+		},
+		{
+			name: "should delete the current record set",
+			handler: newHandler([]googleCloudDNSResourceRecordSet{
+				{Name: txt.Name, Type: txt.Type, TTL: 300, RRDatas: []string{`"other-challenge"`}},
+			}, googleCloudDNSChange{
+				Additions: []googleCloudDNSResourceRecordSet{cname},
+				Deletions: []googleCloudDNSResourceRecordSet{{Name: txt.Name, Type: txt.Type, TTL: 300, RRDatas: []string{`"other-challenge"`}}},
+			}, http.StatusOK),
+			want: &Change{ID: "1", Status: ChangeStatusPending},
+		},
+		{
+			name:    "should ignore the changes already applied",
+			handler: newHandler([]googleCloudDNSResourceRecordSet{cname}, googleCloudDNSChange{}, http.StatusOK),
+			want:    &Change{Status: ChangeStatusInSync},
+		},
+		{
+			name: "should ignore the deletion of record sets deleted concurrently",
+			handler: newHandler([]googleCloudDNSResourceRecordSet{cname, txt}, googleCloudDNSChange{
+				Deletions: []googleCloudDNSResourceRecordSet{txt},
+			}, http.StatusNotFound),
+			want: &Change{Status: ChangeStatusInSync},
+		},
+		{
+			name: "should return an error when no managed zone serves the zone",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"managedZones": []}`))
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when Cloud DNS rejects the changes",
+			handler: newHandler([]googleCloudDNSResourceRecordSet{txt}, googleCloudDNSChange{
+				Additions: []googleCloudDNSResourceRecordSet{cname},
+				Deletions: []googleCloudDNSResourceRecordSet{txt},
+			}, http.StatusConflict),
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			provider, closeServer := newTestGoogleCloudDNSProvider(tt.handler)
+			defer closeServer()
+
+			got, err := provider.ChangeRecords(context.Background(), "example.com", changes)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func Test_googleCloudDNSProvider_GetChange(t *testing.T) {
+	g := gomega.NewWithT(t)
+	provider, closeServer := newTestGoogleCloudDNSProvider(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/projects/test-project/managedZones":
+			_, _ = w.Write([]byte(`{"managedZones": [{"name": "example-zone"}]}`))
+		case "/projects/test-project/managedZones/example-zone/changes/1":
+			_, _ = w.Write([]byte(`{"id": "1", "status": "done"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer closeServer()
+
+	got, err := provider.GetChange(context.Background(), "example.com", "1")
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(got).To(gomega.Equal(&Change{ID: "1", Status: ChangeStatusInSync}))
+
+	_, err = provider.GetChange(context.Background(), "example.com", "2")
+	g.Expect(err).To(gomega.HaveOccurred())
+}
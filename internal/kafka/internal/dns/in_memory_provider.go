@@ -0,0 +1,97 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+var _ Provider = &InMemoryProvider{}
+
+// InMemoryProvider keeps the records of its zones in memory. Like Route53, it applies all the changes or none of
+// them and ignores the changes already applied. Changes are in sync as soon as they are applied.
+// It is meant for local development and testing
+type InMemoryProvider struct {
+	mutex sync.Mutex
+	// zones maps the domain name of a zone to its records, indexed by type and name
+	zones   map[string]map[string]Record
+	changes map[string]*Change
+}
+
+func NewInMemoryProvider() *InMemoryProvider {
+	return &InMemoryProvider{
+		zones:   map[string]map[string]Record{},
+		changes: map[string]*Change{},
+	}
+}
+
+func (p *InMemoryProvider) ChangeRecords(ctx context.Context, zone string, changes []RecordChange) (*Change, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	zone = normalizeZone(zone)
+	records := map[string]Record{}
+	for key, record := range p.zones[zone] {
+		records[key] = record
+	}
+
+	for _, change := range changes {
+		key := recordKey(change.Record)
+		existing, exists := records[key]
+		switch change.Action {
+		case RecordActionCreate:
+			if exists && existing != change.Record {
+				return nil, errors.Errorf("%s record %q already exists in zone %q", change.Record.Type, change.Record.Name, zone)
+			}
+			records[key] = change.Record
+		case RecordActionDelete:
+			delete(records, key)
+		default:
+			return nil, errors.Errorf("unsupported record action %q", change.Action)
+		}
+	}
+
+	p.zones[zone] = records
+	change := &Change{ID: uuid.New().String(), Status: ChangeStatusInSync}
+	p.changes[change.ID] = change
+	return change, nil
+}
+
+func (p *InMemoryProvider) GetChange(ctx context.Context, zone string, changeID string) (*Change, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	change, ok := p.changes[changeID]
+	if !ok {
+		return nil, errors.Errorf("change %q not found", changeID)
+	}
+	return change, nil
+}
+
+// Records returns the records of the zone sorted by name
+func (p *InMemoryProvider) Records(zone string) []Record {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	records := make([]Record, 0, len(p.zones[normalizeZone(zone)]))
+	for _, record := range p.zones[normalizeZone(zone)] {
+		records = append(records, record)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].Name < records[j].Name
+	})
+	return records
+}
+
+func normalizeZone(zone string) string {
+	return strings.ToLower(strings.TrimSuffix(zone, "."))
+}
+
+func recordKey(record Record) string {
+	return fmt.Sprintf("%s/%s", record.Type, strings.ToLower(strings.TrimSuffix(record.Name, ".")))
+}
@@ -0,0 +1,133 @@
+package dns
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+)
+
+func Test_InMemoryProvider_ChangeRecords(t *testing.T) {
+	cname := Record{
+		Name:  "admin-server-kafka.example.com",
+		Type:  RecordTypeCNAME,
+		TTL:   5 * time.Minute,
+		Value: "router.cluster.example.com",
+	}
+	txt := Record{
+		Name:  "_acme-challenge.kafka.example.com",
+		Type:  RecordTypeTXT,
+		TTL:   time.Minute,
+		Value: "challenge",
+	}
+
+	type args struct {
+		zone    string
+		changes []RecordChange
+	}
+	tests := []struct {
+		name        string
+		records     []Record
+		args        args
+		wantRecords []Record
+		wantErr     bool
+	}{
+		{
+			name: "should create the records",
+			args: args{
+				zone: "example.com",
+				changes: []RecordChange{
+					{Action: RecordActionCreate, Record: cname},
+					{Action: RecordActionCreate, Record: txt},
+				},
+			},
+			wantRecords: []Record{txt, cname},
+		},
+		{
+			name:    "should delete the records",
+			records: []Record{cname, txt},
+			args: args{
+				zone: "example.com.",
+				changes: []RecordChange{
+					{Action: RecordActionDelete, Record: txt},
+				},
+			},
+			wantRecords: []Record{cname},
+		},
+		{
+			name:    "should ignore the creation of a record that already exists with the same value",
+			records: []Record{cname},
+			args: args{
+				zone: "example.com",
+				changes: []RecordChange{
+					{Action: RecordActionCreate, Record: txt},
+					{Action: RecordActionCreate, Record: cname},
+				},
+			},
+			wantRecords: []Record{txt, cname},
+		},
+		{
+			name:    "should fail to create an existing record with another value and apply none of the changes",
+			records: []Record{cname},
+			args: args{
+				zone: "example.com",
+				changes: []RecordChange{
+					{Action: RecordActionCreate, Record: txt},
+					{Action: RecordActionCreate, Record: Record{Name: cname.Name, Type: cname.Type, TTL: cname.TTL, Value: "other.example.com"}},
+				},
+			},
+			wantRecords: []Record{cname},
+			wantErr:     true,
+		},
+		{
+			name: "should ignore the deletion of a missing record",
+			args: args{
+				zone: "example.com",
+				changes: []RecordChange{
+					{Action: RecordActionDelete, Record: cname},
+				},
+			},
+			wantRecords: []Record{},
+		},
+		{
+			name: "should fail on an unsupported action",
+			args: args{
+				zone: "example.com",
+				changes: []RecordChange{
+					{Action: "UPSERT", Record: cname},
+				},
+			},
+			wantRecords: []Record{},
+			wantErr:     true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			provider := NewInMemoryProvider()
+			for _, record := range tt.records {
+				_, err := provider.ChangeRecords(context.Background(), tt.args.zone, []RecordChange{{Action: RecordActionCreate, Record: record}})
+				g.Expect(err).ToNot(gomega.HaveOccurred())
+			}
+
+			change, err := provider.ChangeRecords(context.Background(), tt.args.zone, tt.args.changes)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(provider.Records(tt.args.zone)).To(gomega.Equal(tt.wantRecords))
+			if !tt.wantErr {
+				g.Expect(change.IsInSync()).To(gomega.BeTrue())
+				got, err := provider.GetChange(context.Background(), tt.args.zone, change.ID)
+				g.Expect(err).ToNot(gomega.HaveOccurred())
+				g.Expect(got).To(gomega.Equal(change))
+			}
+		})
+	}
+}
+
+func Test_InMemoryProvider_GetChange(t *testing.T) {
+	g := gomega.NewWithT(t)
+	_, err := NewInMemoryProvider().GetChange(context.Background(), "example.com", "unknown")
+	g.Expect(err).To(gomega.HaveOccurred())
+}
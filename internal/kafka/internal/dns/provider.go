@@ -0,0 +1,107 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type RecordAction string
+
+func (a RecordAction) String() string {
+	return string(a)
+}
+
+const (
+	RecordActionCreate RecordAction = "CREATE"
+	RecordActionDelete RecordAction = "DELETE"
+)
+
+const (
+	RecordTypeCNAME = "CNAME"
+	RecordTypeTXT   = "TXT"
+)
+
+type ChangeStatus string
+
+const (
+	// ChangeStatusPending is the status of a change not yet propagated to all the name servers of its zone
+	ChangeStatusPending ChangeStatus = "pending"
+	// ChangeStatusInSync is the status of a change propagated to all the name servers of its zone
+	ChangeStatusInSync ChangeStatus = "in_sync"
+)
+
+// Record is a DNS record. Its name is fully qualified, without the trailing dot, and its value is not quoted
+type Record struct {
+	Name  string
+	Type  string
+	TTL   time.Duration
+	Value string
+}
+
+type RecordChange struct {
+	Action RecordAction
+	Record Record
+}
+
+// Change identifies a set of record changes applied to a zone along with their propagation status
+type Change struct {
+	ID     string
+	Status ChangeStatus
+}
+
+func (c *Change) IsInSync() bool {
+	return c.Status == ChangeStatusInSync
+}
+
+//go:generate moq -out provider_moq.go . Provider
+type Provider interface {
+	// ChangeRecords applies the changes to the records of the zone identified by its domain name.
+	// Changes already applied, e.g. by a previous attempt, are ignored: deleting a missing record succeeds, and so does
+	// creating a record that already exists with the same value
+	ChangeRecords(ctx context.Context, zone string, changes []RecordChange) (*Change, error)
+	// GetChange returns the propagation status of a change previously applied to the zone
+	GetChange(ctx context.Context, zone string, changeID string) (*Change, error)
+}
+
+// relativeRecordName returns the name of the record relative to its zone, "@" being the apex of the zone
+func relativeRecordName(name string, zone string) string {
+	name = strings.TrimSuffix(name, ".")
+	zone = strings.TrimSuffix(zone, ".")
+	if name == zone {
+		return "@"
+	}
+	return strings.TrimSuffix(name, "."+zone)
+}
+
+// fullyQualified returns the name with its trailing dot
+func fullyQualified(name string) string {
+	return strings.TrimSuffix(name, ".") + "."
+}
+
+// statusCodeError is returned by the providers calling a REST API when a request fails with an unexpected status code
+type statusCodeError struct {
+	statusCode int
+	body       string
+}
+
+func (e *statusCodeError) Error() string {
+	return fmt.Sprintf("unexpected status code %d: %s", e.statusCode, e.body)
+}
+
+// hasStatusCode returns whether the error is a statusCodeError with one of the given status codes
+func hasStatusCode(err error, statusCodes ...int) bool {
+	var statusErr *statusCodeError
+	if !errors.As(err, &statusErr) {
+		return false
+	}
+	for _, statusCode := range statusCodes {
+		if statusErr.statusCode == statusCode {
+			return true
+		}
+	}
+	return false
+}
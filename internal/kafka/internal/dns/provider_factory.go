@@ -0,0 +1,67 @@
+package dns
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/aws"
+	"github.com/pkg/errors"
+)
+
+//go:generate moq -out provider_factory_moq.go . ProviderFactory
+type ProviderFactory interface {
+	// GetProvider returns the DNS provider managing the records of the Kafka instances of the given cloud provider
+	GetProvider(cloudProvider string) (Provider, error)
+	// GetDefaultProvider returns the DNS provider of the cloud providers without a provider of their own, which also
+	// solves the ACME DNS-01 challenges
+	GetDefaultProvider() (Provider, error)
+}
+
+// DefaultProviderFactory the default implementation for ProviderFactory. Only the DNS providers in use are created
+type DefaultProviderFactory struct {
+	dnsConfig         *config.DNSConfig
+	providerContainer map[string]Provider
+}
+
+func NewDefaultProviderFactory(
+	dnsConfig *config.DNSConfig,
+	awsConfig *config.AWSConfig,
+	gcpConfig *config.GCPConfig,
+	awsClientFactory aws.ClientFactory,
+) *DefaultProviderFactory {
+	providerContainer := map[string]Provider{}
+	for _, providerType := range dnsConfig.UsedProviders() {
+		switch providerType {
+		case config.Route53DNSProvider:
+			providerContainer[providerType] = newRoute53Provider(awsClientFactory, aws.Config{
+				AccessKeyID:     awsConfig.Route53.AccessKey,
+				SecretAccessKey: awsConfig.Route53.SecretAccessKey,
+			}, aws.DefaultAWSRoute53Region)
+		case config.GoogleCloudDNSProvider:
+			providerContainer[providerType] = newGoogleCloudDNSProvider(gcpConfig.GCPCredentials)
+		case config.AzureDNSProvider:
+			providerContainer[providerType] = newAzureDNSProvider(dnsConfig.AzureDNSCredentials)
+		case config.InMemoryDNSProvider:
+			providerContainer[providerType] = NewInMemoryProvider()
+		}
+	}
+
+	return &DefaultProviderFactory{
+		dnsConfig:         dnsConfig,
+		providerContainer: providerContainer,
+	}
+}
+
+func (d *DefaultProviderFactory) GetProvider(cloudProvider string) (Provider, error) {
+	return d.getProvider(d.dnsConfig.GetProvider(cloudProvider))
+}
+
+func (d *DefaultProviderFactory) GetDefaultProvider() (Provider, error) {
+	return d.getProvider(d.dnsConfig.DefaultProvider)
+}
+
+func (d *DefaultProviderFactory) getProvider(providerType string) (Provider, error) {
+	provider, ok := d.providerContainer[providerType]
+	if !ok {
+		return nil, errors.Errorf("invalid DNS provider type: %v", providerType)
+	}
+	return provider, nil
+}
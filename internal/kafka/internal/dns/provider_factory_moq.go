@@ -0,0 +1,111 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package dns
+
+import (
+	"sync"
+)
+
+// Ensure, that ProviderFactoryMock does implement ProviderFactory.
+// If this is not the case, regenerate this file with moq.
+var _ ProviderFactory = &ProviderFactoryMock{}
+
+// ProviderFactoryMock is a mock implementation of ProviderFactory.
+//
+//	func TestSomethingThatUsesProviderFactory(t *testing.T) {
+//
+//		// make and configure a mocked ProviderFactory
+//		mockedProviderFactory := &ProviderFactoryMock{
+//			GetDefaultProviderFunc: func() (Provider, error) {
+//				panic("mock out the GetDefaultProvider method")
+//			},
+//			GetProviderFunc: func(cloudProvider string) (Provider, error) {
+//				panic("mock out the GetProvider method")
+//			},
+//		}
+//
+//		// use mockedProviderFactory in code that requires ProviderFactory
+//		// and then make assertions.
+//
+//	}
+type ProviderFactoryMock struct {
+	// GetDefaultProviderFunc mocks the GetDefaultProvider method.
+	GetDefaultProviderFunc func() (Provider, error)
+
+	// GetProviderFunc mocks the GetProvider method.
+	GetProviderFunc func(cloudProvider string) (Provider, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetDefaultProvider holds details about calls to the GetDefaultProvider method.
+		GetDefaultProvider []struct {
+		}
+		// GetProvider holds details about calls to the GetProvider method.
+		GetProvider []struct {
+			// CloudProvider is the cloudProvider argument value.
+			CloudProvider string
+		}
+	}
+	lockGetDefaultProvider sync.RWMutex
+	lockGetProvider        sync.RWMutex
+}
+
+// GetDefaultProvider calls GetDefaultProviderFunc.
+func (mock *ProviderFactoryMock) GetDefaultProvider() (Provider, error) {
+	if mock.GetDefaultProviderFunc == nil {
+		panic("ProviderFactoryMock.GetDefaultProviderFunc: method is nil but ProviderFactory.GetDefaultProvider was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetDefaultProvider.Lock()
+	mock.calls.GetDefaultProvider = append(mock.calls.GetDefaultProvider, callInfo)
+	mock.lockGetDefaultProvider.Unlock()
+	return mock.GetDefaultProviderFunc()
+}
+
+// GetDefaultProviderCalls gets all the calls that were made to GetDefaultProvider.
+// Check the length with:
+//
+//	len(mockedProviderFactory.GetDefaultProviderCalls())
+func (mock *ProviderFactoryMock) GetDefaultProviderCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetDefaultProvider.RLock()
+	calls = mock.calls.GetDefaultProvider
+	mock.lockGetDefaultProvider.RUnlock()
+	return calls
+}
+
+// GetProvider calls GetProviderFunc.
+func (mock *ProviderFactoryMock) GetProvider(cloudProvider string) (Provider, error) {
+	if mock.GetProviderFunc == nil {
+		panic("ProviderFactoryMock.GetProviderFunc: method is nil but ProviderFactory.GetProvider was just called")
+	}
+	callInfo := struct {
+		CloudProvider string
+	}{
+		CloudProvider: cloudProvider,
+	}
+	mock.lockGetProvider.Lock()
+	mock.calls.GetProvider = append(mock.calls.GetProvider, callInfo)
+	mock.lockGetProvider.Unlock()
+	return mock.GetProviderFunc(cloudProvider)
+}
+
+// GetProviderCalls gets all the calls that were made to GetProvider.
+// Check the length with:
+//
+//	len(mockedProviderFactory.GetProviderCalls())
+func (mock *ProviderFactoryMock) GetProviderCalls() []struct {
+	CloudProvider string
+} {
+	var calls []struct {
+		CloudProvider string
+	}
+	mock.lockGetProvider.RLock()
+	calls = mock.calls.GetProvider
+	mock.lockGetProvider.RUnlock()
+	return calls
+}
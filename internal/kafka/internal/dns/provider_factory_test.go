@@ -0,0 +1,85 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/aws"
+	"github.com/onsi/gomega"
+)
+
+func Test_DefaultProviderFactory_GetProvider(t *testing.T) {
+	dnsConfig := &config.DNSConfig{
+		DefaultProvider: config.Route53DNSProvider,
+		CloudProviderDNSProviders: map[string]string{
+			"gcp":   config.GoogleCloudDNSProvider,
+			"azure": config.AzureDNSProvider,
+		},
+	}
+	factory := NewDefaultProviderFactory(dnsConfig, config.NewAWSConfig(), config.NewGCPConfig(), &aws.MockClientFactory{})
+
+	tests := []struct {
+		name          string
+		cloudProvider string
+		want          Provider
+	}{
+		{
+			name:          "should return the default provider for a cloud provider without a provider of its own",
+			cloudProvider: "aws",
+			want:          &route53Provider{},
+		},
+		{
+			name:          "should return Google Cloud DNS for gcp",
+			cloudProvider: "gcp",
+			want:          &googleCloudDNSProvider{},
+		},
+		{
+			name:          "should return Azure DNS for azure",
+			cloudProvider: "azure",
+			want:          &azureDNSProvider{},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			got, err := factory.GetProvider(tt.cloudProvider)
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			g.Expect(got).To(gomega.BeAssignableToTypeOf(tt.want))
+		})
+	}
+}
+
+func Test_DefaultProviderFactory_GetDefaultProvider(t *testing.T) {
+	tests := []struct {
+		name      string
+		dnsConfig *config.DNSConfig
+		want      Provider
+		wantErr   bool
+	}{
+		{
+			name:      "should return the default provider",
+			dnsConfig: &config.DNSConfig{DefaultProvider: config.InMemoryDNSProvider},
+			want:      &InMemoryProvider{},
+		},
+		{
+			name:      "should return an error for an invalid provider",
+			dnsConfig: &config.DNSConfig{DefaultProvider: "unknown"},
+			wantErr:   true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			factory := NewDefaultProviderFactory(tt.dnsConfig, config.NewAWSConfig(), config.NewGCPConfig(), &aws.MockClientFactory{})
+			got, err := factory.GetDefaultProvider()
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if !tt.wantErr {
+				g.Expect(got).To(gomega.BeAssignableToTypeOf(tt.want))
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package dns
+
+import (
+	"context"
+	"sync"
+)
+
+// Ensure, that ProviderMock does implement Provider.
+// If this is not the case, regenerate this file with moq.
+var _ Provider = &ProviderMock{}
+
+// ProviderMock is a mock implementation of Provider.
+//
+//	func TestSomethingThatUsesProvider(t *testing.T) {
+//
+//		// make and configure a mocked Provider
+//		mockedProvider := &ProviderMock{
+//			ChangeRecordsFunc: func(ctx context.Context, zone string, changes []RecordChange) (*Change, error) {
+//				panic("mock out the ChangeRecords method")
+//			},
+//			GetChangeFunc: func(ctx context.Context, zone string, changeID string) (*Change, error) {
+//				panic("mock out the GetChange method")
+//			},
+//		}
+//
+//		// use mockedProvider in code that requires Provider
+//		// and then make assertions.
+//
+//	}
+type ProviderMock struct {
+	// ChangeRecordsFunc mocks the ChangeRecords method.
+	ChangeRecordsFunc func(ctx context.Context, zone string, changes []RecordChange) (*Change, error)
+
+	// GetChangeFunc mocks the GetChange method.
+	GetChangeFunc func(ctx context.Context, zone string, changeID string) (*Change, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ChangeRecords holds details about calls to the ChangeRecords method.
+		ChangeRecords []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Zone is the zone argument value.
+			Zone string
+			// Changes is the changes argument value.
+			Changes []RecordChange
+		}
+		// GetChange holds details about calls to the GetChange method.
+		GetChange []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Zone is the zone argument value.
+			Zone string
+			// ChangeID is the changeID argument value.
+			ChangeID string
+		}
+	}
+	lockChangeRecords sync.RWMutex
+	lockGetChange     sync.RWMutex
+}
+
+// ChangeRecords calls ChangeRecordsFunc.
+func (mock *ProviderMock) ChangeRecords(ctx context.Context, zone string, changes []RecordChange) (*Change, error) {
+	if mock.ChangeRecordsFunc == nil {
+		panic("ProviderMock.ChangeRecordsFunc: method is nil but Provider.ChangeRecords was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Zone    string
+		Changes []RecordChange
+	}{
+		Ctx:     ctx,
+		Zone:    zone,
+		Changes: changes,
+	}
+	mock.lockChangeRecords.Lock()
+	mock.calls.ChangeRecords = append(mock.calls.ChangeRecords, callInfo)
+	mock.lockChangeRecords.Unlock()
+	return mock.ChangeRecordsFunc(ctx, zone, changes)
+}
+
+// ChangeRecordsCalls gets all the calls that were made to ChangeRecords.
+// Check the length with:
+//
+//	len(mockedProvider.ChangeRecordsCalls())
+func (mock *ProviderMock) ChangeRecordsCalls() []struct {
+	Ctx     context.Context
+	Zone    string
+	Changes []RecordChange
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Zone    string
+		Changes []RecordChange
+	}
+	mock.lockChangeRecords.RLock()
+	calls = mock.calls.ChangeRecords
+	mock.lockChangeRecords.RUnlock()
+	return calls
+}
+
+// GetChange calls GetChangeFunc.
+func (mock *ProviderMock) GetChange(ctx context.Context, zone string, changeID string) (*Change, error) {
+	if mock.GetChangeFunc == nil {
+		panic("ProviderMock.GetChangeFunc: method is nil but Provider.GetChange was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Zone     string
+		ChangeID string
+	}{
+		Ctx:      ctx,
+		Zone:     zone,
+		ChangeID: changeID,
+	}
+	mock.lockGetChange.Lock()
+	mock.calls.GetChange = append(mock.calls.GetChange, callInfo)
+	mock.lockGetChange.Unlock()
+	return mock.GetChangeFunc(ctx, zone, changeID)
+}
+
+// GetChangeCalls gets all the calls that were made to GetChange.
+// Check the length with:
+//
+//	len(mockedProvider.GetChangeCalls())
+func (mock *ProviderMock) GetChangeCalls() []struct {
+	Ctx      context.Context
+	Zone     string
+	ChangeID string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Zone     string
+		ChangeID string
+	}
+	mock.lockGetChange.RLock()
+	calls = mock.calls.GetChange
+	mock.lockGetChange.RUnlock()
+	return calls
+}
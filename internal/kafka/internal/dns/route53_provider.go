@@ -0,0 +1,103 @@
+package dns
+
+import (
+	"context"
+	"strconv"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/aws"
+	"github.com/pkg/errors"
+)
+
+const route53ChangeStatusInSync = "INSYNC"
+
+var _ Provider = &route53Provider{}
+
+// route53Provider manages the records of AWS Route53 hosted zones. Route53 is a global service: the region only
+// selects the endpoint the requests are sent to
+type route53Provider struct {
+	awsClientFactory aws.ClientFactory
+	credentials      aws.Config
+	region           string
+}
+
+func newRoute53Provider(awsClientFactory aws.ClientFactory, credentials aws.Config, region string) *route53Provider {
+	return &route53Provider{
+		awsClientFactory: awsClientFactory,
+		credentials:      credentials,
+		region:           region,
+	}
+}
+
+func (p *route53Provider) ChangeRecords(ctx context.Context, zone string, changes []RecordChange) (*Change, error) {
+	client, err := p.awsClientFactory.NewClient(p.credentials, p.region)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create aws client")
+	}
+
+	output, err := client.ChangeResourceRecordSets(zone, buildRoute53ChangeBatch(changes))
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to change the record sets of hosted zone %q", zone)
+	}
+	// the client ignores the change batches whose records are already created or deleted
+	if output == nil || output.ChangeInfo == nil {
+		return &Change{Status: ChangeStatusInSync}, nil
+	}
+
+	return toRoute53Change(output.ChangeInfo), nil
+}
+
+func (p *route53Provider) GetChange(ctx context.Context, zone string, changeID string) (*Change, error) {
+	client, err := p.awsClientFactory.NewClient(p.credentials, p.region)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create aws client")
+	}
+
+	output, err := client.GetChange(changeID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to get status of Route53 change batch request with ID %q", changeID)
+	}
+
+	return toRoute53Change(output.ChangeInfo), nil
+}
+
+func toRoute53Change(changeInfo *route53.ChangeInfo) *Change {
+	change := &Change{
+		ID:     awssdk.StringValue(changeInfo.Id),
+		Status: ChangeStatusPending,
+	}
+	if awssdk.StringValue(changeInfo.Status) == route53ChangeStatusInSync {
+		change.Status = ChangeStatusInSync
+	}
+	return change
+}
+
+func buildRoute53ChangeBatch(changes []RecordChange) *route53.ChangeBatch {
+	route53Changes := make([]*route53.Change, 0, len(changes))
+	for _, change := range changes {
+		value := change.Record.Value
+		// Route53 expects the values of TXT records to be quoted
+		if change.Record.Type == RecordTypeTXT {
+			value = strconv.Quote(value)
+		}
+
+		route53Changes = append(route53Changes, &route53.Change{
+			Action: awssdk.String(change.Action.String()),
+			ResourceRecordSet: &route53.ResourceRecordSet{
+				Name: awssdk.String(change.Record.Name),
+				Type: awssdk.String(change.Record.Type),
+				TTL:  awssdk.Int64(int64(change.Record.TTL.Seconds())),
+				ResourceRecords: []*route53.ResourceRecord{
+					{
+						Value: awssdk.String(value),
+					},
+				},
+			},
+		})
+	}
+
+	return &route53.ChangeBatch{
+		Changes: route53Changes,
+	}
+}
@@ -0,0 +1,154 @@
+package dns
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/aws"
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+)
+
+func Test_route53Provider_ChangeRecords(t *testing.T) {
+	changes := []RecordChange{
+		{
+			Action: RecordActionCreate,
+			Record: Record{Name: "kafka.example.com", Type: RecordTypeCNAME, TTL: 5 * time.Minute, Value: "router.example.com"},
+		},
+		{
+			Action: RecordActionDelete,
+			Record: Record{Name: "_acme-challenge.kafka.example.com", Type: RecordTypeTXT, TTL: time.Minute, Value: "challenge"},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		client  aws.AWSClient
+		want    *Change
+		wantErr bool
+	}{
+		{
+			name: "should submit the changes as a single change batch",
+			client: &aws.AWSClientMock{
+				ChangeResourceRecordSetsFunc: func(dnsName string, recordChangeBatch *route53.ChangeBatch) (*route53.ChangeResourceRecordSetsOutput, error) {
+					if dnsName != "example.com" {
+						return nil, errors.Errorf("unexpected hosted zone %q", dnsName)
+					}
+					expected := &route53.ChangeBatch{
+						Changes: []*route53.Change{
+							{
+								Action: awssdk.String("CREATE"),
+								ResourceRecordSet: &route53.ResourceRecordSet{
+									Name:            awssdk.String("kafka.example.com"),
+									Type:            awssdk.String("CNAME"),
+									TTL:             awssdk.Int64(300),
+									ResourceRecords: []*route53.ResourceRecord{{Value: awssdk.String("router.example.com")}},
+								},
+							},
+							{
+								Action: awssdk.String("DELETE"),
+								ResourceRecordSet: &route53.ResourceRecordSet{
+									Name:            awssdk.String("_acme-challenge.kafka.example.com"),
+									Type:            awssdk.String("TXT"),
+									TTL:             awssdk.Int64(60),
+									ResourceRecords: []*route53.ResourceRecord{{Value: awssdk.String(`"challenge"`)}},
+								},
+							},
+						},
+					}
+					if recordChangeBatch.String() != expected.String() {
+						return nil, errors.Errorf("unexpected change batch %s", recordChangeBatch)
+					}
+					return &route53.ChangeResourceRecordSetsOutput{
+						ChangeInfo: &route53.ChangeInfo{Id: awssdk.String("change-id"), Status: awssdk.String(route53.ChangeStatusPending)},
+					}, nil
+				},
+			},
+			want: &Change{ID: "change-id", Status: ChangeStatusPending},
+		},
+		{
+			name: "should return an in sync change when the records are already in the requested state",
+			client: &aws.AWSClientMock{
+				ChangeResourceRecordSetsFunc: func(dnsName string, recordChangeBatch *route53.ChangeBatch) (*route53.ChangeResourceRecordSetsOutput, error) {
+					return nil, nil
+				},
+			},
+			want: &Change{Status: ChangeStatusInSync},
+		},
+		{
+			name: "should return an error when Route53 rejects the changes",
+			client: &aws.AWSClientMock{
+				ChangeResourceRecordSetsFunc: func(dnsName string, recordChangeBatch *route53.ChangeBatch) (*route53.ChangeResourceRecordSetsOutput, error) {
+					return nil, errors.New("InvalidChangeBatch")
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			provider := newRoute53Provider(aws.NewMockClientFactory(tt.client), aws.Config{}, aws.DefaultAWSRoute53Region)
+			got, err := provider.ChangeRecords(context.Background(), "example.com", changes)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func Test_route53Provider_GetChange(t *testing.T) {
+	tests := []struct {
+		name    string
+		client  aws.AWSClient
+		want    *Change
+		wantErr bool
+	}{
+		{
+			name: "should return an in sync change",
+			client: &aws.AWSClientMock{
+				GetChangeFunc: func(changeId string) (*route53.GetChangeOutput, error) {
+					return &route53.GetChangeOutput{
+						ChangeInfo: &route53.ChangeInfo{Id: awssdk.String(changeId), Status: awssdk.String(route53.ChangeStatusInsync)},
+					}, nil
+				},
+			},
+			want: &Change{ID: "change-id", Status: ChangeStatusInSync},
+		},
+		{
+			name: "should return a pending change",
+			client: &aws.AWSClientMock{
+				GetChangeFunc: func(changeId string) (*route53.GetChangeOutput, error) {
+					return &route53.GetChangeOutput{
+						ChangeInfo: &route53.ChangeInfo{Id: awssdk.String(changeId), Status: awssdk.String(route53.ChangeStatusPending)},
+					}, nil
+				},
+			},
+			want: &Change{ID: "change-id", Status: ChangeStatusPending},
+		},
+		{
+			name: "should return an error when the change cannot be retrieved",
+			client: &aws.AWSClientMock{
+				GetChangeFunc: func(changeId string) (*route53.GetChangeOutput, error) {
+					return nil, errors.New("NoSuchChange")
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			provider := newRoute53Provider(aws.NewMockClientFactory(tt.client), aws.Config{}, aws.DefaultAWSRoute53Region)
+			got, err := provider.GetChange(context.Background(), "example.com", "change-id")
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+)
+
+type adminCapacityReportHandler struct {
+	capacityForecastService services.CapacityForecastService
+}
+
+func NewAdminCapacityReportHandler(capacityForecastService services.CapacityForecastService) *adminCapacityReportHandler {
+	return &adminCapacityReportHandler{
+		capacityForecastService: capacityForecastService,
+	}
+}
+
+func (h adminCapacityReportHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			report, err := h.capacityForecastService.GetCapacityReport()
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentCapacityReport(report), nil
+		},
+	}
+
+	handlers.HandleGet(w, r, cfg)
+}
@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+)
+
+func Test_AdminCapacityReportHandler_Get(t *testing.T) {
+	tests := []struct {
+		name           string
+		reportErr      *errors.ServiceError
+		wantStatusCode int
+	}{
+		{
+			name:           "should return the capacity report",
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "should return an error if the capacity report cannot be computed",
+			reportErr:      errors.GeneralError("test"),
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			h := NewAdminCapacityReportHandler(&services.CapacityForecastServiceMock{
+				GetCapacityReportFunc: func() (*services.CapacityReport, *errors.ServiceError) {
+					if tt.reportErr != nil {
+						return nil, tt.reportErr
+					}
+					return &services.CapacityReport{
+						Horizon:  time.Hour,
+						Lookback: 168 * time.Hour,
+						Items: []services.CapacityReportItem{
+							{
+								StreamingUnitsForecast: services.StreamingUnitsForecast{CloudProvider: "aws", Region: "us-east-1", InstanceType: "standard", ForecastedStreamingUnits: 3},
+								FreeStreamingUnits:     5,
+							},
+						},
+					}, nil
+				},
+			})
+			req, rw := GetHandlerParams(http.MethodGet, "/capacity_report", nil, t)
+			h.Get(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			if tt.reportErr == nil {
+				var report private.CapacityReport
+				g.Expect(json.NewDecoder(resp.Body).Decode(&report)).To(gomega.Succeed())
+				g.Expect(report.ForecastHorizonMinutes).To(gomega.Equal(int32(60)))
+				g.Expect(report.Items).To(gomega.HaveLen(1))
+				g.Expect(report.Items[0].ForecastedStreamingUnits).To(gomega.Equal(int32(3)))
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/gorilla/mux"
+)
+
+type adminClusterBlueprintHandler struct {
+	clusterBlueprintService services.ClusterBlueprintService
+	providerConfig          *config.ProviderConfig
+}
+
+func NewAdminClusterBlueprintHandler(clusterBlueprintService services.ClusterBlueprintService, providerConfig *config.ProviderConfig) *adminClusterBlueprintHandler {
+	return &adminClusterBlueprintHandler{
+		clusterBlueprintService: clusterBlueprintService,
+		providerConfig:          providerConfig,
+	}
+}
+
+func (h adminClusterBlueprintHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			blueprints, err := h.clusterBlueprintService.List()
+			if err != nil {
+				return nil, err
+			}
+
+			blueprintList := private.ClusterBlueprintList{
+				Kind:  "ClusterBlueprintList",
+				Page:  1,
+				Size:  int32(len(blueprints)),
+				Total: int32(len(blueprints)),
+				Items: []private.ClusterBlueprint{},
+			}
+
+			for _, blueprint := range blueprints {
+				converted, err := presenters.PresentClusterBlueprint(blueprint)
+				if err != nil {
+					return nil, err
+				}
+				blueprintList.Items = append(blueprintList.Items, *converted)
+			}
+
+			return blueprintList, nil
+		},
+	}
+
+	handlers.HandleList(w, r, cfg)
+}
+
+func (h adminClusterBlueprintHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			blueprint, err := h.clusterBlueprintService.Get(mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentClusterBlueprint(blueprint)
+		},
+	}
+
+	handlers.HandleGet(w, r, cfg)
+}
+
+func (h adminClusterBlueprintHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var request private.ClusterBlueprintRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &request,
+		Validate: []handlers.Validate{
+			validateClusterBlueprintRequest(&request, h.providerConfig),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			blueprint, err := presenters.ConvertClusterBlueprintRequest(request, nil)
+			if err != nil {
+				return nil, err
+			}
+			if err := h.clusterBlueprintService.Create(blueprint); err != nil {
+				return nil, err
+			}
+			return presenters.PresentClusterBlueprint(blueprint)
+		},
+	}
+
+	handlers.Handle(w, r, cfg, http.StatusCreated)
+}
+
+func (h adminClusterBlueprintHandler) Update(w http.ResponseWriter, r *http.Request) {
+	blueprint, getErr := h.clusterBlueprintService.Get(mux.Vars(r)["id"])
+
+	var request private.ClusterBlueprintRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &request,
+		Validate: []handlers.Validate{
+			func() *errors.ServiceError {
+				return getErr
+			},
+			validateClusterBlueprintRequest(&request, h.providerConfig),
+			validateClusterBlueprintLocationUnchanged(&request, blueprint),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			updated, err := presenters.ConvertClusterBlueprintRequest(request, blueprint)
+			if err != nil {
+				return nil, err
+			}
+			if err := h.clusterBlueprintService.Update(updated); err != nil {
+				return nil, err
+			}
+			return presenters.PresentClusterBlueprint(updated)
+		},
+	}
+
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+func (h adminClusterBlueprintHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			return nil, h.clusterBlueprintService.Delete(mux.Vars(r)["id"])
+		},
+	}
+
+	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
+}
+
+func (h adminClusterBlueprintHandler) Plan(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			blueprint, err := h.clusterBlueprintService.Get(mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+
+			plan, err := h.clusterBlueprintService.Plan(blueprint)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentClusterBlueprintPlan(plan), nil
+		},
+	}
+
+	handlers.HandleGet(w, r, cfg)
+}
@@ -0,0 +1,259 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/gorilla/mux"
+	"github.com/onsi/gomega"
+)
+
+func clusterBlueprintProviderConfig() *config.ProviderConfig {
+	instanceTypes := config.InstanceTypeMap{
+		"standard":  {},
+		"developer": {},
+	}
+	return &config.ProviderConfig{
+		ProvidersConfig: config.ProviderConfiguration{
+			SupportedProviders: config.ProviderList{
+				{
+					Name: "aws",
+					Regions: config.RegionList{
+						{Name: "us-east-1", SupportedInstanceTypes: instanceTypes},
+						{Name: "af-south-1", SupportedInstanceTypes: instanceTypes},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildClusterBlueprint() *dbapi.ClusterBlueprint {
+	return &dbapi.ClusterBlueprint{
+		Meta:                  api.Meta{ID: "blueprint-id"},
+		CloudProvider:         "aws",
+		Region:                "us-east-1",
+		ClusterCount:          2,
+		SupportedInstanceType: "standard,developer",
+		MachinePools:          api.JSON(`[{"instance_type":"standard","machine_type":"m5.2xlarge","min_compute_nodes":3,"max_compute_nodes":9}]`),
+	}
+}
+
+func buildClusterBlueprintRequest(modifyFn func(request *private.ClusterBlueprintRequest)) []byte {
+	request := private.ClusterBlueprintRequest{
+		CloudProvider:         "aws",
+		Region:                "us-east-1",
+		ClusterCount:          2,
+		SupportedInstanceType: "standard,developer",
+		MachinePools: []private.ClusterBlueprintMachinePool{
+			{InstanceType: "standard", MachineType: "m5.2xlarge", MinComputeNodes: 3, MaxComputeNodes: 9},
+		},
+	}
+	if modifyFn != nil {
+		modifyFn(&request)
+	}
+	body, _ := json.Marshal(request)
+	return body
+}
+
+func Test_AdminClusterBlueprintHandler_Create(t *testing.T) {
+	tests := []struct {
+		name            string
+		body            []byte
+		createErr       *errors.ServiceError
+		wantStatusCode  int
+		wantCreateCalls int
+	}{
+		{
+			name:            "should create the cluster blueprint",
+			body:            buildClusterBlueprintRequest(nil),
+			wantStatusCode:  http.StatusCreated,
+			wantCreateCalls: 1,
+		},
+		{
+			name: "should return a bad request if the region is not supported",
+			body: buildClusterBlueprintRequest(func(request *private.ClusterBlueprintRequest) {
+				request.Region = "eu-west-1"
+			}),
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "should return a bad request if an instance type is not supported",
+			body: buildClusterBlueprintRequest(func(request *private.ClusterBlueprintRequest) {
+				request.SupportedInstanceType = "standard,enterprise"
+			}),
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "should return a bad request if a machine pool is not for a supported instance type",
+			body: buildClusterBlueprintRequest(func(request *private.ClusterBlueprintRequest) {
+				request.SupportedInstanceType = "developer"
+			}),
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "should return a bad request if the compute nodes of a machine pool are invalid",
+			body: buildClusterBlueprintRequest(func(request *private.ClusterBlueprintRequest) {
+				request.MachinePools[0].MinComputeNodes = 12
+			}),
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:            "should return a conflict if a blueprint already exists for the region",
+			body:            buildClusterBlueprintRequest(nil),
+			createErr:       errors.Conflict("a cluster blueprint already exists"),
+			wantStatusCode:  http.StatusConflict,
+			wantCreateCalls: 1,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			blueprintService := &services.ClusterBlueprintServiceMock{
+				CreateFunc: func(blueprint *dbapi.ClusterBlueprint) *errors.ServiceError {
+					blueprint.ID = "blueprint-id"
+					return tt.createErr
+				},
+			}
+			h := NewAdminClusterBlueprintHandler(blueprintService, clusterBlueprintProviderConfig())
+			req, rw := GetHandlerParams(http.MethodPost, "/cluster_blueprints", bytes.NewBuffer(tt.body), t)
+			h.Create(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			g.Expect(blueprintService.CreateCalls()).To(gomega.HaveLen(tt.wantCreateCalls))
+			if tt.wantStatusCode == http.StatusCreated {
+				var blueprint private.ClusterBlueprint
+				g.Expect(json.NewDecoder(resp.Body).Decode(&blueprint)).To(gomega.Succeed())
+				g.Expect(blueprint.Id).To(gomega.Equal("blueprint-id"))
+				g.Expect(blueprint.MachinePools).To(gomega.HaveLen(1))
+			}
+		})
+	}
+}
+
+func Test_AdminClusterBlueprintHandler_Update(t *testing.T) {
+	tests := []struct {
+		name            string
+		body            []byte
+		getErr          *errors.ServiceError
+		wantStatusCode  int
+		wantUpdateCalls int
+	}{
+		{
+			name: "should update the cluster blueprint",
+			body: buildClusterBlueprintRequest(func(request *private.ClusterBlueprintRequest) {
+				request.ClusterCount = 4
+			}),
+			wantStatusCode:  http.StatusOK,
+			wantUpdateCalls: 1,
+		},
+		{
+			name:           "should return not found if the cluster blueprint does not exist",
+			body:           buildClusterBlueprintRequest(nil),
+			getErr:         errors.NotFound("cluster blueprint with id %q not found", "blueprint-id"),
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "should return a bad request if the cloud provider or region is changed",
+			body: buildClusterBlueprintRequest(func(request *private.ClusterBlueprintRequest) {
+				request.Region = "af-south-1"
+			}),
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			blueprintService := &services.ClusterBlueprintServiceMock{
+				GetFunc: func(id string) (*dbapi.ClusterBlueprint, *errors.ServiceError) {
+					if tt.getErr != nil {
+						return nil, tt.getErr
+					}
+					return buildClusterBlueprint(), nil
+				},
+				UpdateFunc: func(blueprint *dbapi.ClusterBlueprint) *errors.ServiceError {
+					return nil
+				},
+			}
+			h := NewAdminClusterBlueprintHandler(blueprintService, clusterBlueprintProviderConfig())
+			req, rw := GetHandlerParams(http.MethodPut, "/cluster_blueprints/blueprint-id", bytes.NewBuffer(tt.body), t)
+			req = mux.SetURLVars(req, map[string]string{"id": "blueprint-id"})
+			h.Update(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			g.Expect(blueprintService.UpdateCalls()).To(gomega.HaveLen(tt.wantUpdateCalls))
+			if tt.wantUpdateCalls > 0 {
+				g.Expect(blueprintService.UpdateCalls()[0].Blueprint.ClusterCount).To(gomega.Equal(4))
+			}
+		})
+	}
+}
+
+func Test_AdminClusterBlueprintHandler_Plan(t *testing.T) {
+	tests := []struct {
+		name           string
+		planErr        *errors.ServiceError
+		wantStatusCode int
+	}{
+		{
+			name:           "should return the plan of the cluster blueprint",
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "should return an error if the plan cannot be computed",
+			planErr:        errors.GeneralError("test"),
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			h := NewAdminClusterBlueprintHandler(&services.ClusterBlueprintServiceMock{
+				GetFunc: func(id string) (*dbapi.ClusterBlueprint, *errors.ServiceError) {
+					return buildClusterBlueprint(), nil
+				},
+				PlanFunc: func(blueprint *dbapi.ClusterBlueprint) (*services.ClusterBlueprintPlan, *errors.ServiceError) {
+					if tt.planErr != nil {
+						return nil, tt.planErr
+					}
+					return &services.ClusterBlueprintPlan{
+						Blueprint:           blueprint,
+						CurrentClusterCount: 1,
+						Steps: []services.ClusterBlueprintPlanStep{
+							{Action: services.ClusterBlueprintPlanActionCreate, Reason: "cluster count 1 is below the desired count 2"},
+						},
+					}, nil
+				},
+			}, clusterBlueprintProviderConfig())
+			req, rw := GetHandlerParams(http.MethodGet, "/cluster_blueprints/blueprint-id/plan", nil, t)
+			req = mux.SetURLVars(req, map[string]string{"id": "blueprint-id"})
+			h.Plan(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			if tt.planErr == nil {
+				var plan private.ClusterBlueprintPlan
+				g.Expect(json.NewDecoder(resp.Body).Decode(&plan)).To(gomega.Succeed())
+				g.Expect(plan.DesiredClusterCount).To(gomega.Equal(int32(2)))
+				g.Expect(plan.Steps).To(gomega.HaveLen(1))
+				g.Expect(plan.Steps[0].Action).To(gomega.Equal("create"))
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/gorilla/mux"
+)
+
+type adminClusterConsolidationPlanHandler struct {
+	clusterConsolidationPlanService services.ClusterConsolidationPlanService
+}
+
+func NewAdminClusterConsolidationPlanHandler(clusterConsolidationPlanService services.ClusterConsolidationPlanService) *adminClusterConsolidationPlanHandler {
+	return &adminClusterConsolidationPlanHandler{
+		clusterConsolidationPlanService: clusterConsolidationPlanService,
+	}
+}
+
+func (h adminClusterConsolidationPlanHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			plans, err := h.clusterConsolidationPlanService.List()
+			if err != nil {
+				return nil, err
+			}
+
+			planList := private.ClusterConsolidationPlanList{
+				Kind:  "ClusterConsolidationPlanList",
+				Page:  1,
+				Size:  int32(len(plans)),
+				Total: int32(len(plans)),
+				Items: []private.ClusterConsolidationPlan{},
+			}
+
+			for _, plan := range plans {
+				converted, err := presenters.PresentClusterConsolidationPlan(plan)
+				if err != nil {
+					return nil, err
+				}
+				planList.Items = append(planList.Items, *converted)
+			}
+
+			return planList, nil
+		},
+	}
+
+	handlers.HandleList(w, r, cfg)
+}
+
+func (h adminClusterConsolidationPlanHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			plan, err := h.clusterConsolidationPlanService.Get(mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentClusterConsolidationPlan(plan)
+		},
+	}
+
+	handlers.HandleGet(w, r, cfg)
+}
+
+func (h adminClusterConsolidationPlanHandler) Approve(w http.ResponseWriter, r *http.Request) {
+	h.updateStatus(w, r, dbapi.ClusterConsolidationPlanApproved)
+}
+
+func (h adminClusterConsolidationPlanHandler) Reject(w http.ResponseWriter, r *http.Request) {
+	h.updateStatus(w, r, dbapi.ClusterConsolidationPlanRejected)
+}
+
+func (h adminClusterConsolidationPlanHandler) updateStatus(w http.ResponseWriter, r *http.Request, status dbapi.ClusterConsolidationPlanStatus) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			plan, err := h.clusterConsolidationPlanService.UpdateStatus(mux.Vars(r)["id"], status)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentClusterConsolidationPlan(plan)
+		},
+	}
+
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/gorilla/mux"
+	"github.com/onsi/gomega"
+)
+
+func buildClusterConsolidationPlan(status dbapi.ClusterConsolidationPlanStatus) *dbapi.ClusterConsolidationPlan {
+	return &dbapi.ClusterConsolidationPlan{
+		Meta:      api.Meta{ID: "plan-id"},
+		ClusterID: "cluster-id",
+		Status:    status,
+		Moves:     api.JSON(`[{"kafka_id":"kafka-id","instance_type":"standard","streaming_units":1,"target_cluster_id":"target-id"}]`),
+	}
+}
+
+func Test_AdminClusterConsolidationPlanHandler_List(t *testing.T) {
+	tests := []struct {
+		name           string
+		listErr        *errors.ServiceError
+		wantStatusCode int
+	}{
+		{
+			name:           "should return the consolidation plans",
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "should return an error if the consolidation plans cannot be listed",
+			listErr:        errors.GeneralError("test"),
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			h := NewAdminClusterConsolidationPlanHandler(&services.ClusterConsolidationPlanServiceMock{
+				ListFunc: func(statuses ...dbapi.ClusterConsolidationPlanStatus) (dbapi.ClusterConsolidationPlanList, *errors.ServiceError) {
+					if tt.listErr != nil {
+						return nil, tt.listErr
+					}
+					return dbapi.ClusterConsolidationPlanList{buildClusterConsolidationPlan(dbapi.ClusterConsolidationPlanProposed)}, nil
+				},
+			})
+			req, rw := GetHandlerParams(http.MethodGet, "/cluster_consolidation_plans", nil, t)
+			h.List(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			if tt.listErr == nil {
+				var planList private.ClusterConsolidationPlanList
+				g.Expect(json.NewDecoder(resp.Body).Decode(&planList)).To(gomega.Succeed())
+				g.Expect(planList.Items).To(gomega.HaveLen(1))
+				g.Expect(planList.Items[0].ClusterId).To(gomega.Equal("cluster-id"))
+				g.Expect(planList.Items[0].Moves).To(gomega.HaveLen(1))
+			}
+		})
+	}
+}
+
+func Test_AdminClusterConsolidationPlanHandler_ApproveReject(t *testing.T) {
+	tests := []struct {
+		name            string
+		approve         bool
+		updateStatusErr *errors.ServiceError
+		wantStatusCode  int
+		wantStatus      dbapi.ClusterConsolidationPlanStatus
+	}{
+		{
+			name:           "should approve the consolidation plan",
+			approve:        true,
+			wantStatusCode: http.StatusOK,
+			wantStatus:     dbapi.ClusterConsolidationPlanApproved,
+		},
+		{
+			name:           "should reject the consolidation plan",
+			approve:        false,
+			wantStatusCode: http.StatusOK,
+			wantStatus:     dbapi.ClusterConsolidationPlanRejected,
+		},
+		{
+			name:            "should return a conflict if the consolidation plan is no longer proposed",
+			approve:         true,
+			updateStatusErr: errors.Conflict("consolidation plan with id %q cannot move from status %q to %q", "plan-id", "rejected", "approved"),
+			wantStatusCode:  http.StatusConflict,
+			wantStatus:      dbapi.ClusterConsolidationPlanApproved,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			planService := &services.ClusterConsolidationPlanServiceMock{
+				UpdateStatusFunc: func(id string, status dbapi.ClusterConsolidationPlanStatus) (*dbapi.ClusterConsolidationPlan, *errors.ServiceError) {
+					if tt.updateStatusErr != nil {
+						return nil, tt.updateStatusErr
+					}
+					return buildClusterConsolidationPlan(status), nil
+				},
+			}
+			h := NewAdminClusterConsolidationPlanHandler(planService)
+			req, rw := GetHandlerParams(http.MethodPost, "/cluster_consolidation_plans/plan-id/approve", nil, t)
+			req = mux.SetURLVars(req, map[string]string{"id": "plan-id"})
+			if tt.approve {
+				h.Approve(rw, req)
+			} else {
+				h.Reject(rw, req)
+			}
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			g.Expect(planService.UpdateStatusCalls()).To(gomega.HaveLen(1))
+			g.Expect(planService.UpdateStatusCalls()[0].ID).To(gomega.Equal("plan-id"))
+			g.Expect(planService.UpdateStatusCalls()[0].Status).To(gomega.Equal(tt.wantStatus))
+			if tt.updateStatusErr == nil {
+				var plan private.ClusterConsolidationPlan
+				g.Expect(json.NewDecoder(resp.Body).Decode(&plan)).To(gomega.Succeed())
+				g.Expect(plan.Status).To(gomega.Equal(tt.wantStatus.String()))
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/gorilla/mux"
+)
+
+const defaultClusterUpgradeWaveBatchSize = 1
+
+type adminClusterUpgradeHandler struct {
+	clusterService        services.ClusterService
+	clusterUpgradeService services.ClusterUpgradeService
+}
+
+func NewAdminClusterUpgradeHandler(clusterService services.ClusterService, clusterUpgradeService services.ClusterUpgradeService) *adminClusterUpgradeHandler {
+	return &adminClusterUpgradeHandler{
+		clusterService:        clusterService,
+		clusterUpgradeService: clusterUpgradeService,
+	}
+}
+
+func (h adminClusterUpgradeHandler) findCluster(clusterID string) (*api.Cluster, *errors.ServiceError) {
+	cluster, err := h.clusterService.FindClusterByID(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if cluster == nil {
+		return nil, errors.NotFound("cluster with id %q not found", clusterID)
+	}
+	return cluster, nil
+}
+
+func (h adminClusterUpgradeHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			cluster, err := h.findCluster(mux.Vars(r)["cluster_id"])
+			if err != nil {
+				return nil, err
+			}
+
+			// the OpenShift version of standalone clusters is not managed by the fleet manager
+			var versionInfo *types.ClusterVersionInfo
+			if cluster.ProviderType != api.ClusterProviderStandalone {
+				versionInfo, err = h.clusterUpgradeService.GetClusterVersion(cluster)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			return presenters.PresentClusterUpgrade(cluster, versionInfo), nil
+		},
+	}
+
+	handlers.HandleGet(w, r, cfg)
+}
+
+func (h adminClusterUpgradeHandler) Schedule(w http.ResponseWriter, r *http.Request) {
+	var request private.ClusterUpgradeRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &request,
+		Validate: []handlers.Validate{
+			validateClusterUpgradeRequest(&request),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			cluster, err := h.findCluster(mux.Vars(r)["cluster_id"])
+			if err != nil {
+				return nil, err
+			}
+
+			scheduledAt := time.Now()
+			if request.ScheduledAt != nil {
+				scheduledAt = *request.ScheduledAt
+			}
+
+			if err := h.clusterUpgradeService.ScheduleUpgrade(cluster, request.Version, scheduledAt); err != nil {
+				return nil, err
+			}
+			return presenters.PresentClusterUpgrade(cluster, nil), nil
+		},
+	}
+
+	handlers.Handle(w, r, cfg, http.StatusAccepted)
+}
+
+func (h adminClusterUpgradeHandler) Cancel(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			cluster, err := h.findCluster(mux.Vars(r)["cluster_id"])
+			if err != nil {
+				return nil, err
+			}
+
+			if err := h.clusterUpgradeService.CancelUpgrade(cluster); err != nil {
+				return nil, err
+			}
+			return presenters.PresentClusterUpgrade(cluster, nil), nil
+		},
+	}
+
+	handlers.HandleDelete(w, r, cfg, http.StatusOK)
+}
+
+func (h adminClusterUpgradeHandler) CreateWave(w http.ResponseWriter, r *http.Request) {
+	var request private.ClusterUpgradeWaveRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &request,
+		Validate: []handlers.Validate{
+			validateClusterUpgradeWaveRequest(&request),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			waveClusters := make([]*api.Cluster, 0, len(request.ClusterIds))
+			for _, clusterID := range request.ClusterIds {
+				cluster, err := h.findCluster(clusterID)
+				if err != nil {
+					return nil, err
+				}
+				waveClusters = append(waveClusters, cluster)
+			}
+
+			startAt := time.Now()
+			if request.StartAt != nil {
+				startAt = *request.StartAt
+			}
+			batchSize := int(request.BatchSize)
+			if batchSize == 0 {
+				batchSize = defaultClusterUpgradeWaveBatchSize
+			}
+			batchInterval := time.Duration(request.BatchIntervalMinutes) * time.Minute
+
+			waveID, err := h.clusterUpgradeService.ScheduleUpgradeWave(waveClusters, request.Version, startAt, batchSize, batchInterval)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentClusterUpgradeWave(waveID, waveClusters), nil
+		},
+	}
+
+	handlers.Handle(w, r, cfg, http.StatusAccepted)
+}
+
+func (h adminClusterUpgradeHandler) GetWave(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			waveID := mux.Vars(r)["id"]
+			waveClusters, err := h.clusterUpgradeService.ListWaveClusters(waveID)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentClusterUpgradeWave(waveID, waveClusters), nil
+		},
+	}
+
+	handlers.HandleGet(w, r, cfg)
+}
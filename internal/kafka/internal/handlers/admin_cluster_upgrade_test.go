@@ -0,0 +1,265 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/gorilla/mux"
+	"github.com/onsi/gomega"
+)
+
+func clusterUpgradeClusterService(clusters ...*api.Cluster) *services.ClusterServiceMock {
+	return &services.ClusterServiceMock{
+		FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+			for _, cluster := range clusters {
+				if cluster.ClusterID == clusterID {
+					return cluster, nil
+				}
+			}
+			return nil, nil
+		},
+	}
+}
+
+func Test_AdminClusterUpgradeHandler_Get(t *testing.T) {
+	tests := []struct {
+		name                  string
+		cluster               *api.Cluster
+		wantStatusCode        int
+		wantCurrentVersion    string
+		wantGetVersionCalls   int
+		wantAvailableUpgrades []string
+	}{
+		{
+			name:                  "should return the upgrade of the cluster along with its available upgrades",
+			cluster:               &api.Cluster{ClusterID: "cluster-id", ProviderType: api.ClusterProviderOCM, UpgradeStatus: api.ClusterUpgradeCompleted},
+			wantStatusCode:        http.StatusOK,
+			wantCurrentVersion:    "4.11.22",
+			wantGetVersionCalls:   1,
+			wantAvailableUpgrades: []string{"4.11.25"},
+		},
+		{
+			name:           "should not return the version of a standalone cluster",
+			cluster:        &api.Cluster{ClusterID: "cluster-id", ProviderType: api.ClusterProviderStandalone},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "should return not found if the cluster does not exist",
+			cluster:        &api.Cluster{ClusterID: "another-cluster-id"},
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			upgradeService := &services.ClusterUpgradeServiceMock{
+				GetClusterVersionFunc: func(cluster *api.Cluster) (*types.ClusterVersionInfo, *errors.ServiceError) {
+					return &types.ClusterVersionInfo{Version: "4.11.22", AvailableUpgrades: []string{"4.11.25"}}, nil
+				},
+			}
+			h := NewAdminClusterUpgradeHandler(clusterUpgradeClusterService(tt.cluster), upgradeService)
+			req, rw := GetHandlerParams(http.MethodGet, "/clusters/cluster-id/upgrade", nil, t)
+			req = mux.SetURLVars(req, map[string]string{"cluster_id": "cluster-id"})
+			h.Get(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			g.Expect(upgradeService.GetClusterVersionCalls()).To(gomega.HaveLen(tt.wantGetVersionCalls))
+			if tt.wantStatusCode == http.StatusOK {
+				var upgrade private.ClusterUpgrade
+				g.Expect(json.NewDecoder(resp.Body).Decode(&upgrade)).To(gomega.Succeed())
+				g.Expect(upgrade.ClusterId).To(gomega.Equal("cluster-id"))
+				g.Expect(upgrade.CurrentVersion).To(gomega.Equal(tt.wantCurrentVersion))
+				g.Expect(upgrade.AvailableUpgrades).To(gomega.Equal(tt.wantAvailableUpgrades))
+			}
+		})
+	}
+}
+
+func Test_AdminClusterUpgradeHandler_Schedule(t *testing.T) {
+	scheduledAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	buildRequest := func(request private.ClusterUpgradeRequest) []byte {
+		body, _ := json.Marshal(request)
+		return body
+	}
+
+	tests := []struct {
+		name              string
+		body              []byte
+		scheduleErr       *errors.ServiceError
+		wantStatusCode    int
+		wantScheduleCalls int
+	}{
+		{
+			name:              "should request the upgrade of the cluster",
+			body:              buildRequest(private.ClusterUpgradeRequest{Version: "4.11.25", ScheduledAt: &scheduledAt}),
+			wantStatusCode:    http.StatusAccepted,
+			wantScheduleCalls: 1,
+		},
+		{
+			name:           "should return a bad request if the version is missing",
+			body:           buildRequest(private.ClusterUpgradeRequest{}),
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:              "should return a conflict if an upgrade of the cluster is already in progress",
+			body:              buildRequest(private.ClusterUpgradeRequest{Version: "4.11.25"}),
+			scheduleErr:       errors.Conflict("cluster already has an upgrade"),
+			wantStatusCode:    http.StatusConflict,
+			wantScheduleCalls: 1,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			upgradeService := &services.ClusterUpgradeServiceMock{
+				ScheduleUpgradeFunc: func(cluster *api.Cluster, version string, scheduledAt time.Time) *errors.ServiceError {
+					return tt.scheduleErr
+				},
+			}
+			h := NewAdminClusterUpgradeHandler(clusterUpgradeClusterService(&api.Cluster{ClusterID: "cluster-id"}), upgradeService)
+			req, rw := GetHandlerParams(http.MethodPost, "/clusters/cluster-id/upgrade", bytes.NewBuffer(tt.body), t)
+			req = mux.SetURLVars(req, map[string]string{"cluster_id": "cluster-id"})
+			h.Schedule(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			g.Expect(upgradeService.ScheduleUpgradeCalls()).To(gomega.HaveLen(tt.wantScheduleCalls))
+			if tt.wantStatusCode == http.StatusAccepted {
+				g.Expect(upgradeService.ScheduleUpgradeCalls()[0].ScheduledAt).To(gomega.BeTemporally("==", scheduledAt))
+			}
+		})
+	}
+}
+
+func Test_AdminClusterUpgradeHandler_Cancel(t *testing.T) {
+	tests := []struct {
+		name           string
+		cancelErr      *errors.ServiceError
+		wantStatusCode int
+	}{
+		{
+			name:           "should cancel the upgrade of the cluster",
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "should return a conflict if the upgrade is already in progress",
+			cancelErr:      errors.Conflict("the upgrade is in progress"),
+			wantStatusCode: http.StatusConflict,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			h := NewAdminClusterUpgradeHandler(clusterUpgradeClusterService(&api.Cluster{ClusterID: "cluster-id"}), &services.ClusterUpgradeServiceMock{
+				CancelUpgradeFunc: func(cluster *api.Cluster) *errors.ServiceError {
+					return tt.cancelErr
+				},
+			})
+			req, rw := GetHandlerParams(http.MethodDelete, "/clusters/cluster-id/upgrade", nil, t)
+			req = mux.SetURLVars(req, map[string]string{"cluster_id": "cluster-id"})
+			h.Cancel(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+		})
+	}
+}
+
+func Test_AdminClusterUpgradeHandler_CreateWave(t *testing.T) {
+	buildRequest := func(request private.ClusterUpgradeWaveRequest) []byte {
+		body, _ := json.Marshal(request)
+		return body
+	}
+
+	tests := []struct {
+		name              string
+		body              []byte
+		wantStatusCode    int
+		wantBatchSize     int
+		wantBatchInterval time.Duration
+	}{
+		{
+			name: "should schedule the upgrade wave",
+			body: buildRequest(private.ClusterUpgradeWaveRequest{
+				Version:              "4.11.25",
+				ClusterIds:           []string{"cluster-1", "cluster-2"},
+				BatchSize:            2,
+				BatchIntervalMinutes: 30,
+			}),
+			wantStatusCode:    http.StatusAccepted,
+			wantBatchSize:     2,
+			wantBatchInterval: 30 * time.Minute,
+		},
+		{
+			name: "should upgrade the clusters one at a time by default",
+			body: buildRequest(private.ClusterUpgradeWaveRequest{
+				Version:    "4.11.25",
+				ClusterIds: []string{"cluster-1", "cluster-2"},
+			}),
+			wantStatusCode: http.StatusAccepted,
+			wantBatchSize:  1,
+		},
+		{
+			name: "should return a bad request if a cluster is listed twice",
+			body: buildRequest(private.ClusterUpgradeWaveRequest{
+				Version:    "4.11.25",
+				ClusterIds: []string{"cluster-1", "cluster-1"},
+			}),
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "should return not found if a cluster does not exist",
+			body: buildRequest(private.ClusterUpgradeWaveRequest{
+				Version:    "4.11.25",
+				ClusterIds: []string{"cluster-1", "cluster-3"},
+			}),
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			upgradeService := &services.ClusterUpgradeServiceMock{
+				ScheduleUpgradeWaveFunc: func(clusters []*api.Cluster, version string, startAt time.Time, batchSize int, batchInterval time.Duration) (string, *errors.ServiceError) {
+					return "wave-id", nil
+				},
+			}
+			clusterService := clusterUpgradeClusterService(&api.Cluster{ClusterID: "cluster-1"}, &api.Cluster{ClusterID: "cluster-2"})
+			h := NewAdminClusterUpgradeHandler(clusterService, upgradeService)
+			req, rw := GetHandlerParams(http.MethodPost, "/cluster_upgrade_waves", bytes.NewBuffer(tt.body), t)
+			h.CreateWave(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			if tt.wantStatusCode != http.StatusAccepted {
+				g.Expect(upgradeService.ScheduleUpgradeWaveCalls()).To(gomega.BeEmpty())
+				return
+			}
+
+			call := upgradeService.ScheduleUpgradeWaveCalls()[0]
+			g.Expect(call.BatchSize).To(gomega.Equal(tt.wantBatchSize))
+			g.Expect(call.BatchInterval).To(gomega.Equal(tt.wantBatchInterval))
+			var wave private.ClusterUpgradeWave
+			g.Expect(json.NewDecoder(resp.Body).Decode(&wave)).To(gomega.Succeed())
+			g.Expect(wave.Id).To(gomega.Equal("wave-id"))
+			g.Expect(wave.Clusters).To(gomega.HaveLen(2))
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/gorilla/mux"
+)
+
+type adminWorkerHandler struct {
+	workerStatusService workers.WorkerStatusService
+	workQueueService    workers.WorkQueueService
+}
+
+func NewAdminWorkerHandler(workerStatusService workers.WorkerStatusService, workQueueService workers.WorkQueueService) *adminWorkerHandler {
+	return &adminWorkerHandler{
+		workerStatusService: workerStatusService,
+		workQueueService:    workQueueService,
+	}
+}
+
+func (h adminWorkerHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			leases, err := h.workerStatusService.List()
+			if err != nil {
+				return nil, err
+			}
+
+			workerList := private.WorkerList{
+				Kind:  "WorkerList",
+				Page:  1,
+				Size:  int32(len(leases)),
+				Total: int32(len(leases)),
+				Items: []private.Worker{},
+			}
+
+			for _, lease := range leases {
+				converted, err := presenters.PresentWorker(lease)
+				if err != nil {
+					return nil, err
+				}
+				workerList.Items = append(workerList.Items, *converted)
+			}
+
+			return workerList, nil
+		},
+	}
+
+	handlers.HandleList(w, r, cfg)
+}
+
+func (h adminWorkerHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			lease, err := h.workerStatusService.Get(mux.Vars(r)["worker_type"])
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentWorker(lease)
+		},
+	}
+
+	handlers.HandleGet(w, r, cfg)
+}
+
+func (h adminWorkerHandler) Pause(w http.ResponseWriter, r *http.Request) {
+	h.setPaused(w, r, true)
+}
+
+func (h adminWorkerHandler) Resume(w http.ResponseWriter, r *http.Request) {
+	h.setPaused(w, r, false)
+}
+
+func (h adminWorkerHandler) setPaused(w http.ResponseWriter, r *http.Request, paused bool) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			lease, err := h.workerStatusService.SetPaused(mux.Vars(r)["worker_type"], paused)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentWorker(lease)
+		},
+	}
+
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+func (h adminWorkerHandler) Reconcile(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			lease, err := h.workerStatusService.TriggerReconcile(mux.Vars(r)["worker_type"])
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentWorker(lease)
+		},
+	}
+
+	handlers.Handle(w, r, cfg, http.StatusAccepted)
+}
+
+func (h adminWorkerHandler) ListQuarantinedItems(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			failures, err := h.workQueueService.ListQuarantined(mux.Vars(r)["worker_type"])
+			if err != nil {
+				return nil, err
+			}
+
+			itemList := private.QuarantinedWorkItemList{
+				Kind:  "QuarantinedWorkItemList",
+				Page:  1,
+				Size:  int32(len(failures)),
+				Total: int32(len(failures)),
+				Items: []private.QuarantinedWorkItem{},
+			}
+
+			for _, failure := range failures {
+				itemList.Items = append(itemList.Items, presenters.PresentQuarantinedWorkItem(failure))
+			}
+
+			return itemList, nil
+		},
+	}
+
+	handlers.HandleList(w, r, cfg)
+}
+
+func (h adminWorkerHandler) ReleaseQuarantinedItem(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			return nil, h.workQueueService.Release(mux.Vars(r)["worker_type"], mux.Vars(r)["resource_id"])
+		},
+	}
+
+	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
+}
@@ -0,0 +1,322 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/gorilla/mux"
+	"github.com/onsi/gomega"
+)
+
+func buildWorkerLease(workerType string, paused bool) *api.LeaderLease {
+	return &api.LeaderLease{
+		Leader:              "leader-id",
+		LeaseType:           workerType,
+		Paused:              paused,
+		LastReconcileErrors: api.JSON(`["some error"]`),
+	}
+}
+
+func Test_AdminWorkerHandler_List(t *testing.T) {
+	tests := []struct {
+		name                string
+		workerStatusService workers.WorkerStatusService
+		wantStatusCode      int
+		wantItems           []private.Worker
+	}{
+		{
+			name: "should return the status of all the worker types",
+			workerStatusService: &workers.WorkerStatusServiceMock{
+				ListFunc: func() (api.LeaderLeaseList, *errors.ServiceError) {
+					return api.LeaderLeaseList{buildWorkerLease("kafka", true)}, nil
+				},
+			},
+			wantStatusCode: http.StatusOK,
+			wantItems: []private.Worker{
+				{
+					Id:                  "kafka",
+					Kind:                "Worker",
+					Href:                "/api/kafkas_mgmt/v1/admin/workers/kafka",
+					WorkerType:          "kafka",
+					Leader:              "leader-id",
+					Paused:              true,
+					LastReconcileErrors: []string{"some error"},
+				},
+			},
+		},
+		{
+			name: "should return an error if the worker types cannot be listed",
+			workerStatusService: &workers.WorkerStatusServiceMock{
+				ListFunc: func() (api.LeaderLeaseList, *errors.ServiceError) {
+					return nil, errors.GeneralError("test")
+				},
+			},
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			h := NewAdminWorkerHandler(tt.workerStatusService, nil)
+			req, rw := GetHandlerParams(http.MethodGet, "/workers", nil, t)
+			h.List(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			if tt.wantItems != nil {
+				var workerList private.WorkerList
+				g.Expect(json.NewDecoder(resp.Body).Decode(&workerList)).To(gomega.Succeed())
+				g.Expect(workerList.Items).To(gomega.Equal(tt.wantItems))
+			}
+		})
+	}
+}
+
+func Test_AdminWorkerHandler_Get(t *testing.T) {
+	tests := []struct {
+		name                string
+		workerStatusService workers.WorkerStatusService
+		wantStatusCode      int
+	}{
+		{
+			name: "should return the status of the worker type",
+			workerStatusService: &workers.WorkerStatusServiceMock{
+				GetFunc: func(workerType string) (*api.LeaderLease, *errors.ServiceError) {
+					return buildWorkerLease(workerType, false), nil
+				},
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "should return not found if the worker type does not exist",
+			workerStatusService: &workers.WorkerStatusServiceMock{
+				GetFunc: func(workerType string) (*api.LeaderLease, *errors.ServiceError) {
+					return nil, errors.NotFound("worker type '%s' not found", workerType)
+				},
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			h := NewAdminWorkerHandler(tt.workerStatusService, nil)
+			req, rw := GetHandlerParams(http.MethodGet, "/workers/kafka", nil, t)
+			req = mux.SetURLVars(req, map[string]string{"worker_type": "kafka"})
+			h.Get(rw, req)
+			resp := rw.Result()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			resp.Body.Close()
+		})
+	}
+}
+
+func Test_AdminWorkerHandler_PauseResume(t *testing.T) {
+	tests := []struct {
+		name           string
+		pause          bool
+		setPausedErr   *errors.ServiceError
+		wantStatusCode int
+		wantPaused     bool
+	}{
+		{
+			name:           "should pause the worker type",
+			pause:          true,
+			wantStatusCode: http.StatusOK,
+			wantPaused:     true,
+		},
+		{
+			name:           "should resume the worker type",
+			pause:          false,
+			wantStatusCode: http.StatusOK,
+			wantPaused:     false,
+		},
+		{
+			name:           "should return not found if the worker type does not exist",
+			pause:          true,
+			setPausedErr:   errors.NotFound("worker type 'kafka' not found"),
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			workerStatusService := &workers.WorkerStatusServiceMock{
+				SetPausedFunc: func(workerType string, paused bool) (*api.LeaderLease, *errors.ServiceError) {
+					if tt.setPausedErr != nil {
+						return nil, tt.setPausedErr
+					}
+					return buildWorkerLease(workerType, paused), nil
+				},
+			}
+			h := NewAdminWorkerHandler(workerStatusService, nil)
+			req, rw := GetHandlerParams(http.MethodPost, "/workers/kafka/pause", nil, t)
+			req = mux.SetURLVars(req, map[string]string{"worker_type": "kafka"})
+			if tt.pause {
+				h.Pause(rw, req)
+			} else {
+				h.Resume(rw, req)
+			}
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			g.Expect(workerStatusService.SetPausedCalls()).To(gomega.HaveLen(1))
+			g.Expect(workerStatusService.SetPausedCalls()[0].Paused).To(gomega.Equal(tt.pause))
+			if tt.setPausedErr == nil {
+				var worker private.Worker
+				g.Expect(json.NewDecoder(resp.Body).Decode(&worker)).To(gomega.Succeed())
+				g.Expect(worker.Paused).To(gomega.Equal(tt.wantPaused))
+			}
+		})
+	}
+}
+
+func Test_AdminWorkerHandler_Reconcile(t *testing.T) {
+	tests := []struct {
+		name                string
+		workerStatusService workers.WorkerStatusService
+		wantStatusCode      int
+	}{
+		{
+			name: "should trigger a reconcile of the worker type",
+			workerStatusService: &workers.WorkerStatusServiceMock{
+				TriggerReconcileFunc: func(workerType string) (*api.LeaderLease, *errors.ServiceError) {
+					return buildWorkerLease(workerType, false), nil
+				},
+			},
+			wantStatusCode: http.StatusAccepted,
+		},
+		{
+			name: "should return a conflict if the worker type is paused",
+			workerStatusService: &workers.WorkerStatusServiceMock{
+				TriggerReconcileFunc: func(workerType string) (*api.LeaderLease, *errors.ServiceError) {
+					return nil, errors.Conflict("worker type '%s' is paused", workerType)
+				},
+			},
+			wantStatusCode: http.StatusConflict,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			h := NewAdminWorkerHandler(tt.workerStatusService, nil)
+			req, rw := GetHandlerParams(http.MethodPost, "/workers/kafka/reconcile", nil, t)
+			req = mux.SetURLVars(req, map[string]string{"worker_type": "kafka"})
+			h.Reconcile(rw, req)
+			resp := rw.Result()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			resp.Body.Close()
+		})
+	}
+}
+
+func Test_AdminWorkerHandler_ListQuarantinedItems(t *testing.T) {
+	quarantinedAt := time.Now()
+
+	tests := []struct {
+		name             string
+		workQueueService workers.WorkQueueService
+		wantStatusCode   int
+		wantItems        []private.QuarantinedWorkItem
+	}{
+		{
+			name: "should return the quarantined work items of the worker type",
+			workQueueService: &workers.WorkQueueServiceMock{
+				ListQuarantinedFunc: func(workerType string) (api.WorkItemFailureList, *errors.ServiceError) {
+					return api.WorkItemFailureList{
+						{WorkerType: workerType, ResourceID: "kafka-id", Attempts: 10, LastError: "some error", QuarantinedAt: &quarantinedAt},
+					}, nil
+				},
+			},
+			wantStatusCode: http.StatusOK,
+			wantItems: []private.QuarantinedWorkItem{
+				{WorkerType: "kafka", ResourceId: "kafka-id", Attempts: 10, LastError: "some error", QuarantinedAt: quarantinedAt},
+			},
+		},
+		{
+			name: "should return an error if the quarantined work items cannot be listed",
+			workQueueService: &workers.WorkQueueServiceMock{
+				ListQuarantinedFunc: func(workerType string) (api.WorkItemFailureList, *errors.ServiceError) {
+					return nil, errors.GeneralError("test")
+				},
+			},
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			h := NewAdminWorkerHandler(nil, tt.workQueueService)
+			req, rw := GetHandlerParams(http.MethodGet, "/workers/kafka/quarantined_items", nil, t)
+			req = mux.SetURLVars(req, map[string]string{"worker_type": "kafka"})
+			h.ListQuarantinedItems(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			if tt.wantItems != nil {
+				var itemList private.QuarantinedWorkItemList
+				g.Expect(json.NewDecoder(resp.Body).Decode(&itemList)).To(gomega.Succeed())
+				g.Expect(itemList.Items).To(gomega.HaveLen(len(tt.wantItems)))
+				g.Expect(itemList.Items[0].ResourceId).To(gomega.Equal(tt.wantItems[0].ResourceId))
+				g.Expect(itemList.Items[0].QuarantinedAt.Equal(tt.wantItems[0].QuarantinedAt)).To(gomega.BeTrue())
+			}
+		})
+	}
+}
+
+func Test_AdminWorkerHandler_ReleaseQuarantinedItem(t *testing.T) {
+	tests := []struct {
+		name             string
+		workQueueService workers.WorkQueueService
+		wantStatusCode   int
+	}{
+		{
+			name: "should release the quarantined work item",
+			workQueueService: &workers.WorkQueueServiceMock{
+				ReleaseFunc: func(workerType string, resourceID string) *errors.ServiceError {
+					return nil
+				},
+			},
+			wantStatusCode: http.StatusNoContent,
+		},
+		{
+			name: "should return not found if the work item is not quarantined",
+			workQueueService: &workers.WorkQueueServiceMock{
+				ReleaseFunc: func(workerType string, resourceID string) *errors.ServiceError {
+					return errors.NotFound("work item '%s' of worker type '%s' is not quarantined", resourceID, workerType)
+				},
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			h := NewAdminWorkerHandler(nil, tt.workQueueService)
+			req, rw := GetHandlerParams(http.MethodDelete, "/workers/kafka/quarantined_items/kafka-id", nil, t)
+			req = mux.SetURLVars(req, map[string]string{"worker_type": "kafka", "resource_id": "kafka-id"})
+			h.ReleaseQuarantinedItem(rw, req)
+			resp := rw.Result()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+			resp.Body.Close()
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
 	"github.com/gorilla/mux"
 )
@@ -159,17 +160,20 @@ func (h clusterHandler) List(w http.ResponseWriter, r *http.Request) {
 		Action: func() (interface{}, *errors.ServiceError) {
 			ctx := r.Context()
 
-			clusters, err := h.clusterService.ListEnterpriseClustersOfAnOrganization(ctx)
+			listArgs := coreServices.NewListArguments(r.URL.Query())
+
+			clusters, paging, err := h.clusterService.ListEnterpriseClustersOfAnOrganization(ctx, listArgs)
 			if err != nil {
 				return nil, err
 			}
 
 			clusterList := public.EnterpriseClusterList{
-				Kind:  "ClusterList",
-				Page:  1,
-				Size:  int32(len(clusters)),
-				Total: int32(len(clusters)),
-				Items: []public.EnterpriseCluster{},
+				Kind:       "ClusterList",
+				Page:       int32(paging.Page),
+				Size:       int32(paging.Size),
+				Total:      int32(paging.Total),
+				Items:      []public.EnterpriseCluster{},
+				NextCursor: paging.NextCursor,
 			}
 
 			for _, cluster := range clusters {
@@ -20,6 +20,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/gorilla/mux"
 	"github.com/onsi/gomega"
@@ -787,8 +788,8 @@ func Test_ListEnterpriseClusters(t *testing.T) {
 			},
 			fields: fields{
 				clusterService: &services.ClusterServiceMock{
-					ListEnterpriseClustersOfAnOrganizationFunc: func(ctx context.Context) ([]*api.Cluster, *errors.ServiceError) {
-						return nil, errors.GeneralError("failed to register cluster")
+					ListEnterpriseClustersOfAnOrganizationFunc: func(ctx context.Context, listArgs *coreServices.ListArguments) ([]*api.Cluster, *api.PagingMeta, *errors.ServiceError) {
+						return nil, nil, errors.GeneralError("failed to register cluster")
 					},
 				},
 			},
@@ -801,7 +802,7 @@ func Test_ListEnterpriseClusters(t *testing.T) {
 			},
 			fields: fields{
 				clusterService: &services.ClusterServiceMock{
-					ListEnterpriseClustersOfAnOrganizationFunc: func(ctx context.Context) ([]*api.Cluster, *errors.ServiceError) {
+					ListEnterpriseClustersOfAnOrganizationFunc: func(ctx context.Context, listArgs *coreServices.ListArguments) ([]*api.Cluster, *api.PagingMeta, *errors.ServiceError) {
 						return []*api.Cluster{
 							{
 								ClusterID:           validLengthClusterId,
@@ -811,7 +812,7 @@ func Test_ListEnterpriseClusters(t *testing.T) {
 								MultiAZ:             true,
 								DynamicCapacityInfo: api.JSON([]byte(`{"standard":{"max_nodes":12,"max_units":4,"remaining_units":3}}`)),
 							},
-						}, nil
+						}, &api.PagingMeta{Page: 1, Size: 1, Total: 2, NextCursor: "next-cursor"}, nil
 					},
 					ComputeConsumedStreamingUnitCountPerInstanceTypeFunc: func(clusterID string) (services.StreamingUnitCountPerInstanceType, error) {
 						return services.StreamingUnitCountPerInstanceType{
@@ -876,10 +877,11 @@ func Test_ListEnterpriseClusters(t *testing.T) {
 			},
 			wantStatusCode: http.StatusOK,
 			want: public.EnterpriseClusterList{
-				Kind:  "ClusterList",
-				Page:  1,
-				Size:  int32(1),
-				Total: int32(1),
+				Kind:       "ClusterList",
+				Page:       1,
+				Size:       int32(1),
+				Total:      int32(2),
+				NextCursor: "next-cursor",
 				Items: []public.EnterpriseCluster{
 					{
 						Status:        api.ClusterReady.String(),
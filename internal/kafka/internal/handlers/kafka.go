@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"net/http"
+	"reflect"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
 	config "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
@@ -17,22 +18,24 @@ import (
 )
 
 type kafkaHandler struct {
-	service        services.KafkaService
-	providerConfig *config.ProviderConfig
-	authService    authorization.Authorization
-	kafkaConfig    *config.KafkaConfig
+	service                services.KafkaService
+	providerConfig         *config.ProviderConfig
+	authService            authorization.Authorization
+	kafkaConfig            *config.KafkaConfig
+	privateEndpointService services.PrivateEndpointService
 }
 
 func GetAcceptedOrderByParams() []string {
 	return []string{"bootstrap_server_host", "cloud_provider", "cluster_id", "created_at", "href", "id", "instance_type", "multi_az", "name", "organisation_id", "owner", "reauthentication_enabled", "region", "status", "updated_at", "version"}
 }
 
-func NewKafkaHandler(service services.KafkaService, providerConfig *config.ProviderConfig, authService authorization.Authorization, kafkaConfig *config.KafkaConfig) *kafkaHandler {
+func NewKafkaHandler(service services.KafkaService, providerConfig *config.ProviderConfig, authService authorization.Authorization, kafkaConfig *config.KafkaConfig, privateEndpointService services.PrivateEndpointService) *kafkaHandler {
 	return &kafkaHandler{
-		service:        service,
-		providerConfig: providerConfig,
-		authService:    authService,
-		kafkaConfig:    kafkaConfig,
+		service:                service,
+		providerConfig:         providerConfig,
+		authService:            authService,
+		kafkaConfig:            kafkaConfig,
+		privateEndpointService: privateEndpointService,
 	}
 }
 
@@ -53,9 +56,14 @@ func (h kafkaHandler) Create(w http.ResponseWriter, r *http.Request) {
 			ValidateKafkaPlan(ctx, h.service, h.kafkaConfig, &kafkaRequestPayload),
 			validateKafkaBillingModel(ctx, h.service, h.kafkaConfig, &kafkaRequestPayload),
 			ValidateBillingCloudAccountIdAndMarketplace(ctx, h.service, &kafkaRequestPayload),
+			ValidateKafkaConfigOverrides(h.kafkaConfig, &kafkaRequestPayload.KafkaConfig),
+			validateKafkaPrivateEndpoint(ctx, h.service, h.kafkaConfig, h.providerConfig, h.privateEndpointService, &kafkaRequestPayload),
 		},
 		Action: func() (interface{}, *errors.ServiceError) {
 			convKafka := presenters.ConvertKafkaRequest(kafkaRequestPayload)
+			if err := convKafka.SetDesiredKafkaConfig(kafkaRequestPayload.KafkaConfig); err != nil {
+				return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to set kafka_config")
+			}
 
 			claims, _ := getClaims(ctx)
 			convKafka.Owner, _ = claims.GetUsername()
@@ -127,11 +135,12 @@ func (h kafkaHandler) List(w http.ResponseWriter, r *http.Request) {
 			}
 
 			kafkaRequestList := public.KafkaRequestList{
-				Kind:  "KafkaRequestList",
-				Page:  int32(paging.Page),
-				Size:  int32(paging.Size),
-				Total: int32(paging.Total),
-				Items: []public.KafkaRequest{},
+				Kind:       "KafkaRequestList",
+				Page:       int32(paging.Page),
+				Size:       int32(paging.Size),
+				Total:      int32(paging.Total),
+				Items:      []public.KafkaRequest{},
+				NextCursor: paging.NextCursor,
 			}
 
 			for _, kafkaRequest := range kafkaRequests {
@@ -165,6 +174,7 @@ func (h kafkaHandler) Update(w http.ResponseWriter, r *http.Request) {
 		Validate: []handlers.Validate{
 			validateKafkaFound(),
 			ValidateKafkaUserFacingUpdateFields(ctx, h.authService, kafkaRequest, &kafkaUpdateReq),
+			ValidateKafkaConfigOverrides(h.kafkaConfig, kafkaUpdateReq.KafkaConfig),
 		},
 		Action: func() (i interface{}, serviceError *errors.ServiceError) {
 			updatedNeeded := false
@@ -178,10 +188,24 @@ func (h kafkaHandler) Update(w http.ResponseWriter, r *http.Request) {
 				updatedNeeded = true
 			}
 
+			if kafkaUpdateReq.KafkaConfig != nil {
+				desiredKafkaConfig, err := kafkaRequest.GetDesiredKafkaConfig()
+				if err != nil {
+					return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to get kafka_config")
+				}
+				if !(len(desiredKafkaConfig) == 0 && len(*kafkaUpdateReq.KafkaConfig) == 0) && !reflect.DeepEqual(desiredKafkaConfig, *kafkaUpdateReq.KafkaConfig) {
+					if err := kafkaRequest.SetDesiredKafkaConfig(*kafkaUpdateReq.KafkaConfig); err != nil {
+						return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to set kafka_config")
+					}
+					updatedNeeded = true
+				}
+			}
+
 			if updatedNeeded {
 				updateErr := h.service.Updates(kafkaRequest, map[string]interface{}{
 					"reauthentication_enabled": kafkaRequest.ReauthenticationEnabled,
 					"owner":                    kafkaRequest.Owner,
+					"desired_kafka_config":     kafkaRequest.DesiredKafkaConfig,
 				})
 
 				if updateErr != nil {
@@ -32,11 +32,17 @@ func (h *supportedKafkaInstanceTypesHandler) ListSupportedKafkaInstanceTypes(w h
 			handlers.ValidateLength(&cloudRegion, "cloud_region", handlers.MinRequiredFieldLength, nil),
 		},
 		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			claims, err := getClaims(r.Context())
+			if err != nil {
+				return nil, err
+			}
+			organisationId, _ := claims.GetOrgId()
+
 			supportedKafkaInstanceTypeList := public.SupportedKafkaInstanceTypesList{
 				InstanceTypes: []public.SupportedKafkaInstanceType{},
 			}
 
-			regionInstanceTypeList, err := h.supportedKafkaInstanceTypesService.GetSupportedKafkaInstanceTypesByRegion(cloudProvider, cloudRegion)
+			regionInstanceTypeList, err := h.supportedKafkaInstanceTypesService.GetSupportedKafkaInstanceTypesByRegion(cloudProvider, cloudRegion, organisationId)
 			if err != nil {
 				if err.IsInstanceTypeNotSupported() {
 					logger.Logger.Error(err)
@@ -1,10 +1,12 @@
 package handlers
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	mocks "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/test/mocks/kafkas"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/gorilla/mux"
 	"github.com/onsi/gomega"
@@ -25,6 +27,7 @@ func Test_ListSupportedKafkaInstanceTypes(t *testing.T) {
 	type args struct {
 		cloudProvider string
 		cloudRegion   string
+		ctx           context.Context
 	}
 
 	tests := []struct {
@@ -65,10 +68,11 @@ func Test_ListSupportedKafkaInstanceTypes(t *testing.T) {
 			args: args{
 				cloudRegion:   cloudRegion,
 				cloudProvider: cloudProvider,
+				ctx:           ctx,
 			},
 			fields: fields{
 				supportedKafkaInstanceTypesService: &services.SupportedKafkaInstanceTypesServiceMock{
-					GetSupportedKafkaInstanceTypesByRegionFunc: func(providerId, regionId string) ([]config.KafkaInstanceType, *errors.ServiceError) {
+					GetSupportedKafkaInstanceTypesByRegionFunc: func(providerId, regionId, organisationId string) ([]config.KafkaInstanceType, *errors.ServiceError) {
 						return nil, errors.InstanceTypeNotSupported("instance Type not supported")
 					},
 				},
@@ -80,25 +84,46 @@ func Test_ListSupportedKafkaInstanceTypes(t *testing.T) {
 			args: args{
 				cloudRegion:   cloudRegion,
 				cloudProvider: cloudProvider,
+				ctx:           ctx,
 			},
 			fields: fields{
 				supportedKafkaInstanceTypesService: &services.SupportedKafkaInstanceTypesServiceMock{
-					GetSupportedKafkaInstanceTypesByRegionFunc: func(providerId, regionId string) ([]config.KafkaInstanceType, *errors.ServiceError) {
+					GetSupportedKafkaInstanceTypesByRegionFunc: func(providerId, regionId, organisationId string) ([]config.KafkaInstanceType, *errors.ServiceError) {
 						return nil, errors.GeneralError("error occurred")
 					},
 				},
 			},
 			wantStatusCode: http.StatusInternalServerError,
 		},
+		{
+			name: "should pass the organisation id of the user when listing the KafkaInstanceTypes",
+			args: args{
+				cloudRegion:   cloudRegion,
+				cloudProvider: cloudProvider,
+				ctx:           ctx,
+			},
+			fields: fields{
+				supportedKafkaInstanceTypesService: &services.SupportedKafkaInstanceTypesServiceMock{
+					GetSupportedKafkaInstanceTypesByRegionFunc: func(providerId, regionId, organisationId string) ([]config.KafkaInstanceType, *errors.ServiceError) {
+						if organisationId != mocks.DefaultOrganisationId {
+							return nil, errors.GeneralError("unexpected organisation id %q", organisationId)
+						}
+						return []config.KafkaInstanceType{}, nil
+					},
+				},
+			},
+			wantStatusCode: http.StatusOK,
+		},
 		{
 			name: "should return the slice of KafkaInstanceTypes",
 			args: args{
 				cloudRegion:   cloudRegion,
 				cloudProvider: cloudProvider,
+				ctx:           ctx,
 			},
 			fields: fields{
 				supportedKafkaInstanceTypesService: &services.SupportedKafkaInstanceTypesServiceMock{
-					GetSupportedKafkaInstanceTypesByRegionFunc: func(providerId, regionId string) ([]config.KafkaInstanceType, *errors.ServiceError) {
+					GetSupportedKafkaInstanceTypesByRegionFunc: func(providerId, regionId, organisationId string) ([]config.KafkaInstanceType, *errors.ServiceError) {
 						return []config.KafkaInstanceType{
 							{
 								Id:          "developer",
@@ -129,6 +154,9 @@ func Test_ListSupportedKafkaInstanceTypes(t *testing.T) {
 				"cloud_provider": tt.args.cloudProvider,
 				"cloud_region":   tt.args.cloudRegion,
 			}
+			if tt.args.ctx != nil {
+				req = req.WithContext(tt.args.ctx)
+			}
 			req = mux.SetURLVars(req, muxVars)
 			h.ListSupportedKafkaInstanceTypes(rw, req)
 			resp := rw.Result()
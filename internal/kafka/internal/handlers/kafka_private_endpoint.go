@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/gorilla/mux"
+)
+
+type kafkaPrivateEndpointHandler struct {
+	service                services.KafkaService
+	privateEndpointService services.PrivateEndpointService
+}
+
+func NewKafkaPrivateEndpointHandler(service services.KafkaService, privateEndpointService services.PrivateEndpointService) *kafkaPrivateEndpointHandler {
+	return &kafkaPrivateEndpointHandler{
+		service:                service,
+		privateEndpointService: privateEndpointService,
+	}
+}
+
+func (h kafkaPrivateEndpointHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			kafkaRequest, err := h.service.Get(r.Context(), id)
+			if err != nil {
+				return nil, err
+			}
+			if err := validateKafkaHasPrivateEndpoint(kafkaRequest)(); err != nil {
+				return nil, err
+			}
+			return presenters.PresentKafkaPrivateEndpoint(kafkaRequest)
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+// Update replaces the list of accounts allowed to connect to the private endpoint of the Kafka instance.
+// The change is applied asynchronously by the private endpoint worker.
+func (h kafkaPrivateEndpointHandler) Update(w http.ResponseWriter, r *http.Request) {
+	var payload public.KafkaPrivateEndpointPayload
+	id := mux.Vars(r)["id"]
+	ctx := r.Context()
+	kafkaRequest, kafkaGetError := h.service.Get(ctx, id)
+	validateKafkaFound := func() handlers.Validate {
+		return func() *errors.ServiceError {
+			return kafkaGetError
+		}
+	}
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &payload,
+		Validate: []handlers.Validate{
+			validateKafkaFound(),
+			validateUserIsKafkaOwnerOrOrgAdmin(ctx, kafkaRequest),
+			validateKafkaHasPrivateEndpoint(kafkaRequest),
+			validatePrivateEndpointAllowedAccountIDs(&payload.AllowedAccountIds),
+		},
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			if err := h.privateEndpointService.UpdateAllowedAccounts(kafkaRequest, payload.AllowedAccountIds); err != nil {
+				return nil, err
+			}
+			return presenters.PresentKafkaPrivateEndpoint(kafkaRequest)
+		},
+	}
+
+	handlers.Handle(w, r, cfg, http.StatusAccepted)
+}
@@ -0,0 +1,180 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	mocks "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/test/mocks/kafkas"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/gorilla/mux"
+	"github.com/onsi/gomega"
+)
+
+func Test_kafkaPrivateEndpointHandler_Get(t *testing.T) {
+	tests := []struct {
+		name           string
+		kafkaService   services.KafkaService
+		wantStatusCode int
+	}{
+		{
+			name: "should return the private endpoint of a kafka with private connectivity",
+			kafkaService: &services.KafkaServiceMock{
+				GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+					return mocks.BuildKafkaRequest(mocks.WithPredefinedTestValues(), mocks.WithPrivateEndpoint(dbapi.PrivateEndpointStatusReady, "123456789012")), nil
+				},
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "should return bad request if the kafka has no private connectivity",
+			kafkaService: &services.KafkaServiceMock{
+				GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+					return mocks.BuildKafkaRequest(mocks.WithPredefinedTestValues()), nil
+				},
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "should return not found if the kafka does not exist",
+			kafkaService: &services.KafkaServiceMock{
+				GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+					return nil, errors.NotFound("kafka not found")
+				},
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			h := NewKafkaPrivateEndpointHandler(tt.kafkaService, &services.PrivateEndpointServiceMock{})
+			req, rw := GetHandlerParams(http.MethodGet, "/api/kafkas_mgmt/v1/kafkas/{id}/private_endpoint", nil, t)
+			req = mux.SetURLVars(req, map[string]string{"id": id})
+			req = req.WithContext(ctx)
+			h.Get(rw, req)
+			resp := rw.Result()
+			resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode))
+		})
+	}
+}
+
+func Test_kafkaPrivateEndpointHandler_Update(t *testing.T) {
+	type fields struct {
+		kafkaService           services.KafkaService
+		privateEndpointService *services.PrivateEndpointServiceMock
+	}
+
+	privateKafkaService := func() *services.KafkaServiceMock {
+		return &services.KafkaServiceMock{
+			GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+				return mocks.BuildKafkaRequest(
+					mocks.WithPredefinedTestValues(),
+					mocks.WithPrivateEndpoint(dbapi.PrivateEndpointStatusReady, "123456789012"),
+				), nil
+			},
+		}
+	}
+
+	updateAllowedAccountsMock := func(err *errors.ServiceError) *services.PrivateEndpointServiceMock {
+		return &services.PrivateEndpointServiceMock{
+			UpdateAllowedAccountsFunc: func(kafka *dbapi.KafkaRequest, accountIDs []string) *errors.ServiceError {
+				return err
+			},
+		}
+	}
+
+	tests := []struct {
+		name                   string
+		fields                 fields
+		payload                public.KafkaPrivateEndpointPayload
+		wantStatusCode         int
+		wantUpdateAllowedCalls int
+	}{
+		{
+			name: "should accept an update of the allowed accounts",
+			fields: fields{
+				kafkaService:           privateKafkaService(),
+				privateEndpointService: updateAllowedAccountsMock(nil),
+			},
+			payload:                public.KafkaPrivateEndpointPayload{AllowedAccountIds: []string{"123456789012", "210987654321"}},
+			wantStatusCode:         http.StatusAccepted,
+			wantUpdateAllowedCalls: 1,
+		},
+		{
+			name: "should reject an invalid account id",
+			fields: fields{
+				kafkaService:           privateKafkaService(),
+				privateEndpointService: updateAllowedAccountsMock(nil),
+			},
+			payload:        public.KafkaPrivateEndpointPayload{AllowedAccountIds: []string{"not-an-account"}},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "should reject duplicated account ids",
+			fields: fields{
+				kafkaService:           privateKafkaService(),
+				privateEndpointService: updateAllowedAccountsMock(nil),
+			},
+			payload:        public.KafkaPrivateEndpointPayload{AllowedAccountIds: []string{"123456789012", "123456789012"}},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "should reject a kafka without private connectivity",
+			fields: fields{
+				kafkaService: &services.KafkaServiceMock{
+					GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+						return mocks.BuildKafkaRequest(mocks.WithPredefinedTestValues()), nil
+					},
+				},
+				privateEndpointService: updateAllowedAccountsMock(nil),
+			},
+			payload:        public.KafkaPrivateEndpointPayload{AllowedAccountIds: []string{"123456789012"}},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "should return the error of the private endpoint service",
+			fields: fields{
+				kafkaService:           privateKafkaService(),
+				privateEndpointService: updateAllowedAccountsMock(errors.Conflict("the private endpoint has failed")),
+			},
+			payload:                public.KafkaPrivateEndpointPayload{AllowedAccountIds: []string{"123456789012"}},
+			wantStatusCode:         http.StatusConflict,
+			wantUpdateAllowedCalls: 1,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			h := NewKafkaPrivateEndpointHandler(tt.fields.kafkaService, tt.fields.privateEndpointService)
+			requestBody, err := json.Marshal(tt.payload)
+			if err != nil {
+				panic(fmt.Errorf("unexpected test error: %v", err))
+			}
+			req, rw := GetHandlerParams(http.MethodPut, "/api/kafkas_mgmt/v1/kafkas/{id}/private_endpoint", bytes.NewBuffer(requestBody), t)
+			req = mux.SetURLVars(req, map[string]string{"id": id})
+			req = req.WithContext(ctx)
+			h.Update(rw, req)
+			resp := rw.Result()
+			responseBody, err := io.ReadAll(resp.Body)
+			if err != nil {
+				panic(fmt.Errorf("unexpected test error: %v", err))
+			}
+			resp.Body.Close()
+			g.Expect(resp.StatusCode).To(gomega.Equal(tt.wantStatusCode), "returned body: '%s'", responseBody)
+			g.Expect(tt.fields.privateEndpointService.UpdateAllowedAccountsCalls()).To(gomega.HaveLen(tt.wantUpdateAllowedCalls))
+		})
+	}
+}
@@ -36,10 +36,11 @@ var (
 
 func Test_KafkaHandler_Get(t *testing.T) {
 	type fields struct {
-		service        services.KafkaService
-		providerConfig *config.ProviderConfig
-		authService    authorization.Authorization
-		kafkaConfig    *config.KafkaConfig
+		service                services.KafkaService
+		providerConfig         *config.ProviderConfig
+		authService            authorization.Authorization
+		kafkaConfig            *config.KafkaConfig
+		privateEndpointService services.PrivateEndpointService
 	}
 
 	tests := []struct {
@@ -77,7 +78,7 @@ func Test_KafkaHandler_Get(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 			g := gomega.NewWithT(t)
-			h := NewKafkaHandler(tt.fields.service, tt.fields.providerConfig, tt.fields.authService, tt.fields.kafkaConfig)
+			h := NewKafkaHandler(tt.fields.service, tt.fields.providerConfig, tt.fields.authService, tt.fields.kafkaConfig, tt.fields.privateEndpointService)
 			req, rw := GetHandlerParams("GET", "/{id}", nil, t)
 			req = mux.SetURLVars(req, map[string]string{"id": id})
 			h.Get(rw, req)
@@ -90,10 +91,11 @@ func Test_KafkaHandler_Get(t *testing.T) {
 
 func Test_KafkaHandler_Delete(t *testing.T) {
 	type fields struct {
-		service        services.KafkaService
-		providerConfig *config.ProviderConfig
-		authService    authorization.Authorization
-		kafkaConfig    *config.KafkaConfig
+		service                services.KafkaService
+		providerConfig         *config.ProviderConfig
+		authService            authorization.Authorization
+		kafkaConfig            *config.KafkaConfig
+		privateEndpointService services.PrivateEndpointService
 	}
 
 	type args struct {
@@ -145,7 +147,7 @@ func Test_KafkaHandler_Delete(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 			g := gomega.NewWithT(t)
-			h := NewKafkaHandler(tt.fields.service, tt.fields.providerConfig, tt.fields.authService, tt.fields.kafkaConfig)
+			h := NewKafkaHandler(tt.fields.service, tt.fields.providerConfig, tt.fields.authService, tt.fields.kafkaConfig, tt.fields.privateEndpointService)
 			req, rw := GetHandlerParams("DELETE", tt.args.url, nil, t)
 			h.Delete(rw, req)
 			resp := rw.Result()
@@ -157,10 +159,11 @@ func Test_KafkaHandler_Delete(t *testing.T) {
 
 func Test_KafkaHandler_List(t *testing.T) {
 	type fields struct {
-		service        services.KafkaService
-		providerConfig *config.ProviderConfig
-		authService    authorization.Authorization
-		kafkaConfig    *config.KafkaConfig
+		service                services.KafkaService
+		providerConfig         *config.ProviderConfig
+		authService            authorization.Authorization
+		kafkaConfig            *config.KafkaConfig
+		privateEndpointService services.PrivateEndpointService
 	}
 
 	type args struct {
@@ -238,7 +241,7 @@ func Test_KafkaHandler_List(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 			g := gomega.NewWithT(t)
-			h := NewKafkaHandler(tt.fields.service, tt.fields.providerConfig, tt.fields.authService, tt.fields.kafkaConfig)
+			h := NewKafkaHandler(tt.fields.service, tt.fields.providerConfig, tt.fields.authService, tt.fields.kafkaConfig, tt.fields.privateEndpointService)
 			req, rw := GetHandlerParams("GET", tt.args.url, nil, t)
 			h.List(rw, req)
 			resp := rw.Result()
@@ -250,10 +253,11 @@ func Test_KafkaHandler_List(t *testing.T) {
 
 func Test_KafkaHandler_Update(t *testing.T) {
 	type fields struct {
-		service        services.KafkaService
-		providerConfig *config.ProviderConfig
-		authService    authorization.Authorization
-		kafkaConfig    *config.KafkaConfig
+		service                services.KafkaService
+		providerConfig         *config.ProviderConfig
+		authService            authorization.Authorization
+		kafkaConfig            *config.KafkaConfig
+		privateEndpointService services.PrivateEndpointService
 	}
 
 	type args struct {
@@ -351,7 +355,7 @@ func Test_KafkaHandler_Update(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 			g := gomega.NewWithT(t)
-			h := NewKafkaHandler(tt.fields.service, tt.fields.providerConfig, tt.fields.authService, tt.fields.kafkaConfig)
+			h := NewKafkaHandler(tt.fields.service, tt.fields.providerConfig, tt.fields.authService, tt.fields.kafkaConfig, tt.fields.privateEndpointService)
 			req, rw := GetHandlerParams("PATCH", tt.args.url, bytes.NewBuffer(tt.args.body), t)
 			req = req.WithContext(tt.args.ctx)
 			h.Update(rw, req)
@@ -364,10 +368,11 @@ func Test_KafkaHandler_Update(t *testing.T) {
 
 func Test_KafkaHandler_Create(t *testing.T) {
 	type fields struct {
-		service        services.KafkaService
-		providerConfig *config.ProviderConfig
-		authService    authorization.Authorization
-		kafkaConfig    *config.KafkaConfig
+		service                services.KafkaService
+		providerConfig         *config.ProviderConfig
+		authService            authorization.Authorization
+		kafkaConfig            *config.KafkaConfig
+		privateEndpointService services.PrivateEndpointService
 	}
 
 	type args struct {
@@ -376,6 +381,35 @@ func Test_KafkaHandler_Create(t *testing.T) {
 		ctx  context.Context
 	}
 
+	privateConnectivityKafkaConfig := fullKafkaConfig
+	privateConnectivityKafkaConfig.EnablePrivateConnectivity = true
+
+	privateKafkaServiceMock := func() *services.KafkaServiceMock {
+		return &services.KafkaServiceMock{
+			GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+				return mocks.BuildKafkaRequest(mocks.WithPredefinedTestValues()), nil
+			},
+			ListFunc: func(ctx context.Context, listArgs *s.ListArguments) (dbapi.KafkaList, *api.PagingMeta, *errors.ServiceError) {
+				return dbapi.KafkaList{}, &api.PagingMeta{}, nil
+			},
+			RegisterKafkaJobFunc: func(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
+				kafkaRequest.MaxDataRetentionSize = mocksupportedinstancetypes.DefaultMaxDataRetentionSize
+				return nil
+			},
+			AssignInstanceTypeFunc: func(owner, organisationID string) (types.KafkaInstanceType, *errors.ServiceError) {
+				return types.STANDARD, nil
+			},
+		}
+	}
+
+	privateEndpointServiceMock := func(supported bool) *services.PrivateEndpointServiceMock {
+		return &services.PrivateEndpointServiceMock{
+			SupportsCloudProviderFunc: func(cloudProvider string) bool {
+				return supported
+			},
+		}
+	}
+
 	tests := []struct {
 		name           string
 		fields         fields
@@ -597,6 +631,66 @@ func Test_KafkaHandler_Create(t *testing.T) {
 			},
 			wantStatusCode: http.StatusBadRequest,
 		},
+		{
+			name: "succeeds if a private endpoint is requested for a supported cloud provider",
+			fields: fields{
+				service:                privateKafkaServiceMock(),
+				providerConfig:         &supportedProviders,
+				kafkaConfig:            &privateConnectivityKafkaConfig,
+				privateEndpointService: privateEndpointServiceMock(true),
+			},
+			args: args{
+				url:  "/kafkas?async=true",
+				body: []byte(`{"name": "name", "cloud_provider": "aws", "region": "us-east-1", "private_endpoint": {"allowed_account_ids": ["123456789012"]}}`),
+				ctx:  ctx,
+			},
+			wantStatusCode: http.StatusAccepted,
+		},
+		{
+			name: "fails if a private endpoint is requested while private connectivity is disabled",
+			fields: fields{
+				service:                privateKafkaServiceMock(),
+				providerConfig:         &supportedProviders,
+				kafkaConfig:            &fullKafkaConfig,
+				privateEndpointService: privateEndpointServiceMock(true),
+			},
+			args: args{
+				url:  "/kafkas?async=true",
+				body: []byte(`{"name": "name", "cloud_provider": "aws", "region": "us-east-1", "private_endpoint": {"allowed_account_ids": []}}`),
+				ctx:  ctx,
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "fails if a private endpoint is requested for a cloud provider not supporting them",
+			fields: fields{
+				service:                privateKafkaServiceMock(),
+				providerConfig:         &supportedProviders,
+				kafkaConfig:            &privateConnectivityKafkaConfig,
+				privateEndpointService: privateEndpointServiceMock(false),
+			},
+			args: args{
+				url:  "/kafkas?async=true",
+				body: []byte(`{"name": "name", "cloud_provider": "aws", "region": "us-east-1", "private_endpoint": {"allowed_account_ids": []}}`),
+				ctx:  ctx,
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "fails if a private endpoint is requested with an invalid account id",
+			fields: fields{
+				service:                privateKafkaServiceMock(),
+				providerConfig:         &supportedProviders,
+				kafkaConfig:            &privateConnectivityKafkaConfig,
+				privateEndpointService: privateEndpointServiceMock(true),
+			},
+			args: args{
+				url:  "/kafkas?async=true",
+				body: []byte(`{"name": "name", "cloud_provider": "aws", "region": "us-east-1", "private_endpoint": {"allowed_account_ids": ["1234"]}}`),
+				ctx:  ctx,
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
 	}
 
 	for _, testcase := range tests {
@@ -604,7 +698,7 @@ func Test_KafkaHandler_Create(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 			g := gomega.NewWithT(t)
-			h := NewKafkaHandler(tt.fields.service, tt.fields.providerConfig, tt.fields.authService, tt.fields.kafkaConfig)
+			h := NewKafkaHandler(tt.fields.service, tt.fields.providerConfig, tt.fields.authService, tt.fields.kafkaConfig, tt.fields.privateEndpointService)
 			req, rw := GetHandlerParams("CREATE", tt.args.url, bytes.NewBuffer(tt.args.body), t)
 			req = req.WithContext(tt.args.ctx)
 			h.Create(rw, req)
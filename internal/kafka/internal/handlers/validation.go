@@ -35,6 +35,10 @@ var ClusterIdLength = 32
 
 const minimunNumberOfNodesForTheKafkaMachinePool = 3
 
+// ValidPrivateEndpointAccountIDRegexp matches the identifier of an AWS account, the only
+// cloud provider currently offering private endpoints
+var ValidPrivateEndpointAccountIDRegexp = regexp.MustCompile(`^[0-9]{12}$`)
+
 func validateKafkaBillingModel(ctx context.Context, kafkaService services.KafkaService, kafkaConfig *config.KafkaConfig, kafkaRequestPayload *public.KafkaRequestPayload) handlers.Validate {
 	return func() *errors.ServiceError {
 		billingModel := shared.SafeString(kafkaRequestPayload.BillingModel)
@@ -287,7 +291,7 @@ func getInstanceTypeAndSize(ctx context.Context, kafkaService services.KafkaServ
 		if err != nil {
 			return "", "", errors.New(errors.ErrorBadRequest, fmt.Sprintf("unable to detect instance size in plan provided: %q", kafkaRequestPayload.Plan))
 		}
-		_, err = kafkaConfig.GetKafkaInstanceSize(instTypeFromPlan, size)
+		_, err = kafkaConfig.GetKafkaInstanceSizeForOrganisation(instTypeFromPlan, size, organisationId)
 
 		if err != nil {
 			return "", "", errors.InstancePlanNotSupported("unsupported plan provided: %q", kafkaRequestPayload.Plan)
@@ -310,6 +314,20 @@ func ValidateKafkaPlan(ctx context.Context, kafkaService services.KafkaService,
 	}
 }
 
+// ValidateKafkaConfigOverrides - validate that the requested kafka config overrides are allow-listed and within the configured bounds
+func ValidateKafkaConfigOverrides(kafkaConfig *config.KafkaConfig, kafkaConfigOverrides *map[string]string) handlers.Validate {
+	return func() *errors.ServiceError {
+		if kafkaConfigOverrides == nil || len(*kafkaConfigOverrides) == 0 {
+			return nil
+		}
+
+		if err := kafkaConfig.ConfigOverrides.ValidateKafkaConfig(*kafkaConfigOverrides); err != nil {
+			return errors.FieldValidationError("invalid kafka_config: %s", err.Error())
+		}
+		return nil
+	}
+}
+
 func ValidateKafkaUpdateFields(kafkaUpdateRequest *private.KafkaUpdateRequest) handlers.Validate {
 	return func() *errors.ServiceError {
 		if !(stringSet(&kafkaUpdateRequest.StrimziVersion) ||
@@ -606,3 +624,166 @@ func validateKafkaPromoteRequestFields(kafkaPromoteRequest *public.KafkaPromoteR
 		return nil
 	}
 }
+
+// validateClusterBlueprintRequest checks that the cluster blueprint request targets a supported region and only refers to
+// instance types supported in that region
+func validateClusterBlueprintRequest(request *private.ClusterBlueprintRequest, providerConfig *config.ProviderConfig) handlers.Validate {
+	return func() *errors.ServiceError {
+		provider, ok := providerConfig.ProvidersConfig.SupportedProviders.GetByName(request.CloudProvider)
+		if !ok {
+			return errors.FieldValidationError("cloud provider %q is not supported", request.CloudProvider)
+		}
+		region, ok := provider.Regions.GetByName(request.Region)
+		if !ok {
+			return errors.FieldValidationError("region %q is not supported by cloud provider %q", request.Region, request.CloudProvider)
+		}
+
+		if request.ClusterCount < 0 {
+			return errors.FieldValidationError("cluster count %d must be greater than or equal to 0", request.ClusterCount)
+		}
+
+		supportedInstanceTypes := (&dbapi.ClusterBlueprint{SupportedInstanceType: request.SupportedInstanceType}).GetSupportedInstanceTypes()
+		if len(supportedInstanceTypes) == 0 {
+			return errors.FieldValidationError("supported instance type must not be empty")
+		}
+		for _, instanceType := range supportedInstanceTypes {
+			if !region.IsInstanceTypeSupported(config.InstanceType(instanceType)) {
+				return errors.FieldValidationError("instance type %q is not supported in region %q", instanceType, request.Region)
+			}
+		}
+
+		for _, machinePool := range request.MachinePools {
+			if !arrays.Contains(supportedInstanceTypes, machinePool.InstanceType) {
+				return errors.FieldValidationError("machine pool instance type %q is not a supported instance type of the blueprint", machinePool.InstanceType)
+			}
+			if machinePool.MachineType == "" {
+				return errors.FieldValidationError("machine type of the %q machine pool must not be empty", machinePool.InstanceType)
+			}
+			if machinePool.MinComputeNodes <= 0 || machinePool.MinComputeNodes > machinePool.MaxComputeNodes {
+				return errors.FieldValidationError("compute nodes of the %q machine pool must be greater than 0 with min less than or equal to max", machinePool.InstanceType)
+			}
+		}
+
+		for _, parameter := range request.AddonParameters {
+			if parameter.Id == "" {
+				return errors.FieldValidationError("addon parameter id must not be empty")
+			}
+		}
+
+		return nil
+	}
+}
+
+// validateClusterBlueprintLocationUnchanged checks that the update of a cluster blueprint does not move it to another region
+func validateClusterBlueprintLocationUnchanged(request *private.ClusterBlueprintRequest, blueprint *dbapi.ClusterBlueprint) handlers.Validate {
+	return func() *errors.ServiceError {
+		if request.CloudProvider != blueprint.CloudProvider || request.Region != blueprint.Region {
+			return errors.FieldValidationError("the cloud provider and region of cluster blueprint %q cannot be changed", blueprint.ID)
+		}
+		return nil
+	}
+}
+
+func validateClusterUpgradeRequest(request *private.ClusterUpgradeRequest) handlers.Validate {
+	return func() *errors.ServiceError {
+		if request.Version == "" {
+			return errors.FieldValidationError("version must not be empty")
+		}
+		return nil
+	}
+}
+
+func validateClusterUpgradeWaveRequest(request *private.ClusterUpgradeWaveRequest) handlers.Validate {
+	return func() *errors.ServiceError {
+		if request.Version == "" {
+			return errors.FieldValidationError("version must not be empty")
+		}
+		if len(request.ClusterIds) == 0 {
+			return errors.FieldValidationError("cluster ids must not be empty")
+		}
+
+		seen := map[string]bool{}
+		for _, clusterID := range request.ClusterIds {
+			if seen[clusterID] {
+				return errors.FieldValidationError("cluster id %q is listed more than once", clusterID)
+			}
+			seen[clusterID] = true
+		}
+
+		if request.BatchSize < 0 {
+			return errors.FieldValidationError("batch size %d must be greater than or equal to 0", request.BatchSize)
+		}
+		if request.BatchIntervalMinutes < 0 {
+			return errors.FieldValidationError("batch interval minutes %d must be greater than or equal to 0", request.BatchIntervalMinutes)
+		}
+		return nil
+	}
+}
+
+// validateKafkaPrivateEndpoint validates the private endpoint requested on creation of a Kafka instance.
+// Private endpoints are only available for standard, non enterprise instances created on a cloud provider
+// supporting them, and only when the private connectivity feature is enabled.
+func validateKafkaPrivateEndpoint(ctx context.Context, kafkaService services.KafkaService, kafkaConfig *config.KafkaConfig,
+	providerConfig *config.ProviderConfig, privateEndpointService services.PrivateEndpointService, kafkaRequestPayload *public.KafkaRequestPayload) handlers.Validate {
+	return func() *errors.ServiceError {
+		if kafkaRequestPayload.PrivateEndpoint == nil {
+			return nil
+		}
+
+		if !kafkaConfig.EnablePrivateConnectivity {
+			return errors.BadRequest("private endpoints are not available")
+		}
+
+		if !shared.StringEmpty(kafkaRequestPayload.ClusterId) ||
+			shared.StringEqualsIgnoreCase(shared.SafeString(kafkaRequestPayload.BillingModel), constants.BillingModelEnterprise.String()) {
+			return errors.BadRequest("private endpoints are not available for enterprise kafka instances")
+		}
+
+		cloudProvider, _, svcErr := getCloudProviderAndRegion(ctx, kafkaService, kafkaRequestPayload, providerConfig)
+		if svcErr != nil {
+			return svcErr
+		}
+
+		if !privateEndpointService.SupportsCloudProvider(cloudProvider) {
+			return errors.BadRequest("private endpoints are not available for cloud provider %q", cloudProvider)
+		}
+
+		instanceType, _, svcErr := getInstanceTypeAndSize(ctx, kafkaService, kafkaConfig, kafkaRequestPayload)
+		if svcErr != nil {
+			return svcErr
+		}
+
+		if instanceType == types.DEVELOPER.String() {
+			return errors.BadRequest("private endpoints are not available for %q kafka instances", instanceType)
+		}
+
+		return validatePrivateEndpointAllowedAccountIDs(&kafkaRequestPayload.PrivateEndpoint.AllowedAccountIds)()
+	}
+}
+
+func validatePrivateEndpointAllowedAccountIDs(accountIDs *[]string) handlers.Validate {
+	return func() *errors.ServiceError {
+		seen := map[string]bool{}
+		for _, accountID := range *accountIDs {
+			if !ValidPrivateEndpointAccountIDRegexp.MatchString(accountID) {
+				return errors.MalformedRequest("allowed_account_ids: %q is not a valid account id, it must consist of 12 digits", accountID)
+			}
+			if seen[accountID] {
+				return errors.MalformedRequest("allowed_account_ids: account id %q is listed more than once", accountID)
+			}
+			seen[accountID] = true
+		}
+
+		return nil
+	}
+}
+
+func validateKafkaHasPrivateEndpoint(kafkaRequest *dbapi.KafkaRequest) handlers.Validate {
+	return func() *errors.ServiceError {
+		if !kafkaRequest.PrivateConnectivity {
+			return errors.BadRequest("kafka instance %q was not created with a private endpoint", kafkaRequest.ID)
+		}
+
+		return nil
+	}
+}
@@ -1018,6 +1018,57 @@ func TestValidateKafkaUpdateFields(t *testing.T) {
 	}
 }
 
+func TestValidateKafkaConfigOverrides(t *testing.T) {
+	kafkaConfig := config.NewKafkaConfig()
+	kafkaConfig.ConfigOverrides.Configuration.AllowedKafkaConfig = []config.KafkaConfigOverride{
+		{Key: "auto.create.topics.enable", Type: config.KafkaConfigOverrideTypeBoolean},
+	}
+
+	tests := []struct {
+		name                 string
+		kafkaConfigOverrides *map[string]string
+		wantErr              bool
+	}{
+		{
+			name:                 "should return nil when kafka config overrides are not provided",
+			kafkaConfigOverrides: nil,
+			wantErr:              false,
+		},
+		{
+			name:                 "should return nil when kafka config overrides are empty",
+			kafkaConfigOverrides: &map[string]string{},
+			wantErr:              false,
+		},
+		{
+			name:                 "should return nil when kafka config overrides are valid",
+			kafkaConfigOverrides: &map[string]string{"auto.create.topics.enable": "false"},
+			wantErr:              false,
+		},
+		{
+			name:                 "should return an error when a kafka config override is not allowed",
+			kafkaConfigOverrides: &map[string]string{"message.max.bytes": "1"},
+			wantErr:              true,
+		},
+		{
+			name:                 "should return an error when a kafka config override value is not valid",
+			kafkaConfigOverrides: &map[string]string{"auto.create.topics.enable": "maybe"},
+			wantErr:              true,
+		},
+	}
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := gomega.NewWithT(t)
+			err := ValidateKafkaConfigOverrides(kafkaConfig, tt.kafkaConfigOverrides)()
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				g.Expect(err.Code).To(gomega.Equal(errors.ErrorFieldValidationError))
+			}
+		})
+	}
+}
+
 func TestValidateMaxDataRetentionSize(t *testing.T) {
 	type args struct {
 		kafkaRequest   *dbapi.KafkaRequest
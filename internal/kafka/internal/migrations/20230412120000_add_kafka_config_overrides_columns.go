@@ -0,0 +1,26 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addKafkaConfigOverridesColumns() *gormigrate.Migration {
+	type KafkaRequest struct {
+		DesiredKafkaConfig string `gorm:"type:jsonb"`
+		ActualKafkaConfig  string `gorm:"type:jsonb"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "20230412120000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&KafkaRequest{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropColumn(&KafkaRequest{}, "desired_kafka_config"); err != nil {
+				return err
+			}
+			return tx.Migrator().DropColumn(&KafkaRequest{}, "actual_kafka_config")
+		},
+	}
+}
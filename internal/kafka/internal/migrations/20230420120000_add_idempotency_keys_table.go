@@ -0,0 +1,32 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addIdempotencyKeysTable() *gormigrate.Migration {
+	type IdempotencyKey struct {
+		ID                  string `gorm:"primaryKey"`
+		Owner               string `gorm:"uniqueIndex:idx_idempotency_keys_owner_key"`
+		Key                 string `gorm:"uniqueIndex:idx_idempotency_keys_owner_key"`
+		RequestHash         string
+		ResponseStatus      int
+		ResponseContentType string
+		ResponseBody        []byte
+		CreatedAt           time.Time
+		ExpiresAt           time.Time `gorm:"index"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "20230420120000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&IdempotencyKey{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&IdempotencyKey{})
+		},
+	}
+}
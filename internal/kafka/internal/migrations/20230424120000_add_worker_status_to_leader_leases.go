@@ -0,0 +1,32 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addWorkerStatusToLeaderLeases() *gormigrate.Migration {
+	type LeaderLease struct {
+		Paused              bool `gorm:"not null;default:false"`
+		LastReconcileAt     *time.Time
+		LastReconcileErrors string `gorm:"type:jsonb"`
+		NextReconcileAt     *time.Time
+	}
+
+	return &gormigrate.Migration{
+		ID: "20230424120000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&LeaderLease{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			for _, column := range []string{"paused", "last_reconcile_at", "last_reconcile_errors", "next_reconcile_at"} {
+				if err := tx.Migrator().DropColumn(&LeaderLease{}, column); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
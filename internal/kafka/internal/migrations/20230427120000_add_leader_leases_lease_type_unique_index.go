@@ -0,0 +1,20 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+// addLeaderLeasesLeaseTypeUniqueIndex ensures a single lease exists per lease type so that the shard and replica
+// leases created on demand by the replicas running in sharded mode cannot be duplicated
+func addLeaderLeasesLeaseTypeUniqueIndex() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "20230427120000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.Exec("CREATE UNIQUE INDEX IF NOT EXISTS uix_leader_leases_lease_type ON leader_leases (lease_type) WHERE deleted_at IS NULL").Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Exec("DROP INDEX IF EXISTS uix_leader_leases_lease_type").Error
+		},
+	}
+}
@@ -0,0 +1,32 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addWorkItemFailuresTable() *gormigrate.Migration {
+	type WorkItemFailure struct {
+		ID            string `gorm:"primaryKey"`
+		WorkerType    string `gorm:"uniqueIndex:idx_work_item_failures_worker_type_resource_id"`
+		ResourceID    string `gorm:"uniqueIndex:idx_work_item_failures_worker_type_resource_id"`
+		Attempts      int
+		LastError     string
+		NextAttemptAt time.Time
+		QuarantinedAt *time.Time
+		CreatedAt     time.Time
+		UpdatedAt     time.Time
+	}
+
+	return &gormigrate.Migration{
+		ID: "20230501120000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&WorkItemFailure{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&WorkItemFailure{})
+		},
+	}
+}
@@ -0,0 +1,29 @@
+package migrations
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addClusterConsolidationPlansTable() *gormigrate.Migration {
+	type ClusterConsolidationPlan struct {
+		db.Model
+		ClusterID     string `gorm:"index"`
+		CloudProvider string
+		Region        string
+		Status        string `gorm:"index"`
+		Utilization   int
+		Moves         string `gorm:"type:jsonb"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "20230505120000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&ClusterConsolidationPlan{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&ClusterConsolidationPlan{})
+		},
+	}
+}
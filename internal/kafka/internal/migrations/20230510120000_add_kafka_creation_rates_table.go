@@ -0,0 +1,32 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addKafkaCreationRatesTable() *gormigrate.Migration {
+	type KafkaCreationRate struct {
+		ID             string    `gorm:"primaryKey"`
+		CloudProvider  string    `gorm:"uniqueIndex:idx_kafka_creation_rates_window"`
+		Region         string    `gorm:"uniqueIndex:idx_kafka_creation_rates_window"`
+		InstanceType   string    `gorm:"uniqueIndex:idx_kafka_creation_rates_window"`
+		WindowStart    time.Time `gorm:"uniqueIndex:idx_kafka_creation_rates_window"`
+		KafkaCount     int
+		StreamingUnits int
+		CreatedAt      time.Time
+		UpdatedAt      time.Time
+	}
+
+	return &gormigrate.Migration{
+		ID: "20230510120000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&KafkaCreationRate{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&KafkaCreationRate{})
+		},
+	}
+}
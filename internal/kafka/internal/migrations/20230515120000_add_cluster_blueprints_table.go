@@ -0,0 +1,32 @@
+package migrations
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addClusterBlueprintsTable() *gormigrate.Migration {
+	type ClusterBlueprint struct {
+		db.Model
+		CloudProvider          string `gorm:"uniqueIndex:idx_cluster_blueprints_location"`
+		Region                 string `gorm:"uniqueIndex:idx_cluster_blueprints_location"`
+		ClusterCount           int
+		SupportedInstanceType  string
+		MultiAZ                bool
+		OpenShiftVersion       string
+		ClusterWideMachineType string
+		MachinePools           string `gorm:"type:jsonb"`
+		AddonParameters        string `gorm:"type:jsonb"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "20230515120000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&ClusterBlueprint{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&ClusterBlueprint{})
+		},
+	}
+}
@@ -0,0 +1,28 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+const clusterBlueprintIDColumnName = "cluster_blueprint_id"
+
+func addClusterBlueprintIDColumnInClustersTable() *gormigrate.Migration {
+	type Cluster struct {
+		ClusterBlueprintID string `gorm:"index"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "20230515120100",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&Cluster{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if !tx.Migrator().HasColumn(&Cluster{}, clusterBlueprintIDColumnName) {
+				return nil
+			}
+
+			return tx.Migrator().DropColumn(&Cluster{}, clusterBlueprintIDColumnName)
+		},
+	}
+}
@@ -0,0 +1,34 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addUpgradeColumnsInClustersTable() *gormigrate.Migration {
+	type Cluster struct {
+		UpgradeStatus        string `gorm:"index"`
+		UpgradeVersion       string
+		UpgradeScheduledAt   *time.Time
+		UpgradeWaveID        string `gorm:"index"`
+		UpgradePolicyID      string
+		UpgradeStatusDetails string
+	}
+
+	return &gormigrate.Migration{
+		ID: "20230522120000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&Cluster{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			for _, column := range []string{"upgrade_status", "upgrade_version", "upgrade_scheduled_at", "upgrade_wave_id", "upgrade_policy_id", "upgrade_status_details"} {
+				if err := tx.Migrator().DropColumn(&Cluster{}, column); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,39 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addPrivateEndpointColumnsInKafkaRequestsTable() *gormigrate.Migration {
+	type KafkaRequest struct {
+		PrivateConnectivity            bool   `gorm:"default:false"`
+		PrivateEndpointStatus          string `gorm:"index"`
+		PrivateEndpointServiceID       string
+		PrivateEndpointServiceName     string
+		PrivateEndpointStatusDetails   string
+		PrivateEndpointAllowedAccounts string `gorm:"type:jsonb"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "20230529120000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&KafkaRequest{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			for _, column := range []string{
+				"private_connectivity",
+				"private_endpoint_status",
+				"private_endpoint_service_id",
+				"private_endpoint_service_name",
+				"private_endpoint_status_details",
+				"private_endpoint_allowed_accounts",
+			} {
+				if err := tx.Migrator().DropColumn(&KafkaRequest{}, column); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,37 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addDrainColumnsInClusterConsolidationPlansTable() *gormigrate.Migration {
+	type ClusterConsolidationPlan struct {
+		RetainedKafkas string `gorm:"type:jsonb"`
+		ApprovedAt     *time.Time
+	}
+
+	return &gormigrate.Migration{
+		ID: "20230605120000",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&ClusterConsolidationPlan{}); err != nil {
+				return err
+			}
+			// the plans approved before the approval time was recorded start their drain timeout now
+			return tx.Exec("UPDATE cluster_consolidation_plans SET approved_at = NOW() WHERE status = 'approved' AND approved_at IS NULL").Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			for _, column := range []string{
+				"retained_kafkas",
+				"approved_at",
+			} {
+				if err := tx.Migrator().DropColumn(&ClusterConsolidationPlan{}, column); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
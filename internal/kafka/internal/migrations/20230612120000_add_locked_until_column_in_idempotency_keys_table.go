@@ -0,0 +1,28 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addLockedUntilColumnInIdempotencyKeysTable() *gormigrate.Migration {
+	type IdempotencyKey struct {
+		LockedUntil time.Time
+	}
+
+	return &gormigrate.Migration{
+		ID: "20230612120000",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&IdempotencyKey{}); err != nil {
+				return err
+			}
+			// the pending keys stored before the lease existed can be used again
+			return tx.Exec("UPDATE idempotency_keys SET locked_until = created_at WHERE locked_until IS NULL").Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropColumn(&IdempotencyKey{}, "locked_until")
+		},
+	}
+}
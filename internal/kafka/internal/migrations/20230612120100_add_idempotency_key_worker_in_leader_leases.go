@@ -0,0 +1,24 @@
+package migrations
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func addIdempotencyKeyWorkerInLeaderLeases() *gormigrate.Migration {
+	leaderLeaseType := "idempotency_key"
+	return &gormigrate.Migration{
+		ID: "20230612120100",
+		Migrate: func(tx *gorm.DB) error {
+			// the lease may have already been created by the connector fleet manager migrations
+			return tx.Clauses(clause.OnConflict{DoNothing: true}).
+				Create(&api.LeaderLease{Expires: &db.KafkaAdditionalLeasesExpireTime, LeaseType: leaderLeaseType, Leader: api.NewID()}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Unscoped().Where("lease_type = ?", leaderLeaseType).Delete(&api.LeaderLease{}).Error
+		},
+	}
+}
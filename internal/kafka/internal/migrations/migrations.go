@@ -100,6 +100,22 @@ var migrations = []*gormigrate.Migration{
 	addKafkaDomainCertificateManagementInfoInKafkaRequestsTable(),
 	addKafkasRoutesTLSCertificateManagerInLeaderLeases(),
 	addDistributedLockTable(),
+	addKafkaConfigOverridesColumns(),
+	addIdempotencyKeysTable(),
+	addWorkerStatusToLeaderLeases(),
+	addLeaderLeasesLeaseTypeUniqueIndex(),
+	addWorkItemFailuresTable(),
+	addClusterConsolidationPlansTable(),
+	addKafkaCreationRatesTable(),
+	addClusterBlueprintsTable(),
+	addClusterBlueprintIDColumnInClustersTable(),
+	addUpgradeColumnsInClustersTable(),
+	addClusterUpgradeWorkerInLeaderLeases(),
+	addPrivateEndpointColumnsInKafkaRequestsTable(),
+	addKafkaPrivateEndpointWorkerInLeaderLeases(),
+	addDrainColumnsInClusterConsolidationPlansTable(),
+	addLockedUntilColumnInIdempotencyKeysTable(),
+	addIdempotencyKeyWorkerInLeaderLeases(),
 }
 
 func New(dbConfig *db.DatabaseConfig) (*db.Migration, func(), error) {
@@ -0,0 +1,41 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+)
+
+// PresentCapacityReport presents the capacity and the forecasted demand of every supported instance type per region
+func PresentCapacityReport(report *services.CapacityReport) private.CapacityReport {
+	items := make([]private.CapacityReportItem, 0, len(report.Items))
+	for _, item := range report.Items {
+		var limit *int32
+		if item.Limit != nil {
+			l := int32(*item.Limit)
+			limit = &l
+		}
+
+		items = append(items, private.CapacityReportItem{
+			CloudProvider:                           item.CloudProvider,
+			Region:                                  item.Region,
+			InstanceType:                            item.InstanceType,
+			MaxStreamingUnits:                       int32(item.MaxStreamingUnits),
+			ConsumedStreamingUnits:                  int32(item.ConsumedStreamingUnits),
+			FreeStreamingUnits:                      int32(item.FreeStreamingUnits),
+			ObservedKafkaCount:                      int32(item.ObservedKafkaCount),
+			ObservedStreamingUnits:                  int32(item.ObservedStreamingUnits),
+			ForecastedStreamingUnits:                int32(item.ForecastedStreamingUnits),
+			ProjectedFreeStreamingUnits:             int32(item.ProjectedFreeStreamingUnits),
+			MinAvailableCapacitySlackStreamingUnits: int32(item.MinAvailableCapacitySlackStreamingUnits),
+			Limit:                                   limit,
+			ScaleUpOngoing:                          item.ScaleUpOngoing,
+		})
+	}
+
+	return private.CapacityReport{
+		Kind:                   KindCapacityReport,
+		ForecastHorizonMinutes: int32(report.Horizon.Minutes()),
+		LookbackWindowHours:    int32(report.Lookback.Hours()),
+		Items:                  items,
+	}
+}
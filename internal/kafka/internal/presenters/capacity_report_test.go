@@ -0,0 +1,87 @@
+package presenters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/onsi/gomega"
+)
+
+func TestPresentCapacityReport(t *testing.T) {
+	limit := 100
+	presentedLimit := int32(100)
+
+	tests := []struct {
+		name   string
+		report *services.CapacityReport
+		want   private.CapacityReport
+	}{
+		{
+			name: "should present the capacity report and its items",
+			report: &services.CapacityReport{
+				Horizon:  90 * time.Minute,
+				Lookback: 168 * time.Hour,
+				Items: []services.CapacityReportItem{
+					{
+						StreamingUnitsForecast: services.StreamingUnitsForecast{
+							CloudProvider:            "aws",
+							Region:                   "us-east-1",
+							InstanceType:             "standard",
+							ObservedKafkaCount:       10,
+							ObservedStreamingUnits:   20,
+							ForecastedStreamingUnits: 1,
+						},
+						MaxStreamingUnits:                       10,
+						ConsumedStreamingUnits:                  6,
+						FreeStreamingUnits:                      4,
+						ProjectedFreeStreamingUnits:             3,
+						MinAvailableCapacitySlackStreamingUnits: 5,
+						Limit:                                   &limit,
+						ScaleUpOngoing:                          true,
+					},
+				},
+			},
+			want: private.CapacityReport{
+				Kind:                   KindCapacityReport,
+				ForecastHorizonMinutes: 90,
+				LookbackWindowHours:    168,
+				Items: []private.CapacityReportItem{
+					{
+						CloudProvider:                           "aws",
+						Region:                                  "us-east-1",
+						InstanceType:                            "standard",
+						MaxStreamingUnits:                       10,
+						ConsumedStreamingUnits:                  6,
+						FreeStreamingUnits:                      4,
+						ObservedKafkaCount:                      10,
+						ObservedStreamingUnits:                  20,
+						ForecastedStreamingUnits:                1,
+						ProjectedFreeStreamingUnits:             3,
+						MinAvailableCapacitySlackStreamingUnits: 5,
+						Limit:                                   &presentedLimit,
+						ScaleUpOngoing:                          true,
+					},
+				},
+			},
+		},
+		{
+			name:   "should present an empty capacity report",
+			report: &services.CapacityReport{},
+			want: private.CapacityReport{
+				Kind:  KindCapacityReport,
+				Items: []private.CapacityReportItem{},
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := gomega.NewWithT(t)
+			g.Expect(PresentCapacityReport(tt.report)).To(gomega.Equal(tt.want))
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+)
+
+// ConvertClusterBlueprintRequest converts a cluster blueprint request into the given blueprint, creating it if nil
+func ConvertClusterBlueprintRequest(request private.ClusterBlueprintRequest, blueprint *dbapi.ClusterBlueprint) (*dbapi.ClusterBlueprint, *errors.ServiceError) {
+	if blueprint == nil {
+		blueprint = &dbapi.ClusterBlueprint{}
+	}
+
+	blueprint.CloudProvider = request.CloudProvider
+	blueprint.Region = request.Region
+	blueprint.ClusterCount = int(request.ClusterCount)
+	blueprint.SupportedInstanceType = request.SupportedInstanceType
+	blueprint.MultiAZ = request.MultiAz
+	blueprint.OpenShiftVersion = request.OpenshiftVersion
+	blueprint.ClusterWideMachineType = request.ClusterWideMachineType
+
+	machinePools := make([]types.MachinePoolSpec, 0, len(request.MachinePools))
+	for _, machinePool := range request.MachinePools {
+		machinePools = append(machinePools, types.MachinePoolSpec{
+			InstanceType:    machinePool.InstanceType,
+			MachineType:     machinePool.MachineType,
+			MinComputeNodes: int(machinePool.MinComputeNodes),
+			MaxComputeNodes: int(machinePool.MaxComputeNodes),
+		})
+	}
+	if err := blueprint.SetMachinePools(machinePools); err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to convert the machine pools of the cluster blueprint")
+	}
+
+	addonParameters := make([]types.AddonParameterSpec, 0, len(request.AddonParameters))
+	for _, parameter := range request.AddonParameters {
+		addonParameters = append(addonParameters, types.AddonParameterSpec{ID: parameter.Id, Value: parameter.Value})
+	}
+	if err := blueprint.SetAddonParameters(addonParameters); err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to convert the addon parameters of the cluster blueprint")
+	}
+
+	return blueprint, nil
+}
+
+// PresentClusterBlueprint presents a cluster blueprint along with its machine pools and addon parameters
+func PresentClusterBlueprint(blueprint *dbapi.ClusterBlueprint) (*private.ClusterBlueprint, *errors.ServiceError) {
+	reference := PresentReference(blueprint.ID, blueprint)
+
+	machinePools, err := blueprint.GetMachinePools()
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to present cluster blueprint %q", blueprint.ID)
+	}
+	addonParameters, err := blueprint.GetAddonParameters()
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to present cluster blueprint %q", blueprint.ID)
+	}
+
+	presentedMachinePools := make([]private.ClusterBlueprintMachinePool, 0, len(machinePools))
+	for _, machinePool := range machinePools {
+		presentedMachinePools = append(presentedMachinePools, private.ClusterBlueprintMachinePool{
+			InstanceType:    machinePool.InstanceType,
+			MachineType:     machinePool.MachineType,
+			MinComputeNodes: int32(machinePool.MinComputeNodes),
+			MaxComputeNodes: int32(machinePool.MaxComputeNodes),
+		})
+	}
+
+	presentedAddonParameters := make([]private.ClusterBlueprintAddonParameter, 0, len(addonParameters))
+	for _, parameter := range addonParameters {
+		presentedAddonParameters = append(presentedAddonParameters, private.ClusterBlueprintAddonParameter{
+			Id:    parameter.ID,
+			Value: parameter.Value,
+		})
+	}
+
+	return &private.ClusterBlueprint{
+		Id:                     reference.Id,
+		Kind:                   reference.Kind,
+		Href:                   reference.Href,
+		CloudProvider:          blueprint.CloudProvider,
+		Region:                 blueprint.Region,
+		ClusterCount:           int32(blueprint.ClusterCount),
+		SupportedInstanceType:  blueprint.SupportedInstanceType,
+		MultiAz:                blueprint.MultiAZ,
+		OpenshiftVersion:       blueprint.OpenShiftVersion,
+		ClusterWideMachineType: blueprint.ClusterWideMachineType,
+		MachinePools:           presentedMachinePools,
+		AddonParameters:        presentedAddonParameters,
+		CreatedAt:              blueprint.CreatedAt,
+		UpdatedAt:              blueprint.UpdatedAt,
+	}, nil
+}
+
+// PresentClusterBlueprintPlan presents the steps bringing the clusters of a blueprint in line with it
+func PresentClusterBlueprintPlan(plan *services.ClusterBlueprintPlan) private.ClusterBlueprintPlan {
+	steps := make([]private.ClusterBlueprintPlanStep, 0, len(plan.Steps))
+	for _, step := range plan.Steps {
+		presentedStep := private.ClusterBlueprintPlanStep{
+			Action:  step.Action.String(),
+			Reason:  step.Reason,
+			Blocked: step.Blocked,
+		}
+		if step.Cluster != nil {
+			presentedStep.ClusterId = step.Cluster.ClusterID
+			presentedStep.ClusterStatus = step.Cluster.Status.String()
+		}
+		steps = append(steps, presentedStep)
+	}
+
+	return private.ClusterBlueprintPlan{
+		Kind:                KindClusterBlueprintPlan,
+		BlueprintId:         plan.Blueprint.ID,
+		DesiredClusterCount: int32(plan.Blueprint.ClusterCount),
+		CurrentClusterCount: int32(plan.CurrentClusterCount),
+		Steps:               steps,
+	}
+}
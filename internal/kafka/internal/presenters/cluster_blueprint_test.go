@@ -0,0 +1,139 @@
+package presenters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/onsi/gomega"
+)
+
+func TestConvertClusterBlueprintRequest(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	request := private.ClusterBlueprintRequest{
+		CloudProvider:         "aws",
+		Region:                "us-east-1",
+		ClusterCount:          3,
+		SupportedInstanceType: "standard",
+		MultiAz:               true,
+		OpenshiftVersion:      "openshift-v4.11.22",
+		MachinePools: []private.ClusterBlueprintMachinePool{
+			{InstanceType: "standard", MachineType: "m5.2xlarge", MinComputeNodes: 3, MaxComputeNodes: 9},
+		},
+		AddonParameters: []private.ClusterBlueprintAddonParameter{
+			{Id: "strimzi-operator-version", Value: "strimzi-cluster-operator.v0.32.0-3"},
+		},
+	}
+	existing := &dbapi.ClusterBlueprint{Meta: api.Meta{ID: "blueprint-id"}, ClusterCount: 1}
+
+	blueprint, err := ConvertClusterBlueprintRequest(request, existing)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(blueprint).To(gomega.BeIdenticalTo(existing))
+	g.Expect(blueprint.ID).To(gomega.Equal("blueprint-id"))
+	g.Expect(blueprint.ClusterCount).To(gomega.Equal(3))
+	g.Expect(blueprint.MultiAZ).To(gomega.BeTrue())
+
+	spec, specErr := blueprint.BuildClusterProviderSpec()
+	g.Expect(specErr).ToNot(gomega.HaveOccurred())
+	g.Expect(spec.OpenShiftVersion).To(gomega.Equal("openshift-v4.11.22"))
+	g.Expect(spec.MachinePools).To(gomega.HaveLen(1))
+	g.Expect(spec.MachinePools[0].MaxComputeNodes).To(gomega.Equal(9))
+	g.Expect(spec.AddonParameters).To(gomega.HaveLen(1))
+	g.Expect(spec.AddonParameters[0].ID).To(gomega.Equal("strimzi-operator-version"))
+}
+
+func TestPresentClusterBlueprint(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		blueprint *dbapi.ClusterBlueprint
+		want      *private.ClusterBlueprint
+		wantErr   bool
+	}{
+		{
+			name: "should present the cluster blueprint with its machine pools and addon parameters",
+			blueprint: &dbapi.ClusterBlueprint{
+				Meta:                  api.Meta{ID: "blueprint-id", CreatedAt: now, UpdatedAt: now},
+				CloudProvider:         "aws",
+				Region:                "us-east-1",
+				ClusterCount:          2,
+				SupportedInstanceType: "standard,developer",
+				MachinePools:          api.JSON(`[{"instance_type":"standard","machine_type":"m5.2xlarge","min_compute_nodes":3,"max_compute_nodes":9}]`),
+				AddonParameters:       api.JSON(`[{"id":"kas-fleetshard-operator-version","value":"1.0.0"}]`),
+			},
+			want: &private.ClusterBlueprint{
+				Id:                    "blueprint-id",
+				Kind:                  KindClusterBlueprint,
+				Href:                  "/api/kafkas_mgmt/v1/admin/cluster_blueprints/blueprint-id",
+				CloudProvider:         "aws",
+				Region:                "us-east-1",
+				ClusterCount:          2,
+				SupportedInstanceType: "standard,developer",
+				MachinePools: []private.ClusterBlueprintMachinePool{
+					{InstanceType: "standard", MachineType: "m5.2xlarge", MinComputeNodes: 3, MaxComputeNodes: 9},
+				},
+				AddonParameters: []private.ClusterBlueprintAddonParameter{
+					{Id: "kas-fleetshard-operator-version", Value: "1.0.0"},
+				},
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+		},
+		{
+			name: "should return an error if the machine pools are invalid",
+			blueprint: &dbapi.ClusterBlueprint{
+				Meta:         api.Meta{ID: "blueprint-id"},
+				MachinePools: api.JSON(`{}`),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			got, err := PresentClusterBlueprint(tt.blueprint)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func TestPresentClusterBlueprintPlan(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	plan := &services.ClusterBlueprintPlan{
+		Blueprint:           &dbapi.ClusterBlueprint{Meta: api.Meta{ID: "blueprint-id"}, ClusterCount: 1},
+		CurrentClusterCount: 2,
+		Steps: []services.ClusterBlueprintPlanStep{
+			{
+				Action:  services.ClusterBlueprintPlanActionRetire,
+				Cluster: &api.Cluster{ClusterID: "cluster-id", Status: api.ClusterReady},
+				Reason:  "cluster hosts 2 streaming units",
+				Blocked: true,
+			},
+		},
+	}
+
+	g.Expect(PresentClusterBlueprintPlan(plan)).To(gomega.Equal(private.ClusterBlueprintPlan{
+		Kind:                KindClusterBlueprintPlan,
+		BlueprintId:         "blueprint-id",
+		DesiredClusterCount: 1,
+		CurrentClusterCount: 2,
+		Steps: []private.ClusterBlueprintPlanStep{
+			{
+				Action:        "retire",
+				ClusterId:     "cluster-id",
+				ClusterStatus: "ready",
+				Reason:        "cluster hosts 2 streaming units",
+				Blocked:       true,
+			},
+		},
+	}))
+}
@@ -0,0 +1,58 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+)
+
+// PresentClusterConsolidationPlan presents a consolidation plan of a data plane cluster along with its planned moves
+// and its retained Kafka instances
+func PresentClusterConsolidationPlan(plan *dbapi.ClusterConsolidationPlan) (*private.ClusterConsolidationPlan, *errors.ServiceError) {
+	reference := PresentReference(plan.ID, plan)
+
+	moves, err := plan.GetMoves()
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to present consolidation plan %q", plan.ID)
+	}
+
+	presentedMoves := make([]private.ClusterConsolidationMove, 0, len(moves))
+	for _, move := range moves {
+		presentedMoves = append(presentedMoves, private.ClusterConsolidationMove{
+			KafkaId:         move.KafkaID,
+			InstanceType:    move.InstanceType,
+			StreamingUnits:  int32(move.StreamingUnits),
+			TargetClusterId: move.TargetClusterID,
+		})
+	}
+
+	retained, err := plan.GetRetainedKafkas()
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to present consolidation plan %q", plan.ID)
+	}
+
+	presentedRetained := make([]private.ClusterConsolidationRetainedKafka, 0, len(retained))
+	for _, r := range retained {
+		presentedRetained = append(presentedRetained, private.ClusterConsolidationRetainedKafka{
+			KafkaId:        r.KafkaID,
+			InstanceType:   r.InstanceType,
+			StreamingUnits: int32(r.StreamingUnits),
+		})
+	}
+
+	return &private.ClusterConsolidationPlan{
+		Id:             reference.Id,
+		Kind:           reference.Kind,
+		Href:           reference.Href,
+		ClusterId:      plan.ClusterID,
+		CloudProvider:  plan.CloudProvider,
+		Region:         plan.Region,
+		Status:         plan.Status.String(),
+		Utilization:    int32(plan.Utilization),
+		Moves:          presentedMoves,
+		RetainedKafkas: presentedRetained,
+		ApprovedAt:     plan.ApprovedAt,
+		CreatedAt:      plan.CreatedAt,
+		UpdatedAt:      plan.UpdatedAt,
+	}, nil
+}
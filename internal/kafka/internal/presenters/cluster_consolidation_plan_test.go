@@ -0,0 +1,92 @@
+package presenters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/onsi/gomega"
+)
+
+func TestPresentClusterConsolidationPlan(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		plan    *dbapi.ClusterConsolidationPlan
+		want    *private.ClusterConsolidationPlan
+		wantErr bool
+	}{
+		{
+			name: "should present the consolidation plan, its moves and its retained kafka instances",
+			plan: &dbapi.ClusterConsolidationPlan{
+				Meta:           api.Meta{ID: "plan-id", CreatedAt: now, UpdatedAt: now},
+				ClusterID:      "cluster-id",
+				CloudProvider:  "aws",
+				Region:         "us-east-1",
+				Status:         dbapi.ClusterConsolidationPlanApproved,
+				Utilization:    20,
+				Moves:          api.JSON(`[{"kafka_id":"kafka-id","instance_type":"standard","streaming_units":1,"target_cluster_id":"target-id"}]`),
+				RetainedKafkas: api.JSON(`[{"kafka_id":"retained-id","instance_type":"standard","streaming_units":2}]`),
+				ApprovedAt:     &now,
+			},
+			want: &private.ClusterConsolidationPlan{
+				Id:            "plan-id",
+				Kind:          KindClusterConsolidationPlan,
+				Href:          "/api/kafkas_mgmt/v1/admin/cluster_consolidation_plans/plan-id",
+				ClusterId:     "cluster-id",
+				CloudProvider: "aws",
+				Region:        "us-east-1",
+				Status:        "approved",
+				Utilization:   20,
+				Moves: []private.ClusterConsolidationMove{
+					{
+						KafkaId:         "kafka-id",
+						InstanceType:    "standard",
+						StreamingUnits:  1,
+						TargetClusterId: "target-id",
+					},
+				},
+				RetainedKafkas: []private.ClusterConsolidationRetainedKafka{
+					{
+						KafkaId:        "retained-id",
+						InstanceType:   "standard",
+						StreamingUnits: 2,
+					},
+				},
+				ApprovedAt: &now,
+				CreatedAt:  now,
+				UpdatedAt:  now,
+			},
+		},
+		{
+			name: "should return an error if the moves are invalid",
+			plan: &dbapi.ClusterConsolidationPlan{
+				Meta:  api.Meta{ID: "plan-id"},
+				Moves: api.JSON(`{}`),
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error if the retained kafka instances are invalid",
+			plan: &dbapi.ClusterConsolidationPlan{
+				Meta:           api.Meta{ID: "plan-id"},
+				RetainedKafkas: api.JSON(`{}`),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := gomega.NewWithT(t)
+			got, err := PresentClusterConsolidationPlan(tt.plan)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
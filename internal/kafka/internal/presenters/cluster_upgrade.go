@@ -0,0 +1,46 @@
+package presenters
+
+import (
+	"fmt"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+)
+
+// PresentClusterUpgrade presents the latest OpenShift upgrade requested for the cluster.
+// The version information is only presented when given
+func PresentClusterUpgrade(cluster *api.Cluster, versionInfo *types.ClusterVersionInfo) private.ClusterUpgrade {
+	upgrade := private.ClusterUpgrade{
+		Kind:          KindClusterUpgrade,
+		ClusterId:     cluster.ClusterID,
+		Status:        cluster.UpgradeStatus.String(),
+		Version:       cluster.UpgradeVersion,
+		ScheduledAt:   cluster.UpgradeScheduledAt,
+		WaveId:        cluster.UpgradeWaveID,
+		StatusDetails: cluster.UpgradeStatusDetails,
+	}
+
+	if versionInfo != nil {
+		upgrade.CurrentVersion = versionInfo.Version
+		upgrade.AvailableUpgrades = versionInfo.AvailableUpgrades
+	}
+
+	return upgrade
+}
+
+// PresentClusterUpgradeWave presents the upgrades of the clusters of a wave
+func PresentClusterUpgradeWave(waveID string, clusters []*api.Cluster) private.ClusterUpgradeWave {
+	wave := private.ClusterUpgradeWave{
+		Id:       waveID,
+		Kind:     KindClusterUpgradeWave,
+		Href:     fmt.Sprintf("%s/admin/cluster_upgrade_waves/%s", BasePath, waveID),
+		Clusters: make([]private.ClusterUpgrade, 0, len(clusters)),
+	}
+
+	for _, cluster := range clusters {
+		wave.Clusters = append(wave.Clusters, PresentClusterUpgrade(cluster, nil))
+	}
+
+	return wave
+}
@@ -0,0 +1,80 @@
+package presenters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/onsi/gomega"
+)
+
+func TestPresentClusterUpgrade(t *testing.T) {
+	scheduledAt := time.Now()
+	cluster := &api.Cluster{
+		ClusterID:            "cluster-id",
+		UpgradeStatus:        api.ClusterUpgradePending,
+		UpgradeVersion:       "4.11.25",
+		UpgradeScheduledAt:   &scheduledAt,
+		UpgradeWaveID:        "wave-id",
+		UpgradeStatusDetails: "waiting for 1 kafka instances to be ready",
+	}
+
+	tests := []struct {
+		name        string
+		versionInfo *types.ClusterVersionInfo
+		want        private.ClusterUpgrade
+	}{
+		{
+			name:        "should present the upgrade of the cluster along with its version",
+			versionInfo: &types.ClusterVersionInfo{Version: "4.11.22", AvailableUpgrades: []string{"4.11.25"}},
+			want: private.ClusterUpgrade{
+				Kind:              KindClusterUpgrade,
+				ClusterId:         "cluster-id",
+				Status:            "pending",
+				Version:           "4.11.25",
+				ScheduledAt:       &scheduledAt,
+				WaveId:            "wave-id",
+				StatusDetails:     "waiting for 1 kafka instances to be ready",
+				CurrentVersion:    "4.11.22",
+				AvailableUpgrades: []string{"4.11.25"},
+			},
+		},
+		{
+			name: "should present the upgrade of the cluster without version information",
+			want: private.ClusterUpgrade{
+				Kind:          KindClusterUpgrade,
+				ClusterId:     "cluster-id",
+				Status:        "pending",
+				Version:       "4.11.25",
+				ScheduledAt:   &scheduledAt,
+				WaveId:        "wave-id",
+				StatusDetails: "waiting for 1 kafka instances to be ready",
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			g.Expect(PresentClusterUpgrade(cluster, tt.versionInfo)).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func TestPresentClusterUpgradeWave(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	wave := PresentClusterUpgradeWave("wave-id", []*api.Cluster{
+		{ClusterID: "cluster-1", UpgradeStatus: api.ClusterUpgradeCompleted, UpgradeWaveID: "wave-id"},
+		{ClusterID: "cluster-2", UpgradeStatus: api.ClusterUpgradePending, UpgradeWaveID: "wave-id"},
+	})
+	g.Expect(wave.Id).To(gomega.Equal("wave-id"))
+	g.Expect(wave.Kind).To(gomega.Equal(KindClusterUpgradeWave))
+	g.Expect(wave.Href).To(gomega.Equal("/api/kafkas_mgmt/v1/admin/cluster_upgrade_waves/wave-id"))
+	g.Expect(wave.Clusters).To(gomega.HaveLen(2))
+	g.Expect(wave.Clusters[0].Status).To(gomega.Equal("completed"))
+	g.Expect(wave.Clusters[1].ClusterId).To(gomega.Equal("cluster-2"))
+}
@@ -35,6 +35,7 @@ func ConvertDataPlaneKafkaStatus(status map[string]private.DataPlaneKafkaStatus)
 			StrimziVersion:  v.Versions.Strimzi,
 			KafkaIBPVersion: v.Versions.KafkaIbp,
 			AdminServerURI:  v.AdminServerURI,
+			KafkaConfig:     v.KafkaConfig,
 		})
 	}
 
@@ -38,6 +38,13 @@ func ConvertKafkaRequest(kafkaRequestPayload public.KafkaRequestPayload, dbKafka
 		kafka.ReauthenticationEnabled = true // true by default
 	}
 
+	if kafkaRequestPayload.PrivateEndpoint != nil {
+		kafka.PrivateConnectivity = true
+		kafka.PrivateEndpointStatus = dbapi.PrivateEndpointStatusPending
+		// the allowed account ids are validated before the conversion, they can always be marshalled
+		_ = kafka.SetPrivateEndpointAllowedAccounts(kafkaRequestPayload.PrivateEndpoint.AllowedAccountIds)
+	}
+
 	// enterprise kafkas should be assigned to specified cluster, if its ID is provided
 	if !shared.StringEmpty(kafkaRequestPayload.ClusterId) {
 		kafka.ClusterID = *kafkaRequestPayload.ClusterId
@@ -87,6 +94,21 @@ func PresentKafkaRequest(kafkaRequest *dbapi.KafkaRequest, kafkaConfig *config.K
 		return public.KafkaRequest{}, errors.NewWithCause(errors.ErrorGeneral, conversionErr, "failed to get bytes value for max_data_retention_size")
 	}
 
+	desiredKafkaConfig, kafkaConfigErr := kafkaRequest.GetDesiredKafkaConfig()
+	if kafkaConfigErr != nil {
+		return public.KafkaRequest{}, errors.NewWithCause(errors.ErrorGeneral, kafkaConfigErr, "failed to get kafka_config")
+	}
+
+	actualKafkaConfig, kafkaConfigErr := kafkaRequest.GetActualKafkaConfig()
+	if kafkaConfigErr != nil {
+		return public.KafkaRequest{}, errors.NewWithCause(errors.ErrorGeneral, kafkaConfigErr, "failed to get applied_kafka_config")
+	}
+
+	privateEndpoint, privateEndpointErr := PresentKafkaPrivateEndpoint(kafkaRequest)
+	if privateEndpointErr != nil {
+		return public.KafkaRequest{}, privateEndpointErr
+	}
+
 	return public.KafkaRequest{
 		Id:                      reference.Id,
 		Kind:                    reference.Kind,
@@ -124,6 +146,29 @@ func PresentKafkaRequest(kafkaRequest *dbapi.KafkaRequest, kafkaConfig *config.K
 		PromotionStatus:                       kafkaRequest.PromotionStatus.String(),
 		PromotionDetails:                      kafkaRequest.PromotionDetails,
 		ClusterId:                             getClusterID(kafkaRequest),
+		KafkaConfig:                           desiredKafkaConfig,
+		AppliedKafkaConfig:                    actualKafkaConfig,
+		PrivateEndpoint:                       privateEndpoint,
+	}, nil
+}
+
+// PresentKafkaPrivateEndpoint returns the private endpoint of the Kafka instance, or nil if it has none
+func PresentKafkaPrivateEndpoint(kafkaRequest *dbapi.KafkaRequest) (*public.KafkaPrivateEndpoint, *errors.ServiceError) {
+	if !kafkaRequest.PrivateConnectivity {
+		return nil, nil
+	}
+
+	allowedAccountIDs, err := kafkaRequest.GetPrivateEndpointAllowedAccounts()
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to get private_endpoint allowed_account_ids")
+	}
+
+	return &public.KafkaPrivateEndpoint{
+		Kind:              "KafkaPrivateEndpoint",
+		Status:            kafkaRequest.PrivateEndpointStatus.String(),
+		ServiceName:       kafkaRequest.PrivateEndpointServiceName,
+		AllowedAccountIds: allowedAccountIDs,
+		StatusDetails:     kafkaRequest.PrivateEndpointStatusDetails,
 	}, nil
 }
 
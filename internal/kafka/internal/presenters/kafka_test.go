@@ -116,6 +116,22 @@ func TestConvertKafkaRequest(t *testing.T) {
 				mocks.With(mocks.DESIRED_KAFKA_BILLING_MODEL, "mybillingmodel"),
 			),
 		},
+		{
+			name: "should convert the private endpoint if provided",
+			args: args{
+				kafkaRequestPayload: *mocks.BuildKafkaRequestPayload(func(payload *public.KafkaRequestPayload) {
+					payload.PrivateEndpoint = &public.KafkaPrivateEndpointPayload{AllowedAccountIds: []string{"111111111111"}}
+				}),
+				dbKafkaRequests: []*dbapi.KafkaRequest{},
+			},
+			want: mocks.BuildKafkaRequest(
+				mocks.With(mocks.REGION, mocks.DefaultKafkaRequestRegion),
+				mocks.With(mocks.CLOUD_PROVIDER, mocks.DefaultKafkaRequestProvider),
+				mocks.With(mocks.NAME, mocks.DefaultKafkaRequestName),
+				mocks.WithReauthenticationEnabled(reauthEnabled),
+				mocks.WithPrivateEndpoint(dbapi.PrivateEndpointStatusPending, "111111111111"),
+			),
+		},
 	}
 
 	for _, testcase := range tests {
@@ -280,6 +296,46 @@ func TestPresentKafkaRequest(t *testing.T) {
 	}
 }
 
+func TestPresentKafkaPrivateEndpoint(t *testing.T) {
+	tests := []struct {
+		name  string
+		kafka *dbapi.KafkaRequest
+		want  *public.KafkaPrivateEndpoint
+	}{
+		{
+			name: "should present the private endpoint of the kafka",
+			kafka: mocks.BuildKafkaRequest(
+				mocks.WithPrivateEndpoint(dbapi.PrivateEndpointStatusReady, "111111111111", "222222222222"),
+				func(kafka *dbapi.KafkaRequest) {
+					kafka.PrivateEndpointServiceID = "vpce-svc-1"
+					kafka.PrivateEndpointServiceName = "com.amazonaws.vpce.us-east-1.vpce-svc-1"
+				},
+			),
+			want: &public.KafkaPrivateEndpoint{
+				Kind:              "KafkaPrivateEndpoint",
+				Status:            dbapi.PrivateEndpointStatusReady.String(),
+				ServiceName:       "com.amazonaws.vpce.us-east-1.vpce-svc-1",
+				AllowedAccountIds: []string{"111111111111", "222222222222"},
+			},
+		},
+		{
+			name:  "should not present a private endpoint for a kafka without private connectivity",
+			kafka: mocks.BuildKafkaRequest(mocks.WithPredefinedTestValues()),
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			got, err := PresentKafkaPrivateEndpoint(tt.kafka)
+			g.Expect(err).To(gomega.BeNil())
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
 func TestSetBootstrapServerHost(t *testing.T) {
 	type args struct {
 		bootstrapServerHost string
@@ -47,12 +47,14 @@ func PresentManagedKafka(from *v1.ManagedKafka) private.ManagedKafka {
 			Endpoint: private.ManagedKafkaAllOfSpecEndpoint{
 				Tls:                 getOpenAPIManagedKafkaEndpointTLS(from.Spec.Endpoint.Tls),
 				BootstrapServerHost: from.Spec.Endpoint.BootstrapServerHost,
+				PrivateConnectivity: from.Spec.Endpoint.PrivateConnectivity,
 			},
 			Versions: private.ManagedKafkaVersions{
 				Kafka:    from.Spec.Versions.Kafka,
 				KafkaIbp: from.Spec.Versions.KafkaIBP,
 				Strimzi:  from.Spec.Versions.Strimzi,
 			},
+			KafkaConfig:     from.Spec.KafkaConfig,
 			Deleted:         from.Spec.Deleted,
 			Owners:          from.Spec.Owners,
 			ServiceAccounts: getServiceAccounts(from.Spec.ServiceAccounts),
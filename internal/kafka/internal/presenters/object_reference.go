@@ -29,6 +29,27 @@ const (
 	// type public.EnterpriseClusterAddonParameters
 	KindClusterAddonParameters = "ClusterAddonParameters"
 
+	// KindWorker is a string identifier for the type api.LeaderLease
+	KindWorker = "Worker"
+
+	// KindClusterConsolidationPlan is a string identifier for the type dbapi.ClusterConsolidationPlan
+	KindClusterConsolidationPlan = "ClusterConsolidationPlan"
+
+	// KindCapacityReport is a string identifier for the type services.CapacityReport
+	KindCapacityReport = "CapacityReport"
+
+	// KindClusterBlueprint is a string identifier for the type dbapi.ClusterBlueprint
+	KindClusterBlueprint = "ClusterBlueprint"
+
+	// KindClusterBlueprintPlan is a string identifier for the type services.ClusterBlueprintPlan
+	KindClusterBlueprintPlan = "ClusterBlueprintPlan"
+
+	// KindClusterUpgrade is a string identifier for the OpenShift upgrade of an api.Cluster
+	KindClusterUpgrade = "ClusterUpgrade"
+
+	// KindClusterUpgradeWave is a string identifier for the clusters upgraded together in a wave
+	KindClusterUpgradeWave = "ClusterUpgradeWave"
+
 	BasePath = "/api/kafkas_mgmt/v1"
 )
 
@@ -52,6 +73,12 @@ func objectKind(i interface{}) string {
 		return KindCluster
 	case public.EnterpriseClusterAddonParameters, *public.EnterpriseClusterAddonParameters:
 		return KindClusterAddonParameters
+	case api.LeaderLease, *api.LeaderLease:
+		return KindWorker
+	case dbapi.ClusterConsolidationPlan, *dbapi.ClusterConsolidationPlan:
+		return KindClusterConsolidationPlan
+	case dbapi.ClusterBlueprint, *dbapi.ClusterBlueprint:
+		return KindClusterBlueprint
 	default:
 		return ""
 	}
@@ -69,6 +96,12 @@ func objectPath(id string, obj interface{}) string {
 		return fmt.Sprintf("%s/service_accounts/%s", BasePath, id)
 	case public.EnterpriseClusterAddonParameters, *public.EnterpriseClusterAddonParameters:
 		return fmt.Sprintf("%s/clusters/%s/addon_parameters", BasePath, id)
+	case api.LeaderLease, *api.LeaderLease:
+		return fmt.Sprintf("%s/admin/workers/%s", BasePath, id)
+	case dbapi.ClusterConsolidationPlan, *dbapi.ClusterConsolidationPlan:
+		return fmt.Sprintf("%s/admin/cluster_consolidation_plans/%s", BasePath, id)
+	case dbapi.ClusterBlueprint, *dbapi.ClusterBlueprint:
+		return fmt.Sprintf("%s/admin/cluster_blueprints/%s", BasePath, id)
 	default:
 		return ""
 	}
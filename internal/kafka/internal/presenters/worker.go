@@ -0,0 +1,48 @@
+package presenters
+
+import (
+	"encoding/json"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+)
+
+// PresentWorker presents the status of a worker type persisted in its leader lease. Worker types are identified by their type
+func PresentWorker(lease *api.LeaderLease) (*private.Worker, *errors.ServiceError) {
+	reference := PresentReference(lease.LeaseType, lease)
+
+	var lastReconcileErrors []string
+	if len(lease.LastReconcileErrors) > 0 {
+		if err := json.Unmarshal(lease.LastReconcileErrors, &lastReconcileErrors); err != nil {
+			return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to present worker type '%s'", lease.LeaseType)
+		}
+	}
+
+	return &private.Worker{
+		Id:                  reference.Id,
+		Kind:                reference.Kind,
+		Href:                reference.Href,
+		WorkerType:          lease.LeaseType,
+		Leader:              lease.Leader,
+		LeaseExpiresAt:      lease.Expires,
+		Paused:              lease.Paused,
+		LastReconcileAt:     lease.LastReconcileAt,
+		LastReconcileErrors: lastReconcileErrors,
+		NextReconcileAt:     lease.NextReconcileAt,
+	}, nil
+}
+
+// PresentQuarantinedWorkItem presents a work item quarantined by a worker type
+func PresentQuarantinedWorkItem(failure *api.WorkItemFailure) private.QuarantinedWorkItem {
+	item := private.QuarantinedWorkItem{
+		WorkerType: failure.WorkerType,
+		ResourceId: failure.ResourceID,
+		Attempts:   int32(failure.Attempts),
+		LastError:  failure.LastError,
+	}
+	if failure.QuarantinedAt != nil {
+		item.QuarantinedAt = *failure.QuarantinedAt
+	}
+	return item
+}
@@ -0,0 +1,114 @@
+package presenters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/onsi/gomega"
+)
+
+func TestPresentWorker(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		lease   *api.LeaderLease
+		want    *private.Worker
+		wantErr bool
+	}{
+		{
+			name: "should present the status of the worker type",
+			lease: &api.LeaderLease{
+				Leader:              "leader-id",
+				LeaseType:           "kafka",
+				Expires:             &now,
+				Paused:              true,
+				LastReconcileAt:     &now,
+				LastReconcileErrors: api.JSON(`["some error"]`),
+				NextReconcileAt:     &now,
+			},
+			want: &private.Worker{
+				Id:                  "kafka",
+				Kind:                KindWorker,
+				Href:                "/api/kafkas_mgmt/v1/admin/workers/kafka",
+				WorkerType:          "kafka",
+				Leader:              "leader-id",
+				LeaseExpiresAt:      &now,
+				Paused:              true,
+				LastReconcileAt:     &now,
+				LastReconcileErrors: []string{"some error"},
+				NextReconcileAt:     &now,
+			},
+		},
+		{
+			name: "should present a worker type which never reconciled",
+			lease: &api.LeaderLease{
+				LeaseType: "kafka",
+			},
+			want: &private.Worker{
+				Id:         "kafka",
+				Kind:       KindWorker,
+				Href:       "/api/kafkas_mgmt/v1/admin/workers/kafka",
+				WorkerType: "kafka",
+			},
+		},
+		{
+			name: "should return an error if the last reconcile errors are invalid",
+			lease: &api.LeaderLease{
+				LeaseType:           "kafka",
+				LastReconcileErrors: api.JSON(`{}`),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := gomega.NewWithT(t)
+			got, err := PresentWorker(tt.lease)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func TestPresentQuarantinedWorkItem(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		failure *api.WorkItemFailure
+		want    private.QuarantinedWorkItem
+	}{
+		{
+			name: "should present the quarantined work item",
+			failure: &api.WorkItemFailure{
+				WorkerType:    "accepted_kafka",
+				ResourceID:    "kafka-id",
+				Attempts:      10,
+				LastError:     "some error",
+				QuarantinedAt: &now,
+			},
+			want: private.QuarantinedWorkItem{
+				WorkerType:    "accepted_kafka",
+				ResourceId:    "kafka-id",
+				Attempts:      10,
+				LastError:     "some error",
+				QuarantinedAt: now,
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			t.Parallel()
+			g.Expect(PresentQuarantinedWorkItem(tt.failure)).To(gomega.Equal(tt.want))
+		})
+	}
+}
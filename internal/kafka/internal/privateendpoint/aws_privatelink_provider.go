@@ -0,0 +1,157 @@
+package privateendpoint
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/aws"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
+	"github.com/pkg/errors"
+)
+
+const (
+	// KafkaIDTagKey is the tag identifying the Kafka instance of the network load balancers provisioned by the data plane
+	// and of the endpoint services created in front of them
+	KafkaIDTagKey = "bf2.org/kafkaId"
+
+	awsLoadBalancerResourceType = "elasticloadbalancing:loadbalancer"
+	awsNetworkLoadBalancerMark  = ":loadbalancer/net/"
+)
+
+var _ Provider = &awsPrivateLinkProvider{}
+
+// awsPrivateLinkProvider exposes the Kafka instances through AWS PrivateLink VPC endpoint services.
+// The endpoint services are created in the AWS account hosting the data plane clusters, in front of the network load
+// balancer provisioned by the data plane for each Kafka instance requesting private connectivity
+type awsPrivateLinkProvider struct {
+	awsClientFactory aws.ClientFactory
+	credentials      aws.Config
+}
+
+func newAWSPrivateLinkProvider(awsClientFactory aws.ClientFactory, credentials aws.Config) *awsPrivateLinkProvider {
+	return &awsPrivateLinkProvider{
+		awsClientFactory: awsClientFactory,
+		credentials:      credentials,
+	}
+}
+
+func (p *awsPrivateLinkProvider) CreateEndpointService(ctx context.Context, region string, kafkaID string) (*EndpointService, error) {
+	client, err := p.awsClientFactory.NewClient(p.credentials, region)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create aws client")
+	}
+
+	arns, err := client.ListResourceARNsByTags([]string{awsLoadBalancerResourceType}, map[string]string{KafkaIDTagKey: kafkaID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to find the load balancer of kafka %q", kafkaID)
+	}
+	loadBalancerARNs := arrays.Filter(arns, func(arn string) bool {
+		return strings.Contains(arn, awsNetworkLoadBalancerMark)
+	})
+	if len(loadBalancerARNs) == 0 {
+		return nil, nil
+	}
+	sort.Strings(loadBalancerARNs)
+
+	// the kafka id is used as client token so that retrying a creation whose response was lost does not create
+	// a second endpoint service
+	serviceConfiguration, err := client.CreateVpcEndpointServiceConfiguration(kafkaID, loadBalancerARNs, map[string]string{KafkaIDTagKey: kafkaID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to create the VPC endpoint service of kafka %q", kafkaID)
+	}
+
+	return toEndpointService(serviceConfiguration), nil
+}
+
+func (p *awsPrivateLinkProvider) GetEndpointService(ctx context.Context, region string, serviceID string) (*EndpointService, error) {
+	client, err := p.awsClientFactory.NewClient(p.credentials, region)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create aws client")
+	}
+
+	serviceConfiguration, err := client.GetVpcEndpointServiceConfiguration(serviceID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to get VPC endpoint service %q", serviceID)
+	}
+	if serviceConfiguration == nil {
+		return nil, nil
+	}
+
+	return toEndpointService(serviceConfiguration), nil
+}
+
+func (p *awsPrivateLinkProvider) SetAllowedAccounts(ctx context.Context, region string, serviceID string, accountIDs []string) error {
+	client, err := p.awsClientFactory.NewClient(p.credentials, region)
+	if err != nil {
+		return errors.Wrap(err, "unable to create aws client")
+	}
+
+	currentPrincipals, err := client.GetVpcEndpointServiceAllowedPrincipals(serviceID)
+	if err != nil {
+		return errors.Wrapf(err, "unable to get the allowed principals of VPC endpoint service %q", serviceID)
+	}
+
+	desiredPrincipals := make([]string, 0, len(accountIDs))
+	for _, accountID := range accountIDs {
+		desiredPrincipals = append(desiredPrincipals, awsAccountPrincipal(accountID))
+	}
+
+	var principalsToAdd, principalsToRemove []string
+	for _, principal := range desiredPrincipals {
+		if !arrays.Contains(currentPrincipals, principal) {
+			principalsToAdd = append(principalsToAdd, principal)
+		}
+	}
+	for _, principal := range currentPrincipals {
+		if !arrays.Contains(desiredPrincipals, principal) {
+			principalsToRemove = append(principalsToRemove, principal)
+		}
+	}
+
+	if err := client.ModifyVpcEndpointServiceAllowedPrincipals(serviceID, principalsToAdd, principalsToRemove); err != nil {
+		return errors.Wrapf(err, "unable to set the allowed principals of VPC endpoint service %q", serviceID)
+	}
+	return nil
+}
+
+func (p *awsPrivateLinkProvider) DeleteEndpointService(ctx context.Context, region string, serviceID string) error {
+	client, err := p.awsClientFactory.NewClient(p.credentials, region)
+	if err != nil {
+		return errors.Wrap(err, "unable to create aws client")
+	}
+
+	if err := client.DeleteVpcEndpointServiceConfiguration(serviceID); err != nil {
+		return errors.Wrapf(err, "unable to delete VPC endpoint service %q", serviceID)
+	}
+	return nil
+}
+
+// awsAccountPrincipal returns the principal granting access to all the IAM users and roles of the account
+func awsAccountPrincipal(accountID string) string {
+	return fmt.Sprintf("arn:aws:iam::%s:root", accountID)
+}
+
+func toEndpointService(serviceConfiguration *ec2.ServiceConfiguration) *EndpointService {
+	endpointService := &EndpointService{
+		ID:   awssdk.StringValue(serviceConfiguration.ServiceId),
+		Name: awssdk.StringValue(serviceConfiguration.ServiceName),
+	}
+
+	switch state := awssdk.StringValue(serviceConfiguration.ServiceState); state {
+	case ec2.ServiceStateAvailable:
+		endpointService.Status = EndpointServiceStatusAvailable
+	case ec2.ServiceStateFailed:
+		endpointService.Status = EndpointServiceStatusFailed
+		endpointService.StatusDetails = "the VPC endpoint service could not be created"
+	case ec2.ServiceStateDeleting, ec2.ServiceStateDeleted:
+		endpointService.Status = EndpointServiceStatusDeleting
+	default:
+		endpointService.Status = EndpointServiceStatusPending
+	}
+
+	return endpointService
+}
@@ -0,0 +1,229 @@
+package privateendpoint
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/aws"
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+)
+
+const (
+	testRegion    = "us-east-1"
+	testKafkaID   = "kafka-id"
+	testServiceID = "vpce-svc-1"
+)
+
+func Test_awsPrivateLinkProvider_CreateEndpointService(t *testing.T) {
+	networkLoadBalancerARN := "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/net/kafka/1"
+
+	tests := []struct {
+		name    string
+		client  *aws.AWSClientMock
+		want    *EndpointService
+		wantErr bool
+	}{
+		{
+			name: "should create the endpoint service in front of the network load balancer of the kafka",
+			client: &aws.AWSClientMock{
+				ListResourceARNsByTagsFunc: func(resourceTypes []string, tags map[string]string) ([]string, error) {
+					if tags[KafkaIDTagKey] != testKafkaID {
+						return nil, errors.Errorf("unexpected tags %v", tags)
+					}
+					return []string{
+						"arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/console/1",
+						networkLoadBalancerARN,
+					}, nil
+				},
+				CreateVpcEndpointServiceConfigurationFunc: func(clientToken string, networkLoadBalancerArns []string, tags map[string]string) (*ec2.ServiceConfiguration, error) {
+					if clientToken != testKafkaID || len(networkLoadBalancerArns) != 1 || networkLoadBalancerArns[0] != networkLoadBalancerARN {
+						return nil, errors.Errorf("unexpected load balancers %v", networkLoadBalancerArns)
+					}
+					return &ec2.ServiceConfiguration{
+						ServiceId:    awssdk.String(testServiceID),
+						ServiceName:  awssdk.String("com.amazonaws.vpce.us-east-1.vpce-svc-1"),
+						ServiceState: awssdk.String(ec2.ServiceStatePending),
+					}, nil
+				},
+			},
+			want: &EndpointService{ID: testServiceID, Name: "com.amazonaws.vpce.us-east-1.vpce-svc-1", Status: EndpointServiceStatusPending},
+		},
+		{
+			name: "should not create the endpoint service when the network load balancer is not provisioned yet",
+			client: &aws.AWSClientMock{
+				ListResourceARNsByTagsFunc: func(resourceTypes []string, tags map[string]string) ([]string, error) {
+					return nil, nil
+				},
+			},
+		},
+		{
+			name: "should return an error when the load balancers cannot be listed",
+			client: &aws.AWSClientMock{
+				ListResourceARNsByTagsFunc: func(resourceTypes []string, tags map[string]string) ([]string, error) {
+					return nil, errors.New("AccessDenied")
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when the endpoint service cannot be created",
+			client: &aws.AWSClientMock{
+				ListResourceARNsByTagsFunc: func(resourceTypes []string, tags map[string]string) ([]string, error) {
+					return []string{networkLoadBalancerARN}, nil
+				},
+				CreateVpcEndpointServiceConfigurationFunc: func(clientToken string, networkLoadBalancerArns []string, tags map[string]string) (*ec2.ServiceConfiguration, error) {
+					return nil, errors.New("ServiceQuotaExceeded")
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			provider := newAWSPrivateLinkProvider(aws.NewMockClientFactory(tt.client), aws.Config{})
+			got, err := provider.CreateEndpointService(context.Background(), testRegion, testKafkaID)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func Test_awsPrivateLinkProvider_GetEndpointService(t *testing.T) {
+	tests := []struct {
+		name    string
+		client  *aws.AWSClientMock
+		want    *EndpointService
+		wantErr bool
+	}{
+		{
+			name: "should return an available endpoint service",
+			client: &aws.AWSClientMock{
+				GetVpcEndpointServiceConfigurationFunc: func(serviceId string) (*ec2.ServiceConfiguration, error) {
+					return &ec2.ServiceConfiguration{
+						ServiceId:    awssdk.String(serviceId),
+						ServiceName:  awssdk.String("service-name"),
+						ServiceState: awssdk.String(ec2.ServiceStateAvailable),
+					}, nil
+				},
+			},
+			want: &EndpointService{ID: testServiceID, Name: "service-name", Status: EndpointServiceStatusAvailable},
+		},
+		{
+			name: "should return a failed endpoint service",
+			client: &aws.AWSClientMock{
+				GetVpcEndpointServiceConfigurationFunc: func(serviceId string) (*ec2.ServiceConfiguration, error) {
+					return &ec2.ServiceConfiguration{
+						ServiceId:    awssdk.String(serviceId),
+						ServiceName:  awssdk.String("service-name"),
+						ServiceState: awssdk.String(ec2.ServiceStateFailed),
+					}, nil
+				},
+			},
+			want: &EndpointService{
+				ID:            testServiceID,
+				Name:          "service-name",
+				Status:        EndpointServiceStatusFailed,
+				StatusDetails: "the VPC endpoint service could not be created",
+			},
+		},
+		{
+			name: "should return nil when the endpoint service does not exist",
+			client: &aws.AWSClientMock{
+				GetVpcEndpointServiceConfigurationFunc: func(serviceId string) (*ec2.ServiceConfiguration, error) {
+					return nil, nil
+				},
+			},
+		},
+		{
+			name: "should return an error when the endpoint service cannot be retrieved",
+			client: &aws.AWSClientMock{
+				GetVpcEndpointServiceConfigurationFunc: func(serviceId string) (*ec2.ServiceConfiguration, error) {
+					return nil, errors.New("AccessDenied")
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			provider := newAWSPrivateLinkProvider(aws.NewMockClientFactory(tt.client), aws.Config{})
+			got, err := provider.GetEndpointService(context.Background(), testRegion, testServiceID)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func Test_awsPrivateLinkProvider_SetAllowedAccounts(t *testing.T) {
+	tests := []struct {
+		name              string
+		currentPrincipals []string
+		accountIDs        []string
+		wantAdded         []string
+		wantRemoved       []string
+	}{
+		{
+			name:       "should allow the accounts of a new endpoint service",
+			accountIDs: []string{"111111111111", "222222222222"},
+			wantAdded:  []string{"arn:aws:iam::111111111111:root", "arn:aws:iam::222222222222:root"},
+		},
+		{
+			name:              "should only add and remove the accounts that changed",
+			currentPrincipals: []string{"arn:aws:iam::111111111111:root", "arn:aws:iam::222222222222:root"},
+			accountIDs:        []string{"222222222222", "333333333333"},
+			wantAdded:         []string{"arn:aws:iam::333333333333:root"},
+			wantRemoved:       []string{"arn:aws:iam::111111111111:root"},
+		},
+		{
+			name:              "should remove all the accounts",
+			currentPrincipals: []string{"arn:aws:iam::111111111111:root"},
+			wantRemoved:       []string{"arn:aws:iam::111111111111:root"},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			client := &aws.AWSClientMock{
+				GetVpcEndpointServiceAllowedPrincipalsFunc: func(serviceId string) ([]string, error) {
+					return tt.currentPrincipals, nil
+				},
+				ModifyVpcEndpointServiceAllowedPrincipalsFunc: func(serviceId string, principalsToAdd []string, principalsToRemove []string) error {
+					return nil
+				},
+			}
+			provider := newAWSPrivateLinkProvider(aws.NewMockClientFactory(client), aws.Config{})
+			err := provider.SetAllowedAccounts(context.Background(), testRegion, testServiceID, tt.accountIDs)
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			g.Expect(client.ModifyVpcEndpointServiceAllowedPrincipalsCalls()).To(gomega.HaveLen(1))
+			g.Expect(client.ModifyVpcEndpointServiceAllowedPrincipalsCalls()[0].PrincipalsToAdd).To(gomega.Equal(tt.wantAdded))
+			g.Expect(client.ModifyVpcEndpointServiceAllowedPrincipalsCalls()[0].PrincipalsToRemove).To(gomega.Equal(tt.wantRemoved))
+		})
+	}
+}
+
+func Test_awsPrivateLinkProvider_DeleteEndpointService(t *testing.T) {
+	g := gomega.NewWithT(t)
+	client := &aws.AWSClientMock{
+		DeleteVpcEndpointServiceConfigurationFunc: func(serviceId string) error {
+			if serviceId != testServiceID {
+				return errors.New("unexpected service")
+			}
+			return nil
+		},
+	}
+	provider := newAWSPrivateLinkProvider(aws.NewMockClientFactory(client), aws.Config{})
+
+	g.Expect(provider.DeleteEndpointService(context.Background(), testRegion, testServiceID)).To(gomega.Succeed())
+	g.Expect(provider.DeleteEndpointService(context.Background(), testRegion, "other")).ToNot(gomega.Succeed())
+}
@@ -0,0 +1,47 @@
+package privateendpoint
+
+import (
+	"context"
+)
+
+type EndpointServiceStatus string
+
+const (
+	// EndpointServiceStatusPending is the status of an endpoint service being created by the cloud provider
+	EndpointServiceStatusPending EndpointServiceStatus = "pending"
+	// EndpointServiceStatusAvailable is the status of an endpoint service accepting connections from the allowed accounts
+	EndpointServiceStatusAvailable EndpointServiceStatus = "available"
+	// EndpointServiceStatusFailed is the status of an endpoint service that the cloud provider failed to create
+	EndpointServiceStatusFailed EndpointServiceStatus = "failed"
+	// EndpointServiceStatusDeleting is the status of an endpoint service being deleted
+	EndpointServiceStatusDeleting EndpointServiceStatus = "deleting"
+)
+
+// EndpointService exposes the brokers of a Kafka instance to the private networks of the allowed consumer accounts
+type EndpointService struct {
+	// ID identifies the endpoint service in its cloud provider
+	ID string
+	// Name is used by the consumers to create their private endpoints connected to the service
+	Name          string
+	Status        EndpointServiceStatus
+	StatusDetails string
+}
+
+func (s *EndpointService) IsAvailable() bool {
+	return s.Status == EndpointServiceStatusAvailable
+}
+
+//go:generate moq -out provider_moq.go . Provider
+type Provider interface {
+	// CreateEndpointService creates the endpoint service of the Kafka instance in the given region. Creating the endpoint
+	// service of a Kafka instance again returns the existing one.
+	// It returns nil when the load balancer of the Kafka instance is not provisioned yet
+	CreateEndpointService(ctx context.Context, region string, kafkaID string) (*EndpointService, error)
+	// GetEndpointService returns the endpoint service with the given id, or nil if it does not exist
+	GetEndpointService(ctx context.Context, region string, serviceID string) (*EndpointService, error)
+	// SetAllowedAccounts replaces the consumer accounts allowed to connect to the endpoint service
+	SetAllowedAccounts(ctx context.Context, region string, serviceID string, accountIDs []string) error
+	// DeleteEndpointService deletes the endpoint service, closing the connections of its consumers.
+	// Deleting an endpoint service that does not exist is not an error
+	DeleteEndpointService(ctx context.Context, region string, serviceID string) error
+}
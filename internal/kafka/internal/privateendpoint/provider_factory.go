@@ -0,0 +1,48 @@
+package privateendpoint
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/cloudproviders"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/aws"
+	"github.com/pkg/errors"
+)
+
+//go:generate moq -out provider_factory_moq.go . ProviderFactory
+type ProviderFactory interface {
+	// GetProvider returns the private endpoint provider of the Kafka instances of the given cloud provider
+	GetProvider(cloudProvider string) (Provider, error)
+	// SupportsCloudProvider returns whether the Kafka instances of the given cloud provider can be reached privately
+	SupportsCloudProvider(cloudProvider string) bool
+}
+
+var _ ProviderFactory = &DefaultProviderFactory{}
+
+// DefaultProviderFactory provides AWS PrivateLink for the Kafka instances on AWS. Private connectivity on the other
+// cloud providers is not supported yet
+type DefaultProviderFactory struct {
+	providerContainer map[cloudproviders.CloudProviderID]Provider
+}
+
+func NewDefaultProviderFactory(awsConfig *config.AWSConfig, awsClientFactory aws.ClientFactory) *DefaultProviderFactory {
+	return &DefaultProviderFactory{
+		providerContainer: map[cloudproviders.CloudProviderID]Provider{
+			cloudproviders.AWS: newAWSPrivateLinkProvider(awsClientFactory, aws.Config{
+				AccessKeyID:     awsConfig.ConfigForOSDClusterCreation.AccessKey,
+				SecretAccessKey: awsConfig.ConfigForOSDClusterCreation.SecretAccessKey,
+			}),
+		},
+	}
+}
+
+func (d *DefaultProviderFactory) GetProvider(cloudProvider string) (Provider, error) {
+	provider, ok := d.providerContainer[cloudproviders.ParseCloudProviderID(cloudProvider)]
+	if !ok {
+		return nil, errors.Errorf("private connectivity is not supported on cloud provider %q", cloudProvider)
+	}
+	return provider, nil
+}
+
+func (d *DefaultProviderFactory) SupportsCloudProvider(cloudProvider string) bool {
+	_, ok := d.providerContainer[cloudproviders.ParseCloudProviderID(cloudProvider)]
+	return ok
+}
@@ -0,0 +1,118 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package privateendpoint
+
+import (
+	"sync"
+)
+
+// Ensure, that ProviderFactoryMock does implement ProviderFactory.
+// If this is not the case, regenerate this file with moq.
+var _ ProviderFactory = &ProviderFactoryMock{}
+
+// ProviderFactoryMock is a mock implementation of ProviderFactory.
+//
+//	func TestSomethingThatUsesProviderFactory(t *testing.T) {
+//
+//		// make and configure a mocked ProviderFactory
+//		mockedProviderFactory := &ProviderFactoryMock{
+//			GetProviderFunc: func(cloudProvider string) (Provider, error) {
+//				panic("mock out the GetProvider method")
+//			},
+//			SupportsCloudProviderFunc: func(cloudProvider string) bool {
+//				panic("mock out the SupportsCloudProvider method")
+//			},
+//		}
+//
+//		// use mockedProviderFactory in code that requires ProviderFactory
+//		// and then make assertions.
+//
+//	}
+type ProviderFactoryMock struct {
+	// GetProviderFunc mocks the GetProvider method.
+	GetProviderFunc func(cloudProvider string) (Provider, error)
+
+	// SupportsCloudProviderFunc mocks the SupportsCloudProvider method.
+	SupportsCloudProviderFunc func(cloudProvider string) bool
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetProvider holds details about calls to the GetProvider method.
+		GetProvider []struct {
+			// CloudProvider is the cloudProvider argument value.
+			CloudProvider string
+		}
+		// SupportsCloudProvider holds details about calls to the SupportsCloudProvider method.
+		SupportsCloudProvider []struct {
+			// CloudProvider is the cloudProvider argument value.
+			CloudProvider string
+		}
+	}
+	lockGetProvider           sync.RWMutex
+	lockSupportsCloudProvider sync.RWMutex
+}
+
+// GetProvider calls GetProviderFunc.
+func (mock *ProviderFactoryMock) GetProvider(cloudProvider string) (Provider, error) {
+	if mock.GetProviderFunc == nil {
+		panic("ProviderFactoryMock.GetProviderFunc: method is nil but ProviderFactory.GetProvider was just called")
+	}
+	callInfo := struct {
+		CloudProvider string
+	}{
+		CloudProvider: cloudProvider,
+	}
+	mock.lockGetProvider.Lock()
+	mock.calls.GetProvider = append(mock.calls.GetProvider, callInfo)
+	mock.lockGetProvider.Unlock()
+	return mock.GetProviderFunc(cloudProvider)
+}
+
+// GetProviderCalls gets all the calls that were made to GetProvider.
+// Check the length with:
+//
+//	len(mockedProviderFactory.GetProviderCalls())
+func (mock *ProviderFactoryMock) GetProviderCalls() []struct {
+	CloudProvider string
+} {
+	var calls []struct {
+		CloudProvider string
+	}
+	mock.lockGetProvider.RLock()
+	calls = mock.calls.GetProvider
+	mock.lockGetProvider.RUnlock()
+	return calls
+}
+
+// SupportsCloudProvider calls SupportsCloudProviderFunc.
+func (mock *ProviderFactoryMock) SupportsCloudProvider(cloudProvider string) bool {
+	if mock.SupportsCloudProviderFunc == nil {
+		panic("ProviderFactoryMock.SupportsCloudProviderFunc: method is nil but ProviderFactory.SupportsCloudProvider was just called")
+	}
+	callInfo := struct {
+		CloudProvider string
+	}{
+		CloudProvider: cloudProvider,
+	}
+	mock.lockSupportsCloudProvider.Lock()
+	mock.calls.SupportsCloudProvider = append(mock.calls.SupportsCloudProvider, callInfo)
+	mock.lockSupportsCloudProvider.Unlock()
+	return mock.SupportsCloudProviderFunc(cloudProvider)
+}
+
+// SupportsCloudProviderCalls gets all the calls that were made to SupportsCloudProvider.
+// Check the length with:
+//
+//	len(mockedProviderFactory.SupportsCloudProviderCalls())
+func (mock *ProviderFactoryMock) SupportsCloudProviderCalls() []struct {
+	CloudProvider string
+} {
+	var calls []struct {
+		CloudProvider string
+	}
+	mock.lockSupportsCloudProvider.RLock()
+	calls = mock.calls.SupportsCloudProvider
+	mock.lockSupportsCloudProvider.RUnlock()
+	return calls
+}
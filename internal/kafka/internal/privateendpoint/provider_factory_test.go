@@ -0,0 +1,44 @@
+package privateendpoint
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/aws"
+	"github.com/onsi/gomega"
+)
+
+func Test_DefaultProviderFactory_GetProvider(t *testing.T) {
+	factory := NewDefaultProviderFactory(config.NewAWSConfig(), &aws.MockClientFactory{})
+
+	tests := []struct {
+		name          string
+		cloudProvider string
+		want          Provider
+		wantErr       bool
+	}{
+		{
+			name:          "should return AWS PrivateLink for aws",
+			cloudProvider: "aws",
+			want:          &awsPrivateLinkProvider{},
+		},
+		{
+			name:          "should return an error for a cloud provider without private connectivity",
+			cloudProvider: "gcp",
+			wantErr:       true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			got, err := factory.GetProvider(tt.cloudProvider)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(factory.SupportsCloudProvider(tt.cloudProvider)).To(gomega.Equal(!tt.wantErr))
+			if !tt.wantErr {
+				g.Expect(got).To(gomega.BeAssignableToTypeOf(tt.want))
+			}
+		})
+	}
+}
@@ -0,0 +1,261 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package privateendpoint
+
+import (
+	"context"
+	"sync"
+)
+
+// Ensure, that ProviderMock does implement Provider.
+// If this is not the case, regenerate this file with moq.
+var _ Provider = &ProviderMock{}
+
+// ProviderMock is a mock implementation of Provider.
+//
+//	func TestSomethingThatUsesProvider(t *testing.T) {
+//
+//		// make and configure a mocked Provider
+//		mockedProvider := &ProviderMock{
+//			CreateEndpointServiceFunc: func(ctx context.Context, region string, kafkaID string) (*EndpointService, error) {
+//				panic("mock out the CreateEndpointService method")
+//			},
+//			DeleteEndpointServiceFunc: func(ctx context.Context, region string, serviceID string) error {
+//				panic("mock out the DeleteEndpointService method")
+//			},
+//			GetEndpointServiceFunc: func(ctx context.Context, region string, serviceID string) (*EndpointService, error) {
+//				panic("mock out the GetEndpointService method")
+//			},
+//			SetAllowedAccountsFunc: func(ctx context.Context, region string, serviceID string, accountIDs []string) error {
+//				panic("mock out the SetAllowedAccounts method")
+//			},
+//		}
+//
+//		// use mockedProvider in code that requires Provider
+//		// and then make assertions.
+//
+//	}
+type ProviderMock struct {
+	// CreateEndpointServiceFunc mocks the CreateEndpointService method.
+	CreateEndpointServiceFunc func(ctx context.Context, region string, kafkaID string) (*EndpointService, error)
+
+	// DeleteEndpointServiceFunc mocks the DeleteEndpointService method.
+	DeleteEndpointServiceFunc func(ctx context.Context, region string, serviceID string) error
+
+	// GetEndpointServiceFunc mocks the GetEndpointService method.
+	GetEndpointServiceFunc func(ctx context.Context, region string, serviceID string) (*EndpointService, error)
+
+	// SetAllowedAccountsFunc mocks the SetAllowedAccounts method.
+	SetAllowedAccountsFunc func(ctx context.Context, region string, serviceID string, accountIDs []string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateEndpointService holds details about calls to the CreateEndpointService method.
+		CreateEndpointService []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Region is the region argument value.
+			Region string
+			// KafkaID is the kafkaID argument value.
+			KafkaID string
+		}
+		// DeleteEndpointService holds details about calls to the DeleteEndpointService method.
+		DeleteEndpointService []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Region is the region argument value.
+			Region string
+			// ServiceID is the serviceID argument value.
+			ServiceID string
+		}
+		// GetEndpointService holds details about calls to the GetEndpointService method.
+		GetEndpointService []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Region is the region argument value.
+			Region string
+			// ServiceID is the serviceID argument value.
+			ServiceID string
+		}
+		// SetAllowedAccounts holds details about calls to the SetAllowedAccounts method.
+		SetAllowedAccounts []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Region is the region argument value.
+			Region string
+			// ServiceID is the serviceID argument value.
+			ServiceID string
+			// AccountIDs is the accountIDs argument value.
+			AccountIDs []string
+		}
+	}
+	lockCreateEndpointService sync.RWMutex
+	lockDeleteEndpointService sync.RWMutex
+	lockGetEndpointService    sync.RWMutex
+	lockSetAllowedAccounts    sync.RWMutex
+}
+
+// CreateEndpointService calls CreateEndpointServiceFunc.
+func (mock *ProviderMock) CreateEndpointService(ctx context.Context, region string, kafkaID string) (*EndpointService, error) {
+	if mock.CreateEndpointServiceFunc == nil {
+		panic("ProviderMock.CreateEndpointServiceFunc: method is nil but Provider.CreateEndpointService was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Region  string
+		KafkaID string
+	}{
+		Ctx:     ctx,
+		Region:  region,
+		KafkaID: kafkaID,
+	}
+	mock.lockCreateEndpointService.Lock()
+	mock.calls.CreateEndpointService = append(mock.calls.CreateEndpointService, callInfo)
+	mock.lockCreateEndpointService.Unlock()
+	return mock.CreateEndpointServiceFunc(ctx, region, kafkaID)
+}
+
+// CreateEndpointServiceCalls gets all the calls that were made to CreateEndpointService.
+// Check the length with:
+//
+//	len(mockedProvider.CreateEndpointServiceCalls())
+func (mock *ProviderMock) CreateEndpointServiceCalls() []struct {
+	Ctx     context.Context
+	Region  string
+	KafkaID string
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Region  string
+		KafkaID string
+	}
+	mock.lockCreateEndpointService.RLock()
+	calls = mock.calls.CreateEndpointService
+	mock.lockCreateEndpointService.RUnlock()
+	return calls
+}
+
+// DeleteEndpointService calls DeleteEndpointServiceFunc.
+func (mock *ProviderMock) DeleteEndpointService(ctx context.Context, region string, serviceID string) error {
+	if mock.DeleteEndpointServiceFunc == nil {
+		panic("ProviderMock.DeleteEndpointServiceFunc: method is nil but Provider.DeleteEndpointService was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Region    string
+		ServiceID string
+	}{
+		Ctx:       ctx,
+		Region:    region,
+		ServiceID: serviceID,
+	}
+	mock.lockDeleteEndpointService.Lock()
+	mock.calls.DeleteEndpointService = append(mock.calls.DeleteEndpointService, callInfo)
+	mock.lockDeleteEndpointService.Unlock()
+	return mock.DeleteEndpointServiceFunc(ctx, region, serviceID)
+}
+
+// DeleteEndpointServiceCalls gets all the calls that were made to DeleteEndpointService.
+// Check the length with:
+//
+//	len(mockedProvider.DeleteEndpointServiceCalls())
+func (mock *ProviderMock) DeleteEndpointServiceCalls() []struct {
+	Ctx       context.Context
+	Region    string
+	ServiceID string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Region    string
+		ServiceID string
+	}
+	mock.lockDeleteEndpointService.RLock()
+	calls = mock.calls.DeleteEndpointService
+	mock.lockDeleteEndpointService.RUnlock()
+	return calls
+}
+
+// GetEndpointService calls GetEndpointServiceFunc.
+func (mock *ProviderMock) GetEndpointService(ctx context.Context, region string, serviceID string) (*EndpointService, error) {
+	if mock.GetEndpointServiceFunc == nil {
+		panic("ProviderMock.GetEndpointServiceFunc: method is nil but Provider.GetEndpointService was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Region    string
+		ServiceID string
+	}{
+		Ctx:       ctx,
+		Region:    region,
+		ServiceID: serviceID,
+	}
+	mock.lockGetEndpointService.Lock()
+	mock.calls.GetEndpointService = append(mock.calls.GetEndpointService, callInfo)
+	mock.lockGetEndpointService.Unlock()
+	return mock.GetEndpointServiceFunc(ctx, region, serviceID)
+}
+
+// GetEndpointServiceCalls gets all the calls that were made to GetEndpointService.
+// Check the length with:
+//
+//	len(mockedProvider.GetEndpointServiceCalls())
+func (mock *ProviderMock) GetEndpointServiceCalls() []struct {
+	Ctx       context.Context
+	Region    string
+	ServiceID string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Region    string
+		ServiceID string
+	}
+	mock.lockGetEndpointService.RLock()
+	calls = mock.calls.GetEndpointService
+	mock.lockGetEndpointService.RUnlock()
+	return calls
+}
+
+// SetAllowedAccounts calls SetAllowedAccountsFunc.
+func (mock *ProviderMock) SetAllowedAccounts(ctx context.Context, region string, serviceID string, accountIDs []string) error {
+	if mock.SetAllowedAccountsFunc == nil {
+		panic("ProviderMock.SetAllowedAccountsFunc: method is nil but Provider.SetAllowedAccounts was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Region     string
+		ServiceID  string
+		AccountIDs []string
+	}{
+		Ctx:        ctx,
+		Region:     region,
+		ServiceID:  serviceID,
+		AccountIDs: accountIDs,
+	}
+	mock.lockSetAllowedAccounts.Lock()
+	mock.calls.SetAllowedAccounts = append(mock.calls.SetAllowedAccounts, callInfo)
+	mock.lockSetAllowedAccounts.Unlock()
+	return mock.SetAllowedAccountsFunc(ctx, region, serviceID, accountIDs)
+}
+
+// SetAllowedAccountsCalls gets all the calls that were made to SetAllowedAccounts.
+// Check the length with:
+//
+//	len(mockedProvider.SetAllowedAccountsCalls())
+func (mock *ProviderMock) SetAllowedAccountsCalls() []struct {
+	Ctx        context.Context
+	Region     string
+	ServiceID  string
+	AccountIDs []string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Region     string
+		ServiceID  string
+		AccountIDs []string
+	}
+	mock.lockSetAllowedAccounts.RLock()
+	calls = mock.calls.SetAllowedAccounts
+	mock.lockSetAllowedAccounts.RUnlock()
+	return calls
+}
@@ -29,6 +29,7 @@ import (
 	coreHandlers "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/server"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
 
 	"github.com/goava/di"
 	gorillaHandlers "github.com/gorilla/handlers"
@@ -63,6 +64,14 @@ type options struct {
 	AdminRoleAuthZConfig                      *auth.AdminRoleAuthZConfig
 	KasFleetshardOperatorAddon                services.KasFleetshardOperatorAddon
 	KafkaTLSCertificateManagementService      kafkatlscertmgmt.KafkaTLSCertificateManagementService
+	IdempotencyMiddleware                     *coreHandlers.IdempotencyMiddleware
+	WorkerStatusService                       workers.WorkerStatusService
+	WorkQueueService                          workers.WorkQueueService
+	ClusterConsolidationPlanService           services.ClusterConsolidationPlanService
+	CapacityForecastService                   services.CapacityForecastService
+	ClusterBlueprintService                   services.ClusterBlueprintService
+	ClusterUpgradeService                     services.ClusterUpgradeService
+	PrivateEndpointService                    services.PrivateEndpointService
 }
 
 func NewRouteLoader(s options) environments.RouteLoader {
@@ -85,9 +94,10 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string) er
 		return pkgerrors.Wrapf(err, "can't load OpenAPI specification")
 	}
 
-	kafkaHandler := handlers.NewKafkaHandler(s.Kafka, s.ProviderConfig, s.AuthService, s.KafkaConfig)
+	kafkaHandler := handlers.NewKafkaHandler(s.Kafka, s.ProviderConfig, s.AuthService, s.KafkaConfig, s.PrivateEndpointService)
 	kafkaPromoteValidatorFactory := handlers.NewDefaultKafkaPromoteValidatorFactory(s.KafkaConfig)
 	kafkaPromoteHandler := handlers.NewKafkaPromoteHandler(s.Kafka, s.KafkaConfig, kafkaPromoteValidatorFactory)
+	kafkaPrivateEndpointHandler := handlers.NewKafkaPrivateEndpointHandler(s.Kafka, s.PrivateEndpointService)
 	cloudProvidersHandler := handlers.NewCloudProviderHandler(s.CloudProviders, s.ProviderConfig, s.Kafka, s.ClusterPlacementStrategy, s.KafkaConfig)
 	errorsHandler := coreHandlers.NewErrorsHandler()
 	serviceAccountsHandler := handlers.NewServiceAccountHandler(s.Keycloak)
@@ -98,6 +108,7 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string) er
 	requireOrgID := auth.NewRequireOrgIDMiddleware().RequireOrgID(errors.ErrorUnauthenticated)
 	requireIssuer := auth.NewRequireIssuerMiddleware().RequireIssuer([]string{s.ServerConfig.TokenIssuerURL}, errors.ErrorUnauthenticated)
 	requireTermsAcceptance := auth.NewRequireTermsAcceptanceMiddleware().RequireTermsAcceptance(s.ServerConfig.EnableTermsAcceptance, s.AMSClient, errors.ErrorTermsNotAccepted)
+	idempotent := s.IdempotencyMiddleware.Idempotent
 
 	// base path. Could be /api/kafkas_mgmt
 	apiRouter := mainRouter.PathPrefix(basePath).Subrouter()
@@ -141,7 +152,7 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string) er
 	apiV1KafkasRouter.Use(authorizeMiddleware)
 
 	apiV1KafkasCreateRouter := apiV1KafkasRouter.NewRoute().Subrouter()
-	apiV1KafkasCreateRouter.HandleFunc("", kafkaHandler.Create).
+	apiV1KafkasCreateRouter.Handle("", idempotent(http.HandlerFunc(kafkaHandler.Create))).
 		Name(logger.NewLogEvent("create-kafka", "create a kafka instance").ToString()).
 		Methods(http.MethodPost)
 	apiV1KafkasCreateRouter.Use(requireTermsAcceptance)
@@ -152,6 +163,15 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string) er
 		Name(logger.NewLogEvent("promote-kafka", "promote a kafka instance").ToString()).
 		Methods(http.MethodPost)
 
+	// /kafkas/{id}/private_endpoint
+	apiV1KafkasPrivateEndpointRouter := apiV1KafkasRouter.PathPrefix("/{id}/private_endpoint").Subrouter()
+	apiV1KafkasPrivateEndpointRouter.HandleFunc("", kafkaPrivateEndpointHandler.Get).
+		Name(logger.NewLogEvent("get-kafka-private-endpoint", "get the private endpoint of a kafka instance").ToString()).
+		Methods(http.MethodGet)
+	apiV1KafkasPrivateEndpointRouter.HandleFunc("", kafkaPrivateEndpointHandler.Update).
+		Name(logger.NewLogEvent("update-kafka-private-endpoint", "update the private endpoint of a kafka instance").ToString()).
+		Methods(http.MethodPut)
+
 	//  /kafkas/{id}/metrics
 	apiV1MetricsRouter := apiV1KafkasRouter.PathPrefix("/{id}/metrics").Subrouter()
 	apiV1MetricsRouter.HandleFunc("/query_range", metricsHandler.GetMetricsByRangeQuery).
@@ -188,7 +208,7 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string) er
 	apiV1ServiceAccountsRouter.HandleFunc("", serviceAccountsHandler.ListServiceAccounts).
 		Name(logger.NewLogEvent("list-service-accounts", "lists all service accounts").ToString()).
 		Methods(http.MethodGet)
-	apiV1ServiceAccountsRouter.HandleFunc("", serviceAccountsHandler.CreateServiceAccount).
+	apiV1ServiceAccountsRouter.Handle("", idempotent(http.HandlerFunc(serviceAccountsHandler.CreateServiceAccount))).
 		Name(logger.NewLogEvent("create-service-accounts", "create a service accounts").ToString()).
 		Methods(http.MethodPost)
 	apiV1ServiceAccountsRouter.HandleFunc("/{id}", serviceAccountsHandler.DeleteServiceAccount).
@@ -235,7 +255,7 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string) er
 	clusterHandler := handlers.NewClusterHandler(s.KasFleetshardOperatorAddon, s.ClusterService, s.ProviderFactory, s.KafkaConfig)
 	clusterRouter := apiV1Router.PathPrefix("/clusters").Subrouter()
 	clusterRouter.Use(s.EnterpriseClustersAccessControlMiddleware.Authorize)
-	clusterRouter.HandleFunc("", clusterHandler.RegisterEnterpriseCluster).
+	clusterRouter.Handle("", idempotent(http.HandlerFunc(clusterHandler.RegisterEnterpriseCluster))).
 		Name(logger.NewLogEvent("register-enterprise-cluster", "register enterprise data plane cluster").ToString()).
 		Methods(http.MethodPost)
 	clusterRouter.HandleFunc("", clusterHandler.List).
@@ -300,6 +320,90 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string) er
 		Name(logger.NewLogEvent("admin-kafka-tls-certificate-revocation", "[admin] revoke the TLS certificate of a kafka by id").ToString()).
 		Methods(http.MethodPost)
 
+	// /api/kafkas_mgmt/v1/admin/workers
+	adminWorkerHandler := handlers.NewAdminWorkerHandler(s.WorkerStatusService, s.WorkQueueService)
+	adminRouter.HandleFunc("/workers", adminWorkerHandler.List).
+		Name(logger.NewLogEvent("admin-list-workers", "[admin] list the status of all worker types").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/workers/{worker_type}", adminWorkerHandler.Get).
+		Name(logger.NewLogEvent("admin-get-worker", "[admin] get the status of a worker type").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/workers/{worker_type}/pause", adminWorkerHandler.Pause).
+		Name(logger.NewLogEvent("admin-pause-worker", "[admin] pause the reconciles of a worker type").ToString()).
+		Methods(http.MethodPost)
+	adminRouter.HandleFunc("/workers/{worker_type}/resume", adminWorkerHandler.Resume).
+		Name(logger.NewLogEvent("admin-resume-worker", "[admin] resume the reconciles of a worker type").ToString()).
+		Methods(http.MethodPost)
+	adminRouter.HandleFunc("/workers/{worker_type}/reconcile", adminWorkerHandler.Reconcile).
+		Name(logger.NewLogEvent("admin-reconcile-worker", "[admin] trigger a reconcile of a worker type").ToString()).
+		Methods(http.MethodPost)
+	adminRouter.HandleFunc("/workers/{worker_type}/quarantined_items", adminWorkerHandler.ListQuarantinedItems).
+		Name(logger.NewLogEvent("admin-list-worker-quarantined-items", "[admin] list the quarantined work items of a worker type").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/workers/{worker_type}/quarantined_items/{resource_id}", adminWorkerHandler.ReleaseQuarantinedItem).
+		Name(logger.NewLogEvent("admin-release-worker-quarantined-item", "[admin] release a quarantined work item of a worker type").ToString()).
+		Methods(http.MethodDelete)
+
+	// /api/kafkas_mgmt/v1/admin/cluster_consolidation_plans
+	adminClusterConsolidationPlanHandler := handlers.NewAdminClusterConsolidationPlanHandler(s.ClusterConsolidationPlanService)
+	adminRouter.HandleFunc("/cluster_consolidation_plans", adminClusterConsolidationPlanHandler.List).
+		Name(logger.NewLogEvent("admin-list-cluster-consolidation-plans", "[admin] list the consolidation plans of data plane clusters").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/cluster_consolidation_plans/{id}", adminClusterConsolidationPlanHandler.Get).
+		Name(logger.NewLogEvent("admin-get-cluster-consolidation-plan", "[admin] get a consolidation plan by id").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/cluster_consolidation_plans/{id}/approve", adminClusterConsolidationPlanHandler.Approve).
+		Name(logger.NewLogEvent("admin-approve-cluster-consolidation-plan", "[admin] approve a consolidation plan by id").ToString()).
+		Methods(http.MethodPost)
+	adminRouter.HandleFunc("/cluster_consolidation_plans/{id}/reject", adminClusterConsolidationPlanHandler.Reject).
+		Name(logger.NewLogEvent("admin-reject-cluster-consolidation-plan", "[admin] reject a consolidation plan by id").ToString()).
+		Methods(http.MethodPost)
+
+	// /api/kafkas_mgmt/v1/admin/capacity_report
+	adminCapacityReportHandler := handlers.NewAdminCapacityReportHandler(s.CapacityForecastService)
+	adminRouter.HandleFunc("/capacity_report", adminCapacityReportHandler.Get).
+		Name(logger.NewLogEvent("admin-get-capacity-report", "[admin] get the data plane capacity and forecasted demand report").ToString()).
+		Methods(http.MethodGet)
+
+	// /api/kafkas_mgmt/v1/admin/cluster_blueprints
+	adminClusterBlueprintHandler := handlers.NewAdminClusterBlueprintHandler(s.ClusterBlueprintService, s.ProviderConfig)
+	adminRouter.HandleFunc("/cluster_blueprints", adminClusterBlueprintHandler.List).
+		Name(logger.NewLogEvent("admin-list-cluster-blueprints", "[admin] list the data plane cluster blueprints").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/cluster_blueprints", adminClusterBlueprintHandler.Create).
+		Name(logger.NewLogEvent("admin-create-cluster-blueprint", "[admin] create a data plane cluster blueprint").ToString()).
+		Methods(http.MethodPost)
+	adminRouter.HandleFunc("/cluster_blueprints/{id}", adminClusterBlueprintHandler.Get).
+		Name(logger.NewLogEvent("admin-get-cluster-blueprint", "[admin] get a data plane cluster blueprint by id").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/cluster_blueprints/{id}", adminClusterBlueprintHandler.Update).
+		Name(logger.NewLogEvent("admin-update-cluster-blueprint", "[admin] update a data plane cluster blueprint by id").ToString()).
+		Methods(http.MethodPut)
+	adminRouter.HandleFunc("/cluster_blueprints/{id}", adminClusterBlueprintHandler.Delete).
+		Name(logger.NewLogEvent("admin-delete-cluster-blueprint", "[admin] delete a data plane cluster blueprint by id").ToString()).
+		Methods(http.MethodDelete)
+	adminRouter.HandleFunc("/cluster_blueprints/{id}/plan", adminClusterBlueprintHandler.Plan).
+		Name(logger.NewLogEvent("admin-get-cluster-blueprint-plan", "[admin] get the plan reconciling the clusters of a data plane cluster blueprint").ToString()).
+		Methods(http.MethodGet)
+
+	// /api/kafkas_mgmt/v1/admin/clusters/{cluster_id}/upgrade and /api/kafkas_mgmt/v1/admin/cluster_upgrade_waves
+	adminClusterUpgradeHandler := handlers.NewAdminClusterUpgradeHandler(s.ClusterService, s.ClusterUpgradeService)
+	adminRouter.HandleFunc("/clusters/{cluster_id}/upgrade", adminClusterUpgradeHandler.Get).
+		Name(logger.NewLogEvent("admin-get-cluster-upgrade", "[admin] get the OpenShift upgrade of a data plane cluster").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/clusters/{cluster_id}/upgrade", adminClusterUpgradeHandler.Schedule).
+		Name(logger.NewLogEvent("admin-schedule-cluster-upgrade", "[admin] schedule the OpenShift upgrade of a data plane cluster").ToString()).
+		Methods(http.MethodPost)
+	adminRouter.HandleFunc("/clusters/{cluster_id}/upgrade", adminClusterUpgradeHandler.Cancel).
+		Name(logger.NewLogEvent("admin-cancel-cluster-upgrade", "[admin] cancel the OpenShift upgrade of a data plane cluster").ToString()).
+		Methods(http.MethodDelete)
+	adminRouter.HandleFunc("/cluster_upgrade_waves", adminClusterUpgradeHandler.CreateWave).
+		Name(logger.NewLogEvent("admin-create-cluster-upgrade-wave", "[admin] schedule the OpenShift upgrade of data plane clusters in batches").ToString()).
+		Methods(http.MethodPost)
+	adminRouter.HandleFunc("/cluster_upgrade_waves/{id}", adminClusterUpgradeHandler.GetWave).
+		Name(logger.NewLogEvent("admin-get-cluster-upgrade-wave", "[admin] get the OpenShift upgrades of the data plane clusters of a wave").ToString()).
+		Methods(http.MethodGet)
+
 	// /api/kafkas_mgmt/v1
 	v1Metadata := api.VersionMetadata{
 		ID:          "v1",
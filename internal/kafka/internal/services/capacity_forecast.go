@@ -0,0 +1,237 @@
+package services
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	apiErrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// kafkaCreationRateWindow is the granularity at which the Kafka creations are counted
+const kafkaCreationRateWindow = time.Hour
+
+// StreamingUnitsForecast is the demand forecasted for an instance type in a cloud provider's region
+type StreamingUnitsForecast struct {
+	CloudProvider string
+	Region        string
+	InstanceType  string
+	// ObservedKafkaCount and ObservedStreamingUnits are the Kafka instances, and their streaming units,
+	// requested during the lookback window
+	ObservedKafkaCount     int
+	ObservedStreamingUnits int
+	// ForecastedStreamingUnits are the streaming units expected to be requested within the horizon
+	ForecastedStreamingUnits int
+}
+
+type StreamingUnitsForecastList []StreamingUnitsForecast
+
+// Find returns the forecast for the given instance type in the given cloud provider's region.
+// An empty forecast is returned when no Kafka instance has been requested during the lookback window
+func (l StreamingUnitsForecastList) Find(cloudProvider, region, instanceType string) StreamingUnitsForecast {
+	for _, forecast := range l {
+		if forecast.CloudProvider == cloudProvider && forecast.Region == region && forecast.InstanceType == instanceType {
+			return forecast
+		}
+	}
+	return StreamingUnitsForecast{CloudProvider: cloudProvider, Region: region, InstanceType: instanceType}
+}
+
+// CapacityReportItem compares the current data plane capacity of an instance type in a cloud provider's region
+// with the forecasted demand
+type CapacityReportItem struct {
+	StreamingUnitsForecast
+	// MaxStreamingUnits, ConsumedStreamingUnits and FreeStreamingUnits only account for the clusters
+	// that are ready to accept Kafka instances
+	MaxStreamingUnits      int
+	ConsumedStreamingUnits int
+	FreeStreamingUnits     int
+	// ProjectedFreeStreamingUnits is the free capacity left once the forecasted streaming units are consumed
+	ProjectedFreeStreamingUnits             int
+	MinAvailableCapacitySlackStreamingUnits int
+	Limit                                   *int
+	// ScaleUpOngoing is true when a cluster supporting the instance type is being provisioned in the region
+	ScaleUpOngoing bool
+}
+
+type CapacityReport struct {
+	Horizon time.Duration
+	// Lookback is the Kafka creation history the forecast is based on
+	Lookback time.Duration
+	Items    []CapacityReportItem
+}
+
+//go:generate moq -out capacity_forecast_moq.go . CapacityForecastService
+type CapacityForecastService interface {
+	// RecordKafkaCreation adds the given Kafka request, consuming the given streaming units,
+	// to the creation rate of its instance type in its cloud provider's region
+	RecordKafkaCreation(kafkaRequest *dbapi.KafkaRequest, streamingUnits int) *apiErrors.ServiceError
+	// ForecastStreamingUnits forecasts the streaming units requested within the horizon for each instance type in each
+	// cloud provider's region by extrapolating the creation rate observed over the lookback window
+	ForecastStreamingUnits(lookback, horizon time.Duration) (StreamingUnitsForecastList, *apiErrors.ServiceError)
+	// GetCapacityReport returns the current capacity and the forecasted demand of every instance type supported in
+	// the configured cloud providers' regions, using the horizon and lookback window of the dynamic scaling configuration
+	GetCapacityReport() (*CapacityReport, *apiErrors.ServiceError)
+}
+
+var _ CapacityForecastService = &capacityForecastService{}
+
+type capacityForecastService struct {
+	connectionFactory      *db.ConnectionFactory
+	clusterService         ClusterService
+	providerConfig         *config.ProviderConfig
+	dataplaneClusterConfig *config.DataplaneClusterConfig
+}
+
+func NewCapacityForecastService(connectionFactory *db.ConnectionFactory, clusterService ClusterService,
+	providerConfig *config.ProviderConfig, dataplaneClusterConfig *config.DataplaneClusterConfig) CapacityForecastService {
+	return &capacityForecastService{
+		connectionFactory:      connectionFactory,
+		clusterService:         clusterService,
+		providerConfig:         providerConfig,
+		dataplaneClusterConfig: dataplaneClusterConfig,
+	}
+}
+
+func (s *capacityForecastService) RecordKafkaCreation(kafkaRequest *dbapi.KafkaRequest, streamingUnits int) *apiErrors.ServiceError {
+	rate := &dbapi.KafkaCreationRate{
+		CloudProvider:  kafkaRequest.CloudProvider,
+		Region:         kafkaRequest.Region,
+		InstanceType:   kafkaRequest.InstanceType,
+		WindowStart:    time.Now().UTC().Truncate(kafkaCreationRateWindow),
+		KafkaCount:     1,
+		StreamingUnits: streamingUnits,
+	}
+
+	err := s.connectionFactory.New().
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "cloud_provider"}, {Name: "region"}, {Name: "instance_type"}, {Name: "window_start"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"kafka_count":     gorm.Expr("kafka_creation_rates.kafka_count + ?", rate.KafkaCount),
+				"streaming_units": gorm.Expr("kafka_creation_rates.streaming_units + ?", rate.StreamingUnits),
+				"updated_at":      time.Now(),
+			}),
+		}).
+		Create(rate).Error
+	if err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to record creation of kafka %q", kafkaRequest.ID)
+	}
+
+	return nil
+}
+
+func (s *capacityForecastService) ForecastStreamingUnits(lookback, horizon time.Duration) (StreamingUnitsForecastList, *apiErrors.ServiceError) {
+	if lookback <= 0 {
+		return nil, apiErrors.GeneralError("the lookback window of the capacity forecast must be positive")
+	}
+
+	var forecasts StreamingUnitsForecastList
+	err := s.connectionFactory.New().
+		Model(&dbapi.KafkaCreationRate{}).
+		Select("cloud_provider, region, instance_type, sum(kafka_count) as observed_kafka_count, sum(streaming_units) as observed_streaming_units").
+		Where("window_start >= ?", time.Now().UTC().Add(-lookback)).
+		Group("cloud_provider, region, instance_type").
+		Scan(&forecasts).Error
+	if err != nil {
+		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to compute kafka creation rates")
+	}
+
+	for i := range forecasts {
+		forecasts[i].ForecastedStreamingUnits = extrapolateStreamingUnits(forecasts[i].ObservedStreamingUnits, lookback, horizon)
+	}
+
+	return forecasts, nil
+}
+
+func (s *capacityForecastService) GetCapacityReport() (*CapacityReport, *apiErrors.ServiceError) {
+	dynamicScalingConfig := s.dataplaneClusterConfig.DynamicScalingConfig
+	horizon := dynamicScalingConfig.PredictiveScaleUpHorizon()
+	lookback := dynamicScalingConfig.PredictiveScaleUpLookback()
+
+	forecasts, svcErr := s.ForecastStreamingUnits(lookback, horizon)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	streamingUnitCounts, err := s.clusterService.FindStreamingUnitCountByClusterAndInstanceType()
+	if err != nil {
+		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to find the streaming units capacity of the data plane clusters")
+	}
+
+	report := &CapacityReport{
+		Horizon:  horizon,
+		Lookback: lookback,
+		Items:    []CapacityReportItem{},
+	}
+
+	for _, provider := range s.providerConfig.ProvidersConfig.SupportedProviders {
+		for _, region := range provider.Regions {
+			instanceTypes := make([]string, 0, len(region.SupportedInstanceTypes))
+			for instanceType := range region.SupportedInstanceTypes {
+				instanceTypes = append(instanceTypes, instanceType)
+			}
+			sort.Strings(instanceTypes)
+
+			for _, instanceType := range instanceTypes {
+				instanceTypeConfig := region.SupportedInstanceTypes[instanceType]
+				item := CapacityReportItem{
+					StreamingUnitsForecast:                  forecasts.Find(provider.Name, region.Name, instanceType),
+					MinAvailableCapacitySlackStreamingUnits: instanceTypeConfig.MinAvailableCapacitySlackStreamingUnits,
+					Limit:                                   instanceTypeConfig.Limit,
+				}
+				addClustersCapacityToReportItem(&item, streamingUnitCounts)
+				report.Items = append(report.Items, item)
+			}
+		}
+	}
+
+	return report, nil
+}
+
+// addClustersCapacityToReportItem sums up the capacity of the managed data plane clusters of the report item's locator.
+// Clusters being provisioned are not accounted for until they are ready, clusters being deleted no longer accept Kafka instances
+func addClustersCapacityToReportItem(item *CapacityReportItem, streamingUnitCounts KafkaStreamingUnitCountPerClusterList) {
+	clusterStatusesTowardReady := []string{
+		api.ClusterProvisioning.String(), api.ClusterProvisioned.String(),
+		api.ClusterAccepted.String(), api.ClusterWaitingForKasFleetShardOperator.String(),
+	}
+	clusterStatusesTowardDeletion := []string{api.ClusterDeprovisioning.String(), api.ClusterCleanup.String()}
+
+	for _, count := range streamingUnitCounts {
+		if count.CloudProvider != item.CloudProvider || count.Region != item.Region ||
+			count.InstanceType != item.InstanceType || count.ClusterType != api.ManagedDataPlaneClusterType.String() {
+			continue
+		}
+
+		if arrays.Contains(clusterStatusesTowardReady, count.Status) {
+			item.ScaleUpOngoing = true
+			continue
+		}
+
+		if arrays.Contains(clusterStatusesTowardDeletion, count.Status) {
+			continue
+		}
+
+		item.MaxStreamingUnits += int(count.MaxUnits)
+		item.ConsumedStreamingUnits += int(count.Count)
+	}
+
+	item.FreeStreamingUnits = item.MaxStreamingUnits - item.ConsumedStreamingUnits
+	item.ProjectedFreeStreamingUnits = item.FreeStreamingUnits - item.ForecastedStreamingUnits
+}
+
+// extrapolateStreamingUnits scales the streaming units observed over the lookback window to the horizon,
+// rounding up so that any observed demand results in a forecasted demand
+func extrapolateStreamingUnits(observedStreamingUnits int, lookback, horizon time.Duration) int {
+	if observedStreamingUnits <= 0 || horizon <= 0 {
+		return 0
+	}
+	return int(math.Ceil(float64(observedStreamingUnits) * horizon.Hours() / lookback.Hours()))
+}
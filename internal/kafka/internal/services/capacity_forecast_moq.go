@@ -0,0 +1,170 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package services
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	serviceError "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"sync"
+	"time"
+)
+
+// Ensure, that CapacityForecastServiceMock does implement CapacityForecastService.
+// If this is not the case, regenerate this file with moq.
+var _ CapacityForecastService = &CapacityForecastServiceMock{}
+
+// CapacityForecastServiceMock is a mock implementation of CapacityForecastService.
+//
+//	func TestSomethingThatUsesCapacityForecastService(t *testing.T) {
+//
+//		// make and configure a mocked CapacityForecastService
+//		mockedCapacityForecastService := &CapacityForecastServiceMock{
+//			ForecastStreamingUnitsFunc: func(lookback time.Duration, horizon time.Duration) (StreamingUnitsForecastList, *serviceError.ServiceError) {
+//				panic("mock out the ForecastStreamingUnits method")
+//			},
+//			GetCapacityReportFunc: func() (*CapacityReport, *serviceError.ServiceError) {
+//				panic("mock out the GetCapacityReport method")
+//			},
+//			RecordKafkaCreationFunc: func(kafkaRequest *dbapi.KafkaRequest, streamingUnits int) *serviceError.ServiceError {
+//				panic("mock out the RecordKafkaCreation method")
+//			},
+//		}
+//
+//		// use mockedCapacityForecastService in code that requires CapacityForecastService
+//		// and then make assertions.
+//
+//	}
+type CapacityForecastServiceMock struct {
+	// ForecastStreamingUnitsFunc mocks the ForecastStreamingUnits method.
+	ForecastStreamingUnitsFunc func(lookback time.Duration, horizon time.Duration) (StreamingUnitsForecastList, *serviceError.ServiceError)
+
+	// GetCapacityReportFunc mocks the GetCapacityReport method.
+	GetCapacityReportFunc func() (*CapacityReport, *serviceError.ServiceError)
+
+	// RecordKafkaCreationFunc mocks the RecordKafkaCreation method.
+	RecordKafkaCreationFunc func(kafkaRequest *dbapi.KafkaRequest, streamingUnits int) *serviceError.ServiceError
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ForecastStreamingUnits holds details about calls to the ForecastStreamingUnits method.
+		ForecastStreamingUnits []struct {
+			// Lookback is the lookback argument value.
+			Lookback time.Duration
+			// Horizon is the horizon argument value.
+			Horizon time.Duration
+		}
+		// GetCapacityReport holds details about calls to the GetCapacityReport method.
+		GetCapacityReport []struct {
+		}
+		// RecordKafkaCreation holds details about calls to the RecordKafkaCreation method.
+		RecordKafkaCreation []struct {
+			// KafkaRequest is the kafkaRequest argument value.
+			KafkaRequest *dbapi.KafkaRequest
+			// StreamingUnits is the streamingUnits argument value.
+			StreamingUnits int
+		}
+	}
+	lockForecastStreamingUnits sync.RWMutex
+	lockGetCapacityReport      sync.RWMutex
+	lockRecordKafkaCreation    sync.RWMutex
+}
+
+// ForecastStreamingUnits calls ForecastStreamingUnitsFunc.
+func (mock *CapacityForecastServiceMock) ForecastStreamingUnits(lookback time.Duration, horizon time.Duration) (StreamingUnitsForecastList, *serviceError.ServiceError) {
+	if mock.ForecastStreamingUnitsFunc == nil {
+		panic("CapacityForecastServiceMock.ForecastStreamingUnitsFunc: method is nil but CapacityForecastService.ForecastStreamingUnits was just called")
+	}
+	callInfo := struct {
+		Lookback time.Duration
+		Horizon  time.Duration
+	}{
+		Lookback: lookback,
+		Horizon:  horizon,
+	}
+	mock.lockForecastStreamingUnits.Lock()
+	mock.calls.ForecastStreamingUnits = append(mock.calls.ForecastStreamingUnits, callInfo)
+	mock.lockForecastStreamingUnits.Unlock()
+	return mock.ForecastStreamingUnitsFunc(lookback, horizon)
+}
+
+// ForecastStreamingUnitsCalls gets all the calls that were made to ForecastStreamingUnits.
+// Check the length with:
+//
+//	len(mockedCapacityForecastService.ForecastStreamingUnitsCalls())
+func (mock *CapacityForecastServiceMock) ForecastStreamingUnitsCalls() []struct {
+	Lookback time.Duration
+	Horizon  time.Duration
+} {
+	var calls []struct {
+		Lookback time.Duration
+		Horizon  time.Duration
+	}
+	mock.lockForecastStreamingUnits.RLock()
+	calls = mock.calls.ForecastStreamingUnits
+	mock.lockForecastStreamingUnits.RUnlock()
+	return calls
+}
+
+// GetCapacityReport calls GetCapacityReportFunc.
+func (mock *CapacityForecastServiceMock) GetCapacityReport() (*CapacityReport, *serviceError.ServiceError) {
+	if mock.GetCapacityReportFunc == nil {
+		panic("CapacityForecastServiceMock.GetCapacityReportFunc: method is nil but CapacityForecastService.GetCapacityReport was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetCapacityReport.Lock()
+	mock.calls.GetCapacityReport = append(mock.calls.GetCapacityReport, callInfo)
+	mock.lockGetCapacityReport.Unlock()
+	return mock.GetCapacityReportFunc()
+}
+
+// GetCapacityReportCalls gets all the calls that were made to GetCapacityReport.
+// Check the length with:
+//
+//	len(mockedCapacityForecastService.GetCapacityReportCalls())
+func (mock *CapacityForecastServiceMock) GetCapacityReportCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetCapacityReport.RLock()
+	calls = mock.calls.GetCapacityReport
+	mock.lockGetCapacityReport.RUnlock()
+	return calls
+}
+
+// RecordKafkaCreation calls RecordKafkaCreationFunc.
+func (mock *CapacityForecastServiceMock) RecordKafkaCreation(kafkaRequest *dbapi.KafkaRequest, streamingUnits int) *serviceError.ServiceError {
+	if mock.RecordKafkaCreationFunc == nil {
+		panic("CapacityForecastServiceMock.RecordKafkaCreationFunc: method is nil but CapacityForecastService.RecordKafkaCreation was just called")
+	}
+	callInfo := struct {
+		KafkaRequest   *dbapi.KafkaRequest
+		StreamingUnits int
+	}{
+		KafkaRequest:   kafkaRequest,
+		StreamingUnits: streamingUnits,
+	}
+	mock.lockRecordKafkaCreation.Lock()
+	mock.calls.RecordKafkaCreation = append(mock.calls.RecordKafkaCreation, callInfo)
+	mock.lockRecordKafkaCreation.Unlock()
+	return mock.RecordKafkaCreationFunc(kafkaRequest, streamingUnits)
+}
+
+// RecordKafkaCreationCalls gets all the calls that were made to RecordKafkaCreation.
+// Check the length with:
+//
+//	len(mockedCapacityForecastService.RecordKafkaCreationCalls())
+func (mock *CapacityForecastServiceMock) RecordKafkaCreationCalls() []struct {
+	KafkaRequest   *dbapi.KafkaRequest
+	StreamingUnits int
+} {
+	var calls []struct {
+		KafkaRequest   *dbapi.KafkaRequest
+		StreamingUnits int
+	}
+	mock.lockRecordKafkaCreation.RLock()
+	calls = mock.calls.RecordKafkaCreation
+	mock.lockRecordKafkaCreation.RUnlock()
+	return calls
+}
@@ -0,0 +1,170 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_capacityForecastService_ForecastStreamingUnits(t *testing.T) {
+	tests := []struct {
+		name     string
+		lookback time.Duration
+		horizon  time.Duration
+		setupFn  func()
+		want     StreamingUnitsForecastList
+		wantErr  bool
+	}{
+		{
+			name:     "should extrapolate the streaming units observed over the lookback window to the horizon",
+			lookback: 10 * time.Hour,
+			horizon:  time.Hour,
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`FROM "kafka_creation_rates"`).WithReply([]map[string]interface{}{
+					{"cloud_provider": "aws", "region": "us-east-1", "instance_type": "standard", "observed_kafka_count": 15, "observed_streaming_units": 21},
+					{"cloud_provider": "aws", "region": "us-east-1", "instance_type": "developer", "observed_kafka_count": 0, "observed_streaming_units": 0},
+				})
+			},
+			want: StreamingUnitsForecastList{
+				{CloudProvider: "aws", Region: "us-east-1", InstanceType: "standard", ObservedKafkaCount: 15, ObservedStreamingUnits: 21, ForecastedStreamingUnits: 3},
+				{CloudProvider: "aws", Region: "us-east-1", InstanceType: "developer"},
+			},
+		},
+		{
+			name:     "should return an error when the lookback window is not positive",
+			lookback: 0,
+			horizon:  time.Hour,
+			setupFn:  func() { mocket.Catcher.Reset() },
+			wantErr:  true,
+		},
+		{
+			name:     "should return an error when the creation rates cannot be retrieved",
+			lookback: time.Hour,
+			horizon:  time.Hour,
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`FROM "kafka_creation_rates"`).WithQueryException()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			s := NewCapacityForecastService(db.NewMockConnectionFactory(nil), nil, nil, nil)
+			got, err := s.ForecastStreamingUnits(tt.lookback, tt.horizon)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func Test_capacityForecastService_GetCapacityReport(t *testing.T) {
+	g := gomega.NewWithT(t)
+	mocket.Catcher.Reset()
+	mocket.Catcher.NewMock().WithQuery(`FROM "kafka_creation_rates"`).WithReply([]map[string]interface{}{
+		{"cloud_provider": "aws", "region": "us-east-1", "instance_type": "standard", "observed_kafka_count": 168, "observed_streaming_units": 336},
+	})
+
+	limit := 100
+	providerConfig := &config.ProviderConfig{
+		ProvidersConfig: config.ProviderConfiguration{
+			SupportedProviders: config.ProviderList{
+				{
+					Name: "aws",
+					Regions: config.RegionList{
+						{
+							Name: "us-east-1",
+							SupportedInstanceTypes: config.InstanceTypeMap{
+								"standard":  {Limit: &limit, MinAvailableCapacitySlackStreamingUnits: 5},
+								"developer": {},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	clusterService := &ClusterServiceMock{
+		FindStreamingUnitCountByClusterAndInstanceTypeFunc: func() (KafkaStreamingUnitCountPerClusterList, error) {
+			return KafkaStreamingUnitCountPerClusterList{
+				{CloudProvider: "aws", Region: "us-east-1", InstanceType: "standard", ClusterType: api.ManagedDataPlaneClusterType.String(), Status: api.ClusterReady.String(), Count: 6, MaxUnits: 10},
+				{CloudProvider: "aws", Region: "us-east-1", InstanceType: "standard", ClusterType: api.ManagedDataPlaneClusterType.String(), Status: api.ClusterDeprovisioning.String(), Count: 1, MaxUnits: 10},
+				{CloudProvider: "aws", Region: "us-east-1", InstanceType: "standard", ClusterType: api.EnterpriseDataPlaneClusterType.String(), Status: api.ClusterReady.String(), Count: 1, MaxUnits: 10},
+				{CloudProvider: "aws", Region: "us-east-1", InstanceType: "developer", ClusterType: api.ManagedDataPlaneClusterType.String(), Status: api.ClusterProvisioning.String()},
+			}, nil
+		},
+	}
+	dataplaneClusterConfig := config.NewDataplaneClusterConfig()
+
+	s := NewCapacityForecastService(db.NewMockConnectionFactory(nil), clusterService, providerConfig, dataplaneClusterConfig)
+	report, err := s.GetCapacityReport()
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(report).To(gomega.Equal(&CapacityReport{
+		Horizon:  time.Hour,
+		Lookback: 168 * time.Hour,
+		Items: []CapacityReportItem{
+			{
+				StreamingUnitsForecast: StreamingUnitsForecast{CloudProvider: "aws", Region: "us-east-1", InstanceType: "developer"},
+				ScaleUpOngoing:         true,
+			},
+			{
+				StreamingUnitsForecast: StreamingUnitsForecast{
+					CloudProvider: "aws", Region: "us-east-1", InstanceType: "standard",
+					ObservedKafkaCount: 168, ObservedStreamingUnits: 336, ForecastedStreamingUnits: 2,
+				},
+				MaxStreamingUnits:                       10,
+				ConsumedStreamingUnits:                  6,
+				FreeStreamingUnits:                      4,
+				ProjectedFreeStreamingUnits:             2,
+				MinAvailableCapacitySlackStreamingUnits: 5,
+				Limit:                                   &limit,
+			},
+		},
+	}))
+}
+
+func Test_capacityForecastService_RecordKafkaCreation(t *testing.T) {
+	tests := []struct {
+		name    string
+		setupFn func()
+		wantErr bool
+	}{
+		{
+			name: "should add the kafka to the creation rate of the current window",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`ON CONFLICT ("cloud_provider","region","instance_type","window_start") DO UPDATE`).WithRowsNum(1)
+				mocket.Catcher.NewMock().WithQueryException().WithExecException()
+			},
+		},
+		{
+			name: "should return an error when the creation cannot be recorded",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_creation_rates"`).WithExecException()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			s := NewCapacityForecastService(db.NewMockConnectionFactory(nil), nil, nil, nil)
+			err := s.RecordKafkaCreation(buildKafkaRequest(nil), 2)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+		})
+	}
+}
@@ -0,0 +1,298 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	apiErrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type ClusterBlueprintPlanAction string
+
+func (a ClusterBlueprintPlanAction) String() string {
+	return string(a)
+}
+
+const (
+	// ClusterBlueprintPlanActionCreate - a new cluster is registered for the blueprint
+	ClusterBlueprintPlanActionCreate ClusterBlueprintPlanAction = "create"
+	// ClusterBlueprintPlanActionUpdate - the supported instance types and provider spec of a cluster are brought in line with the blueprint.
+	// The step is planned until the changes are applied to the cluster in its provider
+	ClusterBlueprintPlanActionUpdate ClusterBlueprintPlanAction = "update"
+	// ClusterBlueprintPlanActionRetire - an excess cluster is deprovisioned
+	ClusterBlueprintPlanActionRetire ClusterBlueprintPlanAction = "retire"
+)
+
+// clusterStatusesNotCountedByBlueprints are the statuses of the clusters that no longer count toward the cluster count of their blueprint
+var clusterStatusesNotCountedByBlueprints = []string{api.ClusterFailed.String(), api.ClusterDeprovisioning.String(), api.ClusterCleanup.String()}
+
+type ClusterBlueprintPlanStep struct {
+	Action ClusterBlueprintPlanAction
+	// Cluster is the cluster the step applies to. It is nil for create steps
+	Cluster *api.Cluster
+	Reason  string
+	// Blocked is true when the step cannot be taken yet, i.e. when the cluster to retire still has Kafka instances
+	Blocked bool
+}
+
+// ClusterBlueprintPlan lists the steps that bring the clusters of a blueprint in line with it
+type ClusterBlueprintPlan struct {
+	Blueprint           *dbapi.ClusterBlueprint
+	CurrentClusterCount int
+	Steps               []ClusterBlueprintPlanStep
+}
+
+//go:generate moq -out cluster_blueprint_moq.go . ClusterBlueprintService
+type ClusterBlueprintService interface {
+	// Create stores a new cluster blueprint. A Conflict error is returned when a blueprint already exists for its cloud provider's region
+	Create(blueprint *dbapi.ClusterBlueprint) *apiErrors.ServiceError
+	// Get returns the cluster blueprint with the given id
+	Get(id string) (*dbapi.ClusterBlueprint, *apiErrors.ServiceError)
+	// List returns all the cluster blueprints ordered by cloud provider and region
+	List() (dbapi.ClusterBlueprintList, *apiErrors.ServiceError)
+	// Update saves the given cluster blueprint
+	Update(blueprint *dbapi.ClusterBlueprint) *apiErrors.ServiceError
+	// Delete removes the cluster blueprint with the given id. Its clusters are kept but are no longer managed by a blueprint
+	Delete(id string) *apiErrors.ServiceError
+	// Plan computes the steps needed to bring the clusters of the given blueprint in line with it
+	Plan(blueprint *dbapi.ClusterBlueprint) (*ClusterBlueprintPlan, *apiErrors.ServiceError)
+}
+
+var _ ClusterBlueprintService = &clusterBlueprintService{}
+
+type clusterBlueprintService struct {
+	connectionFactory *db.ConnectionFactory
+	clusterService    ClusterService
+}
+
+func NewClusterBlueprintService(connectionFactory *db.ConnectionFactory, clusterService ClusterService) ClusterBlueprintService {
+	return &clusterBlueprintService{
+		connectionFactory: connectionFactory,
+		clusterService:    clusterService,
+	}
+}
+
+func (s *clusterBlueprintService) Create(blueprint *dbapi.ClusterBlueprint) *apiErrors.ServiceError {
+	var count int64
+	if err := s.connectionFactory.New().
+		Model(&dbapi.ClusterBlueprint{}).
+		Where("cloud_provider = ? AND region = ?", blueprint.CloudProvider, blueprint.Region).
+		Count(&count).Error; err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to check for existing cluster blueprints")
+	}
+	if count > 0 {
+		return apiErrors.Conflict("a cluster blueprint already exists for region %q of cloud provider %q", blueprint.Region, blueprint.CloudProvider)
+	}
+
+	if err := s.connectionFactory.New().Create(blueprint).Error; err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to create cluster blueprint for region %q of cloud provider %q", blueprint.Region, blueprint.CloudProvider)
+	}
+	return nil
+}
+
+func (s *clusterBlueprintService) Get(id string) (*dbapi.ClusterBlueprint, *apiErrors.ServiceError) {
+	var blueprint dbapi.ClusterBlueprint
+	if err := s.connectionFactory.New().Where("id = ?", id).First(&blueprint).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apiErrors.NotFound("cluster blueprint with id %q not found", id)
+		}
+		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to get cluster blueprint with id %q", id)
+	}
+	return &blueprint, nil
+}
+
+func (s *clusterBlueprintService) List() (dbapi.ClusterBlueprintList, *apiErrors.ServiceError) {
+	var blueprints dbapi.ClusterBlueprintList
+	if err := s.connectionFactory.New().Order("cloud_provider, region").Find(&blueprints).Error; err != nil {
+		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to list cluster blueprints")
+	}
+	return blueprints, nil
+}
+
+func (s *clusterBlueprintService) Update(blueprint *dbapi.ClusterBlueprint) *apiErrors.ServiceError {
+	if blueprint.ID == "" {
+		return apiErrors.Validation("id is undefined")
+	}
+	if err := s.connectionFactory.New().Save(blueprint).Error; err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to update cluster blueprint with id %q", blueprint.ID)
+	}
+	return nil
+}
+
+func (s *clusterBlueprintService) Delete(id string) *apiErrors.ServiceError {
+	blueprint, svcErr := s.Get(id)
+	if svcErr != nil {
+		return svcErr
+	}
+
+	err := s.connectionFactory.New().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&api.Cluster{}).
+			Where("cluster_blueprint_id = ?", blueprint.ID).
+			Update("cluster_blueprint_id", "").Error; err != nil {
+			return err
+		}
+		return tx.Delete(blueprint).Error
+	})
+	if err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to delete cluster blueprint with id %q", id)
+	}
+	return nil
+}
+
+func (s *clusterBlueprintService) Plan(blueprint *dbapi.ClusterBlueprint) (*ClusterBlueprintPlan, *apiErrors.ServiceError) {
+	var clusters []*api.Cluster
+	if err := s.connectionFactory.New().
+		Where("cluster_blueprint_id = ?", blueprint.ID).
+		Where("status NOT IN (?)", clusterStatusesNotCountedByBlueprints).
+		Order("created_at asc").
+		Find(&clusters).Error; err != nil {
+		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to list the clusters of cluster blueprint %q", blueprint.ID)
+	}
+
+	streamingUnitsPerCluster, svcErr := s.findStreamingUnitsPerCluster(clusters)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	desiredProviderSpec, err := buildClusterBlueprintProviderSpec(blueprint)
+	if err != nil {
+		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to build the provider spec of cluster blueprint %q", blueprint.ID)
+	}
+	desiredInstanceTypes := strings.Join(blueprint.GetSupportedInstanceTypes(), ",")
+
+	plan := &ClusterBlueprintPlan{
+		Blueprint:           blueprint,
+		CurrentClusterCount: len(clusters),
+		Steps:               []ClusterBlueprintPlanStep{},
+	}
+
+	countReason := fmt.Sprintf("the blueprint desires %d clusters and %d exist", blueprint.ClusterCount, len(clusters))
+	for i := len(clusters); i < blueprint.ClusterCount; i++ {
+		plan.Steps = append(plan.Steps, ClusterBlueprintPlanStep{Action: ClusterBlueprintPlanActionCreate, Reason: countReason})
+	}
+
+	retired := map[string]bool{}
+	for _, cluster := range selectClustersToRetire(clusters, streamingUnitsPerCluster, len(clusters)-blueprint.ClusterCount) {
+		retired[cluster.ID] = true
+		step := ClusterBlueprintPlanStep{Action: ClusterBlueprintPlanActionRetire, Cluster: cluster, Reason: countReason}
+		if streamingUnits := streamingUnitsPerCluster[cluster.ClusterID]; streamingUnits > 0 {
+			step.Blocked = true
+			step.Reason = fmt.Sprintf("%s, but the cluster still hosts Kafka instances consuming %d streaming units", countReason, streamingUnits)
+		}
+		plan.Steps = append(plan.Steps, step)
+	}
+
+	for _, cluster := range clusters {
+		if retired[cluster.ID] {
+			continue
+		}
+
+		var reasons []string
+		if currentInstanceTypes := strings.Join(cluster.GetSupportedInstanceTypes(), ","); currentInstanceTypes != desiredInstanceTypes {
+			reasons = append(reasons, fmt.Sprintf("the supported instance types change from %q to %q", currentInstanceTypes, desiredInstanceTypes))
+		}
+		currentProviderSpec, err := normalizeClusterProviderSpec(cluster.ProviderSpec)
+		if err != nil {
+			return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to parse the provider spec of cluster %q", cluster.ID)
+		}
+		if currentProviderSpec != desiredProviderSpec {
+			reasons = append(reasons, "the provider spec is outdated")
+		}
+
+		if len(reasons) > 0 {
+			plan.Steps = append(plan.Steps, ClusterBlueprintPlanStep{
+				Action:  ClusterBlueprintPlanActionUpdate,
+				Cluster: cluster,
+				Reason:  strings.Join(reasons, ", "),
+			})
+		}
+	}
+
+	return plan, nil
+}
+
+// findStreamingUnitsPerCluster returns the streaming units consumed on each of the given clusters, indexed by cluster id.
+// Clusters not yet created in their provider don't have a cluster id and cannot host Kafka instances
+func (s *clusterBlueprintService) findStreamingUnitsPerCluster(clusters []*api.Cluster) (map[string]int, *apiErrors.ServiceError) {
+	streamingUnitsPerCluster := map[string]int{}
+	var clusterIDs []string
+	for _, cluster := range clusters {
+		if cluster.ClusterID != "" {
+			clusterIDs = append(clusterIDs, cluster.ClusterID)
+		}
+	}
+	if len(clusterIDs) == 0 {
+		return streamingUnitsPerCluster, nil
+	}
+
+	counts, err := s.clusterService.FindKafkaInstanceCount(clusterIDs)
+	if err != nil {
+		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to count the Kafka instances of the clusters")
+	}
+	for _, count := range counts {
+		streamingUnitsPerCluster[count.ClusterID] = count.Count
+	}
+	return streamingUnitsPerCluster, nil
+}
+
+// selectClustersToRetire picks the given number of clusters to retire, preferring the empty ones and then the most recent ones.
+// Clusters not yet created in their provider cannot be deprovisioned and are never picked
+func selectClustersToRetire(clusters []*api.Cluster, streamingUnitsPerCluster map[string]int, count int) []*api.Cluster {
+	if count <= 0 {
+		return nil
+	}
+
+	var candidates []*api.Cluster
+	for _, cluster := range clusters {
+		if cluster.ClusterID != "" {
+			candidates = append(candidates, cluster)
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		iEmpty := streamingUnitsPerCluster[candidates[i].ClusterID] == 0
+		jEmpty := streamingUnitsPerCluster[candidates[j].ClusterID] == 0
+		if iEmpty != jEmpty {
+			return iEmpty
+		}
+		return candidates[i].CreatedAt.After(candidates[j].CreatedAt)
+	})
+
+	if count > len(candidates) {
+		count = len(candidates)
+	}
+	return candidates[:count]
+}
+
+func buildClusterBlueprintProviderSpec(blueprint *dbapi.ClusterBlueprint) (string, error) {
+	spec, err := blueprint.BuildClusterProviderSpec()
+	if err != nil {
+		return "", err
+	}
+	b, err := json.Marshal(spec)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// normalizeClusterProviderSpec re-encodes the stored provider spec so that it can be compared with the one built from a blueprint
+func normalizeClusterProviderSpec(providerSpec api.JSON) (string, error) {
+	spec, err := types.ParseClusterProviderSpec(providerSpec)
+	if err != nil {
+		return "", err
+	}
+	b, err := json.Marshal(spec)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
@@ -0,0 +1,289 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package services
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	serviceError "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"sync"
+)
+
+// Ensure, that ClusterBlueprintServiceMock does implement ClusterBlueprintService.
+// If this is not the case, regenerate this file with moq.
+var _ ClusterBlueprintService = &ClusterBlueprintServiceMock{}
+
+// ClusterBlueprintServiceMock is a mock implementation of ClusterBlueprintService.
+//
+//	func TestSomethingThatUsesClusterBlueprintService(t *testing.T) {
+//
+//		// make and configure a mocked ClusterBlueprintService
+//		mockedClusterBlueprintService := &ClusterBlueprintServiceMock{
+//			CreateFunc: func(blueprint *dbapi.ClusterBlueprint) *serviceError.ServiceError {
+//				panic("mock out the Create method")
+//			},
+//			DeleteFunc: func(id string) *serviceError.ServiceError {
+//				panic("mock out the Delete method")
+//			},
+//			GetFunc: func(id string) (*dbapi.ClusterBlueprint, *serviceError.ServiceError) {
+//				panic("mock out the Get method")
+//			},
+//			ListFunc: func() (dbapi.ClusterBlueprintList, *serviceError.ServiceError) {
+//				panic("mock out the List method")
+//			},
+//			PlanFunc: func(blueprint *dbapi.ClusterBlueprint) (*ClusterBlueprintPlan, *serviceError.ServiceError) {
+//				panic("mock out the Plan method")
+//			},
+//			UpdateFunc: func(blueprint *dbapi.ClusterBlueprint) *serviceError.ServiceError {
+//				panic("mock out the Update method")
+//			},
+//		}
+//
+//		// use mockedClusterBlueprintService in code that requires ClusterBlueprintService
+//		// and then make assertions.
+//
+//	}
+type ClusterBlueprintServiceMock struct {
+	// CreateFunc mocks the Create method.
+	CreateFunc func(blueprint *dbapi.ClusterBlueprint) *serviceError.ServiceError
+
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(id string) *serviceError.ServiceError
+
+	// GetFunc mocks the Get method.
+	GetFunc func(id string) (*dbapi.ClusterBlueprint, *serviceError.ServiceError)
+
+	// ListFunc mocks the List method.
+	ListFunc func() (dbapi.ClusterBlueprintList, *serviceError.ServiceError)
+
+	// PlanFunc mocks the Plan method.
+	PlanFunc func(blueprint *dbapi.ClusterBlueprint) (*ClusterBlueprintPlan, *serviceError.ServiceError)
+
+	// UpdateFunc mocks the Update method.
+	UpdateFunc func(blueprint *dbapi.ClusterBlueprint) *serviceError.ServiceError
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Blueprint is the blueprint argument value.
+			Blueprint *dbapi.ClusterBlueprint
+		}
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// ID is the id argument value.
+			ID string
+		}
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// ID is the id argument value.
+			ID string
+		}
+		// List holds details about calls to the List method.
+		List []struct {
+		}
+		// Plan holds details about calls to the Plan method.
+		Plan []struct {
+			// Blueprint is the blueprint argument value.
+			Blueprint *dbapi.ClusterBlueprint
+		}
+		// Update holds details about calls to the Update method.
+		Update []struct {
+			// Blueprint is the blueprint argument value.
+			Blueprint *dbapi.ClusterBlueprint
+		}
+	}
+	lockCreate sync.RWMutex
+	lockDelete sync.RWMutex
+	lockGet    sync.RWMutex
+	lockList   sync.RWMutex
+	lockPlan   sync.RWMutex
+	lockUpdate sync.RWMutex
+}
+
+// Create calls CreateFunc.
+func (mock *ClusterBlueprintServiceMock) Create(blueprint *dbapi.ClusterBlueprint) *serviceError.ServiceError {
+	if mock.CreateFunc == nil {
+		panic("ClusterBlueprintServiceMock.CreateFunc: method is nil but ClusterBlueprintService.Create was just called")
+	}
+	callInfo := struct {
+		Blueprint *dbapi.ClusterBlueprint
+	}{
+		Blueprint: blueprint,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(blueprint)
+}
+
+// CreateCalls gets all the calls that were made to Create.
+// Check the length with:
+//
+//	len(mockedClusterBlueprintService.CreateCalls())
+func (mock *ClusterBlueprintServiceMock) CreateCalls() []struct {
+	Blueprint *dbapi.ClusterBlueprint
+} {
+	var calls []struct {
+		Blueprint *dbapi.ClusterBlueprint
+	}
+	mock.lockCreate.RLock()
+	calls = mock.calls.Create
+	mock.lockCreate.RUnlock()
+	return calls
+}
+
+// Delete calls DeleteFunc.
+func (mock *ClusterBlueprintServiceMock) Delete(id string) *serviceError.ServiceError {
+	if mock.DeleteFunc == nil {
+		panic("ClusterBlueprintServiceMock.DeleteFunc: method is nil but ClusterBlueprintService.Delete was just called")
+	}
+	callInfo := struct {
+		ID string
+	}{
+		ID: id,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(id)
+}
+
+// DeleteCalls gets all the calls that were made to Delete.
+// Check the length with:
+//
+//	len(mockedClusterBlueprintService.DeleteCalls())
+func (mock *ClusterBlueprintServiceMock) DeleteCalls() []struct {
+	ID string
+} {
+	var calls []struct {
+		ID string
+	}
+	mock.lockDelete.RLock()
+	calls = mock.calls.Delete
+	mock.lockDelete.RUnlock()
+	return calls
+}
+
+// Get calls GetFunc.
+func (mock *ClusterBlueprintServiceMock) Get(id string) (*dbapi.ClusterBlueprint, *serviceError.ServiceError) {
+	if mock.GetFunc == nil {
+		panic("ClusterBlueprintServiceMock.GetFunc: method is nil but ClusterBlueprintService.Get was just called")
+	}
+	callInfo := struct {
+		ID string
+	}{
+		ID: id,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(id)
+}
+
+// GetCalls gets all the calls that were made to Get.
+// Check the length with:
+//
+//	len(mockedClusterBlueprintService.GetCalls())
+func (mock *ClusterBlueprintServiceMock) GetCalls() []struct {
+	ID string
+} {
+	var calls []struct {
+		ID string
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// List calls ListFunc.
+func (mock *ClusterBlueprintServiceMock) List() (dbapi.ClusterBlueprintList, *serviceError.ServiceError) {
+	if mock.ListFunc == nil {
+		panic("ClusterBlueprintServiceMock.ListFunc: method is nil but ClusterBlueprintService.List was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc()
+}
+
+// ListCalls gets all the calls that were made to List.
+// Check the length with:
+//
+//	len(mockedClusterBlueprintService.ListCalls())
+func (mock *ClusterBlueprintServiceMock) ListCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockList.RLock()
+	calls = mock.calls.List
+	mock.lockList.RUnlock()
+	return calls
+}
+
+// Plan calls PlanFunc.
+func (mock *ClusterBlueprintServiceMock) Plan(blueprint *dbapi.ClusterBlueprint) (*ClusterBlueprintPlan, *serviceError.ServiceError) {
+	if mock.PlanFunc == nil {
+		panic("ClusterBlueprintServiceMock.PlanFunc: method is nil but ClusterBlueprintService.Plan was just called")
+	}
+	callInfo := struct {
+		Blueprint *dbapi.ClusterBlueprint
+	}{
+		Blueprint: blueprint,
+	}
+	mock.lockPlan.Lock()
+	mock.calls.Plan = append(mock.calls.Plan, callInfo)
+	mock.lockPlan.Unlock()
+	return mock.PlanFunc(blueprint)
+}
+
+// PlanCalls gets all the calls that were made to Plan.
+// Check the length with:
+//
+//	len(mockedClusterBlueprintService.PlanCalls())
+func (mock *ClusterBlueprintServiceMock) PlanCalls() []struct {
+	Blueprint *dbapi.ClusterBlueprint
+} {
+	var calls []struct {
+		Blueprint *dbapi.ClusterBlueprint
+	}
+	mock.lockPlan.RLock()
+	calls = mock.calls.Plan
+	mock.lockPlan.RUnlock()
+	return calls
+}
+
+// Update calls UpdateFunc.
+func (mock *ClusterBlueprintServiceMock) Update(blueprint *dbapi.ClusterBlueprint) *serviceError.ServiceError {
+	if mock.UpdateFunc == nil {
+		panic("ClusterBlueprintServiceMock.UpdateFunc: method is nil but ClusterBlueprintService.Update was just called")
+	}
+	callInfo := struct {
+		Blueprint *dbapi.ClusterBlueprint
+	}{
+		Blueprint: blueprint,
+	}
+	mock.lockUpdate.Lock()
+	mock.calls.Update = append(mock.calls.Update, callInfo)
+	mock.lockUpdate.Unlock()
+	return mock.UpdateFunc(blueprint)
+}
+
+// UpdateCalls gets all the calls that were made to Update.
+// Check the length with:
+//
+//	len(mockedClusterBlueprintService.UpdateCalls())
+func (mock *ClusterBlueprintServiceMock) UpdateCalls() []struct {
+	Blueprint *dbapi.ClusterBlueprint
+} {
+	var calls []struct {
+		Blueprint *dbapi.ClusterBlueprint
+	}
+	mock.lockUpdate.RLock()
+	calls = mock.calls.Update
+	mock.lockUpdate.RUnlock()
+	return calls
+}
@@ -0,0 +1,258 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	apiErrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_clusterBlueprintService_Plan(t *testing.T) {
+	now := time.Now()
+	blueprint := func(clusterCount int, openShiftVersion string) *dbapi.ClusterBlueprint {
+		return &dbapi.ClusterBlueprint{
+			Meta:                  api.Meta{ID: "blueprint-id"},
+			CloudProvider:         "aws",
+			Region:                "us-east-1",
+			ClusterCount:          clusterCount,
+			SupportedInstanceType: "standard",
+			OpenShiftVersion:      openShiftVersion,
+		}
+	}
+	clusterRow := func(id, clusterID string, status api.ClusterStatus, createdAt time.Time) map[string]interface{} {
+		return map[string]interface{}{
+			"id":                      id,
+			"cluster_id":              clusterID,
+			"status":                  status.String(),
+			"supported_instance_type": "standard",
+			"cluster_blueprint_id":    "blueprint-id",
+			"created_at":              createdAt,
+		}
+	}
+	kafkaInstanceCount := func(counts map[string]int) func([]string) ([]ResKafkaInstanceCount, error) {
+		return func(clusterIDs []string) ([]ResKafkaInstanceCount, error) {
+			var res []ResKafkaInstanceCount
+			for _, clusterID := range clusterIDs {
+				res = append(res, ResKafkaInstanceCount{ClusterID: clusterID, Count: counts[clusterID]})
+			}
+			return res, nil
+		}
+	}
+
+	type want struct {
+		actions   []ClusterBlueprintPlanAction
+		clusterID []string
+		blocked   []bool
+	}
+	tests := []struct {
+		name           string
+		blueprint      *dbapi.ClusterBlueprint
+		clusterService ClusterService
+		setupFn        func()
+		want           want
+		wantErr        bool
+	}{
+		{
+			name:           "should plan the creation of the missing clusters",
+			blueprint:      blueprint(2, ""),
+			clusterService: &ClusterServiceMock{},
+			setupFn: func() {
+				mocket.Catcher.NewMock().WithQuery(`FROM "clusters"`).WithReply([]map[string]interface{}{})
+			},
+			want: want{
+				actions:   []ClusterBlueprintPlanAction{ClusterBlueprintPlanActionCreate, ClusterBlueprintPlanActionCreate},
+				clusterID: []string{"", ""},
+				blocked:   []bool{false, false},
+			},
+		},
+		{
+			name:      "should plan the retirement of the excess clusters, empty and most recent ones first",
+			blueprint: blueprint(1, ""),
+			clusterService: &ClusterServiceMock{
+				FindKafkaInstanceCountFunc: kafkaInstanceCount(map[string]int{"cluster-2": 3}),
+			},
+			setupFn: func() {
+				mocket.Catcher.NewMock().WithQuery(`FROM "clusters"`).WithReply([]map[string]interface{}{
+					clusterRow("1", "cluster-1", api.ClusterReady, now.Add(-3*time.Hour)),
+					clusterRow("2", "cluster-2", api.ClusterReady, now.Add(-2*time.Hour)),
+					clusterRow("3", "cluster-3", api.ClusterReady, now.Add(-1*time.Hour)),
+				})
+			},
+			want: want{
+				actions:   []ClusterBlueprintPlanAction{ClusterBlueprintPlanActionRetire, ClusterBlueprintPlanActionRetire},
+				clusterID: []string{"cluster-3", "cluster-1"},
+				blocked:   []bool{false, false},
+			},
+		},
+		{
+			name:      "should block the retirement of clusters hosting Kafka instances",
+			blueprint: blueprint(1, ""),
+			clusterService: &ClusterServiceMock{
+				FindKafkaInstanceCountFunc: kafkaInstanceCount(map[string]int{"cluster-1": 1, "cluster-2": 2}),
+			},
+			setupFn: func() {
+				mocket.Catcher.NewMock().WithQuery(`FROM "clusters"`).WithReply([]map[string]interface{}{
+					clusterRow("1", "cluster-1", api.ClusterReady, now.Add(-2*time.Hour)),
+					clusterRow("2", "cluster-2", api.ClusterReady, now.Add(-1*time.Hour)),
+				})
+			},
+			want: want{
+				actions:   []ClusterBlueprintPlanAction{ClusterBlueprintPlanActionRetire},
+				clusterID: []string{"cluster-2"},
+				blocked:   []bool{true},
+			},
+		},
+		{
+			name:           "should not retire clusters not yet created in their provider",
+			blueprint:      blueprint(0, ""),
+			clusterService: &ClusterServiceMock{},
+			setupFn: func() {
+				mocket.Catcher.NewMock().WithQuery(`FROM "clusters"`).WithReply([]map[string]interface{}{
+					clusterRow("1", "", api.ClusterAccepted, now),
+				})
+			},
+			want: want{},
+		},
+		{
+			name:      "should plan the update of clusters with an outdated provider spec",
+			blueprint: blueprint(1, "openshift-v4.11.22"),
+			clusterService: &ClusterServiceMock{
+				FindKafkaInstanceCountFunc: kafkaInstanceCount(nil),
+			},
+			setupFn: func() {
+				mocket.Catcher.NewMock().WithQuery(`FROM "clusters"`).WithReply([]map[string]interface{}{
+					clusterRow("1", "cluster-1", api.ClusterReady, now),
+				})
+			},
+			want: want{
+				actions:   []ClusterBlueprintPlanAction{ClusterBlueprintPlanActionUpdate},
+				clusterID: []string{"cluster-1"},
+				blocked:   []bool{false},
+			},
+		},
+		{
+			name:      "should plan nothing when the clusters are in line with the blueprint",
+			blueprint: blueprint(1, ""),
+			clusterService: &ClusterServiceMock{
+				FindKafkaInstanceCountFunc: kafkaInstanceCount(nil),
+			},
+			setupFn: func() {
+				mocket.Catcher.NewMock().WithQuery(`FROM "clusters"`).WithReply([]map[string]interface{}{
+					clusterRow("1", "cluster-1", api.ClusterReady, now),
+				})
+			},
+			want: want{},
+		},
+		{
+			name:      "should return an error when the Kafka instances of the clusters cannot be counted",
+			blueprint: blueprint(1, ""),
+			clusterService: &ClusterServiceMock{
+				FindKafkaInstanceCountFunc: func(clusterIDs []string) ([]ResKafkaInstanceCount, error) {
+					return nil, apiErrors.GeneralError("failed to count kafkas")
+				},
+			},
+			setupFn: func() {
+				mocket.Catcher.NewMock().WithQuery(`FROM "clusters"`).WithReply([]map[string]interface{}{
+					clusterRow("1", "cluster-1", api.ClusterReady, now),
+				})
+			},
+			wantErr: true,
+		},
+		{
+			name:           "should return an error when the clusters of the blueprint cannot be listed",
+			blueprint:      blueprint(1, ""),
+			clusterService: &ClusterServiceMock{},
+			setupFn: func() {
+				mocket.Catcher.NewMock().WithQuery(`FROM "clusters"`).WithQueryException()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mocket.Catcher.Reset()
+			tt.setupFn()
+
+			s := NewClusterBlueprintService(db.NewMockConnectionFactory(nil), tt.clusterService)
+			plan, err := s.Plan(tt.blueprint)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				return
+			}
+
+			var actions []ClusterBlueprintPlanAction
+			var clusterIDs []string
+			var blocked []bool
+			for _, step := range plan.Steps {
+				actions = append(actions, step.Action)
+				blocked = append(blocked, step.Blocked)
+				if step.Cluster != nil {
+					clusterIDs = append(clusterIDs, step.Cluster.ClusterID)
+				} else {
+					clusterIDs = append(clusterIDs, "")
+				}
+			}
+			g.Expect(actions).To(gomega.Equal(tt.want.actions))
+			g.Expect(clusterIDs).To(gomega.Equal(tt.want.clusterID))
+			g.Expect(blocked).To(gomega.Equal(tt.want.blocked))
+		})
+	}
+}
+
+func Test_clusterBlueprintService_Create(t *testing.T) {
+	tests := []struct {
+		name     string
+		setupFn  func()
+		wantCode apiErrors.ServiceErrorCode
+		wantErr  bool
+	}{
+		{
+			name: "should create the blueprint",
+			setupFn: func() {
+				mocket.Catcher.NewMock().WithQuery(`SELECT count(1) FROM "cluster_blueprints"`).WithReply([]map[string]interface{}{{"count": 0}})
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "cluster_blueprints"`).WithRowsNum(1)
+			},
+		},
+		{
+			name: "should return a conflict error when a blueprint already exists for the region",
+			setupFn: func() {
+				mocket.Catcher.NewMock().WithQuery(`SELECT count(1) FROM "cluster_blueprints"`).WithReply([]map[string]interface{}{{"count": 1}})
+			},
+			wantErr:  true,
+			wantCode: apiErrors.ErrorConflict,
+		},
+		{
+			name: "should return an error when the blueprint cannot be stored",
+			setupFn: func() {
+				mocket.Catcher.NewMock().WithQuery(`SELECT count(1) FROM "cluster_blueprints"`).WithReply([]map[string]interface{}{{"count": 0}})
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "cluster_blueprints"`).WithExecException()
+			},
+			wantErr:  true,
+			wantCode: apiErrors.ErrorGeneral,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mocket.Catcher.Reset()
+			tt.setupFn()
+
+			s := NewClusterBlueprintService(db.NewMockConnectionFactory(nil), nil)
+			err := s.Create(&dbapi.ClusterBlueprint{CloudProvider: "aws", Region: "us-east-1", ClusterCount: 1, SupportedInstanceType: "standard"})
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				g.Expect(err.Code).To(gomega.Equal(tt.wantCode))
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package services
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	apiErrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// allowedClusterConsolidationPlanTransitions lists the statuses a consolidation plan can move to from each status
+var allowedClusterConsolidationPlanTransitions = map[dbapi.ClusterConsolidationPlanStatus][]dbapi.ClusterConsolidationPlanStatus{
+	dbapi.ClusterConsolidationPlanProposed: {dbapi.ClusterConsolidationPlanApproved, dbapi.ClusterConsolidationPlanRejected},
+	dbapi.ClusterConsolidationPlanApproved: {dbapi.ClusterConsolidationPlanCompleted, dbapi.ClusterConsolidationPlanExpired},
+}
+
+//go:generate moq -out cluster_consolidation_plan_moq.go . ClusterConsolidationPlanService
+type ClusterConsolidationPlanService interface {
+	// Propose stores a new consolidation plan waiting for approval
+	Propose(plan *dbapi.ClusterConsolidationPlan) *apiErrors.ServiceError
+	// Get returns the consolidation plan with the given id
+	Get(id string) (*dbapi.ClusterConsolidationPlan, *apiErrors.ServiceError)
+	// List returns the consolidation plans in any of the given statuses, most recent first. All the plans are returned when no status is given
+	List(statuses ...dbapi.ClusterConsolidationPlanStatus) (dbapi.ClusterConsolidationPlanList, *apiErrors.ServiceError)
+	// UpdateStatus moves the consolidation plan with the given id to the given status.
+	// A Conflict error is returned when the plan cannot move from its current status to the given one
+	UpdateStatus(id string, status dbapi.ClusterConsolidationPlanStatus) (*dbapi.ClusterConsolidationPlan, *apiErrors.ServiceError)
+	// UpdateRetainedKafkas stores the Kafka instances retained on the cluster of the given plan
+	UpdateRetainedKafkas(plan *dbapi.ClusterConsolidationPlan) *apiErrors.ServiceError
+}
+
+var _ ClusterConsolidationPlanService = &clusterConsolidationPlanService{}
+
+type clusterConsolidationPlanService struct {
+	connectionFactory *db.ConnectionFactory
+}
+
+func NewClusterConsolidationPlanService(connectionFactory *db.ConnectionFactory) ClusterConsolidationPlanService {
+	return &clusterConsolidationPlanService{
+		connectionFactory: connectionFactory,
+	}
+}
+
+func (s *clusterConsolidationPlanService) Propose(plan *dbapi.ClusterConsolidationPlan) *apiErrors.ServiceError {
+	plan.Status = dbapi.ClusterConsolidationPlanProposed
+	if err := s.connectionFactory.New().Create(plan).Error; err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to propose consolidation plan for cluster %q", plan.ClusterID)
+	}
+	return nil
+}
+
+func (s *clusterConsolidationPlanService) Get(id string) (*dbapi.ClusterConsolidationPlan, *apiErrors.ServiceError) {
+	var plan dbapi.ClusterConsolidationPlan
+	if err := s.connectionFactory.New().Where("id = ?", id).First(&plan).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apiErrors.NotFound("consolidation plan with id %q not found", id)
+		}
+		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to get consolidation plan with id %q", id)
+	}
+	return &plan, nil
+}
+
+func (s *clusterConsolidationPlanService) List(statuses ...dbapi.ClusterConsolidationPlanStatus) (dbapi.ClusterConsolidationPlanList, *apiErrors.ServiceError) {
+	dbConn := s.connectionFactory.New()
+	if len(statuses) > 0 {
+		dbConn = dbConn.Where("status IN (?)", statuses)
+	}
+
+	var plans dbapi.ClusterConsolidationPlanList
+	if err := dbConn.Order("created_at DESC").Find(&plans).Error; err != nil {
+		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to list consolidation plans")
+	}
+	return plans, nil
+}
+
+func (s *clusterConsolidationPlanService) UpdateStatus(id string, status dbapi.ClusterConsolidationPlanStatus) (*dbapi.ClusterConsolidationPlan, *apiErrors.ServiceError) {
+	plan, svcErr := s.Get(id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	if !isClusterConsolidationPlanTransitionAllowed(plan.Status, status) {
+		return nil, apiErrors.Conflict("consolidation plan with id %q cannot move from status %q to %q", id, plan.Status, status)
+	}
+
+	updates := map[string]interface{}{"status": status}
+	var approvedAt time.Time
+	if status == dbapi.ClusterConsolidationPlanApproved {
+		approvedAt = time.Now()
+		updates["approved_at"] = approvedAt
+	}
+
+	// only update the plan if its status has not been changed concurrently
+	result := s.connectionFactory.New().
+		Model(&dbapi.ClusterConsolidationPlan{}).
+		Where("id = ? AND status = ?", id, plan.Status).
+		Updates(updates)
+	if result.Error != nil {
+		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, result.Error, "failed to update status of consolidation plan with id %q", id)
+	}
+	if result.RowsAffected == 0 {
+		return nil, apiErrors.Conflict("status of consolidation plan with id %q has been changed concurrently", id)
+	}
+
+	plan.Status = status
+	if status == dbapi.ClusterConsolidationPlanApproved {
+		plan.ApprovedAt = &approvedAt
+	}
+	return plan, nil
+}
+
+func (s *clusterConsolidationPlanService) UpdateRetainedKafkas(plan *dbapi.ClusterConsolidationPlan) *apiErrors.ServiceError {
+	if err := s.connectionFactory.New().
+		Model(&dbapi.ClusterConsolidationPlan{}).
+		Where("id = ?", plan.ID).
+		Update("retained_kafkas", plan.RetainedKafkas).Error; err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to update retained kafkas of consolidation plan with id %q", plan.ID)
+	}
+	return nil
+}
+
+func isClusterConsolidationPlanTransitionAllowed(from, to dbapi.ClusterConsolidationPlanStatus) bool {
+	for _, allowed := range allowedClusterConsolidationPlanTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
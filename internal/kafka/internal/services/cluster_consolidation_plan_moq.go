@@ -0,0 +1,258 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package services
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	serviceError "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"sync"
+)
+
+// Ensure, that ClusterConsolidationPlanServiceMock does implement ClusterConsolidationPlanService.
+// If this is not the case, regenerate this file with moq.
+var _ ClusterConsolidationPlanService = &ClusterConsolidationPlanServiceMock{}
+
+// ClusterConsolidationPlanServiceMock is a mock implementation of ClusterConsolidationPlanService.
+//
+//	func TestSomethingThatUsesClusterConsolidationPlanService(t *testing.T) {
+//
+//		// make and configure a mocked ClusterConsolidationPlanService
+//		mockedClusterConsolidationPlanService := &ClusterConsolidationPlanServiceMock{
+//			GetFunc: func(id string) (*dbapi.ClusterConsolidationPlan, *serviceError.ServiceError) {
+//				panic("mock out the Get method")
+//			},
+//			ListFunc: func(statuses ...dbapi.ClusterConsolidationPlanStatus) (dbapi.ClusterConsolidationPlanList, *serviceError.ServiceError) {
+//				panic("mock out the List method")
+//			},
+//			ProposeFunc: func(plan *dbapi.ClusterConsolidationPlan) *serviceError.ServiceError {
+//				panic("mock out the Propose method")
+//			},
+//			UpdateRetainedKafkasFunc: func(plan *dbapi.ClusterConsolidationPlan) *serviceError.ServiceError {
+//				panic("mock out the UpdateRetainedKafkas method")
+//			},
+//			UpdateStatusFunc: func(id string, status dbapi.ClusterConsolidationPlanStatus) (*dbapi.ClusterConsolidationPlan, *serviceError.ServiceError) {
+//				panic("mock out the UpdateStatus method")
+//			},
+//		}
+//
+//		// use mockedClusterConsolidationPlanService in code that requires ClusterConsolidationPlanService
+//		// and then make assertions.
+//
+//	}
+type ClusterConsolidationPlanServiceMock struct {
+	// GetFunc mocks the Get method.
+	GetFunc func(id string) (*dbapi.ClusterConsolidationPlan, *serviceError.ServiceError)
+
+	// ListFunc mocks the List method.
+	ListFunc func(statuses ...dbapi.ClusterConsolidationPlanStatus) (dbapi.ClusterConsolidationPlanList, *serviceError.ServiceError)
+
+	// ProposeFunc mocks the Propose method.
+	ProposeFunc func(plan *dbapi.ClusterConsolidationPlan) *serviceError.ServiceError
+
+	// UpdateRetainedKafkasFunc mocks the UpdateRetainedKafkas method.
+	UpdateRetainedKafkasFunc func(plan *dbapi.ClusterConsolidationPlan) *serviceError.ServiceError
+
+	// UpdateStatusFunc mocks the UpdateStatus method.
+	UpdateStatusFunc func(id string, status dbapi.ClusterConsolidationPlanStatus) (*dbapi.ClusterConsolidationPlan, *serviceError.ServiceError)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// ID is the id argument value.
+			ID string
+		}
+		// List holds details about calls to the List method.
+		List []struct {
+			// Statuses is the statuses argument value.
+			Statuses []dbapi.ClusterConsolidationPlanStatus
+		}
+		// Propose holds details about calls to the Propose method.
+		Propose []struct {
+			// Plan is the plan argument value.
+			Plan *dbapi.ClusterConsolidationPlan
+		}
+		// UpdateRetainedKafkas holds details about calls to the UpdateRetainedKafkas method.
+		UpdateRetainedKafkas []struct {
+			// Plan is the plan argument value.
+			Plan *dbapi.ClusterConsolidationPlan
+		}
+		// UpdateStatus holds details about calls to the UpdateStatus method.
+		UpdateStatus []struct {
+			// ID is the id argument value.
+			ID string
+			// Status is the status argument value.
+			Status dbapi.ClusterConsolidationPlanStatus
+		}
+	}
+	lockGet                  sync.RWMutex
+	lockList                 sync.RWMutex
+	lockPropose              sync.RWMutex
+	lockUpdateRetainedKafkas sync.RWMutex
+	lockUpdateStatus         sync.RWMutex
+}
+
+// Get calls GetFunc.
+func (mock *ClusterConsolidationPlanServiceMock) Get(id string) (*dbapi.ClusterConsolidationPlan, *serviceError.ServiceError) {
+	if mock.GetFunc == nil {
+		panic("ClusterConsolidationPlanServiceMock.GetFunc: method is nil but ClusterConsolidationPlanService.Get was just called")
+	}
+	callInfo := struct {
+		ID string
+	}{
+		ID: id,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(id)
+}
+
+// GetCalls gets all the calls that were made to Get.
+// Check the length with:
+//
+//	len(mockedClusterConsolidationPlanService.GetCalls())
+func (mock *ClusterConsolidationPlanServiceMock) GetCalls() []struct {
+	ID string
+} {
+	var calls []struct {
+		ID string
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// List calls ListFunc.
+func (mock *ClusterConsolidationPlanServiceMock) List(statuses ...dbapi.ClusterConsolidationPlanStatus) (dbapi.ClusterConsolidationPlanList, *serviceError.ServiceError) {
+	if mock.ListFunc == nil {
+		panic("ClusterConsolidationPlanServiceMock.ListFunc: method is nil but ClusterConsolidationPlanService.List was just called")
+	}
+	callInfo := struct {
+		Statuses []dbapi.ClusterConsolidationPlanStatus
+	}{
+		Statuses: statuses,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(statuses...)
+}
+
+// ListCalls gets all the calls that were made to List.
+// Check the length with:
+//
+//	len(mockedClusterConsolidationPlanService.ListCalls())
+func (mock *ClusterConsolidationPlanServiceMock) ListCalls() []struct {
+	Statuses []dbapi.ClusterConsolidationPlanStatus
+} {
+	var calls []struct {
+		Statuses []dbapi.ClusterConsolidationPlanStatus
+	}
+	mock.lockList.RLock()
+	calls = mock.calls.List
+	mock.lockList.RUnlock()
+	return calls
+}
+
+// Propose calls ProposeFunc.
+func (mock *ClusterConsolidationPlanServiceMock) Propose(plan *dbapi.ClusterConsolidationPlan) *serviceError.ServiceError {
+	if mock.ProposeFunc == nil {
+		panic("ClusterConsolidationPlanServiceMock.ProposeFunc: method is nil but ClusterConsolidationPlanService.Propose was just called")
+	}
+	callInfo := struct {
+		Plan *dbapi.ClusterConsolidationPlan
+	}{
+		Plan: plan,
+	}
+	mock.lockPropose.Lock()
+	mock.calls.Propose = append(mock.calls.Propose, callInfo)
+	mock.lockPropose.Unlock()
+	return mock.ProposeFunc(plan)
+}
+
+// ProposeCalls gets all the calls that were made to Propose.
+// Check the length with:
+//
+//	len(mockedClusterConsolidationPlanService.ProposeCalls())
+func (mock *ClusterConsolidationPlanServiceMock) ProposeCalls() []struct {
+	Plan *dbapi.ClusterConsolidationPlan
+} {
+	var calls []struct {
+		Plan *dbapi.ClusterConsolidationPlan
+	}
+	mock.lockPropose.RLock()
+	calls = mock.calls.Propose
+	mock.lockPropose.RUnlock()
+	return calls
+}
+
+// UpdateRetainedKafkas calls UpdateRetainedKafkasFunc.
+func (mock *ClusterConsolidationPlanServiceMock) UpdateRetainedKafkas(plan *dbapi.ClusterConsolidationPlan) *serviceError.ServiceError {
+	if mock.UpdateRetainedKafkasFunc == nil {
+		panic("ClusterConsolidationPlanServiceMock.UpdateRetainedKafkasFunc: method is nil but ClusterConsolidationPlanService.UpdateRetainedKafkas was just called")
+	}
+	callInfo := struct {
+		Plan *dbapi.ClusterConsolidationPlan
+	}{
+		Plan: plan,
+	}
+	mock.lockUpdateRetainedKafkas.Lock()
+	mock.calls.UpdateRetainedKafkas = append(mock.calls.UpdateRetainedKafkas, callInfo)
+	mock.lockUpdateRetainedKafkas.Unlock()
+	return mock.UpdateRetainedKafkasFunc(plan)
+}
+
+// UpdateRetainedKafkasCalls gets all the calls that were made to UpdateRetainedKafkas.
+// Check the length with:
+//
+//	len(mockedClusterConsolidationPlanService.UpdateRetainedKafkasCalls())
+func (mock *ClusterConsolidationPlanServiceMock) UpdateRetainedKafkasCalls() []struct {
+	Plan *dbapi.ClusterConsolidationPlan
+} {
+	var calls []struct {
+		Plan *dbapi.ClusterConsolidationPlan
+	}
+	mock.lockUpdateRetainedKafkas.RLock()
+	calls = mock.calls.UpdateRetainedKafkas
+	mock.lockUpdateRetainedKafkas.RUnlock()
+	return calls
+}
+
+// UpdateStatus calls UpdateStatusFunc.
+func (mock *ClusterConsolidationPlanServiceMock) UpdateStatus(id string, status dbapi.ClusterConsolidationPlanStatus) (*dbapi.ClusterConsolidationPlan, *serviceError.ServiceError) {
+	if mock.UpdateStatusFunc == nil {
+		panic("ClusterConsolidationPlanServiceMock.UpdateStatusFunc: method is nil but ClusterConsolidationPlanService.UpdateStatus was just called")
+	}
+	callInfo := struct {
+		ID     string
+		Status dbapi.ClusterConsolidationPlanStatus
+	}{
+		ID:     id,
+		Status: status,
+	}
+	mock.lockUpdateStatus.Lock()
+	mock.calls.UpdateStatus = append(mock.calls.UpdateStatus, callInfo)
+	mock.lockUpdateStatus.Unlock()
+	return mock.UpdateStatusFunc(id, status)
+}
+
+// UpdateStatusCalls gets all the calls that were made to UpdateStatus.
+// Check the length with:
+//
+//	len(mockedClusterConsolidationPlanService.UpdateStatusCalls())
+func (mock *ClusterConsolidationPlanServiceMock) UpdateStatusCalls() []struct {
+	ID     string
+	Status dbapi.ClusterConsolidationPlanStatus
+} {
+	var calls []struct {
+		ID     string
+		Status dbapi.ClusterConsolidationPlanStatus
+	}
+	mock.lockUpdateStatus.RLock()
+	calls = mock.calls.UpdateStatus
+	mock.lockUpdateStatus.RUnlock()
+	return calls
+}
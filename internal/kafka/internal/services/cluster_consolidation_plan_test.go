@@ -0,0 +1,141 @@
+package services
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_clusterConsolidationPlanService_Get(t *testing.T) {
+	tests := []struct {
+		name           string
+		setupFn        func()
+		wantErr        bool
+		wantHttpStatus int
+	}{
+		{
+			name: "should return the consolidation plan",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "cluster_consolidation_plans"`).WithReply([]map[string]interface{}{{
+					"id":         "plan-id",
+					"cluster_id": "cluster-id",
+					"status":     dbapi.ClusterConsolidationPlanProposed.String(),
+				}})
+			},
+		},
+		{
+			name: "should return a not found error when the consolidation plan does not exist",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "cluster_consolidation_plans"`).WithReply(nil)
+			},
+			wantErr:        true,
+			wantHttpStatus: http.StatusNotFound,
+		},
+		{
+			name: "should return an error when the consolidation plan cannot be retrieved",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "cluster_consolidation_plans"`).WithQueryException()
+			},
+			wantErr:        true,
+			wantHttpStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			s := NewClusterConsolidationPlanService(db.NewMockConnectionFactory(nil))
+			plan, err := s.Get("plan-id")
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				g.Expect(err.HttpCode).To(gomega.Equal(tt.wantHttpStatus))
+				return
+			}
+			g.Expect(plan.ClusterID).To(gomega.Equal("cluster-id"))
+		})
+	}
+}
+
+func Test_clusterConsolidationPlanService_UpdateStatus(t *testing.T) {
+	tests := []struct {
+		name           string
+		currentStatus  dbapi.ClusterConsolidationPlanStatus
+		status         dbapi.ClusterConsolidationPlanStatus
+		rowsAffected   int
+		wantErr        bool
+		wantHttpStatus int
+	}{
+		{
+			name:          "should approve a proposed plan",
+			currentStatus: dbapi.ClusterConsolidationPlanProposed,
+			status:        dbapi.ClusterConsolidationPlanApproved,
+			rowsAffected:  1,
+		},
+		{
+			name:          "should complete an approved plan",
+			currentStatus: dbapi.ClusterConsolidationPlanApproved,
+			status:        dbapi.ClusterConsolidationPlanCompleted,
+			rowsAffected:  1,
+		},
+		{
+			name:          "should expire an approved plan",
+			currentStatus: dbapi.ClusterConsolidationPlanApproved,
+			status:        dbapi.ClusterConsolidationPlanExpired,
+			rowsAffected:  1,
+		},
+		{
+			name:           "should not approve a rejected plan",
+			currentStatus:  dbapi.ClusterConsolidationPlanRejected,
+			status:         dbapi.ClusterConsolidationPlanApproved,
+			wantErr:        true,
+			wantHttpStatus: http.StatusConflict,
+		},
+		{
+			name:           "should not reject an approved plan",
+			currentStatus:  dbapi.ClusterConsolidationPlanApproved,
+			status:         dbapi.ClusterConsolidationPlanRejected,
+			wantErr:        true,
+			wantHttpStatus: http.StatusConflict,
+		},
+		{
+			name:           "should return a conflict when the status of the plan has been changed concurrently",
+			currentStatus:  dbapi.ClusterConsolidationPlanProposed,
+			status:         dbapi.ClusterConsolidationPlanApproved,
+			rowsAffected:   0,
+			wantErr:        true,
+			wantHttpStatus: http.StatusConflict,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mocket.Catcher.Reset()
+			mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "cluster_consolidation_plans"`).WithReply([]map[string]interface{}{{
+				"id":     "plan-id",
+				"status": tt.currentStatus.String(),
+			}})
+			mocket.Catcher.NewMock().WithQuery(`UPDATE "cluster_consolidation_plans"`).WithRowsNum(int64(tt.rowsAffected))
+
+			s := NewClusterConsolidationPlanService(db.NewMockConnectionFactory(nil))
+			plan, err := s.UpdateStatus("plan-id", tt.status)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				g.Expect(err.HttpCode).To(gomega.Equal(tt.wantHttpStatus))
+				return
+			}
+			g.Expect(plan.Status).To(gomega.Equal(tt.status))
+			g.Expect(plan.ApprovedAt != nil).To(gomega.Equal(tt.status == dbapi.ClusterConsolidationPlanApproved))
+		})
+	}
+}
@@ -17,13 +17,13 @@ type ClusterPlacementStrategy interface {
 }
 
 // NewClusterPlacementStrategy return a concrete strategy impl. depends on the placement configuration
-func NewClusterPlacementStrategy(clusterService ClusterService, dataplaneClusterConfig *config.DataplaneClusterConfig, kafkaConfig *config.KafkaConfig) ClusterPlacementStrategy {
+func NewClusterPlacementStrategy(clusterService ClusterService, dataplaneClusterConfig *config.DataplaneClusterConfig, kafkaConfig *config.KafkaConfig, clusterConsolidationPlanService ClusterConsolidationPlanService) ClusterPlacementStrategy {
 	var clusterSelection ClusterPlacementStrategy
 	switch {
 	case dataplaneClusterConfig.IsDataPlaneManualScalingEnabled():
 		clusterSelection = &FirstSchedulableWithinLimit{dataplaneClusterConfig, clusterService, kafkaConfig}
 	case dataplaneClusterConfig.IsDataPlaneAutoScalingEnabled():
-		clusterSelection = &FirstReadyWithCapacity{clusterService, kafkaConfig}
+		clusterSelection = &FirstReadyWithCapacity{clusterService, kafkaConfig, clusterConsolidationPlanService}
 	default:
 		clusterSelection = &FirstReadyCluster{clusterService, kafkaConfig}
 	}
@@ -215,10 +215,12 @@ func (f *FirstSchedulableWithinLimit) findConsumedStreamingUnitPerClusterID(clus
 	return consumedStreamingUnitPerClusterID, nil
 }
 
-// FirstReadyWithCapacity finds and returns the first cluster in a Ready status with remaining capacity
+// FirstReadyWithCapacity finds and returns the first cluster in a Ready status with remaining capacity.
+// Clusters being drained by an approved consolidation plan are not considered
 type FirstReadyWithCapacity struct {
-	clusterService ClusterService
-	kafkaConfig    *config.KafkaConfig
+	clusterService                  ClusterService
+	kafkaConfig                     *config.KafkaConfig
+	clusterConsolidationPlanService ClusterConsolidationPlanService
 }
 
 func (f *FirstReadyWithCapacity) FindCluster(kafka *dbapi.KafkaRequest) (*api.Cluster, error) {
@@ -255,8 +257,13 @@ func (f *FirstReadyWithCapacity) FindCluster(kafka *dbapi.KafkaRequest) (*api.Cl
 		return nil, errors.Wrapf(getInstanceSizeErr, "failed to get kafka instance size for cluster with criteria '%v'", criteria)
 	}
 
+	drainedClusterIDs, drainedClustersErr := f.findClusterIDsBeingDrained()
+	if drainedClustersErr != nil {
+		return nil, errors.Wrapf(drainedClustersErr, "failed to find clusters being drained for criteria '%v'", criteria)
+	}
+
 	for _, cluster := range clusters {
-		if cluster.ClusterType == api.ManagedDataPlaneClusterType.String() {
+		if cluster.ClusterType == api.ManagedDataPlaneClusterType.String() && !arrays.Contains(drainedClusterIDs, cluster.ClusterID) {
 			clusterNotFull := f.isManagedClusterNotFull(cluster, streamingUnitCountPerRegionList, kafka, instanceSize)
 			if clusterNotFull {
 				return cluster, nil
@@ -276,3 +283,23 @@ func (f *FirstReadyWithCapacity) isManagedClusterNotFull(cluster *api.Cluster, s
 
 	return currentStreamingUnitsUsed+instanceSize.CapacityConsumed <= int(maxStreamingUnits)
 }
+
+// findClusterIDsBeingDrained returns the ids of the clusters of the approved consolidation plans.
+// Approved plans are completed once their cluster is no longer ready, or expire after the consolidation drain timeout,
+// by the dynamic scale down manager, which gives their cluster back to the placement
+func (f *FirstReadyWithCapacity) findClusterIDsBeingDrained() ([]string, error) {
+	if f.clusterConsolidationPlanService == nil {
+		return nil, nil
+	}
+
+	plans, err := f.clusterConsolidationPlanService.List(dbapi.ClusterConsolidationPlanApproved)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterIDs := make([]string, 0, len(plans))
+	for _, plan := range plans {
+		clusterIDs = append(clusterIDs, plan.ClusterID)
+	}
+	return clusterIDs, nil
+}
@@ -281,8 +281,9 @@ func TestFirstScheduleWithinLimit_FindCluster(t *testing.T) {
 
 func TestFirstReadyWithCapacity_FindCluster(t *testing.T) {
 	type fields struct {
-		ClusterService ClusterService
-		KafkaConfig    *config.KafkaConfig
+		ClusterService                  ClusterService
+		KafkaConfig                     *config.KafkaConfig
+		ClusterConsolidationPlanService ClusterConsolidationPlanService
 	}
 	type args struct {
 		kafka *dbapi.KafkaRequest
@@ -448,6 +449,68 @@ func TestFirstReadyWithCapacity_FindCluster(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name: "should return nil if the cluster with remaining capacity is being drained by a consolidation plan",
+			fields: fields{
+				ClusterService: &ClusterServiceMock{
+					FindAllClustersFunc: func(criteria FindClusterCriteria) ([]*api.Cluster, error) {
+						return []*api.Cluster{
+							{
+								ClusterID:           mockkafkas.DefaultClusterID,
+								ClusterType:         api.ManagedDataPlaneClusterType.String(),
+								DynamicCapacityInfo: api.JSON([]byte(`{"standard":{"max_nodes":1,"max_units":1,"remaining_units":1}}`)),
+							},
+						}, nil
+					},
+					FindStreamingUnitCountByClusterAndInstanceTypeFunc: func() (KafkaStreamingUnitCountPerClusterList, error) {
+						return KafkaStreamingUnitCountPerClusterList{
+							{
+								ClusterId:    mockkafkas.DefaultClusterID,
+								ClusterType:  api.ManagedDataPlaneClusterType.String(),
+								InstanceType: types.STANDARD.String(),
+								Count:        0,
+							},
+						}, nil
+					},
+				},
+				KafkaConfig: &config.KafkaConfig{
+					SupportedInstanceTypes: &config.KafkaSupportedInstanceTypesConfig{
+						Configuration: config.SupportedKafkaInstanceTypesConfig{
+							SupportedKafkaInstanceTypes: []config.KafkaInstanceType{
+								{
+									Id: types.STANDARD.String(),
+									Sizes: []config.KafkaInstanceSize{
+										{
+											Id:               "x1",
+											CapacityConsumed: 1,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				ClusterConsolidationPlanService: &ClusterConsolidationPlanServiceMock{
+					ListFunc: func(statuses ...dbapi.ClusterConsolidationPlanStatus) (dbapi.ClusterConsolidationPlanList, *apiErrors.ServiceError) {
+						return dbapi.ClusterConsolidationPlanList{
+							{
+								ClusterID: mockkafkas.DefaultClusterID,
+								Status:    dbapi.ClusterConsolidationPlanApproved,
+							},
+						}, nil
+					},
+				},
+			},
+			args: args{
+				kafka: mockkafkas.BuildKafkaRequest(
+					mockkafkas.With(mockkafkas.ID, mockkafkas.DefaultKafkaID),
+					mockkafkas.With(mockkafkas.INSTANCE_TYPE, types.STANDARD.String()),
+					mockkafkas.With(mockkafkas.SIZE_ID, "x1"),
+				),
+			},
+			want:    nil,
+			wantErr: nil,
+		},
 		{
 			name: "should return nil if cluster has no remaining capacity",
 			fields: fields{
@@ -556,8 +619,9 @@ func TestFirstReadyWithCapacity_FindCluster(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			g := gomega.NewWithT(t)
 			f := &FirstReadyWithCapacity{
-				clusterService: tt.fields.ClusterService,
-				kafkaConfig:    tt.fields.KafkaConfig,
+				clusterService:                  tt.fields.ClusterService,
+				kafkaConfig:                     tt.fields.KafkaConfig,
+				clusterConsolidationPlanService: tt.fields.ClusterConsolidationPlanService,
 			}
 
 			got, err := f.FindCluster(tt.args.kafka)
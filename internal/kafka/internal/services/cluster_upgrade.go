@@ -0,0 +1,271 @@
+package services
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	apiErrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
+	"gorm.io/gorm"
+)
+
+// kafkaStatusesReadyForClusterUpgrade are the statuses of the Kafka instances that do not hold back the upgrade of their cluster
+var kafkaStatusesReadyForClusterUpgrade = []string{
+	constants.KafkaRequestStatusReady.String(),
+	constants.KafkaRequestStatusSuspended.String(),
+	constants.KafkaRequestStatusDeprovision.String(),
+	constants.KafkaRequestStatusDeleting.String(),
+}
+
+//go:generate moq -out cluster_upgrade_moq.go . ClusterUpgradeService
+type ClusterUpgradeService interface {
+	// GetClusterVersion returns the OpenShift version of the cluster along with the versions it can be upgraded to
+	GetClusterVersion(cluster *api.Cluster) (*types.ClusterVersionInfo, *apiErrors.ServiceError)
+	// ScheduleUpgrade requests the upgrade of the cluster to the given OpenShift version, not before scheduledAt
+	ScheduleUpgrade(cluster *api.Cluster, version string, scheduledAt time.Time) *apiErrors.ServiceError
+	// ScheduleUpgradeWave requests the upgrade of the clusters to the given OpenShift version in batches of batchSize clusters,
+	// the first batch being scheduled at startAt and each following batch batchInterval after the previous one.
+	// It returns the id of the upgrade wave
+	ScheduleUpgradeWave(clusters []*api.Cluster, version string, startAt time.Time, batchSize int, batchInterval time.Duration) (string, *apiErrors.ServiceError)
+	// ListWaveClusters returns the clusters of an upgrade wave ordered by scheduled time
+	ListWaveClusters(waveID string) ([]*api.Cluster, *apiErrors.ServiceError)
+	// ListActiveUpgrades returns the clusters whose upgrade is pending, scheduled or in progress
+	ListActiveUpgrades() ([]*api.Cluster, *apiErrors.ServiceError)
+	// CancelUpgrade cancels the upgrade of the cluster. Upgrades already in progress cannot be cancelled
+	CancelUpgrade(cluster *api.Cluster) *apiErrors.ServiceError
+	// CountNotReadyKafkas counts the Kafka instances of the cluster that hold back its upgrade
+	CountNotReadyKafkas(clusterID string) (int64, *apiErrors.ServiceError)
+	// StartUpgrade schedules the pending upgrade of the cluster in its provider
+	StartUpgrade(cluster *api.Cluster) *apiErrors.ServiceError
+	// RefreshUpgradeStatus updates the upgrade status of the cluster from the state of the upgrade in its provider
+	RefreshUpgradeStatus(cluster *api.Cluster) *apiErrors.ServiceError
+	// UpdateUpgradeStatus saves the upgrade tracking fields of the cluster
+	UpdateUpgradeStatus(cluster *api.Cluster) *apiErrors.ServiceError
+}
+
+var _ ClusterUpgradeService = &clusterUpgradeService{}
+
+type clusterUpgradeService struct {
+	connectionFactory *db.ConnectionFactory
+	providerFactory   clusters.ProviderFactory
+}
+
+func NewClusterUpgradeService(connectionFactory *db.ConnectionFactory, providerFactory clusters.ProviderFactory) ClusterUpgradeService {
+	return &clusterUpgradeService{
+		connectionFactory: connectionFactory,
+		providerFactory:   providerFactory,
+	}
+}
+
+func (s *clusterUpgradeService) GetClusterVersion(cluster *api.Cluster) (*types.ClusterVersionInfo, *apiErrors.ServiceError) {
+	p, err := s.providerFactory.GetProvider(cluster.ProviderType)
+	if err != nil {
+		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to get provider implementation")
+	}
+
+	versionInfo, err := p.GetClusterVersion(cluster.ClusterID)
+	if err != nil {
+		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to get the OpenShift version of cluster %q", cluster.ClusterID)
+	}
+	return versionInfo, nil
+}
+
+func (s *clusterUpgradeService) ScheduleUpgrade(cluster *api.Cluster, version string, scheduledAt time.Time) *apiErrors.ServiceError {
+	if err := s.validateUpgrade(cluster, version); err != nil {
+		return err
+	}
+
+	requestUpgrade(cluster, version, scheduledAt, "")
+	return s.UpdateUpgradeStatus(cluster)
+}
+
+func (s *clusterUpgradeService) ScheduleUpgradeWave(clusters []*api.Cluster, version string, startAt time.Time, batchSize int, batchInterval time.Duration) (string, *apiErrors.ServiceError) {
+	if batchSize <= 0 {
+		return "", apiErrors.BadRequest("batch size %d must be greater than 0", batchSize)
+	}
+
+	for _, cluster := range clusters {
+		if err := s.validateUpgrade(cluster, version); err != nil {
+			return "", err
+		}
+	}
+
+	waveID := api.NewID()
+	for i, cluster := range clusters {
+		batch := i / batchSize
+		requestUpgrade(cluster, version, startAt.Add(time.Duration(batch)*batchInterval), waveID)
+	}
+
+	if err := s.connectionFactory.New().Transaction(func(tx *gorm.DB) error {
+		for _, cluster := range clusters {
+			if err := updateUpgradeColumns(tx, cluster); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return "", apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to schedule the upgrade wave of %d clusters", len(clusters))
+	}
+
+	return waveID, nil
+}
+
+// validateUpgrade checks that the cluster is ready, has no unfinished upgrade and can be upgraded to the given version
+func (s *clusterUpgradeService) validateUpgrade(cluster *api.Cluster, version string) *apiErrors.ServiceError {
+	if cluster.Status != api.ClusterReady {
+		return apiErrors.BadRequest("cluster %q cannot be upgraded in status %q", cluster.ClusterID, cluster.Status)
+	}
+	if cluster.IsUpgradeActive() {
+		return apiErrors.Conflict("cluster %q already has a %s upgrade to version %q", cluster.ClusterID, cluster.UpgradeStatus, cluster.UpgradeVersion)
+	}
+
+	versionInfo, err := s.GetClusterVersion(cluster)
+	if err != nil {
+		return err
+	}
+	if !arrays.Contains(versionInfo.AvailableUpgrades, version) {
+		return apiErrors.BadRequest("cluster %q running version %q cannot be upgraded to version %q. Available upgrades: %v", cluster.ClusterID, versionInfo.Version, version, versionInfo.AvailableUpgrades)
+	}
+	return nil
+}
+
+func requestUpgrade(cluster *api.Cluster, version string, scheduledAt time.Time, waveID string) {
+	cluster.UpgradeStatus = api.ClusterUpgradePending
+	cluster.UpgradeVersion = version
+	cluster.UpgradeScheduledAt = &scheduledAt
+	cluster.UpgradeWaveID = waveID
+	cluster.UpgradePolicyID = ""
+	cluster.UpgradeStatusDetails = ""
+}
+
+func (s *clusterUpgradeService) ListWaveClusters(waveID string) ([]*api.Cluster, *apiErrors.ServiceError) {
+	var waveClusters []*api.Cluster
+	if err := s.connectionFactory.New().
+		Where("upgrade_wave_id = ?", waveID).
+		Order("upgrade_scheduled_at asc").
+		Find(&waveClusters).Error; err != nil {
+		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to list the clusters of upgrade wave %q", waveID)
+	}
+	if len(waveClusters) == 0 {
+		return nil, apiErrors.NotFound("upgrade wave with id %q not found", waveID)
+	}
+	return waveClusters, nil
+}
+
+func (s *clusterUpgradeService) ListActiveUpgrades() ([]*api.Cluster, *apiErrors.ServiceError) {
+	var upgradingClusters []*api.Cluster
+	if err := s.connectionFactory.New().
+		Where("upgrade_status IN (?)", api.ClusterActiveUpgradeStatuses).
+		Order("upgrade_scheduled_at asc").
+		Find(&upgradingClusters).Error; err != nil {
+		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to list the clusters being upgraded")
+	}
+	return upgradingClusters, nil
+}
+
+func (s *clusterUpgradeService) CancelUpgrade(cluster *api.Cluster) *apiErrors.ServiceError {
+	switch cluster.UpgradeStatus {
+	case api.ClusterUpgradePending:
+	case api.ClusterUpgradeScheduled:
+		p, err := s.providerFactory.GetProvider(cluster.ProviderType)
+		if err != nil {
+			return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to get provider implementation")
+		}
+		if err := p.CancelUpgrade(cluster.ClusterID, cluster.UpgradePolicyID); err != nil {
+			return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to cancel the upgrade of cluster %q", cluster.ClusterID)
+		}
+	case api.ClusterUpgradeInProgress:
+		return apiErrors.Conflict("the upgrade of cluster %q is in progress and cannot be cancelled", cluster.ClusterID)
+	default:
+		return apiErrors.Conflict("cluster %q has no upgrade to cancel", cluster.ClusterID)
+	}
+
+	cluster.UpgradeStatus = api.ClusterUpgradeCancelled
+	cluster.UpgradeStatusDetails = ""
+	return s.UpdateUpgradeStatus(cluster)
+}
+
+func (s *clusterUpgradeService) CountNotReadyKafkas(clusterID string) (int64, *apiErrors.ServiceError) {
+	var count int64
+	if err := s.connectionFactory.New().
+		Model(&dbapi.KafkaRequest{}).
+		Where("cluster_id = ? AND status NOT IN (?)", clusterID, kafkaStatusesReadyForClusterUpgrade).
+		Count(&count).Error; err != nil {
+		return 0, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to count the kafkas not ready on cluster %q", clusterID)
+	}
+	return count, nil
+}
+
+func (s *clusterUpgradeService) StartUpgrade(cluster *api.Cluster) *apiErrors.ServiceError {
+	p, err := s.providerFactory.GetProvider(cluster.ProviderType)
+	if err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to get provider implementation")
+	}
+
+	request := &types.ClusterUpgradeRequest{
+		ClusterID: cluster.ClusterID,
+		Version:   cluster.UpgradeVersion,
+	}
+	if cluster.UpgradeScheduledAt != nil {
+		request.NextRun = *cluster.UpgradeScheduledAt
+	}
+
+	upgradeInfo, err := p.ScheduleUpgrade(request)
+	if err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to schedule the upgrade of cluster %q to version %q", cluster.ClusterID, cluster.UpgradeVersion)
+	}
+
+	cluster.UpgradeStatus = upgradeInfo.State
+	cluster.UpgradePolicyID = upgradeInfo.ID
+	cluster.UpgradeStatusDetails = ""
+	return s.UpdateUpgradeStatus(cluster)
+}
+
+func (s *clusterUpgradeService) RefreshUpgradeStatus(cluster *api.Cluster) *apiErrors.ServiceError {
+	p, err := s.providerFactory.GetProvider(cluster.ProviderType)
+	if err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to get provider implementation")
+	}
+
+	upgradeInfo, err := p.GetUpgrade(cluster.ClusterID, cluster.UpgradePolicyID, cluster.UpgradeVersion)
+	if err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to get the upgrade of cluster %q", cluster.ClusterID)
+	}
+	if upgradeInfo.State == cluster.UpgradeStatus {
+		return nil
+	}
+
+	cluster.UpgradeStatus = upgradeInfo.State
+	cluster.UpgradeStatusDetails = ""
+	if upgradeInfo.State == api.ClusterUpgradeFailed {
+		cluster.UpgradeStatusDetails = upgradeInfo.Description
+	}
+	return s.UpdateUpgradeStatus(cluster)
+}
+
+func (s *clusterUpgradeService) UpdateUpgradeStatus(cluster *api.Cluster) *apiErrors.ServiceError {
+	if err := updateUpgradeColumns(s.connectionFactory.New(), cluster); err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to update the upgrade status of cluster %q", cluster.ClusterID)
+	}
+	return nil
+}
+
+// updateUpgradeColumns updates the upgrade tracking columns only, including their zero values, so that concurrent
+// updates of the other columns of the cluster are not overwritten
+func updateUpgradeColumns(tx *gorm.DB, cluster *api.Cluster) error {
+	return tx.Model(&api.Cluster{}).
+		Where("id = ?", cluster.ID).
+		Updates(map[string]interface{}{
+			"upgrade_status":         cluster.UpgradeStatus,
+			"upgrade_version":        cluster.UpgradeVersion,
+			"upgrade_scheduled_at":   cluster.UpgradeScheduledAt,
+			"upgrade_wave_id":        cluster.UpgradeWaveID,
+			"upgrade_policy_id":      cluster.UpgradePolicyID,
+			"upgrade_status_details": cluster.UpgradeStatusDetails,
+		}).Error
+}
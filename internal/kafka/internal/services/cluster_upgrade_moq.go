@@ -0,0 +1,503 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package services
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	serviceError "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"sync"
+	"time"
+)
+
+// Ensure, that ClusterUpgradeServiceMock does implement ClusterUpgradeService.
+// If this is not the case, regenerate this file with moq.
+var _ ClusterUpgradeService = &ClusterUpgradeServiceMock{}
+
+// ClusterUpgradeServiceMock is a mock implementation of ClusterUpgradeService.
+//
+//	func TestSomethingThatUsesClusterUpgradeService(t *testing.T) {
+//
+//		// make and configure a mocked ClusterUpgradeService
+//		mockedClusterUpgradeService := &ClusterUpgradeServiceMock{
+//			CancelUpgradeFunc: func(cluster *api.Cluster) *serviceError.ServiceError {
+//				panic("mock out the CancelUpgrade method")
+//			},
+//			CountNotReadyKafkasFunc: func(clusterID string) (int64, *serviceError.ServiceError) {
+//				panic("mock out the CountNotReadyKafkas method")
+//			},
+//			GetClusterVersionFunc: func(cluster *api.Cluster) (*types.ClusterVersionInfo, *serviceError.ServiceError) {
+//				panic("mock out the GetClusterVersion method")
+//			},
+//			ListActiveUpgradesFunc: func() ([]*api.Cluster, *serviceError.ServiceError) {
+//				panic("mock out the ListActiveUpgrades method")
+//			},
+//			ListWaveClustersFunc: func(waveID string) ([]*api.Cluster, *serviceError.ServiceError) {
+//				panic("mock out the ListWaveClusters method")
+//			},
+//			RefreshUpgradeStatusFunc: func(cluster *api.Cluster) *serviceError.ServiceError {
+//				panic("mock out the RefreshUpgradeStatus method")
+//			},
+//			ScheduleUpgradeFunc: func(cluster *api.Cluster, version string, scheduledAt time.Time) *serviceError.ServiceError {
+//				panic("mock out the ScheduleUpgrade method")
+//			},
+//			ScheduleUpgradeWaveFunc: func(clusters []*api.Cluster, version string, startAt time.Time, batchSize int, batchInterval time.Duration) (string, *serviceError.ServiceError) {
+//				panic("mock out the ScheduleUpgradeWave method")
+//			},
+//			StartUpgradeFunc: func(cluster *api.Cluster) *serviceError.ServiceError {
+//				panic("mock out the StartUpgrade method")
+//			},
+//			UpdateUpgradeStatusFunc: func(cluster *api.Cluster) *serviceError.ServiceError {
+//				panic("mock out the UpdateUpgradeStatus method")
+//			},
+//		}
+//
+//		// use mockedClusterUpgradeService in code that requires ClusterUpgradeService
+//		// and then make assertions.
+//
+//	}
+type ClusterUpgradeServiceMock struct {
+	// CancelUpgradeFunc mocks the CancelUpgrade method.
+	CancelUpgradeFunc func(cluster *api.Cluster) *serviceError.ServiceError
+
+	// CountNotReadyKafkasFunc mocks the CountNotReadyKafkas method.
+	CountNotReadyKafkasFunc func(clusterID string) (int64, *serviceError.ServiceError)
+
+	// GetClusterVersionFunc mocks the GetClusterVersion method.
+	GetClusterVersionFunc func(cluster *api.Cluster) (*types.ClusterVersionInfo, *serviceError.ServiceError)
+
+	// ListActiveUpgradesFunc mocks the ListActiveUpgrades method.
+	ListActiveUpgradesFunc func() ([]*api.Cluster, *serviceError.ServiceError)
+
+	// ListWaveClustersFunc mocks the ListWaveClusters method.
+	ListWaveClustersFunc func(waveID string) ([]*api.Cluster, *serviceError.ServiceError)
+
+	// RefreshUpgradeStatusFunc mocks the RefreshUpgradeStatus method.
+	RefreshUpgradeStatusFunc func(cluster *api.Cluster) *serviceError.ServiceError
+
+	// ScheduleUpgradeFunc mocks the ScheduleUpgrade method.
+	ScheduleUpgradeFunc func(cluster *api.Cluster, version string, scheduledAt time.Time) *serviceError.ServiceError
+
+	// ScheduleUpgradeWaveFunc mocks the ScheduleUpgradeWave method.
+	ScheduleUpgradeWaveFunc func(clusters []*api.Cluster, version string, startAt time.Time, batchSize int, batchInterval time.Duration) (string, *serviceError.ServiceError)
+
+	// StartUpgradeFunc mocks the StartUpgrade method.
+	StartUpgradeFunc func(cluster *api.Cluster) *serviceError.ServiceError
+
+	// UpdateUpgradeStatusFunc mocks the UpdateUpgradeStatus method.
+	UpdateUpgradeStatusFunc func(cluster *api.Cluster) *serviceError.ServiceError
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CancelUpgrade holds details about calls to the CancelUpgrade method.
+		CancelUpgrade []struct {
+			// Cluster is the cluster argument value.
+			Cluster *api.Cluster
+		}
+		// CountNotReadyKafkas holds details about calls to the CountNotReadyKafkas method.
+		CountNotReadyKafkas []struct {
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+		}
+		// GetClusterVersion holds details about calls to the GetClusterVersion method.
+		GetClusterVersion []struct {
+			// Cluster is the cluster argument value.
+			Cluster *api.Cluster
+		}
+		// ListActiveUpgrades holds details about calls to the ListActiveUpgrades method.
+		ListActiveUpgrades []struct {
+		}
+		// ListWaveClusters holds details about calls to the ListWaveClusters method.
+		ListWaveClusters []struct {
+			// WaveID is the waveID argument value.
+			WaveID string
+		}
+		// RefreshUpgradeStatus holds details about calls to the RefreshUpgradeStatus method.
+		RefreshUpgradeStatus []struct {
+			// Cluster is the cluster argument value.
+			Cluster *api.Cluster
+		}
+		// ScheduleUpgrade holds details about calls to the ScheduleUpgrade method.
+		ScheduleUpgrade []struct {
+			// Cluster is the cluster argument value.
+			Cluster *api.Cluster
+			// Version is the version argument value.
+			Version string
+			// ScheduledAt is the scheduledAt argument value.
+			ScheduledAt time.Time
+		}
+		// ScheduleUpgradeWave holds details about calls to the ScheduleUpgradeWave method.
+		ScheduleUpgradeWave []struct {
+			// Clusters is the clusters argument value.
+			Clusters []*api.Cluster
+			// Version is the version argument value.
+			Version string
+			// StartAt is the startAt argument value.
+			StartAt time.Time
+			// BatchSize is the batchSize argument value.
+			BatchSize int
+			// BatchInterval is the batchInterval argument value.
+			BatchInterval time.Duration
+		}
+		// StartUpgrade holds details about calls to the StartUpgrade method.
+		StartUpgrade []struct {
+			// Cluster is the cluster argument value.
+			Cluster *api.Cluster
+		}
+		// UpdateUpgradeStatus holds details about calls to the UpdateUpgradeStatus method.
+		UpdateUpgradeStatus []struct {
+			// Cluster is the cluster argument value.
+			Cluster *api.Cluster
+		}
+	}
+	lockCancelUpgrade        sync.RWMutex
+	lockCountNotReadyKafkas  sync.RWMutex
+	lockGetClusterVersion    sync.RWMutex
+	lockListActiveUpgrades   sync.RWMutex
+	lockListWaveClusters     sync.RWMutex
+	lockRefreshUpgradeStatus sync.RWMutex
+	lockScheduleUpgrade      sync.RWMutex
+	lockScheduleUpgradeWave  sync.RWMutex
+	lockStartUpgrade         sync.RWMutex
+	lockUpdateUpgradeStatus  sync.RWMutex
+}
+
+// CancelUpgrade calls CancelUpgradeFunc.
+func (mock *ClusterUpgradeServiceMock) CancelUpgrade(cluster *api.Cluster) *serviceError.ServiceError {
+	if mock.CancelUpgradeFunc == nil {
+		panic("ClusterUpgradeServiceMock.CancelUpgradeFunc: method is nil but ClusterUpgradeService.CancelUpgrade was just called")
+	}
+	callInfo := struct {
+		Cluster *api.Cluster
+	}{
+		Cluster: cluster,
+	}
+	mock.lockCancelUpgrade.Lock()
+	mock.calls.CancelUpgrade = append(mock.calls.CancelUpgrade, callInfo)
+	mock.lockCancelUpgrade.Unlock()
+	return mock.CancelUpgradeFunc(cluster)
+}
+
+// CancelUpgradeCalls gets all the calls that were made to CancelUpgrade.
+// Check the length with:
+//
+//	len(mockedClusterUpgradeService.CancelUpgradeCalls())
+func (mock *ClusterUpgradeServiceMock) CancelUpgradeCalls() []struct {
+	Cluster *api.Cluster
+} {
+	var calls []struct {
+		Cluster *api.Cluster
+	}
+	mock.lockCancelUpgrade.RLock()
+	calls = mock.calls.CancelUpgrade
+	mock.lockCancelUpgrade.RUnlock()
+	return calls
+}
+
+// CountNotReadyKafkas calls CountNotReadyKafkasFunc.
+func (mock *ClusterUpgradeServiceMock) CountNotReadyKafkas(clusterID string) (int64, *serviceError.ServiceError) {
+	if mock.CountNotReadyKafkasFunc == nil {
+		panic("ClusterUpgradeServiceMock.CountNotReadyKafkasFunc: method is nil but ClusterUpgradeService.CountNotReadyKafkas was just called")
+	}
+	callInfo := struct {
+		ClusterID string
+	}{
+		ClusterID: clusterID,
+	}
+	mock.lockCountNotReadyKafkas.Lock()
+	mock.calls.CountNotReadyKafkas = append(mock.calls.CountNotReadyKafkas, callInfo)
+	mock.lockCountNotReadyKafkas.Unlock()
+	return mock.CountNotReadyKafkasFunc(clusterID)
+}
+
+// CountNotReadyKafkasCalls gets all the calls that were made to CountNotReadyKafkas.
+// Check the length with:
+//
+//	len(mockedClusterUpgradeService.CountNotReadyKafkasCalls())
+func (mock *ClusterUpgradeServiceMock) CountNotReadyKafkasCalls() []struct {
+	ClusterID string
+} {
+	var calls []struct {
+		ClusterID string
+	}
+	mock.lockCountNotReadyKafkas.RLock()
+	calls = mock.calls.CountNotReadyKafkas
+	mock.lockCountNotReadyKafkas.RUnlock()
+	return calls
+}
+
+// GetClusterVersion calls GetClusterVersionFunc.
+func (mock *ClusterUpgradeServiceMock) GetClusterVersion(cluster *api.Cluster) (*types.ClusterVersionInfo, *serviceError.ServiceError) {
+	if mock.GetClusterVersionFunc == nil {
+		panic("ClusterUpgradeServiceMock.GetClusterVersionFunc: method is nil but ClusterUpgradeService.GetClusterVersion was just called")
+	}
+	callInfo := struct {
+		Cluster *api.Cluster
+	}{
+		Cluster: cluster,
+	}
+	mock.lockGetClusterVersion.Lock()
+	mock.calls.GetClusterVersion = append(mock.calls.GetClusterVersion, callInfo)
+	mock.lockGetClusterVersion.Unlock()
+	return mock.GetClusterVersionFunc(cluster)
+}
+
+// GetClusterVersionCalls gets all the calls that were made to GetClusterVersion.
+// Check the length with:
+//
+//	len(mockedClusterUpgradeService.GetClusterVersionCalls())
+func (mock *ClusterUpgradeServiceMock) GetClusterVersionCalls() []struct {
+	Cluster *api.Cluster
+} {
+	var calls []struct {
+		Cluster *api.Cluster
+	}
+	mock.lockGetClusterVersion.RLock()
+	calls = mock.calls.GetClusterVersion
+	mock.lockGetClusterVersion.RUnlock()
+	return calls
+}
+
+// ListActiveUpgrades calls ListActiveUpgradesFunc.
+func (mock *ClusterUpgradeServiceMock) ListActiveUpgrades() ([]*api.Cluster, *serviceError.ServiceError) {
+	if mock.ListActiveUpgradesFunc == nil {
+		panic("ClusterUpgradeServiceMock.ListActiveUpgradesFunc: method is nil but ClusterUpgradeService.ListActiveUpgrades was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockListActiveUpgrades.Lock()
+	mock.calls.ListActiveUpgrades = append(mock.calls.ListActiveUpgrades, callInfo)
+	mock.lockListActiveUpgrades.Unlock()
+	return mock.ListActiveUpgradesFunc()
+}
+
+// ListActiveUpgradesCalls gets all the calls that were made to ListActiveUpgrades.
+// Check the length with:
+//
+//	len(mockedClusterUpgradeService.ListActiveUpgradesCalls())
+func (mock *ClusterUpgradeServiceMock) ListActiveUpgradesCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockListActiveUpgrades.RLock()
+	calls = mock.calls.ListActiveUpgrades
+	mock.lockListActiveUpgrades.RUnlock()
+	return calls
+}
+
+// ListWaveClusters calls ListWaveClustersFunc.
+func (mock *ClusterUpgradeServiceMock) ListWaveClusters(waveID string) ([]*api.Cluster, *serviceError.ServiceError) {
+	if mock.ListWaveClustersFunc == nil {
+		panic("ClusterUpgradeServiceMock.ListWaveClustersFunc: method is nil but ClusterUpgradeService.ListWaveClusters was just called")
+	}
+	callInfo := struct {
+		WaveID string
+	}{
+		WaveID: waveID,
+	}
+	mock.lockListWaveClusters.Lock()
+	mock.calls.ListWaveClusters = append(mock.calls.ListWaveClusters, callInfo)
+	mock.lockListWaveClusters.Unlock()
+	return mock.ListWaveClustersFunc(waveID)
+}
+
+// ListWaveClustersCalls gets all the calls that were made to ListWaveClusters.
+// Check the length with:
+//
+//	len(mockedClusterUpgradeService.ListWaveClustersCalls())
+func (mock *ClusterUpgradeServiceMock) ListWaveClustersCalls() []struct {
+	WaveID string
+} {
+	var calls []struct {
+		WaveID string
+	}
+	mock.lockListWaveClusters.RLock()
+	calls = mock.calls.ListWaveClusters
+	mock.lockListWaveClusters.RUnlock()
+	return calls
+}
+
+// RefreshUpgradeStatus calls RefreshUpgradeStatusFunc.
+func (mock *ClusterUpgradeServiceMock) RefreshUpgradeStatus(cluster *api.Cluster) *serviceError.ServiceError {
+	if mock.RefreshUpgradeStatusFunc == nil {
+		panic("ClusterUpgradeServiceMock.RefreshUpgradeStatusFunc: method is nil but ClusterUpgradeService.RefreshUpgradeStatus was just called")
+	}
+	callInfo := struct {
+		Cluster *api.Cluster
+	}{
+		Cluster: cluster,
+	}
+	mock.lockRefreshUpgradeStatus.Lock()
+	mock.calls.RefreshUpgradeStatus = append(mock.calls.RefreshUpgradeStatus, callInfo)
+	mock.lockRefreshUpgradeStatus.Unlock()
+	return mock.RefreshUpgradeStatusFunc(cluster)
+}
+
+// RefreshUpgradeStatusCalls gets all the calls that were made to RefreshUpgradeStatus.
+// Check the length with:
+//
+//	len(mockedClusterUpgradeService.RefreshUpgradeStatusCalls())
+func (mock *ClusterUpgradeServiceMock) RefreshUpgradeStatusCalls() []struct {
+	Cluster *api.Cluster
+} {
+	var calls []struct {
+		Cluster *api.Cluster
+	}
+	mock.lockRefreshUpgradeStatus.RLock()
+	calls = mock.calls.RefreshUpgradeStatus
+	mock.lockRefreshUpgradeStatus.RUnlock()
+	return calls
+}
+
+// ScheduleUpgrade calls ScheduleUpgradeFunc.
+func (mock *ClusterUpgradeServiceMock) ScheduleUpgrade(cluster *api.Cluster, version string, scheduledAt time.Time) *serviceError.ServiceError {
+	if mock.ScheduleUpgradeFunc == nil {
+		panic("ClusterUpgradeServiceMock.ScheduleUpgradeFunc: method is nil but ClusterUpgradeService.ScheduleUpgrade was just called")
+	}
+	callInfo := struct {
+		Cluster     *api.Cluster
+		Version     string
+		ScheduledAt time.Time
+	}{
+		Cluster:     cluster,
+		Version:     version,
+		ScheduledAt: scheduledAt,
+	}
+	mock.lockScheduleUpgrade.Lock()
+	mock.calls.ScheduleUpgrade = append(mock.calls.ScheduleUpgrade, callInfo)
+	mock.lockScheduleUpgrade.Unlock()
+	return mock.ScheduleUpgradeFunc(cluster, version, scheduledAt)
+}
+
+// ScheduleUpgradeCalls gets all the calls that were made to ScheduleUpgrade.
+// Check the length with:
+//
+//	len(mockedClusterUpgradeService.ScheduleUpgradeCalls())
+func (mock *ClusterUpgradeServiceMock) ScheduleUpgradeCalls() []struct {
+	Cluster     *api.Cluster
+	Version     string
+	ScheduledAt time.Time
+} {
+	var calls []struct {
+		Cluster     *api.Cluster
+		Version     string
+		ScheduledAt time.Time
+	}
+	mock.lockScheduleUpgrade.RLock()
+	calls = mock.calls.ScheduleUpgrade
+	mock.lockScheduleUpgrade.RUnlock()
+	return calls
+}
+
+// ScheduleUpgradeWave calls ScheduleUpgradeWaveFunc.
+func (mock *ClusterUpgradeServiceMock) ScheduleUpgradeWave(clusters []*api.Cluster, version string, startAt time.Time, batchSize int, batchInterval time.Duration) (string, *serviceError.ServiceError) {
+	if mock.ScheduleUpgradeWaveFunc == nil {
+		panic("ClusterUpgradeServiceMock.ScheduleUpgradeWaveFunc: method is nil but ClusterUpgradeService.ScheduleUpgradeWave was just called")
+	}
+	callInfo := struct {
+		Clusters      []*api.Cluster
+		Version       string
+		StartAt       time.Time
+		BatchSize     int
+		BatchInterval time.Duration
+	}{
+		Clusters:      clusters,
+		Version:       version,
+		StartAt:       startAt,
+		BatchSize:     batchSize,
+		BatchInterval: batchInterval,
+	}
+	mock.lockScheduleUpgradeWave.Lock()
+	mock.calls.ScheduleUpgradeWave = append(mock.calls.ScheduleUpgradeWave, callInfo)
+	mock.lockScheduleUpgradeWave.Unlock()
+	return mock.ScheduleUpgradeWaveFunc(clusters, version, startAt, batchSize, batchInterval)
+}
+
+// ScheduleUpgradeWaveCalls gets all the calls that were made to ScheduleUpgradeWave.
+// Check the length with:
+//
+//	len(mockedClusterUpgradeService.ScheduleUpgradeWaveCalls())
+func (mock *ClusterUpgradeServiceMock) ScheduleUpgradeWaveCalls() []struct {
+	Clusters      []*api.Cluster
+	Version       string
+	StartAt       time.Time
+	BatchSize     int
+	BatchInterval time.Duration
+} {
+	var calls []struct {
+		Clusters      []*api.Cluster
+		Version       string
+		StartAt       time.Time
+		BatchSize     int
+		BatchInterval time.Duration
+	}
+	mock.lockScheduleUpgradeWave.RLock()
+	calls = mock.calls.ScheduleUpgradeWave
+	mock.lockScheduleUpgradeWave.RUnlock()
+	return calls
+}
+
+// StartUpgrade calls StartUpgradeFunc.
+func (mock *ClusterUpgradeServiceMock) StartUpgrade(cluster *api.Cluster) *serviceError.ServiceError {
+	if mock.StartUpgradeFunc == nil {
+		panic("ClusterUpgradeServiceMock.StartUpgradeFunc: method is nil but ClusterUpgradeService.StartUpgrade was just called")
+	}
+	callInfo := struct {
+		Cluster *api.Cluster
+	}{
+		Cluster: cluster,
+	}
+	mock.lockStartUpgrade.Lock()
+	mock.calls.StartUpgrade = append(mock.calls.StartUpgrade, callInfo)
+	mock.lockStartUpgrade.Unlock()
+	return mock.StartUpgradeFunc(cluster)
+}
+
+// StartUpgradeCalls gets all the calls that were made to StartUpgrade.
+// Check the length with:
+//
+//	len(mockedClusterUpgradeService.StartUpgradeCalls())
+func (mock *ClusterUpgradeServiceMock) StartUpgradeCalls() []struct {
+	Cluster *api.Cluster
+} {
+	var calls []struct {
+		Cluster *api.Cluster
+	}
+	mock.lockStartUpgrade.RLock()
+	calls = mock.calls.StartUpgrade
+	mock.lockStartUpgrade.RUnlock()
+	return calls
+}
+
+// UpdateUpgradeStatus calls UpdateUpgradeStatusFunc.
+func (mock *ClusterUpgradeServiceMock) UpdateUpgradeStatus(cluster *api.Cluster) *serviceError.ServiceError {
+	if mock.UpdateUpgradeStatusFunc == nil {
+		panic("ClusterUpgradeServiceMock.UpdateUpgradeStatusFunc: method is nil but ClusterUpgradeService.UpdateUpgradeStatus was just called")
+	}
+	callInfo := struct {
+		Cluster *api.Cluster
+	}{
+		Cluster: cluster,
+	}
+	mock.lockUpdateUpgradeStatus.Lock()
+	mock.calls.UpdateUpgradeStatus = append(mock.calls.UpdateUpgradeStatus, callInfo)
+	mock.lockUpdateUpgradeStatus.Unlock()
+	return mock.UpdateUpgradeStatusFunc(cluster)
+}
+
+// UpdateUpgradeStatusCalls gets all the calls that were made to UpdateUpgradeStatus.
+// Check the length with:
+//
+//	len(mockedClusterUpgradeService.UpdateUpgradeStatusCalls())
+func (mock *ClusterUpgradeServiceMock) UpdateUpgradeStatusCalls() []struct {
+	Cluster *api.Cluster
+} {
+	var calls []struct {
+		Cluster *api.Cluster
+	}
+	mock.lockUpdateUpgradeStatus.RLock()
+	calls = mock.calls.UpdateUpgradeStatus
+	mock.lockUpdateUpgradeStatus.RUnlock()
+	return calls
+}
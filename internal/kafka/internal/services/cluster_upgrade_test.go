@@ -0,0 +1,306 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	apiErrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func clusterUpgradeProviderFactory(provider clusters.Provider) clusters.ProviderFactory {
+	return &clusters.ProviderFactoryMock{
+		GetProviderFunc: func(providerType api.ClusterProviderType) (clusters.Provider, error) {
+			return provider, nil
+		},
+	}
+}
+
+func clusterVersionProvider(availableUpgrades ...string) *clusters.ProviderMock {
+	return &clusters.ProviderMock{
+		GetClusterVersionFunc: func(clusterID string) (*types.ClusterVersionInfo, error) {
+			return &types.ClusterVersionInfo{Version: "4.11.22", AvailableUpgrades: availableUpgrades}, nil
+		},
+	}
+}
+
+func Test_clusterUpgradeService_ScheduleUpgrade(t *testing.T) {
+	scheduledAt := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name     string
+		cluster  *api.Cluster
+		provider *clusters.ProviderMock
+		setupFn  func()
+		wantCode apiErrors.ServiceErrorCode
+		wantErr  bool
+	}{
+		{
+			name:     "should request the upgrade of the cluster",
+			cluster:  &api.Cluster{ClusterID: "cluster-id", Status: api.ClusterReady, UpgradeStatus: api.ClusterUpgradeCompleted},
+			provider: clusterVersionProvider("4.11.25"),
+			setupFn: func() {
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "clusters"`).WithRowsNum(1)
+			},
+		},
+		{
+			name:     "should return a bad request if the cluster is not ready",
+			cluster:  &api.Cluster{ClusterID: "cluster-id", Status: api.ClusterProvisioning},
+			provider: clusterVersionProvider("4.11.25"),
+			wantErr:  true,
+			wantCode: apiErrors.ErrorBadRequest,
+		},
+		{
+			name:     "should return a conflict if an upgrade of the cluster is already in progress",
+			cluster:  &api.Cluster{ClusterID: "cluster-id", Status: api.ClusterReady, UpgradeStatus: api.ClusterUpgradeScheduled},
+			provider: clusterVersionProvider("4.11.25"),
+			wantErr:  true,
+			wantCode: apiErrors.ErrorConflict,
+		},
+		{
+			name:     "should return a bad request if the version is not an available upgrade of the cluster",
+			cluster:  &api.Cluster{ClusterID: "cluster-id", Status: api.ClusterReady},
+			provider: clusterVersionProvider("4.11.23"),
+			wantErr:  true,
+			wantCode: apiErrors.ErrorBadRequest,
+		},
+		{
+			name:    "should return an error if the version of the cluster cannot be retrieved",
+			cluster: &api.Cluster{ClusterID: "cluster-id", Status: api.ClusterReady},
+			provider: &clusters.ProviderMock{
+				GetClusterVersionFunc: func(clusterID string) (*types.ClusterVersionInfo, error) {
+					return nil, errors.New("failed to get cluster")
+				},
+			},
+			wantErr:  true,
+			wantCode: apiErrors.ErrorGeneral,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mocket.Catcher.Reset()
+			if tt.setupFn != nil {
+				tt.setupFn()
+			}
+
+			s := NewClusterUpgradeService(db.NewMockConnectionFactory(nil), clusterUpgradeProviderFactory(tt.provider))
+			err := s.ScheduleUpgrade(tt.cluster, "4.11.25", scheduledAt)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				g.Expect(err.Code).To(gomega.Equal(tt.wantCode))
+				return
+			}
+			g.Expect(tt.cluster.UpgradeStatus).To(gomega.Equal(api.ClusterUpgradePending))
+			g.Expect(tt.cluster.UpgradeVersion).To(gomega.Equal("4.11.25"))
+			g.Expect(*tt.cluster.UpgradeScheduledAt).To(gomega.Equal(scheduledAt))
+		})
+	}
+}
+
+func Test_clusterUpgradeService_ScheduleUpgradeWave(t *testing.T) {
+	startAt := time.Now()
+	buildClusters := func() []*api.Cluster {
+		return []*api.Cluster{
+			{Meta: api.Meta{ID: "1"}, ClusterID: "cluster-1", Status: api.ClusterReady},
+			{Meta: api.Meta{ID: "2"}, ClusterID: "cluster-2", Status: api.ClusterReady},
+			{Meta: api.Meta{ID: "3"}, ClusterID: "cluster-3", Status: api.ClusterReady},
+		}
+	}
+
+	tests := []struct {
+		name             string
+		clusters         []*api.Cluster
+		batchSize        int
+		setupFn          func()
+		wantScheduledAts []time.Time
+		wantErr          bool
+	}{
+		{
+			name:      "should schedule the clusters of the wave in batches",
+			clusters:  buildClusters(),
+			batchSize: 2,
+			setupFn: func() {
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "clusters"`).WithRowsNum(1)
+			},
+			wantScheduledAts: []time.Time{startAt, startAt, startAt.Add(30 * time.Minute)},
+		},
+		{
+			name:      "should return an error if the batch size is not positive",
+			clusters:  buildClusters(),
+			batchSize: 0,
+			wantErr:   true,
+		},
+		{
+			name: "should not schedule any cluster if one of them cannot be upgraded",
+			clusters: append(buildClusters(), &api.Cluster{
+				Meta:      api.Meta{ID: "4"},
+				ClusterID: "cluster-4",
+				Status:    api.ClusterDeprovisioning,
+			}),
+			batchSize: 1,
+			wantErr:   true,
+		},
+		{
+			name:      "should return an error if the wave cannot be stored",
+			clusters:  buildClusters(),
+			batchSize: 1,
+			setupFn: func() {
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "clusters"`).WithExecException()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mocket.Catcher.Reset()
+			if tt.setupFn != nil {
+				tt.setupFn()
+			}
+
+			s := NewClusterUpgradeService(db.NewMockConnectionFactory(nil), clusterUpgradeProviderFactory(clusterVersionProvider("4.11.25")))
+			waveID, err := s.ScheduleUpgradeWave(tt.clusters, "4.11.25", startAt, tt.batchSize, 30*time.Minute)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				return
+			}
+
+			g.Expect(waveID).ToNot(gomega.BeEmpty())
+			var scheduledAts []time.Time
+			for _, cluster := range tt.clusters {
+				g.Expect(cluster.UpgradeWaveID).To(gomega.Equal(waveID))
+				g.Expect(cluster.UpgradeStatus).To(gomega.Equal(api.ClusterUpgradePending))
+				scheduledAts = append(scheduledAts, *cluster.UpgradeScheduledAt)
+			}
+			g.Expect(scheduledAts).To(gomega.Equal(tt.wantScheduledAts))
+		})
+	}
+}
+
+func Test_clusterUpgradeService_CancelUpgrade(t *testing.T) {
+	tests := []struct {
+		name            string
+		upgradeStatus   api.ClusterUpgradeStatus
+		cancelErr       error
+		wantCancelCalls int
+		wantCode        apiErrors.ServiceErrorCode
+		wantErr         bool
+	}{
+		{
+			name:          "should cancel a pending upgrade",
+			upgradeStatus: api.ClusterUpgradePending,
+		},
+		{
+			name:            "should cancel a scheduled upgrade in the cluster provider",
+			upgradeStatus:   api.ClusterUpgradeScheduled,
+			wantCancelCalls: 1,
+		},
+		{
+			name:            "should return an error if the scheduled upgrade cannot be cancelled in the cluster provider",
+			upgradeStatus:   api.ClusterUpgradeScheduled,
+			cancelErr:       errors.New("failed to delete upgrade policy"),
+			wantCancelCalls: 1,
+			wantErr:         true,
+			wantCode:        apiErrors.ErrorGeneral,
+		},
+		{
+			name:          "should return a conflict if the upgrade is in progress",
+			upgradeStatus: api.ClusterUpgradeInProgress,
+			wantErr:       true,
+			wantCode:      apiErrors.ErrorConflict,
+		},
+		{
+			name:          "should return a conflict if the cluster has no upgrade to cancel",
+			upgradeStatus: api.ClusterUpgradeCompleted,
+			wantErr:       true,
+			wantCode:      apiErrors.ErrorConflict,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mocket.Catcher.Reset()
+			mocket.Catcher.NewMock().WithQuery(`UPDATE "clusters"`).WithRowsNum(1)
+
+			provider := &clusters.ProviderMock{
+				CancelUpgradeFunc: func(clusterID string, upgradeID string) error {
+					return tt.cancelErr
+				},
+			}
+			cluster := &api.Cluster{ClusterID: "cluster-id", UpgradeStatus: tt.upgradeStatus, UpgradePolicyID: "policy-id"}
+			s := NewClusterUpgradeService(db.NewMockConnectionFactory(nil), clusterUpgradeProviderFactory(provider))
+			err := s.CancelUpgrade(cluster)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(provider.CancelUpgradeCalls()).To(gomega.HaveLen(tt.wantCancelCalls))
+			if tt.wantErr {
+				g.Expect(err.Code).To(gomega.Equal(tt.wantCode))
+				g.Expect(cluster.UpgradeStatus).To(gomega.Equal(tt.upgradeStatus))
+				return
+			}
+			g.Expect(cluster.UpgradeStatus).To(gomega.Equal(api.ClusterUpgradeCancelled))
+		})
+	}
+}
+
+func Test_clusterUpgradeService_RefreshUpgradeStatus(t *testing.T) {
+	tests := []struct {
+		name        string
+		upgradeInfo *types.ClusterUpgradeInfo
+		wantStatus  api.ClusterUpgradeStatus
+		wantDetails string
+		wantUpdate  bool
+	}{
+		{
+			name:        "should update the upgrade status when it changed in the cluster provider",
+			upgradeInfo: &types.ClusterUpgradeInfo{State: api.ClusterUpgradeInProgress},
+			wantStatus:  api.ClusterUpgradeInProgress,
+			wantUpdate:  true,
+		},
+		{
+			name:        "should record why the upgrade failed",
+			upgradeInfo: &types.ClusterUpgradeInfo{State: api.ClusterUpgradeFailed, Description: "upgrade timed out"},
+			wantStatus:  api.ClusterUpgradeFailed,
+			wantDetails: "upgrade timed out",
+			wantUpdate:  true,
+		},
+		{
+			name:        "should not update the cluster when the upgrade status did not change",
+			upgradeInfo: &types.ClusterUpgradeInfo{State: api.ClusterUpgradeScheduled},
+			wantStatus:  api.ClusterUpgradeScheduled,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mocket.Catcher.Reset()
+			updateMock := mocket.Catcher.NewMock().WithQuery(`UPDATE "clusters"`).WithRowsNum(1)
+
+			provider := &clusters.ProviderMock{
+				GetUpgradeFunc: func(clusterID string, upgradeID string, version string) (*types.ClusterUpgradeInfo, error) {
+					return tt.upgradeInfo, nil
+				},
+			}
+			cluster := &api.Cluster{ClusterID: "cluster-id", UpgradeStatus: api.ClusterUpgradeScheduled, UpgradePolicyID: "policy-id"}
+			s := NewClusterUpgradeService(db.NewMockConnectionFactory(nil), clusterUpgradeProviderFactory(provider))
+			g.Expect(s.RefreshUpgradeStatus(cluster)).To(gomega.BeNil())
+			g.Expect(cluster.UpgradeStatus).To(gomega.Equal(tt.wantStatus))
+			g.Expect(cluster.UpgradeStatusDetails).To(gomega.Equal(tt.wantDetails))
+			g.Expect(updateMock.Triggered).To(gomega.Equal(tt.wantUpdate))
+		})
+	}
+}
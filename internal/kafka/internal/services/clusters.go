@@ -8,6 +8,7 @@ import (
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
@@ -35,8 +36,8 @@ type ClusterService interface {
 	Create(cluster *api.Cluster) (*api.Cluster, *apiErrors.ServiceError)
 	GetClusterDNS(clusterID string) (string, *apiErrors.ServiceError)
 	GetExternalID(clusterID string) (string, *apiErrors.ServiceError)
-	// ListEnterpriseClustersOfAnOrganization returns a list of enterprise clusters (ClusterID, AccessKafkasViaPrivateNetwork, Cloud Provider, Region, MultiAZ and Status fields only) which belong to organization obtained from the context
-	ListEnterpriseClustersOfAnOrganization(ctx context.Context) ([]*api.Cluster, *apiErrors.ServiceError)
+	// ListEnterpriseClustersOfAnOrganization returns the requested page of enterprise clusters (ClusterID, AccessKafkasViaPrivateNetwork, Cloud Provider, Region, MultiAZ and Status fields only) which belong to organization obtained from the context
+	ListEnterpriseClustersOfAnOrganization(ctx context.Context, listArgs *services.ListArguments) ([]*api.Cluster, *api.PagingMeta, *apiErrors.ServiceError)
 	ListByStatus(state api.ClusterStatus) ([]api.Cluster, *apiErrors.ServiceError)
 	UpdateStatus(cluster api.Cluster, status api.ClusterStatus) error
 	// Update updates a Cluster. Only fields whose value is different than the
@@ -60,7 +61,7 @@ type ClusterService interface {
 	// or are being deprovisioned from it i.e kafka that are not in deleting state.
 	// NOTE. Kafka in "failed" are included as well since it is not a terminal status at the moment.
 	FindNonEmptyClusterByID(clusterID string) (*api.Cluster, *apiErrors.ServiceError)
-	// ListNonEnterpriseClusterIDs returns all the valid cluster ids in array (except enterprise clusters)
+	// ListNonEnterpriseClusterIDs returns all the valid cluster ids, along with the id of the cluster blueprint managing them, in array (except enterprise clusters)
 	ListNonEnterpriseClusterIDs() ([]api.Cluster, *apiErrors.ServiceError)
 	// FindAllClusters return all the valid clusters in array
 	FindAllClusters(criteria FindClusterCriteria) ([]*api.Cluster, error)
@@ -130,33 +131,40 @@ func (c clusterService) DeregisterClusterJob(clusterID string) *apiErrors.Servic
 	return nil
 }
 
-// ListEnterpriseClustersOfAnOrganization returns a list of clusters (ClusterID, AccessKafkasViaPrivateNetwork, CloudProvider, Region, MultiAZ and Status fields only) which belong to organization obtained from the context
-func (c clusterService) ListEnterpriseClustersOfAnOrganization(ctx context.Context) ([]*api.Cluster, *apiErrors.ServiceError) {
+// ListEnterpriseClustersOfAnOrganization returns the requested page of clusters (ClusterID, AccessKafkasViaPrivateNetwork, CloudProvider, Region, MultiAZ and Status fields only) which belong to organization obtained from the context
+func (c clusterService) ListEnterpriseClustersOfAnOrganization(ctx context.Context, listArgs *services.ListArguments) ([]*api.Cluster, *api.PagingMeta, *apiErrors.ServiceError) {
 	claims, err := auth.GetClaimsFromContext(ctx)
 	if err != nil {
-		return nil, apiErrors.NewWithCause(apiErrors.ErrorUnauthenticated, err, "user not authenticated")
+		return nil, nil, apiErrors.NewWithCause(apiErrors.ErrorUnauthenticated, err, "user not authenticated")
 	}
 
 	user, _ := claims.GetUsername()
 	if user == "" {
-		return nil, apiErrors.Unauthenticated("user not authenticated")
+		return nil, nil, apiErrors.Unauthenticated("user not authenticated")
 	}
 
 	orgId, _ := claims.GetOrgId()
 
 	dbConn := c.connectionFactory.New().
-		Model(&api.Cluster{}).Select("id, cluster_id, status, dynamic_capacity_info, access_kafkas_via_private_network, cloud_provider, region, multi_az")
+		Model(&api.Cluster{}).Select("id, cluster_id, status, dynamic_capacity_info, access_kafkas_via_private_network, cloud_provider, region, multi_az").
+		Where("organization_id = ? AND cluster_type = ?", orgId, api.EnterpriseDataPlaneClusterType.String()).
+		Order("clusters.id")
 
 	var clusters []*api.Cluster
 
-	if err := dbConn.Where("organization_id = ? AND cluster_type = ?", orgId, api.EnterpriseDataPlaneClusterType.String()).Scan(&clusters).Error; err != nil {
+	dbConn, pagingMeta, svcErr := listArgs.Paginate(dbConn, &api.Cluster{}, "clusters.id")
+	if svcErr != nil {
+		return nil, nil, svcErr
+	}
+
+	if err := dbConn.Scan(&clusters).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return []*api.Cluster{}, nil
+			return []*api.Cluster{}, pagingMeta, nil
 		}
-		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to list clusters")
+		return nil, nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to list clusters")
 	}
 
-	return clusters, nil
+	return clusters, pagingMeta, nil
 }
 
 // Create Creates a new OpenShift/k8s cluster via the provider and save the details of the cluster in the database
@@ -448,7 +456,7 @@ func (c clusterService) ListNonEnterpriseClusterIDs() ([]api.Cluster, *apiErrors
 	// However, it only down to the level of seconds. This means that if a few records are created at almost the same time,
 	// the order is not guaranteed. So use the `created_at` column will provider better consistency.
 	if err := dbConn.Model(&api.Cluster{}).
-		Select("cluster_id").
+		Select("cluster_id, cluster_blueprint_id").
 		Where("cluster_id != '' ").
 		Where("cluster_type != ? ", api.EnterpriseDataPlaneClusterType.String()). // don't include enterprise clusters
 		Order("created_at asc ").
@@ -777,6 +785,8 @@ type KafkaStreamingUnitCountPerCluster struct {
 	MaxUnits      int32
 	Status        string
 	ClusterType   string
+	// ClusterBlueprintID is set when the cluster is managed by a cluster blueprint
+	ClusterBlueprintID string
 }
 
 func (k KafkaStreamingUnitCountPerCluster) isSame(kafkaPerRegionFromDB *KafkaPerClusterCount) bool {
@@ -829,6 +839,7 @@ type ClusterSelection struct {
 	DynamicCapacityInfo   api.JSON
 	Status                string
 	ClusterType           string
+	ClusterBlueprintID    string
 }
 
 func (c *clusterService) FindStreamingUnitCountByClusterAndInstanceType() (KafkaStreamingUnitCountPerClusterList, error) {
@@ -865,15 +876,16 @@ func (c *clusterService) FindStreamingUnitCountByClusterAndInstanceType() (Kafka
 			}
 
 			streamingUnitsCountPerCluster = append(streamingUnitsCountPerCluster, KafkaStreamingUnitCountPerCluster{
-				CloudProvider: clusterSelection.CloudProvider,
-				ID:            clusterSelection.ID,
-				ClusterId:     clusterSelection.ClusterID,
-				InstanceType:  instanceType,
-				Region:        clusterSelection.Region,
-				Count:         0,
-				MaxUnits:      maxUnits,
-				Status:        clusterSelection.Status,
-				ClusterType:   clusterSelection.ClusterType,
+				CloudProvider:      clusterSelection.CloudProvider,
+				ID:                 clusterSelection.ID,
+				ClusterId:          clusterSelection.ClusterID,
+				InstanceType:       instanceType,
+				Region:             clusterSelection.Region,
+				Count:              0,
+				MaxUnits:           maxUnits,
+				Status:             clusterSelection.Status,
+				ClusterType:        clusterSelection.ClusterType,
+				ClusterBlueprintID: clusterSelection.ClusterBlueprintID,
 			})
 		}
 	}
@@ -17,6 +17,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
 	apiErrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
 	"github.com/onsi/gomega"
 	"github.com/pkg/errors"
 	mocket "github.com/selvatico/go-mocket"
@@ -839,7 +840,8 @@ func Test_RegisterClusterJob(t *testing.T) {
 
 func Test_ListEnterpriseClustersOfAnOrganization(t *testing.T) {
 	type args struct {
-		ctx context.Context
+		ctx      context.Context
+		listArgs *services.ListArguments
 	}
 
 	type fields struct {
@@ -865,12 +867,13 @@ func Test_ListEnterpriseClustersOfAnOrganization(t *testing.T) {
 	clusterID := "npnbplmrku0bgnzj82h5uszyxwbetdwd"
 
 	tests := []struct {
-		name    string
-		fields  fields
-		args    args
-		want    []*api.Cluster
-		wantErr bool
-		setupFn func([]*api.Cluster)
+		name       string
+		fields     fields
+		args       args
+		want       []*api.Cluster
+		wantPaging *api.PagingMeta
+		wantErr    bool
+		setupFn    func([]*api.Cluster)
 	}{
 		{
 			name: "should successfully list enterprise clusters",
@@ -878,9 +881,11 @@ func Test_ListEnterpriseClustersOfAnOrganization(t *testing.T) {
 				connectionFactory: db.NewMockConnectionFactory(nil),
 			},
 			args: args{
-				ctx: authenticatedCtx,
+				ctx:      authenticatedCtx,
+				listArgs: &services.ListArguments{Page: 1, Size: 100},
 			},
-			wantErr: false,
+			wantErr:    false,
+			wantPaging: &api.PagingMeta{Page: 1, Size: 1, Total: 1},
 			want: []*api.Cluster{
 				{
 					ClusterID:                     clusterID,
@@ -905,8 +910,10 @@ func Test_ListEnterpriseClustersOfAnOrganization(t *testing.T) {
 					},
 				}
 
-				query := `SELECT id, cluster_id, status, dynamic_capacity_info, access_kafkas_via_private_network, cloud_provider, region, multi_az FROM "clusters" WHERE (organization_id = $1 AND cluster_type = $2) AND "clusters"."deleted_at" IS NULL`
+				countQuery := `SELECT count(1) FROM "clusters" WHERE (organization_id = $1 AND cluster_type = $2) AND "clusters"."deleted_at" IS NULL`
+				query := `SELECT id, cluster_id, status, dynamic_capacity_info, access_kafkas_via_private_network, cloud_provider, region, multi_az FROM "clusters" WHERE (organization_id = $1 AND cluster_type = $2) AND "clusters"."deleted_at" IS NULL ORDER BY clusters.id LIMIT 1`
 
+				mocket.Catcher.NewMock().WithQuery(countQuery).WithReply([]map[string]interface{}{{"count": len(response)}})
 				mocket.Catcher.NewMock().WithQuery(query).WithReply(response)
 				mocket.Catcher.NewMock().WithExecException().WithQueryException()
 			},
@@ -917,7 +924,8 @@ func Test_ListEnterpriseClustersOfAnOrganization(t *testing.T) {
 				connectionFactory: db.NewMockConnectionFactory(nil),
 			},
 			args: args{
-				ctx: context.TODO(),
+				ctx:      context.TODO(),
+				listArgs: &services.ListArguments{Page: 1, Size: 100},
 			},
 			want:    nil,
 			wantErr: true,
@@ -938,7 +946,8 @@ func Test_ListEnterpriseClustersOfAnOrganization(t *testing.T) {
 				connectionFactory: db.NewMockConnectionFactory(nil),
 			},
 			args: args{
-				ctx: authenticatedCtx,
+				ctx:      authenticatedCtx,
+				listArgs: &services.ListArguments{Page: 1, Size: 100},
 			},
 			want:    nil,
 			wantErr: true,
@@ -958,9 +967,10 @@ func Test_ListEnterpriseClustersOfAnOrganization(t *testing.T) {
 				connectionFactory: tt.fields.connectionFactory,
 			}
 
-			result, err := c.ListEnterpriseClustersOfAnOrganization(tt.args.ctx)
+			result, paging, err := c.ListEnterpriseClustersOfAnOrganization(tt.args.ctx, tt.args.listArgs)
 
 			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(paging).To(gomega.Equal(tt.wantPaging))
 
 			g.Expect(len(result)).To(gomega.Equal(len(tt.want)))
 
@@ -1206,7 +1216,7 @@ func Test_clusterService_ListNonEnterpriseClusterIDs(t *testing.T) {
 				connectionFactory: db.NewMockConnectionFactory(nil),
 			},
 			setupFn: func() {
-				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT "cluster_id" FROM "clusters"`)
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT cluster_id, cluster_blueprint_id FROM "clusters"`)
 				mocket.Catcher.NewMock().WithQueryException().WithExecException()
 			},
 			want:    nil,
@@ -1218,7 +1228,7 @@ func Test_clusterService_ListNonEnterpriseClusterIDs(t *testing.T) {
 				connectionFactory: db.NewMockConnectionFactory(nil),
 			},
 			setupFn: func() {
-				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT "cluster_id" FROM "clusters" WHERE cluster_id != ''`).WithReply([]map[string]interface{}{
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT cluster_id, cluster_blueprint_id FROM "clusters" WHERE cluster_id != ''`).WithReply([]map[string]interface{}{
 					{
 						"cluster_id": "test01",
 					},
@@ -9,6 +9,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/ocm"
 	serviceError "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
 	"sync"
 )
 
@@ -94,7 +95,7 @@ var _ ClusterService = &ClusterServiceMock{}
 //			ListByStatusFunc: func(state api.ClusterStatus) ([]api.Cluster, *serviceError.ServiceError) {
 //				panic("mock out the ListByStatus method")
 //			},
-//			ListEnterpriseClustersOfAnOrganizationFunc: func(ctx context.Context) ([]*api.Cluster, *serviceError.ServiceError) {
+//			ListEnterpriseClustersOfAnOrganizationFunc: func(ctx context.Context, listArgs *services.ListArguments) ([]*api.Cluster, *api.PagingMeta, *serviceError.ServiceError) {
 //				panic("mock out the ListEnterpriseClustersOfAnOrganization method")
 //			},
 //			ListGroupByProviderAndRegionFunc: func(providers []string, regions []string, status []string) ([]*ResGroupCPRegion, *serviceError.ServiceError) {
@@ -198,7 +199,7 @@ type ClusterServiceMock struct {
 	ListByStatusFunc func(state api.ClusterStatus) ([]api.Cluster, *serviceError.ServiceError)
 
 	// ListEnterpriseClustersOfAnOrganizationFunc mocks the ListEnterpriseClustersOfAnOrganization method.
-	ListEnterpriseClustersOfAnOrganizationFunc func(ctx context.Context) ([]*api.Cluster, *serviceError.ServiceError)
+	ListEnterpriseClustersOfAnOrganizationFunc func(ctx context.Context, listArgs *services.ListArguments) ([]*api.Cluster, *api.PagingMeta, *serviceError.ServiceError)
 
 	// ListGroupByProviderAndRegionFunc mocks the ListGroupByProviderAndRegion method.
 	ListGroupByProviderAndRegionFunc func(providers []string, regions []string, status []string) ([]*ResGroupCPRegion, *serviceError.ServiceError)
@@ -359,6 +360,8 @@ type ClusterServiceMock struct {
 		ListEnterpriseClustersOfAnOrganization []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
+			// ListArgs is the listArgs argument value.
+			ListArgs *services.ListArguments
 		}
 		// ListGroupByProviderAndRegion holds details about calls to the ListGroupByProviderAndRegion method.
 		ListGroupByProviderAndRegion []struct {
@@ -1230,19 +1233,21 @@ func (mock *ClusterServiceMock) ListByStatusCalls() []struct {
 }
 
 // ListEnterpriseClustersOfAnOrganization calls ListEnterpriseClustersOfAnOrganizationFunc.
-func (mock *ClusterServiceMock) ListEnterpriseClustersOfAnOrganization(ctx context.Context) ([]*api.Cluster, *serviceError.ServiceError) {
+func (mock *ClusterServiceMock) ListEnterpriseClustersOfAnOrganization(ctx context.Context, listArgs *services.ListArguments) ([]*api.Cluster, *api.PagingMeta, *serviceError.ServiceError) {
 	if mock.ListEnterpriseClustersOfAnOrganizationFunc == nil {
 		panic("ClusterServiceMock.ListEnterpriseClustersOfAnOrganizationFunc: method is nil but ClusterService.ListEnterpriseClustersOfAnOrganization was just called")
 	}
 	callInfo := struct {
-		Ctx context.Context
+		Ctx      context.Context
+		ListArgs *services.ListArguments
 	}{
-		Ctx: ctx,
+		Ctx:      ctx,
+		ListArgs: listArgs,
 	}
 	mock.lockListEnterpriseClustersOfAnOrganization.Lock()
 	mock.calls.ListEnterpriseClustersOfAnOrganization = append(mock.calls.ListEnterpriseClustersOfAnOrganization, callInfo)
 	mock.lockListEnterpriseClustersOfAnOrganization.Unlock()
-	return mock.ListEnterpriseClustersOfAnOrganizationFunc(ctx)
+	return mock.ListEnterpriseClustersOfAnOrganizationFunc(ctx, listArgs)
 }
 
 // ListEnterpriseClustersOfAnOrganizationCalls gets all the calls that were made to ListEnterpriseClustersOfAnOrganization.
@@ -1250,10 +1255,12 @@ func (mock *ClusterServiceMock) ListEnterpriseClustersOfAnOrganization(ctx conte
 //
 //	len(mockedClusterService.ListEnterpriseClustersOfAnOrganizationCalls())
 func (mock *ClusterServiceMock) ListEnterpriseClustersOfAnOrganizationCalls() []struct {
-	Ctx context.Context
+	Ctx      context.Context
+	ListArgs *services.ListArguments
 } {
 	var calls []struct {
-		Ctx context.Context
+		Ctx      context.Context
+		ListArgs *services.ListArguments
 	}
 	mock.lockListEnterpriseClustersOfAnOrganization.RLock()
 	calls = mock.calls.ListEnterpriseClustersOfAnOrganization
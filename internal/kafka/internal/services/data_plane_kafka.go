@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strings"
 	"time"
 
@@ -223,6 +224,11 @@ func (d *dataPlaneKafkaService) processRealKafkaDeployment(ks *dbapi.DataPlaneKa
 	if e != nil {
 		log.Error(errors.Wrapf(e, "Error updating kafka '%q' version fields", ks.KafkaClusterId))
 	}
+
+	e = d.setKafkaRequestActualKafkaConfig(kafka, ks)
+	if e != nil {
+		log.Error(errors.Wrapf(e, "Error updating kafka '%q' actual kafka config", ks.KafkaClusterId))
+	}
 }
 
 func (d *dataPlaneKafkaService) setKafkaClusterReady(kafka *dbapi.KafkaRequest) *serviceError.ServiceError {
@@ -340,6 +346,34 @@ func (d *dataPlaneKafkaService) setKafkaRequestVersionFields(kafka *dbapi.KafkaR
 	return nil
 }
 
+// setKafkaRequestActualKafkaConfig stores the kafka config overrides that the data plane reports as applied.
+// Nothing is done when the data plane did not report them.
+func (d *dataPlaneKafkaService) setKafkaRequestActualKafkaConfig(kafka *dbapi.KafkaRequest, status *dbapi.DataPlaneKafkaStatus) *serviceError.ServiceError {
+	if status.KafkaConfig == nil {
+		return nil
+	}
+
+	actualKafkaConfig, err := kafka.GetActualKafkaConfig()
+	if err != nil {
+		return serviceError.NewWithCause(serviceError.ErrorGeneral, err, "failed to get actual kafka config of kafka %q", kafka.ID)
+	}
+
+	if (len(actualKafkaConfig) == 0 && len(status.KafkaConfig) == 0) || reflect.DeepEqual(actualKafkaConfig, status.KafkaConfig) {
+		return nil
+	}
+
+	if err := kafka.SetActualKafkaConfig(status.KafkaConfig); err != nil {
+		return serviceError.NewWithCause(serviceError.ErrorGeneral, err, "failed to set actual kafka config of kafka %q", kafka.ID)
+	}
+
+	logger.Logger.Infof("Updating actual kafka config for Kafka ID %q", kafka.ID)
+	if err := d.kafkaService.Updates(kafka, map[string]interface{}{"actual_kafka_config": kafka.ActualKafkaConfig}); err != nil {
+		return serviceError.NewWithCause(err.Code, err, "failed to update actual kafka config for kafka %q", kafka.ID)
+	}
+
+	return nil
+}
+
 func (d *dataPlaneKafkaService) setKafkaClusterFailed(kafka *dbapi.KafkaRequest, errMessage string) *serviceError.ServiceError {
 	// if kafka was already reported as failed we don't do anything
 	if kafka.Status == string(constants.KafkaRequestStatusFailed) {
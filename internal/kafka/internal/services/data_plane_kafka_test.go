@@ -1087,6 +1087,75 @@ func TestDataPlaneKafkaService_UpdateVersions(t *testing.T) {
 	}
 }
 
+func Test_dataPlaneKafkaService_setKafkaRequestActualKafkaConfig(t *testing.T) {
+	tests := []struct {
+		name             string
+		kafka            *dbapi.KafkaRequest
+		status           *dbapi.DataPlaneKafkaStatus
+		wantErr          bool
+		wantUpdate       bool
+		wantActualConfig map[string]string
+	}{
+		{
+			name:       "should not update the kafka when the data plane did not report the kafka config",
+			kafka:      &dbapi.KafkaRequest{ActualKafkaConfig: []byte(`{"auto.create.topics.enable":"true"}`)},
+			status:     &dbapi.DataPlaneKafkaStatus{},
+			wantUpdate: false,
+		},
+		{
+			name:       "should not update the kafka when the reported kafka config did not change",
+			kafka:      &dbapi.KafkaRequest{ActualKafkaConfig: []byte(`{"auto.create.topics.enable":"true"}`)},
+			status:     &dbapi.DataPlaneKafkaStatus{KafkaConfig: map[string]string{"auto.create.topics.enable": "true"}},
+			wantUpdate: false,
+		},
+		{
+			name:       "should not update the kafka when the reported kafka config is empty and no config was stored",
+			kafka:      &dbapi.KafkaRequest{},
+			status:     &dbapi.DataPlaneKafkaStatus{KafkaConfig: map[string]string{}},
+			wantUpdate: false,
+		},
+		{
+			name:             "should update the kafka when the reported kafka config changed",
+			kafka:            &dbapi.KafkaRequest{ActualKafkaConfig: []byte(`{"auto.create.topics.enable":"true"}`)},
+			status:           &dbapi.DataPlaneKafkaStatus{KafkaConfig: map[string]string{"auto.create.topics.enable": "false", "compression.type": "gzip"}},
+			wantUpdate:       true,
+			wantActualConfig: map[string]string{"auto.create.topics.enable": "false", "compression.type": "gzip"},
+		},
+		{
+			name:             "should clear the actual kafka config when the data plane reports no overrides",
+			kafka:            &dbapi.KafkaRequest{ActualKafkaConfig: []byte(`{"auto.create.topics.enable":"true"}`)},
+			status:           &dbapi.DataPlaneKafkaStatus{KafkaConfig: map[string]string{}},
+			wantUpdate:       true,
+			wantActualConfig: nil,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			updated := false
+			s := &dataPlaneKafkaService{
+				kafkaService: &KafkaServiceMock{
+					UpdatesFunc: func(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *errors.ServiceError {
+						updated = true
+						g.Expect(values).To(gomega.HaveKey("actual_kafka_config"))
+						return nil
+					},
+				},
+			}
+			err := s.setKafkaRequestActualKafkaConfig(tt.kafka, tt.status)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(updated).To(gomega.Equal(tt.wantUpdate))
+			if tt.wantUpdate {
+				actualConfig, e := tt.kafka.GetActualKafkaConfig()
+				g.Expect(e).ToNot(gomega.HaveOccurred())
+				g.Expect(actualConfig).To(gomega.Equal(tt.wantActualConfig))
+			}
+		})
+	}
+}
+
 func Test_DataPlaneKafkaStatus_getManagedKafkaStatus(t *testing.T) {
 	type args struct {
 		status *dbapi.DataPlaneKafkaStatus
@@ -16,8 +16,8 @@ import (
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
-	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/cloudproviders"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/dns"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/kafkas/types"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services/kafkatlscertmgmt"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
@@ -34,10 +34,8 @@ import (
 	managedkafka "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api/managedkafkas.managedkafka.bf2.org/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
-	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
-	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/aws"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
@@ -73,10 +71,7 @@ const (
 
 const CanaryServiceAccountPrefix = "canary"
 
-type CNameRecordStatus struct {
-	Id     *string
-	Status *string
-}
+const kafkaRouteCNAMERecordTTL = 5 * time.Minute
 
 //go:generate moq -out kafkaservice_moq.go . KafkaService
 type KafkaService interface {
@@ -110,6 +105,8 @@ type KafkaService interface {
 	GenerateReservedManagedKafkasByClusterID(clusterID string) ([]managedkafka.ManagedKafka, *errors.ServiceError)
 	RegisterKafkaJob(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError
 	ListByStatus(status ...constants.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError)
+	// ListShardByStatus lists the kafkas with the given statuses restricted by the shard scope of a sharded worker, see workers.BaseWorker.ShardScope
+	ListShardByStatus(shardScope func(*gorm.DB) *gorm.DB, status ...constants.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError)
 	// UpdateStatus change the status of the Kafka cluster
 	// The returned boolean is to be used to know if the update has been tried or not. An update is not tried if the
 	// original status is 'deprovision' (cluster in deprovision state can't be change state) or if the final status is the
@@ -121,8 +118,14 @@ type KafkaService interface {
 	// Use this only when you want to update the multiple columns that may contain zero-fields, otherwise use the `KafkaService.Update()` method.
 	// See https://gorm.io/docs/update.html#Updates-multiple-columns for more info
 	Updates(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *errors.ServiceError
-	ChangeKafkaCNAMErecords(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError)
-	GetCNAMERecordStatus(kafkaRequest *dbapi.KafkaRequest) (*CNameRecordStatus, error)
+	// MoveToCluster places the kafka back in the accepted status on the given cluster, provided it has not changed since
+	// it was read, i.e. it is still in the same status and placement and has not been given a bootstrap server host.
+	// The returned boolean is false when the kafka has changed and has not been moved
+	MoveToCluster(kafkaRequest *dbapi.KafkaRequest, clusterID string) (bool, *errors.ServiceError)
+	// ChangeKafkaCNAMErecords creates or deletes the CNAME records of the routes of the kafka in the DNS provider of its cloud provider
+	ChangeKafkaCNAMErecords(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*dns.Change, *errors.ServiceError)
+	// GetCNAMERecordStatus returns the propagation status of the creation of the CNAME records of the routes of the kafka
+	GetCNAMERecordStatus(kafkaRequest *dbapi.KafkaRequest) (*dns.Change, error)
 	AssignInstanceType(owner string, organisationID string) (types.KafkaInstanceType, *errors.ServiceError)
 	RegisterKafkaDeprovisionJob(ctx context.Context, id string) *errors.ServiceError
 	// DeprovisionKafkaForUsers registers all kafkas for deprovisioning given the list of owners
@@ -156,36 +159,39 @@ type kafkaService struct {
 	clusterService                       ClusterService
 	keycloakService                      sso.KeycloakService
 	kafkaConfig                          *config.KafkaConfig
-	awsConfig                            *config.AWSConfig
 	quotaServiceFactory                  QuotaServiceFactory
 	mu                                   sync.Mutex
-	awsClientFactory                     aws.ClientFactory
+	dnsProviderFactory                   dns.ProviderFactory
 	authService                          authorization.Authorization
 	dataplaneClusterConfig               *config.DataplaneClusterConfig
 	providerConfig                       *config.ProviderConfig
 	clusterPlacementStrategy             ClusterPlacementStrategy
 	kafkaTLSCertificateManagementService kafkatlscertmgmt.KafkaTLSCertificateManagementService
+	capacityForecastService              CapacityForecastService
+	privateEndpointService               PrivateEndpointService
 }
 
 func NewKafkaService(
 	connectionFactory *db.ConnectionFactory, clusterService ClusterService, keycloakService sso.KafkaKeycloakService,
-	kafkaConfig *config.KafkaConfig, dataplaneClusterConfig *config.DataplaneClusterConfig, awsConfig *config.AWSConfig,
-	quotaServiceFactory QuotaServiceFactory, awsClientFactory aws.ClientFactory, authorizationService authorization.Authorization,
+	kafkaConfig *config.KafkaConfig, dataplaneClusterConfig *config.DataplaneClusterConfig,
+	quotaServiceFactory QuotaServiceFactory, dnsProviderFactory dns.ProviderFactory, authorizationService authorization.Authorization,
 	providerConfig *config.ProviderConfig, clusterPlacementStrategy ClusterPlacementStrategy,
-	kafkaTLSCertificateManagementService kafkatlscertmgmt.KafkaTLSCertificateManagementService) *kafkaService {
+	kafkaTLSCertificateManagementService kafkatlscertmgmt.KafkaTLSCertificateManagementService,
+	capacityForecastService CapacityForecastService, privateEndpointService PrivateEndpointService) *kafkaService {
 	return &kafkaService{
 		connectionFactory:                    connectionFactory,
 		clusterService:                       clusterService,
 		keycloakService:                      keycloakService,
 		kafkaConfig:                          kafkaConfig,
-		awsConfig:                            awsConfig,
 		quotaServiceFactory:                  quotaServiceFactory,
-		awsClientFactory:                     awsClientFactory,
+		dnsProviderFactory:                   dnsProviderFactory,
 		authService:                          authorizationService,
 		dataplaneClusterConfig:               dataplaneClusterConfig,
 		providerConfig:                       providerConfig,
 		clusterPlacementStrategy:             clusterPlacementStrategy,
 		kafkaTLSCertificateManagementService: kafkaTLSCertificateManagementService,
+		capacityForecastService:              capacityForecastService,
+		privateEndpointService:               privateEndpointService,
 	}
 }
 
@@ -414,6 +420,8 @@ func (k *kafkaService) RegisterKafkaJob(kafkaRequest *dbapi.KafkaRequest) *error
 			return err
 		}
 		if !hasCapacity {
+			// the request is part of the demand the data plane capacity should have been able to absorb
+			k.recordKafkaCreation(kafkaRequest)
 			errorMsg := fmt.Sprintf("capacity exhausted in '%s' region for '%s' instance type", kafkaRequest.Region, kafkaRequest.InstanceType)
 			logger.Logger.Warningf(errorMsg)
 			return errors.TooManyKafkaInstancesReached(fmt.Sprintf("region %s cannot accept instance type: %s at this moment", kafkaRequest.Region, kafkaRequest.InstanceType))
@@ -482,9 +490,36 @@ func (k *kafkaService) RegisterKafkaJob(kafkaRequest *dbapi.KafkaRequest) *error
 
 	metrics.UpdateKafkaRequestsStatusSinceCreatedMetric(constants.KafkaRequestStatusAccepted, kafkaRequest.ID, kafkaRequest.ClusterID, time.Since(kafkaRequest.CreatedAt))
 
+	if !kafkaRequest.DesiredBillingModelIsEnterprise() {
+		k.recordKafkaCreation(kafkaRequest)
+	}
+
 	return nil
 }
 
+// recordKafkaCreation adds the kafka request to the creation history used to forecast the data plane capacity demand.
+// Failing to record it does not fail the kafka creation as it only makes the forecast slightly less accurate
+func (k *kafkaService) recordKafkaCreation(kafkaRequest *dbapi.KafkaRequest) {
+	if !k.dataplaneClusterConfig.IsDataPlaneAutoScalingEnabled() {
+		return
+	}
+
+	instanceType, err := k.kafkaConfig.SupportedInstanceTypes.Configuration.GetKafkaInstanceTypeByID(kafkaRequest.InstanceType)
+	if err != nil {
+		logger.Logger.Warningf("unable to record creation of kafka %q: %v", kafkaRequest.ID, err)
+		return
+	}
+	size, err := instanceType.GetKafkaInstanceSizeByID(kafkaRequest.SizeId)
+	if err != nil {
+		logger.Logger.Warningf("unable to record creation of kafka %q: %v", kafkaRequest.ID, err)
+		return
+	}
+
+	if svcErr := k.capacityForecastService.RecordKafkaCreation(kafkaRequest, size.CapacityConsumed); svcErr != nil {
+		logger.Logger.Warningf("unable to record creation of kafka %q: %v", kafkaRequest.ID, svcErr)
+	}
+}
+
 func (k *kafkaService) findADataPlaneClusterToPlaceTheKafka(kafkaRequest *dbapi.KafkaRequest) (*api.Cluster, *errors.ServiceError) {
 	cluster, e := k.clusterPlacementStrategy.FindCluster(kafkaRequest)
 	if e != nil || cluster == nil {
@@ -580,6 +615,10 @@ func (k *kafkaService) PrepareKafkaRequest(kafkaRequest *dbapi.KafkaRequest) *er
 }
 
 func (k *kafkaService) ListByStatus(status ...constants.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+	return k.ListShardByStatus(func(dbConn *gorm.DB) *gorm.DB { return dbConn }, status...)
+}
+
+func (k *kafkaService) ListShardByStatus(shardScope func(*gorm.DB) *gorm.DB, status ...constants.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
 	if len(status) == 0 {
 		return nil, errors.GeneralError("no status provided")
 	}
@@ -587,7 +626,7 @@ func (k *kafkaService) ListByStatus(status ...constants.KafkaStatus) ([]*dbapi.K
 
 	var kafkas []*dbapi.KafkaRequest
 
-	if err := dbConn.Model(&dbapi.KafkaRequest{}).Where("status IN (?)", status).Scan(&kafkas).Error; err != nil {
+	if err := dbConn.Model(&dbapi.KafkaRequest{}).Where("status IN (?)", status).Scopes(shardScope).Scan(&kafkas).Error; err != nil {
 		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list by status")
 	}
 
@@ -700,6 +739,14 @@ func (k *kafkaService) RegisterKafkaDeprovisionJob(ctx context.Context, id strin
 	}
 	metrics.IncreaseKafkaTotalOperationsCountMetric(constants.KafkaOperationDeprovision)
 
+	// the connections to the private endpoint are rejected before the data plane removes the kafka. A failure is
+	// retried by the private endpoint manager, which deletes the endpoint services of all the deprovisioning kafkas
+	if kafkaRequest.PrivateConnectivity {
+		if err := k.privateEndpointService.DeletePrivateEndpoint(&kafkaRequest); err != nil {
+			glog.Warningf("failed to delete the private endpoint of kafka %q, it will be retried: %v", kafkaRequest.ID, err)
+		}
+	}
+
 	deprovisionStatus := constants.KafkaRequestStatusDeprovision
 
 	if executed, err := k.UpdateStatus(id, deprovisionStatus); executed {
@@ -826,6 +873,13 @@ func (k *kafkaService) Delete(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceE
 			}
 		}
 
+		// the private endpoint is normally deleted once the kafka is deprovisioned. It is deleted here if that failed,
+		// as the endpoint service could no longer be found once the kafka request is deleted
+		if kafkaRequest.PrivateConnectivity {
+			if err := k.privateEndpointService.DeletePrivateEndpoint(kafkaRequest); err != nil {
+				return err
+			}
+		}
 	}
 
 	// only revoke the certificates if they have been generated and the certificate is not shared among all kafkas
@@ -858,14 +912,16 @@ func (k *kafkaService) ListAll() (dbapi.KafkaList, *errors.ServiceError) {
 	return kafkaRequestList, nil
 }
 
+// GetSearchKafkaColumns returns the typed columns Kafka requests can be searched by
+func GetSearchKafkaColumns() []coreServices.Column {
+	columns := coreServices.TypedColumns(coreServices.StringColumn, "region", "name", "cloud_provider", "status", "owner", "cluster_id", "instance_type")
+	return append(columns, coreServices.TypedColumns(coreServices.TimestampColumn, "created_at", "updated_at", "expires_at")...)
+}
+
 // List returns all Kafka requests belonging to a user.
 func (k *kafkaService) List(ctx context.Context, listArgs *services.ListArguments) (dbapi.KafkaList, *api.PagingMeta, *errors.ServiceError) {
 	var kafkaRequestList dbapi.KafkaList
 	dbConn := k.connectionFactory.New()
-	pagingMeta := &api.PagingMeta{
-		Page: listArgs.Page,
-		Size: listArgs.Size,
-	}
 
 	claims, err := auth.GetClaimsFromContext(ctx)
 	if err != nil {
@@ -893,9 +949,9 @@ func (k *kafkaService) List(ctx context.Context, listArgs *services.ListArgument
 
 	// Apply search query
 	if len(listArgs.Search) > 0 {
-		searchDbQuery, err := coreServices.NewQueryParser().Parse(listArgs.Search)
+		searchDbQuery, err := coreServices.NewTypedQueryParser(GetSearchKafkaColumns()...).Parse(listArgs.Search)
 		if err != nil {
-			return kafkaRequestList, pagingMeta, errors.NewWithCause(errors.ErrorFailedToParseSearch, err, "unable to list kafka requests: %s", err.Error())
+			return kafkaRequestList, nil, errors.NewWithCause(errors.ErrorFailedToParseSearch, err, "unable to list kafka requests: %s", err.Error())
 		}
 		dbConn = dbConn.Where(searchDbQuery.Query, searchDbQuery.Values...)
 	}
@@ -910,14 +966,10 @@ func (k *kafkaService) List(ctx context.Context, listArgs *services.ListArgument
 		dbConn = dbConn.Order(orderByArg)
 	}
 
-	// set total, limit and paging (based on https://gitlab.cee.redhat.com/service/api-guidelines#user-content-paging)
-	total := int64(pagingMeta.Total)
-	dbConn.Model(&kafkaRequestList).Count(&total)
-	pagingMeta.Total = int(total)
-	if pagingMeta.Size > pagingMeta.Total {
-		pagingMeta.Size = pagingMeta.Total
+	dbConn, pagingMeta, svcErr := listArgs.Paginate(dbConn, &kafkaRequestList, "kafka_requests.id")
+	if svcErr != nil {
+		return nil, nil, svcErr
 	}
-	dbConn = dbConn.Offset((pagingMeta.Page - 1) * pagingMeta.Size).Limit(pagingMeta.Size)
 
 	// execute query
 	if err := dbConn.Find(&kafkaRequestList).Error; err != nil {
@@ -1042,6 +1094,23 @@ func (k *kafkaService) Updates(kafkaRequest *dbapi.KafkaRequest, fields map[stri
 	return nil
 }
 
+func (k *kafkaService) MoveToCluster(kafkaRequest *dbapi.KafkaRequest, clusterID string) (bool, *errors.ServiceError) {
+	result := k.connectionFactory.New().
+		Model(&dbapi.KafkaRequest{}).
+		Where("id = ? AND status = ? AND placement_id = ? AND bootstrap_server_host = ?",
+			kafkaRequest.ID, kafkaRequest.Status, kafkaRequest.PlacementId, kafkaRequest.BootstrapServerHost).
+		Updates(map[string]interface{}{
+			"cluster_id":   clusterID,
+			"placement_id": api.NewID(),
+			"status":       constants.KafkaRequestStatusAccepted.String(),
+		})
+	if result.Error != nil {
+		return false, errors.NewWithCause(errors.ErrorGeneral, result.Error, "failed to move kafka %q onto cluster %q", kafkaRequest.ID, clusterID)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
 func (k *kafkaService) VerifyAndUpdateKafkaAdmin(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
 	if !auth.GetIsAdminFromContext(ctx) {
 		return errors.New(errors.ErrorUnauthenticated, "user not authenticated")
@@ -1090,62 +1159,37 @@ func (k *kafkaService) UpdateStatus(id string, status constants.KafkaStatus) (bo
 	return true, nil
 }
 
-func (k *kafkaService) ChangeKafkaCNAMErecords(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError) {
+func (k *kafkaService) ChangeKafkaCNAMErecords(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*dns.Change, *errors.ServiceError) {
 	routes, err := kafkaRequest.GetRoutes()
 	if routes == nil || err != nil {
 		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to get routes")
 	}
 
-	domainRecordBatch := buildKafkaClusterCNAMESRecordBatch(routes, action)
-
-	awsConfig := aws.Config{
-		AccessKeyID:     k.awsConfig.Route53.AccessKey,
-		SecretAccessKey: k.awsConfig.Route53.SecretAccessKey,
-	}
-
-	route53Region, err := k.getRoute53RegionFromKafkaRequest(kafkaRequest)
+	dnsProvider, err := k.dnsProviderFactory.GetProvider(kafkaRequest.CloudProvider)
 	if err != nil {
-		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "error getting route 53 region from kafka request")
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to get the DNS provider of cloud provider %q", kafkaRequest.CloudProvider)
 	}
 
-	awsClient, err := k.awsClientFactory.NewClient(awsConfig, route53Region)
-	if err != nil {
-		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to create aws client")
-	}
-
-	changeRecordsOutput, err := awsClient.ChangeResourceRecordSets(k.kafkaConfig.KafkaDomainName, domainRecordBatch)
+	change, err := dnsProvider.ChangeRecords(context.Background(), k.kafkaConfig.KafkaDomainName, buildKafkaRoutesCNAMERecordChanges(routes, action))
 	if err != nil {
 		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to create domain record sets")
 	}
 
-	return changeRecordsOutput, nil
+	return change, nil
 }
 
-func (k *kafkaService) GetCNAMERecordStatus(kafkaRequest *dbapi.KafkaRequest) (*CNameRecordStatus, error) {
-	awsConfig := aws.Config{
-		AccessKeyID:     k.awsConfig.Route53.AccessKey,
-		SecretAccessKey: k.awsConfig.Route53.SecretAccessKey,
-	}
-
-	route53Region, err := k.getRoute53RegionFromKafkaRequest(kafkaRequest)
-	if err != nil {
-		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "error getting route 53 region from kafka request")
-	}
-
-	awsClient, err := k.awsClientFactory.NewClient(awsConfig, route53Region)
+func (k *kafkaService) GetCNAMERecordStatus(kafkaRequest *dbapi.KafkaRequest) (*dns.Change, error) {
+	dnsProvider, err := k.dnsProviderFactory.GetProvider(kafkaRequest.CloudProvider)
 	if err != nil {
-		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to create aws client")
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to get the DNS provider of cloud provider %q", kafkaRequest.CloudProvider)
 	}
 
-	changeOutput, err := awsClient.GetChange(kafkaRequest.RoutesCreationId)
+	change, err := dnsProvider.GetChange(context.Background(), k.kafkaConfig.KafkaDomainName, kafkaRequest.RoutesCreationId)
 	if err != nil {
-		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to get status of Route53 change batch request with ID %q", kafkaRequest.RoutesCreationId)
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to get status of the DNS change with ID %q", kafkaRequest.RoutesCreationId)
 	}
 
-	return &CNameRecordStatus{
-		Id:     changeOutput.ChangeInfo.Id,
-		Status: changeOutput.ChangeInfo.Status,
-	}, nil
+	return change, nil
 }
 
 type KafkaStatusCount struct {
@@ -1217,6 +1261,11 @@ func buildManagedKafkaCR(kafkaRequest *dbapi.KafkaRequest, kafkaConfig *config.K
 		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list kafka request")
 	}
 
+	desiredKafkaConfig, err := kafkaRequest.GetDesiredKafkaConfig()
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to get desired kafka config of kafka request")
+	}
+
 	labels := map[string]string{
 		"bf2.org/kafkaInstanceProfileQuotaConsumed":   strconv.Itoa(k.QuotaConsumed),
 		"bf2.org/kafkaInstanceProfileType":            kafkaRequest.InstanceType,
@@ -1250,14 +1299,16 @@ func buildManagedKafkaCR(kafkaRequest *dbapi.KafkaRequest, kafkaConfig *config.K
 			},
 			Endpoint: managedkafka.EndpointSpec{
 				BootstrapServerHost: kafkaRequest.BootstrapServerHost,
+				PrivateConnectivity: kafkaRequest.PrivateConnectivity,
 			},
 			Versions: managedkafka.VersionsSpec{
 				Kafka:    kafkaRequest.DesiredKafkaVersion,
 				Strimzi:  kafkaRequest.DesiredStrimziVersion,
 				KafkaIBP: kafkaRequest.DesiredKafkaIBPVersion,
 			},
-			Deleted: kafkaRequest.Status == constants.KafkaRequestStatusDeprovision.String(),
-			Owners:  buildKafkaOwner(kafkaRequest, kafkaConfig),
+			KafkaConfig: desiredKafkaConfig,
+			Deleted:     kafkaRequest.Status == constants.KafkaRequestStatusDeprovision.String(),
+			Owners:      buildKafkaOwner(kafkaRequest, kafkaConfig),
 		},
 		Status: managedkafka.ManagedKafkaStatus{},
 	}
@@ -1376,39 +1427,21 @@ func buildKafkaOwner(kafkaRequest *dbapi.KafkaRequest, kafkaConfig *config.Kafka
 	}
 }
 
-func buildKafkaClusterCNAMESRecordBatch(routes []dbapi.DataPlaneKafkaRoute, action KafkaRoutesAction) *route53.ChangeBatch {
-	var changes []*route53.Change
+func buildKafkaRoutesCNAMERecordChanges(routes []dbapi.DataPlaneKafkaRoute, action KafkaRoutesAction) []dns.RecordChange {
+	changes := make([]dns.RecordChange, 0, len(routes))
 	for _, r := range routes {
-		c := buildResourceRecordChange(r.Domain, r.Router, action)
-		changes = append(changes, c)
-	}
-	recordChangeBatch := &route53.ChangeBatch{
-		Changes: changes,
-	}
-
-	return recordChangeBatch
-}
-
-func buildResourceRecordChange(recordName string, clusterIngress string, action KafkaRoutesAction) *route53.Change {
-	recordType := "CNAME"
-	recordTTL := int64(300)
-
-	actionStr := action.String()
-	resourceRecordChange := &route53.Change{
-		Action: &actionStr,
-		ResourceRecordSet: &route53.ResourceRecordSet{
-			Name: &recordName,
-			Type: &recordType,
-			TTL:  &recordTTL,
-			ResourceRecords: []*route53.ResourceRecord{
-				{
-					Value: &clusterIngress,
-				},
+		changes = append(changes, dns.RecordChange{
+			Action: dns.RecordAction(action),
+			Record: dns.Record{
+				Name:  r.Domain,
+				Type:  dns.RecordTypeCNAME,
+				TTL:   kafkaRouteCNAMERecordTTL,
+				Value: r.Router,
 			},
-		},
+		})
 	}
 
-	return resourceRecordChange
+	return changes
 }
 
 func (k *kafkaService) AssignBootstrapServerHost(kafkaRequest *dbapi.KafkaRequest) error {
@@ -1504,28 +1537,6 @@ func (k *kafkaService) getKafkaRoutesBaseDomainName(kafkaRequest *dbapi.KafkaReq
 	return k.kafkaConfig.KafkaDomainName
 }
 
-// getRoute53RegionFromKafkaRequest calculates the AWS region to be used for
-// Route53 from the kafka request. It calculates its value using the
-// cloud provider specified in the kafka request.
-// Route53 is a global service which means that in most of the cases
-// the region specified is only used to access a regional endpoint in AWS.
-// There are some parts of the Route53 functionality that are regional.
-// For what we perform which is create hosted zones and entries in them
-// that is a global functionality.
-// See: https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/disaster-recovery-resiliency.html
-// If at some point we end up needing Route53 regional functionalities this
-// mechanism should be reevaluated
-func (k *kafkaService) getRoute53RegionFromKafkaRequest(kafkaRequest *dbapi.KafkaRequest) (string, error) {
-	switch kafkaRequest.CloudProvider {
-	case cloudproviders.AWS.String():
-		return aws.DefaultAWSRoute53Region, nil
-	case cloudproviders.GCP.String():
-		return aws.DefaultGCPRoute53Region, nil
-	default:
-		return "", errors.GeneralError("unknown cloud provider: %q", kafkaRequest.CloudProvider)
-	}
-}
-
 func (k *kafkaService) IsQuotaEntitlementActive(kafkaRequest *dbapi.KafkaRequest) (bool, error) {
 	quotaService, factoryErr := k.quotaServiceFactory.GetQuotaService(api.QuotaType(k.kafkaConfig.Quota.Type))
 	if factoryErr != nil {
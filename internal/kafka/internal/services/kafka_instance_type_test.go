@@ -14,8 +14,9 @@ func Test_KafkaInstanceTypes_GetSupportedKafkaInstanceTypesByRegion(t *testing.T
 	}
 
 	type args struct {
-		cloudProvider string
-		cloudRegion   string
+		cloudProvider  string
+		cloudRegion    string
+		organisationId string
 	}
 	tests := []struct {
 		name    string
@@ -50,6 +51,62 @@ func Test_KafkaInstanceTypes_GetSupportedKafkaInstanceTypesByRegion(t *testing.T
 				},
 			},
 		},
+		{
+			name: "success when instance type list contains sizes scoped to the organisation",
+			fields: fields{
+				providerConfig: buildProviderConfiguration(testKafkaRequestRegion, MaxClusterCapacity, MaxClusterCapacity, false),
+				kafkaConfig: &config.KafkaConfig{
+					SupportedInstanceTypes: &config.KafkaSupportedInstanceTypesConfig{
+						Configuration: config.SupportedKafkaInstanceTypesConfig{
+							SupportedKafkaInstanceTypes: []config.KafkaInstanceType{
+								{
+									Id:                     "standard",
+									DisplayName:            "Standard",
+									SupportedBillingModels: testSupportedKafkaBillingModelsStandard,
+									Sizes:                  supportedKafkaSizeStandard,
+									OrganisationSizes: []config.KafkaOrganisationInstanceSizes{
+										{
+											OrganisationID: "org-with-custom-sizes",
+											Sizes:          []config.KafkaInstanceSize{{Id: "x1-custom", CapacityConsumed: 3}},
+										},
+										{
+											OrganisationID: "another-org",
+											Sizes:          []config.KafkaInstanceSize{{Id: "x1-another", CapacityConsumed: 2}},
+										},
+									},
+								},
+								{
+									Id:                     "developer",
+									DisplayName:            "Trial",
+									SupportedBillingModels: testSupportedKafkaBillingModelsDeveloper,
+									Sizes:                  supportedKafkaSizeDeveloper,
+								},
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				cloudProvider:  "aws",
+				cloudRegion:    "us-east-1",
+				organisationId: "org-with-custom-sizes",
+			},
+			wantErr: false,
+			want: []config.KafkaInstanceType{
+				{
+					Id:                     "developer",
+					DisplayName:            "Trial",
+					SupportedBillingModels: testSupportedKafkaBillingModelsDeveloper,
+					Sizes:                  supportedKafkaSizeDeveloper,
+				},
+				{
+					Id:                     "standard",
+					DisplayName:            "Standard",
+					SupportedBillingModels: testSupportedKafkaBillingModelsStandard,
+					Sizes:                  append(append([]config.KafkaInstanceSize{}, supportedKafkaSizeStandard...), config.KafkaInstanceSize{Id: "x1-custom", CapacityConsumed: 3}),
+				},
+			},
+		},
 		{
 			name: "fail when cloud region not supported",
 			fields: fields{
@@ -94,7 +151,7 @@ func Test_KafkaInstanceTypes_GetSupportedKafkaInstanceTypesByRegion(t *testing.T
 				providerConfig: tt.fields.providerConfig,
 				kafkaConfig:    tt.fields.kafkaConfig,
 			}
-			got, err := k.GetSupportedKafkaInstanceTypesByRegion(tt.args.cloudProvider, tt.args.cloudRegion)
+			got, err := k.GetSupportedKafkaInstanceTypesByRegion(tt.args.cloudProvider, tt.args.cloudRegion, tt.args.organisationId)
 			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
 			g.Expect(got).To(gomega.Equal(tt.want))
 		})
@@ -10,7 +10,9 @@ import (
 
 //go:generate moq -out kafka_instance_types_moq.go . SupportedKafkaInstanceTypesService
 type SupportedKafkaInstanceTypesService interface {
-	GetSupportedKafkaInstanceTypesByRegion(providerId string, regionId string) ([]config.KafkaInstanceType, *errors.ServiceError)
+	// GetSupportedKafkaInstanceTypesByRegion returns the instance types supported in the given region. The sizes of each
+	// instance type include the sizes scoped to the given organisation, if any.
+	GetSupportedKafkaInstanceTypesByRegion(providerId string, regionId string, organisationId string) ([]config.KafkaInstanceType, *errors.ServiceError)
 }
 
 type supportedKafkaInstanceTypesService struct {
@@ -25,7 +27,7 @@ func NewSupportedKafkaInstanceTypesService(providerConfig *config.ProviderConfig
 	}
 }
 
-func (t *supportedKafkaInstanceTypesService) GetSupportedKafkaInstanceTypesByRegion(providerId string, regionId string) ([]config.KafkaInstanceType, *errors.ServiceError) {
+func (t *supportedKafkaInstanceTypesService) GetSupportedKafkaInstanceTypesByRegion(providerId string, regionId string, organisationId string) ([]config.KafkaInstanceType, *errors.ServiceError) {
 	instanceTypeList := []config.KafkaInstanceType{}
 	provider, providerFound := t.providerConfig.ProvidersConfig.SupportedProviders.GetByName(providerId)
 	if !providerFound {
@@ -47,7 +49,7 @@ func (t *supportedKafkaInstanceTypesService) GetSupportedKafkaInstanceTypesByReg
 			Id:                     k,
 			DisplayName:            instanceType.DisplayName,
 			SupportedBillingModels: instanceType.SupportedBillingModels,
-			Sizes:                  instanceType.Sizes,
+			Sizes:                  instanceType.GetKafkaInstanceSizesForOrganisation(organisationId),
 		})
 	}
 	sort.Slice(instanceTypeList, func(i, j int) bool {
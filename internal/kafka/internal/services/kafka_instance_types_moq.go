@@ -19,7 +19,7 @@ var _ SupportedKafkaInstanceTypesService = &SupportedKafkaInstanceTypesServiceMo
 //
 //		// make and configure a mocked SupportedKafkaInstanceTypesService
 //		mockedSupportedKafkaInstanceTypesService := &SupportedKafkaInstanceTypesServiceMock{
-//			GetSupportedKafkaInstanceTypesByRegionFunc: func(providerId string, regionId string) ([]config.KafkaInstanceType, *serviceError.ServiceError) {
+//			GetSupportedKafkaInstanceTypesByRegionFunc: func(providerId string, regionId string, organisationId string) ([]config.KafkaInstanceType, *serviceError.ServiceError) {
 //				panic("mock out the GetSupportedKafkaInstanceTypesByRegion method")
 //			},
 //		}
@@ -30,7 +30,7 @@ var _ SupportedKafkaInstanceTypesService = &SupportedKafkaInstanceTypesServiceMo
 //	}
 type SupportedKafkaInstanceTypesServiceMock struct {
 	// GetSupportedKafkaInstanceTypesByRegionFunc mocks the GetSupportedKafkaInstanceTypesByRegion method.
-	GetSupportedKafkaInstanceTypesByRegionFunc func(providerId string, regionId string) ([]config.KafkaInstanceType, *serviceError.ServiceError)
+	GetSupportedKafkaInstanceTypesByRegionFunc func(providerId string, regionId string, organisationId string) ([]config.KafkaInstanceType, *serviceError.ServiceError)
 
 	// calls tracks calls to the methods.
 	calls struct {
@@ -40,27 +40,31 @@ type SupportedKafkaInstanceTypesServiceMock struct {
 			ProviderId string
 			// RegionId is the regionId argument value.
 			RegionId string
+			// OrganisationId is the organisationId argument value.
+			OrganisationId string
 		}
 	}
 	lockGetSupportedKafkaInstanceTypesByRegion sync.RWMutex
 }
 
 // GetSupportedKafkaInstanceTypesByRegion calls GetSupportedKafkaInstanceTypesByRegionFunc.
-func (mock *SupportedKafkaInstanceTypesServiceMock) GetSupportedKafkaInstanceTypesByRegion(providerId string, regionId string) ([]config.KafkaInstanceType, *serviceError.ServiceError) {
+func (mock *SupportedKafkaInstanceTypesServiceMock) GetSupportedKafkaInstanceTypesByRegion(providerId string, regionId string, organisationId string) ([]config.KafkaInstanceType, *serviceError.ServiceError) {
 	if mock.GetSupportedKafkaInstanceTypesByRegionFunc == nil {
 		panic("SupportedKafkaInstanceTypesServiceMock.GetSupportedKafkaInstanceTypesByRegionFunc: method is nil but SupportedKafkaInstanceTypesService.GetSupportedKafkaInstanceTypesByRegion was just called")
 	}
 	callInfo := struct {
-		ProviderId string
-		RegionId   string
+		ProviderId     string
+		RegionId       string
+		OrganisationId string
 	}{
-		ProviderId: providerId,
-		RegionId:   regionId,
+		ProviderId:     providerId,
+		RegionId:       regionId,
+		OrganisationId: organisationId,
 	}
 	mock.lockGetSupportedKafkaInstanceTypesByRegion.Lock()
 	mock.calls.GetSupportedKafkaInstanceTypesByRegion = append(mock.calls.GetSupportedKafkaInstanceTypesByRegion, callInfo)
 	mock.lockGetSupportedKafkaInstanceTypesByRegion.Unlock()
-	return mock.GetSupportedKafkaInstanceTypesByRegionFunc(providerId, regionId)
+	return mock.GetSupportedKafkaInstanceTypesByRegionFunc(providerId, regionId, organisationId)
 }
 
 // GetSupportedKafkaInstanceTypesByRegionCalls gets all the calls that were made to GetSupportedKafkaInstanceTypesByRegion.
@@ -68,12 +72,14 @@ func (mock *SupportedKafkaInstanceTypesServiceMock) GetSupportedKafkaInstanceTyp
 //
 //	len(mockedSupportedKafkaInstanceTypesService.GetSupportedKafkaInstanceTypesByRegionCalls())
 func (mock *SupportedKafkaInstanceTypesServiceMock) GetSupportedKafkaInstanceTypesByRegionCalls() []struct {
-	ProviderId string
-	RegionId   string
+	ProviderId     string
+	RegionId       string
+	OrganisationId string
 } {
 	var calls []struct {
-		ProviderId string
-		RegionId   string
+		ProviderId     string
+		RegionId       string
+		OrganisationId string
 	}
 	mock.lockGetSupportedKafkaInstanceTypesByRegion.RLock()
 	calls = mock.calls.GetSupportedKafkaInstanceTypesByRegion
@@ -12,19 +12,18 @@ import (
 	"testing"
 	"time"
 
-	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/cloudproviders"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/converters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/dns"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/kafkas/types"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services/kafkatlscertmgmt"
 	mocks "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/test/mocks/clusters"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	managedkafka "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api/managedkafkas.managedkafka.bf2.org/v1"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
-	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/aws"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/keycloak"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
@@ -777,7 +776,6 @@ func Test_kafkaService_PrepareKafkaRequest(t *testing.T) {
 				clusterService:                       tt.fields.clusterService,
 				keycloakService:                      tt.fields.keycloakService,
 				kafkaConfig:                          tt.fields.kafkaConfig,
-				awsConfig:                            config.NewAWSConfig(),
 				kafkaTLSCertificateManagementService: tt.fields.kafkaTLSCertificateManagementService,
 			}
 
@@ -798,19 +796,36 @@ func Test_kafkaService_PrepareKafkaRequest(t *testing.T) {
 
 func Test_kafkaService_RegisterKafkaDeprovisionJob(t *testing.T) {
 	type fields struct {
-		connectionFactory *db.ConnectionFactory
-		quotaService      QuotaService
+		connectionFactory      *db.ConnectionFactory
+		quotaService           QuotaService
+		privateEndpointService *PrivateEndpointServiceMock
 	}
 	type args struct {
 		kafkaRequest *dbapi.KafkaRequest
 	}
+	authHelper, err := auth.NewAuthHelper(JwtKeyFile, JwtCAFile, "")
+	if err != nil {
+		t.Fatalf("failed to create auth helper: %s", err.Error())
+	}
+	account, err := authHelper.NewAccount(testUser, "", "", "")
+	if err != nil {
+		t.Fatal("failed to build a new account")
+	}
+	jwt, err := authHelper.CreateJWTWithClaims(account, nil)
+	if err != nil {
+		t.Fatalf("failed to create jwt: %s", err.Error())
+	}
+	authenticatedCtx := auth.SetTokenInContext(context.TODO(), jwt)
+
 	tests := []struct {
-		name       string
-		fields     fields
-		args       args
-		wantErr    bool
-		wantErrMsg string
-		setupFn    func()
+		name                           string
+		fields                         fields
+		args                           args
+		ctx                            context.Context
+		wantErr                        bool
+		wantErrMsg                     string
+		wantDeletePrivateEndpointCalls int
+		setupFn                        func()
 	}{
 		{
 			name: "error when id is undefined",
@@ -862,6 +877,54 @@ func Test_kafkaService_RegisterKafkaDeprovisionJob(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "deletes the private endpoint before deprovisioning the kafka",
+			fields: fields{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+				privateEndpointService: &PrivateEndpointServiceMock{
+					DeletePrivateEndpointFunc: func(kafka *dbapi.KafkaRequest) *errors.ServiceError {
+						return nil
+					},
+				},
+			},
+			args: args{
+				kafkaRequest: buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+					kafkaRequest.ID = testID
+				}),
+			},
+			ctx:                            authenticatedCtx,
+			wantDeletePrivateEndpointCalls: 1,
+			setupFn: func() {
+				reply := converters.ConvertKafkaRequest(buildKafkaRequest(nil))
+				reply[0]["private_connectivity"] = true
+				reply[0]["private_endpoint_service_id"] = "vpce-svc-1"
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "kafka_requests"`).WithReply(reply)
+			},
+		},
+		{
+			name: "deprovisions the kafka even if its private endpoint cannot be deleted yet",
+			fields: fields{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+				privateEndpointService: &PrivateEndpointServiceMock{
+					DeletePrivateEndpointFunc: func(kafka *dbapi.KafkaRequest) *errors.ServiceError {
+						return errors.GeneralError("failed to delete the private endpoint service")
+					},
+				},
+			},
+			args: args{
+				kafkaRequest: buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+					kafkaRequest.ID = testID
+				}),
+			},
+			ctx:                            authenticatedCtx,
+			wantDeletePrivateEndpointCalls: 1,
+			setupFn: func() {
+				reply := converters.ConvertKafkaRequest(buildKafkaRequest(nil))
+				reply[0]["private_connectivity"] = true
+				reply[0]["private_endpoint_service_id"] = "vpce-svc-1"
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "kafka_requests"`).WithReply(reply)
+			},
+		},
 	}
 	for _, testcase := range tests {
 		tt := testcase
@@ -873,9 +936,15 @@ func Test_kafkaService_RegisterKafkaDeprovisionJob(t *testing.T) {
 			k := &kafkaService{
 				connectionFactory: tt.fields.connectionFactory,
 				kafkaConfig:       config.NewKafkaConfig(),
-				awsConfig:         config.NewAWSConfig(),
 			}
-			err := k.RegisterKafkaDeprovisionJob(context.TODO(), tt.args.kafkaRequest.ID)
+			if tt.fields.privateEndpointService != nil {
+				k.privateEndpointService = tt.fields.privateEndpointService
+			}
+			ctx := tt.ctx
+			if ctx == nil {
+				ctx = context.TODO()
+			}
+			err := k.RegisterKafkaDeprovisionJob(ctx, tt.args.kafkaRequest.ID)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Delete() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -885,6 +954,9 @@ func Test_kafkaService_RegisterKafkaDeprovisionJob(t *testing.T) {
 					t.Errorf("bad error message received: '%s'. Expecting to contain %s", err.Error(), tt.wantErrMsg)
 				}
 			}
+			if tt.fields.privateEndpointService != nil {
+				gomega.NewWithT(t).Expect(tt.fields.privateEndpointService.DeletePrivateEndpointCalls()).To(gomega.HaveLen(tt.wantDeletePrivateEndpointCalls))
+			}
 		})
 	}
 }
@@ -896,6 +968,7 @@ func Test_kafkaService_Delete(t *testing.T) {
 		keycloakService                      sso.KeycloakService
 		kafkaConfig                          *config.KafkaConfig
 		kafkaTLSCertificateManagementService kafkatlscertmgmt.KafkaTLSCertificateManagementService
+		privateEndpointService               PrivateEndpointService
 	}
 	type args struct {
 		kafkaRequest *dbapi.KafkaRequest
@@ -1156,6 +1229,59 @@ func Test_kafkaService_Delete(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "successfully deletes a Kafka request and its private endpoint",
+			fields: fields{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+				keycloakService: &sso.KeycloakServiceMock{
+					GetConfigFunc: func() *keycloak.KeycloakConfig {
+						return &keycloak.KeycloakConfig{}
+					},
+				},
+				kafkaConfig: &config.KafkaConfig{},
+				privateEndpointService: &PrivateEndpointServiceMock{
+					DeletePrivateEndpointFunc: func(kafka *dbapi.KafkaRequest) *errors.ServiceError {
+						return nil
+					},
+				},
+			},
+			args: args{
+				kafkaRequest: buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+					kafkaRequest.ID = testID
+					kafkaRequest.PrivateConnectivity = true
+					kafkaRequest.PrivateEndpointServiceID = "vpce-svc-1"
+				}),
+			},
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests" SET "deleted_at"`)
+				mocket.Catcher.NewMock().WithExecException().WithQueryException()
+			},
+		},
+		{
+			name: "returns an error when the private endpoint cannot be deleted",
+			fields: fields{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+				keycloakService: &sso.KeycloakServiceMock{
+					GetConfigFunc: func() *keycloak.KeycloakConfig {
+						return &keycloak.KeycloakConfig{}
+					},
+				},
+				kafkaConfig: &config.KafkaConfig{},
+				privateEndpointService: &PrivateEndpointServiceMock{
+					DeletePrivateEndpointFunc: func(kafka *dbapi.KafkaRequest) *errors.ServiceError {
+						return errors.GeneralError("failed to delete the private endpoint service")
+					},
+				},
+			},
+			args: args{
+				kafkaRequest: buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+					kafkaRequest.ID = testID
+					kafkaRequest.PrivateConnectivity = true
+					kafkaRequest.PrivateEndpointServiceID = "vpce-svc-1"
+				}),
+			},
+			wantErr: true,
+		},
 	}
 	for _, testcase := range tests {
 		tt := testcase
@@ -1169,8 +1295,8 @@ func Test_kafkaService_Delete(t *testing.T) {
 				clusterService:                       tt.fields.clusterService,
 				keycloakService:                      tt.fields.keycloakService,
 				kafkaConfig:                          tt.fields.kafkaConfig,
-				awsConfig:                            config.NewAWSConfig(),
 				kafkaTLSCertificateManagementService: tt.fields.kafkaTLSCertificateManagementService,
+				privateEndpointService:               tt.fields.privateEndpointService,
 			}
 			err := k.Delete(tt.args.kafkaRequest)
 			if (err != nil) != tt.wantErr {
@@ -1256,6 +1382,8 @@ func Test_kafkaService_RegisterKafkaJob(t *testing.T) {
 		// checking the kafka request result contents. If null then it is not
 		// run
 		verifyKafkaUpdatedContentsFunc func(g *gomega.WithT, kafkaRequest *dbapi.KafkaRequest)
+		// wantRecordedKafkaCreations is the number of kafka creations recorded for the capacity forecast
+		wantRecordedKafkaCreations int
 	}{
 		{
 			name: "registering kafka job succeeds",
@@ -1765,6 +1893,7 @@ func Test_kafkaService_RegisterKafkaJob(t *testing.T) {
 			error: errorCheck{
 				wantErr: false,
 			},
+			wantRecordedKafkaCreations: 1,
 		},
 		{
 			name: "registering kafka fails when we are on dynamic scaling mode and region limits have been reached",
@@ -1810,6 +1939,7 @@ func Test_kafkaService_RegisterKafkaJob(t *testing.T) {
 				code:     errors.ErrorTooManyKafkaInstancesReached,
 				httpCode: http.StatusForbidden,
 			},
+			wantRecordedKafkaCreations: 1,
 		},
 		{
 			name: "registering kafka job fails: postgres error",
@@ -1940,11 +2070,16 @@ func Test_kafkaService_RegisterKafkaJob(t *testing.T) {
 				tt.setupFn(tt.fields.connectionFactory)
 			}
 
+			capacityForecastService := &CapacityForecastServiceMock{
+				RecordKafkaCreationFunc: func(kafkaRequest *dbapi.KafkaRequest, streamingUnits int) *errors.ServiceError {
+					return nil
+				},
+			}
 			k := &kafkaService{
+				capacityForecastService:  capacityForecastService,
 				connectionFactory:        tt.fields.connectionFactory,
 				clusterService:           tt.fields.clusterService,
 				kafkaConfig:              &tt.fields.kafkaConfig,
-				awsConfig:                config.NewAWSConfig(),
 				providerConfig:           tt.fields.providerConfig,
 				clusterPlacementStrategy: tt.fields.clusterPlmtStrategy,
 				dataplaneClusterConfig:   tt.fields.dataplaneClusterConfig,
@@ -1974,6 +2109,8 @@ func Test_kafkaService_RegisterKafkaJob(t *testing.T) {
 				tt.verifyKafkaUpdatedContentsFunc(g, tt.args.kafkaRequest)
 			}
 
+			g.Expect(capacityForecastService.RecordKafkaCreationCalls()).To(gomega.HaveLen(tt.wantRecordedKafkaCreations))
+
 		})
 	}
 }
@@ -2359,12 +2496,8 @@ func Test_kafkaService_List(t *testing.T) {
 				},
 			},
 			want: want{
-				kafkaList: dbapi.KafkaList{},
-				pagingMeta: &api.PagingMeta{
-					Page:  1,
-					Size:  0,
-					Total: 0,
-				},
+				kafkaList:  nil,
+				pagingMeta: nil,
 			},
 			wantErr: true,
 			setupFn: func(kafkaList dbapi.KafkaList) {
@@ -2382,7 +2515,6 @@ func Test_kafkaService_List(t *testing.T) {
 			k := &kafkaService{
 				connectionFactory: tt.fields.connectionFactory,
 				kafkaConfig:       config.NewKafkaConfig(),
-				awsConfig:         config.NewAWSConfig(),
 			}
 
 			result, pagingMeta, err := k.List(tt.args.ctx, tt.args.listArgs)
@@ -2487,7 +2619,6 @@ func Test_kafkaService_ListAll(t *testing.T) {
 			k := &kafkaService{
 				connectionFactory: tt.fields.connectionFactory,
 				kafkaConfig:       config.NewKafkaConfig(),
-				awsConfig:         config.NewAWSConfig(),
 			}
 
 			result, err := k.ListAll()
@@ -2556,7 +2687,6 @@ func Test_kafkaService_ListByStatus(t *testing.T) {
 				connectionFactory: tt.fields.connectionFactory,
 				clusterService:    tt.fields.clusterService,
 				kafkaConfig:       config.NewKafkaConfig(),
-				awsConfig:         config.NewAWSConfig(),
 			}
 			got, err := k.ListByStatus(tt.args.status)
 			if (err != nil) != tt.wantErr {
@@ -2669,7 +2799,6 @@ func Test_kafkaService_UpdateStatus(t *testing.T) {
 				connectionFactory: tt.fields.connectionFactory,
 				clusterService:    tt.fields.clusterService,
 				kafkaConfig:       config.NewKafkaConfig(),
-				awsConfig:         config.NewAWSConfig(),
 			}
 			executed, err := k.UpdateStatus(tt.args.id, tt.args.status)
 			if executed != tt.wantExecuted {
@@ -2736,7 +2865,6 @@ func Test_kafkaService_Update(t *testing.T) {
 				connectionFactory: tt.fields.connectionFactory,
 				clusterService:    tt.fields.clusterService,
 				kafkaConfig:       config.NewKafkaConfig(),
-				awsConfig:         config.NewAWSConfig(),
 			}
 			err := k.Update(tt.args.kafkaRequest)
 			if (err != nil) != tt.wantErr {
@@ -2799,7 +2927,6 @@ func Test_kafkaService_Updates(t *testing.T) {
 				connectionFactory: tt.fields.connectionFactory,
 				clusterService:    tt.fields.clusterService,
 				kafkaConfig:       config.NewKafkaConfig(),
-				awsConfig:         config.NewAWSConfig(),
 			}
 			err := k.Updates(tt.args.kafkaRequest, map[string]interface{}{
 				"id":    "idsds",
@@ -2813,6 +2940,50 @@ func Test_kafkaService_Updates(t *testing.T) {
 	}
 }
 
+func Test_kafkaService_MoveToCluster(t *testing.T) {
+	tests := []struct {
+		name      string
+		setupFn   func()
+		wantMoved bool
+		wantErr   bool
+	}{
+		{
+			name: "should move the kafka when it has not changed since it was read",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests" SET`).WithRowsNum(1)
+			},
+			wantMoved: true,
+		},
+		{
+			name: "should not move the kafka when it has changed since it was read",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests" SET`).WithRowsNum(0)
+			},
+		},
+		{
+			name: "should return an error when the database returns an error",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests" SET`).WithExecException()
+			},
+			wantErr: true,
+		},
+	}
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			k := kafkaService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			}
+			moved, err := k.MoveToCluster(buildKafkaRequest(nil), "target-cluster")
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(moved).To(gomega.Equal(tt.wantMoved))
+		})
+	}
+}
+
 func Test_kafkaService_DeprovisionKafkaForUsers(t *testing.T) {
 	type fields struct {
 		connectionFactory *db.ConnectionFactory
@@ -3082,7 +3253,7 @@ func Test_KafkaService_CountByStatus(t *testing.T) {
 
 func Test_KafkaService_ChangeKafkaCNAMErecords(t *testing.T) {
 	type fields struct {
-		awsClient aws.AWSClient
+		dnsProviderFactory dns.ProviderFactory
 	}
 
 	type args struct {
@@ -3090,91 +3261,78 @@ func Test_KafkaService_ChangeKafkaCNAMErecords(t *testing.T) {
 		action       KafkaRoutesAction
 	}
 
+	changeRecordsWithAction := func(action dns.RecordAction) func(ctx context.Context, zone string, changes []dns.RecordChange) (*dns.Change, error) {
+		return func(ctx context.Context, zone string, changes []dns.RecordChange) (*dns.Change, error) {
+			if zone != "rhcloud.com" {
+				return nil, goerrors.Errorf("unexpected zone %q", zone)
+			}
+			if len(changes) != 1 {
+				return nil, goerrors.Errorf("number of record changes should be 1")
+			}
+			if changes[0].Action != action {
+				return nil, goerrors.Errorf("the action of the record change is not %s", action)
+			}
+			return &dns.Change{ID: "test-change-id", Status: dns.ChangeStatusPending}, nil
+		}
+	}
+
+	providerFactory := func(provider dns.Provider) dns.ProviderFactory {
+		return &dns.ProviderFactoryMock{
+			GetProviderFunc: func(cloudProvider string) (dns.Provider, error) {
+				return provider, nil
+			},
+		}
+	}
+
+	kafkaRequest := &dbapi.KafkaRequest{
+		Meta: api.Meta{
+			ID: "test-kafka-id",
+		},
+		Name:          "test-kafka-cname",
+		Routes:        []byte("[{\"domain\": \"test-kafka-id.example.com\", \"router\": \"test-kafka-id.rhcloud.com\"}]"),
+		Region:        testKafkaRequestRegion,
+		CloudProvider: cloudproviders.AWS.String(),
+	}
+
 	tests := []struct {
 		name    string
 		fields  fields
 		args    args
+		want    *dns.Change
 		wantErr bool
 	}{
 		{
 			name: "should create CNAMEs for kafka",
 			fields: fields{
-				awsClient: &aws.AWSClientMock{
-					ChangeResourceRecordSetsFunc: func(dnsName string, recordChangeBatch *route53.ChangeBatch) (*route53.ChangeResourceRecordSetsOutput, error) {
-						if len(recordChangeBatch.Changes) != 1 {
-							return nil, goerrors.Errorf("number of record changes should be 1")
-						}
-						if *recordChangeBatch.Changes[0].Action != "CREATE" {
-							return nil, goerrors.Errorf("the action of the record change is not CREATE")
-						}
-						return nil, nil
-					},
-					ListHostedZonesByNameInputFunc: func(dnsName string) (*route53.ListHostedZonesByNameOutput, error) {
-						return nil, nil
-					},
-				},
+				dnsProviderFactory: providerFactory(&dns.ProviderMock{
+					ChangeRecordsFunc: changeRecordsWithAction(dns.RecordActionCreate),
+				}),
 			},
 			args: args{
-				kafkaRequest: &dbapi.KafkaRequest{
-					Meta: api.Meta{
-						ID: "test-kafka-id",
-					},
-					Name:          "test-kafka-cname",
-					Routes:        []byte("[{\"domain\": \"test-kafka-id.example.com\", \"router\": \"test-kafka-id.rhcloud.com\"}]"),
-					Region:        testKafkaRequestRegion,
-					CloudProvider: cloudproviders.AWS.String(),
-				},
-				action: KafkaRoutesActionCreate,
+				kafkaRequest: kafkaRequest,
+				action:       KafkaRoutesActionCreate,
 			},
+			want: &dns.Change{ID: "test-change-id", Status: dns.ChangeStatusPending},
 		},
 		{
 			name: "should delete CNAMEs for kafka",
 			fields: fields{
-				awsClient: &aws.AWSClientMock{
-					ChangeResourceRecordSetsFunc: func(dnsName string, recordChangeBatch *route53.ChangeBatch) (*route53.ChangeResourceRecordSetsOutput, error) {
-						if len(recordChangeBatch.Changes) != 1 {
-							return nil, goerrors.Errorf("number of record changes should be 1")
-						}
-						if *recordChangeBatch.Changes[0].Action != "DELETE" {
-							return nil, goerrors.Errorf("the action of the record change is not DELETE")
-						}
-						return nil, nil
-					},
-					ListHostedZonesByNameInputFunc: func(dnsName string) (*route53.ListHostedZonesByNameOutput, error) {
-						return nil, nil
-					},
-				},
+				dnsProviderFactory: providerFactory(&dns.ProviderMock{
+					ChangeRecordsFunc: changeRecordsWithAction(dns.RecordActionDelete),
+				}),
 			},
 			args: args{
-				kafkaRequest: &dbapi.KafkaRequest{
-					Meta: api.Meta{
-						ID: "test-kafka-id",
-					},
-					Name:          "test-kafka-cname",
-					Routes:        []byte("[{\"domain\": \"test-kafka-id.example.com\", \"router\": \"test-kafka-id.rhcloud.com\"}]"),
-					Region:        testKafkaRequestRegion,
-					CloudProvider: cloudproviders.AWS.String(),
-				},
-				action: KafkaRoutesActionDelete,
+				kafkaRequest: kafkaRequest,
+				action:       KafkaRoutesActionDelete,
 			},
+			want: &dns.Change{ID: "test-change-id", Status: dns.ChangeStatusPending},
 		},
 		{
 			name: "should return error if it fails to get routes",
 			fields: fields{
-				awsClient: &aws.AWSClientMock{
-					ChangeResourceRecordSetsFunc: func(dnsName string, recordChangeBatch *route53.ChangeBatch) (*route53.ChangeResourceRecordSetsOutput, error) {
-						if len(recordChangeBatch.Changes) != 1 {
-							return nil, goerrors.Errorf("number of record changes should be 1")
-						}
-						if *recordChangeBatch.Changes[0].Action != "CREATE" {
-							return nil, goerrors.Errorf("the action of the record change is not CREATE")
-						}
-						return nil, nil
-					},
-					ListHostedZonesByNameInputFunc: func(dnsName string) (*route53.ListHostedZonesByNameOutput, error) {
-						return nil, nil
-					},
-				},
+				dnsProviderFactory: providerFactory(&dns.ProviderMock{
+					ChangeRecordsFunc: changeRecordsWithAction(dns.RecordActionCreate),
+				}),
 			},
 			args: args{
 				kafkaRequest: &dbapi.KafkaRequest{
@@ -3188,31 +3346,53 @@ func Test_KafkaService_ChangeKafkaCNAMErecords(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "should return error if the cloud provider has no DNS provider",
+			fields: fields{
+				dnsProviderFactory: &dns.ProviderFactoryMock{
+					GetProviderFunc: func(cloudProvider string) (dns.Provider, error) {
+						return nil, goerrors.Errorf("invalid DNS provider type")
+					},
+				},
+			},
+			args: args{
+				kafkaRequest: kafkaRequest,
+				action:       KafkaRoutesActionCreate,
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return error if the DNS provider fails to change the records",
+			fields: fields{
+				dnsProviderFactory: providerFactory(&dns.ProviderMock{
+					ChangeRecordsFunc: changeRecordsWithAction(dns.RecordActionDelete),
+				}),
+			},
+			args: args{
+				kafkaRequest: kafkaRequest,
+				action:       KafkaRoutesActionCreate,
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, testcase := range tests {
 		tt := testcase
 
 		t.Run(tt.name, func(t *testing.T) {
-			awsConfig := &config.AWSConfig{}
-			awsConfig.Route53.AccessKey = "test-route-53-key"
-			awsConfig.Route53.SecretAccessKey = "test-route-53-secret-key"
-
+			g := gomega.NewWithT(t)
 			kafkaService := &kafkaService{
-				awsClientFactory: aws.NewMockClientFactory(tt.fields.awsClient),
-				awsConfig:        awsConfig,
+				dnsProviderFactory: tt.fields.dnsProviderFactory,
 				kafkaConfig: &config.KafkaConfig{
 					KafkaDomainName: "rhcloud.com",
 				},
 			}
 
-			_, err := kafkaService.ChangeKafkaCNAMErecords(tt.args.kafkaRequest, tt.args.action)
-			if err != nil && !tt.wantErr {
-				t.Errorf("unexpected error for ChangeKafkaCNAMErecords %v", err)
-			}
+			got, err := kafkaService.ChangeKafkaCNAMErecords(tt.args.kafkaRequest, tt.args.action)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(got).To(gomega.Equal(tt.want))
 		})
 	}
-
 }
 
 func Test_KafkaService_ListComponentVersions(t *testing.T) {
@@ -4448,17 +4628,9 @@ func Test_kafkaService_VerifyAndUpdateKafkaAdmin(t *testing.T) {
 
 func Test_kafkaService_GetCNAMERecordStatus(t *testing.T) {
 	type fields struct {
-		awsConfig        *config.AWSConfig
-		awsClientFactory aws.ClientFactory
+		dnsProviderFactory dns.ProviderFactory
 	}
 
-	CNAME_Id := "CNAME_Id"
-	CNAME_Status := "CNAME_Status"
-
-	awsConfig := &config.AWSConfig{}
-	awsConfig.Route53.AccessKey = "Route53AccessKey"
-	awsConfig.Route53.SecretAccessKey = "Route53SecretAccessKey"
-
 	type args struct {
 		kafkaRequest *dbapi.KafkaRequest
 	}
@@ -4466,45 +4638,47 @@ func Test_kafkaService_GetCNAMERecordStatus(t *testing.T) {
 		name    string
 		fields  fields
 		args    args
-		want    *CNameRecordStatus
+		want    *dns.Change
 		wantErr bool
 	}{
 		{
 			name: "should get the CNAME record Status",
 			fields: fields{
-				awsConfig: awsConfig,
-				awsClientFactory: aws.NewMockClientFactory(&aws.AWSClientMock{
-					GetChangeFunc: func(changeId string) (*route53.GetChangeOutput, error) {
-						return &route53.GetChangeOutput{
-							ChangeInfo: &route53.ChangeInfo{
-								Id:     &CNAME_Id,
-								Status: &CNAME_Status,
+				dnsProviderFactory: &dns.ProviderFactoryMock{
+					GetProviderFunc: func(cloudProvider string) (dns.Provider, error) {
+						return &dns.ProviderMock{
+							GetChangeFunc: func(ctx context.Context, zone string, changeID string) (*dns.Change, error) {
+								return &dns.Change{ID: changeID, Status: dns.ChangeStatusInSync}, nil
 							},
 						}, nil
 					},
-				}),
+				},
 			},
 			args: args{
 				kafkaRequest: &dbapi.KafkaRequest{
-					Region:        "us-east-1",
-					CloudProvider: cloudproviders.AWS.String(),
+					Region:           "us-east-1",
+					CloudProvider:    cloudproviders.AWS.String(),
+					RoutesCreationId: "CNAME_Id",
 				},
 			},
-			want: &CNameRecordStatus{
-				Id:     &CNAME_Id,
-				Status: &CNAME_Status,
+			want: &dns.Change{
+				ID:     "CNAME_Id",
+				Status: dns.ChangeStatusInSync,
 			},
 			wantErr: false,
 		},
 		{
 			name: "should return error when it fails to get CNAME status",
 			fields: fields{
-				awsConfig: awsConfig,
-				awsClientFactory: aws.NewMockClientFactory(&aws.AWSClientMock{
-					GetChangeFunc: func(changeId string) (*route53.GetChangeOutput, error) {
-						return nil, errors.GeneralError("unable to CNAME record status")
+				dnsProviderFactory: &dns.ProviderFactoryMock{
+					GetProviderFunc: func(cloudProvider string) (dns.Provider, error) {
+						return &dns.ProviderMock{
+							GetChangeFunc: func(ctx context.Context, zone string, changeID string) (*dns.Change, error) {
+								return nil, errors.GeneralError("unable to CNAME record status")
+							},
+						}, nil
 					},
-				}),
+				},
 			},
 			args: args{
 				kafkaRequest: &dbapi.KafkaRequest{
@@ -4513,6 +4687,23 @@ func Test_kafkaService_GetCNAMERecordStatus(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "should return error when the cloud provider has no DNS provider",
+			fields: fields{
+				dnsProviderFactory: &dns.ProviderFactoryMock{
+					GetProviderFunc: func(cloudProvider string) (dns.Provider, error) {
+						return nil, goerrors.Errorf("invalid DNS provider type")
+					},
+				},
+			},
+			args: args{
+				kafkaRequest: &dbapi.KafkaRequest{
+					Region:        "us-east-1",
+					CloudProvider: "anunknowncloudprovider",
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, testcase := range tests {
@@ -4520,8 +4711,8 @@ func Test_kafkaService_GetCNAMERecordStatus(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			g := gomega.NewWithT(t)
 			k := &kafkaService{
-				awsConfig:        tt.fields.awsConfig,
-				awsClientFactory: tt.fields.awsClientFactory,
+				dnsProviderFactory: tt.fields.dnsProviderFactory,
+				kafkaConfig:        &config.KafkaConfig{KafkaDomainName: "rhcloud.com"},
 			}
 			got, err := k.GetCNAMERecordStatus(tt.args.kafkaRequest)
 			g.Expect(got).To(gomega.Equal(tt.want))
@@ -4537,13 +4728,14 @@ func Test_NewKafkaService(t *testing.T) {
 		keycloakService                      sso.KafkaKeycloakService
 		kafkaConfig                          *config.KafkaConfig
 		dataplaneClusterConfig               *config.DataplaneClusterConfig
-		awsConfig                            *config.AWSConfig
 		quotaServiceFactory                  QuotaServiceFactory
-		awsClientFactory                     aws.ClientFactory
+		dnsProviderFactory                   dns.ProviderFactory
 		authorizationService                 authorization.Authorization
 		providerConfig                       *config.ProviderConfig
 		clusterPlacementStrategy             ClusterPlacementStrategy
 		kafkaTLSCertificateManagementService kafkatlscertmgmt.KafkaTLSCertificateManagementService
+		capacityForecastService              CapacityForecastService
+		privateEndpointService               PrivateEndpointService
 	}
 	tests := []struct {
 		name string
@@ -4558,12 +4750,13 @@ func Test_NewKafkaService(t *testing.T) {
 				keycloakService:                      &sso.KeycloakServiceMock{},
 				kafkaConfig:                          &config.KafkaConfig{},
 				dataplaneClusterConfig:               &config.DataplaneClusterConfig{},
-				awsConfig:                            &config.AWSConfig{},
 				quotaServiceFactory:                  &QuotaServiceFactoryMock{},
-				awsClientFactory:                     &aws.MockClientFactory{},
+				dnsProviderFactory:                   &dns.ProviderFactoryMock{},
 				providerConfig:                       &config.ProviderConfig{},
 				clusterPlacementStrategy:             &ClusterPlacementStrategyMock{},
 				kafkaTLSCertificateManagementService: &kafkatlscertmgmt.KafkaTLSCertificateManagementServiceMock{},
+				capacityForecastService:              &CapacityForecastServiceMock{},
+				privateEndpointService:               &PrivateEndpointServiceMock{},
 			},
 			want: &kafkaService{
 				connectionFactory:                    &db.ConnectionFactory{},
@@ -4571,12 +4764,13 @@ func Test_NewKafkaService(t *testing.T) {
 				keycloakService:                      &sso.KeycloakServiceMock{},
 				kafkaConfig:                          &config.KafkaConfig{},
 				dataplaneClusterConfig:               &config.DataplaneClusterConfig{},
-				awsConfig:                            &config.AWSConfig{},
 				quotaServiceFactory:                  &QuotaServiceFactoryMock{},
-				awsClientFactory:                     &aws.MockClientFactory{},
+				dnsProviderFactory:                   &dns.ProviderFactoryMock{},
 				providerConfig:                       &config.ProviderConfig{},
 				clusterPlacementStrategy:             &ClusterPlacementStrategyMock{},
 				kafkaTLSCertificateManagementService: &kafkatlscertmgmt.KafkaTLSCertificateManagementServiceMock{},
+				capacityForecastService:              &CapacityForecastServiceMock{},
+				privateEndpointService:               &PrivateEndpointServiceMock{},
 			},
 		},
 	}
@@ -4590,13 +4784,14 @@ func Test_NewKafkaService(t *testing.T) {
 			tt.args.keycloakService,
 			tt.args.kafkaConfig,
 			tt.args.dataplaneClusterConfig,
-			tt.args.awsConfig,
 			tt.args.quotaServiceFactory,
-			tt.args.awsClientFactory,
+			tt.args.dnsProviderFactory,
 			tt.args.authorizationService,
 			tt.args.providerConfig,
 			tt.args.clusterPlacementStrategy,
-			tt.args.kafkaTLSCertificateManagementService)).To(gomega.Equal(tt.want))
+			tt.args.kafkaTLSCertificateManagementService,
+			tt.args.capacityForecastService,
+			tt.args.privateEndpointService)).To(gomega.Equal(tt.want))
 	}
 }
 
@@ -4738,64 +4933,6 @@ func Test_kafkaService_AssignBootstrapServerHost(t *testing.T) {
 	}
 }
 
-func Test_kafkaService_getRoute53RegionFromKafkaRequest(t *testing.T) {
-
-	type args struct {
-		kafkaRequest *dbapi.KafkaRequest
-	}
-
-	tests := []struct {
-		name    string
-		args    args
-		want    string
-		wantErr bool
-	}{
-		{
-			name: "Route53 region is correctly returned for Kafka instances in AWS",
-			args: args{
-				kafkaRequest: &dbapi.KafkaRequest{
-					Region:        "anotherregion",
-					CloudProvider: cloudproviders.AWS.String(),
-				},
-			},
-			want:    aws.DefaultAWSRoute53Region,
-			wantErr: false,
-		},
-		{
-			name: "Route53 region is correctly returned for Kafka instances in GCP",
-			args: args{
-				kafkaRequest: &dbapi.KafkaRequest{
-					Region:        "anotherregiontwo",
-					CloudProvider: cloudproviders.GCP.String(),
-				},
-			},
-			want:    aws.DefaultGCPRoute53Region,
-			wantErr: false,
-		},
-		{
-			name: "An error is returned if the Kafka instance has an unknown cloud provider",
-			args: args{
-				kafkaRequest: &dbapi.KafkaRequest{
-					Region:        "us-east-1",
-					CloudProvider: "anunknowncloudprovider",
-				},
-			},
-			wantErr: true,
-		},
-	}
-
-	for _, testcase := range tests {
-		tt := testcase
-		t.Run(tt.name, func(t *testing.T) {
-			g := gomega.NewWithT(t)
-			k := &kafkaService{}
-			res, err := k.getRoute53RegionFromKafkaRequest(tt.args.kafkaRequest)
-			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
-			g.Expect(res).To(gomega.Equal(tt.want))
-		})
-	}
-}
-
 func Test_kafkaService_ManagedKafkasRoutesTLSCertificate(t *testing.T) {
 	g := gomega.NewWithT(t)
 	type fields struct {
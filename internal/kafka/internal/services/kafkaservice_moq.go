@@ -5,14 +5,15 @@ package services
 
 import (
 	"context"
-	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/dns"
 	kafkaTypes "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/kafkas/types"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	managedkafka "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api/managedkafkas.managedkafka.bf2.org/v1"
 	serviceError "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"gorm.io/gorm"
 	"sync"
 )
 
@@ -32,7 +33,7 @@ var _ KafkaService = &KafkaServiceMock{}
 //			AssignInstanceTypeFunc: func(owner string, organisationID string) (kafkaTypes.KafkaInstanceType, *serviceError.ServiceError) {
 //				panic("mock out the AssignInstanceType method")
 //			},
-//			ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *serviceError.ServiceError) {
+//			ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*dns.Change, *serviceError.ServiceError) {
 //				panic("mock out the ChangeKafkaCNAMErecords method")
 //			},
 //			CountByStatusFunc: func(status []constants.KafkaStatus) ([]KafkaStatusCount, error) {
@@ -59,7 +60,7 @@ var _ KafkaService = &KafkaServiceMock{}
 //			GetByIDFunc: func(id string) (*dbapi.KafkaRequest, *serviceError.ServiceError) {
 //				panic("mock out the GetByID method")
 //			},
-//			GetCNAMERecordStatusFunc: func(kafkaRequest *dbapi.KafkaRequest) (*CNameRecordStatus, error) {
+//			GetCNAMERecordStatusFunc: func(kafkaRequest *dbapi.KafkaRequest) (*dns.Change, error) {
 //				panic("mock out the GetCNAMERecordStatus method")
 //			},
 //			GetManagedKafkaByClusterIDFunc: func(clusterID string) ([]managedkafka.ManagedKafka, *serviceError.ServiceError) {
@@ -89,9 +90,15 @@ var _ KafkaService = &KafkaServiceMock{}
 //			ListKafkasWithRoutesNotCreatedFunc: func() ([]*dbapi.KafkaRequest, *serviceError.ServiceError) {
 //				panic("mock out the ListKafkasWithRoutesNotCreated method")
 //			},
+//			ListShardByStatusFunc: func(shardScope func(*gorm.DB) *gorm.DB, status ...constants.KafkaStatus) ([]*dbapi.KafkaRequest, *serviceError.ServiceError) {
+//				panic("mock out the ListShardByStatus method")
+//			},
 //			ManagedKafkasRoutesTLSCertificateFunc: func(kafkaRequest *dbapi.KafkaRequest) error {
 //				panic("mock out the ManagedKafkasRoutesTLSCertificate method")
 //			},
+//			MoveToClusterFunc: func(kafkaRequest *dbapi.KafkaRequest, clusterID string) (bool, *serviceError.ServiceError) {
+//				panic("mock out the MoveToCluster method")
+//			},
 //			PrepareKafkaRequestFunc: func(kafkaRequest *dbapi.KafkaRequest) *serviceError.ServiceError {
 //				panic("mock out the PrepareKafkaRequest method")
 //			},
@@ -130,7 +137,7 @@ type KafkaServiceMock struct {
 	AssignInstanceTypeFunc func(owner string, organisationID string) (kafkaTypes.KafkaInstanceType, *serviceError.ServiceError)
 
 	// ChangeKafkaCNAMErecordsFunc mocks the ChangeKafkaCNAMErecords method.
-	ChangeKafkaCNAMErecordsFunc func(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *serviceError.ServiceError)
+	ChangeKafkaCNAMErecordsFunc func(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*dns.Change, *serviceError.ServiceError)
 
 	// CountByStatusFunc mocks the CountByStatus method.
 	CountByStatusFunc func(status []constants.KafkaStatus) ([]KafkaStatusCount, error)
@@ -157,7 +164,7 @@ type KafkaServiceMock struct {
 	GetByIDFunc func(id string) (*dbapi.KafkaRequest, *serviceError.ServiceError)
 
 	// GetCNAMERecordStatusFunc mocks the GetCNAMERecordStatus method.
-	GetCNAMERecordStatusFunc func(kafkaRequest *dbapi.KafkaRequest) (*CNameRecordStatus, error)
+	GetCNAMERecordStatusFunc func(kafkaRequest *dbapi.KafkaRequest) (*dns.Change, error)
 
 	// GetManagedKafkaByClusterIDFunc mocks the GetManagedKafkaByClusterID method.
 	GetManagedKafkaByClusterIDFunc func(clusterID string) ([]managedkafka.ManagedKafka, *serviceError.ServiceError)
@@ -186,9 +193,15 @@ type KafkaServiceMock struct {
 	// ListKafkasWithRoutesNotCreatedFunc mocks the ListKafkasWithRoutesNotCreated method.
 	ListKafkasWithRoutesNotCreatedFunc func() ([]*dbapi.KafkaRequest, *serviceError.ServiceError)
 
+	// ListShardByStatusFunc mocks the ListShardByStatus method.
+	ListShardByStatusFunc func(shardScope func(*gorm.DB) *gorm.DB, status ...constants.KafkaStatus) ([]*dbapi.KafkaRequest, *serviceError.ServiceError)
+
 	// ManagedKafkasRoutesTLSCertificateFunc mocks the ManagedKafkasRoutesTLSCertificate method.
 	ManagedKafkasRoutesTLSCertificateFunc func(kafkaRequest *dbapi.KafkaRequest) error
 
+	// MoveToClusterFunc mocks the MoveToCluster method.
+	MoveToClusterFunc func(kafkaRequest *dbapi.KafkaRequest, clusterID string) (bool, *serviceError.ServiceError)
+
 	// PrepareKafkaRequestFunc mocks the PrepareKafkaRequest method.
 	PrepareKafkaRequestFunc func(kafkaRequest *dbapi.KafkaRequest) *serviceError.ServiceError
 
@@ -318,11 +331,25 @@ type KafkaServiceMock struct {
 		// ListKafkasWithRoutesNotCreated holds details about calls to the ListKafkasWithRoutesNotCreated method.
 		ListKafkasWithRoutesNotCreated []struct {
 		}
+		// ListShardByStatus holds details about calls to the ListShardByStatus method.
+		ListShardByStatus []struct {
+			// ShardScope is the shardScope argument value.
+			ShardScope func(*gorm.DB) *gorm.DB
+			// Status is the status argument value.
+			Status []constants.KafkaStatus
+		}
 		// ManagedKafkasRoutesTLSCertificate holds details about calls to the ManagedKafkasRoutesTLSCertificate method.
 		ManagedKafkasRoutesTLSCertificate []struct {
 			// KafkaRequest is the kafkaRequest argument value.
 			KafkaRequest *dbapi.KafkaRequest
 		}
+		// MoveToCluster holds details about calls to the MoveToCluster method.
+		MoveToCluster []struct {
+			// KafkaRequest is the kafkaRequest argument value.
+			KafkaRequest *dbapi.KafkaRequest
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+		}
 		// PrepareKafkaRequest holds details about calls to the PrepareKafkaRequest method.
 		PrepareKafkaRequest []struct {
 			// KafkaRequest is the kafkaRequest argument value.
@@ -401,7 +428,9 @@ type KafkaServiceMock struct {
 	lockListComponentVersions                    sync.RWMutex
 	lockListKafkasToBePromoted                   sync.RWMutex
 	lockListKafkasWithRoutesNotCreated           sync.RWMutex
+	lockListShardByStatus                        sync.RWMutex
 	lockManagedKafkasRoutesTLSCertificate        sync.RWMutex
+	lockMoveToCluster                            sync.RWMutex
 	lockPrepareKafkaRequest                      sync.RWMutex
 	lockRegisterKafkaDeprovisionJob              sync.RWMutex
 	lockRegisterKafkaJob                         sync.RWMutex
@@ -481,7 +510,7 @@ func (mock *KafkaServiceMock) AssignInstanceTypeCalls() []struct {
 }
 
 // ChangeKafkaCNAMErecords calls ChangeKafkaCNAMErecordsFunc.
-func (mock *KafkaServiceMock) ChangeKafkaCNAMErecords(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *serviceError.ServiceError) {
+func (mock *KafkaServiceMock) ChangeKafkaCNAMErecords(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*dns.Change, *serviceError.ServiceError) {
 	if mock.ChangeKafkaCNAMErecordsFunc == nil {
 		panic("KafkaServiceMock.ChangeKafkaCNAMErecordsFunc: method is nil but KafkaService.ChangeKafkaCNAMErecords was just called")
 	}
@@ -772,7 +801,7 @@ func (mock *KafkaServiceMock) GetByIDCalls() []struct {
 }
 
 // GetCNAMERecordStatus calls GetCNAMERecordStatusFunc.
-func (mock *KafkaServiceMock) GetCNAMERecordStatus(kafkaRequest *dbapi.KafkaRequest) (*CNameRecordStatus, error) {
+func (mock *KafkaServiceMock) GetCNAMERecordStatus(kafkaRequest *dbapi.KafkaRequest) (*dns.Change, error) {
 	if mock.GetCNAMERecordStatusFunc == nil {
 		panic("KafkaServiceMock.GetCNAMERecordStatusFunc: method is nil but KafkaService.GetCNAMERecordStatus was just called")
 	}
@@ -1075,6 +1104,42 @@ func (mock *KafkaServiceMock) ListKafkasWithRoutesNotCreatedCalls() []struct {
 	return calls
 }
 
+// ListShardByStatus calls ListShardByStatusFunc.
+func (mock *KafkaServiceMock) ListShardByStatus(shardScope func(*gorm.DB) *gorm.DB, status ...constants.KafkaStatus) ([]*dbapi.KafkaRequest, *serviceError.ServiceError) {
+	if mock.ListShardByStatusFunc == nil {
+		panic("KafkaServiceMock.ListShardByStatusFunc: method is nil but KafkaService.ListShardByStatus was just called")
+	}
+	callInfo := struct {
+		ShardScope func(*gorm.DB) *gorm.DB
+		Status     []constants.KafkaStatus
+	}{
+		ShardScope: shardScope,
+		Status:     status,
+	}
+	mock.lockListShardByStatus.Lock()
+	mock.calls.ListShardByStatus = append(mock.calls.ListShardByStatus, callInfo)
+	mock.lockListShardByStatus.Unlock()
+	return mock.ListShardByStatusFunc(shardScope, status...)
+}
+
+// ListShardByStatusCalls gets all the calls that were made to ListShardByStatus.
+// Check the length with:
+//
+//	len(mockedKafkaService.ListShardByStatusCalls())
+func (mock *KafkaServiceMock) ListShardByStatusCalls() []struct {
+	ShardScope func(*gorm.DB) *gorm.DB
+	Status     []constants.KafkaStatus
+} {
+	var calls []struct {
+		ShardScope func(*gorm.DB) *gorm.DB
+		Status     []constants.KafkaStatus
+	}
+	mock.lockListShardByStatus.RLock()
+	calls = mock.calls.ListShardByStatus
+	mock.lockListShardByStatus.RUnlock()
+	return calls
+}
+
 // ManagedKafkasRoutesTLSCertificate calls ManagedKafkasRoutesTLSCertificateFunc.
 func (mock *KafkaServiceMock) ManagedKafkasRoutesTLSCertificate(kafkaRequest *dbapi.KafkaRequest) error {
 	if mock.ManagedKafkasRoutesTLSCertificateFunc == nil {
@@ -1107,6 +1172,42 @@ func (mock *KafkaServiceMock) ManagedKafkasRoutesTLSCertificateCalls() []struct
 	return calls
 }
 
+// MoveToCluster calls MoveToClusterFunc.
+func (mock *KafkaServiceMock) MoveToCluster(kafkaRequest *dbapi.KafkaRequest, clusterID string) (bool, *serviceError.ServiceError) {
+	if mock.MoveToClusterFunc == nil {
+		panic("KafkaServiceMock.MoveToClusterFunc: method is nil but KafkaService.MoveToCluster was just called")
+	}
+	callInfo := struct {
+		KafkaRequest *dbapi.KafkaRequest
+		ClusterID    string
+	}{
+		KafkaRequest: kafkaRequest,
+		ClusterID:    clusterID,
+	}
+	mock.lockMoveToCluster.Lock()
+	mock.calls.MoveToCluster = append(mock.calls.MoveToCluster, callInfo)
+	mock.lockMoveToCluster.Unlock()
+	return mock.MoveToClusterFunc(kafkaRequest, clusterID)
+}
+
+// MoveToClusterCalls gets all the calls that were made to MoveToCluster.
+// Check the length with:
+//
+//	len(mockedKafkaService.MoveToClusterCalls())
+func (mock *KafkaServiceMock) MoveToClusterCalls() []struct {
+	KafkaRequest *dbapi.KafkaRequest
+	ClusterID    string
+} {
+	var calls []struct {
+		KafkaRequest *dbapi.KafkaRequest
+		ClusterID    string
+	}
+	mock.lockMoveToCluster.RLock()
+	calls = mock.calls.MoveToCluster
+	mock.lockMoveToCluster.RUnlock()
+	return calls
+}
+
 // PrepareKafkaRequest calls PrepareKafkaRequestFunc.
 func (mock *KafkaServiceMock) PrepareKafkaRequest(kafkaRequest *dbapi.KafkaRequest) *serviceError.ServiceError {
 	if mock.PrepareKafkaRequestFunc == nil {
@@ -3,19 +3,24 @@ package kafkatlscertmgmt
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/dns"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
 	"github.com/caddyserver/certmagic"
-	"github.com/libdns/route53"
 	"go.uber.org/zap"
 )
 
-const certmagicCertFailedEvent = "cert_failed"
+const (
+	certmagicCertFailedEvent   = "cert_failed"
+	certmagicCertObtainedEvent = "cert_obtained"
+)
 
 // CertificateManagementOutput is the output indicating the certificates references
 type CertificateManagementOutput struct {
@@ -67,7 +72,7 @@ type KafkaTLSCertificateManagementService interface {
 
 // certificateManagementClientWrapper wrapps the certmagic.Config (https://github.com/caddyserver/certmagic/blob/91cbe177810730b91352b5069474f1eca8c0a9a0/config.go) struct used for certificate management.
 // The intention is that we can easily unit test the kafkaTLSCertificateManagementService which otherwise would have been difficult with the certmagic.Config.
-// certificateManagementClientWrapper being a wrapper, just proxies all calls to certmagic.Config
+// certificateManagementClientWrapper being a wrapper, just proxies all calls to the certmagic.Config of the issuer of the domain
 //
 //go:generate moq -out certmagic_client_wrapper_moq.go . certMagicClientWrapper
 type certMagicClientWrapper interface {
@@ -80,19 +85,30 @@ type certMagicClientWrapper interface {
 }
 
 type wrapper struct {
-	wrappedClient *certmagic.Config
+	// wrappedClients holds a certmagic.Config per certificate issuer, each config having a single issuer
+	wrappedClients map[string]*certmagic.Config
+	config         *config.KafkaTLSCertificateManagementConfig
+}
+
+func (w wrapper) wrappedClient(domain string) *certmagic.Config {
+	return w.wrappedClients[w.config.GetCertificateIssuer(domain)]
 }
 
 func (w wrapper) ManageCertificate(ctx context.Context, domainNames []string) error {
-	return w.wrappedClient.ManageAsync(ctx, domainNames)
+	for _, domain := range domainNames {
+		if err := w.wrappedClient(domain).ManageAsync(ctx, []string{domain}); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (w wrapper) RevokeCertificate(ctx context.Context, domain string, reason int) error {
-	return w.wrappedClient.RevokeCert(ctx, domain, reason, false)
+	return w.wrappedClient(domain).RevokeCert(ctx, domain, reason, false)
 }
 
 func (w wrapper) GetCerticateRefs(domain string) CertificateManagementOutput {
-	issuer := w.wrappedClient.Issuers[0]
+	issuer := w.wrappedClient(domain).Issuers[0]
 	issuerKey := issuer.IssuerKey()
 	return CertificateManagementOutput{
 		TLSCertRef: certmagic.StorageKeys.SiteCert(issuerKey, domain),
@@ -146,7 +162,13 @@ func (certManagementService *kafkaTLSCertificateManagementService) ManageCertifi
 		return CertificateManagementOutput{}, err
 	}
 
-	return certManagementService.certManagementClient.GetCerticateRefs(wildcardDomain), nil
+	refs := certManagementService.certManagementClient.GetCerticateRefs(wildcardDomain)
+	// the certificate is obtained asynchronously, it may only be there from the next reconciliation on
+	if certManagementService.storage.Exists(ctx, refs.TLSCertRef) {
+		recordCertificateExpiry(ctx, certManagementService.storage, certManagementService.config.GetCertificateIssuer(domain), domain, refs.TLSCertRef)
+	}
+
+	return refs, nil
 }
 
 func (certManagementService *kafkaTLSCertificateManagementService) RevokeCertificate(ctx context.Context, domain string, reason CertificateRevocationReason) error {
@@ -165,7 +187,13 @@ func (certManagementService *kafkaTLSCertificateManagementService) RevokeCertifi
 
 	// We revoke the wildcard certificate of the given domain
 	// see ADR-90 https://github.com/bf2fc6cc711aee1a0c2a/architecture/blob/main/_adr/90/index.adoc for context
-	return certManagementService.certManagementClient.RevokeCertificate(ctx, wildcardDomain, reason.AsInt())
+	err := certManagementService.certManagementClient.RevokeCertificate(ctx, wildcardDomain, reason.AsInt())
+	if err != nil {
+		return err
+	}
+
+	metrics.DeleteKafkaTLSCertificateExpiryMetric(domain)
+	return nil
 }
 
 func (certManagementService *kafkaTLSCertificateManagementService) IsKafkaExternalCertificateEnabled() bool {
@@ -180,6 +208,7 @@ func NewKafkaTLSCertificateManagementService(
 	connectionFactory *db.ConnectionFactory,
 	awsConfig *config.AWSConfig,
 	kafkaTLSCertificateManagementConfig *config.KafkaTLSCertificateManagementConfig,
+	dnsProviderFactory dns.ProviderFactory,
 ) (KafkaTLSCertificateManagementService, error) {
 	var storage certmagic.Storage
 	var err error
@@ -196,8 +225,17 @@ func NewKafkaTLSCertificateManagementService(
 
 	var certManagementClient certMagicClientWrapper
 	if kafkaTLSCertificateManagementConfig.CertificateManagementStrategy == config.AutomaticCertificateManagement {
+		wrappedClients := map[string]*certmagic.Config{}
+		for _, issuerName := range kafkaTLSCertificateManagementConfig.UsedCertificateIssuers() {
+			issuerFactory, issuerErr := newCertificateIssuerFactory(issuerName, kafkaTLSCertificateManagementConfig, storage, dnsProviderFactory)
+			if issuerErr != nil {
+				return nil, issuerErr
+			}
+			wrappedClients[issuerName] = createCertMagicClient(issuerName, issuerFactory, kafkaTLSCertificateManagementConfig, storage)
+		}
 		certManagementClient = wrapper{
-			wrappedClient: createCertMagicClient(awsConfig, kafkaTLSCertificateManagementConfig, storage),
+			wrappedClients: wrappedClients,
+			config:         kafkaTLSCertificateManagementConfig,
 		}
 	}
 
@@ -208,27 +246,85 @@ func NewKafkaTLSCertificateManagementService(
 	}, err
 }
 
-func createCertMagicClient(awsConfig *config.AWSConfig,
+// certificateIssuerFactory builds the issuer of a certmagic.Config. The ACME issuer needs the config it belongs to
+type certificateIssuerFactory func(magic *certmagic.Config) certmagic.Issuer
+
+func newCertificateIssuerFactory(issuerName string,
 	kafkaTLSCertificateManagementConfig *config.KafkaTLSCertificateManagementConfig,
-	storage certmagic.Storage) *certmagic.Config {
-	provider := &route53.Provider{
-		WaitForPropagation: true,
-		// wait up to 150 seconds for the temporary txt record to be propagated.
-		// this is a blocking operation but it is acceptable since the management of certificate for each domain is done async
-		MaxWaitDur:      150 * time.Second,
-		AccessKeyId:     awsConfig.Route53.AccessKey,
-		SecretAccessKey: awsConfig.Route53.SecretAccessKey,
+	storage certmagic.Storage,
+	dnsProviderFactory dns.ProviderFactory) (certificateIssuerFactory, error) {
+	switch issuerName {
+	case config.ACMECertificateIssuer:
+		dnsProvider, err := dnsProviderFactory.GetDefaultProvider()
+		if err != nil {
+			return nil, err
+		}
+		return func(magic *certmagic.Config) certmagic.Issuer {
+			return createACMEIssuer(magic, dnsProvider, kafkaTLSCertificateManagementConfig)
+		}, nil
+	case config.LocalCACertificateIssuer:
+		issuer := newLocalCAIssuer(storage, kafkaTLSCertificateManagementConfig.LocalCAIssuerConfig)
+		return func(*certmagic.Config) certmagic.Issuer { return issuer }, nil
+	case config.WebhookCertificateIssuer:
+		issuer := newWebhookIssuer(kafkaTLSCertificateManagementConfig.WebhookIssuerConfig)
+		return func(*certmagic.Config) certmagic.Issuer { return issuer }, nil
+	default:
+		return nil, fmt.Errorf("unsupported certificate issuer %q", issuerName)
 	}
+}
 
+// createCertMagicClient creates the certmagic.Config managing the certificates of a single issuer.
+// Each config gets a cache of its own so that the certificates it manages are renewed by their issuer.
+func createCertMagicClient(issuerName string,
+	issuerFactory certificateIssuerFactory,
+	kafkaTLSCertificateManagementConfig *config.KafkaTLSCertificateManagementConfig,
+	storage certmagic.Storage) *certmagic.Config {
 	certmagic.Default.MustStaple = kafkaTLSCertificateManagementConfig.AutomaticCertificateManagementConfig.MustStaple
 	certmagic.Default.OCSP.DisableStapling = !kafkaTLSCertificateManagementConfig.AutomaticCertificateManagementConfig.MustStaple
 	certmagic.Default.RenewalWindowRatio = kafkaTLSCertificateManagementConfig.AutomaticCertificateManagementConfig.RenewalWindowRatio
 
-	magic := certmagic.NewDefault()
-	magic.Storage = storage
-	magic.Logger = zap.NewNop()
+	var magic *certmagic.Config
+	cache := certmagic.NewCache(certmagic.CacheOptions{
+		GetConfigForCert: func(certmagic.Certificate) (*certmagic.Config, error) {
+			return magic, nil
+		},
+		Logger: zap.NewNop(),
+	})
+
+	magic = certmagic.New(cache, certmagic.Config{
+		MustStaple:         certmagic.Default.MustStaple,
+		OCSP:               certmagic.Default.OCSP,
+		RenewalWindowRatio: certmagic.Default.RenewalWindowRatio,
+		Storage:            storage,
+		Logger:             zap.NewNop(),
+		KeySource:          certmagic.StandardKeyGenerator{KeyType: certmagic.RSA4096},
+	})
+	magic.Issuers = []certmagic.Issuer{issuerFactory(magic)}
+	magic.OnEvent = func(ctx context.Context, event string, data map[string]any) error {
+		switch event {
+		case certmagicCertFailedEvent:
+			logger.NewUHCLogger(ctx).Errorf("certificate management failed with the following event details: %v", data)
+		case certmagicCertObtainedEvent:
+			if identifier, ok := data["identifier"].(string); ok {
+				domain := strings.TrimPrefix(identifier, "*.")
+				recordCertificateExpiry(ctx, storage, issuerName, domain, certmagic.StorageKeys.SiteCert(magic.Issuers[0].IssuerKey(), identifier))
+			}
+			logger.NewUHCLogger(ctx).V(10).Infof("event %q received with data %v", event, data)
+		default:
+			logger.NewUHCLogger(ctx).V(10).Infof("event %q received with data %v", event, data)
+		}
+		return nil
+	}
+
+	return magic
+}
+
+func createACMEIssuer(magic *certmagic.Config, dnsProvider dns.Provider, kafkaTLSCertificateManagementConfig *config.KafkaTLSCertificateManagementConfig) *certmagic.ACMEIssuer {
+	// wait up to 150 seconds for the temporary txt record to be propagated.
+	// this is a blocking operation but it is acceptable since the management of certificate for each domain is done async
+	provider := dns.NewACMEDNSProvider(dnsProvider, 150*time.Second)
 
-	acmeIssuer := certmagic.NewACMEIssuer(magic, certmagic.ACMEIssuer{
+	return certmagic.NewACMEIssuer(magic, certmagic.ACMEIssuer{
 		Agreed:                  true,
 		DisableHTTPChallenge:    true,
 		DisableTLSALPNChallenge: true,
@@ -239,18 +335,21 @@ func createCertMagicClient(awsConfig *config.AWSConfig,
 		AccountKeyPEM:           kafkaTLSCertificateManagementConfig.AutomaticCertificateManagementConfig.AcmeIssuerAccountKey,
 		Email:                   kafkaTLSCertificateManagementConfig.AutomaticCertificateManagementConfig.EmailToSendNotificationTo,
 	})
+}
 
-	magic.Issuers = []certmagic.Issuer{acmeIssuer}
-	magic.KeySource = certmagic.StandardKeyGenerator{KeyType: certmagic.RSA4096}
-	magic.OnEvent = func(ctx context.Context, event string, data map[string]any) error {
-		if event == certmagicCertFailedEvent {
-			logger.NewUHCLogger(ctx).Errorf("certificate management failed with the following event details: %v", data)
-			return nil
-		}
+// recordCertificateExpiry reports the expiry of the certificate stored under the given key of the storage
+func recordCertificateExpiry(ctx context.Context, storage certmagic.Storage, issuerName string, domain string, certRef string) {
+	certPEM, err := storage.Load(ctx, certRef)
+	if err != nil {
+		logger.NewUHCLogger(ctx).Warningf("failed to load the certificate of domain %q to report its expiry: %v", domain, err)
+		return
+	}
 
-		logger.NewUHCLogger(ctx).V(10).Infof("event %q received with data %v", event, data)
-		return nil
+	cert, err := parseLeafCertificate(certPEM)
+	if err != nil {
+		logger.NewUHCLogger(ctx).Warningf("failed to parse the certificate of domain %q to report its expiry: %v", domain, err)
+		return
 	}
 
-	return magic
+	metrics.UpdateKafkaTLSCertificateExpiryMetric(domain, issuerName, cert.NotAfter)
 }
@@ -3,15 +3,21 @@ package kafkatlscertmgmt
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 	"testing"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
 	"github.com/caddyserver/certmagic"
 	"github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 )
 
+var kafkaTLSCertificateExpiryMetricName = fmt.Sprintf("%s_%s", metrics.KasFleetManager, metrics.KafkaTLSCertificateExpiry)
+
 func Test_kafkaTLSCertificateManagementService_GetCertificate(t *testing.T) {
 	type fields struct {
 		storage certmagic.Storage
@@ -267,16 +273,25 @@ func Test_kafkaTLSCertificateManagementService_ManageCertificate(t *testing.T) {
 	type fields struct {
 		config               *config.KafkaTLSCertificateManagementConfig
 		certManagementClient certMagicClientWrapper
+		storage              certmagic.Storage
 	}
 	type args struct {
 		domain string
 	}
+
+	certRef := "certificates/local_ca/wildcard_.some-domain/wildcard_.some-domain.crt"
+	keyRef := "certificates/local_ca/wildcard_.some-domain/wildcard_.some-domain.key"
+	storageWithCert := newInMemoryStorage(db.NewMockConnectionFactory(nil))
+	certPEM, notAfter := issueTestCertificate(t, storageWithCert, "*.some-domain")
+	_ = storageWithCert.Store(context.Background(), certRef, certPEM)
+
 	tests := []struct {
-		name    string
-		fields  fields
-		args    args
-		want    CertificateManagementOutput
-		wantErr bool
+		name       string
+		fields     fields
+		args       args
+		want       CertificateManagementOutput
+		wantErr    bool
+		wantExpiry string
 	}{
 		{
 			name: "should not manage the certificate if in manual mode",
@@ -340,18 +355,65 @@ func Test_kafkaTLSCertificateManagementService_ManageCertificate(t *testing.T) {
 				TLSKeyRef:  "certificates/acme-v02.api.letsencrypt.org-directory/wildcard_.some-domain/wildcard_.some-domain.key",
 			},
 		},
+		{
+			name: "should report the expiry of the certificate when it has already been obtained",
+			fields: fields{
+				config: &config.KafkaTLSCertificateManagementConfig{
+					CertificateManagementStrategy: config.AutomaticCertificateManagement,
+					DefaultCertificateIssuer:      config.ACMECertificateIssuer,
+					BaseDomainCertificateIssuers:  map[string]string{"some-domain": config.LocalCACertificateIssuer},
+				},
+				certManagementClient: &certMagicClientWrapperMock{
+					ManageCertificateFunc: func(ctx context.Context, domainNames []string) error {
+						return nil
+					},
+					GetCerticateRefsFunc: func(domain string) CertificateManagementOutput {
+						return CertificateManagementOutput{
+							TLSCertRef: certRef,
+							TLSKeyRef:  keyRef,
+						}
+					},
+				},
+				storage: storageWithCert,
+			},
+			args: args{
+				domain: "some-domain",
+			},
+			wantErr: false,
+			want: CertificateManagementOutput{
+				TLSCertRef: certRef,
+				TLSKeyRef:  keyRef,
+			},
+			wantExpiry: fmt.Sprintf(`kas_fleet_manager_kafka_tls_certificate_expiry_timestamp_seconds{domain="some-domain",issuer="local_ca"} %d`, notAfter.Unix()),
+		},
 	}
 	for _, tt := range tests {
 		testcase := tt
 		t.Run(testcase.name, func(t *testing.T) {
+			metrics.Reset()
+			storage := testcase.fields.storage
+			if storage == nil {
+				storage = newInMemoryStorage(db.NewMockConnectionFactory(nil))
+			}
 			certManagementService := &kafkaTLSCertificateManagementService{
 				config:               testcase.fields.config,
 				certManagementClient: testcase.fields.certManagementClient,
+				storage:              storage,
 			}
 			output, err := certManagementService.ManageCertificate(context.Background(), testcase.args.domain)
 			g := gomega.NewWithT(t)
 			g.Expect(err != nil).To(gomega.Equal(testcase.wantErr))
 			g.Expect(output).To(gomega.Equal(testcase.want))
+
+			count, err := testutil.GatherAndCount(prometheus.DefaultGatherer, kafkaTLSCertificateExpiryMetricName)
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			if testcase.wantExpiry == "" {
+				g.Expect(count).To(gomega.BeZero())
+				return
+			}
+			expected := fmt.Sprintf("# HELP %[1]s expiry time of the automatically managed Kafka TLS certificate of a base domain, in seconds since the Unix epoch.\n# TYPE %[1]s gauge\n%[2]s\n", kafkaTLSCertificateExpiryMetricName, testcase.wantExpiry)
+			err = testutil.GatherAndCompare(prometheus.DefaultGatherer, strings.NewReader(expected), kafkaTLSCertificateExpiryMetricName)
+			g.Expect(err).ToNot(gomega.HaveOccurred())
 		})
 	}
 }
@@ -0,0 +1,307 @@
+package kafkatlscertmgmt
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/caddyserver/certmagic"
+	"github.com/pkg/errors"
+)
+
+const (
+	localCAIssuerKey = "local_ca"
+
+	localCACertificateKey = "local_ca/ca.crt"
+	localCAPrivateKeyKey  = "local_ca/ca.key"
+	// localCARevocationListKey holds the PEM encoded CRL of the certificates revoked by the local CA
+	localCARevocationListKey = "local_ca/ca.crl"
+
+	// the issued certificates are backdated to tolerate clock skews between the CA and the clients
+	localCABackdate = 5 * time.Minute
+)
+
+var _ certmagic.Issuer = &localCAIssuer{}
+var _ certmagic.Revoker = &localCAIssuer{}
+
+// localCAIssuer issues the certificates from a self-signed certificate authority, meant for installations
+// that can't reach a public certificate authority. The CA is generated on first use and, together with its
+// revocation list, persisted in the certificate storage so that it is shared by all the Fleet Manager replicas.
+type localCAIssuer struct {
+	storage certmagic.Storage
+	config  config.LocalCAIssuerConfig
+	now     func() time.Time
+
+	mu     sync.Mutex
+	caCert *x509.Certificate
+	caKey  crypto.Signer
+	caPEM  []byte
+}
+
+func newLocalCAIssuer(storage certmagic.Storage, localCAIssuerConfig config.LocalCAIssuerConfig) *localCAIssuer {
+	return &localCAIssuer{
+		storage: storage,
+		config:  localCAIssuerConfig,
+		now:     time.Now,
+	}
+}
+
+func (issuer *localCAIssuer) IssuerKey() string {
+	return localCAIssuerKey
+}
+
+func (issuer *localCAIssuer) Issue(ctx context.Context, request *x509.CertificateRequest) (*certmagic.IssuedCertificate, error) {
+	if err := request.CheckSignature(); err != nil {
+		return nil, errors.Wrap(err, "invalid certificate signing request signature")
+	}
+
+	caCert, caKey, caPEM, err := issuer.loadOrCreateCA(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	serialNumber, err := newSerialNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	now := issuer.now()
+	notAfter := now.Add(issuer.config.CertificateValidity)
+	if notAfter.After(caCert.NotAfter) {
+		notAfter = caCert.NotAfter
+	}
+
+	commonName := request.Subject.CommonName
+	if commonName == "" && len(request.DNSNames) > 0 {
+		commonName = request.DNSNames[0]
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     request.DNSNames,
+		NotBefore:    now.Add(-localCABackdate),
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, caCert, request.PublicKey, caKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to sign the certificate of %v", request.DNSNames)
+	}
+
+	// the chain includes the CA so that the clients can be given the full chain to trust
+	chain := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	chain = append(chain, caPEM...)
+
+	return &certmagic.IssuedCertificate{Certificate: chain}, nil
+}
+
+// Revoke adds the certificate to the revocation list of the local CA
+func (issuer *localCAIssuer) Revoke(ctx context.Context, cert certmagic.CertificateResource, reason int) error {
+	leaf, err := parseLeafCertificate(cert.CertificatePEM)
+	if err != nil {
+		return err
+	}
+
+	caCert, caKey, _, err := issuer.loadOrCreateCA(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := issuer.storage.Lock(ctx, localCARevocationListKey); err != nil {
+		return errors.Wrap(err, "failed to lock the local CA revocation list")
+	}
+	defer func() {
+		_ = issuer.storage.Unlock(ctx, localCARevocationListKey)
+	}()
+
+	revokedCertificates := []pkix.RevokedCertificate{}
+	crlNumber := big.NewInt(1)
+	if issuer.storage.Exists(ctx, localCARevocationListKey) {
+		crl, err := issuer.loadRevocationList(ctx)
+		if err != nil {
+			return err
+		}
+		revokedCertificates = append(revokedCertificates, crl.RevokedCertificates...)
+		crlNumber.Add(crl.Number, big.NewInt(1))
+	}
+
+	for _, revoked := range revokedCertificates {
+		if revoked.SerialNumber.Cmp(leaf.SerialNumber) == 0 {
+			return nil // already revoked
+		}
+	}
+
+	now := issuer.now()
+	reasonExtension, err := revocationReasonExtension(reason)
+	if err != nil {
+		return err
+	}
+	revokedCertificates = append(revokedCertificates, pkix.RevokedCertificate{
+		SerialNumber:   leaf.SerialNumber,
+		RevocationTime: now,
+		Extensions:     []pkix.Extension{reasonExtension},
+	})
+
+	der, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		RevokedCertificates: revokedCertificates,
+		Number:              crlNumber,
+		ThisUpdate:          now,
+		NextUpdate:          now.Add(issuer.config.CertificateValidity),
+	}, caCert, caKey)
+	if err != nil {
+		return errors.Wrap(err, "failed to sign the local CA revocation list")
+	}
+
+	return issuer.storage.Store(ctx, localCARevocationListKey, pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: der}))
+}
+
+func (issuer *localCAIssuer) loadRevocationList(ctx context.Context) (*x509.RevocationList, error) {
+	crlPEM, err := issuer.storage.Load(ctx, localCARevocationListKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load the local CA revocation list")
+	}
+	block, _ := pem.Decode(crlPEM)
+	if block == nil {
+		return nil, fmt.Errorf("the local CA revocation list is not PEM encoded")
+	}
+	crl, err := x509.ParseRevocationList(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse the local CA revocation list")
+	}
+	return crl, nil
+}
+
+// loadOrCreateCA returns the CA from the storage, generating it if it does not exist yet.
+// The storage lock ensures a single CA is generated when multiple replicas issue their first certificate concurrently.
+func (issuer *localCAIssuer) loadOrCreateCA(ctx context.Context) (*x509.Certificate, crypto.Signer, []byte, error) {
+	issuer.mu.Lock()
+	defer issuer.mu.Unlock()
+
+	if issuer.caCert != nil {
+		return issuer.caCert, issuer.caKey, issuer.caPEM, nil
+	}
+
+	if err := issuer.storage.Lock(ctx, localCACertificateKey); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to lock the local CA")
+	}
+	defer func() {
+		_ = issuer.storage.Unlock(ctx, localCACertificateKey)
+	}()
+
+	var caPEM, caKeyPEM []byte
+	var err error
+	if issuer.storage.Exists(ctx, localCACertificateKey) {
+		caPEM, err = issuer.storage.Load(ctx, localCACertificateKey)
+		if err != nil {
+			return nil, nil, nil, errors.Wrap(err, "failed to load the local CA certificate")
+		}
+		caKeyPEM, err = issuer.storage.Load(ctx, localCAPrivateKeyKey)
+		if err != nil {
+			return nil, nil, nil, errors.Wrap(err, "failed to load the local CA private key")
+		}
+	} else {
+		caPEM, caKeyPEM, err = issuer.generateCA()
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		// the private key is stored first so that a CA certificate is never found without its key
+		if err := issuer.storage.Store(ctx, localCAPrivateKeyKey, caKeyPEM); err != nil {
+			return nil, nil, nil, errors.Wrap(err, "failed to store the local CA private key")
+		}
+		if err := issuer.storage.Store(ctx, localCACertificateKey, caPEM); err != nil {
+			return nil, nil, nil, errors.Wrap(err, "failed to store the local CA certificate")
+		}
+	}
+
+	caCert, err := parseLeafCertificate(caPEM)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	caKey, err := certmagic.PEMDecodePrivateKey(caKeyPEM)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to decode the local CA private key")
+	}
+
+	issuer.caCert, issuer.caKey, issuer.caPEM = caCert, caKey, caPEM
+	return caCert, caKey, caPEM, nil
+}
+
+func (issuer *localCAIssuer) generateCA() ([]byte, []byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to generate the local CA private key")
+	}
+
+	serialNumber, err := newSerialNumber()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	now := issuer.now()
+	template := &x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               pkix.Name{CommonName: issuer.config.CommonName},
+		NotBefore:             now.Add(-localCABackdate),
+		NotAfter:              now.Add(issuer.config.CAValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLenZero:        true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to self-sign the local CA certificate")
+	}
+
+	keyPEM, err := certmagic.PEMEncodePrivateKey(key)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to encode the local CA private key")
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), keyPEM, nil
+}
+
+// revocationReasonExtension builds the CRL entry extension carrying the reason code of the revocation
+func revocationReasonExtension(reason int) (pkix.Extension, error) {
+	value, err := asn1.Marshal(asn1.Enumerated(reason))
+	if err != nil {
+		return pkix.Extension{}, errors.Wrap(err, "failed to encode the revocation reason")
+	}
+	return pkix.Extension{Id: asn1.ObjectIdentifier{2, 5, 29, 21}, Value: value}, nil
+}
+
+func newSerialNumber() (*big.Int, error) {
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate a certificate serial number")
+	}
+	return serialNumber, nil
+}
+
+// parseLeafCertificate parses the first certificate of the given PEM encoded chain
+func parseLeafCertificate(chainPEM []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(chainPEM)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("no PEM encoded certificate found")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse the certificate")
+	}
+	return cert, nil
+}
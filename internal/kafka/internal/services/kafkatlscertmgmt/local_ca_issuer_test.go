@@ -0,0 +1,130 @@
+package kafkatlscertmgmt
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/caddyserver/certmagic"
+	"github.com/onsi/gomega"
+)
+
+var testLocalCAIssuerConfig = config.LocalCAIssuerConfig{
+	CommonName:          "Test CA",
+	CAValidity:          365 * 24 * time.Hour,
+	CertificateValidity: 24 * time.Hour,
+}
+
+func newTestCertificateRequest(t *testing.T, dnsNames ...string) *x509.CertificateRequest {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: dnsNames[0]},
+		DNSNames: dnsNames,
+	}, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate request: %v", err)
+	}
+	request, err := x509.ParseCertificateRequest(der)
+	if err != nil {
+		t.Fatalf("failed to parse certificate request: %v", err)
+	}
+	return request
+}
+
+// issueTestCertificate issues a certificate for the given domain from a local CA kept in the given storage
+func issueTestCertificate(t *testing.T, storage certmagic.Storage, domain string) ([]byte, time.Time) {
+	issued, err := newLocalCAIssuer(storage, testLocalCAIssuerConfig).Issue(context.Background(), newTestCertificateRequest(t, domain))
+	if err != nil {
+		t.Fatalf("failed to issue certificate: %v", err)
+	}
+	cert, err := parseLeafCertificate(issued.Certificate)
+	if err != nil {
+		t.Fatalf("failed to parse issued certificate: %v", err)
+	}
+	return issued.Certificate, cert.NotAfter
+}
+
+func Test_localCAIssuer_Issue(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+	storage := newInMemoryStorage(db.NewMockConnectionFactory(nil))
+
+	issuer := newLocalCAIssuer(storage, testLocalCAIssuerConfig)
+	issued, err := issuer.Issue(ctx, newTestCertificateRequest(t, "*.some-domain"))
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+
+	leaf, err := parseLeafCertificate(issued.Certificate)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(leaf.DNSNames).To(gomega.Equal([]string{"*.some-domain"}))
+	g.Expect(leaf.NotAfter).To(gomega.BeTemporally("~", time.Now().Add(testLocalCAIssuerConfig.CertificateValidity), time.Minute))
+
+	// the chain carries the CA, which is persisted in the storage and trusted to verify the leaf
+	_, rest := pem.Decode(issued.Certificate)
+	ca, err := parseLeafCertificate(rest)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(ca.IsCA).To(gomega.BeTrue())
+	g.Expect(ca.Subject.CommonName).To(gomega.Equal(testLocalCAIssuerConfig.CommonName))
+	g.Expect(storage.Exists(ctx, localCAPrivateKeyKey)).To(gomega.BeTrue())
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca)
+	_, err = leaf.Verify(x509.VerifyOptions{DNSName: "kafka.some-domain", Roots: roots})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+
+	// another issuer sharing the storage, as another replica would, reuses the same CA
+	otherIssued, err := newLocalCAIssuer(storage, testLocalCAIssuerConfig).Issue(ctx, newTestCertificateRequest(t, "*.other-domain"))
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	_, otherRest := pem.Decode(otherIssued.Certificate)
+	g.Expect(otherRest).To(gomega.Equal(rest))
+}
+
+func Test_localCAIssuer_Revoke(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+	storage := newInMemoryStorage(db.NewMockConnectionFactory(nil))
+	issuer := newLocalCAIssuer(storage, testLocalCAIssuerConfig)
+
+	revoke := func(certPEM []byte) {
+		err := issuer.Revoke(ctx, certmagic.CertificateResource{CertificatePEM: certPEM}, KeyCompromise.AsInt())
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+	}
+
+	firstPEM, _ := issueTestCertificate(t, storage, "*.some-domain")
+	secondPEM, _ := issueTestCertificate(t, storage, "*.other-domain")
+
+	revoke(firstPEM)
+	revoke(firstPEM) // revoking twice must not add the certificate twice
+	revoke(secondPEM)
+
+	crl, err := issuer.loadRevocationList(ctx)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+
+	first, _ := parseLeafCertificate(firstPEM)
+	second, _ := parseLeafCertificate(secondPEM)
+	g.Expect(crl.Number.Int64()).To(gomega.Equal(int64(2)))
+	g.Expect(crl.RevokedCertificates).To(gomega.HaveLen(2))
+	g.Expect(crl.RevokedCertificates[0].SerialNumber).To(gomega.Equal(first.SerialNumber))
+	g.Expect(crl.RevokedCertificates[1].SerialNumber).To(gomega.Equal(second.SerialNumber))
+
+	caCert, _, _, err := issuer.loadOrCreateCA(ctx)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(crl.CheckSignatureFrom(caCert)).To(gomega.Succeed())
+}
+
+func Test_localCAIssuer_Revoke_InvalidCertificate(t *testing.T) {
+	g := gomega.NewWithT(t)
+	issuer := newLocalCAIssuer(newInMemoryStorage(db.NewMockConnectionFactory(nil)), testLocalCAIssuerConfig)
+	err := issuer.Revoke(context.Background(), certmagic.CertificateResource{CertificatePEM: []byte("not-a-certificate")}, KeyCompromise.AsInt())
+	g.Expect(err).To(gomega.HaveOccurred())
+}
@@ -0,0 +1,125 @@
+package kafkatlscertmgmt
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/caddyserver/certmagic"
+	"github.com/pkg/errors"
+)
+
+const webhookIssuerKey = "webhook"
+
+var _ certmagic.Issuer = &webhookIssuer{}
+var _ certmagic.Revoker = &webhookIssuer{}
+
+// webhookIssueRequest is the body sent to '<url>/issue' to get a certificate signing request signed
+type webhookIssueRequest struct {
+	CSR      string   `json:"csr"`
+	DNSNames []string `json:"dns_names"`
+}
+
+// webhookIssueResponse is the body expected from '<url>/issue'
+type webhookIssueResponse struct {
+	// Certificate is the PEM encoded certificate chain, leaf certificate first
+	Certificate string `json:"certificate"`
+}
+
+// webhookRevokeRequest is the body sent to '<url>/revoke' to revoke a certificate
+type webhookRevokeRequest struct {
+	Certificate string `json:"certificate"`
+	Reason      int    `json:"reason"`
+}
+
+// webhookIssuer delegates the signature and the revocation of the certificates to an external issuer over HTTP.
+// It allows to plug certificate authorities Fleet Manager has no native support for.
+type webhookIssuer struct {
+	config     config.WebhookIssuerConfig
+	httpClient *http.Client
+}
+
+func newWebhookIssuer(webhookIssuerConfig config.WebhookIssuerConfig) *webhookIssuer {
+	return &webhookIssuer{
+		config:     webhookIssuerConfig,
+		httpClient: &http.Client{Timeout: webhookIssuerConfig.Timeout},
+	}
+}
+
+func (issuer *webhookIssuer) IssuerKey() string {
+	return webhookIssuerKey
+}
+
+func (issuer *webhookIssuer) Issue(ctx context.Context, request *x509.CertificateRequest) (*certmagic.IssuedCertificate, error) {
+	var response webhookIssueResponse
+	err := issuer.post(ctx, "issue", webhookIssueRequest{
+		CSR:      string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: request.Raw})),
+		DNSNames: request.DNSNames,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	// the certificate is parsed to fail early on a malformed response rather than when serving it
+	if _, err := parseLeafCertificate([]byte(response.Certificate)); err != nil {
+		return nil, errors.Wrap(err, "invalid certificate returned by the external issuer")
+	}
+
+	return &certmagic.IssuedCertificate{Certificate: []byte(response.Certificate)}, nil
+}
+
+func (issuer *webhookIssuer) Revoke(ctx context.Context, cert certmagic.CertificateResource, reason int) error {
+	return issuer.post(ctx, "revoke", webhookRevokeRequest{
+		Certificate: string(cert.CertificatePEM),
+		Reason:      reason,
+	}, nil)
+}
+
+// post sends the payload to the given path of the external issuer and decodes its response into the result, if any
+func (issuer *webhookIssuer) post(ctx context.Context, path string, payload interface{}, result interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal the %s request of the external issuer", path)
+	}
+
+	url := fmt.Sprintf("%s/%s", strings.TrimSuffix(issuer.config.URL, "/"), path)
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrapf(err, "failed to build the %s request of the external issuer", path)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if issuer.config.Token != "" {
+		request.Header.Set("Authorization", fmt.Sprintf("Bearer %s", issuer.config.Token))
+	}
+
+	response, err := issuer.httpClient.Do(request)
+	if err != nil {
+		return errors.Wrapf(err, "failed to send the %s request to the external issuer", path)
+	}
+	defer response.Body.Close()
+
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read the %s response of the external issuer", path)
+	}
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("the external issuer answered the %s request with status %d: %s", path, response.StatusCode, string(responseBody))
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	if err := json.Unmarshal(responseBody, result); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal the %s response of the external issuer", path)
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package kafkatlscertmgmt
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/caddyserver/certmagic"
+	"github.com/onsi/gomega"
+)
+
+func Test_webhookIssuer_Issue(t *testing.T) {
+	certPEM, _ := issueTestCertificate(t, newInMemoryStorage(db.NewMockConnectionFactory(nil)), "*.some-domain")
+
+	tests := []struct {
+		name    string
+		token   string
+		handler http.HandlerFunc
+		wantErr bool
+	}{
+		{
+			name:  "should return the certificate signed by the external issuer",
+			token: "some-token",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				var request webhookIssueRequest
+				if r.URL.Path != "/issue" || r.Header.Get("Authorization") != "Bearer some-token" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				if err := json.NewDecoder(r.Body).Decode(&request); err != nil || len(request.DNSNames) != 1 {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				if block, _ := pem.Decode([]byte(request.CSR)); block == nil || block.Type != "CERTIFICATE REQUEST" {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				_ = json.NewEncoder(w).Encode(webhookIssueResponse{Certificate: string(certPEM)})
+			},
+		},
+		{
+			name: "should return an error when the external issuer fails",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when the external issuer returns an invalid certificate",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewEncoder(w).Encode(webhookIssueResponse{Certificate: "not-a-certificate"})
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			server := httptest.NewServer(tt.handler)
+			defer server.Close()
+
+			issuer := newWebhookIssuer(config.WebhookIssuerConfig{URL: server.URL + "/", Timeout: time.Second, Token: tt.token})
+			issued, err := issuer.Issue(context.Background(), newTestCertificateRequest(t, "*.some-domain"))
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if !tt.wantErr {
+				g.Expect(issued.Certificate).To(gomega.Equal(certPEM))
+			}
+		})
+	}
+}
+
+func Test_webhookIssuer_Revoke(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		wantErr    bool
+	}{
+		{
+			name:       "should revoke the certificate through the external issuer",
+			statusCode: http.StatusNoContent,
+		},
+		{
+			name:       "should return an error when the external issuer refuses the revocation",
+			statusCode: http.StatusBadRequest,
+			wantErr:    true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			var received webhookRevokeRequest
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/revoke" {
+					_ = json.NewDecoder(r.Body).Decode(&received)
+				}
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer server.Close()
+
+			issuer := newWebhookIssuer(config.WebhookIssuerConfig{URL: server.URL, Timeout: time.Second})
+			err := issuer.Revoke(context.Background(), certmagic.CertificateResource{CertificatePEM: []byte("some-certificate")}, KeyCompromise.AsInt())
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(received).To(gomega.Equal(webhookRevokeRequest{Certificate: "some-certificate", Reason: KeyCompromise.AsInt()}))
+		})
+	}
+}
@@ -139,7 +139,29 @@ func (o *kasFleetshardOperatorAddon) GetAddonParams(cluster *api.Cluster) (Param
 		}
 	}
 	params := o.buildAddonParams(cluster, acc)
-	return params, nil
+
+	providerSpec, err := types.ParseClusterProviderSpec(cluster.ProviderSpec)
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to parse the provider spec of cluster %s", cluster.ClusterID)
+	}
+	return mergeAddonParams(params, providerSpec.AddonParameters), nil
+}
+
+// mergeAddonParams adds the given parameter overrides to the addon parameters, replacing the parameters with the same id
+func mergeAddonParams(params []types.Parameter, overrides []types.AddonParameterSpec) []types.Parameter {
+	for _, override := range overrides {
+		replaced := false
+		for i := range params {
+			if params[i].Id == override.ID {
+				params[i].Value = override.Value
+				replaced = true
+			}
+		}
+		if !replaced {
+			params = append(params, types.Parameter{Id: override.ID, Value: override.Value})
+		}
+	}
+	return params
 }
 
 func (o *kasFleetshardOperatorAddon) provisionServiceAccount(clusterId string) (*api.ServiceAccount, *errors.ServiceError) {
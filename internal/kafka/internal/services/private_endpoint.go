@@ -0,0 +1,227 @@
+package services
+
+import (
+	"context"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/privateendpoint"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/golang/glog"
+)
+
+// kafkaStatusesWithPrivateEndpoint are the statuses of the Kafka instances whose load balancer may be provisioned
+// by the data plane, i.e. the ones whose private endpoint can be reconciled
+var kafkaStatusesWithPrivateEndpoint = []string{
+	constants.KafkaRequestStatusProvisioning.String(),
+	constants.KafkaRequestStatusReady.String(),
+}
+
+//go:generate moq -out private_endpoint_moq.go . PrivateEndpointService
+type PrivateEndpointService interface {
+	// SupportsCloudProvider returns whether the Kafka instances of the given cloud provider can be requested with a private endpoint
+	SupportsCloudProvider(cloudProvider string) bool
+	// ListKafkasWithPrivateEndpointInProgress returns the Kafka instances whose private endpoint is not ready nor failed
+	ListKafkasWithPrivateEndpointInProgress() ([]*dbapi.KafkaRequest, *errors.ServiceError)
+	// ReconcilePrivateEndpoint moves the private endpoint of the Kafka instance forward: it creates the endpoint service once
+	// the load balancer of the Kafka instance is provisioned and applies the allowed accounts once the endpoint service
+	// is available. The private endpoint fields of the Kafka instance are saved
+	ReconcilePrivateEndpoint(kafka *dbapi.KafkaRequest) *errors.ServiceError
+	// UpdateAllowedAccounts replaces the accounts allowed to connect to the private endpoint of the Kafka instance.
+	// The change is applied asynchronously
+	UpdateAllowedAccounts(kafka *dbapi.KafkaRequest, accountIDs []string) *errors.ServiceError
+	// ListDeprovisioningKafkasWithPrivateEndpoint returns the deprovisioning and deleting Kafka instances whose endpoint
+	// service has not been deleted yet
+	ListDeprovisioningKafkasWithPrivateEndpoint() ([]*dbapi.KafkaRequest, *errors.ServiceError)
+	// DeletePrivateEndpoint rejects the connections to the endpoint service of the Kafka instance, if any, deletes it
+	// and marks the private endpoint as deleted
+	DeletePrivateEndpoint(kafka *dbapi.KafkaRequest) *errors.ServiceError
+}
+
+var _ PrivateEndpointService = &privateEndpointService{}
+
+type privateEndpointService struct {
+	connectionFactory *db.ConnectionFactory
+	providerFactory   privateendpoint.ProviderFactory
+}
+
+func NewPrivateEndpointService(connectionFactory *db.ConnectionFactory, providerFactory privateendpoint.ProviderFactory) PrivateEndpointService {
+	return &privateEndpointService{
+		connectionFactory: connectionFactory,
+		providerFactory:   providerFactory,
+	}
+}
+
+func (s *privateEndpointService) SupportsCloudProvider(cloudProvider string) bool {
+	return s.providerFactory.SupportsCloudProvider(cloudProvider)
+}
+
+func (s *privateEndpointService) ListKafkasWithPrivateEndpointInProgress() ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+	var kafkas []*dbapi.KafkaRequest
+	dbConn := s.connectionFactory.New().
+		Where("private_connectivity = ?", true).
+		Where("private_endpoint_status IN (?)", dbapi.PrivateEndpointStatusesInProgress).
+		Where("status IN (?)", kafkaStatusesWithPrivateEndpoint)
+
+	if err := dbConn.Find(&kafkas).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list kafkas whose private endpoint is in progress")
+	}
+	return kafkas, nil
+}
+
+func (s *privateEndpointService) ListDeprovisioningKafkasWithPrivateEndpoint() ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+	var kafkas []*dbapi.KafkaRequest
+	dbConn := s.connectionFactory.New().
+		Where("private_connectivity = ?", true).
+		Where("private_endpoint_service_id <> ?", "").
+		Where("private_endpoint_status <> ?", dbapi.PrivateEndpointStatusDeleted).
+		Where("status IN (?)", kafkaDeletionStatuses)
+
+	if err := dbConn.Find(&kafkas).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list deprovisioning kafkas with a private endpoint")
+	}
+	return kafkas, nil
+}
+
+func (s *privateEndpointService) ReconcilePrivateEndpoint(kafka *dbapi.KafkaRequest) *errors.ServiceError {
+	provider, err := s.providerFactory.GetProvider(kafka.CloudProvider)
+	if err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to get private endpoint provider of kafka %q", kafka.ID)
+	}
+	ctx := context.Background()
+
+	if kafka.PrivateEndpointServiceID == "" {
+		endpointService, err := provider.CreateEndpointService(ctx, kafka.Region, kafka.ID)
+		if err != nil {
+			return errors.NewWithCause(errors.ErrorGeneral, err, "failed to create the private endpoint service of kafka %q", kafka.ID)
+		}
+		if endpointService == nil {
+			glog.V(10).Infof("load balancer of kafka %q not provisioned yet, waiting to create its private endpoint service", kafka.ID)
+			return nil
+		}
+
+		kafka.PrivateEndpointServiceID = endpointService.ID
+		kafka.PrivateEndpointServiceName = endpointService.Name
+		kafka.PrivateEndpointStatus = dbapi.PrivateEndpointStatusProvisioning
+		if err := s.updatePrivateEndpointStatus(kafka); err != nil {
+			return err
+		}
+	}
+
+	endpointService, err := provider.GetEndpointService(ctx, kafka.Region, kafka.PrivateEndpointServiceID)
+	if err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to get the private endpoint service of kafka %q", kafka.ID)
+	}
+
+	switch {
+	case endpointService == nil, endpointService.Status == privateendpoint.EndpointServiceStatusDeleting:
+		kafka.PrivateEndpointStatus = dbapi.PrivateEndpointStatusFailed
+		kafka.PrivateEndpointStatusDetails = "the private endpoint service has been deleted"
+		return s.updatePrivateEndpointStatus(kafka)
+	case endpointService.Status == privateendpoint.EndpointServiceStatusFailed:
+		kafka.PrivateEndpointStatus = dbapi.PrivateEndpointStatusFailed
+		kafka.PrivateEndpointStatusDetails = endpointService.StatusDetails
+		return s.updatePrivateEndpointStatus(kafka)
+	case !endpointService.IsAvailable():
+		return nil
+	}
+
+	accountIDs, err := kafka.GetPrivateEndpointAllowedAccounts()
+	if err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to get the private endpoint allowed accounts of kafka %q", kafka.ID)
+	}
+	if err := provider.SetAllowedAccounts(ctx, kafka.Region, kafka.PrivateEndpointServiceID, accountIDs); err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to set the private endpoint allowed accounts of kafka %q", kafka.ID)
+	}
+
+	kafka.PrivateEndpointStatus = dbapi.PrivateEndpointStatusReady
+	kafka.PrivateEndpointStatusDetails = ""
+	return s.markPrivateEndpointReady(kafka)
+}
+
+func (s *privateEndpointService) UpdateAllowedAccounts(kafka *dbapi.KafkaRequest, accountIDs []string) *errors.ServiceError {
+	if !kafka.PrivateConnectivity {
+		return errors.BadRequest("kafka %q has no private endpoint", kafka.ID)
+	}
+	if kafka.PrivateEndpointStatus == dbapi.PrivateEndpointStatusFailed {
+		return errors.Conflict("the private endpoint of kafka %q has failed: %s", kafka.ID, kafka.PrivateEndpointStatusDetails)
+	}
+
+	if err := kafka.SetPrivateEndpointAllowedAccounts(accountIDs); err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to set the private endpoint allowed accounts of kafka %q", kafka.ID)
+	}
+	// the endpoint services not available yet get their allowed accounts once they are
+	if kafka.PrivateEndpointStatus == dbapi.PrivateEndpointStatusReady {
+		kafka.PrivateEndpointStatus = dbapi.PrivateEndpointStatusUpdating
+	}
+
+	dbConn := s.connectionFactory.New().
+		Model(kafka).
+		Where("status not IN (?)", kafkaDeletionStatuses)
+	if err := dbConn.Updates(map[string]interface{}{
+		"private_endpoint_allowed_accounts": kafka.PrivateEndpointAllowedAccounts,
+		"private_endpoint_status":           kafka.PrivateEndpointStatus,
+	}).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update the private endpoint allowed accounts of kafka %q", kafka.ID)
+	}
+	return nil
+}
+
+func (s *privateEndpointService) DeletePrivateEndpoint(kafka *dbapi.KafkaRequest) *errors.ServiceError {
+	if kafka.PrivateEndpointServiceID == "" || kafka.PrivateEndpointStatus == dbapi.PrivateEndpointStatusDeleted {
+		return nil
+	}
+
+	provider, err := s.providerFactory.GetProvider(kafka.CloudProvider)
+	if err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to get private endpoint provider of kafka %q", kafka.ID)
+	}
+	if err := provider.DeleteEndpointService(context.Background(), kafka.Region, kafka.PrivateEndpointServiceID); err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to delete the private endpoint service of kafka %q", kafka.ID)
+	}
+
+	// the status is saved whatever the status of the kafka, as the endpoint service is deleted while it is deprovisioned
+	kafka.PrivateEndpointStatus = dbapi.PrivateEndpointStatusDeleted
+	kafka.PrivateEndpointStatusDetails = ""
+	if err := s.connectionFactory.New().Model(kafka).Updates(privateEndpointStatusColumns(kafka)).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update the private endpoint status of kafka %q", kafka.ID)
+	}
+	return nil
+}
+
+func (s *privateEndpointService) updatePrivateEndpointStatus(kafka *dbapi.KafkaRequest) *errors.ServiceError {
+	dbConn := s.connectionFactory.New().
+		Model(kafka).
+		Where("status not IN (?)", kafkaDeletionStatuses)
+	if err := dbConn.Updates(privateEndpointStatusColumns(kafka)).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update the private endpoint status of kafka %q", kafka.ID)
+	}
+	return nil
+}
+
+// markPrivateEndpointReady saves the ready status only if the allowed accounts have not been changed since they were
+// applied. Otherwise, the private endpoint is left for the next reconciliation to apply the new allowed accounts
+func (s *privateEndpointService) markPrivateEndpointReady(kafka *dbapi.KafkaRequest) *errors.ServiceError {
+	dbConn := s.connectionFactory.New().
+		Model(kafka).
+		Where("status not IN (?)", kafkaDeletionStatuses)
+	if len(kafka.PrivateEndpointAllowedAccounts) == 0 {
+		dbConn = dbConn.Where("private_endpoint_allowed_accounts IS NULL")
+	} else {
+		dbConn = dbConn.Where("private_endpoint_allowed_accounts = ?::jsonb", string(kafka.PrivateEndpointAllowedAccounts))
+	}
+	if err := dbConn.Updates(privateEndpointStatusColumns(kafka)).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update the private endpoint status of kafka %q", kafka.ID)
+	}
+	return nil
+}
+
+func privateEndpointStatusColumns(kafka *dbapi.KafkaRequest) map[string]interface{} {
+	return map[string]interface{}{
+		"private_endpoint_status":         kafka.PrivateEndpointStatus,
+		"private_endpoint_service_id":     kafka.PrivateEndpointServiceID,
+		"private_endpoint_service_name":   kafka.PrivateEndpointServiceName,
+		"private_endpoint_status_details": kafka.PrivateEndpointStatusDetails,
+	}
+}
@@ -0,0 +1,288 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package services
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	serviceError "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"sync"
+)
+
+// Ensure, that PrivateEndpointServiceMock does implement PrivateEndpointService.
+// If this is not the case, regenerate this file with moq.
+var _ PrivateEndpointService = &PrivateEndpointServiceMock{}
+
+// PrivateEndpointServiceMock is a mock implementation of PrivateEndpointService.
+//
+//	func TestSomethingThatUsesPrivateEndpointService(t *testing.T) {
+//
+//		// make and configure a mocked PrivateEndpointService
+//		mockedPrivateEndpointService := &PrivateEndpointServiceMock{
+//			DeletePrivateEndpointFunc: func(kafka *dbapi.KafkaRequest) *serviceError.ServiceError {
+//				panic("mock out the DeletePrivateEndpoint method")
+//			},
+//			ListDeprovisioningKafkasWithPrivateEndpointFunc: func() ([]*dbapi.KafkaRequest, *serviceError.ServiceError) {
+//				panic("mock out the ListDeprovisioningKafkasWithPrivateEndpoint method")
+//			},
+//			ListKafkasWithPrivateEndpointInProgressFunc: func() ([]*dbapi.KafkaRequest, *serviceError.ServiceError) {
+//				panic("mock out the ListKafkasWithPrivateEndpointInProgress method")
+//			},
+//			ReconcilePrivateEndpointFunc: func(kafka *dbapi.KafkaRequest) *serviceError.ServiceError {
+//				panic("mock out the ReconcilePrivateEndpoint method")
+//			},
+//			SupportsCloudProviderFunc: func(cloudProvider string) bool {
+//				panic("mock out the SupportsCloudProvider method")
+//			},
+//			UpdateAllowedAccountsFunc: func(kafka *dbapi.KafkaRequest, accountIDs []string) *serviceError.ServiceError {
+//				panic("mock out the UpdateAllowedAccounts method")
+//			},
+//		}
+//
+//		// use mockedPrivateEndpointService in code that requires PrivateEndpointService
+//		// and then make assertions.
+//
+//	}
+type PrivateEndpointServiceMock struct {
+	// DeletePrivateEndpointFunc mocks the DeletePrivateEndpoint method.
+	DeletePrivateEndpointFunc func(kafka *dbapi.KafkaRequest) *serviceError.ServiceError
+
+	// ListDeprovisioningKafkasWithPrivateEndpointFunc mocks the ListDeprovisioningKafkasWithPrivateEndpoint method.
+	ListDeprovisioningKafkasWithPrivateEndpointFunc func() ([]*dbapi.KafkaRequest, *serviceError.ServiceError)
+
+	// ListKafkasWithPrivateEndpointInProgressFunc mocks the ListKafkasWithPrivateEndpointInProgress method.
+	ListKafkasWithPrivateEndpointInProgressFunc func() ([]*dbapi.KafkaRequest, *serviceError.ServiceError)
+
+	// ReconcilePrivateEndpointFunc mocks the ReconcilePrivateEndpoint method.
+	ReconcilePrivateEndpointFunc func(kafka *dbapi.KafkaRequest) *serviceError.ServiceError
+
+	// SupportsCloudProviderFunc mocks the SupportsCloudProvider method.
+	SupportsCloudProviderFunc func(cloudProvider string) bool
+
+	// UpdateAllowedAccountsFunc mocks the UpdateAllowedAccounts method.
+	UpdateAllowedAccountsFunc func(kafka *dbapi.KafkaRequest, accountIDs []string) *serviceError.ServiceError
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// DeletePrivateEndpoint holds details about calls to the DeletePrivateEndpoint method.
+		DeletePrivateEndpoint []struct {
+			// Kafka is the kafka argument value.
+			Kafka *dbapi.KafkaRequest
+		}
+		// ListDeprovisioningKafkasWithPrivateEndpoint holds details about calls to the ListDeprovisioningKafkasWithPrivateEndpoint method.
+		ListDeprovisioningKafkasWithPrivateEndpoint []struct {
+		}
+		// ListKafkasWithPrivateEndpointInProgress holds details about calls to the ListKafkasWithPrivateEndpointInProgress method.
+		ListKafkasWithPrivateEndpointInProgress []struct {
+		}
+		// ReconcilePrivateEndpoint holds details about calls to the ReconcilePrivateEndpoint method.
+		ReconcilePrivateEndpoint []struct {
+			// Kafka is the kafka argument value.
+			Kafka *dbapi.KafkaRequest
+		}
+		// SupportsCloudProvider holds details about calls to the SupportsCloudProvider method.
+		SupportsCloudProvider []struct {
+			// CloudProvider is the cloudProvider argument value.
+			CloudProvider string
+		}
+		// UpdateAllowedAccounts holds details about calls to the UpdateAllowedAccounts method.
+		UpdateAllowedAccounts []struct {
+			// Kafka is the kafka argument value.
+			Kafka *dbapi.KafkaRequest
+			// AccountIDs is the accountIDs argument value.
+			AccountIDs []string
+		}
+	}
+	lockDeletePrivateEndpoint                       sync.RWMutex
+	lockListDeprovisioningKafkasWithPrivateEndpoint sync.RWMutex
+	lockListKafkasWithPrivateEndpointInProgress     sync.RWMutex
+	lockReconcilePrivateEndpoint                    sync.RWMutex
+	lockSupportsCloudProvider                       sync.RWMutex
+	lockUpdateAllowedAccounts                       sync.RWMutex
+}
+
+// DeletePrivateEndpoint calls DeletePrivateEndpointFunc.
+func (mock *PrivateEndpointServiceMock) DeletePrivateEndpoint(kafka *dbapi.KafkaRequest) *serviceError.ServiceError {
+	if mock.DeletePrivateEndpointFunc == nil {
+		panic("PrivateEndpointServiceMock.DeletePrivateEndpointFunc: method is nil but PrivateEndpointService.DeletePrivateEndpoint was just called")
+	}
+	callInfo := struct {
+		Kafka *dbapi.KafkaRequest
+	}{
+		Kafka: kafka,
+	}
+	mock.lockDeletePrivateEndpoint.Lock()
+	mock.calls.DeletePrivateEndpoint = append(mock.calls.DeletePrivateEndpoint, callInfo)
+	mock.lockDeletePrivateEndpoint.Unlock()
+	return mock.DeletePrivateEndpointFunc(kafka)
+}
+
+// DeletePrivateEndpointCalls gets all the calls that were made to DeletePrivateEndpoint.
+// Check the length with:
+//
+//	len(mockedPrivateEndpointService.DeletePrivateEndpointCalls())
+func (mock *PrivateEndpointServiceMock) DeletePrivateEndpointCalls() []struct {
+	Kafka *dbapi.KafkaRequest
+} {
+	var calls []struct {
+		Kafka *dbapi.KafkaRequest
+	}
+	mock.lockDeletePrivateEndpoint.RLock()
+	calls = mock.calls.DeletePrivateEndpoint
+	mock.lockDeletePrivateEndpoint.RUnlock()
+	return calls
+}
+
+// ListDeprovisioningKafkasWithPrivateEndpoint calls ListDeprovisioningKafkasWithPrivateEndpointFunc.
+func (mock *PrivateEndpointServiceMock) ListDeprovisioningKafkasWithPrivateEndpoint() ([]*dbapi.KafkaRequest, *serviceError.ServiceError) {
+	if mock.ListDeprovisioningKafkasWithPrivateEndpointFunc == nil {
+		panic("PrivateEndpointServiceMock.ListDeprovisioningKafkasWithPrivateEndpointFunc: method is nil but PrivateEndpointService.ListDeprovisioningKafkasWithPrivateEndpoint was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockListDeprovisioningKafkasWithPrivateEndpoint.Lock()
+	mock.calls.ListDeprovisioningKafkasWithPrivateEndpoint = append(mock.calls.ListDeprovisioningKafkasWithPrivateEndpoint, callInfo)
+	mock.lockListDeprovisioningKafkasWithPrivateEndpoint.Unlock()
+	return mock.ListDeprovisioningKafkasWithPrivateEndpointFunc()
+}
+
+// ListDeprovisioningKafkasWithPrivateEndpointCalls gets all the calls that were made to ListDeprovisioningKafkasWithPrivateEndpoint.
+// Check the length with:
+//
+//	len(mockedPrivateEndpointService.ListDeprovisioningKafkasWithPrivateEndpointCalls())
+func (mock *PrivateEndpointServiceMock) ListDeprovisioningKafkasWithPrivateEndpointCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockListDeprovisioningKafkasWithPrivateEndpoint.RLock()
+	calls = mock.calls.ListDeprovisioningKafkasWithPrivateEndpoint
+	mock.lockListDeprovisioningKafkasWithPrivateEndpoint.RUnlock()
+	return calls
+}
+
+// ListKafkasWithPrivateEndpointInProgress calls ListKafkasWithPrivateEndpointInProgressFunc.
+func (mock *PrivateEndpointServiceMock) ListKafkasWithPrivateEndpointInProgress() ([]*dbapi.KafkaRequest, *serviceError.ServiceError) {
+	if mock.ListKafkasWithPrivateEndpointInProgressFunc == nil {
+		panic("PrivateEndpointServiceMock.ListKafkasWithPrivateEndpointInProgressFunc: method is nil but PrivateEndpointService.ListKafkasWithPrivateEndpointInProgress was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockListKafkasWithPrivateEndpointInProgress.Lock()
+	mock.calls.ListKafkasWithPrivateEndpointInProgress = append(mock.calls.ListKafkasWithPrivateEndpointInProgress, callInfo)
+	mock.lockListKafkasWithPrivateEndpointInProgress.Unlock()
+	return mock.ListKafkasWithPrivateEndpointInProgressFunc()
+}
+
+// ListKafkasWithPrivateEndpointInProgressCalls gets all the calls that were made to ListKafkasWithPrivateEndpointInProgress.
+// Check the length with:
+//
+//	len(mockedPrivateEndpointService.ListKafkasWithPrivateEndpointInProgressCalls())
+func (mock *PrivateEndpointServiceMock) ListKafkasWithPrivateEndpointInProgressCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockListKafkasWithPrivateEndpointInProgress.RLock()
+	calls = mock.calls.ListKafkasWithPrivateEndpointInProgress
+	mock.lockListKafkasWithPrivateEndpointInProgress.RUnlock()
+	return calls
+}
+
+// ReconcilePrivateEndpoint calls ReconcilePrivateEndpointFunc.
+func (mock *PrivateEndpointServiceMock) ReconcilePrivateEndpoint(kafka *dbapi.KafkaRequest) *serviceError.ServiceError {
+	if mock.ReconcilePrivateEndpointFunc == nil {
+		panic("PrivateEndpointServiceMock.ReconcilePrivateEndpointFunc: method is nil but PrivateEndpointService.ReconcilePrivateEndpoint was just called")
+	}
+	callInfo := struct {
+		Kafka *dbapi.KafkaRequest
+	}{
+		Kafka: kafka,
+	}
+	mock.lockReconcilePrivateEndpoint.Lock()
+	mock.calls.ReconcilePrivateEndpoint = append(mock.calls.ReconcilePrivateEndpoint, callInfo)
+	mock.lockReconcilePrivateEndpoint.Unlock()
+	return mock.ReconcilePrivateEndpointFunc(kafka)
+}
+
+// ReconcilePrivateEndpointCalls gets all the calls that were made to ReconcilePrivateEndpoint.
+// Check the length with:
+//
+//	len(mockedPrivateEndpointService.ReconcilePrivateEndpointCalls())
+func (mock *PrivateEndpointServiceMock) ReconcilePrivateEndpointCalls() []struct {
+	Kafka *dbapi.KafkaRequest
+} {
+	var calls []struct {
+		Kafka *dbapi.KafkaRequest
+	}
+	mock.lockReconcilePrivateEndpoint.RLock()
+	calls = mock.calls.ReconcilePrivateEndpoint
+	mock.lockReconcilePrivateEndpoint.RUnlock()
+	return calls
+}
+
+// SupportsCloudProvider calls SupportsCloudProviderFunc.
+func (mock *PrivateEndpointServiceMock) SupportsCloudProvider(cloudProvider string) bool {
+	if mock.SupportsCloudProviderFunc == nil {
+		panic("PrivateEndpointServiceMock.SupportsCloudProviderFunc: method is nil but PrivateEndpointService.SupportsCloudProvider was just called")
+	}
+	callInfo := struct {
+		CloudProvider string
+	}{
+		CloudProvider: cloudProvider,
+	}
+	mock.lockSupportsCloudProvider.Lock()
+	mock.calls.SupportsCloudProvider = append(mock.calls.SupportsCloudProvider, callInfo)
+	mock.lockSupportsCloudProvider.Unlock()
+	return mock.SupportsCloudProviderFunc(cloudProvider)
+}
+
+// SupportsCloudProviderCalls gets all the calls that were made to SupportsCloudProvider.
+// Check the length with:
+//
+//	len(mockedPrivateEndpointService.SupportsCloudProviderCalls())
+func (mock *PrivateEndpointServiceMock) SupportsCloudProviderCalls() []struct {
+	CloudProvider string
+} {
+	var calls []struct {
+		CloudProvider string
+	}
+	mock.lockSupportsCloudProvider.RLock()
+	calls = mock.calls.SupportsCloudProvider
+	mock.lockSupportsCloudProvider.RUnlock()
+	return calls
+}
+
+// UpdateAllowedAccounts calls UpdateAllowedAccountsFunc.
+func (mock *PrivateEndpointServiceMock) UpdateAllowedAccounts(kafka *dbapi.KafkaRequest, accountIDs []string) *serviceError.ServiceError {
+	if mock.UpdateAllowedAccountsFunc == nil {
+		panic("PrivateEndpointServiceMock.UpdateAllowedAccountsFunc: method is nil but PrivateEndpointService.UpdateAllowedAccounts was just called")
+	}
+	callInfo := struct {
+		Kafka      *dbapi.KafkaRequest
+		AccountIDs []string
+	}{
+		Kafka:      kafka,
+		AccountIDs: accountIDs,
+	}
+	mock.lockUpdateAllowedAccounts.Lock()
+	mock.calls.UpdateAllowedAccounts = append(mock.calls.UpdateAllowedAccounts, callInfo)
+	mock.lockUpdateAllowedAccounts.Unlock()
+	return mock.UpdateAllowedAccountsFunc(kafka, accountIDs)
+}
+
+// UpdateAllowedAccountsCalls gets all the calls that were made to UpdateAllowedAccounts.
+// Check the length with:
+//
+//	len(mockedPrivateEndpointService.UpdateAllowedAccountsCalls())
+func (mock *PrivateEndpointServiceMock) UpdateAllowedAccountsCalls() []struct {
+	Kafka      *dbapi.KafkaRequest
+	AccountIDs []string
+} {
+	var calls []struct {
+		Kafka      *dbapi.KafkaRequest
+		AccountIDs []string
+	}
+	mock.lockUpdateAllowedAccounts.RLock()
+	calls = mock.calls.UpdateAllowedAccounts
+	mock.lockUpdateAllowedAccounts.RUnlock()
+	return calls
+}
@@ -0,0 +1,291 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/privateendpoint"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	apiErrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func privateEndpointProviderFactory(provider privateendpoint.Provider) privateendpoint.ProviderFactory {
+	return &privateendpoint.ProviderFactoryMock{
+		GetProviderFunc: func(cloudProvider string) (privateendpoint.Provider, error) {
+			return provider, nil
+		},
+	}
+}
+
+func buildPrivateKafkaRequest(modifyFn func(kafka *dbapi.KafkaRequest)) *dbapi.KafkaRequest {
+	kafka := buildKafkaRequest(func(kafka *dbapi.KafkaRequest) {
+		kafka.PrivateConnectivity = true
+		kafka.PrivateEndpointStatus = dbapi.PrivateEndpointStatusPending
+		_ = kafka.SetPrivateEndpointAllowedAccounts([]string{"111111111111"})
+	})
+	if modifyFn != nil {
+		modifyFn(kafka)
+	}
+	return kafka
+}
+
+func Test_privateEndpointService_ReconcilePrivateEndpoint(t *testing.T) {
+	availableEndpointService := func(ctx context.Context, region string, serviceID string) (*privateendpoint.EndpointService, error) {
+		return &privateendpoint.EndpointService{ID: serviceID, Name: "service-name", Status: privateendpoint.EndpointServiceStatusAvailable}, nil
+	}
+
+	tests := []struct {
+		name                string
+		kafka               *dbapi.KafkaRequest
+		provider            *privateendpoint.ProviderMock
+		wantStatus          dbapi.PrivateEndpointStatus
+		wantServiceID       string
+		wantAllowedAccounts []string
+		wantStatusDetails   string
+		wantSetAllowedCalls int
+		wantErr             bool
+	}{
+		{
+			name:  "should wait for the load balancer of the kafka to be provisioned",
+			kafka: buildPrivateKafkaRequest(nil),
+			provider: &privateendpoint.ProviderMock{
+				CreateEndpointServiceFunc: func(ctx context.Context, region string, kafkaID string) (*privateendpoint.EndpointService, error) {
+					return nil, nil
+				},
+			},
+			wantStatus: dbapi.PrivateEndpointStatusPending,
+		},
+		{
+			name:  "should create the endpoint service and wait for it to be available",
+			kafka: buildPrivateKafkaRequest(nil),
+			provider: &privateendpoint.ProviderMock{
+				CreateEndpointServiceFunc: func(ctx context.Context, region string, kafkaID string) (*privateendpoint.EndpointService, error) {
+					return &privateendpoint.EndpointService{ID: "vpce-svc-1", Name: "service-name", Status: privateendpoint.EndpointServiceStatusPending}, nil
+				},
+				GetEndpointServiceFunc: func(ctx context.Context, region string, serviceID string) (*privateendpoint.EndpointService, error) {
+					return &privateendpoint.EndpointService{ID: serviceID, Name: "service-name", Status: privateendpoint.EndpointServiceStatusPending}, nil
+				},
+			},
+			wantStatus:    dbapi.PrivateEndpointStatusProvisioning,
+			wantServiceID: "vpce-svc-1",
+		},
+		{
+			name: "should allow the accounts of an available endpoint service",
+			kafka: buildPrivateKafkaRequest(func(kafka *dbapi.KafkaRequest) {
+				kafka.PrivateEndpointStatus = dbapi.PrivateEndpointStatusProvisioning
+				kafka.PrivateEndpointServiceID = "vpce-svc-1"
+			}),
+			provider: &privateendpoint.ProviderMock{
+				GetEndpointServiceFunc: availableEndpointService,
+				SetAllowedAccountsFunc: func(ctx context.Context, region string, serviceID string, accountIDs []string) error {
+					return nil
+				},
+			},
+			wantStatus:          dbapi.PrivateEndpointStatusReady,
+			wantServiceID:       "vpce-svc-1",
+			wantAllowedAccounts: []string{"111111111111"},
+			wantSetAllowedCalls: 1,
+		},
+		{
+			name: "should fail the private endpoint when its endpoint service failed",
+			kafka: buildPrivateKafkaRequest(func(kafka *dbapi.KafkaRequest) {
+				kafka.PrivateEndpointStatus = dbapi.PrivateEndpointStatusProvisioning
+				kafka.PrivateEndpointServiceID = "vpce-svc-1"
+			}),
+			provider: &privateendpoint.ProviderMock{
+				GetEndpointServiceFunc: func(ctx context.Context, region string, serviceID string) (*privateendpoint.EndpointService, error) {
+					return &privateendpoint.EndpointService{ID: serviceID, Status: privateendpoint.EndpointServiceStatusFailed, StatusDetails: "creation failed"}, nil
+				},
+			},
+			wantStatus:        dbapi.PrivateEndpointStatusFailed,
+			wantServiceID:     "vpce-svc-1",
+			wantStatusDetails: "creation failed",
+		},
+		{
+			name: "should fail the private endpoint when its endpoint service no longer exists",
+			kafka: buildPrivateKafkaRequest(func(kafka *dbapi.KafkaRequest) {
+				kafka.PrivateEndpointStatus = dbapi.PrivateEndpointStatusUpdating
+				kafka.PrivateEndpointServiceID = "vpce-svc-1"
+			}),
+			provider: &privateendpoint.ProviderMock{
+				GetEndpointServiceFunc: func(ctx context.Context, region string, serviceID string) (*privateendpoint.EndpointService, error) {
+					return nil, nil
+				},
+			},
+			wantStatus:        dbapi.PrivateEndpointStatusFailed,
+			wantServiceID:     "vpce-svc-1",
+			wantStatusDetails: "the private endpoint service has been deleted",
+		},
+		{
+			name: "should return an error when the allowed accounts cannot be set",
+			kafka: buildPrivateKafkaRequest(func(kafka *dbapi.KafkaRequest) {
+				kafka.PrivateEndpointStatus = dbapi.PrivateEndpointStatusUpdating
+				kafka.PrivateEndpointServiceID = "vpce-svc-1"
+			}),
+			provider: &privateendpoint.ProviderMock{
+				GetEndpointServiceFunc: availableEndpointService,
+				SetAllowedAccountsFunc: func(ctx context.Context, region string, serviceID string, accountIDs []string) error {
+					return errors.New("InvalidPrincipal")
+				},
+			},
+			wantStatus:          dbapi.PrivateEndpointStatusUpdating,
+			wantServiceID:       "vpce-svc-1",
+			wantAllowedAccounts: []string{"111111111111"},
+			wantSetAllowedCalls: 1,
+			wantErr:             true,
+		},
+		{
+			name:  "should return an error when the endpoint service cannot be created",
+			kafka: buildPrivateKafkaRequest(nil),
+			provider: &privateendpoint.ProviderMock{
+				CreateEndpointServiceFunc: func(ctx context.Context, region string, kafkaID string) (*privateendpoint.EndpointService, error) {
+					return nil, errors.New("ServiceQuotaExceeded")
+				},
+			},
+			wantStatus: dbapi.PrivateEndpointStatusPending,
+			wantErr:    true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mocket.Catcher.Reset()
+			mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests"`).WithRowsNum(1)
+
+			s := NewPrivateEndpointService(db.NewMockConnectionFactory(nil), privateEndpointProviderFactory(tt.provider))
+			err := s.ReconcilePrivateEndpoint(tt.kafka)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(tt.kafka.PrivateEndpointStatus).To(gomega.Equal(tt.wantStatus))
+			g.Expect(tt.kafka.PrivateEndpointServiceID).To(gomega.Equal(tt.wantServiceID))
+			g.Expect(tt.kafka.PrivateEndpointStatusDetails).To(gomega.Equal(tt.wantStatusDetails))
+			g.Expect(tt.provider.SetAllowedAccountsCalls()).To(gomega.HaveLen(tt.wantSetAllowedCalls))
+			if tt.wantSetAllowedCalls > 0 {
+				g.Expect(tt.provider.SetAllowedAccountsCalls()[0].AccountIDs).To(gomega.Equal(tt.wantAllowedAccounts))
+			}
+		})
+	}
+}
+
+func Test_privateEndpointService_UpdateAllowedAccounts(t *testing.T) {
+	tests := []struct {
+		name       string
+		kafka      *dbapi.KafkaRequest
+		wantStatus dbapi.PrivateEndpointStatus
+		wantCode   apiErrors.ServiceErrorCode
+		wantErr    bool
+	}{
+		{
+			name: "should mark a ready private endpoint for update",
+			kafka: buildPrivateKafkaRequest(func(kafka *dbapi.KafkaRequest) {
+				kafka.PrivateEndpointStatus = dbapi.PrivateEndpointStatusReady
+			}),
+			wantStatus: dbapi.PrivateEndpointStatusUpdating,
+		},
+		{
+			name:       "should keep the status of a private endpoint not provisioned yet",
+			kafka:      buildPrivateKafkaRequest(nil),
+			wantStatus: dbapi.PrivateEndpointStatusPending,
+		},
+		{
+			name: "should return a conflict when the private endpoint failed",
+			kafka: buildPrivateKafkaRequest(func(kafka *dbapi.KafkaRequest) {
+				kafka.PrivateEndpointStatus = dbapi.PrivateEndpointStatusFailed
+			}),
+			wantErr:  true,
+			wantCode: apiErrors.ErrorConflict,
+		},
+		{
+			name:     "should return a bad request when the kafka has no private endpoint",
+			kafka:    buildKafkaRequest(nil),
+			wantErr:  true,
+			wantCode: apiErrors.ErrorBadRequest,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			mocket.Catcher.Reset()
+			mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests"`).WithRowsNum(1)
+
+			s := NewPrivateEndpointService(db.NewMockConnectionFactory(nil), &privateendpoint.ProviderFactoryMock{})
+			err := s.UpdateAllowedAccounts(tt.kafka, []string{"222222222222", "333333333333"})
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			if tt.wantErr {
+				g.Expect(err.Code).To(gomega.Equal(tt.wantCode))
+				return
+			}
+			g.Expect(tt.kafka.PrivateEndpointStatus).To(gomega.Equal(tt.wantStatus))
+			g.Expect(tt.kafka.GetPrivateEndpointAllowedAccounts()).To(gomega.Equal([]string{"222222222222", "333333333333"}))
+		})
+	}
+}
+
+func Test_privateEndpointService_DeletePrivateEndpoint(t *testing.T) {
+	tests := []struct {
+		name            string
+		kafka           *dbapi.KafkaRequest
+		deleteErr       error
+		wantDeleteCalls int
+		wantStatus      dbapi.PrivateEndpointStatus
+		wantErr         bool
+	}{
+		{
+			name: "should delete the endpoint service of the kafka",
+			kafka: buildPrivateKafkaRequest(func(kafka *dbapi.KafkaRequest) {
+				kafka.PrivateEndpointServiceID = "vpce-svc-1"
+				kafka.PrivateEndpointStatus = dbapi.PrivateEndpointStatusReady
+			}),
+			wantDeleteCalls: 1,
+			wantStatus:      dbapi.PrivateEndpointStatusDeleted,
+		},
+		{
+			name:       "should not do anything when the endpoint service has not been created",
+			kafka:      buildPrivateKafkaRequest(nil),
+			wantStatus: dbapi.PrivateEndpointStatusPending,
+		},
+		{
+			name: "should not do anything when the endpoint service has already been deleted",
+			kafka: buildPrivateKafkaRequest(func(kafka *dbapi.KafkaRequest) {
+				kafka.PrivateEndpointServiceID = "vpce-svc-1"
+				kafka.PrivateEndpointStatus = dbapi.PrivateEndpointStatusDeleted
+			}),
+			wantStatus: dbapi.PrivateEndpointStatusDeleted,
+		},
+		{
+			name: "should return an error when the endpoint service cannot be deleted",
+			kafka: buildPrivateKafkaRequest(func(kafka *dbapi.KafkaRequest) {
+				kafka.PrivateEndpointServiceID = "vpce-svc-1"
+			}),
+			deleteErr:       errors.New("AccessDenied"),
+			wantDeleteCalls: 1,
+			wantStatus:      dbapi.PrivateEndpointStatusPending,
+			wantErr:         true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			provider := &privateendpoint.ProviderMock{
+				DeleteEndpointServiceFunc: func(ctx context.Context, region string, serviceID string) error {
+					return tt.deleteErr
+				},
+			}
+
+			s := NewPrivateEndpointService(db.NewMockConnectionFactory(nil), privateEndpointProviderFactory(provider))
+			err := s.DeletePrivateEndpoint(tt.kafka)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(provider.DeleteEndpointServiceCalls()).To(gomega.HaveLen(tt.wantDeleteCalls))
+			g.Expect(tt.kafka.PrivateEndpointStatus).To(gomega.Equal(tt.wantStatus))
+		})
+	}
+}
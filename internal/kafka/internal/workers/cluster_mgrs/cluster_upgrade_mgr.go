@@ -0,0 +1,160 @@
+package cluster_mgrs
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	fleeterrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	clusterUpgradeWorkerType = "cluster_upgrade"
+)
+
+// ClusterUpgradeManager orchestrates the OpenShift upgrades requested for data plane clusters. Pending upgrades are
+// scheduled in the cluster provider once their scheduled time is reached, the upgrades of the previous batches of
+// their wave are completed and all the Kafka instances of the cluster are ready. The status of the scheduled
+// upgrades is then tracked until they complete or fail
+type ClusterUpgradeManager struct {
+	workers.BaseWorker
+
+	clusterUpgradeService services.ClusterUpgradeService
+}
+
+var _ workers.Worker = &ClusterUpgradeManager{}
+
+func NewClusterUpgradeManager(reconciler workers.Reconciler, clusterUpgradeService services.ClusterUpgradeService) *ClusterUpgradeManager {
+	return &ClusterUpgradeManager{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: clusterUpgradeWorkerType,
+			Reconciler: reconciler,
+		},
+		clusterUpgradeService: clusterUpgradeService,
+	}
+}
+
+func (m *ClusterUpgradeManager) Start() {
+	m.StartWorker(m)
+}
+
+func (m *ClusterUpgradeManager) Stop() {
+	m.StopWorker(m)
+}
+
+func (m *ClusterUpgradeManager) Reconcile() []error {
+	glog.Infoln("reconciling data plane cluster upgrades")
+	var errList fleeterrors.ErrorList
+
+	upgradingClusters, err := m.clusterUpgradeService.ListActiveUpgrades()
+	if err != nil {
+		errList.AddErrors(errors.Wrap(err, "failed to list the clusters being upgraded"))
+		return errList.ToErrorSlice()
+	}
+
+	waves := map[string][]*api.Cluster{}
+	for _, cluster := range upgradingClusters {
+		var reconcileErr *fleeterrors.ServiceError
+		if cluster.UpgradeStatus == api.ClusterUpgradePending {
+			reconcileErr = m.reconcilePendingUpgrade(cluster, waves)
+		} else {
+			reconcileErr = m.clusterUpgradeService.RefreshUpgradeStatus(cluster)
+		}
+
+		if reconcileErr != nil {
+			errList.AddErrors(errors.Wrapf(reconcileErr, "failed to reconcile the upgrade of cluster %q", cluster.ClusterID))
+		}
+	}
+
+	return errList.ToErrorSlice()
+}
+
+// reconcilePendingUpgrade starts the upgrade of the cluster once all its pre-checks pass. The reason an upgrade is
+// held back is recorded in its status details
+func (m *ClusterUpgradeManager) reconcilePendingUpgrade(cluster *api.Cluster, waves map[string][]*api.Cluster) *fleeterrors.ServiceError {
+	if arrays.Contains(api.ClusterDeletionStatuses, cluster.Status.String()) {
+		glog.Infof("cancelling the upgrade of cluster %q being deleted", cluster.ClusterID)
+		cluster.UpgradeStatus = api.ClusterUpgradeCancelled
+		cluster.UpgradeStatusDetails = fmt.Sprintf("cluster is in status %q", cluster.Status)
+		return m.clusterUpgradeService.UpdateUpgradeStatus(cluster)
+	}
+
+	if cluster.UpgradeScheduledAt != nil && cluster.UpgradeScheduledAt.After(time.Now()) {
+		return nil
+	}
+
+	holdReason, err := m.findUpgradeHoldReason(cluster, waves)
+	if err != nil {
+		return err
+	}
+
+	if holdReason != "" {
+		if holdReason == cluster.UpgradeStatusDetails {
+			return nil
+		}
+		glog.Infof("upgrade of cluster %q to version %q is held back: %s", cluster.ClusterID, cluster.UpgradeVersion, holdReason)
+		cluster.UpgradeStatusDetails = holdReason
+		return m.clusterUpgradeService.UpdateUpgradeStatus(cluster)
+	}
+
+	glog.Infof("scheduling the upgrade of cluster %q to version %q", cluster.ClusterID, cluster.UpgradeVersion)
+	return m.clusterUpgradeService.StartUpgrade(cluster)
+}
+
+func (m *ClusterUpgradeManager) findUpgradeHoldReason(cluster *api.Cluster, waves map[string][]*api.Cluster) (string, *fleeterrors.ServiceError) {
+	if cluster.Status != api.ClusterReady {
+		return fmt.Sprintf("waiting for the cluster in status %q to be ready", cluster.Status), nil
+	}
+
+	if cluster.UpgradeWaveID != "" {
+		waveClusters, ok := waves[cluster.UpgradeWaveID]
+		if !ok {
+			var err *fleeterrors.ServiceError
+			waveClusters, err = m.clusterUpgradeService.ListWaveClusters(cluster.UpgradeWaveID)
+			if err != nil {
+				return "", err
+			}
+			waves[cluster.UpgradeWaveID] = waveClusters
+		}
+
+		if reason := findUpgradeWaveHoldReason(cluster, waveClusters); reason != "" {
+			return reason, nil
+		}
+	}
+
+	notReadyKafkas, err := m.clusterUpgradeService.CountNotReadyKafkas(cluster.ClusterID)
+	if err != nil {
+		return "", err
+	}
+	if notReadyKafkas > 0 {
+		return fmt.Sprintf("waiting for %d kafka instances to be ready", notReadyKafkas), nil
+	}
+
+	return "", nil
+}
+
+// findUpgradeWaveHoldReason holds the upgrade of a cluster back until the upgrades of the clusters of the previous
+// batches of its wave are finished. The whole wave is halted as soon as one of these upgrades fails
+func findUpgradeWaveHoldReason(cluster *api.Cluster, waveClusters []*api.Cluster) string {
+	for _, waveCluster := range waveClusters {
+		if waveCluster.UpgradeScheduledAt == nil || !waveCluster.UpgradeScheduledAt.Before(*cluster.UpgradeScheduledAt) {
+			continue
+		}
+
+		switch {
+		case waveCluster.UpgradeStatus == api.ClusterUpgradeFailed:
+			return fmt.Sprintf("upgrade wave %q is halted as the upgrade of cluster %q failed", cluster.UpgradeWaveID, waveCluster.ClusterID)
+		case waveCluster.IsUpgradeActive():
+			return fmt.Sprintf("waiting for the upgrade of cluster %q of the previous batch to finish", waveCluster.ClusterID)
+		}
+	}
+
+	return ""
+}
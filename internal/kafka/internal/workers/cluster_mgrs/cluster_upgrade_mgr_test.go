@@ -0,0 +1,173 @@
+package cluster_mgrs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/onsi/gomega"
+)
+
+func Test_ClusterUpgradeManager_Reconcile(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	earlier := past.Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	pendingUpgrade := func(modifyFn func(cluster *api.Cluster)) *api.Cluster {
+		cluster := &api.Cluster{
+			ClusterID:          "cluster-id",
+			Status:             api.ClusterReady,
+			UpgradeStatus:      api.ClusterUpgradePending,
+			UpgradeVersion:     "4.11.25",
+			UpgradeScheduledAt: &past,
+		}
+		if modifyFn != nil {
+			modifyFn(cluster)
+		}
+		return cluster
+	}
+
+	type want struct {
+		startCalls   int
+		refreshCalls int
+		updateCalls  int
+		status       api.ClusterUpgradeStatus
+		details      string
+	}
+
+	tests := []struct {
+		name           string
+		cluster        *api.Cluster
+		waveClusters   []*api.Cluster
+		notReadyKafkas int64
+		want           want
+	}{
+		{
+			name:    "should start a pending upgrade once all its pre-checks pass",
+			cluster: pendingUpgrade(nil),
+			want:    want{startCalls: 1, status: api.ClusterUpgradePending},
+		},
+		{
+			name: "should not start a pending upgrade before its scheduled time",
+			cluster: pendingUpgrade(func(cluster *api.Cluster) {
+				cluster.UpgradeScheduledAt = &future
+			}),
+			want: want{status: api.ClusterUpgradePending},
+		},
+		{
+			name:           "should hold a pending upgrade back while Kafka instances of the cluster are not ready",
+			cluster:        pendingUpgrade(nil),
+			notReadyKafkas: 2,
+			want:           want{updateCalls: 1, status: api.ClusterUpgradePending, details: "waiting for 2 kafka instances to be ready"},
+		},
+		{
+			name: "should not update a held back upgrade whose hold reason did not change",
+			cluster: pendingUpgrade(func(cluster *api.Cluster) {
+				cluster.UpgradeStatusDetails = "waiting for 2 kafka instances to be ready"
+			}),
+			notReadyKafkas: 2,
+			want:           want{status: api.ClusterUpgradePending, details: "waiting for 2 kafka instances to be ready"},
+		},
+		{
+			name: "should hold a pending upgrade back while the cluster is not ready",
+			cluster: pendingUpgrade(func(cluster *api.Cluster) {
+				cluster.Status = api.ClusterWaitingForKasFleetShardOperator
+			}),
+			want: want{updateCalls: 1, status: api.ClusterUpgradePending, details: `waiting for the cluster in status "waiting_for_kas_fleetshard_operator" to be ready`},
+		},
+		{
+			name: "should cancel the pending upgrade of a cluster being deleted",
+			cluster: pendingUpgrade(func(cluster *api.Cluster) {
+				cluster.Status = api.ClusterDeprovisioning
+			}),
+			want: want{updateCalls: 1, status: api.ClusterUpgradeCancelled, details: `cluster is in status "deprovisioning"`},
+		},
+		{
+			name: "should hold a pending upgrade back until the upgrades of the previous batch of its wave finish",
+			cluster: pendingUpgrade(func(cluster *api.Cluster) {
+				cluster.UpgradeWaveID = "wave-id"
+			}),
+			waveClusters: []*api.Cluster{
+				{ClusterID: "previous-cluster-id", UpgradeStatus: api.ClusterUpgradeInProgress, UpgradeScheduledAt: &earlier},
+			},
+			want: want{updateCalls: 1, status: api.ClusterUpgradePending, details: `waiting for the upgrade of cluster "previous-cluster-id" of the previous batch to finish`},
+		},
+		{
+			name: "should halt the wave when an upgrade of a previous batch failed",
+			cluster: pendingUpgrade(func(cluster *api.Cluster) {
+				cluster.UpgradeWaveID = "wave-id"
+			}),
+			waveClusters: []*api.Cluster{
+				{ClusterID: "previous-cluster-id", UpgradeStatus: api.ClusterUpgradeFailed, UpgradeScheduledAt: &earlier},
+			},
+			want: want{updateCalls: 1, status: api.ClusterUpgradePending, details: `upgrade wave "wave-id" is halted as the upgrade of cluster "previous-cluster-id" failed`},
+		},
+		{
+			name: "should start a pending upgrade of a wave once the upgrades of the previous batches completed",
+			cluster: pendingUpgrade(func(cluster *api.Cluster) {
+				cluster.UpgradeWaveID = "wave-id"
+			}),
+			waveClusters: []*api.Cluster{
+				{ClusterID: "previous-cluster-id", UpgradeStatus: api.ClusterUpgradeCompleted, UpgradeScheduledAt: &earlier},
+				{ClusterID: "same-batch-cluster-id", UpgradeStatus: api.ClusterUpgradeInProgress, UpgradeScheduledAt: &past},
+			},
+			want: want{startCalls: 1, status: api.ClusterUpgradePending},
+		},
+		{
+			name: "should refresh the status of a scheduled upgrade",
+			cluster: pendingUpgrade(func(cluster *api.Cluster) {
+				cluster.UpgradeStatus = api.ClusterUpgradeScheduled
+			}),
+			want: want{refreshCalls: 1, status: api.ClusterUpgradeScheduled},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			upgradeService := &services.ClusterUpgradeServiceMock{
+				ListActiveUpgradesFunc: func() ([]*api.Cluster, *errors.ServiceError) {
+					return []*api.Cluster{tt.cluster}, nil
+				},
+				ListWaveClustersFunc: func(waveID string) ([]*api.Cluster, *errors.ServiceError) {
+					return append(tt.waveClusters, tt.cluster), nil
+				},
+				CountNotReadyKafkasFunc: func(clusterID string) (int64, *errors.ServiceError) {
+					return tt.notReadyKafkas, nil
+				},
+				StartUpgradeFunc: func(cluster *api.Cluster) *errors.ServiceError {
+					return nil
+				},
+				RefreshUpgradeStatusFunc: func(cluster *api.Cluster) *errors.ServiceError {
+					return nil
+				},
+				UpdateUpgradeStatusFunc: func(cluster *api.Cluster) *errors.ServiceError {
+					return nil
+				},
+			}
+
+			m := NewClusterUpgradeManager(workers.Reconciler{}, upgradeService)
+			g.Expect(m.Reconcile()).To(gomega.BeEmpty())
+			g.Expect(upgradeService.StartUpgradeCalls()).To(gomega.HaveLen(tt.want.startCalls))
+			g.Expect(upgradeService.RefreshUpgradeStatusCalls()).To(gomega.HaveLen(tt.want.refreshCalls))
+			g.Expect(upgradeService.UpdateUpgradeStatusCalls()).To(gomega.HaveLen(tt.want.updateCalls))
+			g.Expect(tt.cluster.UpgradeStatus).To(gomega.Equal(tt.want.status))
+			g.Expect(tt.cluster.UpgradeStatusDetails).To(gomega.Equal(tt.want.details))
+		})
+	}
+}
+
+func Test_ClusterUpgradeManager_ReconcileErrors(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	m := NewClusterUpgradeManager(workers.Reconciler{}, &services.ClusterUpgradeServiceMock{
+		ListActiveUpgradesFunc: func() ([]*api.Cluster, *errors.ServiceError) {
+			return nil, errors.GeneralError("failed to list clusters")
+		},
+	})
+	g.Expect(m.Reconcile()).To(gomega.HaveLen(1))
+}
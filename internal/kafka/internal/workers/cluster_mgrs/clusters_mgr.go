@@ -1,11 +1,14 @@
 package cluster_mgrs
 
 import (
+	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/sso"
 
 	kafkaConstants "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/cloudproviders"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters/types"
@@ -16,7 +19,6 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
 
 	"strings"
-	"sync"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
@@ -92,11 +94,7 @@ var clusterLoggingOperatorAddonParams = []types.Parameter{
 // ClusterManager represents a cluster manager that periodically reconciles osd clusters.
 
 type ClusterManager struct {
-	id           string
-	workerType   string
-	isRunning    bool
-	imStop       chan struct{} //a chan used only for cancellation.
-	syncTeardown sync.WaitGroup
+	workers.BaseWorker
 	ClusterManagerOptions
 }
 
@@ -113,6 +111,8 @@ type ClusterManagerOptions struct {
 	SsoService                 sso.KafkaKeycloakService
 	OsdIdpKeycloakService      sso.OsdKeycloakService
 	ProviderFactory            clusters.ProviderFactory
+	ClusterBlueprintService    services.ClusterBlueprintService
+	ClusterUpgradeService      services.ClusterUpgradeService
 }
 
 type processor func() []error
@@ -120,52 +120,25 @@ type processor func() []error
 // NewClusterManager creates a new cluster manager.
 func NewClusterManager(o ClusterManagerOptions) *ClusterManager {
 	return &ClusterManager{
-		id:                    uuid.New().String(),
-		workerType:            "cluster",
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: "cluster",
+			Reconciler: o.Reconciler,
+		},
 		ClusterManagerOptions: o,
 	}
 }
 
-func (c *ClusterManager) GetStopChan() *chan struct{} {
-	return &c.imStop
-}
-
-func (c *ClusterManager) GetSyncGroup() *sync.WaitGroup {
-	return &c.syncTeardown
-}
-
-// GetID returns the ID that represents this worker.
-func (c *ClusterManager) GetID() string {
-	return c.id
-}
-
-func (c *ClusterManager) GetWorkerType() string {
-	return c.workerType
-}
-
 // Start initializes the cluster manager to reconcile osd clusters.
 func (c *ClusterManager) Start() {
-	metrics.SetLeaderWorkerMetric(c.workerType, true)
-	c.Reconciler.Start(c)
+	c.StartWorker(c)
 }
 
 // Stop causes the process for reconciling osd clusters to stop.
 func (c *ClusterManager) Stop() {
-	c.Reconciler.Stop(c)
+	c.BaseWorker.Reconciler.Stop(c)
 	metrics.ResetMetricsForClusterManagers()
-	metrics.SetLeaderWorkerMetric(c.workerType, false)
-}
-
-func (c *ClusterManager) IsRunning() bool {
-	return c.isRunning
-}
-
-func (c *ClusterManager) SetIsRunning(val bool) {
-	c.isRunning = val
-}
-
-func (c *ClusterManager) HasTerminated() bool {
-	return false
+	metrics.SetLeaderWorkerMetric(c.WorkerType, false)
 }
 
 func (c *ClusterManager) Reconcile() []error {
@@ -175,6 +148,7 @@ func (c *ClusterManager) Reconcile() []error {
 	processors := []processor{
 		c.processMetrics,
 		c.reconcileClusterWithManualConfig,
+		c.reconcileClusterBlueprints,
 		c.processAcceptedClusters,
 		c.processProvisioningClusters,
 		c.processProvisionedClusters,
@@ -669,7 +643,7 @@ func (c *ClusterManager) reconcileClusterLoggingOperator(provisionedCluster api.
 
 // reconcileClusterWithConfig reconciles clusters within the dataplane-cluster-configuration file.
 // New clusters will be registered if it is not yet in the database.
-// A cluster will be deprovisioned if it is in the database but not in the coreConfig file (unless it's an enterprise OSD cluster)
+// A cluster will be deprovisioned if it is in the database but not in the coreConfig file (unless it's an enterprise OSD cluster or it is managed by a cluster blueprint)
 func (c *ClusterManager) reconcileClusterWithManualConfig() []error {
 	if !c.DataplaneClusterConfig.IsDataPlaneManualScalingEnabled() {
 		glog.Infoln("manual cluster configuration reconciliation is skipped as it is disabled")
@@ -709,8 +683,14 @@ func (c *ClusterManager) reconcileClusterWithManualConfig() []error {
 		}
 	}
 
-	// Remove all clusters that are not in the config file.
-	excessClusterIds := c.DataplaneClusterConfig.ClusterConfig.ExcessClusters(clusterIdsMap)
+	// Remove all clusters that are not in the config file. Clusters managed by a cluster blueprint are retired by their blueprint instead
+	var excessClusterIds []string
+	for _, clusterID := range c.DataplaneClusterConfig.ClusterConfig.ExcessClusters(clusterIdsMap) {
+		if clusterIdsMap[clusterID].ClusterBlueprintID != "" {
+			continue
+		}
+		excessClusterIds = append(excessClusterIds, clusterID)
+	}
 	if len(excessClusterIds) == 0 {
 		return nil
 	}
@@ -744,6 +724,177 @@ func (c *ClusterManager) reconcileClusterWithManualConfig() []error {
 	return []error{}
 }
 
+// reconcileClusterBlueprints brings the clusters of each cluster blueprint in line with it.
+// Missing clusters are registered, clusters with outdated instance types or provider spec are updated
+// and excess clusters are deprovisioned once they are empty
+func (c *ClusterManager) reconcileClusterBlueprints() []error {
+	blueprints, err := c.ClusterBlueprintService.List()
+	if err != nil {
+		return []error{errors.Wrap(err, "failed to list cluster blueprints")}
+	}
+
+	var errs []error
+	for _, blueprint := range blueprints {
+		plan, err := c.ClusterBlueprintService.Plan(blueprint)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to plan cluster blueprint %q", blueprint.ID))
+			continue
+		}
+
+		for _, step := range plan.Steps {
+			if err := c.applyClusterBlueprintPlanStep(blueprint, step); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return errs
+}
+
+func (c *ClusterManager) applyClusterBlueprintPlanStep(blueprint *dbapi.ClusterBlueprint, step services.ClusterBlueprintPlanStep) error {
+	if step.Blocked {
+		glog.Infof("skipping %s step of cluster blueprint %q for cluster %q: %s", step.Action, blueprint.ID, step.Cluster.ClusterID, step.Reason)
+		return nil
+	}
+
+	providerSpec, err := blueprint.BuildClusterProviderSpec()
+	if err != nil {
+		return errors.Wrapf(err, "failed to build the provider spec of cluster blueprint %q", blueprint.ID)
+	}
+	providerSpecJSON, err := json.Marshal(providerSpec)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal the provider spec of cluster blueprint %q", blueprint.ID)
+	}
+	supportedInstanceType := strings.Join(blueprint.GetSupportedInstanceTypes(), ",")
+
+	switch step.Action {
+	case services.ClusterBlueprintPlanActionCreate:
+		clusterRequest := &api.Cluster{
+			CloudProvider:                 blueprint.CloudProvider,
+			Region:                        blueprint.Region,
+			MultiAZ:                       blueprint.MultiAZ,
+			SupportedInstanceType:         supportedInstanceType,
+			Status:                        api.ClusterAccepted,
+			ProviderType:                  api.ClusterProviderOCM,
+			ProviderSpec:                  providerSpecJSON,
+			AccessKafkasViaPrivateNetwork: false,
+			ClusterType:                   api.ManagedDataPlaneClusterType.String(),
+			ClusterBlueprintID:            blueprint.ID,
+		}
+		if err := c.ClusterService.RegisterClusterJob(clusterRequest); err != nil {
+			return errors.Wrapf(err, "failed to register new cluster for cluster blueprint %q", blueprint.ID)
+		}
+		glog.Infof("registered a new cluster for cluster blueprint %q: %s", blueprint.ID, step.Reason)
+	case services.ClusterBlueprintPlanActionUpdate:
+		cluster := *step.Cluster
+		cluster.SupportedInstanceType = supportedInstanceType
+		cluster.ProviderSpec = providerSpecJSON
+		switch {
+		case cluster.Status == api.ClusterReady:
+			// the provider spec is only saved once the cluster is in line with it, the step is pending until then
+			applied, err := c.applyClusterBlueprintSpec(cluster, providerSpec)
+			if err != nil {
+				return errors.Wrapf(err, "failed to update cluster %q for cluster blueprint %q", cluster.ClusterID, blueprint.ID)
+			}
+			if !applied {
+				return nil
+			}
+		case cluster.ClusterID == "":
+			// the cluster is created in its provider with the updated spec
+			if err := c.ClusterService.Update(cluster); err != nil {
+				return errors.Wrapf(err, "failed to update cluster %q for cluster blueprint %q", cluster.ID, blueprint.ID)
+			}
+		default:
+			glog.Infof("update of cluster %q for cluster blueprint %q is pending until the cluster is ready: %s", cluster.ClusterID, blueprint.ID, step.Reason)
+			return nil
+		}
+		glog.Infof("updated cluster %q for cluster blueprint %q: %s", cluster.ClusterID, blueprint.ID, step.Reason)
+	case services.ClusterBlueprintPlanActionRetire:
+		if err := c.ClusterService.UpdateStatus(*step.Cluster, api.ClusterDeprovisioning); err != nil {
+			return errors.Wrapf(err, "failed to deprovision cluster %q for cluster blueprint %q", step.Cluster.ClusterID, blueprint.ID)
+		}
+		glog.Infof("deprovisioning cluster %q for cluster blueprint %q: %s", step.Cluster.ClusterID, blueprint.ID, step.Reason)
+	}
+
+	return nil
+}
+
+// applyClusterBlueprintSpec brings a ready cluster in line with the provider spec of its blueprint, and saves it once it is.
+// The cluster is upgraded to the OpenShift version of the blueprint first, then the autoscaling of its existing machine pools
+// is updated and its missing machine pools are created. It returns false while the changes are not applied yet.
+// The cluster wide machine type only applies to new clusters, and the machine type of an existing machine pool cannot be changed
+func (c *ClusterManager) applyClusterBlueprintSpec(cluster api.Cluster, providerSpec *types.ClusterProviderSpec) (bool, error) {
+	if providerSpec.OpenShiftVersion != "" {
+		upgraded, err := c.upgradeClusterToBlueprintVersion(cluster, strings.TrimPrefix(providerSpec.OpenShiftVersion, "openshift-v"))
+		if err != nil || !upgraded {
+			return false, err
+		}
+	}
+
+	providerClient, err := c.ProviderFactory.GetProvider(cluster.ProviderType)
+	if err != nil {
+		return false, err
+	}
+	for _, supportedInstanceType := range cluster.GetSupportedInstanceTypes() {
+		machinePoolID := fmt.Sprintf("kafka-%s", supportedInstanceType)
+		existingMachinePool, err := providerClient.GetMachinePool(cluster.ClusterID, machinePoolID)
+		if err != nil {
+			return false, err
+		}
+		if existingMachinePool == nil {
+			// created by the machine pool reconciliation below
+			continue
+		}
+
+		machinePoolRequest, err := c.buildMachinePoolRequest(machinePoolID, supportedInstanceType, cluster)
+		if err != nil {
+			return false, err
+		}
+		if existingMachinePool.InstanceSize != machinePoolRequest.InstanceSize {
+			return false, fmt.Errorf("the machine type of machine pool %q cannot be changed from %q to %q, the cluster has to be replaced",
+				machinePoolID, existingMachinePool.InstanceSize, machinePoolRequest.InstanceSize)
+		}
+		if existingMachinePool.AutoScaling == machinePoolRequest.AutoScaling {
+			continue
+		}
+		glog.Infof("updating the autoscaling of MachinePool '%s' for clusterID '%s' from %+v to %+v", machinePoolID, cluster.ClusterID,
+			existingMachinePool.AutoScaling, machinePoolRequest.AutoScaling)
+		if _, err := providerClient.UpdateMachinePool(machinePoolRequest); err != nil {
+			return false, err
+		}
+	}
+
+	// creates the missing machine pools and saves the cluster along with the capacity of its machine pools
+	return c.reconcileClusterMachinePools(cluster)
+}
+
+// upgradeClusterToBlueprintVersion requests the upgrade of the cluster to the given OpenShift version. It returns true once the cluster runs it
+func (c *ClusterManager) upgradeClusterToBlueprintVersion(cluster api.Cluster, version string) (bool, error) {
+	versionInfo, svcErr := c.ClusterUpgradeService.GetClusterVersion(&cluster)
+	if svcErr != nil {
+		return false, svcErr
+	}
+	if versionInfo.Version == version {
+		return true, nil
+	}
+
+	switch {
+	case cluster.IsUpgradeActive():
+		glog.Infof("cluster %q is being upgraded from OpenShift version %q to %q, its blueprint requires %q", cluster.ClusterID,
+			versionInfo.Version, cluster.UpgradeVersion, version)
+		return false, nil
+	case cluster.UpgradeStatus == api.ClusterUpgradeFailed && cluster.UpgradeVersion == version:
+		// a failed upgrade is not retried automatically, it can be scheduled again through the cluster upgrade endpoints
+		return false, fmt.Errorf("the upgrade to OpenShift version %q failed: %s", version, cluster.UpgradeStatusDetails)
+	}
+
+	if svcErr := c.ClusterUpgradeService.ScheduleUpgrade(&cluster, version, time.Now()); svcErr != nil {
+		return false, svcErr
+	}
+	glog.Infof("scheduled the upgrade of cluster %q from OpenShift version %q to %q", cluster.ClusterID, versionInfo.Version, version)
+	return false, nil
+}
+
 func (c *ClusterManager) buildResourceSet(cluster api.Cluster) types.ResourceSet {
 	var r []interface{}
 	switch cluster.ClusterType {
@@ -1106,7 +1257,21 @@ func (c *ClusterManager) buildMachinePoolRequest(machinePoolID string, supported
 		return nil, errors.Wrapf(err, "clusterID's %q cloud provider %q is not a recognized cloud provider", cluster.ClusterID, cluster.CloudProvider)
 	}
 
+	providerSpec, err := types.ParseClusterProviderSpec(cluster.ProviderSpec)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse the provider spec of cluster %q", cluster.ClusterID)
+	}
+
 	dynamicScalingConfig, found := computeMachinesConfig.GetKafkaWorkloadConfigForInstanceType(supportedInstanceType)
+	// the machine pool of clusters managed by a cluster blueprint may be overridden by the blueprint
+	if machinePoolSpec, ok := providerSpec.GetMachinePool(supportedInstanceType); ok {
+		dynamicScalingConfig.ComputeMachineType = machinePoolSpec.MachineType
+		dynamicScalingConfig.ComputeNodesAutoscaling = &config.ComputeNodesAutoscalingConfig{
+			MinComputeNodes: machinePoolSpec.MinComputeNodes,
+			MaxComputeNodes: machinePoolSpec.MaxComputeNodes,
+		}
+		found = true
+	}
 	if !found {
 		return nil, fmt.Errorf("no dynamic scaling configuration found for instance type '%s'", supportedInstanceType)
 	}
@@ -1138,7 +1303,8 @@ func (c *ClusterManager) buildMachinePoolRequest(machinePoolID string, supported
 }
 
 func (c *ClusterManager) reconcileClusterMachinePools(cluster api.Cluster) (bool, error) {
-	if !c.DataplaneClusterConfig.IsDataPlaneAutoScalingEnabled() {
+	// clusters managed by a cluster blueprint get their machine pools whatever the scaling mode
+	if !c.DataplaneClusterConfig.IsDataPlaneAutoScalingEnabled() && cluster.ClusterBlueprintID == "" {
 		return true, nil
 	}
 	// TODO should we implement machinepool creation of the additional 'kafka'
@@ -3,8 +3,10 @@ package cluster_mgrs
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/cloudproviders"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters/types"
@@ -191,11 +193,12 @@ func TestClusterManager_IsRunning(t *testing.T) {
 
 func TestClusterManager_reconcile(t *testing.T) {
 	type fields struct {
-		clusterService         services.ClusterService
-		dataplaneClusterConfig *config.DataplaneClusterConfig
-		supportedProviders     *config.ProviderConfig
-		OCMConfig              *ocm.OCMConfig
-		ProviderFactory        clusters.ProviderFactory
+		clusterService          services.ClusterService
+		dataplaneClusterConfig  *config.DataplaneClusterConfig
+		supportedProviders      *config.ProviderConfig
+		OCMConfig               *ocm.OCMConfig
+		ProviderFactory         clusters.ProviderFactory
+		clusterBlueprintService services.ClusterBlueprintService
 	}
 	tests := []struct {
 		name    string
@@ -236,6 +239,11 @@ func TestClusterManager_reconcile(t *testing.T) {
 						}}, nil
 					},
 				},
+				clusterBlueprintService: &services.ClusterBlueprintServiceMock{
+					ListFunc: func() (dbapi.ClusterBlueprintList, *apiErrors.ServiceError) {
+						return dbapi.ClusterBlueprintList{}, nil
+					},
+				},
 			},
 			wantErr: false,
 		},
@@ -248,11 +256,12 @@ func TestClusterManager_reconcile(t *testing.T) {
 
 			c := &ClusterManager{
 				ClusterManagerOptions: ClusterManagerOptions{
-					ClusterService:         tt.fields.clusterService,
-					DataplaneClusterConfig: tt.fields.dataplaneClusterConfig,
-					SupportedProviders:     tt.fields.supportedProviders,
-					OCMConfig:              tt.fields.OCMConfig,
-					ProviderFactory:        tt.fields.ProviderFactory,
+					ClusterService:          tt.fields.clusterService,
+					DataplaneClusterConfig:  tt.fields.dataplaneClusterConfig,
+					SupportedProviders:      tt.fields.supportedProviders,
+					OCMConfig:               tt.fields.OCMConfig,
+					ProviderFactory:         tt.fields.ProviderFactory,
+					ClusterBlueprintService: tt.fields.clusterBlueprintService,
 				},
 			}
 
@@ -2933,6 +2942,21 @@ func TestClusterManager_reconcileClusterWithManualConfig(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Should not deprovision clusters managed by a cluster blueprint",
+			fields: fields{
+				clusterService: &services.ClusterServiceMock{
+					ListNonEnterpriseClusterIDsFunc: func() ([]api.Cluster, *apiErrors.ServiceError) {
+						return []api.Cluster{{ClusterID: "test02", ClusterBlueprintID: "blueprint-id"}}, nil
+					},
+					RegisterClusterJobFunc: func(clusterReq *api.Cluster) *apiErrors.ServiceError {
+						return nil
+					},
+				},
+				DataplaneClusterConfig: testOsdConfig,
+			},
+			wantErr: false,
+		},
 		{
 			name: "Should fail if UpdateMultiClusterStatus fails on clusters to deprovision",
 			fields: fields{
@@ -3030,6 +3054,279 @@ func TestClusterManager_reconcileClusterWithManualConfig(t *testing.T) {
 	}
 }
 
+func TestClusterManager_reconcileClusterBlueprints(t *testing.T) {
+	blueprint := &dbapi.ClusterBlueprint{
+		Meta:                  api.Meta{ID: "blueprint-id"},
+		CloudProvider:         cloudproviders.AWS.String(),
+		Region:                "us-east-1",
+		ClusterCount:          1,
+		SupportedInstanceType: "standard",
+		MultiAZ:               true,
+		OpenShiftVersion:      "openshift-v4.11.22",
+		MachinePools:          api.JSON(`[{"instance_type":"standard","machine_type":"m5.2xlarge","min_compute_nodes":3,"max_compute_nodes":6}]`),
+	}
+	blueprintCluster := &api.Cluster{
+		Meta:                  api.Meta{ID: "id"},
+		ClusterID:             "cluster-id",
+		CloudProvider:         cloudproviders.AWS.String(),
+		Status:                api.ClusterReady,
+		SupportedInstanceType: "developer",
+		ClusterBlueprintID:    blueprint.ID,
+	}
+	listBlueprints := func() (dbapi.ClusterBlueprintList, *apiErrors.ServiceError) {
+		return dbapi.ClusterBlueprintList{blueprint}, nil
+	}
+	clusterWithStatus := func(clusterID string, status api.ClusterStatus) *api.Cluster {
+		cluster := *blueprintCluster
+		cluster.ClusterID = clusterID
+		cluster.Status = status
+		return &cluster
+	}
+	clusterVersion := func(version string) func(*api.Cluster) (*types.ClusterVersionInfo, *apiErrors.ServiceError) {
+		return func(cluster *api.Cluster) (*types.ClusterVersionInfo, *apiErrors.ServiceError) {
+			return &types.ClusterVersionInfo{Version: version, AvailableUpgrades: []string{"4.11.22"}}, nil
+		}
+	}
+	existingMachinePool := func(machineType string, autoScaling types.MachinePoolAutoScaling) clusters.ProviderFactory {
+		return &clusters.ProviderFactoryMock{
+			GetProviderFunc: func(providerType api.ClusterProviderType) (clusters.Provider, error) {
+				return &clusters.ProviderMock{
+					GetMachinePoolFunc: func(clusterID, id string) (*types.MachinePoolInfo, error) {
+						return &types.MachinePoolInfo{ID: id, ClusterID: clusterID, InstanceSize: machineType, AutoScalingEnabled: true, AutoScaling: autoScaling}, nil
+					},
+					UpdateMachinePoolFunc: func(request *types.MachinePoolRequest) (*types.MachinePoolRequest, error) {
+						if request.ID != "kafka-standard" || request.AutoScaling.MinNodes != 3 || request.AutoScaling.MaxNodes != 6 {
+							return nil, fmt.Errorf("unexpected machinepool update %+v", request)
+						}
+						return request, nil
+					},
+				}, nil
+			},
+		}
+	}
+	planWithSteps := func(steps ...services.ClusterBlueprintPlanStep) func(*dbapi.ClusterBlueprint) (*services.ClusterBlueprintPlan, *apiErrors.ServiceError) {
+		return func(b *dbapi.ClusterBlueprint) (*services.ClusterBlueprintPlan, *apiErrors.ServiceError) {
+			return &services.ClusterBlueprintPlan{Blueprint: b, Steps: steps}, nil
+		}
+	}
+
+	type fields struct {
+		clusterBlueprintService services.ClusterBlueprintService
+		clusterService          services.ClusterService
+		providerFactory         clusters.ProviderFactory
+		clusterUpgradeService   services.ClusterUpgradeService
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr bool
+	}{
+		{
+			name: "should register a new cluster managed by the blueprint for a create step",
+			fields: fields{
+				clusterBlueprintService: &services.ClusterBlueprintServiceMock{
+					ListFunc: listBlueprints,
+					PlanFunc: planWithSteps(services.ClusterBlueprintPlanStep{Action: services.ClusterBlueprintPlanActionCreate}),
+				},
+				clusterService: &services.ClusterServiceMock{
+					RegisterClusterJobFunc: func(cluster *api.Cluster) *apiErrors.ServiceError {
+						if cluster.ClusterBlueprintID != blueprint.ID || cluster.SupportedInstanceType != "standard" || !cluster.MultiAZ ||
+							cluster.Region != "us-east-1" || string(cluster.ProviderSpec) != `{"openshift_version":"openshift-v4.11.22","machine_pools":[{"instance_type":"standard","machine_type":"m5.2xlarge","min_compute_nodes":3,"max_compute_nodes":6}]}` {
+							return apiErrors.GeneralError("unexpected cluster registered: %+v", cluster)
+						}
+						return nil
+					},
+				},
+			},
+		},
+		{
+			name: "should update the machine pools and save the cluster for an update step of a ready cluster",
+			fields: fields{
+				clusterBlueprintService: &services.ClusterBlueprintServiceMock{
+					ListFunc: listBlueprints,
+					PlanFunc: planWithSteps(services.ClusterBlueprintPlanStep{Action: services.ClusterBlueprintPlanActionUpdate, Cluster: blueprintCluster}),
+				},
+				clusterService: &services.ClusterServiceMock{
+					UpdateFunc: func(cluster api.Cluster) *apiErrors.ServiceError {
+						if cluster.SupportedInstanceType != "standard" {
+							return apiErrors.GeneralError("unexpected supported instance type %q", cluster.SupportedInstanceType)
+						}
+						return nil
+					},
+				},
+				clusterUpgradeService: &services.ClusterUpgradeServiceMock{
+					GetClusterVersionFunc: clusterVersion("4.11.22"),
+				},
+				providerFactory: existingMachinePool("m5.2xlarge", types.MachinePoolAutoScaling{MinNodes: 3, MaxNodes: 3}),
+			},
+		},
+		{
+			name: "should schedule the upgrade to the blueprint's OpenShift version and keep the update pending",
+			fields: fields{
+				clusterBlueprintService: &services.ClusterBlueprintServiceMock{
+					ListFunc: listBlueprints,
+					PlanFunc: planWithSteps(services.ClusterBlueprintPlanStep{Action: services.ClusterBlueprintPlanActionUpdate, Cluster: blueprintCluster}),
+				},
+				// the cluster is not saved
+				clusterService: &services.ClusterServiceMock{},
+				clusterUpgradeService: &services.ClusterUpgradeServiceMock{
+					GetClusterVersionFunc: clusterVersion("4.11.20"),
+					ScheduleUpgradeFunc: func(cluster *api.Cluster, version string, scheduledAt time.Time) *apiErrors.ServiceError {
+						if version != "4.11.22" {
+							return apiErrors.GeneralError("unexpected upgrade version %q", version)
+						}
+						return nil
+					},
+				},
+			},
+		},
+		{
+			name: "should keep the update pending while the cluster is being upgraded",
+			fields: fields{
+				clusterBlueprintService: &services.ClusterBlueprintServiceMock{
+					ListFunc: listBlueprints,
+					PlanFunc: planWithSteps(services.ClusterBlueprintPlanStep{Action: services.ClusterBlueprintPlanActionUpdate, Cluster: func() *api.Cluster {
+						cluster := clusterWithStatus("cluster-id", api.ClusterReady)
+						cluster.UpgradeStatus = api.ClusterUpgradeInProgress
+						cluster.UpgradeVersion = "4.11.22"
+						return cluster
+					}()}),
+				},
+				clusterService: &services.ClusterServiceMock{},
+				clusterUpgradeService: &services.ClusterUpgradeServiceMock{
+					GetClusterVersionFunc: clusterVersion("4.11.20"),
+				},
+			},
+		},
+		{
+			name: "should return an error if the machine type of an existing machine pool changes",
+			fields: fields{
+				clusterBlueprintService: &services.ClusterBlueprintServiceMock{
+					ListFunc: listBlueprints,
+					PlanFunc: planWithSteps(services.ClusterBlueprintPlanStep{Action: services.ClusterBlueprintPlanActionUpdate, Cluster: blueprintCluster}),
+				},
+				clusterService: &services.ClusterServiceMock{},
+				clusterUpgradeService: &services.ClusterUpgradeServiceMock{
+					GetClusterVersionFunc: clusterVersion("4.11.22"),
+				},
+				providerFactory: existingMachinePool("m5.xlarge", types.MachinePoolAutoScaling{MinNodes: 3, MaxNodes: 6}),
+			},
+			wantErr: true,
+		},
+		{
+			name: "should save the update of a cluster not created in its provider yet",
+			fields: fields{
+				clusterBlueprintService: &services.ClusterBlueprintServiceMock{
+					ListFunc: listBlueprints,
+					PlanFunc: planWithSteps(services.ClusterBlueprintPlanStep{Action: services.ClusterBlueprintPlanActionUpdate, Cluster: clusterWithStatus("", api.ClusterAccepted)}),
+				},
+				clusterService: &services.ClusterServiceMock{
+					UpdateFunc: func(cluster api.Cluster) *apiErrors.ServiceError {
+						return nil
+					},
+				},
+			},
+		},
+		{
+			name: "should keep the update of a provisioning cluster pending",
+			fields: fields{
+				clusterBlueprintService: &services.ClusterBlueprintServiceMock{
+					ListFunc: listBlueprints,
+					PlanFunc: planWithSteps(services.ClusterBlueprintPlanStep{Action: services.ClusterBlueprintPlanActionUpdate, Cluster: clusterWithStatus("cluster-id", api.ClusterProvisioning)}),
+				},
+				clusterService: &services.ClusterServiceMock{},
+			},
+		},
+		{
+			name: "should deprovision the cluster for a retire step",
+			fields: fields{
+				clusterBlueprintService: &services.ClusterBlueprintServiceMock{
+					ListFunc: listBlueprints,
+					PlanFunc: planWithSteps(services.ClusterBlueprintPlanStep{Action: services.ClusterBlueprintPlanActionRetire, Cluster: blueprintCluster}),
+				},
+				clusterService: &services.ClusterServiceMock{
+					UpdateStatusFunc: func(cluster api.Cluster, status api.ClusterStatus) error {
+						if status != api.ClusterDeprovisioning {
+							return fmt.Errorf("unexpected status %q", status)
+						}
+						return nil
+					},
+				},
+			},
+		},
+		{
+			name: "should skip blocked steps",
+			fields: fields{
+				clusterBlueprintService: &services.ClusterBlueprintServiceMock{
+					ListFunc: listBlueprints,
+					PlanFunc: planWithSteps(services.ClusterBlueprintPlanStep{Action: services.ClusterBlueprintPlanActionRetire, Cluster: blueprintCluster, Blocked: true}),
+				},
+				clusterService: &services.ClusterServiceMock{},
+			},
+		},
+		{
+			name: "should return an error if the blueprints cannot be listed",
+			fields: fields{
+				clusterBlueprintService: &services.ClusterBlueprintServiceMock{
+					ListFunc: func() (dbapi.ClusterBlueprintList, *apiErrors.ServiceError) {
+						return nil, apiErrors.GeneralError("failed to list cluster blueprints")
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error if a blueprint cannot be planned",
+			fields: fields{
+				clusterBlueprintService: &services.ClusterBlueprintServiceMock{
+					ListFunc: listBlueprints,
+					PlanFunc: func(b *dbapi.ClusterBlueprint) (*services.ClusterBlueprintPlan, *apiErrors.ServiceError) {
+						return nil, apiErrors.GeneralError("failed to plan cluster blueprint")
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error if a step fails",
+			fields: fields{
+				clusterBlueprintService: &services.ClusterBlueprintServiceMock{
+					ListFunc: listBlueprints,
+					PlanFunc: planWithSteps(services.ClusterBlueprintPlanStep{Action: services.ClusterBlueprintPlanActionCreate}),
+				},
+				clusterService: &services.ClusterServiceMock{
+					RegisterClusterJobFunc: func(cluster *api.Cluster) *apiErrors.ServiceError {
+						return apiErrors.GeneralError("failed to register cluster job")
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			dataplaneClusterConfig := config.NewDataplaneClusterConfig()
+			dataplaneClusterConfig.DynamicScalingConfig.ComputeMachinePerCloudProvider = map[cloudproviders.CloudProviderID]config.ComputeMachinesConfig{
+				cloudproviders.AWS: {},
+			}
+			c := &ClusterManager{
+				ClusterManagerOptions: ClusterManagerOptions{
+					ClusterBlueprintService: tt.fields.clusterBlueprintService,
+					ClusterService:          tt.fields.clusterService,
+					ProviderFactory:         tt.fields.providerFactory,
+					ClusterUpgradeService:   tt.fields.clusterUpgradeService,
+					DataplaneClusterConfig:  dataplaneClusterConfig,
+				},
+			}
+
+			g.Expect(len(c.reconcileClusterBlueprints()) > 0).To(gomega.Equal(tt.wantErr))
+		})
+	}
+}
+
 func TestClusterManager_reconcileClusterInstanceType(t *testing.T) {
 	type fields struct {
 		clusterService         services.ClusterService
@@ -3519,6 +3816,48 @@ func TestClusterManager_reconcileClusterMachinePool(t *testing.T) {
 			want:    false,
 			wantErr: true,
 		},
+		{
+			name: "should create the machinepools of a cluster managed by a cluster blueprint with the blueprint's machine type even if autoscaling is disabled",
+			fields: fields{
+				dataplaneClusterConfig: &config.DataplaneClusterConfig{
+					DataPlaneClusterScalingType: config.ManualScaling,
+					DynamicScalingConfig: config.DynamicScalingConfig{
+						ComputeMachinePerCloudProvider: map[cloudproviders.CloudProviderID]config.ComputeMachinesConfig{
+							cloudproviders.AWS: {},
+						},
+					},
+				},
+				providerFactory: &clusters.ProviderFactoryMock{
+					GetProviderFunc: func(providerType api.ClusterProviderType) (clusters.Provider, error) {
+						return &clusters.ProviderMock{
+							GetMachinePoolFunc: func(clusterID, id string) (*types.MachinePoolInfo, error) {
+								return nil, nil
+							},
+							CreateMachinePoolFunc: func(request *types.MachinePoolRequest) (*types.MachinePoolRequest, error) {
+								if request.InstanceSize != "blueprint-machine-type" || request.AutoScaling.MinNodes != 3 || request.AutoScaling.MaxNodes != 6 {
+									return nil, fmt.Errorf("unexpected machinepool request %+v", request)
+								}
+								return request, nil
+							},
+						}, nil
+					},
+				},
+				clusterService: &services.ClusterServiceMock{
+					UpdateFunc: func(cluster api.Cluster) *apiErrors.ServiceError {
+						return nil
+					},
+				},
+			},
+			arg: api.Cluster{
+				ClusterID:             "test-cluster-id",
+				SupportedInstanceType: "standard",
+				CloudProvider:         cloudproviders.AWS.String(),
+				ClusterBlueprintID:    "blueprint-id",
+				ProviderSpec:          api.JSON(`{"machine_pools":[{"instance_type":"standard","machine_type":"blueprint-machine-type","min_compute_nodes":3,"max_compute_nodes":6}]}`),
+			},
+			want:    true,
+			wantErr: false,
+		},
 		{
 			name: "should return an error if an error is returned when trying to create a machinepool",
 			fields: fields{
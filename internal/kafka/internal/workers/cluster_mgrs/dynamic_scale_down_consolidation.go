@@ -0,0 +1,493 @@
+package cluster_mgrs
+
+import (
+	"sort"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	fleeterrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+// consolidationPlanRejectionCooldown is the time during which a cluster whose consolidation plan has been rejected
+// is not proposed for consolidation again
+const consolidationPlanRejectionCooldown = 24 * time.Hour
+
+// kafkaStatusesToConsolidate are the statuses of the Kafka instances that have to be moved off a cluster to empty it.
+// Kafka instances being deprovisioned are not moved as they are going to be removed from the cluster anyway
+var kafkaStatusesToConsolidate = []constants.KafkaStatus{
+	constants.KafkaRequestStatusAccepted,
+	constants.KafkaRequestStatusPreparing,
+	constants.KafkaRequestStatusProvisioning,
+	constants.KafkaRequestStatusReady,
+	constants.KafkaRequestStatusSuspending,
+	constants.KafkaRequestStatusSuspended,
+	constants.KafkaRequestStatusResuming,
+}
+
+// processConsolidationReconcileEvent executes the approved consolidation plans and, when the consolidation is enabled,
+// proposes new plans for the underutilized clusters.
+// Approved plans are executed even when the consolidation is disabled, so that they still complete or expire and their
+// cluster is not kept out of the placement of new Kafka instances forever
+func (m *DynamicScaleDownManager) processConsolidationReconcileEvent() error {
+	var errList fleeterrors.ErrorList
+
+	plans, err := m.clusterConsolidationPlanService.List(
+		dbapi.ClusterConsolidationPlanProposed,
+		dbapi.ClusterConsolidationPlanApproved,
+		dbapi.ClusterConsolidationPlanRejected,
+	)
+	if err != nil {
+		errList.AddErrors(err)
+		return errList
+	}
+
+	consolidationEnabled := m.dataplaneClusterConfig.DynamicScalingConfig.IsDataplaneConsolidationEnabled()
+	var approvedPlans dbapi.ClusterConsolidationPlanList
+	for _, plan := range plans {
+		if plan.Status == dbapi.ClusterConsolidationPlanApproved {
+			approvedPlans = append(approvedPlans, plan)
+		}
+	}
+	if len(approvedPlans) == 0 && !consolidationEnabled {
+		return nil
+	}
+
+	kafkas, err := m.kafkaService.ListByStatus(kafkaStatusesToConsolidate...)
+	if err != nil {
+		errList.AddErrors(err)
+		return errList
+	}
+	kafkasPerCluster := map[string][]*dbapi.KafkaRequest{}
+	for _, kafka := range kafkas {
+		kafkasPerCluster[kafka.ClusterID] = append(kafkasPerCluster[kafka.ClusterID], kafka)
+	}
+
+	for _, plan := range approvedPlans {
+		if err := m.executeConsolidationPlan(plan, kafkasPerCluster[plan.ClusterID]); err != nil {
+			errList.AddErrors(err)
+		}
+	}
+
+	if consolidationEnabled {
+		if err := m.proposeConsolidationPlans(plans, kafkasPerCluster); err != nil {
+			errList.AddErrors(err)
+		}
+	}
+
+	if errList.IsEmpty() {
+		return nil
+	}
+
+	return errList
+}
+
+// executeConsolidationPlan moves the Kafka instances of an approved plan that are not yet provisioned in the data plane
+// onto their target cluster. A Kafka instance of the moves provisioned in the data plane since the plan was proposed can
+// no longer be moved: it is added to the retained Kafka instances of the plan, which stay on the cluster until they expire.
+// The plan is completed once the cluster is no longer ready, i.e. once it has been emptied and scaled down, and expires
+// when the cluster cannot be emptied within the drain timeout so that its capacity is used again
+func (m *DynamicScaleDownManager) executeConsolidationPlan(plan *dbapi.ClusterConsolidationPlan, kafkasOnCluster []*dbapi.KafkaRequest) error {
+	cluster, svcErr := m.clusterService.FindClusterByID(plan.ClusterID)
+	if svcErr != nil {
+		return errors.Wrapf(svcErr, "failed to find cluster %q of consolidation plan %q", plan.ClusterID, plan.ID)
+	}
+
+	if cluster == nil || cluster.Status != api.ClusterReady {
+		glog.Infof("cluster %q of consolidation plan %q is no longer ready. Marking the plan as completed", plan.ClusterID, plan.ID)
+		if _, err := m.clusterConsolidationPlanService.UpdateStatus(plan.ID, dbapi.ClusterConsolidationPlanCompleted); err != nil {
+			return errors.Wrapf(err, "failed to complete consolidation plan %q", plan.ID)
+		}
+		return nil
+	}
+
+	approvedAt := plan.UpdatedAt
+	if plan.ApprovedAt != nil {
+		approvedAt = *plan.ApprovedAt
+	}
+	drainTimeout := m.dataplaneClusterConfig.DynamicScalingConfig.ConsolidationDrainTimeout()
+	drainDeadline := approvedAt.Add(drainTimeout)
+	if time.Now().After(drainDeadline) {
+		glog.Infof("cluster %q of consolidation plan %q has not been emptied within %s, %d kafka instances are left. Marking the plan as expired",
+			plan.ClusterID, plan.ID, drainTimeout, len(kafkasOnCluster))
+		if _, err := m.clusterConsolidationPlanService.UpdateStatus(plan.ID, dbapi.ClusterConsolidationPlanExpired); err != nil {
+			return errors.Wrapf(err, "failed to expire consolidation plan %q", plan.ID)
+		}
+		return nil
+	}
+
+	// the cluster can't be emptied if a kafka that can't be moved stays on it past the drain deadline
+	for _, kafka := range kafkasOnCluster {
+		if !isKafkaRelocatable(kafka) && !isKafkaExpiringBefore(kafka, drainDeadline) {
+			glog.Infof("kafka %q on cluster %q of consolidation plan %q cannot be moved and does not expire before the drain deadline. Marking the plan as expired",
+				kafka.ID, plan.ClusterID, plan.ID)
+			if _, err := m.clusterConsolidationPlanService.UpdateStatus(plan.ID, dbapi.ClusterConsolidationPlanExpired); err != nil {
+				return errors.Wrapf(err, "failed to expire consolidation plan %q", plan.ID)
+			}
+			return nil
+		}
+	}
+
+	moves, err := plan.GetMoves()
+	if err != nil {
+		return errors.Wrapf(err, "failed to read the moves of consolidation plan %q", plan.ID)
+	}
+	retained, err := plan.GetRetainedKafkas()
+	if err != nil {
+		return errors.Wrapf(err, "failed to read the retained kafkas of consolidation plan %q", plan.ID)
+	}
+
+	var errList fleeterrors.ErrorList
+	retainedChanged := false
+	for _, move := range moves {
+		kafka := findKafkaByID(kafkasOnCluster, move.KafkaID)
+		if kafka == nil {
+			continue // the kafka has already been moved or is being deleted
+		}
+
+		if !isKafkaRelocatable(kafka) {
+			if !isKafkaRetained(retained, kafka.ID) {
+				glog.Warningf("kafka %q of consolidation plan %q has been provisioned on cluster %q since the plan was proposed and cannot be moved anymore. It stays on the cluster until it expires",
+					kafka.ID, plan.ID, plan.ClusterID)
+				retained = append(retained, dbapi.ClusterConsolidationRetainedKafka{
+					KafkaID:        kafka.ID,
+					InstanceType:   move.InstanceType,
+					StreamingUnits: move.StreamingUnits,
+				})
+				retainedChanged = true
+			}
+			continue
+		}
+
+		glog.Infof("moving kafka %q from cluster %q onto cluster %q as part of consolidation plan %q", kafka.ID, plan.ClusterID, move.TargetClusterID, plan.ID)
+		// placing the kafka back in the accepted status lets the accepted kafka manager pick the desired versions
+		// of the target cluster. The kafka is only moved if it hasn't progressed since it was read, otherwise it
+		// is evaluated again in the next reconcile
+		moved, err := m.kafkaService.MoveToCluster(kafka, move.TargetClusterID)
+		if err != nil {
+			errList.AddErrors(err)
+		} else if !moved {
+			glog.Infof("kafka %q of consolidation plan %q has changed since it was read and has not been moved", kafka.ID, plan.ID)
+		}
+	}
+
+	if retainedChanged {
+		if err := plan.SetRetainedKafkas(retained); err != nil {
+			errList.AddErrors(errors.Wrapf(err, "failed to set the retained kafkas of consolidation plan %q", plan.ID))
+		} else if err := m.clusterConsolidationPlanService.UpdateRetainedKafkas(plan); err != nil {
+			errList.AddErrors(err)
+		}
+	}
+
+	if errList.IsEmpty() {
+		return nil
+	}
+
+	return errList
+}
+
+// proposeConsolidationPlans proposes a consolidation plan for each underutilized cluster whose Kafka instances not yet
+// provisioned in the data plane can all be moved onto the other clusters of the same region without causing a scale up.
+// The Kafka instances already provisioned in the data plane cannot be moved and are reported as retained by the plan, so
+// a plan is only proposed when they all expire within the drain timeout.
+// Clusters with a pending or approved plan, or whose plan has been recently rejected, are not considered
+func (m *DynamicScaleDownManager) proposeConsolidationPlans(plans dbapi.ClusterConsolidationPlanList, kafkasPerCluster map[string][]*dbapi.KafkaRequest) error {
+	kafkaStreamingUnitCountPerClusterList, err := m.clusterService.FindStreamingUnitCountByClusterAndInstanceType()
+	if err != nil {
+		return err
+	}
+
+	skippedClusters := map[string]bool{}
+	drainedClusters := map[string]bool{}
+	for _, plan := range plans {
+		switch plan.Status {
+		case dbapi.ClusterConsolidationPlanProposed, dbapi.ClusterConsolidationPlanApproved:
+			skippedClusters[plan.ClusterID] = true
+			drainedClusters[plan.ClusterID] = true
+		case dbapi.ClusterConsolidationPlanRejected:
+			if time.Since(plan.UpdatedAt) < consolidationPlanRejectionCooldown {
+				skippedClusters[plan.ClusterID] = true
+			}
+		}
+	}
+
+	// work on a copy of the streaming unit counts where the clusters being drained no longer provide any capacity,
+	// so that the moves of each plan are accounted for when evaluating the next candidates
+	working := make(services.KafkaStreamingUnitCountPerClusterList, len(kafkaStreamingUnitCountPerClusterList))
+	copy(working, kafkaStreamingUnitCountPerClusterList)
+	markClustersAsDrained(working, drainedClusters)
+
+	drainDeadline := time.Now().Add(m.dataplaneClusterConfig.DynamicScalingConfig.ConsolidationDrainTimeout())
+	var errList fleeterrors.ErrorList
+	for _, candidate := range m.findConsolidationCandidates(working, skippedClusters) {
+		if skippedClusters[candidate.clusterID] {
+			continue // the cluster is receiving kafka instances of a previous candidate
+		}
+
+		glog.Infof("evaluating consolidation of cluster %q with a streaming units utilization of %d%%", candidate.clusterID, candidate.utilization)
+
+		simulated, moves, retained, err := m.planConsolidationMoves(working, candidate, kafkasPerCluster[candidate.clusterID], drainedClusters, drainDeadline)
+		if err != nil {
+			errList.AddErrors(err)
+			continue
+		}
+		if moves == nil {
+			glog.Infof("kafka instances of cluster %q cannot be moved onto the other clusters of region %q or do not expire within the drain timeout", candidate.clusterID, candidate.region)
+			continue
+		}
+
+		scaleUpNeeded, err := m.isScaleUpNeededAfterConsolidation(simulated, candidate)
+		if err != nil {
+			errList.AddErrors(err)
+			continue
+		}
+		if scaleUpNeeded {
+			glog.Infof("consolidating cluster %q would trigger a scale up. No consolidation plan is proposed", candidate.clusterID)
+			continue
+		}
+
+		plan := &dbapi.ClusterConsolidationPlan{
+			ClusterID:     candidate.clusterID,
+			CloudProvider: candidate.cloudProvider,
+			Region:        candidate.region,
+			Utilization:   candidate.utilization,
+		}
+		if err := plan.SetMoves(moves); err != nil {
+			errList.AddErrors(errors.Wrapf(err, "failed to set the moves of the consolidation plan of cluster %q", candidate.clusterID))
+			continue
+		}
+		if err := plan.SetRetainedKafkas(retained); err != nil {
+			errList.AddErrors(errors.Wrapf(err, "failed to set the retained kafkas of the consolidation plan of cluster %q", candidate.clusterID))
+			continue
+		}
+		if err := m.clusterConsolidationPlanService.Propose(plan); err != nil {
+			errList.AddErrors(err)
+			continue
+		}
+		glog.Infof("consolidation plan %q proposed for cluster %q with %d moves and %d retained kafka instances", plan.ID, candidate.clusterID, len(moves), len(retained))
+
+		working = simulated
+		drainedClusters[candidate.clusterID] = true
+		markClustersAsDrained(working, drainedClusters)
+		// the clusters receiving the kafka instances are no longer underutilized
+		for _, move := range moves {
+			skippedClusters[move.TargetClusterID] = true
+		}
+	}
+
+	if errList.IsEmpty() {
+		return nil
+	}
+
+	return errList
+}
+
+// consolidationCandidate is an underutilized cluster whose Kafka instances could be moved onto other clusters
+type consolidationCandidate struct {
+	clusterID     string
+	cloudProvider string
+	region        string
+	utilization   int
+}
+
+// findConsolidationCandidates returns the ready managed clusters, not managed by a cluster blueprint, which are not empty and whose streaming units utilization
+// is lower or equal to the configured threshold, the least utilized first
+func (m *DynamicScaleDownManager) findConsolidationCandidates(kafkaStreamingUnitCountPerClusterList services.KafkaStreamingUnitCountPerClusterList, skippedClusters map[string]bool) []consolidationCandidate {
+	type usage struct {
+		candidate consolidationCandidate
+		count     int
+		maxUnits  int
+	}
+
+	usages := map[string]*usage{}
+	var clusterIDs []string
+	for _, suCount := range kafkaStreamingUnitCountPerClusterList {
+		if suCount.Status != api.ClusterReady.String() || suCount.ClusterType != api.ManagedDataPlaneClusterType.String() ||
+			suCount.ClusterBlueprintID != "" || skippedClusters[suCount.ClusterId] {
+			continue
+		}
+
+		u, ok := usages[suCount.ClusterId]
+		if !ok {
+			u = &usage{candidate: consolidationCandidate{
+				clusterID:     suCount.ClusterId,
+				cloudProvider: suCount.CloudProvider,
+				region:        suCount.Region,
+			}}
+			usages[suCount.ClusterId] = u
+			clusterIDs = append(clusterIDs, suCount.ClusterId)
+		}
+		u.count += int(suCount.Count)
+		u.maxUnits += int(suCount.MaxUnits)
+	}
+
+	maxUtilization := m.dataplaneClusterConfig.DynamicScalingConfig.ConsolidationMaxUtilizationPercentage
+	var candidates []consolidationCandidate
+	for _, clusterID := range clusterIDs {
+		u := usages[clusterID]
+		if u.count == 0 || u.maxUnits == 0 {
+			continue // empty clusters are handled by the scale down evaluation
+		}
+		u.candidate.utilization = u.count * 100 / u.maxUnits
+		if u.candidate.utilization <= maxUtilization {
+			candidates = append(candidates, u.candidate)
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].utilization != candidates[j].utilization {
+			return candidates[i].utilization < candidates[j].utilization
+		}
+		return candidates[i].clusterID < candidates[j].clusterID
+	})
+
+	return candidates
+}
+
+// planConsolidationMoves plans the move of each Kafka instance of the candidate not yet provisioned in the data plane onto
+// the fullest cluster of the same region which has enough capacity left for it, biggest instances first. The Kafka
+// instances already provisioned in the data plane are returned as retained on the candidate.
+// It returns the streaming unit counts once the moves are performed, and nil moves when an instance cannot be moved or
+// when a retained instance does not expire before the drain deadline
+func (m *DynamicScaleDownManager) planConsolidationMoves(kafkaStreamingUnitCountPerClusterList services.KafkaStreamingUnitCountPerClusterList,
+	candidate consolidationCandidate, kafkas []*dbapi.KafkaRequest, drainedClusters map[string]bool, drainDeadline time.Time) (services.KafkaStreamingUnitCountPerClusterList, []dbapi.ClusterConsolidationMove, []dbapi.ClusterConsolidationRetainedKafka, error) {
+	simulated := make(services.KafkaStreamingUnitCountPerClusterList, len(kafkaStreamingUnitCountPerClusterList))
+	copy(simulated, kafkaStreamingUnitCountPerClusterList)
+
+	moves := make([]dbapi.ClusterConsolidationMove, 0, len(kafkas))
+	retained := []dbapi.ClusterConsolidationRetainedKafka{}
+	for _, kafka := range kafkas {
+		size, err := m.kafkaConfig.GetKafkaInstanceSize(kafka.InstanceType, kafka.SizeId)
+		if err != nil {
+			return nil, nil, nil, errors.Wrapf(err, "failed to get the size of kafka %q on cluster %q", kafka.ID, candidate.clusterID)
+		}
+		if !isKafkaRelocatable(kafka) {
+			if !isKafkaExpiringBefore(kafka, drainDeadline) {
+				return nil, nil, nil, nil
+			}
+			retained = append(retained, dbapi.ClusterConsolidationRetainedKafka{
+				KafkaID:        kafka.ID,
+				InstanceType:   kafka.InstanceType,
+				StreamingUnits: size.CapacityConsumed,
+			})
+			continue
+		}
+		moves = append(moves, dbapi.ClusterConsolidationMove{
+			KafkaID:        kafka.ID,
+			InstanceType:   kafka.InstanceType,
+			StreamingUnits: size.CapacityConsumed,
+		})
+	}
+
+	sort.SliceStable(moves, func(i, j int) bool {
+		if moves[i].StreamingUnits != moves[j].StreamingUnits {
+			return moves[i].StreamingUnits > moves[j].StreamingUnits
+		}
+		return moves[i].KafkaID < moves[j].KafkaID
+	})
+
+	for i := range moves {
+		target := -1
+		for j, suCount := range simulated {
+			if suCount.ClusterId == candidate.clusterID || drainedClusters[suCount.ClusterId] ||
+				suCount.Status != api.ClusterReady.String() || suCount.ClusterType != api.ManagedDataPlaneClusterType.String() ||
+				suCount.CloudProvider != candidate.cloudProvider || suCount.Region != candidate.region ||
+				suCount.InstanceType != moves[i].InstanceType || int(suCount.FreeStreamingUnits()) < moves[i].StreamingUnits {
+				continue
+			}
+			if target == -1 || suCount.Count > simulated[target].Count ||
+				(suCount.Count == simulated[target].Count && suCount.ClusterId < simulated[target].ClusterId) {
+				target = j
+			}
+		}
+		if target == -1 {
+			return nil, nil, nil, nil
+		}
+
+		simulated[target].Count += int32(moves[i].StreamingUnits)
+		moves[i].TargetClusterID = simulated[target].ClusterId
+	}
+
+	return simulated, moves, retained, nil
+}
+
+// isScaleUpNeededAfterConsolidation evaluates, with the same calculations as the scale down evaluation of empty clusters,
+// whether a scale up would be needed once the kafka instances of the candidate are moved and the candidate is removed
+func (m *DynamicScaleDownManager) isScaleUpNeededAfterConsolidation(simulated services.KafkaStreamingUnitCountPerClusterList, candidate consolidationCandidate) (bool, error) {
+	var indexes []int
+	for i, suCount := range simulated {
+		if suCount.ClusterId == candidate.clusterID {
+			indexes = append(indexes, i)
+		}
+	}
+	if len(indexes) == 0 {
+		return false, nil
+	}
+
+	regionsSupportedInstanceType := m.findRegionInstanceTypeConfiguration(simulated[indexes[0]])
+	if len(regionsSupportedInstanceType) == 0 {
+		return false, nil
+	}
+
+	processor := &standardDynamicScaleDownProcessor{
+		clusterID:                              candidate.clusterID,
+		regionsSupportedInstanceType:           regionsSupportedInstanceType,
+		kafkaStreamingUnitCountPerClusterList:  simulated,
+		indexesOfStreamingUnitForSameClusterID: indexes,
+		supportedKafkaInstanceTypesConfig:      &m.kafkaConfig.SupportedInstanceTypes.Configuration,
+		clusterService:                         m.clusterService,
+		dryRun:                                 true,
+	}
+
+	return processor.isScaleUpNeededAfterCandidateClusterRemoval(processor.createNewStreamingUnitPerClusterListAfterRemovalOfCandidateCluster())
+}
+
+// markClustersAsDrained marks the streaming unit counts of the given clusters as deprovisioning so that they are no
+// longer considered as capacity
+func markClustersAsDrained(kafkaStreamingUnitCountPerClusterList services.KafkaStreamingUnitCountPerClusterList, drainedClusters map[string]bool) {
+	for i := range kafkaStreamingUnitCountPerClusterList {
+		if drainedClusters[kafkaStreamingUnitCountPerClusterList[i].ClusterId] {
+			kafkaStreamingUnitCountPerClusterList[i].Status = api.ClusterDeprovisioning.String()
+		}
+	}
+}
+
+// isKafkaRelocatable returns whether the kafka has not been provisioned in the data plane yet, and can therefore be
+// moved onto another cluster without losing any data
+func isKafkaRelocatable(kafka *dbapi.KafkaRequest) bool {
+	switch kafka.Status {
+	case constants.KafkaRequestStatusAccepted.String():
+		return true
+	case constants.KafkaRequestStatusPreparing.String():
+		return kafka.BootstrapServerHost == ""
+	default:
+		return false
+	}
+}
+
+// isKafkaExpiringBefore returns whether the kafka is going to be removed from its cluster by its expiration before the deadline
+func isKafkaExpiringBefore(kafka *dbapi.KafkaRequest, deadline time.Time) bool {
+	return kafka.ExpiresAt.Valid && !kafka.ExpiresAt.Time.After(deadline)
+}
+
+func isKafkaRetained(retained []dbapi.ClusterConsolidationRetainedKafka, id string) bool {
+	for _, r := range retained {
+		if r.KafkaID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func findKafkaByID(kafkas []*dbapi.KafkaRequest, id string) *dbapi.KafkaRequest {
+	for _, kafka := range kafkas {
+		if kafka.ID == id {
+			return kafka
+		}
+	}
+	return nil
+}
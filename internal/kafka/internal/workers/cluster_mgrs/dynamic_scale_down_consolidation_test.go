@@ -0,0 +1,349 @@
+package cluster_mgrs
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	apiErrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+)
+
+func buildConsolidationKafkaConfig() *config.KafkaConfig {
+	return &config.KafkaConfig{
+		SupportedInstanceTypes: &config.KafkaSupportedInstanceTypesConfig{
+			Configuration: config.SupportedKafkaInstanceTypesConfig{
+				SupportedKafkaInstanceTypes: []config.KafkaInstanceType{
+					{
+						Id: api.StandardTypeSupport.String(),
+						Sizes: []config.KafkaInstanceSize{
+							{Id: "x1", CapacityConsumed: 1},
+							{Id: "x2", CapacityConsumed: 2},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildConsolidationStreamingUnitCount(clusterID string, count, maxUnits int32) services.KafkaStreamingUnitCountPerCluster {
+	return services.KafkaStreamingUnitCountPerCluster{
+		ClusterId:     clusterID,
+		CloudProvider: "aws",
+		Region:        "us-east-1",
+		InstanceType:  api.StandardTypeSupport.String(),
+		ClusterType:   api.ManagedDataPlaneClusterType.String(),
+		Status:        api.ClusterReady.String(),
+		Count:         count,
+		MaxUnits:      maxUnits,
+	}
+}
+
+func Test_DynamicScaleDownManager_proposeConsolidationPlans(t *testing.T) {
+	expiringSoon := sql.NullTime{Time: time.Now().Add(48 * time.Hour), Valid: true}
+	kafkasPerCluster := map[string][]*dbapi.KafkaRequest{
+		"underutilized": {
+			{Meta: api.Meta{ID: "kafka-1"}, ClusterID: "underutilized", InstanceType: api.StandardTypeSupport.String(), SizeId: "x2", Status: constants.KafkaRequestStatusAccepted.String()},
+			{Meta: api.Meta{ID: "kafka-2"}, ClusterID: "underutilized", InstanceType: api.StandardTypeSupport.String(), SizeId: "x1", Status: constants.KafkaRequestStatusReady.String(), ExpiresAt: expiringSoon},
+		},
+	}
+
+	tests := []struct {
+		name                  string
+		kafkasPerCluster      map[string][]*dbapi.KafkaRequest
+		streamingUnitCounts   services.KafkaStreamingUnitCountPerClusterList
+		plans                 dbapi.ClusterConsolidationPlanList
+		clusterProviderConfig config.ProviderList
+		wantMoves             []dbapi.ClusterConsolidationMove
+		wantRetained          []dbapi.ClusterConsolidationRetainedKafka
+	}{
+		{
+			name: "should propose to move the kafka instances of an underutilized cluster onto the fullest cluster with capacity",
+			streamingUnitCounts: services.KafkaStreamingUnitCountPerClusterList{
+				buildConsolidationStreamingUnitCount("underutilized", 2, 10),
+				buildConsolidationStreamingUnitCount("half-full", 5, 10),
+				buildConsolidationStreamingUnitCount("almost-full", 9, 10),
+			},
+			wantMoves: []dbapi.ClusterConsolidationMove{
+				{KafkaID: "kafka-1", InstanceType: api.StandardTypeSupport.String(), StreamingUnits: 2, TargetClusterID: "half-full"},
+			},
+			wantRetained: []dbapi.ClusterConsolidationRetainedKafka{
+				{KafkaID: "kafka-2", InstanceType: api.StandardTypeSupport.String(), StreamingUnits: 1},
+			},
+		},
+		{
+			name: "should not propose a plan when a provisioned kafka instance does not expire within the drain timeout",
+			kafkasPerCluster: map[string][]*dbapi.KafkaRequest{
+				"underutilized": {
+					{Meta: api.Meta{ID: "kafka-1"}, ClusterID: "underutilized", InstanceType: api.StandardTypeSupport.String(), SizeId: "x2", Status: constants.KafkaRequestStatusAccepted.String()},
+					{Meta: api.Meta{ID: "kafka-2"}, ClusterID: "underutilized", InstanceType: api.StandardTypeSupport.String(), SizeId: "x1", Status: constants.KafkaRequestStatusReady.String()},
+				},
+			},
+			streamingUnitCounts: services.KafkaStreamingUnitCountPerClusterList{
+				buildConsolidationStreamingUnitCount("underutilized", 2, 10),
+				buildConsolidationStreamingUnitCount("half-full", 5, 10),
+			},
+		},
+		{
+			name: "should not propose a plan when the kafka instances do not fit in the other clusters of the region",
+			streamingUnitCounts: services.KafkaStreamingUnitCountPerClusterList{
+				buildConsolidationStreamingUnitCount("underutilized", 2, 10),
+				buildConsolidationStreamingUnitCount("almost-full", 9, 10),
+			},
+		},
+		{
+			name: "should not propose a plan when the cluster already has a pending plan",
+			streamingUnitCounts: services.KafkaStreamingUnitCountPerClusterList{
+				buildConsolidationStreamingUnitCount("underutilized", 2, 10),
+				buildConsolidationStreamingUnitCount("half-full", 5, 10),
+			},
+			plans: dbapi.ClusterConsolidationPlanList{
+				{ClusterID: "underutilized", Status: dbapi.ClusterConsolidationPlanProposed},
+			},
+		},
+		{
+			name: "should not propose a plan when the plan of the cluster has been recently rejected",
+			streamingUnitCounts: services.KafkaStreamingUnitCountPerClusterList{
+				buildConsolidationStreamingUnitCount("underutilized", 2, 10),
+				buildConsolidationStreamingUnitCount("half-full", 5, 10),
+			},
+			plans: dbapi.ClusterConsolidationPlanList{
+				{Meta: api.Meta{UpdatedAt: time.Now()}, ClusterID: "underutilized", Status: dbapi.ClusterConsolidationPlanRejected},
+			},
+		},
+		{
+			name: "should not propose a plan when the consolidation would trigger a scale up",
+			streamingUnitCounts: services.KafkaStreamingUnitCountPerClusterList{
+				buildConsolidationStreamingUnitCount("underutilized", 2, 10),
+				buildConsolidationStreamingUnitCount("half-full", 5, 10),
+			},
+			clusterProviderConfig: config.ProviderList{
+				{
+					Name: "aws",
+					Regions: config.RegionList{
+						{
+							Name: "us-east-1",
+							SupportedInstanceTypes: config.InstanceTypeMap{
+								api.StandardTypeSupport.String(): {MinAvailableCapacitySlackStreamingUnits: 5},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			t.Parallel()
+			planService := &services.ClusterConsolidationPlanServiceMock{
+				ProposeFunc: func(plan *dbapi.ClusterConsolidationPlan) *apiErrors.ServiceError {
+					return nil
+				},
+			}
+			dataplaneClusterConfig := config.NewDataplaneClusterConfig()
+			dataplaneClusterConfig.DynamicScalingConfig.ConsolidationMaxUtilizationPercentage = 25
+			mgr := DynamicScaleDownManager{
+				dataplaneClusterConfig: dataplaneClusterConfig,
+				clusterProvidersConfig: &config.ProviderConfig{
+					ProvidersConfig: config.ProviderConfiguration{
+						SupportedProviders: tt.clusterProviderConfig,
+					},
+				},
+				kafkaConfig: buildConsolidationKafkaConfig(),
+				clusterService: &services.ClusterServiceMock{
+					FindStreamingUnitCountByClusterAndInstanceTypeFunc: func() (services.KafkaStreamingUnitCountPerClusterList, error) {
+						return tt.streamingUnitCounts, nil
+					},
+				},
+				clusterConsolidationPlanService: planService,
+			}
+
+			kafkas := kafkasPerCluster
+			if tt.kafkasPerCluster != nil {
+				kafkas = tt.kafkasPerCluster
+			}
+			err := mgr.proposeConsolidationPlans(tt.plans, kafkas)
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+
+			if tt.wantMoves == nil {
+				g.Expect(planService.ProposeCalls()).To(gomega.BeEmpty())
+				return
+			}
+			g.Expect(planService.ProposeCalls()).To(gomega.HaveLen(1))
+			plan := planService.ProposeCalls()[0].Plan
+			g.Expect(plan.ClusterID).To(gomega.Equal("underutilized"))
+			g.Expect(plan.Utilization).To(gomega.Equal(20))
+			moves, movesErr := plan.GetMoves()
+			g.Expect(movesErr).ToNot(gomega.HaveOccurred())
+			g.Expect(moves).To(gomega.Equal(tt.wantMoves))
+			retained, retainedErr := plan.GetRetainedKafkas()
+			g.Expect(retainedErr).ToNot(gomega.HaveOccurred())
+			g.Expect(retained).To(gomega.Equal(tt.wantRetained))
+		})
+	}
+}
+
+func Test_DynamicScaleDownManager_executeConsolidationPlan(t *testing.T) {
+	expiringSoon := sql.NullTime{Time: time.Now().Add(48 * time.Hour), Valid: true}
+	kafkas := []*dbapi.KafkaRequest{
+		{Meta: api.Meta{ID: "accepted"}, Status: constants.KafkaRequestStatusAccepted.String()},
+		{Meta: api.Meta{ID: "preparing"}, Status: constants.KafkaRequestStatusPreparing.String(), BootstrapServerHost: "host", ExpiresAt: expiringSoon},
+		{Meta: api.Meta{ID: "ready"}, Status: constants.KafkaRequestStatusReady.String(), ExpiresAt: expiringSoon},
+	}
+
+	recentlyApproved := time.Now().Add(-time.Hour)
+	longAgoApproved := time.Now().Add(-8 * 24 * time.Hour)
+
+	tests := []struct {
+		name          string
+		kafkas        []*dbapi.KafkaRequest
+		clusterStatus api.ClusterStatus
+		approvedAt    *time.Time
+		retained      []dbapi.ClusterConsolidationRetainedKafka
+		movedKafkas   map[string]bool
+		wantMoved     []string
+		wantStatus    dbapi.ClusterConsolidationPlanStatus
+		wantRetained  []string
+	}{
+		{
+			name:          "should only move the kafka instances not provisioned in the data plane yet and report the others as retained",
+			clusterStatus: api.ClusterReady,
+			approvedAt:    &recentlyApproved,
+			wantMoved:     []string{"accepted"},
+			wantRetained:  []string{"preparing", "ready"},
+		},
+		{
+			name:          "should not update the retained kafka instances when they are already reported",
+			clusterStatus: api.ClusterReady,
+			approvedAt:    &recentlyApproved,
+			retained: []dbapi.ClusterConsolidationRetainedKafka{
+				{KafkaID: "preparing"},
+				{KafkaID: "ready"},
+			},
+			wantMoved: []string{"accepted"},
+		},
+		{
+			name:          "should not report a kafka instance as moved when it has changed since it was read",
+			clusterStatus: api.ClusterReady,
+			approvedAt:    &recentlyApproved,
+			retained: []dbapi.ClusterConsolidationRetainedKafka{
+				{KafkaID: "preparing"},
+				{KafkaID: "ready"},
+			},
+			movedKafkas: map[string]bool{"accepted": false},
+			wantMoved:   []string{"accepted"},
+		},
+		{
+			name: "should expire the plan when a provisioned kafka instance does not expire before the drain deadline",
+			kafkas: []*dbapi.KafkaRequest{
+				{Meta: api.Meta{ID: "accepted"}, Status: constants.KafkaRequestStatusAccepted.String()},
+				{Meta: api.Meta{ID: "ready"}, Status: constants.KafkaRequestStatusReady.String()},
+			},
+			clusterStatus: api.ClusterReady,
+			approvedAt:    &recentlyApproved,
+			wantStatus:    dbapi.ClusterConsolidationPlanExpired,
+		},
+		{
+			name:          "should complete the plan once the cluster is no longer ready",
+			clusterStatus: api.ClusterDeprovisioning,
+			approvedAt:    &recentlyApproved,
+			wantStatus:    dbapi.ClusterConsolidationPlanCompleted,
+		},
+		{
+			name:          "should expire the plan when the cluster has not been emptied within the drain timeout",
+			clusterStatus: api.ClusterReady,
+			approvedAt:    &longAgoApproved,
+			wantStatus:    dbapi.ClusterConsolidationPlanExpired,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			t.Parallel()
+			plan := &dbapi.ClusterConsolidationPlan{
+				Meta:       api.Meta{ID: "plan-id"},
+				ClusterID:  "cluster-id",
+				Status:     dbapi.ClusterConsolidationPlanApproved,
+				ApprovedAt: tt.approvedAt,
+			}
+			g.Expect(plan.SetMoves([]dbapi.ClusterConsolidationMove{
+				{KafkaID: "accepted", TargetClusterID: "target"},
+				{KafkaID: "preparing", TargetClusterID: "target"},
+				{KafkaID: "ready", TargetClusterID: "target"},
+				{KafkaID: "deleted", TargetClusterID: "target"},
+			})).To(gomega.Succeed())
+			g.Expect(plan.SetRetainedKafkas(tt.retained)).To(gomega.Succeed())
+
+			kafkaService := &services.KafkaServiceMock{
+				MoveToClusterFunc: func(kafkaRequest *dbapi.KafkaRequest, clusterID string) (bool, *apiErrors.ServiceError) {
+					if moved, ok := tt.movedKafkas[kafkaRequest.ID]; ok {
+						return moved, nil
+					}
+					return true, nil
+				},
+			}
+			planService := &services.ClusterConsolidationPlanServiceMock{
+				UpdateStatusFunc: func(id string, status dbapi.ClusterConsolidationPlanStatus) (*dbapi.ClusterConsolidationPlan, *apiErrors.ServiceError) {
+					return plan, nil
+				},
+				UpdateRetainedKafkasFunc: func(plan *dbapi.ClusterConsolidationPlan) *apiErrors.ServiceError {
+					return nil
+				},
+			}
+			mgr := DynamicScaleDownManager{
+				dataplaneClusterConfig: config.NewDataplaneClusterConfig(),
+				clusterService: &services.ClusterServiceMock{
+					FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *apiErrors.ServiceError) {
+						return &api.Cluster{ClusterID: clusterID, Status: tt.clusterStatus}, nil
+					},
+				},
+				kafkaService:                    kafkaService,
+				clusterConsolidationPlanService: planService,
+			}
+
+			kafkasOnCluster := kafkas
+			if tt.kafkas != nil {
+				kafkasOnCluster = tt.kafkas
+			}
+			g.Expect(mgr.executeConsolidationPlan(plan, kafkasOnCluster)).To(gomega.Succeed())
+
+			var moved []string
+			for _, call := range kafkaService.MoveToClusterCalls() {
+				moved = append(moved, call.KafkaRequest.ID)
+				g.Expect(call.ClusterID).To(gomega.Equal("target"))
+			}
+			g.Expect(moved).To(gomega.Equal(tt.wantMoved))
+
+			if tt.wantStatus != "" {
+				g.Expect(planService.UpdateStatusCalls()).To(gomega.HaveLen(1))
+				g.Expect(planService.UpdateStatusCalls()[0].Status).To(gomega.Equal(tt.wantStatus))
+			} else {
+				g.Expect(planService.UpdateStatusCalls()).To(gomega.BeEmpty())
+			}
+
+			if tt.wantRetained == nil {
+				g.Expect(planService.UpdateRetainedKafkasCalls()).To(gomega.BeEmpty())
+				return
+			}
+			g.Expect(planService.UpdateRetainedKafkasCalls()).To(gomega.HaveLen(1))
+			retained, err := planService.UpdateRetainedKafkasCalls()[0].Plan.GetRetainedKafkas()
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			var retainedIDs []string
+			for _, r := range retained {
+				retainedIDs = append(retainedIDs, r.KafkaID)
+			}
+			g.Expect(retainedIDs).To(gomega.Equal(tt.wantRetained))
+		})
+	}
+}
@@ -23,6 +23,9 @@ type DynamicScaleDownManager struct {
 	clusterProvidersConfig *config.ProviderConfig
 	kafkaConfig            *config.KafkaConfig
 	clusterService         services.ClusterService
+
+	kafkaService                    services.KafkaService
+	clusterConsolidationPlanService services.ClusterConsolidationPlanService
 }
 
 var _ workers.Worker = &DynamicScaleDownManager{}
@@ -33,6 +36,8 @@ func NewDynamicScaleDownManager(
 	clusterProvidersConfig *config.ProviderConfig,
 	kafkaConfig *config.KafkaConfig,
 	clusterService services.ClusterService,
+	kafkaService services.KafkaService,
+	clusterConsolidationPlanService services.ClusterConsolidationPlanService,
 ) *DynamicScaleDownManager {
 
 	return &DynamicScaleDownManager{
@@ -46,6 +51,9 @@ func NewDynamicScaleDownManager(
 		clusterProvidersConfig: clusterProvidersConfig,
 		kafkaConfig:            kafkaConfig,
 		clusterService:         clusterService,
+
+		kafkaService:                    kafkaService,
+		clusterConsolidationPlanService: clusterConsolidationPlanService,
 	}
 }
 
@@ -71,6 +79,11 @@ func (m *DynamicScaleDownManager) Reconcile() []error {
 		errList.AddErrors(err)
 	}
 
+	glog.Infoln("running data plane clusters consolidation")
+	if err := m.processConsolidationReconcileEvent(); err != nil {
+		errList.AddErrors(err)
+	}
+
 	glog.Infoln("dynamic scale down reconcile event finished")
 	return errList.ToErrorSlice()
 }
@@ -258,6 +271,12 @@ func (p *standardDynamicScaleDownProcessor) ShouldScaleDown() (bool, error) {
 		return false, nil
 	}
 
+	// clusters managed by a cluster blueprint are only retired by their blueprint
+	if p.isManagedByClusterBlueprint() {
+		glog.Infof("cluster with cluster id %q is managed by a cluster blueprint. It is not going to be removed", p.clusterID)
+		return false, nil
+	}
+
 	// First let's check if the cluster is empty
 	if p.isClusterNotEmpty() {
 		return false, nil
@@ -290,6 +309,16 @@ func (p *standardDynamicScaleDownProcessor) isEnterpriseCluster() bool {
 	return p.kafkaStreamingUnitCountPerClusterList[index].ClusterType == api.EnterpriseDataPlaneClusterType.String()
 }
 
+// isManagedByClusterBlueprint checks if the cluster being processed is managed by a cluster blueprint
+func (p *standardDynamicScaleDownProcessor) isManagedByClusterBlueprint() bool {
+	if arrays.IsEmpty(p.indexesOfStreamingUnitForSameClusterID) { // should never happen
+		return false
+	}
+
+	index := p.indexesOfStreamingUnitForSameClusterID[0]
+	return p.kafkaStreamingUnitCountPerClusterList[index].ClusterBlueprintID != ""
+}
+
 // isClusterNotEmpty checks whether the cluster is not empty.
 // The method iterates through all occurrences of cluster_id
 func (p *standardDynamicScaleDownProcessor) isClusterNotEmpty() bool {
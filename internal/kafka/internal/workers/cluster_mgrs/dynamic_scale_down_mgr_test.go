@@ -4,9 +4,11 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	apiErrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/onsi/gomega"
 )
 
@@ -103,6 +105,25 @@ func Test_standardDynamicScaleDownProcessor_ShouldScaleDown(t *testing.T) {
 			wantErr: false,
 			want:    false,
 		},
+		{
+			name: "should not scale down if the cluster is managed by a cluster blueprint",
+			fields: fields{
+				standardDynamicScaleDownProcessor: &standardDynamicScaleDownProcessor{
+					regionsSupportedInstanceType: config.InstanceTypeMap{}, // an empty supported instance type
+					kafkaStreamingUnitCountPerClusterList: services.KafkaStreamingUnitCountPerClusterList{
+						services.KafkaStreamingUnitCountPerCluster{
+							Status:             api.ClusterReady.String(),
+							Count:              0,
+							ClusterType:        api.ManagedDataPlaneClusterType.String(),
+							ClusterBlueprintID: "blueprint-id",
+						},
+					},
+					indexesOfStreamingUnitForSameClusterID: []int{0},
+				},
+			},
+			wantErr: false,
+			want:    false,
+		},
 		{
 			name: "should scale down if all streaming unit count are zero for the given cluster indexes and the instance type is not part of supported instance types in the region",
 			fields: fields{
@@ -1241,6 +1262,11 @@ func Test_DynamicScaleDownManager_Reconcile(t *testing.T) {
 				clusterProvidersConfig: tt.fields.clusterProvidersConfig,
 				kafkaConfig:            tt.fields.kafkaConfig,
 				clusterService:         tt.fields.clusterService,
+				clusterConsolidationPlanService: &services.ClusterConsolidationPlanServiceMock{
+					ListFunc: func(statuses ...dbapi.ClusterConsolidationPlanStatus) (dbapi.ClusterConsolidationPlanList, *apiErrors.ServiceError) {
+						return nil, nil
+					},
+				},
 			}
 
 			errs := mgr.Reconcile()
@@ -1,6 +1,7 @@
 package cluster_mgrs
 
 import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
 	fleeterrors "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
@@ -23,7 +24,9 @@ type DynamicScaleUpManager struct {
 	ClusterProvidersConfig *config.ProviderConfig
 	KafkaConfig            *config.KafkaConfig
 
-	ClusterService services.ClusterService
+	ClusterService                  services.ClusterService
+	CapacityForecastService         services.CapacityForecastService
+	ClusterConsolidationPlanService services.ClusterConsolidationPlanService
 }
 
 var _ workers.Worker = &DynamicScaleUpManager{}
@@ -34,6 +37,8 @@ func NewDynamicScaleUpManager(
 	clusterProvidersConfig *config.ProviderConfig,
 	kafkaConfig *config.KafkaConfig,
 	clusterService services.ClusterService,
+	capacityForecastService services.CapacityForecastService,
+	clusterConsolidationPlanService services.ClusterConsolidationPlanService,
 ) *DynamicScaleUpManager {
 
 	return &DynamicScaleUpManager{
@@ -47,7 +52,9 @@ func NewDynamicScaleUpManager(
 		ClusterProvidersConfig: clusterProvidersConfig,
 		KafkaConfig:            kafkaConfig,
 
-		ClusterService: clusterService,
+		ClusterService:                  clusterService,
+		CapacityForecastService:         capacityForecastService,
+		ClusterConsolidationPlanService: clusterConsolidationPlanService,
 	}
 }
 
@@ -87,6 +94,28 @@ func (m *DynamicScaleUpManager) processDynamicScaleUpReconcileEvent() error {
 		return errList
 	}
 
+	var streamingUnitsForecasts services.StreamingUnitsForecastList
+	dynamicScalingConfig := &m.DataplaneClusterConfig.DynamicScalingConfig
+	if dynamicScalingConfig.IsPredictiveScaleUpEnabled() {
+		forecasts, forecastErr := m.CapacityForecastService.ForecastStreamingUnits(dynamicScalingConfig.PredictiveScaleUpLookback(), dynamicScalingConfig.PredictiveScaleUpHorizon())
+		if forecastErr != nil {
+			// scaling up on the current capacity alone is still better than not scaling up at all
+			errList.AddErrors(forecastErr)
+		}
+		streamingUnitsForecasts = forecasts
+	}
+
+	// the clusters being drained by an approved consolidation plan no longer receive new kafka instances
+	drainedClusterIDs := map[string]bool{}
+	approvedPlans, planErr := m.ClusterConsolidationPlanService.List(dbapi.ClusterConsolidationPlanApproved)
+	if planErr != nil {
+		// scaling up without accounting for the clusters being drained is still better than not scaling up at all
+		errList.AddErrors(planErr)
+	}
+	for _, plan := range approvedPlans {
+		drainedClusterIDs[plan.ClusterID] = true
+	}
+
 	for _, provider := range m.ClusterProvidersConfig.ProvidersConfig.SupportedProviders {
 		for _, region := range provider.Regions {
 			for supportedInstanceTypeName := range region.SupportedInstanceTypes {
@@ -103,6 +132,8 @@ func (m *DynamicScaleUpManager) processDynamicScaleUpReconcileEvent() error {
 					kafkaStreamingUnitCountPerClusterList: kafkaStreamingUnitCountPerClusterList,
 					supportedKafkaInstanceTypesConfig:     &m.KafkaConfig.SupportedInstanceTypes.Configuration,
 					clusterService:                        m.ClusterService,
+					forecastedStreamingUnits:              streamingUnitsForecasts.Find(provider.Name, region.Name, supportedInstanceTypeName).ForecastedStreamingUnits,
+					drainedClusterIDs:                     drainedClusterIDs,
 					dryRun:                                !m.DataplaneClusterConfig.DynamicScalingConfig.IsDataplaneScaleUpTriggerEnabled(),
 				}
 				glog.Infof("evaluating dynamic scale up for locator '%+v'", currLocator)
@@ -193,6 +224,14 @@ type standardDynamicScaleUpProcessor struct {
 	kafkaStreamingUnitCountPerClusterList services.KafkaStreamingUnitCountPerClusterList
 	supportedKafkaInstanceTypesConfig     *config.SupportedKafkaInstanceTypesConfig
 	clusterService                        services.ClusterService
+	// forecastedStreamingUnits are the streaming units expected to be
+	// requested before a new cluster could be provisioned. They are
+	// deducted from the free capacity when evaluating the capacity slack.
+	forecastedStreamingUnits int
+	// drainedClusterIDs are the clusters being emptied by an approved
+	// consolidation plan. Their kafka instances still count towards the
+	// consumed capacity but they provide no free capacity.
+	drainedClusterIDs map[string]bool
 
 	// dryRun controls whether the ScaleUp method performs real actions.
 	// Useful when you don't want to trigger a real scale up.
@@ -216,9 +255,10 @@ var _ dynamicScaleUpProcessor = &standardDynamicScaleUpProcessor{}
 //     * No cluster in the provider's region has enough capacity to allocate
 //     the biggest instance size of the given instance type
 //     * The free capacity (in streaming units) for the given instance type in
-//     the provider's region is smaller or equal than the defined slack
-//     capacity (also in streaming units) of the given instance type. Free
-//     capacity is defined as max(total) capacity - consumed capacity.
+//     the provider's region, minus the forecasted streaming units, is
+//     smaller than the defined slack capacity (also in streaming units) of
+//     the given instance type. Free capacity is defined as max(total)
+//     capacity - consumed capacity.
 //     For the calculation of the max capacity:
 //     * Clusters in deprovisioning and cleanup state are excluded, as
 //     clusters into those states don't accept kafka instances anymore.
@@ -238,6 +278,7 @@ func (p *standardDynamicScaleUpProcessor) ShouldScaleUp() (bool, error) {
 		locator:                               p.locator,
 		kafkaStreamingUnitCountPerClusterList: p.kafkaStreamingUnitCountPerClusterList,
 		supportedKafkaInstanceTypesConfig:     p.supportedKafkaInstanceTypesConfig,
+		drainedClusterIDs:                     p.drainedClusterIDs,
 	}
 
 	instanceTypeConsumptionInRegionSummary, err := summaryCalculator.Calculate()
@@ -309,12 +350,12 @@ func (p *standardDynamicScaleUpProcessor) ScaleUp() error {
 }
 
 func (p *standardDynamicScaleUpProcessor) enoughCapacitySlackInRegion(summary instanceTypeConsumptionSummary) bool {
-	freeStreamingUnitsInRegion := summary.freeStreamingUnits
+	freeStreamingUnitsInRegion := summary.freeStreamingUnits - p.forecastedStreamingUnits
 	capacitySlackInRegion := p.instanceTypeConfig.MinAvailableCapacitySlackStreamingUnits
-	glog.V(10).Infof("configured minimum capacity slack for locator %+v is: '%v'", p.locator, capacitySlackInRegion)
+	glog.V(10).Infof("configured minimum capacity slack for locator %+v is: '%v', forecasted streaming units: '%v'", p.locator, capacitySlackInRegion, p.forecastedStreamingUnits)
 
-	// Note: if capacitySlackInRegion is 0 we always return that there is enough
-	// capacity slack in region.
+	// Note: if capacitySlackInRegion is 0 and no streaming units are forecasted
+	// we always return that there is enough capacity slack in region.
 	return freeStreamingUnitsInRegion >= capacitySlackInRegion
 }
 
@@ -367,6 +408,7 @@ type instanceTypeConsumptionSummaryCalculator struct {
 	locator                               supportedInstanceTypeLocator
 	kafkaStreamingUnitCountPerClusterList services.KafkaStreamingUnitCountPerClusterList
 	supportedKafkaInstanceTypesConfig     *config.SupportedKafkaInstanceTypesConfig
+	drainedClusterIDs                     map[string]bool
 }
 
 // Calculate returns a instanceTypeConsumptionSummary containing a consumption
@@ -380,6 +422,9 @@ type instanceTypeConsumptionSummaryCalculator struct {
 //   - Clusters that are still not ready to accept kafka instance but that
 //     should eventually accept them (like accepted state for example)
 //     are included
+//   - Clusters being drained by an approved consolidation plan only count
+//     their consumed streaming units, as they don't accept new kafka
+//     instances anymore
 //
 // For the calculation of whether a scale up actions is ongoing:
 //   - A scale up action is ongoing if there is at least one cluster in the
@@ -427,6 +472,12 @@ func (i *instanceTypeConsumptionSummaryCalculator) Calculate() (instanceTypeCons
 			continue
 		}
 
+		if i.drainedClusterIDs[kafkaStreamingUnitCountPerCluster.ClusterId] {
+			consumedStreamingUnitsInRegion = consumedStreamingUnitsInRegion + int(kafkaStreamingUnitCountPerCluster.Count)
+			maxStreamingUnitsInRegion = maxStreamingUnitsInRegion + int(kafkaStreamingUnitCountPerCluster.Count)
+			continue
+		}
+
 		if kafkaStreamingUnitCountPerCluster.FreeStreamingUnits() >= int32(biggestKafkaInstanceSizeCapacityConsumption) {
 			atLeastOneClusterHasCapacityForBiggestInstanceType = true
 		}
@@ -425,6 +425,40 @@ func Test_standardDynamicScaleUpProcessor_enoughCapacitySlackInRegion(t *testing
 			},
 			want: true,
 		},
+		{
+			name: "When the forecasted streaming units would consume the free streaming units below the defined slack capacity there is not enough capacity slack in the region",
+			fields: fields{
+				standardDynamicScaleUpProcessor: &standardDynamicScaleUpProcessor{
+					instanceTypeConfig: &config.InstanceTypeConfig{
+						MinAvailableCapacitySlackStreamingUnits: 3,
+					},
+					forecastedStreamingUnits: 4,
+				},
+			},
+			args: args{
+				summary: instanceTypeConsumptionSummary{
+					freeStreamingUnits: 5,
+				},
+			},
+			want: false,
+		},
+		{
+			name: "When the defined slack capacity is 0 but the forecasted streaming units exceed the free streaming units there is not enough capacity slack in the region",
+			fields: fields{
+				standardDynamicScaleUpProcessor: &standardDynamicScaleUpProcessor{
+					instanceTypeConfig: &config.InstanceTypeConfig{
+						MinAvailableCapacitySlackStreamingUnits: 0,
+					},
+					forecastedStreamingUnits: 2,
+				},
+			},
+			args: args{
+				summary: instanceTypeConsumptionSummary{
+					freeStreamingUnits: 1,
+				},
+			},
+			want: false,
+		},
 	}
 
 	for _, testcase := range tests {
@@ -913,6 +947,7 @@ func Test_instanceTypeConsumptionSummaryCalculator_Calculate(t *testing.T) {
 		locator                                      supportedInstanceTypeLocator
 		kafkaStreamingUnitCountPerClusterListFactory func() services.KafkaStreamingUnitCountPerClusterList
 		supportedKafkaInstanceTypesConfigFactory     func() *config.SupportedKafkaInstanceTypesConfig
+		drainedClusterIDs                            map[string]bool
 	}
 
 	tests := []struct {
@@ -1267,6 +1302,40 @@ func Test_instanceTypeConsumptionSummaryCalculator_Calculate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "When one of the clusters that match the locator is being drained only its consumed units are taken into account",
+			fields: fields{
+				locator: newTestHelperBaseSupportedInstanceTypeLocator(),
+				kafkaStreamingUnitCountPerClusterListFactory: func() services.KafkaStreamingUnitCountPerClusterList {
+					res := []services.KafkaStreamingUnitCountPerCluster(newTestHelperBaseKafkaStreamingUnitCountPerClusterList())
+					locator := newTestHelperBaseSupportedInstanceTypeLocator()
+					clusterBeingDrainedInfo := services.KafkaStreamingUnitCountPerCluster{
+						ClusterId:     "drained",
+						CloudProvider: locator.provider,
+						Region:        locator.region,
+						InstanceType:  locator.instanceTypeName,
+						Count:         1,
+						MaxUnits:      30,
+						Status:        api.ClusterReady.String(),
+						ClusterType:   locator.clusterType,
+					}
+					res = append(res, clusterBeingDrainedInfo)
+					return res
+				},
+				supportedKafkaInstanceTypesConfigFactory: func() *config.SupportedKafkaInstanceTypesConfig {
+					return newTestHelperBaseSupportedKafkaInstanceTypesConfig()
+				},
+				drainedClusterIDs: map[string]bool{"drained": true},
+			},
+			want: instanceTypeConsumptionSummary{
+				maxStreamingUnits:                    9,
+				freeStreamingUnits:                   3,
+				consumedStreamingUnits:               6,
+				ongoingScaleUpAction:                 false,
+				biggestInstanceSizeCapacityAvailable: true,
+			},
+			wantErr: false,
+		},
 		{
 			name: "When the provided locator's instance type is not found in the supported providers configuration an error is returned",
 			fields: fields{
@@ -1346,6 +1415,7 @@ func Test_instanceTypeConsumptionSummaryCalculator_Calculate(t *testing.T) {
 				locator:                               tt.fields.locator,
 				kafkaStreamingUnitCountPerClusterList: tt.fields.kafkaStreamingUnitCountPerClusterListFactory(),
 				supportedKafkaInstanceTypesConfig:     tt.fields.supportedKafkaInstanceTypesConfigFactory(),
+				drainedClusterIDs:                     tt.fields.drainedClusterIDs,
 			}
 			res, err := summaryCalculator.Calculate()
 			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
@@ -65,10 +65,15 @@ func (k *AcceptedKafkaManager) Reconcile() []error {
 		glog.Infof("accepted kafkas count = %d", len(acceptedKafkas))
 	}
 
+	queue := k.WorkQueue()
+	if err := queue.Refresh(); err != nil {
+		encounteredErrors = append(encounteredErrors, errors.Wrap(err, "failed to list failing accepted kafkas"))
+	}
+
 	for _, kafka := range acceptedKafkas {
 		glog.V(10).Infof("accepted kafka id = %s", kafka.ID)
 		metrics.UpdateKafkaRequestsStatusSinceCreatedMetric(constants.KafkaRequestStatusAccepted, kafka.ID, kafka.ClusterID, time.Since(kafka.CreatedAt))
-		if err := k.reconcileAcceptedKafka(kafka); err != nil {
+		if err := queue.Process(kafka.ID, func() error { return k.reconcileAcceptedKafka(kafka) }); err != nil {
 			encounteredErrors = append(encounteredErrors, errors.Wrapf(err, "failed to reconcile accepted kafka %s", kafka.ID))
 			continue
 		}
@@ -114,7 +114,7 @@ func TestAcceptedKafkaManager_Reconcile(t *testing.T) {
 			g := gomega.NewWithT(t)
 			k := NewAcceptedKafkaManager(
 				tt.fields.kafkaService,
-				services.NewClusterPlacementStrategy(tt.fields.clusterService, config.NewDataplaneClusterConfig(), &config.KafkaConfig{}),
+				services.NewClusterPlacementStrategy(tt.fields.clusterService, config.NewDataplaneClusterConfig(), &config.KafkaConfig{}, nil),
 				config.NewDataplaneClusterConfig(),
 				tt.fields.clusterService,
 				w.Reconciler{})
@@ -46,6 +46,11 @@ func (k *DeletingKafkaManager) Stop() {
 	k.StopWorker(k)
 }
 
+// SupportsSharding returns true as cleaning up the dependencies of a deleting kafka does not involve the other kafkas
+func (k *DeletingKafkaManager) SupportsSharding() bool {
+	return true
+}
+
 func (k *DeletingKafkaManager) Reconcile() []error {
 	glog.Infoln("reconciling deleting kafkas")
 	var encounteredErrors []error
@@ -55,7 +60,7 @@ func (k *DeletingKafkaManager) Reconcile() []error {
 	// from the data plane cluster by the KAS Fleetshard operator. This reconcile phase ensures that any other
 	// dependencies (i.e. SSO clients, CNAME records) are cleaned up for these Kafkas and their records soft deleted from the database.
 
-	deletingKafkas, serviceErr := k.kafkaService.ListByStatus(constants.KafkaRequestStatusDeleting)
+	deletingKafkas, serviceErr := k.kafkaService.ListShardByStatus(k.ShardScope("kafka_requests.id"), constants.KafkaRequestStatusDeleting)
 	originalTotalKafkaInDeleting := len(deletingKafkas)
 	if serviceErr != nil {
 		encounteredErrors = append(encounteredErrors, errors.Wrap(serviceErr, "failed to list deleting kafka requests"))
@@ -64,7 +69,7 @@ func (k *DeletingKafkaManager) Reconcile() []error {
 	}
 
 	// We also want to remove Kafkas that are set to deprovisioning but have not been provisioned on a data plane cluster.
-	deprovisioningKafkas, serviceErr := k.kafkaService.ListByStatus(constants.KafkaRequestStatusDeprovision)
+	deprovisioningKafkas, serviceErr := k.kafkaService.ListShardByStatus(k.ShardScope("kafka_requests.id"), constants.KafkaRequestStatusDeprovision)
 	if serviceErr != nil {
 		encounteredErrors = append(encounteredErrors, errors.Wrap(serviceErr, "failed to list kafka deprovisioning requests"))
 	} else {
@@ -81,9 +86,14 @@ func (k *DeletingKafkaManager) Reconcile() []error {
 
 	glog.Infof("An additional of kafkas count = %d which are marked for removal before being provisioned will also be deleted", len(deletingKafkas)-originalTotalKafkaInDeleting)
 
+	queue := k.WorkQueue()
+	if err := queue.Refresh(); err != nil {
+		encounteredErrors = append(encounteredErrors, errors.Wrap(err, "failed to list failing deleting kafka requests"))
+	}
+
 	for _, kafka := range deletingKafkas {
 		glog.V(10).Infof("deleting kafka id = %s", kafka.ID)
-		if err := k.reconcileDeletingKafkas(kafka); err != nil {
+		if err := queue.Process(kafka.ID, func() error { return k.reconcileDeletingKafkas(kafka) }); err != nil {
 			encounteredErrors = append(encounteredErrors, errors.Wrapf(err, "failed to reconcile deleting kafka request %s", kafka.ID))
 			continue
 		}
@@ -14,6 +14,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/keycloak"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	w "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"gorm.io/gorm"
 
 	"github.com/onsi/gomega"
 )
@@ -33,7 +34,7 @@ func TestDeletingKafkaManager_Reconcile(t *testing.T) {
 			name: "Should fail if listing kafkas in the reconciler fails",
 			fields: fields{
 				kafkaService: &services.KafkaServiceMock{
-					ListByStatusFunc: func(status ...constants.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+					ListShardByStatusFunc: func(shardScope func(*gorm.DB) *gorm.DB, status ...constants.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
 						return nil, errors.GeneralError("fail to list kafka requests")
 					},
 				},
@@ -44,7 +45,7 @@ func TestDeletingKafkaManager_Reconcile(t *testing.T) {
 			name: "Should not fail if listing kafkas returns an empty list",
 			fields: fields{
 				kafkaService: &services.KafkaServiceMock{
-					ListByStatusFunc: func(status ...constants.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+					ListShardByStatusFunc: func(shardScope func(*gorm.DB) *gorm.DB, status ...constants.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
 						return []*dbapi.KafkaRequest{}, nil
 					},
 				},
@@ -55,7 +56,7 @@ func TestDeletingKafkaManager_Reconcile(t *testing.T) {
 			name: "Should call reconcileDeletingKafkas and fail if an error is returned",
 			fields: fields{
 				kafkaService: &services.KafkaServiceMock{
-					ListByStatusFunc: func(status ...constants.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+					ListShardByStatusFunc: func(shardScope func(*gorm.DB) *gorm.DB, status ...constants.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
 						return []*dbapi.KafkaRequest{
 							mockKafkas.BuildKafkaRequest(
 								mockKafkas.WithPredefinedTestValues(),
@@ -82,7 +83,7 @@ func TestDeletingKafkaManager_Reconcile(t *testing.T) {
 			name: "Should call reconcileDeletingKafkas and not fail if no error is returned",
 			fields: fields{
 				kafkaService: &services.KafkaServiceMock{
-					ListByStatusFunc: func(status ...constants.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+					ListShardByStatusFunc: func(shardScope func(*gorm.DB) *gorm.DB, status ...constants.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
 						return []*dbapi.KafkaRequest{
 							mockKafkas.BuildKafkaRequest(
 								mockKafkas.WithPredefinedTestValues(),
@@ -0,0 +1,76 @@
+package kafka_mgrs
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// KafkaPrivateEndpointManager creates the private endpoint services of the Kafka instances requested with private
+// connectivity, keeps their allowed accounts in sync and deletes them once the Kafka instances are deprovisioned
+type KafkaPrivateEndpointManager struct {
+	workers.BaseWorker
+	privateEndpointService services.PrivateEndpointService
+	kafkaConfig            *config.KafkaConfig
+}
+
+var _ workers.Worker = &KafkaPrivateEndpointManager{}
+
+func NewKafkaPrivateEndpointManager(privateEndpointService services.PrivateEndpointService, kafkaConfig *config.KafkaConfig, reconciler workers.Reconciler) *KafkaPrivateEndpointManager {
+	return &KafkaPrivateEndpointManager{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: "kafka_private_endpoint",
+			Reconciler: reconciler,
+		},
+		privateEndpointService: privateEndpointService,
+		kafkaConfig:            kafkaConfig,
+	}
+}
+
+func (k *KafkaPrivateEndpointManager) Start() {
+	k.StartWorker(k)
+}
+
+func (k *KafkaPrivateEndpointManager) Stop() {
+	k.StopWorker(k)
+}
+
+func (k *KafkaPrivateEndpointManager) Reconcile() []error {
+	if !k.kafkaConfig.EnablePrivateConnectivity {
+		glog.Infoln("private connectivity is disabled, skipping reconciliation of private endpoints")
+		return nil
+	}
+
+	glog.Infoln("reconciling private endpoints of kafkas")
+	var errs []error
+
+	kafkas, listErr := k.privateEndpointService.ListKafkasWithPrivateEndpointInProgress()
+	if listErr != nil {
+		return []error{errors.Wrap(listErr, "failed to list kafkas whose private endpoint is in progress")}
+	}
+	glog.Infof("kafkas with private endpoint in progress count = %d", len(kafkas))
+
+	for _, kafka := range kafkas {
+		if err := k.privateEndpointService.ReconcilePrivateEndpoint(kafka); err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to reconcile the private endpoint of kafka %q", kafka.ID))
+		}
+	}
+
+	deprovisioningKafkas, listErr := k.privateEndpointService.ListDeprovisioningKafkasWithPrivateEndpoint()
+	if listErr != nil {
+		return append(errs, errors.Wrap(listErr, "failed to list deprovisioning kafkas with a private endpoint"))
+	}
+	glog.Infof("deprovisioning kafkas with private endpoint count = %d", len(deprovisioningKafkas))
+
+	for _, kafka := range deprovisioningKafkas {
+		if err := k.privateEndpointService.DeletePrivateEndpoint(kafka); err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to delete the private endpoint of kafka %q", kafka.ID))
+		}
+	}
+
+	return errs
+}
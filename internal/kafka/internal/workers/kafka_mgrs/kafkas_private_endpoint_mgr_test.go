@@ -0,0 +1,115 @@
+package kafka_mgrs
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	w "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/onsi/gomega"
+
+	mockKafkas "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/test/mocks/kafkas"
+)
+
+func TestKafkaPrivateEndpointManager_Reconcile(t *testing.T) {
+	privateKafkas := func() ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+		return []*dbapi.KafkaRequest{
+			mockKafkas.BuildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+				kafkaRequest.ID = "kafka-1"
+				kafkaRequest.PrivateConnectivity = true
+			}),
+			mockKafkas.BuildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+				kafkaRequest.ID = "kafka-2"
+				kafkaRequest.PrivateConnectivity = true
+			}),
+		}, nil
+	}
+
+	noDeprovisioningKafkas := func() ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+		return nil, nil
+	}
+
+	tests := []struct {
+		name                   string
+		privateEndpointService *services.PrivateEndpointServiceMock
+		kafkaConfig            *config.KafkaConfig
+		wantErrCount           int
+		wantReconcileCount     int
+		wantDeleteCount        int
+	}{
+		{
+			name: "should reconcile the private endpoints in progress",
+			privateEndpointService: &services.PrivateEndpointServiceMock{
+				ListKafkasWithPrivateEndpointInProgressFunc:     privateKafkas,
+				ListDeprovisioningKafkasWithPrivateEndpointFunc: noDeprovisioningKafkas,
+				ReconcilePrivateEndpointFunc: func(kafka *dbapi.KafkaRequest) *errors.ServiceError {
+					return nil
+				},
+			},
+			kafkaConfig:        &config.KafkaConfig{EnablePrivateConnectivity: true},
+			wantReconcileCount: 2,
+		},
+		{
+			name: "should keep reconciling the other private endpoints when one fails",
+			privateEndpointService: &services.PrivateEndpointServiceMock{
+				ListKafkasWithPrivateEndpointInProgressFunc:     privateKafkas,
+				ListDeprovisioningKafkasWithPrivateEndpointFunc: noDeprovisioningKafkas,
+				ReconcilePrivateEndpointFunc: func(kafka *dbapi.KafkaRequest) *errors.ServiceError {
+					if kafka.ID == "kafka-1" {
+						return errors.GeneralError("failed to create the private endpoint service")
+					}
+					return nil
+				},
+			},
+			kafkaConfig:        &config.KafkaConfig{EnablePrivateConnectivity: true},
+			wantErrCount:       1,
+			wantReconcileCount: 2,
+		},
+		{
+			name: "should return an error when the kafkas cannot be listed",
+			privateEndpointService: &services.PrivateEndpointServiceMock{
+				ListKafkasWithPrivateEndpointInProgressFunc: func() ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+					return nil, errors.GeneralError("failed to list kafkas")
+				},
+			},
+			kafkaConfig:  &config.KafkaConfig{EnablePrivateConnectivity: true},
+			wantErrCount: 1,
+		},
+		{
+			name: "should delete the private endpoints of the deprovisioning kafkas",
+			privateEndpointService: &services.PrivateEndpointServiceMock{
+				ListKafkasWithPrivateEndpointInProgressFunc: func() ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+					return nil, nil
+				},
+				ListDeprovisioningKafkasWithPrivateEndpointFunc: privateKafkas,
+				DeletePrivateEndpointFunc: func(kafka *dbapi.KafkaRequest) *errors.ServiceError {
+					if kafka.ID == "kafka-1" {
+						return errors.GeneralError("failed to delete the private endpoint service")
+					}
+					return nil
+				},
+			},
+			kafkaConfig:     &config.KafkaConfig{EnablePrivateConnectivity: true},
+			wantErrCount:    1,
+			wantDeleteCount: 2,
+		},
+		{
+			name:                   "should not do anything when private connectivity is disabled",
+			privateEndpointService: &services.PrivateEndpointServiceMock{},
+			kafkaConfig:            &config.KafkaConfig{},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			k := NewKafkaPrivateEndpointManager(tt.privateEndpointService, tt.kafkaConfig, w.Reconciler{})
+			g.Expect(k.Reconcile()).To(gomega.HaveLen(tt.wantErrCount))
+			g.Expect(tt.privateEndpointService.ReconcilePrivateEndpointCalls()).To(gomega.HaveLen(tt.wantReconcileCount))
+			g.Expect(tt.privateEndpointService.DeletePrivateEndpointCalls()).To(gomega.HaveLen(tt.wantDeleteCount))
+		})
+	}
+}
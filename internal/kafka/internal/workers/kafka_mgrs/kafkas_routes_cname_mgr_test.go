@@ -3,9 +3,9 @@ package kafka_mgrs
 import (
 	"testing"
 
-	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/dns"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	w "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
@@ -17,7 +17,7 @@ import (
 
 func TestKafkaRoutesCNAMEManager_Reconcile(t *testing.T) {
 	testChangeID := "1234"
-	testChangeINSYNC := route53.ChangeStatusInsync
+	testChangeINSYNC := dns.ChangeStatusInSync
 
 	type fields struct {
 		kafkaService services.KafkaService
@@ -39,12 +39,10 @@ func TestKafkaRoutesCNAMEManager_Reconcile(t *testing.T) {
 							}),
 						}, nil
 					},
-					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError) {
-						return &route53.ChangeResourceRecordSetsOutput{
-							ChangeInfo: &route53.ChangeInfo{
-								Id:     &testChangeID,
-								Status: &testChangeINSYNC,
-							},
+					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*dns.Change, *errors.ServiceError) {
+						return &dns.Change{
+							ID:     testChangeID,
+							Status: testChangeINSYNC,
 						}, nil
 					},
 					UpdateFunc: func(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
@@ -69,20 +67,18 @@ func TestKafkaRoutesCNAMEManager_Reconcile(t *testing.T) {
 							}),
 						}, nil
 					},
-					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError) {
-						return &route53.ChangeResourceRecordSetsOutput{
-							ChangeInfo: &route53.ChangeInfo{
-								Id:     &testChangeID,
-								Status: &testChangeINSYNC,
-							},
+					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*dns.Change, *errors.ServiceError) {
+						return &dns.Change{
+							ID:     testChangeID,
+							Status: testChangeINSYNC,
 						}, nil
 					},
 					UpdateFunc: func(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
 						return nil
 					},
-					GetCNAMERecordStatusFunc: func(kafkaRequest *dbapi.KafkaRequest) (*services.CNameRecordStatus, error) {
-						return &services.CNameRecordStatus{
-							Status: &testChangeINSYNC,
+					GetCNAMERecordStatusFunc: func(kafkaRequest *dbapi.KafkaRequest) (*dns.Change, error) {
+						return &dns.Change{
+							Status: testChangeINSYNC,
 						}, nil
 					},
 				},
@@ -104,18 +100,16 @@ func TestKafkaRoutesCNAMEManager_Reconcile(t *testing.T) {
 							}),
 						}, nil
 					},
-					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError) {
-						return &route53.ChangeResourceRecordSetsOutput{
-							ChangeInfo: &route53.ChangeInfo{
-								Id:     &testChangeID,
-								Status: &testChangeINSYNC,
-							},
+					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*dns.Change, *errors.ServiceError) {
+						return &dns.Change{
+							ID:     testChangeID,
+							Status: testChangeINSYNC,
 						}, nil
 					},
 					UpdateFunc: func(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
 						return nil
 					},
-					GetCNAMERecordStatusFunc: func(kafkaRequest *dbapi.KafkaRequest) (*services.CNameRecordStatus, error) {
+					GetCNAMERecordStatusFunc: func(kafkaRequest *dbapi.KafkaRequest) (*dns.Change, error) {
 						return nil, errors.GeneralError("failed to get cname record status")
 					},
 				},
@@ -157,12 +151,10 @@ func TestKafkaRoutesCNAMEManager_Reconcile(t *testing.T) {
 							}),
 						}, nil
 					},
-					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError) {
-						return &route53.ChangeResourceRecordSetsOutput{
-							ChangeInfo: &route53.ChangeInfo{
-								Id:     &testChangeID,
-								Status: &testChangeINSYNC,
-							},
+					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*dns.Change, *errors.ServiceError) {
+						return &dns.Change{
+							ID:     testChangeID,
+							Status: testChangeINSYNC,
 						}, nil
 					},
 					UpdateFunc: func(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
@@ -200,7 +192,7 @@ func TestKafkaRoutesCNAMEManager_Reconcile(t *testing.T) {
 							}),
 						}, nil
 					},
-					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError) {
+					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*dns.Change, *errors.ServiceError) {
 						return nil, errors.GeneralError("failed to create CNAME")
 					},
 				},
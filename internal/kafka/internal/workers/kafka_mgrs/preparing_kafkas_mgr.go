@@ -45,22 +45,32 @@ func (k *PreparingKafkaManager) Stop() {
 	k.StopWorker(k)
 }
 
+// SupportsSharding opts preparing kafkas into the sharded reconciliation, each of them is prepared independently
+func (k *PreparingKafkaManager) SupportsSharding() bool {
+	return true
+}
+
 func (k *PreparingKafkaManager) Reconcile() []error {
 	glog.Infoln("reconciling preparing kafkas")
 	var encounteredErrors []error
 
 	// handle preparing kafkas
-	preparingKafkas, serviceErr := k.kafkaService.ListByStatus(constants.KafkaRequestStatusPreparing)
+	preparingKafkas, serviceErr := k.kafkaService.ListShardByStatus(k.ShardScope("kafka_requests.id"), constants.KafkaRequestStatusPreparing)
 	if serviceErr != nil {
 		encounteredErrors = append(encounteredErrors, errors.Wrap(serviceErr, "failed to list preparing kafkas"))
 	} else {
 		glog.Infof("preparing kafkas count = %d", len(preparingKafkas))
 	}
 
+	queue := k.WorkQueue()
+	if err := queue.Refresh(); err != nil {
+		encounteredErrors = append(encounteredErrors, errors.Wrap(err, "failed to list failing preparing kafkas"))
+	}
+
 	for _, kafka := range preparingKafkas {
 		glog.V(10).Infof("preparing kafka id = %s", kafka.ID)
 		metrics.UpdateKafkaRequestsStatusSinceCreatedMetric(constants.KafkaRequestStatusPreparing, kafka.ID, kafka.ClusterID, time.Since(kafka.CreatedAt))
-		if err := k.reconcilePreparingKafka(kafka); err != nil {
+		if err := queue.Process(kafka.ID, func() error { return k.reconcilePreparingKafka(kafka) }); err != nil {
 			encounteredErrors = append(encounteredErrors, errors.Wrapf(err, "failed to reconcile preparing kafka %s", kafka.ID))
 			continue
 		}
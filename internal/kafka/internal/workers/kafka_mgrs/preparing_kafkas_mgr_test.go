@@ -13,6 +13,7 @@ import (
 	mockKafkas "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/test/mocks/kafkas"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	w "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"gorm.io/gorm"
 )
 
 func TestPreparingKafkaManager_Reconcile(t *testing.T) {
@@ -28,7 +29,7 @@ func TestPreparingKafkaManager_Reconcile(t *testing.T) {
 			name: "Should fail if listing kafkas in the reconciler fails",
 			fields: fields{
 				kafkaService: &services.KafkaServiceMock{
-					ListByStatusFunc: func(status ...constants.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+					ListShardByStatusFunc: func(shardScope func(*gorm.DB) *gorm.DB, status ...constants.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
 						return nil, errors.GeneralError("fail to list kafka requests")
 					},
 				},
@@ -39,7 +40,7 @@ func TestPreparingKafkaManager_Reconcile(t *testing.T) {
 			name: "Should not fail if listing kafkas returns an empty list",
 			fields: fields{
 				kafkaService: &services.KafkaServiceMock{
-					ListByStatusFunc: func(status ...constants.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+					ListShardByStatusFunc: func(shardScope func(*gorm.DB) *gorm.DB, status ...constants.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
 						return []*dbapi.KafkaRequest{}, nil
 					},
 				},
@@ -50,7 +51,7 @@ func TestPreparingKafkaManager_Reconcile(t *testing.T) {
 			name: "Should successfully call reconcilePreparingKafka and return no error",
 			fields: fields{
 				kafkaService: &services.KafkaServiceMock{
-					ListByStatusFunc: func(status ...constants.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+					ListShardByStatusFunc: func(shardScope func(*gorm.DB) *gorm.DB, status ...constants.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
 						return []*dbapi.KafkaRequest{
 							mockKafkas.BuildKafkaRequest(
 								mockKafkas.With(mockKafkas.STATUS, constants.KafkaRequestStatusPreparing.String()),
@@ -71,7 +72,7 @@ func TestPreparingKafkaManager_Reconcile(t *testing.T) {
 			name: "Should call reconcilePreparingKafka and fail if an error is returned",
 			fields: fields{
 				kafkaService: &services.KafkaServiceMock{
-					ListByStatusFunc: func(status ...constants.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+					ListShardByStatusFunc: func(shardScope func(*gorm.DB) *gorm.DB, status ...constants.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
 						return []*dbapi.KafkaRequest{
 							mockKafkas.BuildKafkaRequest(
 								mockKafkas.With(mockKafkas.STATUS, constants.KafkaRequestStatusPreparing.String()),
@@ -48,6 +48,11 @@ func (k *ReadyKafkaManager) Stop() {
 	k.StopWorker(k)
 }
 
+// SupportsSharding returns true, canary service accounts are reconciled per kafka
+func (k *ReadyKafkaManager) SupportsSharding() bool {
+	return true
+}
+
 func (k *ReadyKafkaManager) Reconcile() []error {
 	glog.Infoln("reconciling ready kafkas")
 	if !k.keycloakConfig.EnableAuthenticationOnKafka {
@@ -56,7 +61,7 @@ func (k *ReadyKafkaManager) Reconcile() []error {
 
 	var encounteredErrors []error
 
-	readyKafkas, serviceErr := k.kafkaService.ListByStatus(constants.KafkaRequestStatusReady)
+	readyKafkas, serviceErr := k.kafkaService.ListShardByStatus(k.ShardScope("kafka_requests.id"), constants.KafkaRequestStatusReady)
 	if serviceErr != nil {
 		encounteredErrors = append(encounteredErrors, errors.Wrap(serviceErr, "failed to list ready kafkas"))
 	} else {
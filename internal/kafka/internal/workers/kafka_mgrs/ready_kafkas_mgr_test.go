@@ -17,6 +17,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	w "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"gorm.io/gorm"
 )
 
 var (
@@ -48,7 +49,7 @@ func TestReadyKafkaManager_Reconcile(t *testing.T) {
 			name: "Should throw an error if listing kafkas fails",
 			fields: fields{
 				kafkaService: &services.KafkaServiceMock{
-					ListByStatusFunc: func(status ...constants.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+					ListShardByStatusFunc: func(shardScope func(*gorm.DB) *gorm.DB, status ...constants.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
 						return nil, errors.GeneralError("failed to list kafka requests")
 					},
 				},
@@ -60,7 +61,7 @@ func TestReadyKafkaManager_Reconcile(t *testing.T) {
 			name: "Should succeed if no kafkas are returned",
 			fields: fields{
 				kafkaService: &services.KafkaServiceMock{
-					ListByStatusFunc: func(status ...constants.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+					ListShardByStatusFunc: func(shardScope func(*gorm.DB) *gorm.DB, status ...constants.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
 						return []*dbapi.KafkaRequest{}, nil
 					},
 				},
@@ -72,7 +73,7 @@ func TestReadyKafkaManager_Reconcile(t *testing.T) {
 			name: "Should throw an error if reconciling canary service account fails",
 			fields: fields{
 				kafkaService: &services.KafkaServiceMock{
-					ListByStatusFunc: func(status ...constants.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+					ListShardByStatusFunc: func(shardScope func(*gorm.DB) *gorm.DB, status ...constants.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
 						return []*dbapi.KafkaRequest{
 							mockKafkas.BuildKafkaRequest(),
 						}, nil
@@ -97,7 +98,7 @@ func TestReadyKafkaManager_Reconcile(t *testing.T) {
 			name: "successfully reconciles ready kafkas",
 			fields: fields{
 				kafkaService: &services.KafkaServiceMock{
-					ListByStatusFunc: func(status ...constants.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+					ListShardByStatusFunc: func(shardScope func(*gorm.DB) *gorm.DB, status ...constants.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
 						return []*dbapi.KafkaRequest{
 							mockKafkas.BuildKafkaRequest(),
 						}, nil
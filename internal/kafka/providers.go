@@ -4,10 +4,12 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/acl"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/dns"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/environments"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/handlers"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/metrics"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/migrations"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/privateendpoint"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/routes"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services/kafkatlscertmgmt"
@@ -43,6 +45,7 @@ func ConfigProviders() di.Option {
 		// Configuration for the Kafka service...
 		di.Provide(config.NewAWSConfig, di.As(new(environments2.ConfigModule))),
 		di.Provide(config.NewGCPConfig, di.As(new(environments2.ConfigModule)), di.As(new(environments2.ServiceValidator))),
+		di.Provide(config.NewDNSConfig, di.As(new(environments2.ConfigModule)), di.As(new(environments2.ServiceValidator))),
 
 		di.Provide(config.NewSupportedProvidersConfig, di.As(new(environments2.ConfigModule)), di.As(new(environments2.ServiceValidator))),
 		di.Provide(observatoriumClient.NewObservabilityConfigurationConfig, di.As(new(environments2.ConfigModule)), di.As(new(environments2.ServiceValidator))),
@@ -69,10 +72,17 @@ func ServiceProviders() di.Option {
 		di.Provide(services.NewObservatoriumService),
 		di.Provide(services.NewKasFleetshardOperatorAddon),
 		di.Provide(services.NewClusterPlacementStrategy),
+		di.Provide(services.NewClusterConsolidationPlanService),
+		di.Provide(services.NewCapacityForecastService),
+		di.Provide(services.NewClusterBlueprintService),
+		di.Provide(services.NewClusterUpgradeService),
+		di.Provide(services.NewPrivateEndpointService),
 		di.Provide(services.NewDataPlaneClusterService, di.As(new(services.DataPlaneClusterService))),
 		di.Provide(services.NewDataPlaneKafkaService, di.As(new(services.DataPlaneKafkaService))),
 		di.Provide(handlers.NewAuthenticationBuilder),
 		di.Provide(clusters.NewDefaultProviderFactory, di.As(new(clusters.ProviderFactory))),
+		di.Provide(dns.NewDefaultProviderFactory, di.As(new(dns.ProviderFactory))),
+		di.Provide(privateendpoint.NewDefaultProviderFactory, di.As(new(privateendpoint.ProviderFactory))),
 		di.Provide(routes.NewRouteLoader),
 		di.Provide(quota.NewDefaultQuotaServiceFactory),
 		di.Provide(cluster_mgrs.NewClusterManager, di.As(new(workers.Worker))),
@@ -80,6 +90,7 @@ func ServiceProviders() di.Option {
 		di.Provide(cluster_mgrs.NewCleanupClustersManager, di.As(new(workers.Worker))),
 		di.Provide(cluster_mgrs.NewDeprovisioningClustersManager, di.As(new(workers.Worker))),
 		di.Provide(cluster_mgrs.NewDynamicScaleDownManager, di.As(new(workers.Worker))),
+		di.Provide(cluster_mgrs.NewClusterUpgradeManager, di.As(new(workers.Worker))),
 		di.Provide(kafka_mgrs.NewKafkaManager, di.As(new(workers.Worker))),
 		di.Provide(kafka_mgrs.NewAcceptedKafkaManager, di.As(new(workers.Worker))),
 		di.Provide(kafka_mgrs.NewPreparingKafkaManager, di.As(new(workers.Worker))),
@@ -87,6 +98,7 @@ func ServiceProviders() di.Option {
 		di.Provide(kafka_mgrs.NewProvisioningKafkaManager, di.As(new(workers.Worker))),
 		di.Provide(kafka_mgrs.NewReadyKafkaManager, di.As(new(workers.Worker))),
 		di.Provide(kafka_mgrs.NewKafkaCNAMEManager, di.As(new(workers.Worker))),
+		di.Provide(kafka_mgrs.NewKafkaPrivateEndpointManager, di.As(new(workers.Worker))),
 		di.Provide(promotion.NewPromotionKafkaManager, di.As(new(workers.Worker))),
 		di.Provide(kafka_mgrs.NewKafkasRoutesTLSCertificateManager, di.As(new(workers.Worker))),
 		di.Provide(acl.NewEnterpriseClustersAccessControlMiddleware),
@@ -75,7 +75,7 @@ func TestEnterpriseClustersList(t *testing.T) {
 	err = db.Create(otherOrgCluster).Error
 	g.Expect(err).NotTo(gomega.HaveOccurred())
 
-	clusterListNonAuth, resp, err := client.EnterpriseDataplaneClustersApi.GetEnterpriseOsdClusters(nonAuthCtx)
+	clusterListNonAuth, resp, err := client.EnterpriseDataplaneClustersApi.GetEnterpriseOsdClusters(nonAuthCtx, nil)
 	g.Expect(err).To(gomega.HaveOccurred())
 	g.Expect(len(clusterListNonAuth.Items)).To(gomega.Equal(0))
 	if resp != nil {
@@ -83,7 +83,7 @@ func TestEnterpriseClustersList(t *testing.T) {
 	}
 
 	// only return clusters belonging to the org of the user
-	clusterList, resp2, err := client.EnterpriseDataplaneClustersApi.GetEnterpriseOsdClusters(authCtx)
+	clusterList, resp2, err := client.EnterpriseDataplaneClustersApi.GetEnterpriseOsdClusters(authCtx, nil)
 	g.Expect(err).ToNot(gomega.HaveOccurred())
 	g.Expect(clusterList).ToNot((gomega.BeNil()))
 	g.Expect(len(clusterList.Items)).To(gomega.Equal(1))
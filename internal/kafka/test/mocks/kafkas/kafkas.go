@@ -165,6 +165,14 @@ func WithExpiresAt(expiresAt sql.NullTime) KafkaRequestBuildOption {
 	}
 }
 
+func WithPrivateEndpoint(status dbapi.PrivateEndpointStatus, allowedAccountIDs ...string) KafkaRequestBuildOption {
+	return func(request *dbapi.KafkaRequest) {
+		request.PrivateConnectivity = true
+		request.PrivateEndpointStatus = status
+		_ = request.SetPrivateEndpointAllowedAccounts(allowedAccountIDs)
+	}
+}
+
 func WithPredefinedTestValues() KafkaRequestBuildOption {
 	return func(request *dbapi.KafkaRequest) {
 		request.Meta = api.Meta{
@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
 
@@ -19,6 +20,7 @@ type ClusterStatus string
 type ClusterProviderType string
 type ClusterInstanceTypeSupport string
 type DataPlaneClusterType string
+type ClusterUpgradeStatus string
 
 func (k ClusterStatus) String() string {
 	return string(k)
@@ -131,6 +133,28 @@ func (t DataPlaneClusterType) String() string {
 	return string(t)
 }
 
+const (
+	// ClusterUpgradePending the upgrade is requested and waits for its scheduled time and pre-checks to pass
+	ClusterUpgradePending ClusterUpgradeStatus = "pending"
+	// ClusterUpgradeScheduled the upgrade is scheduled in the cluster provider
+	ClusterUpgradeScheduled ClusterUpgradeStatus = "scheduled"
+	// ClusterUpgradeInProgress the cluster provider is upgrading the cluster
+	ClusterUpgradeInProgress ClusterUpgradeStatus = "in_progress"
+	// ClusterUpgradeCompleted the cluster runs the requested OpenShift version
+	ClusterUpgradeCompleted ClusterUpgradeStatus = "completed"
+	// ClusterUpgradeFailed the cluster provider failed to upgrade the cluster
+	ClusterUpgradeFailed ClusterUpgradeStatus = "failed"
+	// ClusterUpgradeCancelled the upgrade was cancelled before it started
+	ClusterUpgradeCancelled ClusterUpgradeStatus = "cancelled"
+)
+
+func (s ClusterUpgradeStatus) String() string {
+	return string(s)
+}
+
+// ClusterActiveUpgradeStatuses are the statuses of upgrades that are not finished yet
+var ClusterActiveUpgradeStatuses = []string{ClusterUpgradePending.String(), ClusterUpgradeScheduled.String(), ClusterUpgradeInProgress.String()}
+
 // This represents the valid statuses of a dataplane cluster
 var StatusForValidCluster = []string{string(ClusterProvisioning), string(ClusterProvisioned), string(ClusterReady),
 	string(ClusterAccepted), string(ClusterWaitingForKasFleetShardOperator)}
@@ -178,6 +202,22 @@ type Cluster struct {
 
 	// AccessKafkasViaPrivateNetwork indicates whether Kafkas deployed on this OSD cluster have to be accessed via private network
 	AccessKafkasViaPrivateNetwork bool `json:"access_kafkas_via_private_network"`
+
+	// ClusterBlueprintID is the id of the cluster blueprint the cluster is managed by. Empty if the cluster is not managed by a blueprint
+	ClusterBlueprintID string `json:"cluster_blueprint_id" gorm:"index"`
+
+	// UpgradeStatus tracks the latest OpenShift upgrade requested for the cluster. Empty if no upgrade was ever requested
+	UpgradeStatus ClusterUpgradeStatus `json:"upgrade_status" gorm:"index"`
+	// UpgradeVersion is the OpenShift version the cluster is requested to be upgraded to
+	UpgradeVersion string `json:"upgrade_version"`
+	// UpgradeScheduledAt is the time before which the upgrade must not start
+	UpgradeScheduledAt *time.Time `json:"upgrade_scheduled_at"`
+	// UpgradeWaveID groups the clusters whose upgrades were requested together. Empty for upgrades requested for a single cluster
+	UpgradeWaveID string `json:"upgrade_wave_id" gorm:"index"`
+	// UpgradePolicyID is the id of the upgrade in the cluster provider, once scheduled there
+	UpgradePolicyID string `json:"upgrade_policy_id"`
+	// UpgradeStatusDetails explains why a pending upgrade is held back or why an upgrade failed
+	UpgradeStatusDetails string `json:"upgrade_status_details"`
 }
 
 type ClusterList []*Cluster
@@ -531,3 +571,8 @@ func (cluster *Cluster) GetSupportedInstanceTypes() []string {
 func (cluster *Cluster) GetRawSupportedInstanceTypes() string {
 	return cluster.SupportedInstanceType
 }
+
+// IsUpgradeActive returns true if an OpenShift upgrade of the cluster is requested and not finished yet
+func (cluster *Cluster) IsUpgradeActive() bool {
+	return arrays.Contains(ClusterActiveUpgradeStatuses, cluster.UpgradeStatus.String())
+}
@@ -0,0 +1,43 @@
+package api
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// IdempotencyKey stores the response of a request sent with an Idempotency-Key header,
+// so that the response can be replayed when the same request is sent again
+type IdempotencyKey struct {
+	ID    string `gorm:"primaryKey"`
+	Owner string `gorm:"uniqueIndex:idx_idempotency_keys_owner_key"`
+	Key   string `gorm:"uniqueIndex:idx_idempotency_keys_owner_key"`
+	// RequestHash is the hash of the method, path and body of the request
+	RequestHash         string
+	ResponseStatus      int
+	ResponseContentType string
+	ResponseBody        []byte
+	CreatedAt           time.Time
+	ExpiresAt           time.Time `gorm:"index"`
+	// LockedUntil is the end of the lease of a pending key, after which its request is deemed to have died
+	// without storing its response, and the key can be used again
+	LockedUntil time.Time
+}
+
+// IsPending returns true while the request of the idempotency key is being processed
+func (k *IdempotencyKey) IsPending() bool {
+	return k.ResponseStatus == 0
+}
+
+// IsStale returns true when the key can be used again: its retention window has passed, or its request has
+// not stored a response before the end of its lease
+func (k *IdempotencyKey) IsStale(now time.Time) bool {
+	return !k.ExpiresAt.After(now) || (k.IsPending() && !k.LockedUntil.After(now))
+}
+
+func (k *IdempotencyKey) BeforeCreate(tx *gorm.DB) error {
+	if k.ID == "" {
+		k.ID = NewID()
+	}
+	return nil
+}
@@ -11,6 +11,12 @@ type LeaderLease struct {
 	Leader    string
 	LeaseType string
 	Expires   *time.Time
+	// Paused workers keep running for their lease type but skip their reconciles
+	Paused bool
+	// LastReconcileAt, LastReconcileErrors and NextReconcileAt are recorded by the leader of the lease type
+	LastReconcileAt     *time.Time
+	LastReconcileErrors JSON `gorm:"type:jsonb"`
+	NextReconcileAt     *time.Time
 }
 
 type LeaderLeaseList []*LeaderLease
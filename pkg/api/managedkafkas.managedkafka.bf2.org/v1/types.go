@@ -69,6 +69,9 @@ type TlsSpec struct {
 type EndpointSpec struct {
 	BootstrapServerHost string   `json:"bootstrapServerHost"`
 	Tls                 *TlsSpec `json:"tls,omitempty"`
+	// PrivateConnectivity requests the Kafka brokers to be exposed through a network load balancer tagged with the
+	// Kafka id, in front of which the fleet manager creates the private endpoint service
+	PrivateConnectivity bool `json:"privateConnectivity,omitempty"`
 }
 
 type ServiceAccount struct {
@@ -78,13 +81,14 @@ type ServiceAccount struct {
 }
 
 type ManagedKafkaSpec struct {
-	Capacity        Capacity         `json:"capacity"`
-	OAuth           OAuthSpec        `json:"oauth"`
-	Endpoint        EndpointSpec     `json:"endpoint"`
-	Versions        VersionsSpec     `json:"versions"`
-	Deleted         bool             `json:"deleted"`
-	Owners          []string         `json:"owners"`
-	ServiceAccounts []ServiceAccount `json:"service_accounts"`
+	Capacity        Capacity          `json:"capacity"`
+	OAuth           OAuthSpec         `json:"oauth"`
+	Endpoint        EndpointSpec      `json:"endpoint"`
+	Versions        VersionsSpec      `json:"versions"`
+	KafkaConfig     map[string]string `json:"kafkaConfig,omitempty"`
+	Deleted         bool              `json:"deleted"`
+	Owners          []string          `json:"owners"`
+	ServiceAccounts []ServiceAccount  `json:"service_accounts"`
 }
 
 type ManagedKafka struct {
@@ -62,4 +62,6 @@ type PagingMeta struct {
 	Page  int
 	Size  int
 	Total int
+	// NextCursor is the cursor of the following page when paging with a cursor. It is empty on the last page
+	NextCursor string
 }
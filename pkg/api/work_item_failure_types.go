@@ -0,0 +1,38 @@
+package api
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// WorkItemFailure tracks the failed reconciles of a work item (i.e. a kafka or a connector) by a worker type,
+// it is removed as soon as the work item is successfully reconciled
+type WorkItemFailure struct {
+	ID         string `gorm:"primaryKey"`
+	WorkerType string `gorm:"uniqueIndex:idx_work_item_failures_worker_type_resource_id"`
+	ResourceID string `gorm:"uniqueIndex:idx_work_item_failures_worker_type_resource_id"`
+	Attempts   int
+	LastError  string
+	// NextAttemptAt is the time before which the work item is not reconciled again
+	NextAttemptAt time.Time
+	// QuarantinedAt is set once the work item exceeded the maximum number of attempts,
+	// it is no longer reconciled until it is released
+	QuarantinedAt *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+type WorkItemFailureList []*WorkItemFailure
+
+// IsQuarantined returns true when the work item is no longer reconciled
+func (f *WorkItemFailure) IsQuarantined() bool {
+	return f.QuarantinedAt != nil
+}
+
+func (f *WorkItemFailure) BeforeCreate(tx *gorm.DB) error {
+	if f.ID == "" {
+		f.ID = NewID()
+	}
+	return nil
+}
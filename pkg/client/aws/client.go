@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -9,6 +10,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws/client"
 	awscredentials "github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
 	"github.com/aws/aws-sdk-go/service/route53"
 	errors "github.com/pkg/errors"
 )
@@ -18,6 +21,9 @@ type Route53API route53Client
 const (
 	DefaultAWSRoute53Region = "us-east-1"
 	DefaultGCPRoute53Region = "us-east-1"
+
+	// errCodeVpcEndpointServiceNotFound is returned by EC2 when a VPC endpoint service does not exist
+	errCodeVpcEndpointServiceNotFound = "InvalidVpcEndpointServiceId.NotFound"
 )
 
 //go:generate moq -out client_moq.go . AWSClient
@@ -26,6 +32,16 @@ type AWSClient interface {
 	ListHostedZonesByNameInput(dnsName string) (*route53.ListHostedZonesByNameOutput, error)
 	ChangeResourceRecordSets(dnsName string, recordChangeBatch *route53.ChangeBatch) (*route53.ChangeResourceRecordSetsOutput, error)
 	GetChange(changeId string) (*route53.GetChangeOutput, error)
+
+	// ec2
+	CreateVpcEndpointServiceConfiguration(clientToken string, networkLoadBalancerArns []string, tags map[string]string) (*ec2.ServiceConfiguration, error)
+	GetVpcEndpointServiceConfiguration(serviceId string) (*ec2.ServiceConfiguration, error)
+	DeleteVpcEndpointServiceConfiguration(serviceId string) error
+	GetVpcEndpointServiceAllowedPrincipals(serviceId string) ([]string, error)
+	ModifyVpcEndpointServiceAllowedPrincipals(serviceId string, principalsToAdd []string, principalsToRemove []string) error
+
+	// resource groups tagging
+	ListResourceARNsByTags(resourceTypes []string, tags map[string]string) ([]string, error)
 }
 
 type ClientFactory interface {
@@ -60,6 +76,8 @@ var _ AWSClient = &awsCl{}
 
 type awsCl struct {
 	route53Client route53Client
+	ec2Client     ec2Client
+	taggingClient resourceTaggingClient
 }
 
 // Config contains the AWS settings
@@ -85,6 +103,8 @@ func newClient(credentials Config, region string) (AWSClient, error) {
 	}
 	return &awsCl{
 		route53Client: route53.New(sess),
+		ec2Client:     ec2.New(sess),
+		taggingClient: resourcegroupstaggingapi.New(sess),
 	}, nil
 }
 
@@ -156,6 +176,184 @@ func (client *awsCl) ChangeResourceRecordSets(dnsName string, recordChangeBatch
 	return recordSetsOutput, nil
 }
 
+// CreateVpcEndpointServiceConfiguration creates a VPC endpoint service in front of the given network load balancers.
+// The client token makes the request idempotent: retrying with the same token returns the already created service.
+// Connection requests are accepted automatically for the allowed principals.
+func (client *awsCl) CreateVpcEndpointServiceConfiguration(clientToken string, networkLoadBalancerArns []string, tags map[string]string) (*ec2.ServiceConfiguration, error) {
+	input := &ec2.CreateVpcEndpointServiceConfigurationInput{
+		AcceptanceRequired:      aws.Bool(false),
+		ClientToken:             aws.String(clientToken),
+		NetworkLoadBalancerArns: aws.StringSlice(networkLoadBalancerArns),
+	}
+	if len(tags) > 0 {
+		input.TagSpecifications = []*ec2.TagSpecification{
+			{
+				ResourceType: aws.String(ec2.ResourceTypeVpcEndpointService),
+				Tags:         toEC2Tags(tags),
+			},
+		}
+	}
+
+	output, err := client.ec2Client.CreateVpcEndpointServiceConfiguration(input)
+	if err != nil {
+		return nil, wrapAWSError(err, "Failed to create VPC endpoint service.")
+	}
+	return output.ServiceConfiguration, nil
+}
+
+// GetVpcEndpointServiceConfiguration returns the VPC endpoint service with the given id, or nil if it does not exist
+func (client *awsCl) GetVpcEndpointServiceConfiguration(serviceId string) (*ec2.ServiceConfiguration, error) {
+	output, err := client.ec2Client.DescribeVpcEndpointServiceConfigurations(&ec2.DescribeVpcEndpointServiceConfigurationsInput{
+		ServiceIds: aws.StringSlice([]string{serviceId}),
+	})
+	if err != nil {
+		if isAWSErrorCode(err, errCodeVpcEndpointServiceNotFound) {
+			return nil, nil
+		}
+		return nil, wrapAWSError(err, "Failed to get VPC endpoint service.")
+	}
+	if len(output.ServiceConfigurations) == 0 {
+		return nil, nil
+	}
+	return output.ServiceConfigurations[0], nil
+}
+
+// DeleteVpcEndpointServiceConfiguration deletes the VPC endpoint service with the given id.
+// Endpoint connections still attached to the service are rejected first, as AWS refuses to delete a service that has any.
+// Deleting a service that does not exist is not an error.
+func (client *awsCl) DeleteVpcEndpointServiceConfiguration(serviceId string) error {
+	connections, err := client.ec2Client.DescribeVpcEndpointConnections(&ec2.DescribeVpcEndpointConnectionsInput{
+		Filters: []*ec2.Filter{
+			{Name: aws.String("service-id"), Values: aws.StringSlice([]string{serviceId})},
+		},
+	})
+	if err != nil {
+		return wrapAWSError(err, "Failed to list VPC endpoint connections.")
+	}
+
+	var endpointIds []*string
+	for _, connection := range connections.VpcEndpointConnections {
+		switch aws.StringValue(connection.VpcEndpointState) {
+		case ec2.StateAvailable, ec2.StatePendingAcceptance, ec2.StatePending:
+			endpointIds = append(endpointIds, connection.VpcEndpointId)
+		}
+	}
+	if len(endpointIds) > 0 {
+		_, err = client.ec2Client.RejectVpcEndpointConnections(&ec2.RejectVpcEndpointConnectionsInput{
+			ServiceId:      aws.String(serviceId),
+			VpcEndpointIds: endpointIds,
+		})
+		if err != nil {
+			return wrapAWSError(err, "Failed to reject VPC endpoint connections.")
+		}
+	}
+
+	output, err := client.ec2Client.DeleteVpcEndpointServiceConfigurations(&ec2.DeleteVpcEndpointServiceConfigurationsInput{
+		ServiceIds: aws.StringSlice([]string{serviceId}),
+	})
+	if err != nil {
+		return wrapAWSError(err, "Failed to delete VPC endpoint service.")
+	}
+	for _, item := range output.Unsuccessful {
+		if item.Error == nil || aws.StringValue(item.Error.Code) == errCodeVpcEndpointServiceNotFound {
+			continue
+		}
+		return fmt.Errorf("failed to delete VPC endpoint service %q: %s", serviceId, aws.StringValue(item.Error.Message))
+	}
+	return nil
+}
+
+// GetVpcEndpointServiceAllowedPrincipals returns the ARNs of the principals allowed to connect to the VPC endpoint service
+func (client *awsCl) GetVpcEndpointServiceAllowedPrincipals(serviceId string) ([]string, error) {
+	var principals []string
+	input := &ec2.DescribeVpcEndpointServicePermissionsInput{
+		ServiceId: aws.String(serviceId),
+	}
+	for {
+		output, err := client.ec2Client.DescribeVpcEndpointServicePermissions(input)
+		if err != nil {
+			return nil, wrapAWSError(err, "Failed to get VPC endpoint service permissions.")
+		}
+		for _, principal := range output.AllowedPrincipals {
+			principals = append(principals, aws.StringValue(principal.Principal))
+		}
+		if aws.StringValue(output.NextToken) == "" {
+			return principals, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
+
+// ModifyVpcEndpointServiceAllowedPrincipals adds and removes principals allowed to connect to the VPC endpoint service
+func (client *awsCl) ModifyVpcEndpointServiceAllowedPrincipals(serviceId string, principalsToAdd []string, principalsToRemove []string) error {
+	if len(principalsToAdd) == 0 && len(principalsToRemove) == 0 {
+		return nil
+	}
+
+	input := &ec2.ModifyVpcEndpointServicePermissionsInput{
+		ServiceId: aws.String(serviceId),
+	}
+	if len(principalsToAdd) > 0 {
+		input.AddAllowedPrincipals = aws.StringSlice(principalsToAdd)
+	}
+	if len(principalsToRemove) > 0 {
+		input.RemoveAllowedPrincipals = aws.StringSlice(principalsToRemove)
+	}
+
+	_, err := client.ec2Client.ModifyVpcEndpointServicePermissions(input)
+	if err != nil {
+		return wrapAWSError(err, "Failed to modify VPC endpoint service permissions.")
+	}
+	return nil
+}
+
+// ListResourceARNsByTags returns the ARNs of the resources of the given types having all the given tags
+func (client *awsCl) ListResourceARNsByTags(resourceTypes []string, tags map[string]string) ([]string, error) {
+	input := &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceTypeFilters: aws.StringSlice(resourceTypes),
+	}
+	for key, value := range tags {
+		input.TagFilters = append(input.TagFilters, &resourcegroupstaggingapi.TagFilter{
+			Key:    aws.String(key),
+			Values: aws.StringSlice([]string{value}),
+		})
+	}
+
+	var arns []string
+	for {
+		output, err := client.taggingClient.GetResources(input)
+		if err != nil {
+			return nil, wrapAWSError(err, "Failed to get resources by tags.")
+		}
+		for _, mapping := range output.ResourceTagMappingList {
+			arns = append(arns, aws.StringValue(mapping.ResourceARN))
+		}
+		if aws.StringValue(output.PaginationToken) == "" {
+			return arns, nil
+		}
+		input.PaginationToken = output.PaginationToken
+	}
+}
+
+func toEC2Tags(tags map[string]string) []*ec2.Tag {
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	ec2Tags := make([]*ec2.Tag, 0, len(tags))
+	for _, key := range keys {
+		ec2Tags = append(ec2Tags, &ec2.Tag{Key: aws.String(key), Value: aws.String(tags[key])})
+	}
+	return ec2Tags
+}
+
+func isAWSErrorCode(err error, code string) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == code
+}
+
 func wrapAWSError(err error, msg string) error {
 	switch err.(type) {
 	case awserr.RequestFailure:
@@ -4,6 +4,7 @@
 package aws
 
 import (
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"sync"
 )
@@ -21,12 +22,30 @@ var _ AWSClient = &AWSClientMock{}
 //			ChangeResourceRecordSetsFunc: func(dnsName string, recordChangeBatch *route53.ChangeBatch) (*route53.ChangeResourceRecordSetsOutput, error) {
 //				panic("mock out the ChangeResourceRecordSets method")
 //			},
+//			CreateVpcEndpointServiceConfigurationFunc: func(clientToken string, networkLoadBalancerArns []string, tags map[string]string) (*ec2.ServiceConfiguration, error) {
+//				panic("mock out the CreateVpcEndpointServiceConfiguration method")
+//			},
+//			DeleteVpcEndpointServiceConfigurationFunc: func(serviceId string) error {
+//				panic("mock out the DeleteVpcEndpointServiceConfiguration method")
+//			},
 //			GetChangeFunc: func(changeId string) (*route53.GetChangeOutput, error) {
 //				panic("mock out the GetChange method")
 //			},
+//			GetVpcEndpointServiceAllowedPrincipalsFunc: func(serviceId string) ([]string, error) {
+//				panic("mock out the GetVpcEndpointServiceAllowedPrincipals method")
+//			},
+//			GetVpcEndpointServiceConfigurationFunc: func(serviceId string) (*ec2.ServiceConfiguration, error) {
+//				panic("mock out the GetVpcEndpointServiceConfiguration method")
+//			},
 //			ListHostedZonesByNameInputFunc: func(dnsName string) (*route53.ListHostedZonesByNameOutput, error) {
 //				panic("mock out the ListHostedZonesByNameInput method")
 //			},
+//			ListResourceARNsByTagsFunc: func(resourceTypes []string, tags map[string]string) ([]string, error) {
+//				panic("mock out the ListResourceARNsByTags method")
+//			},
+//			ModifyVpcEndpointServiceAllowedPrincipalsFunc: func(serviceId string, principalsToAdd []string, principalsToRemove []string) error {
+//				panic("mock out the ModifyVpcEndpointServiceAllowedPrincipals method")
+//			},
 //		}
 //
 //		// use mockedAWSClient in code that requires AWSClient
@@ -37,12 +56,30 @@ type AWSClientMock struct {
 	// ChangeResourceRecordSetsFunc mocks the ChangeResourceRecordSets method.
 	ChangeResourceRecordSetsFunc func(dnsName string, recordChangeBatch *route53.ChangeBatch) (*route53.ChangeResourceRecordSetsOutput, error)
 
+	// CreateVpcEndpointServiceConfigurationFunc mocks the CreateVpcEndpointServiceConfiguration method.
+	CreateVpcEndpointServiceConfigurationFunc func(clientToken string, networkLoadBalancerArns []string, tags map[string]string) (*ec2.ServiceConfiguration, error)
+
+	// DeleteVpcEndpointServiceConfigurationFunc mocks the DeleteVpcEndpointServiceConfiguration method.
+	DeleteVpcEndpointServiceConfigurationFunc func(serviceId string) error
+
 	// GetChangeFunc mocks the GetChange method.
 	GetChangeFunc func(changeId string) (*route53.GetChangeOutput, error)
 
+	// GetVpcEndpointServiceAllowedPrincipalsFunc mocks the GetVpcEndpointServiceAllowedPrincipals method.
+	GetVpcEndpointServiceAllowedPrincipalsFunc func(serviceId string) ([]string, error)
+
+	// GetVpcEndpointServiceConfigurationFunc mocks the GetVpcEndpointServiceConfiguration method.
+	GetVpcEndpointServiceConfigurationFunc func(serviceId string) (*ec2.ServiceConfiguration, error)
+
 	// ListHostedZonesByNameInputFunc mocks the ListHostedZonesByNameInput method.
 	ListHostedZonesByNameInputFunc func(dnsName string) (*route53.ListHostedZonesByNameOutput, error)
 
+	// ListResourceARNsByTagsFunc mocks the ListResourceARNsByTags method.
+	ListResourceARNsByTagsFunc func(resourceTypes []string, tags map[string]string) ([]string, error)
+
+	// ModifyVpcEndpointServiceAllowedPrincipalsFunc mocks the ModifyVpcEndpointServiceAllowedPrincipals method.
+	ModifyVpcEndpointServiceAllowedPrincipalsFunc func(serviceId string, principalsToAdd []string, principalsToRemove []string) error
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// ChangeResourceRecordSets holds details about calls to the ChangeResourceRecordSets method.
@@ -52,20 +89,66 @@ type AWSClientMock struct {
 			// RecordChangeBatch is the recordChangeBatch argument value.
 			RecordChangeBatch *route53.ChangeBatch
 		}
+		// CreateVpcEndpointServiceConfiguration holds details about calls to the CreateVpcEndpointServiceConfiguration method.
+		CreateVpcEndpointServiceConfiguration []struct {
+			// ClientToken is the clientToken argument value.
+			ClientToken string
+			// NetworkLoadBalancerArns is the networkLoadBalancerArns argument value.
+			NetworkLoadBalancerArns []string
+			// Tags is the tags argument value.
+			Tags map[string]string
+		}
+		// DeleteVpcEndpointServiceConfiguration holds details about calls to the DeleteVpcEndpointServiceConfiguration method.
+		DeleteVpcEndpointServiceConfiguration []struct {
+			// ServiceId is the serviceId argument value.
+			ServiceId string
+		}
 		// GetChange holds details about calls to the GetChange method.
 		GetChange []struct {
 			// ChangeId is the changeId argument value.
 			ChangeId string
 		}
+		// GetVpcEndpointServiceAllowedPrincipals holds details about calls to the GetVpcEndpointServiceAllowedPrincipals method.
+		GetVpcEndpointServiceAllowedPrincipals []struct {
+			// ServiceId is the serviceId argument value.
+			ServiceId string
+		}
+		// GetVpcEndpointServiceConfiguration holds details about calls to the GetVpcEndpointServiceConfiguration method.
+		GetVpcEndpointServiceConfiguration []struct {
+			// ServiceId is the serviceId argument value.
+			ServiceId string
+		}
 		// ListHostedZonesByNameInput holds details about calls to the ListHostedZonesByNameInput method.
 		ListHostedZonesByNameInput []struct {
 			// DnsName is the dnsName argument value.
 			DnsName string
 		}
+		// ListResourceARNsByTags holds details about calls to the ListResourceARNsByTags method.
+		ListResourceARNsByTags []struct {
+			// ResourceTypes is the resourceTypes argument value.
+			ResourceTypes []string
+			// Tags is the tags argument value.
+			Tags map[string]string
+		}
+		// ModifyVpcEndpointServiceAllowedPrincipals holds details about calls to the ModifyVpcEndpointServiceAllowedPrincipals method.
+		ModifyVpcEndpointServiceAllowedPrincipals []struct {
+			// ServiceId is the serviceId argument value.
+			ServiceId string
+			// PrincipalsToAdd is the principalsToAdd argument value.
+			PrincipalsToAdd []string
+			// PrincipalsToRemove is the principalsToRemove argument value.
+			PrincipalsToRemove []string
+		}
 	}
-	lockChangeResourceRecordSets   sync.RWMutex
-	lockGetChange                  sync.RWMutex
-	lockListHostedZonesByNameInput sync.RWMutex
+	lockChangeResourceRecordSets                  sync.RWMutex
+	lockCreateVpcEndpointServiceConfiguration     sync.RWMutex
+	lockDeleteVpcEndpointServiceConfiguration     sync.RWMutex
+	lockGetChange                                 sync.RWMutex
+	lockGetVpcEndpointServiceAllowedPrincipals    sync.RWMutex
+	lockGetVpcEndpointServiceConfiguration        sync.RWMutex
+	lockListHostedZonesByNameInput                sync.RWMutex
+	lockListResourceARNsByTags                    sync.RWMutex
+	lockModifyVpcEndpointServiceAllowedPrincipals sync.RWMutex
 }
 
 // ChangeResourceRecordSets calls ChangeResourceRecordSetsFunc.
@@ -104,6 +187,78 @@ func (mock *AWSClientMock) ChangeResourceRecordSetsCalls() []struct {
 	return calls
 }
 
+// CreateVpcEndpointServiceConfiguration calls CreateVpcEndpointServiceConfigurationFunc.
+func (mock *AWSClientMock) CreateVpcEndpointServiceConfiguration(clientToken string, networkLoadBalancerArns []string, tags map[string]string) (*ec2.ServiceConfiguration, error) {
+	if mock.CreateVpcEndpointServiceConfigurationFunc == nil {
+		panic("AWSClientMock.CreateVpcEndpointServiceConfigurationFunc: method is nil but AWSClient.CreateVpcEndpointServiceConfiguration was just called")
+	}
+	callInfo := struct {
+		ClientToken             string
+		NetworkLoadBalancerArns []string
+		Tags                    map[string]string
+	}{
+		ClientToken:             clientToken,
+		NetworkLoadBalancerArns: networkLoadBalancerArns,
+		Tags:                    tags,
+	}
+	mock.lockCreateVpcEndpointServiceConfiguration.Lock()
+	mock.calls.CreateVpcEndpointServiceConfiguration = append(mock.calls.CreateVpcEndpointServiceConfiguration, callInfo)
+	mock.lockCreateVpcEndpointServiceConfiguration.Unlock()
+	return mock.CreateVpcEndpointServiceConfigurationFunc(clientToken, networkLoadBalancerArns, tags)
+}
+
+// CreateVpcEndpointServiceConfigurationCalls gets all the calls that were made to CreateVpcEndpointServiceConfiguration.
+// Check the length with:
+//
+//	len(mockedAWSClient.CreateVpcEndpointServiceConfigurationCalls())
+func (mock *AWSClientMock) CreateVpcEndpointServiceConfigurationCalls() []struct {
+	ClientToken             string
+	NetworkLoadBalancerArns []string
+	Tags                    map[string]string
+} {
+	var calls []struct {
+		ClientToken             string
+		NetworkLoadBalancerArns []string
+		Tags                    map[string]string
+	}
+	mock.lockCreateVpcEndpointServiceConfiguration.RLock()
+	calls = mock.calls.CreateVpcEndpointServiceConfiguration
+	mock.lockCreateVpcEndpointServiceConfiguration.RUnlock()
+	return calls
+}
+
+// DeleteVpcEndpointServiceConfiguration calls DeleteVpcEndpointServiceConfigurationFunc.
+func (mock *AWSClientMock) DeleteVpcEndpointServiceConfiguration(serviceId string) error {
+	if mock.DeleteVpcEndpointServiceConfigurationFunc == nil {
+		panic("AWSClientMock.DeleteVpcEndpointServiceConfigurationFunc: method is nil but AWSClient.DeleteVpcEndpointServiceConfiguration was just called")
+	}
+	callInfo := struct {
+		ServiceId string
+	}{
+		ServiceId: serviceId,
+	}
+	mock.lockDeleteVpcEndpointServiceConfiguration.Lock()
+	mock.calls.DeleteVpcEndpointServiceConfiguration = append(mock.calls.DeleteVpcEndpointServiceConfiguration, callInfo)
+	mock.lockDeleteVpcEndpointServiceConfiguration.Unlock()
+	return mock.DeleteVpcEndpointServiceConfigurationFunc(serviceId)
+}
+
+// DeleteVpcEndpointServiceConfigurationCalls gets all the calls that were made to DeleteVpcEndpointServiceConfiguration.
+// Check the length with:
+//
+//	len(mockedAWSClient.DeleteVpcEndpointServiceConfigurationCalls())
+func (mock *AWSClientMock) DeleteVpcEndpointServiceConfigurationCalls() []struct {
+	ServiceId string
+} {
+	var calls []struct {
+		ServiceId string
+	}
+	mock.lockDeleteVpcEndpointServiceConfiguration.RLock()
+	calls = mock.calls.DeleteVpcEndpointServiceConfiguration
+	mock.lockDeleteVpcEndpointServiceConfiguration.RUnlock()
+	return calls
+}
+
 // GetChange calls GetChangeFunc.
 func (mock *AWSClientMock) GetChange(changeId string) (*route53.GetChangeOutput, error) {
 	if mock.GetChangeFunc == nil {
@@ -136,6 +291,70 @@ func (mock *AWSClientMock) GetChangeCalls() []struct {
 	return calls
 }
 
+// GetVpcEndpointServiceAllowedPrincipals calls GetVpcEndpointServiceAllowedPrincipalsFunc.
+func (mock *AWSClientMock) GetVpcEndpointServiceAllowedPrincipals(serviceId string) ([]string, error) {
+	if mock.GetVpcEndpointServiceAllowedPrincipalsFunc == nil {
+		panic("AWSClientMock.GetVpcEndpointServiceAllowedPrincipalsFunc: method is nil but AWSClient.GetVpcEndpointServiceAllowedPrincipals was just called")
+	}
+	callInfo := struct {
+		ServiceId string
+	}{
+		ServiceId: serviceId,
+	}
+	mock.lockGetVpcEndpointServiceAllowedPrincipals.Lock()
+	mock.calls.GetVpcEndpointServiceAllowedPrincipals = append(mock.calls.GetVpcEndpointServiceAllowedPrincipals, callInfo)
+	mock.lockGetVpcEndpointServiceAllowedPrincipals.Unlock()
+	return mock.GetVpcEndpointServiceAllowedPrincipalsFunc(serviceId)
+}
+
+// GetVpcEndpointServiceAllowedPrincipalsCalls gets all the calls that were made to GetVpcEndpointServiceAllowedPrincipals.
+// Check the length with:
+//
+//	len(mockedAWSClient.GetVpcEndpointServiceAllowedPrincipalsCalls())
+func (mock *AWSClientMock) GetVpcEndpointServiceAllowedPrincipalsCalls() []struct {
+	ServiceId string
+} {
+	var calls []struct {
+		ServiceId string
+	}
+	mock.lockGetVpcEndpointServiceAllowedPrincipals.RLock()
+	calls = mock.calls.GetVpcEndpointServiceAllowedPrincipals
+	mock.lockGetVpcEndpointServiceAllowedPrincipals.RUnlock()
+	return calls
+}
+
+// GetVpcEndpointServiceConfiguration calls GetVpcEndpointServiceConfigurationFunc.
+func (mock *AWSClientMock) GetVpcEndpointServiceConfiguration(serviceId string) (*ec2.ServiceConfiguration, error) {
+	if mock.GetVpcEndpointServiceConfigurationFunc == nil {
+		panic("AWSClientMock.GetVpcEndpointServiceConfigurationFunc: method is nil but AWSClient.GetVpcEndpointServiceConfiguration was just called")
+	}
+	callInfo := struct {
+		ServiceId string
+	}{
+		ServiceId: serviceId,
+	}
+	mock.lockGetVpcEndpointServiceConfiguration.Lock()
+	mock.calls.GetVpcEndpointServiceConfiguration = append(mock.calls.GetVpcEndpointServiceConfiguration, callInfo)
+	mock.lockGetVpcEndpointServiceConfiguration.Unlock()
+	return mock.GetVpcEndpointServiceConfigurationFunc(serviceId)
+}
+
+// GetVpcEndpointServiceConfigurationCalls gets all the calls that were made to GetVpcEndpointServiceConfiguration.
+// Check the length with:
+//
+//	len(mockedAWSClient.GetVpcEndpointServiceConfigurationCalls())
+func (mock *AWSClientMock) GetVpcEndpointServiceConfigurationCalls() []struct {
+	ServiceId string
+} {
+	var calls []struct {
+		ServiceId string
+	}
+	mock.lockGetVpcEndpointServiceConfiguration.RLock()
+	calls = mock.calls.GetVpcEndpointServiceConfiguration
+	mock.lockGetVpcEndpointServiceConfiguration.RUnlock()
+	return calls
+}
+
 // ListHostedZonesByNameInput calls ListHostedZonesByNameInputFunc.
 func (mock *AWSClientMock) ListHostedZonesByNameInput(dnsName string) (*route53.ListHostedZonesByNameOutput, error) {
 	if mock.ListHostedZonesByNameInputFunc == nil {
@@ -167,3 +386,79 @@ func (mock *AWSClientMock) ListHostedZonesByNameInputCalls() []struct {
 	mock.lockListHostedZonesByNameInput.RUnlock()
 	return calls
 }
+
+// ListResourceARNsByTags calls ListResourceARNsByTagsFunc.
+func (mock *AWSClientMock) ListResourceARNsByTags(resourceTypes []string, tags map[string]string) ([]string, error) {
+	if mock.ListResourceARNsByTagsFunc == nil {
+		panic("AWSClientMock.ListResourceARNsByTagsFunc: method is nil but AWSClient.ListResourceARNsByTags was just called")
+	}
+	callInfo := struct {
+		ResourceTypes []string
+		Tags          map[string]string
+	}{
+		ResourceTypes: resourceTypes,
+		Tags:          tags,
+	}
+	mock.lockListResourceARNsByTags.Lock()
+	mock.calls.ListResourceARNsByTags = append(mock.calls.ListResourceARNsByTags, callInfo)
+	mock.lockListResourceARNsByTags.Unlock()
+	return mock.ListResourceARNsByTagsFunc(resourceTypes, tags)
+}
+
+// ListResourceARNsByTagsCalls gets all the calls that were made to ListResourceARNsByTags.
+// Check the length with:
+//
+//	len(mockedAWSClient.ListResourceARNsByTagsCalls())
+func (mock *AWSClientMock) ListResourceARNsByTagsCalls() []struct {
+	ResourceTypes []string
+	Tags          map[string]string
+} {
+	var calls []struct {
+		ResourceTypes []string
+		Tags          map[string]string
+	}
+	mock.lockListResourceARNsByTags.RLock()
+	calls = mock.calls.ListResourceARNsByTags
+	mock.lockListResourceARNsByTags.RUnlock()
+	return calls
+}
+
+// ModifyVpcEndpointServiceAllowedPrincipals calls ModifyVpcEndpointServiceAllowedPrincipalsFunc.
+func (mock *AWSClientMock) ModifyVpcEndpointServiceAllowedPrincipals(serviceId string, principalsToAdd []string, principalsToRemove []string) error {
+	if mock.ModifyVpcEndpointServiceAllowedPrincipalsFunc == nil {
+		panic("AWSClientMock.ModifyVpcEndpointServiceAllowedPrincipalsFunc: method is nil but AWSClient.ModifyVpcEndpointServiceAllowedPrincipals was just called")
+	}
+	callInfo := struct {
+		ServiceId          string
+		PrincipalsToAdd    []string
+		PrincipalsToRemove []string
+	}{
+		ServiceId:          serviceId,
+		PrincipalsToAdd:    principalsToAdd,
+		PrincipalsToRemove: principalsToRemove,
+	}
+	mock.lockModifyVpcEndpointServiceAllowedPrincipals.Lock()
+	mock.calls.ModifyVpcEndpointServiceAllowedPrincipals = append(mock.calls.ModifyVpcEndpointServiceAllowedPrincipals, callInfo)
+	mock.lockModifyVpcEndpointServiceAllowedPrincipals.Unlock()
+	return mock.ModifyVpcEndpointServiceAllowedPrincipalsFunc(serviceId, principalsToAdd, principalsToRemove)
+}
+
+// ModifyVpcEndpointServiceAllowedPrincipalsCalls gets all the calls that were made to ModifyVpcEndpointServiceAllowedPrincipals.
+// Check the length with:
+//
+//	len(mockedAWSClient.ModifyVpcEndpointServiceAllowedPrincipalsCalls())
+func (mock *AWSClientMock) ModifyVpcEndpointServiceAllowedPrincipalsCalls() []struct {
+	ServiceId          string
+	PrincipalsToAdd    []string
+	PrincipalsToRemove []string
+} {
+	var calls []struct {
+		ServiceId          string
+		PrincipalsToAdd    []string
+		PrincipalsToRemove []string
+	}
+	mock.lockModifyVpcEndpointServiceAllowedPrincipals.RLock()
+	calls = mock.calls.ModifyVpcEndpointServiceAllowedPrincipals
+	mock.lockModifyVpcEndpointServiceAllowedPrincipals.RUnlock()
+	return calls
+}
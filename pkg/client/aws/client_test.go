@@ -3,7 +3,10 @@ package aws
 import (
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/onsi/gomega"
 )
@@ -289,3 +292,285 @@ func TestAwsClient_ChangeResourceRecordSets(t *testing.T) {
 		})
 	}
 }
+
+func TestAwsClient_CreateVpcEndpointServiceConfiguration(t *testing.T) {
+	tests := []struct {
+		name      string
+		ec2Client ec2Client
+		want      *ec2.ServiceConfiguration
+		wantErr   bool
+	}{
+		{
+			name: "Should create an endpoint service accepting connections automatically",
+			ec2Client: &ec2ClientMock{
+				CreateVpcEndpointServiceConfigurationFunc: func(input *ec2.CreateVpcEndpointServiceConfigurationInput) (*ec2.CreateVpcEndpointServiceConfigurationOutput, error) {
+					if aws.BoolValue(input.AcceptanceRequired) || aws.StringValue(input.ClientToken) != testValue ||
+						len(input.TagSpecifications) != 1 || len(input.TagSpecifications[0].Tags) != 2 ||
+						aws.StringValue(input.TagSpecifications[0].Tags[0].Key) != "a" {
+						return nil, awserr.New("InvalidParameter", "unexpected input", nil)
+					}
+					return &ec2.CreateVpcEndpointServiceConfigurationOutput{
+						ServiceConfiguration: &ec2.ServiceConfiguration{ServiceId: aws.String("vpce-svc-1")},
+					}, nil
+				},
+			},
+			want: &ec2.ServiceConfiguration{ServiceId: aws.String("vpce-svc-1")},
+		},
+		{
+			name: "Should return an error when the endpoint service cannot be created",
+			ec2Client: &ec2ClientMock{
+				CreateVpcEndpointServiceConfigurationFunc: func(input *ec2.CreateVpcEndpointServiceConfigurationInput) (*ec2.CreateVpcEndpointServiceConfigurationOutput, error) {
+					return nil, awserr.New("InvalidParameter", "test", nil)
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			awsClient := &awsCl{ec2Client: tt.ec2Client}
+			got, err := awsClient.CreateVpcEndpointServiceConfiguration(testValue, []string{"nlb-arn"}, map[string]string{"b": "2", "a": "1"})
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func TestAwsClient_GetVpcEndpointServiceConfiguration(t *testing.T) {
+	tests := []struct {
+		name      string
+		ec2Client ec2Client
+		want      *ec2.ServiceConfiguration
+		wantErr   bool
+	}{
+		{
+			name: "Should return the endpoint service",
+			ec2Client: &ec2ClientMock{
+				DescribeVpcEndpointServiceConfigurationsFunc: func(input *ec2.DescribeVpcEndpointServiceConfigurationsInput) (*ec2.DescribeVpcEndpointServiceConfigurationsOutput, error) {
+					return &ec2.DescribeVpcEndpointServiceConfigurationsOutput{
+						ServiceConfigurations: []*ec2.ServiceConfiguration{{ServiceId: input.ServiceIds[0]}},
+					}, nil
+				},
+			},
+			want: &ec2.ServiceConfiguration{ServiceId: aws.String(testValue)},
+		},
+		{
+			name: "Should return nil when the endpoint service does not exist",
+			ec2Client: &ec2ClientMock{
+				DescribeVpcEndpointServiceConfigurationsFunc: func(input *ec2.DescribeVpcEndpointServiceConfigurationsInput) (*ec2.DescribeVpcEndpointServiceConfigurationsOutput, error) {
+					return nil, awserr.New(errCodeVpcEndpointServiceNotFound, "test", nil)
+				},
+			},
+		},
+		{
+			name: "Should return an error when the endpoint service cannot be described",
+			ec2Client: &ec2ClientMock{
+				DescribeVpcEndpointServiceConfigurationsFunc: func(input *ec2.DescribeVpcEndpointServiceConfigurationsInput) (*ec2.DescribeVpcEndpointServiceConfigurationsOutput, error) {
+					return nil, awserr.New("UnauthorizedOperation", "test", nil)
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			awsClient := &awsCl{ec2Client: tt.ec2Client}
+			got, err := awsClient.GetVpcEndpointServiceConfiguration(testValue)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func TestAwsClient_DeleteVpcEndpointServiceConfiguration(t *testing.T) {
+	connections := &ec2.DescribeVpcEndpointConnectionsOutput{
+		VpcEndpointConnections: []*ec2.VpcEndpointConnection{
+			{VpcEndpointId: aws.String("vpce-1"), VpcEndpointState: aws.String(ec2.StateAvailable)},
+			{VpcEndpointId: aws.String("vpce-2"), VpcEndpointState: aws.String(ec2.StateRejected)},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		ec2Client    *ec2ClientMock
+		wantRejected int
+		wantErr      bool
+	}{
+		{
+			name: "Should reject the active connections before deleting the endpoint service",
+			ec2Client: &ec2ClientMock{
+				DescribeVpcEndpointConnectionsFunc: func(input *ec2.DescribeVpcEndpointConnectionsInput) (*ec2.DescribeVpcEndpointConnectionsOutput, error) {
+					return connections, nil
+				},
+				RejectVpcEndpointConnectionsFunc: func(input *ec2.RejectVpcEndpointConnectionsInput) (*ec2.RejectVpcEndpointConnectionsOutput, error) {
+					if len(input.VpcEndpointIds) != 1 || aws.StringValue(input.VpcEndpointIds[0]) != "vpce-1" {
+						return nil, awserr.New("InvalidParameter", "unexpected endpoints", nil)
+					}
+					return &ec2.RejectVpcEndpointConnectionsOutput{}, nil
+				},
+				DeleteVpcEndpointServiceConfigurationsFunc: func(input *ec2.DeleteVpcEndpointServiceConfigurationsInput) (*ec2.DeleteVpcEndpointServiceConfigurationsOutput, error) {
+					return &ec2.DeleteVpcEndpointServiceConfigurationsOutput{}, nil
+				},
+			},
+			wantRejected: 1,
+		},
+		{
+			name: "Should not return an error when the endpoint service does not exist",
+			ec2Client: &ec2ClientMock{
+				DescribeVpcEndpointConnectionsFunc: func(input *ec2.DescribeVpcEndpointConnectionsInput) (*ec2.DescribeVpcEndpointConnectionsOutput, error) {
+					return &ec2.DescribeVpcEndpointConnectionsOutput{}, nil
+				},
+				DeleteVpcEndpointServiceConfigurationsFunc: func(input *ec2.DeleteVpcEndpointServiceConfigurationsInput) (*ec2.DeleteVpcEndpointServiceConfigurationsOutput, error) {
+					return &ec2.DeleteVpcEndpointServiceConfigurationsOutput{
+						Unsuccessful: []*ec2.UnsuccessfulItem{
+							{Error: &ec2.UnsuccessfulItemError{Code: aws.String(errCodeVpcEndpointServiceNotFound)}},
+						},
+					}, nil
+				},
+			},
+		},
+		{
+			name: "Should return an error when the endpoint service cannot be deleted",
+			ec2Client: &ec2ClientMock{
+				DescribeVpcEndpointConnectionsFunc: func(input *ec2.DescribeVpcEndpointConnectionsInput) (*ec2.DescribeVpcEndpointConnectionsOutput, error) {
+					return &ec2.DescribeVpcEndpointConnectionsOutput{}, nil
+				},
+				DeleteVpcEndpointServiceConfigurationsFunc: func(input *ec2.DeleteVpcEndpointServiceConfigurationsInput) (*ec2.DeleteVpcEndpointServiceConfigurationsOutput, error) {
+					return &ec2.DeleteVpcEndpointServiceConfigurationsOutput{
+						Unsuccessful: []*ec2.UnsuccessfulItem{
+							{Error: &ec2.UnsuccessfulItemError{Code: aws.String("ExistingVpcEndpointConnections"), Message: aws.String("test")}},
+						},
+					}, nil
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			awsClient := &awsCl{ec2Client: tt.ec2Client}
+			err := awsClient.DeleteVpcEndpointServiceConfiguration(testValue)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(tt.ec2Client.RejectVpcEndpointConnectionsCalls()).To(gomega.HaveLen(tt.wantRejected))
+		})
+	}
+}
+
+func TestAwsClient_GetVpcEndpointServiceAllowedPrincipals(t *testing.T) {
+	g := gomega.NewWithT(t)
+	awsClient := &awsCl{
+		ec2Client: &ec2ClientMock{
+			DescribeVpcEndpointServicePermissionsFunc: func(input *ec2.DescribeVpcEndpointServicePermissionsInput) (*ec2.DescribeVpcEndpointServicePermissionsOutput, error) {
+				if input.NextToken == nil {
+					return &ec2.DescribeVpcEndpointServicePermissionsOutput{
+						AllowedPrincipals: []*ec2.AllowedPrincipal{{Principal: aws.String("arn:aws:iam::111111111111:root")}},
+						NextToken:         aws.String("next"),
+					}, nil
+				}
+				return &ec2.DescribeVpcEndpointServicePermissionsOutput{
+					AllowedPrincipals: []*ec2.AllowedPrincipal{{Principal: aws.String("arn:aws:iam::222222222222:root")}},
+				}, nil
+			},
+		},
+	}
+
+	got, err := awsClient.GetVpcEndpointServiceAllowedPrincipals(testValue)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(got).To(gomega.Equal([]string{"arn:aws:iam::111111111111:root", "arn:aws:iam::222222222222:root"}))
+}
+
+func TestAwsClient_ModifyVpcEndpointServiceAllowedPrincipals(t *testing.T) {
+	tests := []struct {
+		name      string
+		add       []string
+		remove    []string
+		wantCalls int
+	}{
+		{
+			name:      "Should add and remove the principals",
+			add:       []string{"arn:aws:iam::111111111111:root"},
+			remove:    []string{"arn:aws:iam::222222222222:root"},
+			wantCalls: 1,
+		},
+		{
+			name: "Should not call EC2 when there is nothing to change",
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			ec2Client := &ec2ClientMock{
+				ModifyVpcEndpointServicePermissionsFunc: func(input *ec2.ModifyVpcEndpointServicePermissionsInput) (*ec2.ModifyVpcEndpointServicePermissionsOutput, error) {
+					return &ec2.ModifyVpcEndpointServicePermissionsOutput{}, nil
+				},
+			}
+			awsClient := &awsCl{ec2Client: ec2Client}
+			err := awsClient.ModifyVpcEndpointServiceAllowedPrincipals(testValue, tt.add, tt.remove)
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			g.Expect(ec2Client.ModifyVpcEndpointServicePermissionsCalls()).To(gomega.HaveLen(tt.wantCalls))
+			if tt.wantCalls > 0 {
+				input := ec2Client.ModifyVpcEndpointServicePermissionsCalls()[0].Input
+				g.Expect(aws.StringValueSlice(input.AddAllowedPrincipals)).To(gomega.Equal(tt.add))
+				g.Expect(aws.StringValueSlice(input.RemoveAllowedPrincipals)).To(gomega.Equal(tt.remove))
+			}
+		})
+	}
+}
+
+func TestAwsClient_ListResourceARNsByTags(t *testing.T) {
+	tests := []struct {
+		name          string
+		taggingClient resourceTaggingClient
+		want          []string
+		wantErr       bool
+	}{
+		{
+			name: "Should return the ARNs of all the pages",
+			taggingClient: &resourceTaggingClientMock{
+				GetResourcesFunc: func(input *resourcegroupstaggingapi.GetResourcesInput) (*resourcegroupstaggingapi.GetResourcesOutput, error) {
+					if input.PaginationToken == nil {
+						return &resourcegroupstaggingapi.GetResourcesOutput{
+							ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{{ResourceARN: aws.String("arn-1")}},
+							PaginationToken:        aws.String("next"),
+						}, nil
+					}
+					return &resourcegroupstaggingapi.GetResourcesOutput{
+						ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{{ResourceARN: aws.String("arn-2")}},
+					}, nil
+				},
+			},
+			want: []string{"arn-1", "arn-2"},
+		},
+		{
+			name: "Should return an error when the resources cannot be listed",
+			taggingClient: &resourceTaggingClientMock{
+				GetResourcesFunc: func(input *resourcegroupstaggingapi.GetResourcesInput) (*resourcegroupstaggingapi.GetResourcesOutput, error) {
+					return nil, awserr.New("ThrottledException", "test", nil)
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			awsClient := &awsCl{taggingClient: tt.taggingClient}
+			got, err := awsClient.ListResourceARNsByTags([]string{"elasticloadbalancing:loadbalancer"}, map[string]string{"key": "value"})
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
@@ -0,0 +1,339 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"sync"
+)
+
+// Ensure, that ec2ClientMock does implement ec2Client.
+// If this is not the case, regenerate this file with moq.
+var _ ec2Client = &ec2ClientMock{}
+
+// ec2ClientMock is a mock implementation of ec2Client.
+//
+//	func TestSomethingThatUsesec2Client(t *testing.T) {
+//
+//		// make and configure a mocked ec2Client
+//		mockedec2Client := &ec2ClientMock{
+//			CreateVpcEndpointServiceConfigurationFunc: func(input *ec2.CreateVpcEndpointServiceConfigurationInput) (*ec2.CreateVpcEndpointServiceConfigurationOutput, error) {
+//				panic("mock out the CreateVpcEndpointServiceConfiguration method")
+//			},
+//			DeleteVpcEndpointServiceConfigurationsFunc: func(input *ec2.DeleteVpcEndpointServiceConfigurationsInput) (*ec2.DeleteVpcEndpointServiceConfigurationsOutput, error) {
+//				panic("mock out the DeleteVpcEndpointServiceConfigurations method")
+//			},
+//			DescribeVpcEndpointConnectionsFunc: func(input *ec2.DescribeVpcEndpointConnectionsInput) (*ec2.DescribeVpcEndpointConnectionsOutput, error) {
+//				panic("mock out the DescribeVpcEndpointConnections method")
+//			},
+//			DescribeVpcEndpointServiceConfigurationsFunc: func(input *ec2.DescribeVpcEndpointServiceConfigurationsInput) (*ec2.DescribeVpcEndpointServiceConfigurationsOutput, error) {
+//				panic("mock out the DescribeVpcEndpointServiceConfigurations method")
+//			},
+//			DescribeVpcEndpointServicePermissionsFunc: func(input *ec2.DescribeVpcEndpointServicePermissionsInput) (*ec2.DescribeVpcEndpointServicePermissionsOutput, error) {
+//				panic("mock out the DescribeVpcEndpointServicePermissions method")
+//			},
+//			ModifyVpcEndpointServicePermissionsFunc: func(input *ec2.ModifyVpcEndpointServicePermissionsInput) (*ec2.ModifyVpcEndpointServicePermissionsOutput, error) {
+//				panic("mock out the ModifyVpcEndpointServicePermissions method")
+//			},
+//			RejectVpcEndpointConnectionsFunc: func(input *ec2.RejectVpcEndpointConnectionsInput) (*ec2.RejectVpcEndpointConnectionsOutput, error) {
+//				panic("mock out the RejectVpcEndpointConnections method")
+//			},
+//		}
+//
+//		// use mockedec2Client in code that requires ec2Client
+//		// and then make assertions.
+//
+//	}
+type ec2ClientMock struct {
+	// CreateVpcEndpointServiceConfigurationFunc mocks the CreateVpcEndpointServiceConfiguration method.
+	CreateVpcEndpointServiceConfigurationFunc func(input *ec2.CreateVpcEndpointServiceConfigurationInput) (*ec2.CreateVpcEndpointServiceConfigurationOutput, error)
+
+	// DeleteVpcEndpointServiceConfigurationsFunc mocks the DeleteVpcEndpointServiceConfigurations method.
+	DeleteVpcEndpointServiceConfigurationsFunc func(input *ec2.DeleteVpcEndpointServiceConfigurationsInput) (*ec2.DeleteVpcEndpointServiceConfigurationsOutput, error)
+
+	// DescribeVpcEndpointConnectionsFunc mocks the DescribeVpcEndpointConnections method.
+	DescribeVpcEndpointConnectionsFunc func(input *ec2.DescribeVpcEndpointConnectionsInput) (*ec2.DescribeVpcEndpointConnectionsOutput, error)
+
+	// DescribeVpcEndpointServiceConfigurationsFunc mocks the DescribeVpcEndpointServiceConfigurations method.
+	DescribeVpcEndpointServiceConfigurationsFunc func(input *ec2.DescribeVpcEndpointServiceConfigurationsInput) (*ec2.DescribeVpcEndpointServiceConfigurationsOutput, error)
+
+	// DescribeVpcEndpointServicePermissionsFunc mocks the DescribeVpcEndpointServicePermissions method.
+	DescribeVpcEndpointServicePermissionsFunc func(input *ec2.DescribeVpcEndpointServicePermissionsInput) (*ec2.DescribeVpcEndpointServicePermissionsOutput, error)
+
+	// ModifyVpcEndpointServicePermissionsFunc mocks the ModifyVpcEndpointServicePermissions method.
+	ModifyVpcEndpointServicePermissionsFunc func(input *ec2.ModifyVpcEndpointServicePermissionsInput) (*ec2.ModifyVpcEndpointServicePermissionsOutput, error)
+
+	// RejectVpcEndpointConnectionsFunc mocks the RejectVpcEndpointConnections method.
+	RejectVpcEndpointConnectionsFunc func(input *ec2.RejectVpcEndpointConnectionsInput) (*ec2.RejectVpcEndpointConnectionsOutput, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateVpcEndpointServiceConfiguration holds details about calls to the CreateVpcEndpointServiceConfiguration method.
+		CreateVpcEndpointServiceConfiguration []struct {
+			// Input is the input argument value.
+			Input *ec2.CreateVpcEndpointServiceConfigurationInput
+		}
+		// DeleteVpcEndpointServiceConfigurations holds details about calls to the DeleteVpcEndpointServiceConfigurations method.
+		DeleteVpcEndpointServiceConfigurations []struct {
+			// Input is the input argument value.
+			Input *ec2.DeleteVpcEndpointServiceConfigurationsInput
+		}
+		// DescribeVpcEndpointConnections holds details about calls to the DescribeVpcEndpointConnections method.
+		DescribeVpcEndpointConnections []struct {
+			// Input is the input argument value.
+			Input *ec2.DescribeVpcEndpointConnectionsInput
+		}
+		// DescribeVpcEndpointServiceConfigurations holds details about calls to the DescribeVpcEndpointServiceConfigurations method.
+		DescribeVpcEndpointServiceConfigurations []struct {
+			// Input is the input argument value.
+			Input *ec2.DescribeVpcEndpointServiceConfigurationsInput
+		}
+		// DescribeVpcEndpointServicePermissions holds details about calls to the DescribeVpcEndpointServicePermissions method.
+		DescribeVpcEndpointServicePermissions []struct {
+			// Input is the input argument value.
+			Input *ec2.DescribeVpcEndpointServicePermissionsInput
+		}
+		// ModifyVpcEndpointServicePermissions holds details about calls to the ModifyVpcEndpointServicePermissions method.
+		ModifyVpcEndpointServicePermissions []struct {
+			// Input is the input argument value.
+			Input *ec2.ModifyVpcEndpointServicePermissionsInput
+		}
+		// RejectVpcEndpointConnections holds details about calls to the RejectVpcEndpointConnections method.
+		RejectVpcEndpointConnections []struct {
+			// Input is the input argument value.
+			Input *ec2.RejectVpcEndpointConnectionsInput
+		}
+	}
+	lockCreateVpcEndpointServiceConfiguration    sync.RWMutex
+	lockDeleteVpcEndpointServiceConfigurations   sync.RWMutex
+	lockDescribeVpcEndpointConnections           sync.RWMutex
+	lockDescribeVpcEndpointServiceConfigurations sync.RWMutex
+	lockDescribeVpcEndpointServicePermissions    sync.RWMutex
+	lockModifyVpcEndpointServicePermissions      sync.RWMutex
+	lockRejectVpcEndpointConnections             sync.RWMutex
+}
+
+// CreateVpcEndpointServiceConfiguration calls CreateVpcEndpointServiceConfigurationFunc.
+func (mock *ec2ClientMock) CreateVpcEndpointServiceConfiguration(input *ec2.CreateVpcEndpointServiceConfigurationInput) (*ec2.CreateVpcEndpointServiceConfigurationOutput, error) {
+	if mock.CreateVpcEndpointServiceConfigurationFunc == nil {
+		panic("ec2ClientMock.CreateVpcEndpointServiceConfigurationFunc: method is nil but ec2Client.CreateVpcEndpointServiceConfiguration was just called")
+	}
+	callInfo := struct {
+		Input *ec2.CreateVpcEndpointServiceConfigurationInput
+	}{
+		Input: input,
+	}
+	mock.lockCreateVpcEndpointServiceConfiguration.Lock()
+	mock.calls.CreateVpcEndpointServiceConfiguration = append(mock.calls.CreateVpcEndpointServiceConfiguration, callInfo)
+	mock.lockCreateVpcEndpointServiceConfiguration.Unlock()
+	return mock.CreateVpcEndpointServiceConfigurationFunc(input)
+}
+
+// CreateVpcEndpointServiceConfigurationCalls gets all the calls that were made to CreateVpcEndpointServiceConfiguration.
+// Check the length with:
+//
+//	len(mockedec2Client.CreateVpcEndpointServiceConfigurationCalls())
+func (mock *ec2ClientMock) CreateVpcEndpointServiceConfigurationCalls() []struct {
+	Input *ec2.CreateVpcEndpointServiceConfigurationInput
+} {
+	var calls []struct {
+		Input *ec2.CreateVpcEndpointServiceConfigurationInput
+	}
+	mock.lockCreateVpcEndpointServiceConfiguration.RLock()
+	calls = mock.calls.CreateVpcEndpointServiceConfiguration
+	mock.lockCreateVpcEndpointServiceConfiguration.RUnlock()
+	return calls
+}
+
+// DeleteVpcEndpointServiceConfigurations calls DeleteVpcEndpointServiceConfigurationsFunc.
+func (mock *ec2ClientMock) DeleteVpcEndpointServiceConfigurations(input *ec2.DeleteVpcEndpointServiceConfigurationsInput) (*ec2.DeleteVpcEndpointServiceConfigurationsOutput, error) {
+	if mock.DeleteVpcEndpointServiceConfigurationsFunc == nil {
+		panic("ec2ClientMock.DeleteVpcEndpointServiceConfigurationsFunc: method is nil but ec2Client.DeleteVpcEndpointServiceConfigurations was just called")
+	}
+	callInfo := struct {
+		Input *ec2.DeleteVpcEndpointServiceConfigurationsInput
+	}{
+		Input: input,
+	}
+	mock.lockDeleteVpcEndpointServiceConfigurations.Lock()
+	mock.calls.DeleteVpcEndpointServiceConfigurations = append(mock.calls.DeleteVpcEndpointServiceConfigurations, callInfo)
+	mock.lockDeleteVpcEndpointServiceConfigurations.Unlock()
+	return mock.DeleteVpcEndpointServiceConfigurationsFunc(input)
+}
+
+// DeleteVpcEndpointServiceConfigurationsCalls gets all the calls that were made to DeleteVpcEndpointServiceConfigurations.
+// Check the length with:
+//
+//	len(mockedec2Client.DeleteVpcEndpointServiceConfigurationsCalls())
+func (mock *ec2ClientMock) DeleteVpcEndpointServiceConfigurationsCalls() []struct {
+	Input *ec2.DeleteVpcEndpointServiceConfigurationsInput
+} {
+	var calls []struct {
+		Input *ec2.DeleteVpcEndpointServiceConfigurationsInput
+	}
+	mock.lockDeleteVpcEndpointServiceConfigurations.RLock()
+	calls = mock.calls.DeleteVpcEndpointServiceConfigurations
+	mock.lockDeleteVpcEndpointServiceConfigurations.RUnlock()
+	return calls
+}
+
+// DescribeVpcEndpointConnections calls DescribeVpcEndpointConnectionsFunc.
+func (mock *ec2ClientMock) DescribeVpcEndpointConnections(input *ec2.DescribeVpcEndpointConnectionsInput) (*ec2.DescribeVpcEndpointConnectionsOutput, error) {
+	if mock.DescribeVpcEndpointConnectionsFunc == nil {
+		panic("ec2ClientMock.DescribeVpcEndpointConnectionsFunc: method is nil but ec2Client.DescribeVpcEndpointConnections was just called")
+	}
+	callInfo := struct {
+		Input *ec2.DescribeVpcEndpointConnectionsInput
+	}{
+		Input: input,
+	}
+	mock.lockDescribeVpcEndpointConnections.Lock()
+	mock.calls.DescribeVpcEndpointConnections = append(mock.calls.DescribeVpcEndpointConnections, callInfo)
+	mock.lockDescribeVpcEndpointConnections.Unlock()
+	return mock.DescribeVpcEndpointConnectionsFunc(input)
+}
+
+// DescribeVpcEndpointConnectionsCalls gets all the calls that were made to DescribeVpcEndpointConnections.
+// Check the length with:
+//
+//	len(mockedec2Client.DescribeVpcEndpointConnectionsCalls())
+func (mock *ec2ClientMock) DescribeVpcEndpointConnectionsCalls() []struct {
+	Input *ec2.DescribeVpcEndpointConnectionsInput
+} {
+	var calls []struct {
+		Input *ec2.DescribeVpcEndpointConnectionsInput
+	}
+	mock.lockDescribeVpcEndpointConnections.RLock()
+	calls = mock.calls.DescribeVpcEndpointConnections
+	mock.lockDescribeVpcEndpointConnections.RUnlock()
+	return calls
+}
+
+// DescribeVpcEndpointServiceConfigurations calls DescribeVpcEndpointServiceConfigurationsFunc.
+func (mock *ec2ClientMock) DescribeVpcEndpointServiceConfigurations(input *ec2.DescribeVpcEndpointServiceConfigurationsInput) (*ec2.DescribeVpcEndpointServiceConfigurationsOutput, error) {
+	if mock.DescribeVpcEndpointServiceConfigurationsFunc == nil {
+		panic("ec2ClientMock.DescribeVpcEndpointServiceConfigurationsFunc: method is nil but ec2Client.DescribeVpcEndpointServiceConfigurations was just called")
+	}
+	callInfo := struct {
+		Input *ec2.DescribeVpcEndpointServiceConfigurationsInput
+	}{
+		Input: input,
+	}
+	mock.lockDescribeVpcEndpointServiceConfigurations.Lock()
+	mock.calls.DescribeVpcEndpointServiceConfigurations = append(mock.calls.DescribeVpcEndpointServiceConfigurations, callInfo)
+	mock.lockDescribeVpcEndpointServiceConfigurations.Unlock()
+	return mock.DescribeVpcEndpointServiceConfigurationsFunc(input)
+}
+
+// DescribeVpcEndpointServiceConfigurationsCalls gets all the calls that were made to DescribeVpcEndpointServiceConfigurations.
+// Check the length with:
+//
+//	len(mockedec2Client.DescribeVpcEndpointServiceConfigurationsCalls())
+func (mock *ec2ClientMock) DescribeVpcEndpointServiceConfigurationsCalls() []struct {
+	Input *ec2.DescribeVpcEndpointServiceConfigurationsInput
+} {
+	var calls []struct {
+		Input *ec2.DescribeVpcEndpointServiceConfigurationsInput
+	}
+	mock.lockDescribeVpcEndpointServiceConfigurations.RLock()
+	calls = mock.calls.DescribeVpcEndpointServiceConfigurations
+	mock.lockDescribeVpcEndpointServiceConfigurations.RUnlock()
+	return calls
+}
+
+// DescribeVpcEndpointServicePermissions calls DescribeVpcEndpointServicePermissionsFunc.
+func (mock *ec2ClientMock) DescribeVpcEndpointServicePermissions(input *ec2.DescribeVpcEndpointServicePermissionsInput) (*ec2.DescribeVpcEndpointServicePermissionsOutput, error) {
+	if mock.DescribeVpcEndpointServicePermissionsFunc == nil {
+		panic("ec2ClientMock.DescribeVpcEndpointServicePermissionsFunc: method is nil but ec2Client.DescribeVpcEndpointServicePermissions was just called")
+	}
+	callInfo := struct {
+		Input *ec2.DescribeVpcEndpointServicePermissionsInput
+	}{
+		Input: input,
+	}
+	mock.lockDescribeVpcEndpointServicePermissions.Lock()
+	mock.calls.DescribeVpcEndpointServicePermissions = append(mock.calls.DescribeVpcEndpointServicePermissions, callInfo)
+	mock.lockDescribeVpcEndpointServicePermissions.Unlock()
+	return mock.DescribeVpcEndpointServicePermissionsFunc(input)
+}
+
+// DescribeVpcEndpointServicePermissionsCalls gets all the calls that were made to DescribeVpcEndpointServicePermissions.
+// Check the length with:
+//
+//	len(mockedec2Client.DescribeVpcEndpointServicePermissionsCalls())
+func (mock *ec2ClientMock) DescribeVpcEndpointServicePermissionsCalls() []struct {
+	Input *ec2.DescribeVpcEndpointServicePermissionsInput
+} {
+	var calls []struct {
+		Input *ec2.DescribeVpcEndpointServicePermissionsInput
+	}
+	mock.lockDescribeVpcEndpointServicePermissions.RLock()
+	calls = mock.calls.DescribeVpcEndpointServicePermissions
+	mock.lockDescribeVpcEndpointServicePermissions.RUnlock()
+	return calls
+}
+
+// ModifyVpcEndpointServicePermissions calls ModifyVpcEndpointServicePermissionsFunc.
+func (mock *ec2ClientMock) ModifyVpcEndpointServicePermissions(input *ec2.ModifyVpcEndpointServicePermissionsInput) (*ec2.ModifyVpcEndpointServicePermissionsOutput, error) {
+	if mock.ModifyVpcEndpointServicePermissionsFunc == nil {
+		panic("ec2ClientMock.ModifyVpcEndpointServicePermissionsFunc: method is nil but ec2Client.ModifyVpcEndpointServicePermissions was just called")
+	}
+	callInfo := struct {
+		Input *ec2.ModifyVpcEndpointServicePermissionsInput
+	}{
+		Input: input,
+	}
+	mock.lockModifyVpcEndpointServicePermissions.Lock()
+	mock.calls.ModifyVpcEndpointServicePermissions = append(mock.calls.ModifyVpcEndpointServicePermissions, callInfo)
+	mock.lockModifyVpcEndpointServicePermissions.Unlock()
+	return mock.ModifyVpcEndpointServicePermissionsFunc(input)
+}
+
+// ModifyVpcEndpointServicePermissionsCalls gets all the calls that were made to ModifyVpcEndpointServicePermissions.
+// Check the length with:
+//
+//	len(mockedec2Client.ModifyVpcEndpointServicePermissionsCalls())
+func (mock *ec2ClientMock) ModifyVpcEndpointServicePermissionsCalls() []struct {
+	Input *ec2.ModifyVpcEndpointServicePermissionsInput
+} {
+	var calls []struct {
+		Input *ec2.ModifyVpcEndpointServicePermissionsInput
+	}
+	mock.lockModifyVpcEndpointServicePermissions.RLock()
+	calls = mock.calls.ModifyVpcEndpointServicePermissions
+	mock.lockModifyVpcEndpointServicePermissions.RUnlock()
+	return calls
+}
+
+// RejectVpcEndpointConnections calls RejectVpcEndpointConnectionsFunc.
+func (mock *ec2ClientMock) RejectVpcEndpointConnections(input *ec2.RejectVpcEndpointConnectionsInput) (*ec2.RejectVpcEndpointConnectionsOutput, error) {
+	if mock.RejectVpcEndpointConnectionsFunc == nil {
+		panic("ec2ClientMock.RejectVpcEndpointConnectionsFunc: method is nil but ec2Client.RejectVpcEndpointConnections was just called")
+	}
+	callInfo := struct {
+		Input *ec2.RejectVpcEndpointConnectionsInput
+	}{
+		Input: input,
+	}
+	mock.lockRejectVpcEndpointConnections.Lock()
+	mock.calls.RejectVpcEndpointConnections = append(mock.calls.RejectVpcEndpointConnections, callInfo)
+	mock.lockRejectVpcEndpointConnections.Unlock()
+	return mock.RejectVpcEndpointConnectionsFunc(input)
+}
+
+// RejectVpcEndpointConnectionsCalls gets all the calls that were made to RejectVpcEndpointConnections.
+// Check the length with:
+//
+//	len(mockedec2Client.RejectVpcEndpointConnectionsCalls())
+func (mock *ec2ClientMock) RejectVpcEndpointConnectionsCalls() []struct {
+	Input *ec2.RejectVpcEndpointConnectionsInput
+} {
+	var calls []struct {
+		Input *ec2.RejectVpcEndpointConnectionsInput
+	}
+	mock.lockRejectVpcEndpointConnections.RLock()
+	calls = mock.calls.RejectVpcEndpointConnections
+	mock.lockRejectVpcEndpointConnections.RUnlock()
+	return calls
+}
@@ -0,0 +1,75 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"sync"
+)
+
+// Ensure, that resourceTaggingClientMock does implement resourceTaggingClient.
+// If this is not the case, regenerate this file with moq.
+var _ resourceTaggingClient = &resourceTaggingClientMock{}
+
+// resourceTaggingClientMock is a mock implementation of resourceTaggingClient.
+//
+//	func TestSomethingThatUsesresourceTaggingClient(t *testing.T) {
+//
+//		// make and configure a mocked resourceTaggingClient
+//		mockedresourceTaggingClient := &resourceTaggingClientMock{
+//			GetResourcesFunc: func(input *resourcegroupstaggingapi.GetResourcesInput) (*resourcegroupstaggingapi.GetResourcesOutput, error) {
+//				panic("mock out the GetResources method")
+//			},
+//		}
+//
+//		// use mockedresourceTaggingClient in code that requires resourceTaggingClient
+//		// and then make assertions.
+//
+//	}
+type resourceTaggingClientMock struct {
+	// GetResourcesFunc mocks the GetResources method.
+	GetResourcesFunc func(input *resourcegroupstaggingapi.GetResourcesInput) (*resourcegroupstaggingapi.GetResourcesOutput, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetResources holds details about calls to the GetResources method.
+		GetResources []struct {
+			// Input is the input argument value.
+			Input *resourcegroupstaggingapi.GetResourcesInput
+		}
+	}
+	lockGetResources sync.RWMutex
+}
+
+// GetResources calls GetResourcesFunc.
+func (mock *resourceTaggingClientMock) GetResources(input *resourcegroupstaggingapi.GetResourcesInput) (*resourcegroupstaggingapi.GetResourcesOutput, error) {
+	if mock.GetResourcesFunc == nil {
+		panic("resourceTaggingClientMock.GetResourcesFunc: method is nil but resourceTaggingClient.GetResources was just called")
+	}
+	callInfo := struct {
+		Input *resourcegroupstaggingapi.GetResourcesInput
+	}{
+		Input: input,
+	}
+	mock.lockGetResources.Lock()
+	mock.calls.GetResources = append(mock.calls.GetResources, callInfo)
+	mock.lockGetResources.Unlock()
+	return mock.GetResourcesFunc(input)
+}
+
+// GetResourcesCalls gets all the calls that were made to GetResources.
+// Check the length with:
+//
+//	len(mockedresourceTaggingClient.GetResourcesCalls())
+func (mock *resourceTaggingClientMock) GetResourcesCalls() []struct {
+	Input *resourcegroupstaggingapi.GetResourcesInput
+} {
+	var calls []struct {
+		Input *resourcegroupstaggingapi.GetResourcesInput
+	}
+	mock.lockGetResources.RLock()
+	calls = mock.calls.GetResources
+	mock.lockGetResources.RUnlock()
+	return calls
+}
@@ -48,6 +48,11 @@ type Client interface {
 	Connection() *sdkClient.Connection
 	GetMachinePool(clusterID string, machinePoolID string) (*clustersmgmtv1.MachinePool, error)
 	CreateMachinePool(clusterID string, machinePool *clustersmgmtv1.MachinePool) (*clustersmgmtv1.MachinePool, error)
+	UpdateMachinePool(clusterID string, machinePool *clustersmgmtv1.MachinePool) (*clustersmgmtv1.MachinePool, error)
+	CreateUpgradePolicy(clusterID string, upgradePolicy *clustersmgmtv1.UpgradePolicy) (*clustersmgmtv1.UpgradePolicy, error)
+	// GetUpgradePolicyState returns the state of the upgrade policy or nil if OCM no longer knows about it
+	GetUpgradePolicyState(clusterID string, upgradePolicyID string) (*clustersmgmtv1.UpgradePolicyState, error)
+	DeleteUpgradePolicy(clusterID string, upgradePolicyID string) (int, error)
 	// GetQuotaCosts returns a list of quota cost for the given organizationID.
 	// Each quota cost contains information on the usage and max allowed ocm resources quota given to the specified oganization.
 	//
@@ -521,6 +526,60 @@ func (c *client) CreateMachinePool(clusterID string, machinePool *clustersmgmtv1
 	return createdMachinePool, nil
 }
 
+// UpdateMachinePool updates the MachinePool with the id of the provided MachinePool in OCM.
+// Only the attributes set in the provided MachinePool are updated. The updated MachinePool or an error is returned
+func (c *client) UpdateMachinePool(clusterID string, machinePool *clustersmgmtv1.MachinePool) (*clustersmgmtv1.MachinePool, error) {
+	machinePoolsClient := c.connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).MachinePools()
+	response, err := machinePoolsClient.MachinePool(machinePool.ID()).Update().Body(machinePool).Send()
+	if err != nil {
+		return nil, errors.New(errors.ErrorGeneral, err.Error())
+	}
+
+	return response.Body(), nil
+}
+
+// CreateUpgradePolicy creates the provided upgrade policy for the cluster in OCM.
+// The created upgrade policy or an error is returned
+func (c *client) CreateUpgradePolicy(clusterID string, upgradePolicy *clustersmgmtv1.UpgradePolicy) (*clustersmgmtv1.UpgradePolicy, error) {
+	upgradePoliciesClient := c.connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).UpgradePolicies()
+	response, err := upgradePoliciesClient.Add().Body(upgradePolicy).Send()
+	if err != nil {
+		return nil, errors.New(errors.ErrorGeneral, err.Error())
+	}
+
+	return response.Body(), nil
+}
+
+// GetUpgradePolicyState returns the state of an upgrade policy of the cluster.
+// OCM removes an upgrade policy once it has been executed, in which case nil is returned
+func (c *client) GetUpgradePolicyState(clusterID string, upgradePolicyID string) (*clustersmgmtv1.UpgradePolicyState, error) {
+	upgradePolicyClient := c.connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).UpgradePolicies().UpgradePolicy(upgradePolicyID)
+	resp, err := upgradePolicyClient.State().Get().Send()
+	if resp != nil && resp.Status() == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Body(), nil
+}
+
+func (c *client) DeleteUpgradePolicy(clusterID string, upgradePolicyID string) (int, error) {
+	upgradePolicyClient := c.connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).UpgradePolicies().UpgradePolicy(upgradePolicyID)
+	resp, err := upgradePolicyClient.Delete().Send()
+	if resp != nil && resp.Status() == http.StatusNotFound {
+		return http.StatusNotFound, nil
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	return resp.Status(), nil
+}
+
 // QuotaCostRelatedResourceFilter represents the properties of the related resource, associated
 // to each quota cost, that can be used to filter the result of the get quota costs request.
 // Any property set to nil will not be applied as a filter.
@@ -44,6 +44,9 @@ var _ Client = &ClientMock{}
 //			CreateSyncSetFunc: func(clusterID string, syncset *clustersmgmtv1.Syncset) (*clustersmgmtv1.Syncset, error) {
 //				panic("mock out the CreateSyncSet method")
 //			},
+//			CreateUpgradePolicyFunc: func(clusterID string, upgradePolicy *clustersmgmtv1.UpgradePolicy) (*clustersmgmtv1.UpgradePolicy, error) {
+//				panic("mock out the CreateUpgradePolicy method")
+//			},
 //			DeleteClusterFunc: func(clusterID string) (int, error) {
 //				panic("mock out the DeleteCluster method")
 //			},
@@ -53,6 +56,9 @@ var _ Client = &ClientMock{}
 //			DeleteSyncSetFunc: func(clusterID string, syncsetID string) (int, error) {
 //				panic("mock out the DeleteSyncSet method")
 //			},
+//			DeleteUpgradePolicyFunc: func(clusterID string, upgradePolicyID string) (int, error) {
+//				panic("mock out the DeleteUpgradePolicy method")
+//			},
 //			FindSubscriptionsFunc: func(query string) ([]*amsv1.Subscription, error) {
 //				panic("mock out the FindSubscriptions method")
 //			},
@@ -104,9 +110,15 @@ var _ Client = &ClientMock{}
 //			GetSyncSetFunc: func(clusterID string, syncSetID string) (*clustersmgmtv1.Syncset, error) {
 //				panic("mock out the GetSyncSet method")
 //			},
+//			GetUpgradePolicyStateFunc: func(clusterID string, upgradePolicyID string) (*clustersmgmtv1.UpgradePolicyState, error) {
+//				panic("mock out the GetUpgradePolicyState method")
+//			},
 //			UpdateAddonParametersFunc: func(clusterId string, addonId string, parameters []Parameter) (*clustersmgmtv1.AddOnInstallation, error) {
 //				panic("mock out the UpdateAddonParameters method")
 //			},
+//			UpdateMachinePoolFunc: func(clusterID string, machinePool *clustersmgmtv1.MachinePool) (*clustersmgmtv1.MachinePool, error) {
+//				panic("mock out the UpdateMachinePool method")
+//			},
 //			UpdateSyncSetFunc: func(clusterID string, syncSetID string, syncset *clustersmgmtv1.Syncset) (*clustersmgmtv1.Syncset, error) {
 //				panic("mock out the UpdateSyncSet method")
 //			},
@@ -141,6 +153,9 @@ type ClientMock struct {
 	// CreateSyncSetFunc mocks the CreateSyncSet method.
 	CreateSyncSetFunc func(clusterID string, syncset *clustersmgmtv1.Syncset) (*clustersmgmtv1.Syncset, error)
 
+	// CreateUpgradePolicyFunc mocks the CreateUpgradePolicy method.
+	CreateUpgradePolicyFunc func(clusterID string, upgradePolicy *clustersmgmtv1.UpgradePolicy) (*clustersmgmtv1.UpgradePolicy, error)
+
 	// DeleteClusterFunc mocks the DeleteCluster method.
 	DeleteClusterFunc func(clusterID string) (int, error)
 
@@ -150,6 +165,9 @@ type ClientMock struct {
 	// DeleteSyncSetFunc mocks the DeleteSyncSet method.
 	DeleteSyncSetFunc func(clusterID string, syncsetID string) (int, error)
 
+	// DeleteUpgradePolicyFunc mocks the DeleteUpgradePolicy method.
+	DeleteUpgradePolicyFunc func(clusterID string, upgradePolicyID string) (int, error)
+
 	// FindSubscriptionsFunc mocks the FindSubscriptions method.
 	FindSubscriptionsFunc func(query string) ([]*amsv1.Subscription, error)
 
@@ -201,9 +219,15 @@ type ClientMock struct {
 	// GetSyncSetFunc mocks the GetSyncSet method.
 	GetSyncSetFunc func(clusterID string, syncSetID string) (*clustersmgmtv1.Syncset, error)
 
+	// GetUpgradePolicyStateFunc mocks the GetUpgradePolicyState method.
+	GetUpgradePolicyStateFunc func(clusterID string, upgradePolicyID string) (*clustersmgmtv1.UpgradePolicyState, error)
+
 	// UpdateAddonParametersFunc mocks the UpdateAddonParameters method.
 	UpdateAddonParametersFunc func(clusterId string, addonId string, parameters []Parameter) (*clustersmgmtv1.AddOnInstallation, error)
 
+	// UpdateMachinePoolFunc mocks the UpdateMachinePool method.
+	UpdateMachinePoolFunc func(clusterID string, machinePool *clustersmgmtv1.MachinePool) (*clustersmgmtv1.MachinePool, error)
+
 	// UpdateSyncSetFunc mocks the UpdateSyncSet method.
 	UpdateSyncSetFunc func(clusterID string, syncSetID string, syncset *clustersmgmtv1.Syncset) (*clustersmgmtv1.Syncset, error)
 
@@ -259,6 +283,13 @@ type ClientMock struct {
 			// Syncset is the syncset argument value.
 			Syncset *clustersmgmtv1.Syncset
 		}
+		// CreateUpgradePolicy holds details about calls to the CreateUpgradePolicy method.
+		CreateUpgradePolicy []struct {
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// UpgradePolicy is the upgradePolicy argument value.
+			UpgradePolicy *clustersmgmtv1.UpgradePolicy
+		}
 		// DeleteCluster holds details about calls to the DeleteCluster method.
 		DeleteCluster []struct {
 			// ClusterID is the clusterID argument value.
@@ -276,6 +307,13 @@ type ClientMock struct {
 			// SyncsetID is the syncsetID argument value.
 			SyncsetID string
 		}
+		// DeleteUpgradePolicy holds details about calls to the DeleteUpgradePolicy method.
+		DeleteUpgradePolicy []struct {
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// UpgradePolicyID is the upgradePolicyID argument value.
+			UpgradePolicyID string
+		}
 		// FindSubscriptions holds details about calls to the FindSubscriptions method.
 		FindSubscriptions []struct {
 			// Query is the query argument value.
@@ -373,6 +411,13 @@ type ClientMock struct {
 			// SyncSetID is the syncSetID argument value.
 			SyncSetID string
 		}
+		// GetUpgradePolicyState holds details about calls to the GetUpgradePolicyState method.
+		GetUpgradePolicyState []struct {
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// UpgradePolicyID is the upgradePolicyID argument value.
+			UpgradePolicyID string
+		}
 		// UpdateAddonParameters holds details about calls to the UpdateAddonParameters method.
 		UpdateAddonParameters []struct {
 			// ClusterId is the clusterId argument value.
@@ -382,6 +427,13 @@ type ClientMock struct {
 			// Parameters is the parameters argument value.
 			Parameters []Parameter
 		}
+		// UpdateMachinePool holds details about calls to the UpdateMachinePool method.
+		UpdateMachinePool []struct {
+			// ClusterID is the clusterID argument value.
+			ClusterID string
+			// MachinePool is the machinePool argument value.
+			MachinePool *clustersmgmtv1.MachinePool
+		}
 		// UpdateSyncSet holds details about calls to the UpdateSyncSet method.
 		UpdateSyncSet []struct {
 			// ClusterID is the clusterID argument value.
@@ -400,9 +452,11 @@ type ClientMock struct {
 	lockCreateIdentityProvider          sync.RWMutex
 	lockCreateMachinePool               sync.RWMutex
 	lockCreateSyncSet                   sync.RWMutex
+	lockCreateUpgradePolicy             sync.RWMutex
 	lockDeleteCluster                   sync.RWMutex
 	lockDeleteSubscription              sync.RWMutex
 	lockDeleteSyncSet                   sync.RWMutex
+	lockDeleteUpgradePolicy             sync.RWMutex
 	lockFindSubscriptions               sync.RWMutex
 	lockGetAddon                        sync.RWMutex
 	lockGetCloudProviders               sync.RWMutex
@@ -420,7 +474,9 @@ type ClientMock struct {
 	lockGetRequiresTermsAcceptance      sync.RWMutex
 	lockGetSubscriptionByID             sync.RWMutex
 	lockGetSyncSet                      sync.RWMutex
+	lockGetUpgradePolicyState           sync.RWMutex
 	lockUpdateAddonParameters           sync.RWMutex
+	lockUpdateMachinePool               sync.RWMutex
 	lockUpdateSyncSet                   sync.RWMutex
 }
 
@@ -699,6 +755,42 @@ func (mock *ClientMock) CreateSyncSetCalls() []struct {
 	return calls
 }
 
+// CreateUpgradePolicy calls CreateUpgradePolicyFunc.
+func (mock *ClientMock) CreateUpgradePolicy(clusterID string, upgradePolicy *clustersmgmtv1.UpgradePolicy) (*clustersmgmtv1.UpgradePolicy, error) {
+	if mock.CreateUpgradePolicyFunc == nil {
+		panic("ClientMock.CreateUpgradePolicyFunc: method is nil but Client.CreateUpgradePolicy was just called")
+	}
+	callInfo := struct {
+		ClusterID     string
+		UpgradePolicy *clustersmgmtv1.UpgradePolicy
+	}{
+		ClusterID:     clusterID,
+		UpgradePolicy: upgradePolicy,
+	}
+	mock.lockCreateUpgradePolicy.Lock()
+	mock.calls.CreateUpgradePolicy = append(mock.calls.CreateUpgradePolicy, callInfo)
+	mock.lockCreateUpgradePolicy.Unlock()
+	return mock.CreateUpgradePolicyFunc(clusterID, upgradePolicy)
+}
+
+// CreateUpgradePolicyCalls gets all the calls that were made to CreateUpgradePolicy.
+// Check the length with:
+//
+//	len(mockedClient.CreateUpgradePolicyCalls())
+func (mock *ClientMock) CreateUpgradePolicyCalls() []struct {
+	ClusterID     string
+	UpgradePolicy *clustersmgmtv1.UpgradePolicy
+} {
+	var calls []struct {
+		ClusterID     string
+		UpgradePolicy *clustersmgmtv1.UpgradePolicy
+	}
+	mock.lockCreateUpgradePolicy.RLock()
+	calls = mock.calls.CreateUpgradePolicy
+	mock.lockCreateUpgradePolicy.RUnlock()
+	return calls
+}
+
 // DeleteCluster calls DeleteClusterFunc.
 func (mock *ClientMock) DeleteCluster(clusterID string) (int, error) {
 	if mock.DeleteClusterFunc == nil {
@@ -799,6 +891,42 @@ func (mock *ClientMock) DeleteSyncSetCalls() []struct {
 	return calls
 }
 
+// DeleteUpgradePolicy calls DeleteUpgradePolicyFunc.
+func (mock *ClientMock) DeleteUpgradePolicy(clusterID string, upgradePolicyID string) (int, error) {
+	if mock.DeleteUpgradePolicyFunc == nil {
+		panic("ClientMock.DeleteUpgradePolicyFunc: method is nil but Client.DeleteUpgradePolicy was just called")
+	}
+	callInfo := struct {
+		ClusterID       string
+		UpgradePolicyID string
+	}{
+		ClusterID:       clusterID,
+		UpgradePolicyID: upgradePolicyID,
+	}
+	mock.lockDeleteUpgradePolicy.Lock()
+	mock.calls.DeleteUpgradePolicy = append(mock.calls.DeleteUpgradePolicy, callInfo)
+	mock.lockDeleteUpgradePolicy.Unlock()
+	return mock.DeleteUpgradePolicyFunc(clusterID, upgradePolicyID)
+}
+
+// DeleteUpgradePolicyCalls gets all the calls that were made to DeleteUpgradePolicy.
+// Check the length with:
+//
+//	len(mockedClient.DeleteUpgradePolicyCalls())
+func (mock *ClientMock) DeleteUpgradePolicyCalls() []struct {
+	ClusterID       string
+	UpgradePolicyID string
+} {
+	var calls []struct {
+		ClusterID       string
+		UpgradePolicyID string
+	}
+	mock.lockDeleteUpgradePolicy.RLock()
+	calls = mock.calls.DeleteUpgradePolicy
+	mock.lockDeleteUpgradePolicy.RUnlock()
+	return calls
+}
+
 // FindSubscriptions calls FindSubscriptionsFunc.
 func (mock *ClientMock) FindSubscriptions(query string) ([]*amsv1.Subscription, error) {
 	if mock.FindSubscriptionsFunc == nil {
@@ -1365,6 +1493,42 @@ func (mock *ClientMock) GetSyncSetCalls() []struct {
 	return calls
 }
 
+// GetUpgradePolicyState calls GetUpgradePolicyStateFunc.
+func (mock *ClientMock) GetUpgradePolicyState(clusterID string, upgradePolicyID string) (*clustersmgmtv1.UpgradePolicyState, error) {
+	if mock.GetUpgradePolicyStateFunc == nil {
+		panic("ClientMock.GetUpgradePolicyStateFunc: method is nil but Client.GetUpgradePolicyState was just called")
+	}
+	callInfo := struct {
+		ClusterID       string
+		UpgradePolicyID string
+	}{
+		ClusterID:       clusterID,
+		UpgradePolicyID: upgradePolicyID,
+	}
+	mock.lockGetUpgradePolicyState.Lock()
+	mock.calls.GetUpgradePolicyState = append(mock.calls.GetUpgradePolicyState, callInfo)
+	mock.lockGetUpgradePolicyState.Unlock()
+	return mock.GetUpgradePolicyStateFunc(clusterID, upgradePolicyID)
+}
+
+// GetUpgradePolicyStateCalls gets all the calls that were made to GetUpgradePolicyState.
+// Check the length with:
+//
+//	len(mockedClient.GetUpgradePolicyStateCalls())
+func (mock *ClientMock) GetUpgradePolicyStateCalls() []struct {
+	ClusterID       string
+	UpgradePolicyID string
+} {
+	var calls []struct {
+		ClusterID       string
+		UpgradePolicyID string
+	}
+	mock.lockGetUpgradePolicyState.RLock()
+	calls = mock.calls.GetUpgradePolicyState
+	mock.lockGetUpgradePolicyState.RUnlock()
+	return calls
+}
+
 // UpdateAddonParameters calls UpdateAddonParametersFunc.
 func (mock *ClientMock) UpdateAddonParameters(clusterId string, addonId string, parameters []Parameter) (*clustersmgmtv1.AddOnInstallation, error) {
 	if mock.UpdateAddonParametersFunc == nil {
@@ -1405,6 +1569,42 @@ func (mock *ClientMock) UpdateAddonParametersCalls() []struct {
 	return calls
 }
 
+// UpdateMachinePool calls UpdateMachinePoolFunc.
+func (mock *ClientMock) UpdateMachinePool(clusterID string, machinePool *clustersmgmtv1.MachinePool) (*clustersmgmtv1.MachinePool, error) {
+	if mock.UpdateMachinePoolFunc == nil {
+		panic("ClientMock.UpdateMachinePoolFunc: method is nil but Client.UpdateMachinePool was just called")
+	}
+	callInfo := struct {
+		ClusterID   string
+		MachinePool *clustersmgmtv1.MachinePool
+	}{
+		ClusterID:   clusterID,
+		MachinePool: machinePool,
+	}
+	mock.lockUpdateMachinePool.Lock()
+	mock.calls.UpdateMachinePool = append(mock.calls.UpdateMachinePool, callInfo)
+	mock.lockUpdateMachinePool.Unlock()
+	return mock.UpdateMachinePoolFunc(clusterID, machinePool)
+}
+
+// UpdateMachinePoolCalls gets all the calls that were made to UpdateMachinePool.
+// Check the length with:
+//
+//	len(mockedClient.UpdateMachinePoolCalls())
+func (mock *ClientMock) UpdateMachinePoolCalls() []struct {
+	ClusterID   string
+	MachinePool *clustersmgmtv1.MachinePool
+} {
+	var calls []struct {
+		ClusterID   string
+		MachinePool *clustersmgmtv1.MachinePool
+	}
+	mock.lockUpdateMachinePool.RLock()
+	calls = mock.calls.UpdateMachinePool
+	mock.lockUpdateMachinePool.RUnlock()
+	return calls
+}
+
 // UpdateSyncSet calls UpdateSyncSetFunc.
 func (mock *ClientMock) UpdateSyncSet(clusterID string, syncSetID string, syncset *clustersmgmtv1.Syncset) (*clustersmgmtv1.Syncset, error) {
 	if mock.UpdateSyncSetFunc == nil {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
 )
 
@@ -203,6 +204,11 @@ func HandleList(w http.ResponseWriter, r *http.Request, cfg *HandlerConfig) {
 			}
 		}
 	} else {
+		results, err := SelectListFields(results, services.NewListArguments(r.URL.Query()).Fields)
+		if err != nil {
+			errorHandler(r, w, cfg, errors.GeneralError("unable to select the fields of the list: %v", err))
+			return
+		}
 		shared.WriteJSONResponse(w, http.StatusOK, results)
 	}
 	success(r)
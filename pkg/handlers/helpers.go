@@ -1,9 +1,15 @@
 package handlers
 
 import (
+	"encoding/json"
 	"reflect"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
 )
 
+// item properties that are always returned when selecting the fields of a list
+var alwaysSelectedFields = []string{"id", "kind", "href"}
+
 // Prepare a 'list' of non-db-backed resources
 func DetermineListRange(obj interface{}, page int, size int) (list []interface{}, total int) {
 	items := reflect.ValueOf(obj)
@@ -20,3 +26,42 @@ func DetermineListRange(obj interface{}, page int, size int) (list []interface{}
 
 	return list, total
 }
+
+// SelectListFields restricts the items of a list response to the given fields.
+// The id, kind and href of the items are always kept. The list is returned unchanged when no fields are given
+func SelectListFields(list interface{}, fields []string) (interface{}, error) {
+	if len(fields) == 0 {
+		return list, nil
+	}
+
+	b, err := json.Marshal(list)
+	if err != nil {
+		return nil, err
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal(b, &result); err != nil {
+		return nil, err
+	}
+
+	items, ok := result["items"].([]interface{})
+	if !ok {
+		return list, nil
+	}
+
+	for i, item := range items {
+		properties, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		selected := map[string]interface{}{}
+		for name, value := range properties {
+			if arrays.Contains(fields, name) || arrays.Contains(alwaysSelectedFields, name) {
+				selected[name] = value
+			}
+		}
+		items[i] = selected
+	}
+
+	return result, nil
+}
@@ -49,3 +49,67 @@ func Test_DetermineListRange(t *testing.T) {
 		})
 	}
 }
+
+func Test_SelectListFields(t *testing.T) {
+	type item struct {
+		Id     string `json:"id"`
+		Kind   string `json:"kind"`
+		Name   string `json:"name"`
+		Status string `json:"status"`
+	}
+	type list struct {
+		Kind  string `json:"kind"`
+		Total int    `json:"total"`
+		Items []item `json:"items"`
+	}
+	testList := list{
+		Kind:  "TestList",
+		Total: 1,
+		Items: []item{{Id: "id", Kind: "Test", Name: "name", Status: "ready"}},
+	}
+
+	tests := []struct {
+		name   string
+		fields []string
+		want   interface{}
+	}{
+		{
+			name:   "should return the list unchanged when no fields are given",
+			fields: nil,
+			want:   testList,
+		},
+		{
+			name:   "should only keep the selected fields together with the id and kind of the items",
+			fields: []string{"name"},
+			want: map[string]interface{}{
+				"kind":  "TestList",
+				"total": float64(1),
+				"items": []interface{}{
+					map[string]interface{}{"id": "id", "kind": "Test", "name": "name"},
+				},
+			},
+		},
+		{
+			name:   "should ignore unknown fields",
+			fields: []string{"unknown"},
+			want: map[string]interface{}{
+				"kind":  "TestList",
+				"total": float64(1),
+				"items": []interface{}{
+					map[string]interface{}{"id": "id", "kind": "Test"},
+				},
+			},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			got, err := SelectListFields(testList, tt.fields)
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+type IdempotencyConfig struct {
+	// KeyRetention is the time for which the response of a request is replayed when it is sent again with the same idempotency key
+	KeyRetention time.Duration `json:"idempotency_key_retention"`
+	// PendingKeyLease is the time for which a key is locked while its request is processed. A key whose request
+	// didn't store its response within the lease, e.g. because the process died, can be used again
+	PendingKeyLease time.Duration `json:"idempotency_pending_key_lease"`
+}
+
+func NewIdempotencyConfig() *IdempotencyConfig {
+	return &IdempotencyConfig{
+		KeyRetention:    24 * time.Hour,
+		PendingKeyLease: time.Minute,
+	}
+}
+
+func (c *IdempotencyConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.DurationVar(&c.KeyRetention, "idempotency-key-retention", c.KeyRetention, "The time for which idempotency keys and the responses of their requests are retained.")
+	fs.DurationVar(&c.PendingKeyLease, "idempotency-pending-key-lease", c.PendingKeyLease, "The time for which an idempotency key is locked while its request is processed, after which it can be used again if the request did not complete.")
+}
+
+func (c *IdempotencyConfig) ReadFiles() error {
+	return nil
+}
@@ -0,0 +1,217 @@
+package handlers
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// IdempotencyKeyHeader is the request header holding the client generated idempotency key
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader is set on responses which are replayed from a previous request
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 255
+)
+
+// IdempotencyMiddleware makes the requests sent with an Idempotency-Key header idempotent.
+//
+// The response of the first request sent with a given key is persisted together with a hash of the request for the
+// configured retention window. Sending the same request again with the same key replays the persisted response
+// without calling the wrapped handler, while reusing the key for a different request results in a conflict error.
+// Keys are scoped to the user sending the request. Error responses are not persisted,
+// so that a failed request can be retried with the same key. A key is locked for a short lease while its request is
+// processed, so that a key whose request died before storing its response can be used again.
+// Expired keys are purged by the IdempotencyKeyManager worker
+type IdempotencyMiddleware struct {
+	connectionFactory *db.ConnectionFactory
+	config            *IdempotencyConfig
+}
+
+func NewIdempotencyMiddleware(connectionFactory *db.ConnectionFactory, config *IdempotencyConfig) *IdempotencyMiddleware {
+	return &IdempotencyMiddleware{
+		connectionFactory: connectionFactory,
+		config:            config,
+	}
+}
+
+func (m *IdempotencyMiddleware) Idempotent(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(IdempotencyKeyHeader)
+		if key == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if len(key) > maxIdempotencyKeyLength {
+			shared.HandleError(r, w, errors.BadRequest("%s header must not be longer than %d characters", IdempotencyKeyHeader, maxIdempotencyKeyLength))
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			shared.HandleError(r, w, errors.NewWithCause(errors.ErrorBadRequest, err, "unable to read request body"))
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		owner := idempotencyKeyOwner(r)
+		requestHash := hashIdempotentRequest(r, body)
+
+		// the idempotency key is stored outside of the request transaction so that concurrent requests can see it
+		dbConn := m.connectionFactory.New()
+		now := time.Now()
+
+		var existing api.IdempotencyKey
+		err = dbConn.Where("owner = ? AND key = ?", owner, key).First(&existing).Error
+		switch {
+		case err == nil && !existing.IsStale(now):
+			m.replay(w, r, &existing, requestHash)
+			return
+		case err == nil:
+			// the stale copy of the key is released, unless a concurrent request has taken it over in the meantime
+			if err := dbConn.Where("id = ? AND (expires_at <= ? OR (response_status = 0 AND locked_until <= ?))", existing.ID, now, now).
+				Delete(&api.IdempotencyKey{}).Error; err != nil {
+				shared.HandleError(r, w, errors.NewWithCause(errors.ErrorGeneral, err, "unable to release stale idempotency key"))
+				return
+			}
+		case err != gorm.ErrRecordNotFound:
+			shared.HandleError(r, w, errors.NewWithCause(errors.ErrorGeneral, err, "unable to find idempotency key"))
+			return
+		}
+
+		idempotencyKey := &api.IdempotencyKey{
+			Owner:       owner,
+			Key:         key,
+			RequestHash: requestHash,
+			CreatedAt:   now,
+			ExpiresAt:   now.Add(m.config.KeyRetention),
+			LockedUntil: now.Add(m.config.PendingKeyLease),
+		}
+		result := dbConn.Clauses(clause.OnConflict{DoNothing: true}).Create(idempotencyKey)
+		if result.Error != nil {
+			shared.HandleError(r, w, errors.NewWithCause(errors.ErrorGeneral, result.Error, "unable to store idempotency key"))
+			return
+		}
+		if result.RowsAffected == 0 {
+			// a concurrent request stored the same key in the meantime
+			shared.HandleError(r, w, errors.Conflict("a request with the same %s is already being processed", IdempotencyKeyHeader))
+			return
+		}
+
+		ulog := logger.NewUHCLogger(r.Context())
+		recorder := &idempotentResponseRecorder{wrapped: w}
+		defer func() {
+			// a panicking handler doesn't respond, the key is released so that the request can be retried
+			if rec := recover(); rec != nil {
+				m.release(ulog, dbConn, idempotencyKey)
+				panic(rec)
+			}
+		}()
+		next.ServeHTTP(recorder, r)
+
+		if recorder.status() >= http.StatusBadRequest {
+			// nothing was created, the key is released so that the request can be retried
+			m.release(ulog, dbConn, idempotencyKey)
+			return
+		}
+
+		// a key whose response can't be stored is used again once its lease ends
+		err = dbConn.Model(idempotencyKey).Updates(map[string]interface{}{
+			"response_status":       recorder.status(),
+			"response_content_type": w.Header().Get("Content-Type"),
+			"response_body":         recorder.body.Bytes(),
+		}).Error
+		if err != nil {
+			ulog.Errorf("unable to store the response of idempotency key %q: %v", idempotencyKey.ID, err)
+		}
+	})
+}
+
+func (m *IdempotencyMiddleware) release(ulog logger.UHCLogger, dbConn *gorm.DB, idempotencyKey *api.IdempotencyKey) {
+	if err := dbConn.Delete(idempotencyKey).Error; err != nil {
+		ulog.Errorf("unable to delete idempotency key %q: %v", idempotencyKey.ID, err)
+	}
+}
+
+func (m *IdempotencyMiddleware) replay(w http.ResponseWriter, r *http.Request, idempotencyKey *api.IdempotencyKey, requestHash string) {
+	if idempotencyKey.RequestHash != requestHash {
+		shared.HandleError(r, w, errors.Conflict("%s was already used for a different request", IdempotencyKeyHeader))
+		return
+	}
+	if idempotencyKey.IsPending() {
+		shared.HandleError(r, w, errors.Conflict("a request with the same %s is already being processed", IdempotencyKeyHeader))
+		return
+	}
+
+	if idempotencyKey.ResponseContentType != "" {
+		w.Header().Set("Content-Type", idempotencyKey.ResponseContentType)
+	}
+	w.Header().Set(IdempotentReplayedHeader, "true")
+	w.WriteHeader(idempotencyKey.ResponseStatus)
+	_, _ = w.Write(idempotencyKey.ResponseBody)
+}
+
+// idempotencyKeyOwner returns the user the idempotency keys of the request belong to
+func idempotencyKeyOwner(r *http.Request) string {
+	claims, err := auth.GetClaimsFromContext(r.Context())
+	if err != nil {
+		return ""
+	}
+	orgID, _ := claims.GetOrgId()
+	username, _ := claims.GetUsername()
+	return orgID + "/" + username
+}
+
+func hashIdempotentRequest(r *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(r.Method + "\n" + r.URL.Path + "\n"))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// idempotentResponseRecorder writes the response through to the wrapped writer while keeping a copy of it
+type idempotentResponseRecorder struct {
+	wrapped    http.ResponseWriter
+	statusCode int
+	body       bytes.Buffer
+}
+
+func (rr *idempotentResponseRecorder) Header() http.Header {
+	return rr.wrapped.Header()
+}
+
+func (rr *idempotentResponseRecorder) WriteHeader(code int) {
+	if rr.statusCode == 0 {
+		rr.statusCode = code
+	}
+	rr.wrapped.WriteHeader(code)
+}
+
+func (rr *idempotentResponseRecorder) Write(b []byte) (int, error) {
+	if rr.statusCode == 0 {
+		rr.statusCode = http.StatusOK
+	}
+	rr.body.Write(b)
+	return rr.wrapped.Write(b)
+}
+
+func (rr *idempotentResponseRecorder) status() int {
+	if rr.statusCode == 0 {
+		return http.StatusOK
+	}
+	return rr.statusCode
+}
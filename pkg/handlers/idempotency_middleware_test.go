@@ -0,0 +1,237 @@
+package handlers
+
+import (
+	"database/sql/driver"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+const (
+	idempotencyTestPath = "/api/kafkas_mgmt/v1/kafkas"
+	idempotencyTestBody = `{"name":"test"}`
+)
+
+func idempotencyTestRequestHash() string {
+	return hashIdempotentRequest(httptest.NewRequest(http.MethodPost, idempotencyTestPath, nil), []byte(idempotencyTestBody))
+}
+
+func TestIdempotencyMiddleware_Idempotent(t *testing.T) {
+	type result struct {
+		handlerCalled bool
+		status        int
+		body          string
+		replayed      bool
+		stored        bool
+		released      bool
+	}
+
+	tests := []struct {
+		name          string
+		key           string
+		handlerStatus int
+		handlerPanics bool
+		setupFn       func(stored *bool, released *bool)
+		want          result
+	}{
+		{
+			name:          "should call the handler when no idempotency key is provided",
+			key:           "",
+			handlerStatus: http.StatusAccepted,
+			setupFn: func(stored *bool, released *bool) {
+				mocket.Catcher.Reset()
+			},
+			want: result{handlerCalled: true, status: http.StatusAccepted, body: "created"},
+		},
+		{
+			name: "should return a bad request error when the idempotency key is too long",
+			key:  strings.Repeat("a", maxIdempotencyKeyLength+1),
+			setupFn: func(stored *bool, released *bool) {
+				mocket.Catcher.Reset()
+			},
+			want: result{status: http.StatusBadRequest},
+		},
+		{
+			name:          "should store the response of the first request sent with an idempotency key",
+			key:           "key",
+			handlerStatus: http.StatusAccepted,
+			setupFn: func(stored *bool, released *bool) {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "idempotency_keys"`).WithReply(nil)
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "idempotency_keys" SET`).WithCallback(func(s string, nv []driver.NamedValue) {
+					*stored = true
+				})
+			},
+			want: result{handlerCalled: true, status: http.StatusAccepted, body: "created", stored: true},
+		},
+		{
+			name:          "should release the idempotency key when the request fails",
+			key:           "key",
+			handlerStatus: http.StatusBadRequest,
+			setupFn: func(stored *bool, released *bool) {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "idempotency_keys"`).WithReply(nil)
+				mocket.Catcher.NewMock().WithQuery(`DELETE FROM "idempotency_keys" WHERE "idempotency_keys"."id" = $1`).WithCallback(func(s string, nv []driver.NamedValue) {
+					*released = true
+				})
+			},
+			want: result{handlerCalled: true, status: http.StatusBadRequest, body: "created", released: true},
+		},
+		{
+			name: "should replay the stored response when the same request is sent again",
+			key:  "key",
+			setupFn: func(stored *bool, released *bool) {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "idempotency_keys"`).WithReply([]map[string]interface{}{{
+					"id":                    "id",
+					"key":                   "key",
+					"request_hash":          idempotencyTestRequestHash(),
+					"response_status":       http.StatusAccepted,
+					"response_content_type": "application/json",
+					"response_body":         []byte("created"),
+					"expires_at":            time.Now().Add(time.Hour),
+				}})
+			},
+			want: result{status: http.StatusAccepted, body: "created", replayed: true},
+		},
+		{
+			name: "should return a conflict error when the idempotency key is reused for a different request",
+			key:  "key",
+			setupFn: func(stored *bool, released *bool) {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "idempotency_keys"`).WithReply([]map[string]interface{}{{
+					"id":              "id",
+					"key":             "key",
+					"request_hash":    "another-hash",
+					"response_status": http.StatusAccepted,
+					"expires_at":      time.Now().Add(time.Hour),
+				}})
+			},
+			want: result{status: http.StatusConflict},
+		},
+		{
+			name: "should return a conflict error when the request of the idempotency key is still being processed",
+			key:  "key",
+			setupFn: func(stored *bool, released *bool) {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "idempotency_keys"`).WithReply([]map[string]interface{}{{
+					"id":           "id",
+					"key":          "key",
+					"request_hash": idempotencyTestRequestHash(),
+					"expires_at":   time.Now().Add(time.Hour),
+					"locked_until": time.Now().Add(time.Minute),
+				}})
+			},
+			want: result{status: http.StatusConflict},
+		},
+		{
+			name:          "should process the request again when the lease of the pending idempotency key has ended",
+			key:           "key",
+			handlerStatus: http.StatusAccepted,
+			setupFn: func(stored *bool, released *bool) {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "idempotency_keys"`).WithReply([]map[string]interface{}{{
+					"id":           "id",
+					"key":          "key",
+					"request_hash": idempotencyTestRequestHash(),
+					"expires_at":   time.Now().Add(time.Hour),
+					"locked_until": time.Now().Add(-time.Minute),
+				}})
+				mocket.Catcher.NewMock().WithQuery(`DELETE FROM "idempotency_keys" WHERE id = $1 AND (expires_at <= $2 OR (response_status = 0 AND locked_until <= $3))`).WithCallback(func(s string, nv []driver.NamedValue) {
+					*released = true
+				})
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "idempotency_keys" SET`).WithCallback(func(s string, nv []driver.NamedValue) {
+					*stored = true
+				})
+			},
+			want: result{handlerCalled: true, status: http.StatusAccepted, body: "created", stored: true, released: true},
+		},
+		{
+			name:          "should process the request again when the idempotency key has expired",
+			key:           "key",
+			handlerStatus: http.StatusAccepted,
+			setupFn: func(stored *bool, released *bool) {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "idempotency_keys"`).WithReply([]map[string]interface{}{{
+					"id":              "id",
+					"key":             "key",
+					"request_hash":    "another-hash",
+					"response_status": http.StatusAccepted,
+					"expires_at":      time.Now().Add(-time.Minute),
+				}})
+				mocket.Catcher.NewMock().WithQuery(`DELETE FROM "idempotency_keys" WHERE id = $1 AND (expires_at <= $2 OR (response_status = 0 AND locked_until <= $3))`).WithCallback(func(s string, nv []driver.NamedValue) {
+					*released = true
+				})
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "idempotency_keys" SET`).WithCallback(func(s string, nv []driver.NamedValue) {
+					*stored = true
+				})
+			},
+			want: result{handlerCalled: true, status: http.StatusAccepted, body: "created", stored: true, released: true},
+		},
+		{
+			name:          "should release the idempotency key when the handler panics",
+			key:           "key",
+			handlerPanics: true,
+			setupFn: func(stored *bool, released *bool) {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "idempotency_keys"`).WithReply(nil)
+				mocket.Catcher.NewMock().WithQuery(`DELETE FROM "idempotency_keys" WHERE "idempotency_keys"."id" = $1`).WithCallback(func(s string, nv []driver.NamedValue) {
+					*released = true
+				})
+			},
+			want: result{handlerCalled: true, status: http.StatusOK, released: true},
+		},
+		{
+			name: "should return an error when the idempotency key cannot be found",
+			key:  "key",
+			setupFn: func(stored *bool, released *bool) {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "idempotency_keys"`).WithQueryException()
+			},
+			want: result{status: http.StatusInternalServerError},
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			got := result{}
+			tt.setupFn(&got.stored, &got.released)
+
+			middleware := NewIdempotencyMiddleware(db.NewMockConnectionFactory(nil), NewIdempotencyConfig())
+			handler := middleware.Idempotent(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got.handlerCalled = true
+				if tt.handlerPanics {
+					panic("handler failed")
+				}
+				w.WriteHeader(tt.handlerStatus)
+				_, _ = w.Write([]byte("created"))
+			}))
+
+			req := httptest.NewRequest(http.MethodPost, idempotencyTestPath, strings.NewReader(idempotencyTestBody))
+			if tt.key != "" {
+				req.Header.Set(IdempotencyKeyHeader, tt.key)
+			}
+			rw := httptest.NewRecorder()
+			if tt.handlerPanics {
+				g.Expect(func() { handler.ServeHTTP(rw, req) }).To(gomega.Panic())
+			} else {
+				handler.ServeHTTP(rw, req)
+			}
+
+			got.status = rw.Code
+			got.replayed = rw.Header().Get(IdempotentReplayedHeader) == "true"
+			if tt.want.body != "" {
+				got.body = rw.Body.String()
+			}
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
@@ -55,6 +55,9 @@ const (
 
 	LeaderWorker = "leader_worker"
 
+	// ReconcilerQuarantinedItems - name of the metric for the number of work items quarantined after failing too many times
+	ReconcilerQuarantinedItems = "reconciler_quarantined_items"
+
 	// ObservatoriumRequestCount - metric name for the number of observatorium requests sent
 	ObservatoriumRequestCount = "observatorium_request_count"
 	// ObservatoriumRequestDuration - metric name for observatorium request duration in seconds
@@ -83,6 +86,9 @@ const (
 	// PrewarmingStatusInfoCount - metric name for the total number of prewarmed instances per cluster_id, status and instance type.
 	PrewarmingStatusInfoCount = "prewarmed_kafka_instances"
 
+	// KafkaTLSCertificateExpiry - metric name for the expiry time of the automatically managed Kafka TLS certificates, in seconds since the Unix epoch
+	KafkaTLSCertificateExpiry = "kafka_tls_certificate_expiry_timestamp_seconds"
+
 	LabelStatusCode = "code"
 	LabelMethod     = "method"
 	LabelPath       = "path"
@@ -96,6 +102,9 @@ const (
 	LabelQuotaId         = "quota_id"
 	LabelClusterProvider = "cluster_provider"
 
+	LabelDomain            = "domain"
+	LabelCertificateIssuer = "issuer"
+
 	// prewarming metric labels
 	prewarmingStatusLabel       = "status"
 	prewarmingInstanceTypeLabel = "instance_type"
@@ -638,6 +647,21 @@ func SetLeaderWorkerMetric(workerType string, leader bool) {
 	leaderWorkerMetric.With(labels).Set(float64(val))
 }
 
+var reconcilerQuarantinedItemsMetric = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Subsystem: KasFleetManager,
+		Name:      ReconcilerQuarantinedItems,
+		Help:      "number of work items no longer reconciled by a worker after failing too many times",
+	}, ReconcilerMetricsLabels)
+
+// UpdateReconcilerQuarantinedItemsMetric sets the number of work items quarantined by the given worker type
+func UpdateReconcilerQuarantinedItemsMetric(workerType string, count int) {
+	labels := prometheus.Labels{
+		labelWorkerType: workerType,
+	}
+	reconcilerQuarantinedItemsMetric.With(labels).Set(float64(count))
+}
+
 // #### Metrics for Reconcilers - End ####
 
 // #### Metrics for Observatorium ####
@@ -809,6 +833,31 @@ func UpdateClusterPrewarmingStatusInfoCountMetric(prewarmingStatusInfo Prewarmin
 	prewarmingStatusInfoCountMetric.With(labels).Set(float64(prewarmingStatusInfo.Count))
 }
 
+var kafkaTLSCertificateExpiryMetric = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Subsystem: KasFleetManager,
+		Name:      KafkaTLSCertificateExpiry,
+		Help:      "expiry time of the automatically managed Kafka TLS certificate of a base domain, in seconds since the Unix epoch.",
+	},
+	[]string{LabelDomain, LabelCertificateIssuer},
+)
+
+// UpdateKafkaTLSCertificateExpiryMetric - Updates the expiry time of the TLS certificate of the given base domain
+func UpdateKafkaTLSCertificateExpiryMetric(domain string, issuer string, expiry time.Time) {
+	// the certificate of a domain moving to another issuer must not be reported twice
+	kafkaTLSCertificateExpiryMetric.DeletePartialMatch(prometheus.Labels{LabelDomain: domain})
+	labels := prometheus.Labels{
+		LabelDomain:            domain,
+		LabelCertificateIssuer: issuer,
+	}
+	kafkaTLSCertificateExpiryMetric.With(labels).Set(float64(expiry.Unix()))
+}
+
+// DeleteKafkaTLSCertificateExpiryMetric - Stops reporting the expiry time of the TLS certificate of the given base domain
+func DeleteKafkaTLSCertificateExpiryMetric(domain string) {
+	kafkaTLSCertificateExpiryMetric.DeletePartialMatch(prometheus.Labels{LabelDomain: domain})
+}
+
 // register the metric(s)
 func init() {
 	// metrics for data plane clusters
@@ -832,6 +881,7 @@ func init() {
 	prometheus.MustRegister(kafkaStatusSinceCreatedMetric)
 	prometheus.MustRegister(kafkaRequestsCurrentStatusInfoMetric)
 	prometheus.MustRegister(KafkaStatusCountMetric)
+	prometheus.MustRegister(kafkaTLSCertificateExpiryMetric)
 
 	// metrics for reconcilers
 	prometheus.MustRegister(reconcilerDurationMetric)
@@ -839,6 +889,7 @@ func init() {
 	prometheus.MustRegister(reconcilerFailureCountMetric)
 	prometheus.MustRegister(reconcilerErrorsCountMetric)
 	prometheus.MustRegister(leaderWorkerMetric)
+	prometheus.MustRegister(reconcilerQuarantinedItemsMetric)
 
 	// metrics for observatorium
 	prometheus.MustRegister(observatoriumRequestCountMetric)
@@ -876,6 +927,7 @@ func ResetMetricsForReconcilers() {
 	reconcilerSuccessCountMetric.Reset()
 	reconcilerFailureCountMetric.Reset()
 	reconcilerErrorsCountMetric.Reset()
+	reconcilerQuarantinedItemsMetric.Reset()
 }
 
 // ResetMetricsForObservatorium will reset the metrics related to Observatorium requests
@@ -905,12 +957,14 @@ func Reset() {
 	kafkaOperationsTotalCountMetric.Reset()
 	kafkaStatusSinceCreatedMetric.Reset()
 	KafkaStatusCountMetric.Reset()
+	kafkaTLSCertificateExpiryMetric.Reset()
 
 	reconcilerDurationMetric.Reset()
 	reconcilerSuccessCountMetric.Reset()
 	reconcilerFailureCountMetric.Reset()
 	reconcilerErrorsCountMetric.Reset()
 	leaderWorkerMetric.Reset()
+	reconcilerQuarantinedItemsMetric.Reset()
 
 	ResetMetricsForObservatorium()
 
@@ -40,6 +40,7 @@ func CoreConfigProviders() di.Option {
 		di.Provide(workers.NewReconcilerConfig, di.As(new(environments.ConfigModule))),
 		di.Provide(auth.NewContextConfig, di.As(new(environments.ConfigModule))),
 		di.Provide(auth.NewAdminAuthZConfig, di.As(new(environments.ConfigModule)), di.As(new(environments.ServiceValidator))),
+		di.Provide(handlers.NewIdempotencyConfig, di.As(new(environments.ConfigModule))),
 
 		// Add common CLI sub commands
 		di.Provide(serve.NewServeCommand),
@@ -82,6 +83,10 @@ func ServiceProviders() di.Option {
 
 		di.Provide(acl.NewAccessControlListMiddleware),
 		di.Provide(handlers.NewErrorsHandler),
+		di.Provide(handlers.NewIdempotencyMiddleware),
+		di.Provide(workers.NewWorkerStatusService),
+		di.Provide(workers.NewWorkQueueService),
+		di.Provide(workers.NewIdempotencyKeyManager, di.As(new(workers.Worker))),
 		di.Provide(func(c *keycloak.KeycloakConfig) sso.KafkaKeycloakService {
 			return sso.NewKeycloakServiceBuilder().
 				ForKFM().
@@ -105,6 +105,7 @@ func NewAPIServer(options ServerOptions) *ApiServer {
 		gorillahandlers.AllowedHeaders([]string{
 			"Authorization",
 			"Content-Type",
+			"Idempotency-Key",
 		}),
 		gorillahandlers.MaxAge(int((10 * time.Minute).Seconds())),
 	)(mainHandler)
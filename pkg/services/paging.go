@@ -0,0 +1,113 @@
+package services
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// listCursor is the content of the opaque cursor token handed out to clients
+type listCursor struct {
+	LastID string `json:"last_id"`
+}
+
+func encodeListCursor(lastID string) string {
+	// marshalling a struct of strings cannot fail
+	b, _ := json.Marshal(listCursor{LastID: lastID})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decodeListCursor returns the id of the last item of the previous page. An empty token refers to the first page
+func decodeListCursor(token string) (string, error) {
+	if token == "" {
+		return "", nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", fmt.Errorf("invalid cursor '%s'", token)
+	}
+
+	var cursor listCursor
+	if err := json.Unmarshal(b, &cursor); err != nil || cursor.LastID == "" {
+		return "", fmt.Errorf("invalid cursor '%s'", token)
+	}
+
+	return cursor.LastID, nil
+}
+
+// countItems returns the number of rows matched by the query
+func countItems(dbConn *gorm.DB, model interface{}) (int64, error) {
+	var total int64
+	query := dbConn.Session(&gorm.Session{}).Model(model)
+	if _, grouped := dbConn.Statement.Clauses["GROUP BY"]; grouped {
+		// grouped rows have to be counted from a sub query, otherwise only the count of the first group is returned
+		query = dbConn.Session(&gorm.Session{NewDB: true}).Table("(?) as items", query)
+	}
+	err := query.Count(&total).Error
+	return total, err
+}
+
+// Paginate restricts the query to the page requested by the list arguments and returns the resulting paging metadata.
+//
+// The total of the paging metadata is always the number of items matching the query filters, regardless of the requested page.
+// The size is the number of items returned in the requested page.
+//
+// When cursor based paging is requested, the items are ordered by idColumn and only the items following the cursor are returned.
+// The next cursor is set in the paging metadata as long as more items follow the returned page.
+// The filters of the query must be applied before calling Paginate
+func (la *ListArguments) Paginate(dbConn *gorm.DB, model interface{}, idColumn string) (*gorm.DB, *api.PagingMeta, *errors.ServiceError) {
+	pagingMeta := &api.PagingMeta{
+		Page: la.Page,
+		Size: la.Size,
+	}
+
+	// set total, limit and paging (based on https://gitlab.cee.redhat.com/service/api-guidelines#user-content-paging)
+	total, err := countItems(dbConn, model)
+	if err != nil {
+		return dbConn, nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to count items")
+	}
+	pagingMeta.Total = int(total)
+
+	if !la.CursorPaging {
+		if pagingMeta.Size > pagingMeta.Total {
+			pagingMeta.Size = pagingMeta.Total
+		}
+		return dbConn.Offset((pagingMeta.Page - 1) * pagingMeta.Size).Limit(pagingMeta.Size), pagingMeta, nil
+	}
+
+	lastID, err := decodeListCursor(la.Cursor)
+	if err != nil {
+		return dbConn, nil, errors.NewWithCause(errors.ErrorMalformedRequest, err, "%s", err.Error())
+	}
+	if lastID != "" {
+		dbConn = dbConn.Where(fmt.Sprintf("%s > ?", idColumn), lastID)
+	}
+	// ids are unique, ordering by them gives a stable order which is not affected by concurrent inserts
+	dbConn = dbConn.Order(clause.OrderByColumn{Column: clause.Column{Name: idColumn}, Reorder: true})
+
+	remaining, err := countItems(dbConn, model)
+	if err != nil {
+		return dbConn, nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to count items")
+	}
+	if int64(pagingMeta.Size) >= remaining {
+		pagingMeta.Size = int(remaining)
+		return dbConn.Limit(pagingMeta.Size), pagingMeta, nil
+	}
+
+	// more items follow this page, the next cursor points at the last item of this page
+	var ids []string
+	if err := dbConn.Session(&gorm.Session{}).Model(model).Offset(pagingMeta.Size-1).Limit(1).Pluck(idColumn, &ids).Error; err != nil {
+		return dbConn, nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to find the next cursor")
+	}
+	if len(ids) > 0 {
+		pagingMeta.NextCursor = encodeListCursor(ids[0])
+	}
+
+	return dbConn.Limit(pagingMeta.Size), pagingMeta, nil
+}
@@ -0,0 +1,134 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+type pagingTestItem struct {
+	ID string
+}
+
+func Test_decodeListCursor(t *testing.T) {
+	tests := []struct {
+		name    string
+		token   string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "should return an empty id for an empty cursor",
+			token: "",
+			want:  "",
+		},
+		{
+			name:  "should return the id of an encoded cursor",
+			token: encodeListCursor("some-id"),
+			want:  "some-id",
+		},
+		{
+			name:    "should return an error if the cursor is not base64 encoded",
+			token:   "not base64",
+			wantErr: true,
+		},
+		{
+			name:    "should return an error if the cursor does not contain an id",
+			token:   encodeListCursor(""),
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := gomega.NewWithT(t)
+			got, err := decodeListCursor(tt.token)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func TestListArguments_Paginate(t *testing.T) {
+	tests := []struct {
+		name     string
+		listArgs *ListArguments
+		setupFn  func()
+		want     *api.PagingMeta
+		wantErr  bool
+	}{
+		{
+			name:     "should return the page window when paging with offsets",
+			listArgs: &ListArguments{Page: 2, Size: 2},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT count(1) FROM "paging_test_items"`).WithReply([]map[string]interface{}{{"count": 5}})
+			},
+			want: &api.PagingMeta{Page: 2, Size: 2, Total: 5},
+		},
+		{
+			name:     "should limit the size to the total when paging with offsets",
+			listArgs: &ListArguments{Page: 1, Size: 100},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT count(1) FROM "paging_test_items"`).WithReply([]map[string]interface{}{{"count": 5}})
+			},
+			want: &api.PagingMeta{Page: 1, Size: 5, Total: 5},
+		},
+		{
+			name:     "should return the next cursor when more items follow the page",
+			listArgs: &ListArguments{Page: 1, Size: 2, Cursor: encodeListCursor("a"), CursorPaging: true},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT count(1) FROM "paging_test_items" WHERE id > $1`).WithReply([]map[string]interface{}{{"count": 4}})
+				mocket.Catcher.NewMock().WithQuery(`SELECT count(1) FROM "paging_test_items"`).WithReply([]map[string]interface{}{{"count": 5}})
+				mocket.Catcher.NewMock().WithQuery(`SELECT "id" FROM "paging_test_items" WHERE id > $1 ORDER BY "id" LIMIT 1 OFFSET 1`).WithReply([]map[string]interface{}{{"id": "c"}})
+			},
+			want: &api.PagingMeta{Page: 1, Size: 2, Total: 5, NextCursor: encodeListCursor("c")},
+		},
+		{
+			name:     "should not return a next cursor on the last page",
+			listArgs: &ListArguments{Page: 1, Size: 100, CursorPaging: true},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT count(1) FROM "paging_test_items"`).WithReply([]map[string]interface{}{{"count": 5}})
+			},
+			want: &api.PagingMeta{Page: 1, Size: 5, Total: 5},
+		},
+		{
+			name:     "should return an error if the cursor is invalid",
+			listArgs: &ListArguments{Page: 1, Size: 100, Cursor: "invalid", CursorPaging: true},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT count(1) FROM "paging_test_items"`).WithReply([]map[string]interface{}{{"count": 5}})
+			},
+			wantErr: true,
+		},
+		{
+			name:     "should return an error if the items cannot be counted",
+			listArgs: &ListArguments{Page: 1, Size: 100},
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT count(1) FROM "paging_test_items"`).WithQueryException()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			dbConn := db.NewMockConnectionFactory(nil).New()
+			_, pagingMeta, err := tt.listArgs.Paginate(dbConn, &[]pagingTestItem{}, "id")
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(pagingMeta).To(gomega.Equal(tt.want))
+		})
+	}
+}
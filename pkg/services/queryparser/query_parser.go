@@ -2,7 +2,9 @@ package queryparser
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
 
@@ -14,6 +16,52 @@ import (
 
 var validColumns = []string{"region", "name", "cloud_provider", "status", "owner", "cluster_id", "instance_type"}
 
+// ColumnType is the type of the values a column can be compared with
+type ColumnType string
+
+const (
+	StringColumn    ColumnType = "string"
+	IntegerColumn   ColumnType = "integer"
+	BooleanColumn   ColumnType = "boolean"
+	TimestampColumn ColumnType = "timestamp"
+	// JSONColumn is a jsonb column searched by a dot separated path of keys, e.g. `connector_spec.kafka.topic`.
+	// The value found at the path is compared as a string
+	JSONColumn ColumnType = "json"
+	// KeyValueColumn is a flat jsonb object searched by a single key which can contain dots, e.g. `annotations.cos.bf2.org/pricing-tier`.
+	// The value of the key is compared as a string
+	KeyValueColumn ColumnType = "key_value"
+)
+
+// timestamp values can either be a date or a RFC3339 timestamp
+var timestampLayouts = []string{"2006-01-02", time.RFC3339}
+
+// Column is a column that can be used in a search query
+type Column struct {
+	Name string
+	Type ColumnType
+	// Expression is the SQL expression the column values are read from. When empty, the (prefixed) column name is used
+	Expression string
+}
+
+// TypedColumns returns a column of the given type for each of the given column names
+func TypedColumns(columnType ColumnType, names ...string) []Column {
+	columns := make([]Column, 0, len(names))
+	for _, name := range names {
+		columns = append(columns, Column{Name: name, Type: columnType})
+	}
+	return columns
+}
+
+// SetColumnType sets the type of the named columns
+func SetColumnType(columns []Column, columnType ColumnType, names ...string) []Column {
+	for i := range columns {
+		if arrays.Contains(names, columns[i].Name) {
+			columns[i].Type = columnType
+		}
+	}
+	return columns
+}
+
 const (
 	braceTokenFamily     = "BRACE"
 	opTokenFamily        = "OP"
@@ -31,6 +79,13 @@ const (
 	quotedValue            = "QUOTED_VALUE"
 	eq                     = "EQ"
 	notEq                  = "NOT_EQ"
+	lt                     = "LT"
+	lte                    = "LTE"
+	gt                     = "GT"
+	gte                    = "GTE"
+	is                     = "IS"
+	isNot                  = "IS_NOT"
+	null                   = "NULL"
 	like                   = "LIKE"
 	ilike                  = "ILIKE"
 	in                     = "IN"
@@ -49,6 +104,7 @@ type DBQuery struct {
 	Query        string
 	Values       []interface{}
 	ValidColumns []string
+	Columns      []Column
 	ColumnPrefix string
 }
 
@@ -68,24 +124,36 @@ var _ QueryParser = &queryParser{}
 // Tokens:
 // OPEN_BRACE       = (
 // CLOSED_BRACE     = )
-// COLUMN -         = [A-Za-z][A-Za-z0-9_]*
+// COLUMN -         = [A-Za-z][A-Za-z0-9_]*(\.[A-Za-z0-9_\-./]+)?
 // VALUE            = [^ ^(^)]+
 // QUOTED_VALUE     = `'([^']|\\')*'`
 // EQ               = =
 // NOT_EQ           = <>
+// LT               = <
+// LTE              = <=
+// GT               = >
+// GTE              = >=
 // LIKE             = [Ll][Ii][Kk][Ee]
 // ILIKE             = [Ii][Ll][Ii][Kk][Ee]
+// IS               = [Ii][Ss]
+// IS_NOT           = [Nn][Oo][Tt]
+// NULL             = [Nn][Uu][Ll][Ll]
 // AND              = [Aa][Nn][Dd]
 // OR               = [Oo][Rr]
 //
 // VALID TRANSITIONS:
 // START        -> COLUMN | OPEN_BRACE
 // OPEN_BRACE   -> OPEN_BRACE | COLUMN
-// COLUMN       -> EQ | NOT_EQ | LIKE | ILIKE
+// COLUMN       -> EQ | NOT_EQ | LT | LTE | GT | GTE | LIKE | ILIKE | IN | NOT | IS
 // EQ           -> VALUE | QUOTED_VALUE
 // NOT_EQ       -> VALUE | QUOTED_VALUE
+// LT, LTE      -> VALUE | QUOTED_VALUE
+// GT, GTE      -> VALUE | QUOTED_VALUE
 // LIKE         -> VALUE | QUOTED_VALUE
 // ILIKE        -> VALUE | QUOTED_VALUE
+// IS           -> IS_NOT | NULL
+// IS_NOT       -> NULL
+// NULL         -> OR | AND | CLOSED_BRACE | [END]
 // NOT          -> IN
 // IN			-> IN_OPEN_BRACE
 // IN_OPEN_BRACE -> VALUE_IN_LIST
@@ -103,6 +171,10 @@ func (p *queryParser) initStateMachine() (*state_machine.State, checkUnbalancedB
 
 	contains := arrays.Contains[string]
 
+	// the column and the type of the values of the condition being parsed
+	var currentColumn Column
+	var currentValueType ColumnType
+
 	// This variable counts the open openBraces
 	openBraces := 0
 	countOpenBraces := func(tok string) error {
@@ -127,18 +199,32 @@ func (p *queryParser) initStateMachine() (*state_machine.State, checkUnbalancedB
 			p.dbqry.Query += token.Value
 			return nil
 		case valueTokenFamily:
+			v, err := convertValue(currentColumn, currentValueType, token.Value)
+			if err != nil {
+				return err
+			}
 			p.dbqry.Query += " ?"
-			p.dbqry.Values = append(p.dbqry.Values, token.Value)
+			p.dbqry.Values = append(p.dbqry.Values, v)
 			return nil
 		case quotedValueTokenFamily:
-			p.dbqry.Query += " ?"
 			// unescape
 			tmp := strings.ReplaceAll(token.Value, `\'`, "'")
 			// remove quotes:
 			if len(tmp) > 1 {
 				tmp = string([]rune(tmp)[1 : len(tmp)-1])
 			}
-			p.dbqry.Values = append(p.dbqry.Values, tmp)
+			v, err := convertValue(currentColumn, currentValueType, tmp)
+			if err != nil {
+				return err
+			}
+			p.dbqry.Query += " ?"
+			p.dbqry.Values = append(p.dbqry.Values, v)
+			return nil
+		case opTokenFamily:
+			if err := validateOperator(currentColumn, currentValueType, token); err != nil {
+				return err
+			}
+			p.dbqry.Query += " " + token.Value
 			return nil
 		case logicalOpTokenFamily:
 			complexity++
@@ -148,15 +234,52 @@ func (p *queryParser) initStateMachine() (*state_machine.State, checkUnbalancedB
 			p.dbqry.Query += " " + token.Value + " "
 			return nil
 		case columnTokenFamily:
+			// json columns are followed by the path of the searched key,
+			// other columns can be prefixed with the column prefix, e.g. `connectors.name`
+			columnName, path, hasPath := strings.Cut(token.Value, ".")
 			// we want column names to be lowercase
-			columnName := strings.ToLower(token.Value)
+			columnName = strings.ToLower(columnName)
+			if hasPath && !p.isPathColumn(columnName) {
+				columnName, path, hasPath = strings.ToLower(token.Value), "", false
+				if p.dbqry.ColumnPrefix != "" {
+					columnName = strings.TrimPrefix(columnName, p.dbqry.ColumnPrefix+".")
+				}
+			}
 			if !contains(p.dbqry.ValidColumns, columnName) {
-				return fmt.Errorf("invalid column name: '%s', valid values are: %v", token.Value, p.dbqry.ValidColumns)
+				return fmt.Errorf("invalid column name: '%s', valid values are: %v", columnName, p.dbqry.ValidColumns)
+			}
+			_, currentColumn = arrays.FindFirst(p.dbqry.Columns, func(c Column) bool { return c.Name == columnName })
+
+			expression := currentColumn.Expression
+			if expression == "" {
+				expression = columnName
+				if p.dbqry.ColumnPrefix != "" {
+					expression = p.dbqry.ColumnPrefix + "." + columnName
+				}
 			}
-			if p.dbqry.ColumnPrefix != "" && !strings.HasPrefix(columnName, p.dbqry.ColumnPrefix+".") {
-				columnName = p.dbqry.ColumnPrefix + "." + columnName
+
+			currentValueType = currentColumn.Type
+			if currentColumn.Type == JSONColumn || currentColumn.Type == KeyValueColumn {
+				if !hasPath || path == "" {
+					return fmt.Errorf("column '%s' must be followed by the path of the searched key, e.g. '%s.key'", columnName, columnName)
+				}
+				keys := []string{path}
+				if currentColumn.Type == JSONColumn {
+					keys = strings.Split(path, ".")
+				}
+				// keys are bound as values: the last one returns the found value as text
+				for i, key := range keys {
+					if i == len(keys)-1 {
+						expression += " ->> ?"
+					} else {
+						expression += " -> ?"
+					}
+					p.dbqry.Values = append(p.dbqry.Values, key)
+				}
+				currentValueType = StringColumn
 			}
-			p.dbqry.Query += columnName
+
+			p.dbqry.Query += expression
 			return nil
 		default:
 			p.dbqry.Query += " " + token.Value
@@ -168,12 +291,19 @@ func (p *queryParser) initStateMachine() (*state_machine.State, checkUnbalancedB
 		Tokens: []state_machine.TokenDefinition{
 			{Name: openBrace, Family: braceTokenFamily, AcceptPattern: `\(`},
 			{Name: closedBrace, Family: braceTokenFamily, AcceptPattern: `\)`},
-			{Name: column, Family: columnTokenFamily, AcceptPattern: `[A-Za-z][A-Za-z0-9_]*`},
+			{Name: column, Family: columnTokenFamily, AcceptPattern: `[A-Za-z][A-Za-z0-9_]*(\.[A-Za-z0-9_\-./]+)?`},
 			{Name: value, Family: valueTokenFamily, AcceptPattern: `[^'][^ ^(^)]*`},
 			{Name: quotedValue, Family: quotedValueTokenFamily, AcceptPattern: `'([^']|\\')*'`},
 			{Name: eq, Family: opTokenFamily, AcceptPattern: `=`},
 			{Name: comma, AcceptPattern: `,`},
 			{Name: notEq, Family: opTokenFamily, AcceptPattern: `<>`},
+			{Name: lt, Family: opTokenFamily, AcceptPattern: `<`},
+			{Name: lte, Family: opTokenFamily, AcceptPattern: `<=`},
+			{Name: gt, Family: opTokenFamily, AcceptPattern: `>`},
+			{Name: gte, Family: opTokenFamily, AcceptPattern: `>=`},
+			{Name: is, Family: opTokenFamily, AcceptPattern: `[Ii][Ss]`},
+			{Name: isNot, Family: opTokenFamily, AcceptPattern: `[Nn][Oo][Tt]`},
+			{Name: null, Family: opTokenFamily, AcceptPattern: `[Nn][Uu][Ll][Ll]`},
 			{Name: like, Family: opTokenFamily, AcceptPattern: `[Ll][Ii][Kk][Ee]`},
 			{Name: ilike, Family: opTokenFamily, AcceptPattern: `[Ii][Ll][Ii][Kk][Ee]`},
 			{Name: in, Family: opTokenFamily, AcceptPattern: `[Ii][Nn]`},
@@ -187,9 +317,16 @@ func (p *queryParser) initStateMachine() (*state_machine.State, checkUnbalancedB
 		Transitions: []state_machine.TokenTransitions{
 			{TokenName: state_machine.StartState, ValidTransitions: []string{column, openBrace}},
 			{TokenName: openBrace, ValidTransitions: []string{column, openBrace}},
-			{TokenName: column, ValidTransitions: []string{eq, notEq, like, ilike, in, not}},
+			{TokenName: column, ValidTransitions: []string{eq, notEq, lt, lte, gt, gte, like, ilike, in, not, is}},
 			{TokenName: eq, ValidTransitions: []string{quotedValue, value}},
 			{TokenName: notEq, ValidTransitions: []string{quotedValue, value}},
+			{TokenName: lt, ValidTransitions: []string{quotedValue, value}},
+			{TokenName: lte, ValidTransitions: []string{quotedValue, value}},
+			{TokenName: gt, ValidTransitions: []string{quotedValue, value}},
+			{TokenName: gte, ValidTransitions: []string{quotedValue, value}},
+			{TokenName: is, ValidTransitions: []string{isNot, null}},
+			{TokenName: isNot, ValidTransitions: []string{null}},
+			{TokenName: null, ValidTransitions: []string{or, and, closedBrace, state_machine.EndState}},
 			{TokenName: like, ValidTransitions: []string{quotedValue, value}},
 			{TokenName: ilike, ValidTransitions: []string{quotedValue, value}},
 			{TokenName: quotedValue, ValidTransitions: []string{or, and, closedBrace, state_machine.EndState}},
@@ -220,6 +357,12 @@ func (p *queryParser) initStateMachine() (*state_machine.State, checkUnbalancedB
 	}
 }
 
+// isPathColumn returns true if the named column is searched by the path of a key, like json columns
+func (p *queryParser) isPathColumn(name string) bool {
+	_, column := arrays.FindFirst(p.dbqry.Columns, func(c Column) bool { return c.Name == name })
+	return column.Type == JSONColumn || column.Type == KeyValueColumn
+}
+
 func (p *queryParser) Parse(sql string) (*DBQuery, error) {
 	state, checkBalancedBraces := p.initStateMachine()
 
@@ -245,17 +388,71 @@ func (p *queryParser) Parse(sql string) (*DBQuery, error) {
 	return &p.dbqry, nil
 }
 
+// validateOperator checks that the operator can be used with the values of the column
+func validateOperator(col Column, valueType ColumnType, token *state_machine.ParsedToken) error {
+	switch token.Name {
+	case like, ilike:
+		if valueType != StringColumn {
+			return fmt.Errorf("operator '%s' cannot be used with %s column '%s'", token.Value, valueType, col.Name)
+		}
+	case lt, lte, gt, gte:
+		if valueType == BooleanColumn {
+			return fmt.Errorf("operator '%s' cannot be used with %s column '%s'", token.Value, valueType, col.Name)
+		}
+	}
+	return nil
+}
+
+// convertValue converts the value to the type of the column, returning an error if the value is not of that type
+func convertValue(col Column, valueType ColumnType, value string) (interface{}, error) {
+	switch valueType {
+	case IntegerColumn:
+		v, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value '%s' for %s column '%s'", value, valueType, col.Name)
+		}
+		return v, nil
+	case BooleanColumn:
+		v, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value '%s' for %s column '%s'", value, valueType, col.Name)
+		}
+		return v, nil
+	case TimestampColumn:
+		for _, layout := range timestampLayouts {
+			if v, err := time.Parse(layout, value); err == nil {
+				return v, nil
+			}
+		}
+		return nil, fmt.Errorf("invalid value '%s' for %s column '%s', valid formats are: %v", value, valueType, col.Name, timestampLayouts)
+	default:
+		return value, nil
+	}
+}
+
 func NewQueryParser(columns ...string) QueryParser {
 	return NewQueryParserWithColumnPrefix("", columns...)
 }
 
 func NewQueryParserWithColumnPrefix(columnsPrefix string, columns ...string) QueryParser {
-	query := DBQuery{}
 	if len(columns) == 0 {
-		query.ValidColumns = validColumns
-	} else {
-		query.ValidColumns = columns
+		columns = validColumns
+	}
+	return NewTypedQueryParserWithColumnPrefix(columnsPrefix, TypedColumns(StringColumn, columns...)...)
+}
+
+// NewTypedQueryParser returns a parser validating the values of the search query against the type of the given columns
+func NewTypedQueryParser(columns ...Column) QueryParser {
+	return NewTypedQueryParserWithColumnPrefix("", columns...)
+}
+
+func NewTypedQueryParserWithColumnPrefix(columnsPrefix string, columns ...Column) QueryParser {
+	query := DBQuery{
+		Columns:      columns,
+		ColumnPrefix: columnsPrefix,
+	}
+	for _, c := range columns {
+		query.ValidColumns = append(query.ValidColumns, c.Name)
 	}
-	query.ColumnPrefix = columnsPrefix
 	return &queryParser{dbqry: query}
 }
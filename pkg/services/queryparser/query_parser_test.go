@@ -2,10 +2,20 @@ package queryparser
 
 import (
 	"testing"
+	"time"
 
 	"github.com/onsi/gomega"
 )
 
+var typedTestColumns = []Column{
+	{Name: "created_at", Type: TimestampColumn},
+	{Name: "updated_at", Type: TimestampColumn},
+	{Name: "size", Type: IntegerColumn},
+	{Name: "enabled", Type: BooleanColumn},
+	{Name: "spec", Type: JSONColumn},
+	{Name: "annotations", Type: KeyValueColumn, Expression: "(SELECT annotations)"},
+}
+
 func Test_QueryParser(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -218,6 +228,115 @@ func Test_QueryParser(t *testing.T) {
 			outValues: []interface{}{"Value", "value1", "value2", "b", "c", "e", "%test%"},
 			wantErr:   false,
 		},
+		{
+			name:      "Testing comparison operators",
+			qry:       "name >= a and name < 'b' or name<=c and name>d",
+			qryParser: NewQueryParser(),
+			outQry:    "name >= ? and name < ? or name <= ? and name > ?",
+			outValues: []interface{}{"a", "b", "c", "d"},
+			wantErr:   false,
+		},
+		{
+			name:      "Testing IS NULL and IS NOT NULL",
+			qry:       "(name is null or owner IS NOT NULL) and region = a",
+			qryParser: NewQueryParser(),
+			outQry:    "(name is null or owner IS NOT NULL) and region = ?",
+			outValues: []interface{}{"a"},
+			wantErr:   false,
+		},
+		{
+			name:      "Testing invalid IS",
+			qry:       "name is a",
+			qryParser: NewQueryParser(),
+			wantErr:   true,
+		},
+		{
+			name:      "Testing typed columns",
+			qry:       "created_at >= 2023-01-02 and updated_at < '2023-01-02T10:00:00Z' and size > 3 and enabled = true",
+			qryParser: NewTypedQueryParserWithColumnPrefix("prefix", typedTestColumns...),
+			outQry:    "prefix.created_at >= ? and prefix.updated_at < ? and prefix.size > ? and prefix.enabled = ?",
+			outValues: []interface{}{
+				time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC),
+				time.Date(2023, 1, 2, 10, 0, 0, 0, time.UTC),
+				int64(3),
+				true,
+			},
+			wantErr: false,
+		},
+		{
+			name:      "Testing typed columns in IN",
+			qry:       "size in (1, 2)",
+			qryParser: NewTypedQueryParser(typedTestColumns...),
+			outQry:    "size in( ? , ?)",
+			outValues: []interface{}{int64(1), int64(2)},
+			wantErr:   false,
+		},
+		{
+			name:      "Testing invalid timestamp value",
+			qry:       "created_at > yesterday",
+			qryParser: NewTypedQueryParser(typedTestColumns...),
+			wantErr:   true,
+		},
+		{
+			name:      "Testing invalid integer value in IN",
+			qry:       "size in (1, a)",
+			qryParser: NewTypedQueryParser(typedTestColumns...),
+			wantErr:   true,
+		},
+		{
+			name:      "Testing LIKE on a non string column",
+			qry:       "size like 1",
+			qryParser: NewTypedQueryParser(typedTestColumns...),
+			wantErr:   true,
+		},
+		{
+			name:      "Testing comparison operator on a boolean column",
+			qry:       "enabled > true",
+			qryParser: NewTypedQueryParser(typedTestColumns...),
+			wantErr:   true,
+		},
+		{
+			name:      "Testing JSON path",
+			qry:       "spec.kafka.topic = test and spec.name like 'a%'",
+			qryParser: NewTypedQueryParserWithColumnPrefix("prefix", typedTestColumns...),
+			outQry:    "prefix.spec -> ? ->> ? = ? and prefix.spec ->> ? like ?",
+			outValues: []interface{}{"kafka", "topic", "test", "name", "a%"},
+			wantErr:   false,
+		},
+		{
+			name:      "Testing key value path",
+			qry:       "annotations.cos.bf2.org/pricing-tier is not null",
+			qryParser: NewTypedQueryParserWithColumnPrefix("prefix", typedTestColumns...),
+			outQry:    "(SELECT annotations) ->> ? is not null",
+			outValues: []interface{}{"cos.bf2.org/pricing-tier"},
+			wantErr:   false,
+		},
+		{
+			name:      "Testing JSON column without path",
+			qry:       "spec = test",
+			qryParser: NewTypedQueryParser(typedTestColumns...),
+			wantErr:   true,
+		},
+		{
+			name:      "Testing path on a non JSON column",
+			qry:       "size.value = 1",
+			qryParser: NewTypedQueryParser(typedTestColumns...),
+			wantErr:   true,
+		},
+		{
+			name:      "Testing prefixed columns",
+			qry:       "prefix.created_at >= 2023-01-01 and PREFIX.size > 1",
+			qryParser: NewTypedQueryParserWithColumnPrefix("prefix", typedTestColumns...),
+			outQry:    "prefix.created_at >= ? and prefix.size > ?",
+			outValues: []interface{}{time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), int64(1)},
+			wantErr:   false,
+		},
+		{
+			name:      "Testing prefixed columns with another prefix",
+			qry:       "other.size = 1",
+			qryParser: NewTypedQueryParserWithColumnPrefix("prefix", typedTestColumns...),
+			wantErr:   true,
+		},
 	}
 
 	for _, testcase := range tests {
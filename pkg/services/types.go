@@ -11,6 +11,8 @@ import (
 	"github.com/pkg/errors"
 )
 
+var fieldRegexp = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
 // ListArguments are arguments relevant for listing objects.
 // This struct is common to all service List funcs in this package
 type ListArguments struct {
@@ -19,6 +21,12 @@ type ListArguments struct {
 	Preloads []string
 	Search   string
 	OrderBy  []string
+	// Fields restricts the properties of the listed items to the given ones. All the properties are returned when empty
+	Fields []string
+	// Cursor is the opaque token pointing at the last item of the previous page.
+	// It is only used when CursorPaging is set, in which case an empty cursor refers to the first page
+	Cursor       string
+	CursorPaging bool
 }
 
 // NewListArguments - Create ListArguments from url query parameters with sane defaults
@@ -49,6 +57,18 @@ func NewListArguments(params url.Values) *ListArguments {
 			listArgs.OrderBy[i] = strings.Trim(s, " ")
 		}
 	}
+	if v := params.Get("fields"); v != "" {
+		for _, field := range strings.Split(v, ",") {
+			if field = strings.TrimSpace(field); field != "" {
+				listArgs.Fields = append(listArgs.Fields, field)
+			}
+		}
+	}
+	// cursor based paging is requested by providing the cursor parameter, even when its value is empty
+	if params.Has("cursor") {
+		listArgs.CursorPaging = true
+		listArgs.Cursor = params.Get("cursor")
+	}
 	return listArgs
 }
 
@@ -85,5 +105,21 @@ func (la *ListArguments) Validate(acceptedOrderByParams []string) error {
 		}
 	}
 
+	for _, field := range la.Fields {
+		if !fieldRegexp.MatchString(field) {
+			return errors.Errorf("invalid field '%s'", field)
+		}
+	}
+
+	if la.CursorPaging {
+		// items are always ordered by id when paging with a cursor
+		if len(la.OrderBy) > 0 {
+			return errors.Errorf("order by cannot be used together with cursor")
+		}
+		if _, err := decodeListCursor(la.Cursor); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
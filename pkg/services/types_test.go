@@ -127,6 +127,21 @@ func Test_NewListArguments(t *testing.T) {
 			},
 			want: overriddenListArgs,
 		},
+		{
+			name: "should parse fields and cursor",
+			args: args{
+				params: url.Values{
+					"fields": []string{"name, status,"},
+					"cursor": []string{""},
+				},
+			},
+			want: &ListArguments{
+				Page:         1,
+				Size:         100,
+				Fields:       []string{"name", "status"},
+				CursorPaging: true,
+			},
+		},
 	}
 
 	for _, testcase := range tests {
@@ -141,10 +156,13 @@ func Test_NewListArguments(t *testing.T) {
 
 func TestListArguments_Validate(t *testing.T) {
 	type fields struct {
-		Page    int
-		Size    int
-		Search  string
-		OrderBy []string
+		Page         int
+		Size         int
+		Search       string
+		OrderBy      []string
+		Fields       []string
+		Cursor       string
+		CursorPaging bool
 	}
 	type args struct {
 		acceptedOrderByParams []string
@@ -187,6 +205,44 @@ func TestListArguments_Validate(t *testing.T) {
 			},
 			want: errors.Errorf("invalid order by clause 'region desc name owner'"),
 		},
+		{
+			name: "should return an error if a field is invalid",
+			fields: fields{
+				Page:   1,
+				Size:   100,
+				Fields: []string{"name;"},
+			},
+			args: args{
+				acceptedOrderByParams: getValidTestParams(),
+			},
+			want: errors.Errorf("invalid field 'name;'"),
+		},
+		{
+			name: "should return an error if order by is used together with cursor",
+			fields: fields{
+				Page:         1,
+				Size:         100,
+				OrderBy:      []string{"name asc"},
+				CursorPaging: true,
+			},
+			args: args{
+				acceptedOrderByParams: getValidTestParams(),
+			},
+			want: errors.Errorf("order by cannot be used together with cursor"),
+		},
+		{
+			name: "should return an error if the cursor is invalid",
+			fields: fields{
+				Page:         1,
+				Size:         100,
+				Cursor:       "invalid",
+				CursorPaging: true,
+			},
+			args: args{
+				acceptedOrderByParams: getValidTestParams(),
+			},
+			want: errors.Errorf("invalid cursor 'invalid'"),
+		},
 		{
 			name: "should return nil if the validation is completed",
 			fields: fields{
@@ -208,10 +264,13 @@ func TestListArguments_Validate(t *testing.T) {
 			t.Parallel()
 			g := gomega.NewWithT(t)
 			la := &ListArguments{
-				Page:    tt.fields.Page,
-				Size:    tt.fields.Size,
-				Search:  tt.fields.Search,
-				OrderBy: tt.fields.OrderBy,
+				Page:         tt.fields.Page,
+				Size:         tt.fields.Size,
+				Search:       tt.fields.Search,
+				OrderBy:      tt.fields.OrderBy,
+				Fields:       tt.fields.Fields,
+				Cursor:       tt.fields.Cursor,
+				CursorPaging: tt.fields.CursorPaging,
 			}
 			err := la.Validate(tt.args.acceptedOrderByParams)
 			if err != nil {
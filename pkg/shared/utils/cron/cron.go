@@ -0,0 +1,215 @@
+// Package cron parses standard five fields cron expressions and computes the times matching them.
+//
+// Times are matched against the wall clock of a location. When clocks spring forward for daylight saving time,
+// the skipped wall clock times don't exist and never match: e.g. "30 2 * * *" doesn't fire on the day 02:30 is skipped,
+// it isn't moved to the next existing time either. When clocks fall back, repeated wall clock times only match once.
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxSearchYears bounds the search of the next matching time, for expressions that never match like "0 0 30 2 *"
+const maxSearchYears = 5
+
+// Schedule is a parsed cron expression, with fields minute, hour, day of month, month and day of week
+type Schedule struct {
+	minute, hour, dayOfMonth, month, dayOfWeek bits
+	// restricted day fields are matched if either the day of month or the day of week matches, like in crontab
+	dayOfMonthStar, dayOfWeekStar bool
+}
+
+type bits uint64
+
+func (b bits) has(i int) bool {
+	return b&(1<<uint(i)) != 0
+}
+
+type field struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var (
+	minuteField     = field{name: "minute", min: 0, max: 59}
+	hourField       = field{name: "hour", min: 0, max: 23}
+	dayOfMonthField = field{name: "day of month", min: 1, max: 31}
+	monthField      = field{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6, "jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	// 7 is accepted for Sunday, and folded to 0 when parsed
+	dayOfWeekField = field{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+var macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// Parse parses a cron expression with five space separated fields, minute, hour, day of month, month and day of week.
+// Fields support "*", values, ranges "a-b", lists "a,b" and steps "*/n" or "a-b/n", months and days of week
+// may also be given by their three letters english names. The macros @yearly, @monthly, @weekly, @daily and @hourly are supported.
+func Parse(expression string) (*Schedule, error) {
+	expression = strings.TrimSpace(expression)
+	if macro, ok := macros[strings.ToLower(expression)]; ok {
+		expression = macro
+	}
+
+	fields := strings.Fields(expression)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid cron expression %q: expected 5 fields, found %d", expression, len(fields))
+	}
+
+	var s Schedule
+	var err error
+	if s.minute, err = minuteField.parse(fields[0]); err != nil {
+		return nil, err
+	}
+	if s.hour, err = hourField.parse(fields[1]); err != nil {
+		return nil, err
+	}
+	if s.dayOfMonth, err = dayOfMonthField.parse(fields[2]); err != nil {
+		return nil, err
+	}
+	if s.month, err = monthField.parse(fields[3]); err != nil {
+		return nil, err
+	}
+	if s.dayOfWeek, err = dayOfWeekField.parse(fields[4]); err != nil {
+		return nil, err
+	}
+	if s.dayOfWeek.has(7) {
+		s.dayOfWeek = s.dayOfWeek&^(1<<7) | 1
+	}
+	s.dayOfMonthStar = strings.HasPrefix(fields[2], "*")
+	s.dayOfWeekStar = strings.HasPrefix(fields[4], "*")
+
+	return &s, nil
+}
+
+func (f field) parse(value string) (bits, error) {
+	var result bits
+	for _, part := range strings.Split(value, ",") {
+		rangePart, step := part, 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			var err error
+			if step, err = strconv.Atoi(part[i+1:]); err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step %q in %s field %q", part[i+1:], f.name, value)
+			}
+			rangePart = part[:i]
+		}
+
+		var low, high int
+		switch {
+		case rangePart == "*":
+			low, high = f.min, f.max
+		case strings.Contains(rangePart, "-"):
+			bounds := strings.SplitN(rangePart, "-", 2)
+			var err error
+			if low, err = f.value(bounds[0]); err != nil {
+				return 0, err
+			}
+			if high, err = f.value(bounds[1]); err != nil {
+				return 0, err
+			}
+			if low > high {
+				return 0, fmt.Errorf("invalid range %q in %s field %q", rangePart, f.name, value)
+			}
+		default:
+			var err error
+			if low, err = f.value(rangePart); err != nil {
+				return 0, err
+			}
+			high = low
+			// a step applies to the values from low to the maximum, as in "5/15"
+			if step > 1 {
+				high = f.max
+			}
+		}
+
+		for i := low; i <= high; i += step {
+			result |= 1 << uint(i)
+		}
+	}
+	return result, nil
+}
+
+func (f field) value(s string) (int, error) {
+	if v, ok := f.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("invalid %s %q, expected a value between %d and %d", f.name, s, f.min, f.max)
+	}
+	return v, nil
+}
+
+// Next returns the first time matching the schedule strictly after the given time, in the location of the given time.
+// Wall clock times skipped when clocks spring forward never match, so Next returns the following matching time,
+// and times repeated when clocks fall back only match once. The zero time is returned if the schedule never matches.
+func (s *Schedule) Next(after time.Time) time.Time {
+	loc := after.Location()
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(maxSearchYears, 0, 0)
+
+	for t.Before(limit) {
+		if !s.month.has(int(t.Month())) {
+			t = forward(t, time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc))
+			continue
+		}
+		if !s.matchesDay(t) {
+			t = forward(t, time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc))
+			continue
+		}
+		if !s.hour.has(t.Hour()) {
+			t = forward(t, time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc))
+			continue
+		}
+		if !s.minute.has(t.Minute()) || repeated(t) {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+// forward returns next, a later wall clock time than t. When that wall clock time doesn't exist because clocks
+// sprang forward, time.Date may normalize it to a time before t, so the first hour after the gap is returned instead.
+func forward(t time.Time, next time.Time) time.Time {
+	for !next.After(t) {
+		next = next.Add(time.Hour)
+	}
+	return next
+}
+
+// repeated returns true for the second occurrence of a wall clock time, when clocks fell back within the last hours
+func repeated(t time.Time) bool {
+	_, offset := t.Zone()
+	_, earlierOffset := t.Add(-3 * time.Hour).Zone()
+	if earlierOffset <= offset {
+		return false
+	}
+	first := t.Add(-time.Duration(earlierOffset-offset) * time.Second)
+	return first.Hour() == t.Hour() && first.Minute() == t.Minute()
+}
+
+func (s *Schedule) matchesDay(t time.Time) bool {
+	dayOfMonth := s.dayOfMonth.has(t.Day())
+	dayOfWeek := s.dayOfWeek.has(int(t.Weekday()))
+	if s.dayOfMonthStar || s.dayOfWeekStar {
+		return dayOfMonth && dayOfWeek
+	}
+	return dayOfMonth || dayOfWeek
+}
@@ -0,0 +1,162 @@
+package cron_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/cron"
+	"github.com/onsi/gomega"
+)
+
+func Test_Parse(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		wantErr    bool
+	}{
+		{name: "every minute", expression: "* * * * *"},
+		{name: "lists, ranges and steps", expression: "0,30 8-18/2 1-15 */3 1-5"},
+		{name: "names", expression: "0 22 * jan-jun MON,wed"},
+		{name: "macro", expression: "@daily"},
+		{name: "sunday as 7", expression: "0 0 * * 7"},
+		{name: "missing field", expression: "0 0 * *", wantErr: true},
+		{name: "out of range", expression: "60 0 * * *", wantErr: true},
+		{name: "invalid range", expression: "0 10-2 * * *", wantErr: true},
+		{name: "invalid step", expression: "*/0 * * * *", wantErr: true},
+		{name: "invalid name", expression: "0 0 * * sunday", wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			_, err := cron.Parse(tt.expression)
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr), "Parse() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
+
+func Test_Schedule_Next(t *testing.T) {
+	// a Wednesday
+	after := time.Date(2023, time.March, 15, 10, 20, 30, 0, time.UTC)
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone data not available: %v", err)
+	}
+	// clocks spring forward at midnight
+	havana, err := time.LoadLocation("America/Havana")
+	if err != nil {
+		t.Skipf("time zone data not available: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		expression string
+		after      time.Time
+		want       time.Time
+	}{
+		{
+			name:       "next minute",
+			expression: "* * * * *",
+			after:      after,
+			want:       time.Date(2023, time.March, 15, 10, 21, 0, 0, time.UTC),
+		},
+		{
+			name:       "later today",
+			expression: "0 22 * * *",
+			after:      after,
+			want:       time.Date(2023, time.March, 15, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "tomorrow",
+			expression: "0 6 * * *",
+			after:      after,
+			want:       time.Date(2023, time.March, 16, 6, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "strictly after",
+			expression: "20 10 * * *",
+			after:      time.Date(2023, time.March, 15, 10, 20, 0, 0, time.UTC),
+			want:       time.Date(2023, time.March, 16, 10, 20, 0, 0, time.UTC),
+		},
+		{
+			name:       "day of week",
+			expression: "30 8 * * mon",
+			after:      after,
+			want:       time.Date(2023, time.March, 20, 8, 30, 0, 0, time.UTC),
+		},
+		{
+			name:       "day of month or day of week",
+			expression: "0 0 1 * fri",
+			after:      after,
+			want:       time.Date(2023, time.March, 17, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "next year",
+			expression: "0 0 1 1 *",
+			after:      after,
+			want:       time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "leap day",
+			expression: "0 0 29 2 *",
+			after:      after,
+			want:       time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "time zone",
+			expression: "0 2 * * *",
+			after:      after.In(newYork),
+			want:       time.Date(2023, time.March, 16, 2, 0, 0, 0, newYork),
+		},
+		{
+			name:       "spring forward after the gap",
+			expression: "0 3 * * *",
+			after:      time.Date(2023, time.March, 12, 0, 30, 0, 0, newYork),
+			want:       time.Date(2023, time.March, 12, 3, 0, 0, 0, newYork),
+		},
+		{
+			name:       "spring forward skipped time",
+			expression: "30 2 * * *",
+			after:      time.Date(2023, time.March, 12, 0, 30, 0, 0, newYork),
+			want:       time.Date(2023, time.March, 13, 2, 30, 0, 0, newYork),
+		},
+		{
+			name:       "fall back first occurrence",
+			expression: "30 1 * * *",
+			after:      time.Date(2023, time.November, 5, 0, 30, 0, 0, newYork),
+			want:       time.Date(2023, time.November, 5, 5, 30, 0, 0, time.UTC),
+		},
+		{
+			name:       "fall back repeated time matches once",
+			expression: "30 1 * * *",
+			after:      time.Date(2023, time.November, 5, 5, 30, 0, 0, time.UTC).In(newYork),
+			want:       time.Date(2023, time.November, 6, 1, 30, 0, 0, newYork),
+		},
+		{
+			name:       "fall back hourly",
+			expression: "0 * * * *",
+			after:      time.Date(2023, time.November, 5, 5, 30, 0, 0, time.UTC).In(newYork),
+			want:       time.Date(2023, time.November, 5, 7, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "spring forward at midnight",
+			expression: "0 0 * * *",
+			after:      time.Date(2023, time.March, 11, 12, 0, 0, 0, havana),
+			want:       time.Date(2023, time.March, 13, 0, 0, 0, 0, havana),
+		},
+		{
+			name:       "never",
+			expression: "0 0 30 2 *",
+			after:      after,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			schedule, err := cron.Parse(tt.expression)
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			g.Expect(schedule.Next(tt.after).Equal(tt.want)).To(gomega.BeTrue(), "Next() = %v, want %v", schedule.Next(tt.after), tt.want)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package workers
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// IdempotencyKeyWorkerType is the type of the worker purging the expired idempotency keys. The kas-fleet-manager and
+// the connector fleet manager share the idempotency keys table and the leases, so a single worker purges it at a time
+const IdempotencyKeyWorkerType = "idempotency_key"
+
+// IdempotencyKeyManager purges the idempotency keys whose retention window has passed
+type IdempotencyKeyManager struct {
+	BaseWorker
+	connectionFactory *db.ConnectionFactory
+}
+
+var _ Worker = &IdempotencyKeyManager{}
+
+func NewIdempotencyKeyManager(connectionFactory *db.ConnectionFactory, reconciler Reconciler) *IdempotencyKeyManager {
+	return &IdempotencyKeyManager{
+		BaseWorker: BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: IdempotencyKeyWorkerType,
+			Reconciler: reconciler,
+		},
+		connectionFactory: connectionFactory,
+	}
+}
+
+func (m *IdempotencyKeyManager) Start() {
+	m.StartWorker(m)
+}
+
+func (m *IdempotencyKeyManager) Stop() {
+	m.StopWorker(m)
+}
+
+func (m *IdempotencyKeyManager) Reconcile() []error {
+	result := m.connectionFactory.New().Where("expires_at <= ?", time.Now()).Delete(&api.IdempotencyKey{})
+	if result.Error != nil {
+		return []error{errors.Wrap(result.Error, "failed to delete expired idempotency keys")}
+	}
+	glog.V(5).Infof("deleted %d expired idempotency keys", result.RowsAffected)
+	return nil
+}
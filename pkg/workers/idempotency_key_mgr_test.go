@@ -0,0 +1,45 @@
+package workers
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func TestIdempotencyKeyManager_Reconcile(t *testing.T) {
+	tests := []struct {
+		name    string
+		setupFn func() *mocket.FakeResponse
+		wantErr bool
+	}{
+		{
+			name: "should delete the expired idempotency keys",
+			setupFn: func() *mocket.FakeResponse {
+				mocket.Catcher.Reset()
+				return mocket.Catcher.NewMock().WithQuery(`DELETE FROM "idempotency_keys" WHERE expires_at <= $1`).WithRowsNum(2)
+			},
+		},
+		{
+			name: "should return an error when the expired idempotency keys cannot be deleted",
+			setupFn: func() *mocket.FakeResponse {
+				mocket.Catcher.Reset()
+				return mocket.Catcher.NewMock().WithQuery(`DELETE FROM "idempotency_keys"`).WithExecException()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			response := tt.setupFn()
+			m := NewIdempotencyKeyManager(db.NewMockConnectionFactory(nil), Reconciler{})
+			errs := m.Reconcile()
+			g.Expect(len(errs) > 0).To(gomega.Equal(tt.wantErr))
+			g.Expect(response.Triggered).To(gomega.BeTrue())
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type LeaderElectionManager struct {
@@ -19,6 +20,11 @@ type LeaderElectionManager struct {
 	leaderElectionReconcilerRepeatInterval time.Duration
 	leaderLeaseExpirationTime              time.Duration
 	workerGrp                              sync.WaitGroup
+	// shardCount is the number of shards the work items of the workers supporting sharding are partitioned into,
+	// sharding is disabled when lower than 2
+	shardCount int
+	// replicaID identifies this replica amongst the replicas sharing the shards
+	replicaID string
 }
 
 // leaderLeaseAcquisition a wrapper for a lease and whether it's been acquired/is owned by another worker
@@ -30,12 +36,17 @@ type leaderLeaseAcquisition struct {
 }
 
 func NewLeaderElectionManager(workers []Worker, connectionFactory *db.ConnectionFactory, reconcilerConfig *ReconcilerConfig) *LeaderElectionManager {
-	return &LeaderElectionManager{
+	s := &LeaderElectionManager{
 		workers:                                workers,
 		connectionFactory:                      connectionFactory,
 		leaderElectionReconcilerRepeatInterval: reconcilerConfig.LeaderElectionReconcilerRepeatInterval,
 		leaderLeaseExpirationTime:              reconcilerConfig.LeaderLeaseExpirationTime,
+		shardCount:                             reconcilerConfig.ShardCount,
 	}
+	if s.isShardingEnabled() {
+		s.replicaID = api.NewID()
+	}
+	return s
 }
 
 func (s *LeaderElectionManager) Start() {
@@ -61,6 +72,10 @@ func (s *LeaderElectionManager) Start() {
 						s.workerGrp.Done()
 					}
 				}
+				if s.isShardingEnabled() {
+					// hand over our shards to the remaining replicas without waiting for our leases to expire
+					s.leaveReplicas()
+				}
 				return
 			}
 		}
@@ -87,6 +102,15 @@ func (s *LeaderElectionManager) Stop() {
 }
 
 func (s *LeaderElectionManager) startWorkers() {
+	var replicaIDs []string
+	if s.isShardingEnabled() {
+		var err error
+		replicaIDs, err = s.heartbeat(s.connectionFactory.New())
+		if err != nil {
+			glog.Errorf("failed to update the replica heartbeat: %s", err)
+		}
+	}
+
 	newWorkers := make([]Worker, 0)
 	for _, worker := range s.workers {
 		if worker.HasTerminated() {
@@ -99,7 +123,13 @@ func (s *LeaderElectionManager) startWorkers() {
 		}
 		newWorkers = append(newWorkers, worker)
 
-		isLeader := s.isWorkerLeader(worker)
+		var isLeader bool
+		if s.isShardingEnabled() && worker.SupportsSharding() {
+			// a sharded worker runs as long as it owns at least one shard
+			isLeader = s.acquireShards(worker, replicaIDs)
+		} else {
+			isLeader = s.isWorkerLeader(worker)
+		}
 		if isLeader && !worker.IsRunning() {
 			glog.V(1).Infoln(fmt.Sprintf("Running as the leader and starting worker %T [%s]", worker, worker.GetID()))
 			worker.Start()
@@ -135,6 +165,127 @@ func (s *LeaderElectionManager) isWorkerLeader(worker Worker) bool {
 	return true
 }
 
+func (s *LeaderElectionManager) isShardingEnabled() bool {
+	return s.shardCount > 1
+}
+
+// heartbeat extends the lease tracking this replica, removes the leases of the replicas that stopped extending theirs
+// and returns the ids of the live replicas
+func (s *LeaderElectionManager) heartbeat(dbConn *gorm.DB) ([]string, error) {
+	now := time.Now()
+	expires := now.Add(s.leaderLeaseExpirationTime)
+	result := dbConn.Model(&api.LeaderLease{}).Where("lease_type = ?", ReplicaLeaseType(s.replicaID)).Update("expires", expires)
+	if result.Error != nil {
+		return nil, errors.Wrap(result.Error, "failed to extend replica lease")
+	}
+	if result.RowsAffected == 0 {
+		// this replica is joining, or its lease expired and has been removed by another replica
+		lease := &api.LeaderLease{
+			Leader:    s.replicaID,
+			LeaseType: ReplicaLeaseType(s.replicaID),
+			Expires:   &expires,
+		}
+		if err := dbConn.Clauses(clause.OnConflict{DoNothing: true}).Create(lease).Error; err != nil {
+			return nil, errors.Wrap(err, "failed to create replica lease")
+		}
+	}
+
+	if err := dbConn.Unscoped().Where("lease_type LIKE ? AND expires < ?", ReplicaLeaseTypePrefix+"%", now).Delete(&api.LeaderLease{}).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to remove expired replica leases")
+	}
+
+	var leaseTypes []string
+	if err := dbConn.Model(&api.LeaderLease{}).Where("lease_type LIKE ?", ReplicaLeaseTypePrefix+"%").Pluck("lease_type", &leaseTypes).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to list replica leases")
+	}
+
+	return sortedReplicaIDs(leaseTypes), nil
+}
+
+// leaveReplicas removes the lease of this replica and releases the shard leases of its workers
+func (s *LeaderElectionManager) leaveReplicas() {
+	dbConn := s.connectionFactory.New()
+	if err := dbConn.Unscoped().Where("lease_type = ?", ReplicaLeaseType(s.replicaID)).Delete(&api.LeaderLease{}).Error; err != nil {
+		glog.Errorf("failed to remove replica lease: %s", err)
+	}
+	for _, worker := range s.workers {
+		if !worker.SupportsSharding() {
+			continue
+		}
+		for shard := 0; shard < s.shardCount; shard++ {
+			if err := s.releaseLeaderLease(worker.GetID(), ShardLeaseType(worker.GetWorkerType(), shard), dbConn); err != nil {
+				glog.Errorf("failed to release shard lease: %s", err)
+			}
+		}
+	}
+}
+
+// acquireShards acquires the leases of the shards assigned to this replica, releases the leases of the other shards and
+// hands the owned shards over to the worker. It returns whether the worker owns at least one shard
+func (s *LeaderElectionManager) acquireShards(worker Worker, replicaIDs []string) bool {
+	dbConn := s.connectionFactory.New()
+	workerType := worker.GetWorkerType()
+
+	if err := s.createShardLeases(workerType, dbConn); err != nil {
+		glog.Errorf("failed to create shard leases: %s", err)
+		worker.SetShards(s.shardCount, nil)
+		return false
+	}
+
+	assigned := map[int]bool{}
+	for _, shard := range assignShards(workerType, s.shardCount, replicaIDs, s.replicaID) {
+		assigned[shard] = true
+	}
+
+	owned := []int{}
+	for shard := 0; shard < s.shardCount; shard++ {
+		leaseType := ShardLeaseType(workerType, shard)
+		if !assigned[shard] {
+			// the shard has been rebalanced to another replica
+			if err := s.releaseLeaderLease(worker.GetID(), leaseType, dbConn); err != nil {
+				glog.Errorf("failed to release shard lease: %s", err)
+			}
+			continue
+		}
+
+		// the previous owner of a rebalanced shard may still hold its lease, the shard is owned once it is released or expired
+		acquisition, err := s.acquireLeaderLease(worker.GetID(), leaseType, dbConn)
+		if err != nil {
+			glog.V(5).Infof("failed to acquire shard lease: %s", err)
+			continue
+		}
+		if acquisition.acquired {
+			owned = append(owned, shard)
+		}
+	}
+
+	glog.V(5).Infof("worker %T [%s] owns shards %v of %d", worker, worker.GetID(), owned, s.shardCount)
+	worker.SetShards(s.shardCount, owned)
+	return len(owned) > 0
+}
+
+// createShardLeases creates the missing leases of the shards of the given worker type
+func (s *LeaderElectionManager) createShardLeases(workerType string, dbConn *gorm.DB) error {
+	expires := time.Now()
+	for shard := 0; shard < s.shardCount; shard++ {
+		lease := &api.LeaderLease{
+			LeaseType: ShardLeaseType(workerType, shard),
+			Expires:   &expires,
+		}
+		if err := dbConn.Clauses(clause.OnConflict{DoNothing: true}).Create(lease).Error; err != nil {
+			return errors.Wrapf(err, "failed to create lease for shard %d of %s", shard, workerType)
+		}
+	}
+	return nil
+}
+
+// releaseLeaderLease expires the lease of the given type if it is held by the given worker
+func (s *LeaderElectionManager) releaseLeaderLease(workerId string, leaseType string, dbConn *gorm.DB) error {
+	return dbConn.Model(&api.LeaderLease{}).
+		Where("lease_type = ? AND leader = ?", leaseType, workerId).
+		Updates(map[string]interface{}{"leader": "", "expires": time.Now()}).Error
+}
+
 // acquireLeaderLease attempt to claim the leader role using a provided table and return a leaderLeaseAcquisition
 // containing the lease
 func (s *LeaderElectionManager) acquireLeaderLease(workerId string, workerType string, dbConn *gorm.DB) (*leaderLeaseAcquisition, error) {
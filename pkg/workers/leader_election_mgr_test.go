@@ -1,6 +1,7 @@
 package workers
 
 import (
+	"database/sql/driver"
 	"errors"
 	"strings"
 	"sync"
@@ -777,3 +778,68 @@ func Test_HasTerminated(t *testing.T) {
 		})
 	}
 }
+
+func TestLeaderElectionManager_acquireShards(t *testing.T) {
+	tests := []struct {
+		name         string
+		replicaIDs   []string
+		wantShards   []int
+		wantLeader   bool
+		wantReleased bool
+	}{
+		{
+			name:       "should own all the shards when running as the only replica",
+			replicaIDs: []string{"replica-1"},
+			wantShards: []int{0, 1},
+			wantLeader: true,
+		},
+		{
+			name:         "should release its shards when no shard is assigned to the replica",
+			replicaIDs:   []string{"replica-2"},
+			wantShards:   []int{},
+			wantLeader:   false,
+			wantReleased: true,
+		},
+	}
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			released := false
+			mocket.Catcher.Reset().
+				NewMock().
+				WithQuery("SELECT * FROM leader_leases").
+				WithReply([]map[string]interface{}{{
+					"leader":  "01",
+					"expires": time.Now().Add(time.Hour),
+				}})
+			mocket.Catcher.NewMock().
+				WithQuery(`UPDATE "leader_leases" SET "expires"=$1,"leader"=$2`).
+				WithCallback(func(s string, nv []driver.NamedValue) {
+					released = true
+				})
+
+			worker := &WorkerMock{
+				GetIDFunc: func() string {
+					return "01"
+				},
+				GetWorkerTypeFunc: func() string {
+					return "connector"
+				},
+				SetShardsFunc: func(shardCount int, shards []int) {},
+			}
+			s := &LeaderElectionManager{
+				connectionFactory:         db.NewMockConnectionFactory(nil),
+				leaderLeaseExpirationTime: time.Minute,
+				shardCount:                2,
+				replicaID:                 "replica-1",
+			}
+
+			g.Expect(s.acquireShards(worker, tt.replicaIDs)).To(gomega.Equal(tt.wantLeader))
+			g.Expect(worker.SetShardsCalls()).To(gomega.HaveLen(1))
+			g.Expect(worker.SetShardsCalls()[0].ShardCount).To(gomega.Equal(2))
+			g.Expect(worker.SetShardsCalls()[0].Shards).To(gomega.Equal(tt.wantShards))
+			g.Expect(released).To(gomega.Equal(tt.wantReleased))
+		})
+	}
+}
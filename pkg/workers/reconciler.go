@@ -16,9 +16,14 @@ import (
 
 type Reconciler struct {
 	di.Inject
-	wakeup           chan *sync.WaitGroup
-	SignalBus        signalbus.SignalBus
-	ReconcilerConfig *ReconcilerConfig
+	wakeup              chan *sync.WaitGroup
+	SignalBus           signalbus.SignalBus
+	ReconcilerConfig    *ReconcilerConfig
+	WorkerStatusService WorkerStatusService
+	WorkQueueService    WorkQueueService
+	// paused is the status of the worker type read at pausedReadAt, see isPaused
+	paused       bool
+	pausedReadAt time.Time
 }
 
 // Wakeup causes the worker reconcile to be performed as soon as possible.  If wait is true, the this
@@ -41,32 +46,34 @@ func (r *Reconciler) Wakeup(wait bool) {
 
 func (r *Reconciler) Start(worker Worker) {
 	r.wakeup = make(chan *sync.WaitGroup, 1)
+	r.pausedReadAt = time.Time{}
 	*worker.GetStopChan() = make(chan struct{})
 	worker.GetSyncGroup().Add(1)
 	worker.SetIsRunning(true)
 
-	sub := r.SignalBus.Subscribe("reconcile:" + worker.GetWorkerType())
+	sub := r.SignalBus.Subscribe(ReconcileSignal(worker.GetWorkerType()))
 	ticker := time.NewTicker(r.ReconcilerConfig.ReconcilerRepeatInterval)
 
 	go func() {
 		defer sub.Close()
 		//starts reconcile immediately and then on every repeat interval
 		glog.V(1).Infoln(fmt.Sprintf("Initial reconciliation loop for %T [%s]", worker, worker.GetID()))
-		r.runReconcile(worker)
+		r.runReconcile(worker, true)
 		for {
 			select {
 			case wg := <-r.wakeup: //we were asked to wake up...
 				glog.V(1).Infoln(fmt.Sprintf("Wakeup triggered reconciliation loop for %T [%s]", worker, worker.GetID()))
-				r.runReconcile(worker)
+				r.runReconcile(worker, true)
 				if wg != nil {
 					wg.Done()
 				}
 			case <-ticker.C: //time out
 				glog.V(1).Infoln(fmt.Sprintf("Timeout triggered reconciliation loop for %T [%s]", worker, worker.GetID()))
-				r.runReconcile(worker)
+				r.runReconcile(worker, false)
 			case <-sub.Signal():
+				// the worker type is signalled when it's resumed, its status is read again
 				glog.V(1).Infoln(fmt.Sprintf("Signalbus triggered reconciliation loop for %T [%s]", worker, worker.GetID()))
-				r.runReconcile(worker)
+				r.runReconcile(worker, true)
 			case <-*worker.GetStopChan():
 				ticker.Stop()
 				defer worker.GetSyncGroup().Done()
@@ -77,7 +84,12 @@ func (r *Reconciler) Start(worker Worker) {
 	}()
 }
 
-func (r *Reconciler) runReconcile(worker Worker) {
+func (r *Reconciler) runReconcile(worker Worker, readStatus bool) {
+	if r.isPaused(worker, readStatus) {
+		glog.V(1).Infoln(fmt.Sprintf("Skipping reconciliation loop for paused %T [%s]", worker, worker.GetID()))
+		return
+	}
+
 	start := time.Now()
 	errors := worker.Reconcile()
 	if len(errors) == 0 {
@@ -90,6 +102,37 @@ func (r *Reconciler) runReconcile(worker Worker) {
 	for _, e := range errors {
 		logger.Logger.Error(e)
 	}
+
+	if r.WorkerStatusService != nil {
+		if err := r.WorkerStatusService.RecordReconcile(worker.GetWorkerType(), start, errors, time.Now().Add(r.ReconcilerConfig.ReconcilerRepeatInterval)); err != nil {
+			logger.Logger.Error(err)
+		}
+	}
+}
+
+// isPaused returns true if the reconciles of the worker type were paused. The status of the worker type is read at most
+// once per leader election interval, unless readStatus is set. Worker types without a status are never paused,
+// and reconciles are not skipped when the status of the worker type can't be read
+func (r *Reconciler) isPaused(worker Worker, readStatus bool) bool {
+	if r.WorkerStatusService == nil {
+		return false
+	}
+	if !readStatus && time.Since(r.pausedReadAt) < r.ReconcilerConfig.LeaderElectionReconcilerRepeatInterval {
+		return r.paused
+	}
+
+	status, err := r.WorkerStatusService.Get(worker.GetWorkerType())
+	switch {
+	case err == nil:
+		r.paused = status.Paused
+	case err.Is404():
+		r.paused = false
+	default:
+		logger.Logger.Error(err)
+		return false
+	}
+	r.pausedReadAt = time.Now()
+	return r.paused
 }
 
 func (r *Reconciler) Stop(worker Worker) {
@@ -10,6 +10,10 @@ type ReconcilerConfig struct {
 	ReconcilerRepeatInterval               time.Duration `json:"reconciler_repeat_interval"`
 	LeaderLeaseExpirationTime              time.Duration `json:"leader_lease_expiration_time"`
 	LeaderElectionReconcilerRepeatInterval time.Duration `json:"leader_election_reconciler_repeat_interval"`
+	ShardCount                             int           `json:"shard_count"`
+	RetryInitialBackoff                    time.Duration `json:"retry_initial_backoff"`
+	RetryMaxBackoff                        time.Duration `json:"retry_max_backoff"`
+	RetryMaxAttempts                       int           `json:"retry_max_attempts"`
 }
 
 func NewReconcilerConfig() *ReconcilerConfig {
@@ -17,6 +21,9 @@ func NewReconcilerConfig() *ReconcilerConfig {
 		ReconcilerRepeatInterval:               30 * time.Second,
 		LeaderLeaseExpirationTime:              1 * time.Minute,
 		LeaderElectionReconcilerRepeatInterval: 15 * time.Second,
+		RetryInitialBackoff:                    30 * time.Second,
+		RetryMaxBackoff:                        30 * time.Minute,
+		RetryMaxAttempts:                       10,
 	}
 }
 
@@ -24,6 +31,10 @@ func (r *ReconcilerConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.DurationVar(&r.ReconcilerRepeatInterval, "reconciler-repeat-interval", r.ReconcilerRepeatInterval, "The frequency at which each scheduled reconciler worker is running.")
 	fs.DurationVar(&r.LeaderLeaseExpirationTime, "leader-lease-expiration-time", r.LeaderLeaseExpirationTime, "The time before a lease expires.")
 	fs.DurationVar(&r.LeaderElectionReconcilerRepeatInterval, "leader-election-reconciler-repeat-interval", r.LeaderElectionReconcilerRepeatInterval, "The scheduled interval between leader election reconciliation.")
+	fs.IntVar(&r.ShardCount, "reconciler-shard-count", r.ShardCount, "The number of shards the work items of the workers supporting sharding are partitioned into across the replicas. Sharding is disabled when lower than 2.")
+	fs.DurationVar(&r.RetryInitialBackoff, "reconciler-retry-initial-backoff", r.RetryInitialBackoff, "The time before a work item failing to reconcile is reconciled again, it doubles after each failed attempt.")
+	fs.DurationVar(&r.RetryMaxBackoff, "reconciler-retry-max-backoff", r.RetryMaxBackoff, "The maximum time before a work item failing to reconcile is reconciled again.")
+	fs.IntVar(&r.RetryMaxAttempts, "reconciler-retry-max-attempts", r.RetryMaxAttempts, "The number of failed attempts after which a work item is quarantined and no longer reconciled until it is released. Work items are never quarantined when set to 0.")
 }
 
 func (c *ReconcilerConfig) ReadFiles() error {
@@ -2,10 +2,13 @@ package workers
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
 
 	"github.com/onsi/gomega"
@@ -79,3 +82,157 @@ func TestReconciler_Wakeup(t *testing.T) {
 	// We can use a 0 timeout here because Wakeup will wait for the reconcile to occur first.
 	g.Expect(waitForReconcile(0)).Should(gomega.Equal(false))
 }
+
+func TestReconciler_runReconcile(t *testing.T) {
+	tests := []struct {
+		name              string
+		getStatusFn       func(workerType string) (*api.LeaderLease, *errors.ServiceError)
+		wantReconciled    bool
+		wantRecordedError bool
+	}{
+		{
+			name: "should reconcile and record the result when the worker type is not paused",
+			getStatusFn: func(workerType string) (*api.LeaderLease, *errors.ServiceError) {
+				return &api.LeaderLease{LeaseType: workerType}, nil
+			},
+			wantReconciled:    true,
+			wantRecordedError: true,
+		},
+		{
+			name: "should skip the reconcile when the worker type is paused",
+			getStatusFn: func(workerType string) (*api.LeaderLease, *errors.ServiceError) {
+				return &api.LeaderLease{LeaseType: workerType, Paused: true}, nil
+			},
+			wantReconciled: false,
+		},
+		{
+			name: "should reconcile when the worker type has no status",
+			getStatusFn: func(workerType string) (*api.LeaderLease, *errors.ServiceError) {
+				return nil, errors.NotFound("worker type '%s' not found", workerType)
+			},
+			wantReconciled:    true,
+			wantRecordedError: true,
+		},
+		{
+			name: "should reconcile when the status of the worker type cannot be read",
+			getStatusFn: func(workerType string) (*api.LeaderLease, *errors.ServiceError) {
+				return nil, errors.GeneralError("test")
+			},
+			wantReconciled:    true,
+			wantRecordedError: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			workerStatusService := &WorkerStatusServiceMock{
+				GetFunc: tt.getStatusFn,
+				RecordReconcileFunc: func(workerType string, reconciledAt time.Time, reconcileErrors []error, nextReconcileAt time.Time) *errors.ServiceError {
+					return nil
+				},
+			}
+			r := Reconciler{
+				SignalBus:           signalbus.NewSignalBus(),
+				ReconcilerConfig:    NewReconcilerConfig(),
+				WorkerStatusService: workerStatusService,
+			}
+			reconciled := false
+			worker := &WorkerMock{
+				GetIDFunc: func() string {
+					return "test"
+				},
+				GetWorkerTypeFunc: func() string {
+					return "test"
+				},
+				ReconcileFunc: func() []error {
+					reconciled = true
+					return []error{fmt.Errorf("reconcile error")}
+				},
+			}
+
+			r.runReconcile(worker, true)
+
+			g.Expect(reconciled).To(gomega.Equal(tt.wantReconciled))
+			if tt.wantRecordedError {
+				g.Expect(workerStatusService.RecordReconcileCalls()).To(gomega.HaveLen(1))
+				g.Expect(workerStatusService.RecordReconcileCalls()[0].ReconcileErrors).To(gomega.HaveLen(1))
+			} else {
+				g.Expect(workerStatusService.RecordReconcileCalls()).To(gomega.BeEmpty())
+			}
+		})
+	}
+}
+
+func TestReconciler_isPaused(t *testing.T) {
+	tests := []struct {
+		name            string
+		readStatus      bool
+		pausedReadAt    time.Time
+		getStatusErr    *errors.ServiceError
+		want            bool
+		wantStatusReads int
+		wantCached      bool
+	}{
+		{
+			name:            "should read the status of the worker type the first time",
+			want:            true,
+			wantStatusReads: 1,
+			wantCached:      true,
+		},
+		{
+			name:         "should reuse the status of the worker type read during the leader election interval",
+			pausedReadAt: time.Now(),
+		},
+		{
+			name:            "should read the status of the worker type again when asked to",
+			readStatus:      true,
+			pausedReadAt:    time.Now(),
+			want:            true,
+			wantStatusReads: 1,
+			wantCached:      true,
+		},
+		{
+			name:            "should read the status of the worker type again after the leader election interval",
+			pausedReadAt:    time.Now().Add(-time.Hour),
+			want:            true,
+			wantStatusReads: 1,
+			wantCached:      true,
+		},
+		{
+			name:            "should not cache the status of the worker type when it cannot be read",
+			getStatusErr:    errors.GeneralError("test"),
+			wantStatusReads: 1,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			workerStatusService := &WorkerStatusServiceMock{
+				GetFunc: func(workerType string) (*api.LeaderLease, *errors.ServiceError) {
+					if tt.getStatusErr != nil {
+						return nil, tt.getStatusErr
+					}
+					return &api.LeaderLease{LeaseType: workerType, Paused: true}, nil
+				},
+			}
+			r := Reconciler{
+				ReconcilerConfig:    NewReconcilerConfig(),
+				WorkerStatusService: workerStatusService,
+				pausedReadAt:        tt.pausedReadAt,
+			}
+			worker := &WorkerMock{
+				GetWorkerTypeFunc: func() string {
+					return "test"
+				},
+			}
+
+			g.Expect(r.isPaused(worker, tt.readStatus)).To(gomega.Equal(tt.want))
+			g.Expect(workerStatusService.GetCalls()).To(gomega.HaveLen(tt.wantStatusReads))
+			g.Expect(r.pausedReadAt.After(tt.pausedReadAt)).To(gomega.Equal(tt.wantCached))
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package workers
+
+import (
+	"crypto/md5"
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+)
+
+// ReplicaLeaseTypePrefix prefixes the lease types of the heartbeat leases used to track the replicas taking part in
+// the sharded reconciliation
+const ReplicaLeaseTypePrefix = "replica:"
+
+// ShardOf returns the shard the resource with the given id belongs to when the work items are partitioned into
+// shardCount shards. The shard is computed from the first 32 bits of the md5 sum of the id, so that it can also be
+// computed by the database, see ShardCondition
+func ShardOf(resourceID string, shardCount int) int {
+	if shardCount <= 1 {
+		return 0
+	}
+	sum := md5.Sum([]byte(resourceID))
+	return int(binary.BigEndian.Uint32(sum[:4]) % uint32(shardCount))
+}
+
+// ShardCondition returns a SQL condition, with its arguments, matching the rows whose id in the given column belongs
+// to one of the given shards, as computed by ShardOf
+func ShardCondition(column string, shardCount int, shards []int) (string, []interface{}) {
+	return fmt.Sprintf("('x' || substr(md5(%s), 1, 8))::bit(32)::bigint %% ? IN ?", column), []interface{}{shardCount, shards}
+}
+
+// ShardLeaseType returns the lease type of the given shard of a worker type
+func ShardLeaseType(workerType string, shard int) string {
+	return fmt.Sprintf("%s_shard_%d", workerType, shard)
+}
+
+// ReplicaLeaseType returns the lease type of the heartbeat lease of the given replica
+func ReplicaLeaseType(replicaID string) string {
+	return ReplicaLeaseTypePrefix + replicaID
+}
+
+// assignShards returns the shards of the shardCount shards of a worker type assigned to the given replica.
+//
+// Shards are assigned with rendezvous hashing: every replica computes the same assignment from the list of live
+// replicas, and only the shards of a replica joining or leaving are moved when the list changes
+func assignShards(workerType string, shardCount int, replicaIDs []string, replicaID string) []int {
+	shards := []int{}
+	for shard := 0; shard < shardCount; shard++ {
+		owner := ""
+		var ownerWeight uint64
+		for _, candidate := range replicaIDs {
+			weight := hash(fmt.Sprintf("%s/%s/%d", candidate, workerType, shard))
+			// break ties on the replica id so that all the replicas agree on the owner
+			if owner == "" || weight > ownerWeight || (weight == ownerWeight && candidate < owner) {
+				owner = candidate
+				ownerWeight = weight
+			}
+		}
+		if owner == replicaID {
+			shards = append(shards, shard)
+		}
+	}
+	return shards
+}
+
+func hash(value string) uint64 {
+	h := fnv.New64a()
+	// writing to a hash never returns an error
+	_, _ = h.Write([]byte(value))
+	return h.Sum64()
+}
+
+func sortedReplicaIDs(leaseTypes []string) []string {
+	replicaIDs := make([]string, 0, len(leaseTypes))
+	for _, leaseType := range leaseTypes {
+		replicaIDs = append(replicaIDs, strings.TrimPrefix(leaseType, ReplicaLeaseTypePrefix))
+	}
+	sort.Strings(replicaIDs)
+	return replicaIDs
+}
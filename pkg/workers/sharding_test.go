@@ -0,0 +1,177 @@
+package workers
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+func TestShardOf(t *testing.T) {
+	tests := []struct {
+		name       string
+		resourceID string
+		shardCount int
+	}{
+		{
+			name:       "should return shard 0 when sharding is disabled",
+			resourceID: "resource-id",
+			shardCount: 0,
+		},
+		{
+			name:       "should return a shard lower than the shard count",
+			resourceID: "resource-id",
+			shardCount: 4,
+		},
+	}
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			t.Parallel()
+			shard := ShardOf(tt.resourceID, tt.shardCount)
+			g.Expect(shard).To(gomega.BeNumerically(">=", 0))
+			if tt.shardCount <= 1 {
+				g.Expect(shard).To(gomega.Equal(0))
+			} else {
+				g.Expect(shard).To(gomega.BeNumerically("<", tt.shardCount))
+			}
+			g.Expect(ShardOf(tt.resourceID, tt.shardCount)).To(gomega.Equal(shard))
+		})
+	}
+}
+
+func Test_assignShards(t *testing.T) {
+	tests := []struct {
+		name       string
+		shardCount int
+		replicaIDs []string
+	}{
+		{
+			name:       "should assign all the shards to a single replica",
+			shardCount: 8,
+			replicaIDs: []string{"replica-1"},
+		},
+		{
+			name:       "should assign each shard to exactly one replica",
+			shardCount: 16,
+			replicaIDs: []string{"replica-1", "replica-2", "replica-3"},
+		},
+	}
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			t.Parallel()
+			owners := map[int]string{}
+			for _, replicaID := range tt.replicaIDs {
+				for _, shard := range assignShards("test", tt.shardCount, tt.replicaIDs, replicaID) {
+					g.Expect(owners).ToNot(gomega.HaveKey(shard))
+					owners[shard] = replicaID
+				}
+			}
+			g.Expect(owners).To(gomega.HaveLen(tt.shardCount))
+		})
+	}
+}
+
+func Test_assignShards_rebalance(t *testing.T) {
+	g := gomega.NewWithT(t)
+	shardCount := 32
+	before := []string{"replica-1", "replica-2", "replica-3"}
+	after := []string{"replica-1", "replica-2", "replica-3", "replica-4"}
+
+	// only the shards taken over by the joining replica should move
+	for _, replicaID := range before {
+		remaining := assignShards("test", shardCount, after, replicaID)
+		g.Expect(assignShards("test", shardCount, before, replicaID)).To(gomega.ContainElements(remaining))
+	}
+}
+
+func TestBaseWorker_OwnsResource(t *testing.T) {
+	tests := []struct {
+		name       string
+		shardCount int
+		shards     func(id string) []int
+		want       bool
+	}{
+		{
+			name:       "should own every resource when sharding is disabled",
+			shardCount: 0,
+			shards:     func(id string) []int { return nil },
+			want:       true,
+		},
+		{
+			name:       "should own the resources of its shards",
+			shardCount: 4,
+			shards:     func(id string) []int { return []int{ShardOf(id, 4)} },
+			want:       true,
+		},
+		{
+			name:       "should not own the resources of the other shards",
+			shardCount: 4,
+			shards:     func(id string) []int { return []int{(ShardOf(id, 4) + 1) % 4} },
+			want:       false,
+		},
+	}
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			t.Parallel()
+			b := &BaseWorker{}
+			b.SetShards(tt.shardCount, tt.shards("resource-id"))
+			g.Expect(b.OwnsResource("resource-id")).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func TestShardCondition(t *testing.T) {
+	g := gomega.NewWithT(t)
+	condition, args := ShardCondition("kafka_requests.id", 4, []int{1, 3})
+	g.Expect(condition).To(gomega.Equal("('x' || substr(md5(kafka_requests.id), 1, 8))::bit(32)::bigint % ? IN ?"))
+	g.Expect(args).To(gomega.Equal([]interface{}{4, []int{1, 3}}))
+}
+
+func TestBaseWorker_ShardCondition(t *testing.T) {
+	tests := []struct {
+		name          string
+		shardCount    int
+		shards        []int
+		wantCondition bool
+	}{
+		{
+			name:       "should not restrict the query when sharding is disabled",
+			shardCount: 0,
+		},
+		{
+			name:          "should restrict the query to the owned shards",
+			shardCount:    4,
+			shards:        []int{2},
+			wantCondition: true,
+		},
+		{
+			name:          "should restrict the query when no shard is owned",
+			shardCount:    4,
+			shards:        []int{},
+			wantCondition: true,
+		},
+	}
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			t.Parallel()
+			b := &BaseWorker{}
+			b.SetShards(tt.shardCount, tt.shards)
+			condition, args := b.ShardCondition("id")
+			if !tt.wantCondition {
+				g.Expect(condition).To(gomega.BeEmpty())
+				g.Expect(args).To(gomega.BeEmpty())
+				return
+			}
+			wantCondition, wantArgs := ShardCondition("id", tt.shardCount, tt.shards)
+			g.Expect(condition).To(gomega.Equal(wantCondition))
+			g.Expect(args).To(gomega.Equal(wantArgs))
+		})
+	}
+}
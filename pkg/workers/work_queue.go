@@ -0,0 +1,98 @@
+package workers
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+// WorkQueue reconciles the work items of a worker, backing off the work items failing to reconcile and
+// quarantining the ones that keep failing instead of retrying them on every reconcile
+type WorkQueue struct {
+	workerType string
+	service    WorkQueueService
+	failures   map[string]*api.WorkItemFailure
+}
+
+// NewWorkQueue creates the work queue of the given worker type. Failures are not tracked when the service is nil
+func NewWorkQueue(workerType string, service WorkQueueService) *WorkQueue {
+	return &WorkQueue{
+		workerType: workerType,
+		service:    service,
+		failures:   map[string]*api.WorkItemFailure{},
+	}
+}
+
+// Refresh loads the failures of the work items, it is expected to be called at the beginning of each reconcile
+func (q *WorkQueue) Refresh() error {
+	q.failures = map[string]*api.WorkItemFailure{}
+	if q.service == nil {
+		return nil
+	}
+
+	failures, err := q.service.ListFailures(q.workerType)
+	if err != nil {
+		return err
+	}
+	for _, failure := range failures {
+		q.failures[failure.ResourceID] = failure
+	}
+	q.updateQuarantinedItemsMetric()
+	return nil
+}
+
+// Process reconciles the given work item with reconcileFn unless it is backing off or quarantined.
+// It returns the error of reconcileFn, nil when the work item is skipped
+func (q *WorkQueue) Process(resourceID string, reconcileFn func() error) error {
+	failure := q.failures[resourceID]
+	if failure != nil {
+		if failure.IsQuarantined() {
+			glog.V(10).Infof("skipping quarantined work item %s of worker type %s", resourceID, q.workerType)
+			return nil
+		}
+		if time.Now().Before(failure.NextAttemptAt) {
+			glog.V(10).Infof("backing off work item %s of worker type %s until %s", resourceID, q.workerType, failure.NextAttemptAt)
+			return nil
+		}
+	}
+
+	err := reconcileFn()
+	if q.service == nil {
+		return err
+	}
+
+	if err == nil {
+		if failure != nil {
+			if svcErr := q.service.ClearFailures(q.workerType, resourceID); svcErr != nil {
+				glog.Errorf("failed to clear the failures of work item %s of worker type %s: %v", resourceID, q.workerType, svcErr)
+			}
+			delete(q.failures, resourceID)
+		}
+		return nil
+	}
+
+	recorded, svcErr := q.service.RecordFailure(q.workerType, resourceID, err)
+	if svcErr != nil {
+		glog.Errorf("failed to record the failure of work item %s of worker type %s: %v", resourceID, q.workerType, svcErr)
+		return err
+	}
+	q.failures[resourceID] = recorded
+	if recorded.IsQuarantined() {
+		q.updateQuarantinedItemsMetric()
+		return errors.Wrapf(err, "quarantined after %d failed attempts", recorded.Attempts)
+	}
+	return err
+}
+
+func (q *WorkQueue) updateQuarantinedItemsMetric() {
+	quarantined := 0
+	for _, failure := range q.failures {
+		if failure.IsQuarantined() {
+			quarantined++
+		}
+	}
+	metrics.UpdateReconcilerQuarantinedItemsMetric(q.workerType, quarantined)
+}
@@ -0,0 +1,128 @@
+package workers
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
+	"gorm.io/gorm"
+)
+
+// WorkQueueService persists the failed reconciles of the work items of the workers, so that the backoff and quarantine
+// of a work item survive a change of leader and quarantined work items can be listed and released from any replica
+//
+//go:generate moq -out work_queue_service_moq.go . WorkQueueService
+type WorkQueueService interface {
+	// ListFailures returns the failures of the work items of the given worker type
+	ListFailures(workerType string) (api.WorkItemFailureList, *errors.ServiceError)
+	// ListQuarantined returns the quarantined work items of the given worker type
+	ListQuarantined(workerType string) (api.WorkItemFailureList, *errors.ServiceError)
+	// RecordFailure records a failed reconcile of a work item, backing it off or quarantining it once it reached the maximum number of attempts
+	RecordFailure(workerType string, resourceID string, reconcileErr error) (*api.WorkItemFailure, *errors.ServiceError)
+	// ClearFailures removes the failures of a work item once it is successfully reconciled
+	ClearFailures(workerType string, resourceID string) *errors.ServiceError
+	// Release releases a quarantined work item so that it is reconciled again
+	Release(workerType string, resourceID string) *errors.ServiceError
+}
+
+type workQueueService struct {
+	connectionFactory *db.ConnectionFactory
+	reconcilerConfig  *ReconcilerConfig
+	signalBus         signalbus.SignalBus
+}
+
+var _ WorkQueueService = &workQueueService{}
+
+func NewWorkQueueService(connectionFactory *db.ConnectionFactory, reconcilerConfig *ReconcilerConfig, signalBus signalbus.SignalBus) WorkQueueService {
+	return &workQueueService{
+		connectionFactory: connectionFactory,
+		reconcilerConfig:  reconcilerConfig,
+		signalBus:         signalBus,
+	}
+}
+
+func (s *workQueueService) ListFailures(workerType string) (api.WorkItemFailureList, *errors.ServiceError) {
+	var failures api.WorkItemFailureList
+	if err := s.connectionFactory.New().Where("worker_type = ?", workerType).Find(&failures).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list the failures of worker type '%s'", workerType)
+	}
+	return failures, nil
+}
+
+func (s *workQueueService) ListQuarantined(workerType string) (api.WorkItemFailureList, *errors.ServiceError) {
+	var failures api.WorkItemFailureList
+	if err := s.connectionFactory.New().
+		Where("worker_type = ? AND quarantined_at IS NOT NULL", workerType).
+		Order("quarantined_at").
+		Find(&failures).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list the quarantined work items of worker type '%s'", workerType)
+	}
+	return failures, nil
+}
+
+func (s *workQueueService) RecordFailure(workerType string, resourceID string, reconcileErr error) (*api.WorkItemFailure, *errors.ServiceError) {
+	dbConn := s.connectionFactory.New()
+
+	var failure api.WorkItemFailure
+	if err := dbConn.Where("worker_type = ? AND resource_id = ?", workerType, resourceID).First(&failure).Error; err != nil {
+		if err != gorm.ErrRecordNotFound {
+			return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to get the failures of work item '%s' of worker type '%s'", resourceID, workerType)
+		}
+		failure = api.WorkItemFailure{
+			WorkerType: workerType,
+			ResourceID: resourceID,
+		}
+	}
+
+	now := time.Now()
+	failure.Attempts++
+	failure.LastError = reconcileErr.Error()
+	failure.NextAttemptAt = now.Add(s.backoff(failure.Attempts))
+	if s.reconcilerConfig.RetryMaxAttempts > 0 && failure.Attempts >= s.reconcilerConfig.RetryMaxAttempts {
+		failure.QuarantinedAt = &now
+	}
+
+	if err := dbConn.Save(&failure).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to record the failure of work item '%s' of worker type '%s'", resourceID, workerType)
+	}
+	return &failure, nil
+}
+
+func (s *workQueueService) ClearFailures(workerType string, resourceID string) *errors.ServiceError {
+	if err := s.connectionFactory.New().
+		Where("worker_type = ? AND resource_id = ?", workerType, resourceID).
+		Delete(&api.WorkItemFailure{}).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "unable to clear the failures of work item '%s' of worker type '%s'", resourceID, workerType)
+	}
+	return nil
+}
+
+func (s *workQueueService) Release(workerType string, resourceID string) *errors.ServiceError {
+	result := s.connectionFactory.New().
+		Where("worker_type = ? AND resource_id = ? AND quarantined_at IS NOT NULL", workerType, resourceID).
+		Delete(&api.WorkItemFailure{})
+	if result.Error != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, result.Error, "unable to release work item '%s' of worker type '%s'", resourceID, workerType)
+	}
+	if result.RowsAffected == 0 {
+		return errors.NotFound("work item '%s' of worker type '%s' is not quarantined", resourceID, workerType)
+	}
+
+	// reconcile the released work item without waiting for the next scheduled reconcile
+	s.signalBus.Notify(ReconcileSignal(workerType))
+	return nil
+}
+
+// backoff returns the time to wait before the given attempt, doubling from the initial backoff up to the max backoff
+func (s *workQueueService) backoff(attempts int) time.Duration {
+	backoff := s.reconcilerConfig.RetryInitialBackoff
+	for i := 1; i < attempts && backoff < s.reconcilerConfig.RetryMaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > s.reconcilerConfig.RetryMaxBackoff {
+		backoff = s.reconcilerConfig.RetryMaxBackoff
+	}
+	return backoff
+}
@@ -0,0 +1,276 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package workers
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"sync"
+)
+
+// Ensure, that WorkQueueServiceMock does implement WorkQueueService.
+// If this is not the case, regenerate this file with moq.
+var _ WorkQueueService = &WorkQueueServiceMock{}
+
+// WorkQueueServiceMock is a mock implementation of WorkQueueService.
+//
+//	func TestSomethingThatUsesWorkQueueService(t *testing.T) {
+//
+//		// make and configure a mocked WorkQueueService
+//		mockedWorkQueueService := &WorkQueueServiceMock{
+//			ClearFailuresFunc: func(workerType string, resourceID string) *errors.ServiceError {
+//				panic("mock out the ClearFailures method")
+//			},
+//			ListFailuresFunc: func(workerType string) (api.WorkItemFailureList, *errors.ServiceError) {
+//				panic("mock out the ListFailures method")
+//			},
+//			ListQuarantinedFunc: func(workerType string) (api.WorkItemFailureList, *errors.ServiceError) {
+//				panic("mock out the ListQuarantined method")
+//			},
+//			RecordFailureFunc: func(workerType string, resourceID string, reconcileErr error) (*api.WorkItemFailure, *errors.ServiceError) {
+//				panic("mock out the RecordFailure method")
+//			},
+//			ReleaseFunc: func(workerType string, resourceID string) *errors.ServiceError {
+//				panic("mock out the Release method")
+//			},
+//		}
+//
+//		// use mockedWorkQueueService in code that requires WorkQueueService
+//		// and then make assertions.
+//
+//	}
+type WorkQueueServiceMock struct {
+	// ClearFailuresFunc mocks the ClearFailures method.
+	ClearFailuresFunc func(workerType string, resourceID string) *errors.ServiceError
+
+	// ListFailuresFunc mocks the ListFailures method.
+	ListFailuresFunc func(workerType string) (api.WorkItemFailureList, *errors.ServiceError)
+
+	// ListQuarantinedFunc mocks the ListQuarantined method.
+	ListQuarantinedFunc func(workerType string) (api.WorkItemFailureList, *errors.ServiceError)
+
+	// RecordFailureFunc mocks the RecordFailure method.
+	RecordFailureFunc func(workerType string, resourceID string, reconcileErr error) (*api.WorkItemFailure, *errors.ServiceError)
+
+	// ReleaseFunc mocks the Release method.
+	ReleaseFunc func(workerType string, resourceID string) *errors.ServiceError
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ClearFailures holds details about calls to the ClearFailures method.
+		ClearFailures []struct {
+			// WorkerType is the workerType argument value.
+			WorkerType string
+			// ResourceID is the resourceID argument value.
+			ResourceID string
+		}
+		// ListFailures holds details about calls to the ListFailures method.
+		ListFailures []struct {
+			// WorkerType is the workerType argument value.
+			WorkerType string
+		}
+		// ListQuarantined holds details about calls to the ListQuarantined method.
+		ListQuarantined []struct {
+			// WorkerType is the workerType argument value.
+			WorkerType string
+		}
+		// RecordFailure holds details about calls to the RecordFailure method.
+		RecordFailure []struct {
+			// WorkerType is the workerType argument value.
+			WorkerType string
+			// ResourceID is the resourceID argument value.
+			ResourceID string
+			// ReconcileErr is the reconcileErr argument value.
+			ReconcileErr error
+		}
+		// Release holds details about calls to the Release method.
+		Release []struct {
+			// WorkerType is the workerType argument value.
+			WorkerType string
+			// ResourceID is the resourceID argument value.
+			ResourceID string
+		}
+	}
+	lockClearFailures   sync.RWMutex
+	lockListFailures    sync.RWMutex
+	lockListQuarantined sync.RWMutex
+	lockRecordFailure   sync.RWMutex
+	lockRelease         sync.RWMutex
+}
+
+// ClearFailures calls ClearFailuresFunc.
+func (mock *WorkQueueServiceMock) ClearFailures(workerType string, resourceID string) *errors.ServiceError {
+	if mock.ClearFailuresFunc == nil {
+		panic("WorkQueueServiceMock.ClearFailuresFunc: method is nil but WorkQueueService.ClearFailures was just called")
+	}
+	callInfo := struct {
+		WorkerType string
+		ResourceID string
+	}{
+		WorkerType: workerType,
+		ResourceID: resourceID,
+	}
+	mock.lockClearFailures.Lock()
+	mock.calls.ClearFailures = append(mock.calls.ClearFailures, callInfo)
+	mock.lockClearFailures.Unlock()
+	return mock.ClearFailuresFunc(workerType, resourceID)
+}
+
+// ClearFailuresCalls gets all the calls that were made to ClearFailures.
+// Check the length with:
+//
+//	len(mockedWorkQueueService.ClearFailuresCalls())
+func (mock *WorkQueueServiceMock) ClearFailuresCalls() []struct {
+	WorkerType string
+	ResourceID string
+} {
+	var calls []struct {
+		WorkerType string
+		ResourceID string
+	}
+	mock.lockClearFailures.RLock()
+	calls = mock.calls.ClearFailures
+	mock.lockClearFailures.RUnlock()
+	return calls
+}
+
+// ListFailures calls ListFailuresFunc.
+func (mock *WorkQueueServiceMock) ListFailures(workerType string) (api.WorkItemFailureList, *errors.ServiceError) {
+	if mock.ListFailuresFunc == nil {
+		panic("WorkQueueServiceMock.ListFailuresFunc: method is nil but WorkQueueService.ListFailures was just called")
+	}
+	callInfo := struct {
+		WorkerType string
+	}{
+		WorkerType: workerType,
+	}
+	mock.lockListFailures.Lock()
+	mock.calls.ListFailures = append(mock.calls.ListFailures, callInfo)
+	mock.lockListFailures.Unlock()
+	return mock.ListFailuresFunc(workerType)
+}
+
+// ListFailuresCalls gets all the calls that were made to ListFailures.
+// Check the length with:
+//
+//	len(mockedWorkQueueService.ListFailuresCalls())
+func (mock *WorkQueueServiceMock) ListFailuresCalls() []struct {
+	WorkerType string
+} {
+	var calls []struct {
+		WorkerType string
+	}
+	mock.lockListFailures.RLock()
+	calls = mock.calls.ListFailures
+	mock.lockListFailures.RUnlock()
+	return calls
+}
+
+// ListQuarantined calls ListQuarantinedFunc.
+func (mock *WorkQueueServiceMock) ListQuarantined(workerType string) (api.WorkItemFailureList, *errors.ServiceError) {
+	if mock.ListQuarantinedFunc == nil {
+		panic("WorkQueueServiceMock.ListQuarantinedFunc: method is nil but WorkQueueService.ListQuarantined was just called")
+	}
+	callInfo := struct {
+		WorkerType string
+	}{
+		WorkerType: workerType,
+	}
+	mock.lockListQuarantined.Lock()
+	mock.calls.ListQuarantined = append(mock.calls.ListQuarantined, callInfo)
+	mock.lockListQuarantined.Unlock()
+	return mock.ListQuarantinedFunc(workerType)
+}
+
+// ListQuarantinedCalls gets all the calls that were made to ListQuarantined.
+// Check the length with:
+//
+//	len(mockedWorkQueueService.ListQuarantinedCalls())
+func (mock *WorkQueueServiceMock) ListQuarantinedCalls() []struct {
+	WorkerType string
+} {
+	var calls []struct {
+		WorkerType string
+	}
+	mock.lockListQuarantined.RLock()
+	calls = mock.calls.ListQuarantined
+	mock.lockListQuarantined.RUnlock()
+	return calls
+}
+
+// RecordFailure calls RecordFailureFunc.
+func (mock *WorkQueueServiceMock) RecordFailure(workerType string, resourceID string, reconcileErr error) (*api.WorkItemFailure, *errors.ServiceError) {
+	if mock.RecordFailureFunc == nil {
+		panic("WorkQueueServiceMock.RecordFailureFunc: method is nil but WorkQueueService.RecordFailure was just called")
+	}
+	callInfo := struct {
+		WorkerType   string
+		ResourceID   string
+		ReconcileErr error
+	}{
+		WorkerType:   workerType,
+		ResourceID:   resourceID,
+		ReconcileErr: reconcileErr,
+	}
+	mock.lockRecordFailure.Lock()
+	mock.calls.RecordFailure = append(mock.calls.RecordFailure, callInfo)
+	mock.lockRecordFailure.Unlock()
+	return mock.RecordFailureFunc(workerType, resourceID, reconcileErr)
+}
+
+// RecordFailureCalls gets all the calls that were made to RecordFailure.
+// Check the length with:
+//
+//	len(mockedWorkQueueService.RecordFailureCalls())
+func (mock *WorkQueueServiceMock) RecordFailureCalls() []struct {
+	WorkerType   string
+	ResourceID   string
+	ReconcileErr error
+} {
+	var calls []struct {
+		WorkerType   string
+		ResourceID   string
+		ReconcileErr error
+	}
+	mock.lockRecordFailure.RLock()
+	calls = mock.calls.RecordFailure
+	mock.lockRecordFailure.RUnlock()
+	return calls
+}
+
+// Release calls ReleaseFunc.
+func (mock *WorkQueueServiceMock) Release(workerType string, resourceID string) *errors.ServiceError {
+	if mock.ReleaseFunc == nil {
+		panic("WorkQueueServiceMock.ReleaseFunc: method is nil but WorkQueueService.Release was just called")
+	}
+	callInfo := struct {
+		WorkerType string
+		ResourceID string
+	}{
+		WorkerType: workerType,
+		ResourceID: resourceID,
+	}
+	mock.lockRelease.Lock()
+	mock.calls.Release = append(mock.calls.Release, callInfo)
+	mock.lockRelease.Unlock()
+	return mock.ReleaseFunc(workerType, resourceID)
+}
+
+// ReleaseCalls gets all the calls that were made to Release.
+// Check the length with:
+//
+//	len(mockedWorkQueueService.ReleaseCalls())
+func (mock *WorkQueueServiceMock) ReleaseCalls() []struct {
+	WorkerType string
+	ResourceID string
+} {
+	var calls []struct {
+		WorkerType string
+		ResourceID string
+	}
+	mock.lockRelease.RLock()
+	calls = mock.calls.Release
+	mock.lockRelease.RUnlock()
+	return calls
+}
@@ -0,0 +1,145 @@
+package workers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_workQueueService_backoff(t *testing.T) {
+	s := &workQueueService{
+		reconcilerConfig: &ReconcilerConfig{
+			RetryInitialBackoff: 30 * time.Second,
+			RetryMaxBackoff:     5 * time.Minute,
+		},
+	}
+
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{attempts: 1, want: 30 * time.Second},
+		{attempts: 2, want: time.Minute},
+		{attempts: 4, want: 4 * time.Minute},
+		{attempts: 5, want: 5 * time.Minute},
+		{attempts: 100, want: 5 * time.Minute},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(fmt.Sprintf("should back off %s after %d attempts", tt.want, tt.attempts), func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			g.Expect(s.backoff(tt.attempts)).To(gomega.Equal(tt.want))
+		})
+	}
+}
+
+func Test_workQueueService_RecordFailure(t *testing.T) {
+	tests := []struct {
+		name            string
+		setupFn         func()
+		wantAttempts    int
+		wantQuarantined bool
+	}{
+		{
+			name: "should record the first failure of a work item",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "work_item_failures"`).WithReply(nil)
+			},
+			wantAttempts: 1,
+		},
+		{
+			name: "should quarantine a work item reaching the maximum number of attempts",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "work_item_failures"`).WithReply([]map[string]interface{}{{
+					"id":          "id",
+					"worker_type": "test",
+					"resource_id": "resource-id",
+					"attempts":    2,
+				}})
+			},
+			wantAttempts:    3,
+			wantQuarantined: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			config := NewReconcilerConfig()
+			config.RetryMaxAttempts = 3
+
+			s := NewWorkQueueService(db.NewMockConnectionFactory(nil), config, signalbus.NewSignalBus())
+			failure, err := s.RecordFailure("test", "resource-id", fmt.Errorf("reconcile error"))
+			g.Expect(err).To(gomega.BeNil())
+			g.Expect(failure.Attempts).To(gomega.Equal(tt.wantAttempts))
+			g.Expect(failure.LastError).To(gomega.Equal("reconcile error"))
+			g.Expect(failure.IsQuarantined()).To(gomega.Equal(tt.wantQuarantined))
+			g.Expect(failure.NextAttemptAt.After(time.Now())).To(gomega.BeTrue())
+		})
+	}
+}
+
+func Test_workQueueService_Release(t *testing.T) {
+	tests := []struct {
+		name         string
+		setupFn      func()
+		wantCode     int
+		wantNotified bool
+	}{
+		{
+			name: "should release the quarantined work item",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`DELETE FROM "work_item_failures"`).WithRowsNum(1)
+			},
+			wantNotified: true,
+		},
+		{
+			name: "should return not found when the work item is not quarantined",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`DELETE FROM "work_item_failures"`).WithRowsNum(0)
+			},
+			wantCode: http.StatusNotFound,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			bus := signalbus.NewSignalBus()
+			sub := bus.Subscribe(ReconcileSignal("test"))
+			defer sub.Close()
+
+			s := NewWorkQueueService(db.NewMockConnectionFactory(nil), NewReconcilerConfig(), bus)
+			err := s.Release("test", "resource-id")
+			if tt.wantCode != 0 {
+				g.Expect(err).ToNot(gomega.BeNil())
+				g.Expect(err.HttpCode).To(gomega.Equal(tt.wantCode))
+			} else {
+				g.Expect(err).To(gomega.BeNil())
+			}
+
+			notified := false
+			select {
+			case <-sub.Signal():
+				notified = true
+			default:
+			}
+			g.Expect(notified).To(gomega.Equal(tt.wantNotified))
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package workers
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/onsi/gomega"
+)
+
+func TestWorkQueue_Process(t *testing.T) {
+	now := time.Now()
+	reconcileErr := fmt.Errorf("reconcile error")
+
+	tests := []struct {
+		name          string
+		failure       *api.WorkItemFailure
+		reconcileErr  error
+		recorded      *api.WorkItemFailure
+		wantReconcile bool
+		wantErr       bool
+		wantRecorded  bool
+		wantCleared   bool
+	}{
+		{
+			name:          "should reconcile a work item without failures",
+			wantReconcile: true,
+		},
+		{
+			name:          "should skip a work item backing off",
+			failure:       &api.WorkItemFailure{ResourceID: "id", Attempts: 1, NextAttemptAt: now.Add(time.Hour)},
+			wantReconcile: false,
+		},
+		{
+			name:          "should skip a quarantined work item",
+			failure:       &api.WorkItemFailure{ResourceID: "id", Attempts: 10, QuarantinedAt: &now},
+			wantReconcile: false,
+		},
+		{
+			name:          "should clear the failures of a work item once it is successfully reconciled",
+			failure:       &api.WorkItemFailure{ResourceID: "id", Attempts: 1, NextAttemptAt: now.Add(-time.Minute)},
+			wantReconcile: true,
+			wantCleared:   true,
+		},
+		{
+			name:          "should record the failure of a work item failing to reconcile",
+			reconcileErr:  reconcileErr,
+			recorded:      &api.WorkItemFailure{ResourceID: "id", Attempts: 1, NextAttemptAt: now.Add(time.Minute)},
+			wantReconcile: true,
+			wantErr:       true,
+			wantRecorded:  true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			service := &WorkQueueServiceMock{
+				ListFailuresFunc: func(workerType string) (api.WorkItemFailureList, *errors.ServiceError) {
+					if tt.failure == nil {
+						return api.WorkItemFailureList{}, nil
+					}
+					return api.WorkItemFailureList{tt.failure}, nil
+				},
+				RecordFailureFunc: func(workerType string, resourceID string, reconcileErr error) (*api.WorkItemFailure, *errors.ServiceError) {
+					return tt.recorded, nil
+				},
+				ClearFailuresFunc: func(workerType string, resourceID string) *errors.ServiceError {
+					return nil
+				},
+			}
+
+			queue := NewWorkQueue("test", service)
+			g.Expect(queue.Refresh()).To(gomega.Succeed())
+
+			reconciled := false
+			err := queue.Process("id", func() error {
+				reconciled = true
+				return tt.reconcileErr
+			})
+			g.Expect(reconciled).To(gomega.Equal(tt.wantReconcile))
+			g.Expect(err != nil).To(gomega.Equal(tt.wantErr))
+			g.Expect(service.RecordFailureCalls()).To(gomega.HaveLen(boolToInt(tt.wantRecorded)))
+			g.Expect(service.ClearFailuresCalls()).To(gomega.HaveLen(boolToInt(tt.wantCleared)))
+		})
+	}
+}
+
+func TestWorkQueue_Process_withoutService(t *testing.T) {
+	g := gomega.NewWithT(t)
+	queue := NewWorkQueue("test", nil)
+	g.Expect(queue.Refresh()).To(gomega.Succeed())
+	g.Expect(queue.Process("id", func() error { return fmt.Errorf("reconcile error") })).ToNot(gomega.Succeed())
+	g.Expect(queue.Process("id", func() error { return nil })).To(gomega.Succeed())
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
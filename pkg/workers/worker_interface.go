@@ -4,6 +4,7 @@ import (
 	"sync"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
+	"gorm.io/gorm"
 )
 
 //go:generate moq -out worker_interface_moq.go . Worker
@@ -18,6 +19,10 @@ type Worker interface {
 	IsRunning() bool
 	SetIsRunning(val bool)
 	HasTerminated() bool
+	// SupportsSharding tells whether the work items of the worker can be partitioned across replicas
+	SupportsSharding() bool
+	// SetShards sets the shards, out of shardCount, whose work items are reconciled by the worker
+	SetShards(shardCount int, shards []int)
 }
 
 type BaseWorker struct {
@@ -27,6 +32,10 @@ type BaseWorker struct {
 	isRunning    bool
 	imStop       chan struct{}
 	syncTeardown sync.WaitGroup
+	shardsMutex  sync.RWMutex
+	shardCount   int
+	shards       []int
+	workQueue    *WorkQueue
 }
 
 func (b *BaseWorker) GetID() string {
@@ -67,3 +76,71 @@ func (b *BaseWorker) StopWorker(w Worker) {
 func (b *BaseWorker) HasTerminated() bool {
 	return false
 }
+
+// SupportsSharding returns false by default, workers reconciling their work items independently of each other
+// override it to opt into the sharded reconciliation
+func (b *BaseWorker) SupportsSharding() bool {
+	return false
+}
+
+func (b *BaseWorker) SetShards(shardCount int, shards []int) {
+	b.shardsMutex.Lock()
+	defer b.shardsMutex.Unlock()
+	b.shardCount = shardCount
+	b.shards = shards
+}
+
+// GetShards returns the shards currently owned by the worker, it is empty when sharding is disabled
+func (b *BaseWorker) GetShards() []int {
+	b.shardsMutex.RLock()
+	defer b.shardsMutex.RUnlock()
+	return append([]int{}, b.shards...)
+}
+
+// ShardScope restricts a query to the work items of the shards owned by the worker, whose ids are in the given column.
+// The query is left unchanged when sharding is disabled
+func (b *BaseWorker) ShardScope(column string) func(*gorm.DB) *gorm.DB {
+	condition, args := b.ShardCondition(column)
+	return func(dbConn *gorm.DB) *gorm.DB {
+		if condition == "" {
+			return dbConn
+		}
+		return dbConn.Where(condition, args...)
+	}
+}
+
+// ShardCondition returns the SQL condition, with its arguments, matching the work items of the shards owned by the worker,
+// whose ids are in the given column. The condition is empty when sharding is disabled
+func (b *BaseWorker) ShardCondition(column string) (string, []interface{}) {
+	b.shardsMutex.RLock()
+	defer b.shardsMutex.RUnlock()
+	if b.shardCount <= 1 {
+		return "", nil
+	}
+	return ShardCondition(column, b.shardCount, append([]int{}, b.shards...))
+}
+
+// OwnsResource tells whether the work item with the given id belongs to one of the shards owned by the worker.
+// It is always true when sharding is disabled
+func (b *BaseWorker) OwnsResource(id string) bool {
+	b.shardsMutex.RLock()
+	defer b.shardsMutex.RUnlock()
+	if b.shardCount <= 1 {
+		return true
+	}
+	shard := ShardOf(id, b.shardCount)
+	for _, owned := range b.shards {
+		if owned == shard {
+			return true
+		}
+	}
+	return false
+}
+
+// WorkQueue returns the queue backing off and quarantining the work items of the worker failing to reconcile
+func (b *BaseWorker) WorkQueue() *WorkQueue {
+	if b.workQueue == nil {
+		b.workQueue = NewWorkQueue(b.WorkerType, b.Reconciler.WorkQueueService)
+	}
+	return b.workQueue
+}
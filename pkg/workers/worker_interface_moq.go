@@ -41,12 +41,18 @@ var _ Worker = &WorkerMock{}
 //			SetIsRunningFunc: func(val bool)  {
 //				panic("mock out the SetIsRunning method")
 //			},
+//			SetShardsFunc: func(shardCount int, shards []int)  {
+//				panic("mock out the SetShards method")
+//			},
 //			StartFunc: func()  {
 //				panic("mock out the Start method")
 //			},
 //			StopFunc: func()  {
 //				panic("mock out the Stop method")
 //			},
+//			SupportsShardingFunc: func() bool {
+//				panic("mock out the SupportsSharding method")
+//			},
 //		}
 //
 //		// use mockedWorker in code that requires Worker
@@ -78,12 +84,18 @@ type WorkerMock struct {
 	// SetIsRunningFunc mocks the SetIsRunning method.
 	SetIsRunningFunc func(val bool)
 
+	// SetShardsFunc mocks the SetShards method.
+	SetShardsFunc func(shardCount int, shards []int)
+
 	// StartFunc mocks the Start method.
 	StartFunc func()
 
 	// StopFunc mocks the Stop method.
 	StopFunc func()
 
+	// SupportsShardingFunc mocks the SupportsSharding method.
+	SupportsShardingFunc func() bool
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// GetID holds details about calls to the GetID method.
@@ -112,23 +124,35 @@ type WorkerMock struct {
 			// Val is the val argument value.
 			Val bool
 		}
+		// SetShards holds details about calls to the SetShards method.
+		SetShards []struct {
+			// ShardCount is the shardCount argument value.
+			ShardCount int
+			// Shards is the shards argument value.
+			Shards []int
+		}
 		// Start holds details about calls to the Start method.
 		Start []struct {
 		}
 		// Stop holds details about calls to the Stop method.
 		Stop []struct {
 		}
+		// SupportsSharding holds details about calls to the SupportsSharding method.
+		SupportsSharding []struct {
+		}
 	}
-	lockGetID         sync.RWMutex
-	lockGetStopChan   sync.RWMutex
-	lockGetSyncGroup  sync.RWMutex
-	lockGetWorkerType sync.RWMutex
-	lockHasTerminated sync.RWMutex
-	lockIsRunning     sync.RWMutex
-	lockReconcile     sync.RWMutex
-	lockSetIsRunning  sync.RWMutex
-	lockStart         sync.RWMutex
-	lockStop          sync.RWMutex
+	lockGetID            sync.RWMutex
+	lockGetStopChan      sync.RWMutex
+	lockGetSyncGroup     sync.RWMutex
+	lockGetWorkerType    sync.RWMutex
+	lockHasTerminated    sync.RWMutex
+	lockIsRunning        sync.RWMutex
+	lockReconcile        sync.RWMutex
+	lockSetIsRunning     sync.RWMutex
+	lockSetShards        sync.RWMutex
+	lockStart            sync.RWMutex
+	lockStop             sync.RWMutex
+	lockSupportsSharding sync.RWMutex
 }
 
 // GetID calls GetIDFunc.
@@ -352,6 +376,42 @@ func (mock *WorkerMock) SetIsRunningCalls() []struct {
 	return calls
 }
 
+// SetShards calls SetShardsFunc.
+func (mock *WorkerMock) SetShards(shardCount int, shards []int) {
+	if mock.SetShardsFunc == nil {
+		panic("WorkerMock.SetShardsFunc: method is nil but Worker.SetShards was just called")
+	}
+	callInfo := struct {
+		ShardCount int
+		Shards     []int
+	}{
+		ShardCount: shardCount,
+		Shards:     shards,
+	}
+	mock.lockSetShards.Lock()
+	mock.calls.SetShards = append(mock.calls.SetShards, callInfo)
+	mock.lockSetShards.Unlock()
+	mock.SetShardsFunc(shardCount, shards)
+}
+
+// SetShardsCalls gets all the calls that were made to SetShards.
+// Check the length with:
+//
+//	len(mockedWorker.SetShardsCalls())
+func (mock *WorkerMock) SetShardsCalls() []struct {
+	ShardCount int
+	Shards     []int
+} {
+	var calls []struct {
+		ShardCount int
+		Shards     []int
+	}
+	mock.lockSetShards.RLock()
+	calls = mock.calls.SetShards
+	mock.lockSetShards.RUnlock()
+	return calls
+}
+
 // Start calls StartFunc.
 func (mock *WorkerMock) Start() {
 	if mock.StartFunc == nil {
@@ -405,3 +465,30 @@ func (mock *WorkerMock) StopCalls() []struct {
 	mock.lockStop.RUnlock()
 	return calls
 }
+
+// SupportsSharding calls SupportsShardingFunc.
+func (mock *WorkerMock) SupportsSharding() bool {
+	if mock.SupportsShardingFunc == nil {
+		panic("WorkerMock.SupportsShardingFunc: method is nil but Worker.SupportsSharding was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockSupportsSharding.Lock()
+	mock.calls.SupportsSharding = append(mock.calls.SupportsSharding, callInfo)
+	mock.lockSupportsSharding.Unlock()
+	return mock.SupportsShardingFunc()
+}
+
+// SupportsShardingCalls gets all the calls that were made to SupportsSharding.
+// Check the length with:
+//
+//	len(mockedWorker.SupportsShardingCalls())
+func (mock *WorkerMock) SupportsShardingCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockSupportsSharding.RLock()
+	calls = mock.calls.SupportsSharding
+	mock.lockSupportsSharding.RUnlock()
+	return calls
+}
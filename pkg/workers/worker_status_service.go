@@ -0,0 +1,123 @@
+package workers
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
+	"gorm.io/gorm"
+)
+
+// ReconcileSignal returns the name of the signal bus channel triggering the reconcile of the given worker type
+func ReconcileSignal(workerType string) string {
+	return "reconcile:" + workerType
+}
+
+// WorkerStatusService manages the status of the worker types.
+//
+// The status of a worker type is persisted in its leader lease so that it is shared by all the replicas:
+// a worker type paused through any replica is paused on the replica holding the lease
+//
+//go:generate moq -out worker_status_service_moq.go . WorkerStatusService
+type WorkerStatusService interface {
+	// List returns the status of all the worker types
+	List() (api.LeaderLeaseList, *errors.ServiceError)
+	// Get returns the status of the given worker type
+	Get(workerType string) (*api.LeaderLease, *errors.ServiceError)
+	// SetPaused pauses or resumes the reconciles of the given worker type
+	SetPaused(workerType string, paused bool) (*api.LeaderLease, *errors.ServiceError)
+	// TriggerReconcile asks the leader of the given worker type to reconcile as soon as possible
+	TriggerReconcile(workerType string) (*api.LeaderLease, *errors.ServiceError)
+	// RecordReconcile stores the result of a reconcile of the given worker type
+	RecordReconcile(workerType string, reconciledAt time.Time, reconcileErrors []error, nextReconcileAt time.Time) *errors.ServiceError
+}
+
+type workerStatusService struct {
+	connectionFactory *db.ConnectionFactory
+	signalBus         signalbus.SignalBus
+}
+
+var _ WorkerStatusService = &workerStatusService{}
+
+func NewWorkerStatusService(connectionFactory *db.ConnectionFactory, signalBus signalbus.SignalBus) WorkerStatusService {
+	return &workerStatusService{
+		connectionFactory: connectionFactory,
+		signalBus:         signalBus,
+	}
+}
+
+func (s *workerStatusService) List() (api.LeaderLeaseList, *errors.ServiceError) {
+	var leases api.LeaderLeaseList
+	if err := s.connectionFactory.New().Where("lease_type NOT LIKE ?", ReplicaLeaseTypePrefix+"%").Order("lease_type").Find(&leases).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list workers")
+	}
+	return leases, nil
+}
+
+func (s *workerStatusService) Get(workerType string) (*api.LeaderLease, *errors.ServiceError) {
+	var lease api.LeaderLease
+	if err := s.connectionFactory.New().Where("lease_type = ?", workerType).First(&lease).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.NotFound("worker type '%s' not found", workerType)
+		}
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to get worker type '%s'", workerType)
+	}
+	return &lease, nil
+}
+
+func (s *workerStatusService) SetPaused(workerType string, paused bool) (*api.LeaderLease, *errors.ServiceError) {
+	lease, svcErr := s.Get(workerType)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	if err := s.connectionFactory.New().Model(lease).Update("paused", paused).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to update worker type '%s'", workerType)
+	}
+	lease.Paused = paused
+
+	if !paused {
+		// catch up with the reconciles skipped while paused instead of waiting for the next scheduled one
+		s.signalBus.Notify(ReconcileSignal(workerType))
+	}
+
+	return lease, nil
+}
+
+func (s *workerStatusService) TriggerReconcile(workerType string) (*api.LeaderLease, *errors.ServiceError) {
+	lease, svcErr := s.Get(workerType)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	if lease.Paused {
+		return nil, errors.Conflict("worker type '%s' is paused", workerType)
+	}
+
+	s.signalBus.Notify(ReconcileSignal(workerType))
+	return lease, nil
+}
+
+func (s *workerStatusService) RecordReconcile(workerType string, reconciledAt time.Time, reconcileErrors []error, nextReconcileAt time.Time) *errors.ServiceError {
+	messages := make([]string, 0, len(reconcileErrors))
+	for _, e := range reconcileErrors {
+		messages = append(messages, e.Error())
+	}
+	// marshalling a slice of strings cannot fail
+	lastReconcileErrors, _ := json.Marshal(messages)
+
+	err := s.connectionFactory.New().Model(&api.LeaderLease{}).
+		Where("lease_type = ?", workerType).
+		Updates(map[string]interface{}{
+			"last_reconcile_at":     reconciledAt,
+			"last_reconcile_errors": api.JSON(lastReconcileErrors),
+			"next_reconcile_at":     nextReconcileAt,
+		}).Error
+	if err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "unable to record the reconcile of worker type '%s'", workerType)
+	}
+	return nil
+}
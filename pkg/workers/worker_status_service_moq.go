@@ -0,0 +1,270 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package workers
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"sync"
+	"time"
+)
+
+// Ensure, that WorkerStatusServiceMock does implement WorkerStatusService.
+// If this is not the case, regenerate this file with moq.
+var _ WorkerStatusService = &WorkerStatusServiceMock{}
+
+// WorkerStatusServiceMock is a mock implementation of WorkerStatusService.
+//
+//	func TestSomethingThatUsesWorkerStatusService(t *testing.T) {
+//
+//		// make and configure a mocked WorkerStatusService
+//		mockedWorkerStatusService := &WorkerStatusServiceMock{
+//			GetFunc: func(workerType string) (*api.LeaderLease, *errors.ServiceError) {
+//				panic("mock out the Get method")
+//			},
+//			ListFunc: func() (api.LeaderLeaseList, *errors.ServiceError) {
+//				panic("mock out the List method")
+//			},
+//			RecordReconcileFunc: func(workerType string, reconciledAt time.Time, reconcileErrors []error, nextReconcileAt time.Time) *errors.ServiceError {
+//				panic("mock out the RecordReconcile method")
+//			},
+//			SetPausedFunc: func(workerType string, paused bool) (*api.LeaderLease, *errors.ServiceError) {
+//				panic("mock out the SetPaused method")
+//			},
+//			TriggerReconcileFunc: func(workerType string) (*api.LeaderLease, *errors.ServiceError) {
+//				panic("mock out the TriggerReconcile method")
+//			},
+//		}
+//
+//		// use mockedWorkerStatusService in code that requires WorkerStatusService
+//		// and then make assertions.
+//
+//	}
+type WorkerStatusServiceMock struct {
+	// GetFunc mocks the Get method.
+	GetFunc func(workerType string) (*api.LeaderLease, *errors.ServiceError)
+
+	// ListFunc mocks the List method.
+	ListFunc func() (api.LeaderLeaseList, *errors.ServiceError)
+
+	// RecordReconcileFunc mocks the RecordReconcile method.
+	RecordReconcileFunc func(workerType string, reconciledAt time.Time, reconcileErrors []error, nextReconcileAt time.Time) *errors.ServiceError
+
+	// SetPausedFunc mocks the SetPaused method.
+	SetPausedFunc func(workerType string, paused bool) (*api.LeaderLease, *errors.ServiceError)
+
+	// TriggerReconcileFunc mocks the TriggerReconcile method.
+	TriggerReconcileFunc func(workerType string) (*api.LeaderLease, *errors.ServiceError)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// WorkerType is the workerType argument value.
+			WorkerType string
+		}
+		// List holds details about calls to the List method.
+		List []struct {
+		}
+		// RecordReconcile holds details about calls to the RecordReconcile method.
+		RecordReconcile []struct {
+			// WorkerType is the workerType argument value.
+			WorkerType string
+			// ReconciledAt is the reconciledAt argument value.
+			ReconciledAt time.Time
+			// ReconcileErrors is the reconcileErrors argument value.
+			ReconcileErrors []error
+			// NextReconcileAt is the nextReconcileAt argument value.
+			NextReconcileAt time.Time
+		}
+		// SetPaused holds details about calls to the SetPaused method.
+		SetPaused []struct {
+			// WorkerType is the workerType argument value.
+			WorkerType string
+			// Paused is the paused argument value.
+			Paused bool
+		}
+		// TriggerReconcile holds details about calls to the TriggerReconcile method.
+		TriggerReconcile []struct {
+			// WorkerType is the workerType argument value.
+			WorkerType string
+		}
+	}
+	lockGet              sync.RWMutex
+	lockList             sync.RWMutex
+	lockRecordReconcile  sync.RWMutex
+	lockSetPaused        sync.RWMutex
+	lockTriggerReconcile sync.RWMutex
+}
+
+// Get calls GetFunc.
+func (mock *WorkerStatusServiceMock) Get(workerType string) (*api.LeaderLease, *errors.ServiceError) {
+	if mock.GetFunc == nil {
+		panic("WorkerStatusServiceMock.GetFunc: method is nil but WorkerStatusService.Get was just called")
+	}
+	callInfo := struct {
+		WorkerType string
+	}{
+		WorkerType: workerType,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(workerType)
+}
+
+// GetCalls gets all the calls that were made to Get.
+// Check the length with:
+//
+//	len(mockedWorkerStatusService.GetCalls())
+func (mock *WorkerStatusServiceMock) GetCalls() []struct {
+	WorkerType string
+} {
+	var calls []struct {
+		WorkerType string
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// List calls ListFunc.
+func (mock *WorkerStatusServiceMock) List() (api.LeaderLeaseList, *errors.ServiceError) {
+	if mock.ListFunc == nil {
+		panic("WorkerStatusServiceMock.ListFunc: method is nil but WorkerStatusService.List was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc()
+}
+
+// ListCalls gets all the calls that were made to List.
+// Check the length with:
+//
+//	len(mockedWorkerStatusService.ListCalls())
+func (mock *WorkerStatusServiceMock) ListCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockList.RLock()
+	calls = mock.calls.List
+	mock.lockList.RUnlock()
+	return calls
+}
+
+// RecordReconcile calls RecordReconcileFunc.
+func (mock *WorkerStatusServiceMock) RecordReconcile(workerType string, reconciledAt time.Time, reconcileErrors []error, nextReconcileAt time.Time) *errors.ServiceError {
+	if mock.RecordReconcileFunc == nil {
+		panic("WorkerStatusServiceMock.RecordReconcileFunc: method is nil but WorkerStatusService.RecordReconcile was just called")
+	}
+	callInfo := struct {
+		WorkerType      string
+		ReconciledAt    time.Time
+		ReconcileErrors []error
+		NextReconcileAt time.Time
+	}{
+		WorkerType:      workerType,
+		ReconciledAt:    reconciledAt,
+		ReconcileErrors: reconcileErrors,
+		NextReconcileAt: nextReconcileAt,
+	}
+	mock.lockRecordReconcile.Lock()
+	mock.calls.RecordReconcile = append(mock.calls.RecordReconcile, callInfo)
+	mock.lockRecordReconcile.Unlock()
+	return mock.RecordReconcileFunc(workerType, reconciledAt, reconcileErrors, nextReconcileAt)
+}
+
+// RecordReconcileCalls gets all the calls that were made to RecordReconcile.
+// Check the length with:
+//
+//	len(mockedWorkerStatusService.RecordReconcileCalls())
+func (mock *WorkerStatusServiceMock) RecordReconcileCalls() []struct {
+	WorkerType      string
+	ReconciledAt    time.Time
+	ReconcileErrors []error
+	NextReconcileAt time.Time
+} {
+	var calls []struct {
+		WorkerType      string
+		ReconciledAt    time.Time
+		ReconcileErrors []error
+		NextReconcileAt time.Time
+	}
+	mock.lockRecordReconcile.RLock()
+	calls = mock.calls.RecordReconcile
+	mock.lockRecordReconcile.RUnlock()
+	return calls
+}
+
+// SetPaused calls SetPausedFunc.
+func (mock *WorkerStatusServiceMock) SetPaused(workerType string, paused bool) (*api.LeaderLease, *errors.ServiceError) {
+	if mock.SetPausedFunc == nil {
+		panic("WorkerStatusServiceMock.SetPausedFunc: method is nil but WorkerStatusService.SetPaused was just called")
+	}
+	callInfo := struct {
+		WorkerType string
+		Paused     bool
+	}{
+		WorkerType: workerType,
+		Paused:     paused,
+	}
+	mock.lockSetPaused.Lock()
+	mock.calls.SetPaused = append(mock.calls.SetPaused, callInfo)
+	mock.lockSetPaused.Unlock()
+	return mock.SetPausedFunc(workerType, paused)
+}
+
+// SetPausedCalls gets all the calls that were made to SetPaused.
+// Check the length with:
+//
+//	len(mockedWorkerStatusService.SetPausedCalls())
+func (mock *WorkerStatusServiceMock) SetPausedCalls() []struct {
+	WorkerType string
+	Paused     bool
+} {
+	var calls []struct {
+		WorkerType string
+		Paused     bool
+	}
+	mock.lockSetPaused.RLock()
+	calls = mock.calls.SetPaused
+	mock.lockSetPaused.RUnlock()
+	return calls
+}
+
+// TriggerReconcile calls TriggerReconcileFunc.
+func (mock *WorkerStatusServiceMock) TriggerReconcile(workerType string) (*api.LeaderLease, *errors.ServiceError) {
+	if mock.TriggerReconcileFunc == nil {
+		panic("WorkerStatusServiceMock.TriggerReconcileFunc: method is nil but WorkerStatusService.TriggerReconcile was just called")
+	}
+	callInfo := struct {
+		WorkerType string
+	}{
+		WorkerType: workerType,
+	}
+	mock.lockTriggerReconcile.Lock()
+	mock.calls.TriggerReconcile = append(mock.calls.TriggerReconcile, callInfo)
+	mock.lockTriggerReconcile.Unlock()
+	return mock.TriggerReconcileFunc(workerType)
+}
+
+// TriggerReconcileCalls gets all the calls that were made to TriggerReconcile.
+// Check the length with:
+//
+//	len(mockedWorkerStatusService.TriggerReconcileCalls())
+func (mock *WorkerStatusServiceMock) TriggerReconcileCalls() []struct {
+	WorkerType string
+} {
+	var calls []struct {
+		WorkerType string
+	}
+	mock.lockTriggerReconcile.RLock()
+	calls = mock.calls.TriggerReconcile
+	mock.lockTriggerReconcile.RUnlock()
+	return calls
+}
@@ -0,0 +1,143 @@
+package workers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
+	"github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_workerStatusService_SetPaused(t *testing.T) {
+	tests := []struct {
+		name         string
+		paused       bool
+		setupFn      func()
+		wantCode     int
+		wantNotified bool
+	}{
+		{
+			name:   "should pause the worker type",
+			paused: true,
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "leader_leases" WHERE lease_type = $1`).WithReply([]map[string]interface{}{{"id": "1", "lease_type": "test"}})
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "leader_leases" SET "paused"=$1`)
+			},
+		},
+		{
+			name:   "should trigger a reconcile when the worker type is resumed",
+			paused: false,
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "leader_leases" WHERE lease_type = $1`).WithReply([]map[string]interface{}{{"id": "1", "lease_type": "test", "paused": true}})
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "leader_leases" SET "paused"=$1`)
+			},
+			wantNotified: true,
+		},
+		{
+			name:   "should return not found when the worker type does not exist",
+			paused: true,
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "leader_leases" WHERE lease_type = $1`).WithReply(nil)
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:   "should return an error when the worker type cannot be updated",
+			paused: true,
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "leader_leases" WHERE lease_type = $1`).WithReply([]map[string]interface{}{{"id": "1", "lease_type": "test"}})
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "leader_leases" SET "paused"=$1`).WithExecException()
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			bus := signalbus.NewSignalBus()
+			sub := bus.Subscribe(ReconcileSignal("test"))
+			defer sub.Close()
+
+			s := NewWorkerStatusService(db.NewMockConnectionFactory(nil), bus)
+			lease, err := s.SetPaused("test", tt.paused)
+			if tt.wantCode != 0 {
+				g.Expect(err).ToNot(gomega.BeNil())
+				g.Expect(err.HttpCode).To(gomega.Equal(tt.wantCode))
+				return
+			}
+			g.Expect(err).To(gomega.BeNil())
+			g.Expect(lease.Paused).To(gomega.Equal(tt.paused))
+
+			notified := false
+			select {
+			case <-sub.Signal():
+				notified = true
+			default:
+			}
+			g.Expect(notified).To(gomega.Equal(tt.wantNotified))
+		})
+	}
+}
+
+func Test_workerStatusService_TriggerReconcile(t *testing.T) {
+	tests := []struct {
+		name         string
+		setupFn      func()
+		wantCode     int
+		wantNotified bool
+	}{
+		{
+			name: "should trigger a reconcile of the worker type",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "leader_leases" WHERE lease_type = $1`).WithReply([]map[string]interface{}{{"id": "1", "lease_type": "test"}})
+			},
+			wantNotified: true,
+		},
+		{
+			name: "should return a conflict when the worker type is paused",
+			setupFn: func() {
+				mocket.Catcher.Reset()
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "leader_leases" WHERE lease_type = $1`).WithReply([]map[string]interface{}{{"id": "1", "lease_type": "test", "paused": true}})
+			},
+			wantCode: http.StatusConflict,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			tt.setupFn()
+			bus := signalbus.NewSignalBus()
+			sub := bus.Subscribe(ReconcileSignal("test"))
+			defer sub.Close()
+
+			s := NewWorkerStatusService(db.NewMockConnectionFactory(nil), bus)
+			_, err := s.TriggerReconcile("test")
+			if tt.wantCode != 0 {
+				g.Expect(err).ToNot(gomega.BeNil())
+				g.Expect(err.HttpCode).To(gomega.Equal(tt.wantCode))
+			} else {
+				g.Expect(err).To(gomega.BeNil())
+			}
+
+			notified := false
+			select {
+			case <-sub.Signal():
+				notified = true
+			default:
+			}
+			g.Expect(notified).To(gomega.Equal(tt.wantNotified))
+		})
+	}
+}